@@ -296,9 +296,10 @@ func (b Builder) build(imageName string) error {
 
 func (b Builder) sign(imageName string) error {
 	s := signing.NewSigning(signing.Options{
-		Type:      enums.SigningTypeCosign,
-		Http:      strings.HasPrefix(b.Endpoint, "http://"),
-		MultiArch: len(b.BuildkitPlatforms) > 1,
+		Type:        b.SigningType,
+		Http:        strings.HasPrefix(b.Endpoint, "http://"),
+		MultiArch:   len(b.BuildkitPlatforms) > 1,
+		Certificate: b.SigningCertificate,
 	})
 	return s.Sign(context.Background(), b.Authorization, b.SigningPrivateKey, imageName)
 }
@@ -101,5 +101,13 @@ func (b *Builder) checker() error {
 	}
 	b.SigningPrivateKey = signingPrivateKey
 
+	if b.SigningCertificate != "" {
+		signingCertificate, err := crypt.Decrypt(fmt.Sprintf("%d-%d", b.BuilderID, b.RunnerID), b.SigningCertificate)
+		if err != nil {
+			return fmt.Errorf("Decrypt signing certificate failed: %v", err)
+		}
+		b.SigningCertificate = signingCertificate
+	}
+
 	return nil
 }
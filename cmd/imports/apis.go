@@ -15,6 +15,7 @@
 package imports
 
 import (
+	_ "github.com/go-sigma/sigma/pkg/handlers/allowlists"
 	_ "github.com/go-sigma/sigma/pkg/handlers/apidocs"
 	_ "github.com/go-sigma/sigma/pkg/handlers/artifacts"
 	_ "github.com/go-sigma/sigma/pkg/handlers/builders"
@@ -15,6 +15,7 @@
 package imports
 
 import (
+	_ "github.com/go-sigma/sigma/pkg/builder/buildkit"
 	_ "github.com/go-sigma/sigma/pkg/builder/docker"
 	_ "github.com/go-sigma/sigma/pkg/builder/kubernetes"
 	_ "github.com/go-sigma/sigma/pkg/builder/logger/database"
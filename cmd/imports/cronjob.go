@@ -15,5 +15,9 @@
 package imports
 
 import (
+	_ "github.com/go-sigma/sigma/pkg/cronjob/allowlist"
 	_ "github.com/go-sigma/sigma/pkg/cronjob/builder"
+	_ "github.com/go-sigma/sigma/pkg/cronjob/gc"
+	_ "github.com/go-sigma/sigma/pkg/cronjob/quota"
+	_ "github.com/go-sigma/sigma/pkg/cronjob/rescan"
 )
@@ -19,6 +19,7 @@ import (
 	_ "github.com/go-sigma/sigma/pkg/daemon/coderepo"
 	_ "github.com/go-sigma/sigma/pkg/daemon/gc"
 	_ "github.com/go-sigma/sigma/pkg/daemon/pushed"
+	_ "github.com/go-sigma/sigma/pkg/daemon/quota"
 	_ "github.com/go-sigma/sigma/pkg/daemon/scan"
 	_ "github.com/go-sigma/sigma/pkg/daemon/webhook"
 )
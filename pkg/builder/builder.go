@@ -16,19 +16,23 @@ package builder
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"strconv"
 	"strings"
 
 	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/go-sigma/sigma/pkg/builder/logger"
 	"github.com/go-sigma/sigma/pkg/configs"
 	"github.com/go-sigma/sigma/pkg/consts"
 	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/signing"
 	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
 	"github.com/go-sigma/sigma/pkg/utils"
 	"github.com/go-sigma/sigma/pkg/utils/crypt"
 	"github.com/go-sigma/sigma/pkg/utils/ptr"
@@ -50,6 +54,9 @@ type Builder interface {
 // BuilderConfig ...
 type BuilderConfig struct {
 	types.Builder
+
+	// NamespaceID the namespace of the repository, the active signing key of the namespace is preferred
+	NamespaceID int64
 }
 
 // Driver is the builder driver, maybe implement by docker, podman, k8s, etc.
@@ -91,16 +98,7 @@ func BuildEnv(builderConfig BuilderConfig) ([]string, error) {
 
 	ctx := log.Logger.WithContext(context.Background())
 
-	userService := dao.NewUserServiceFactory().New()
-	userObj, err := userService.GetByUsername(ctx, consts.UserInternal)
-	if err != nil {
-		return nil, err
-	}
-	tokenService, err := token.NewTokenService(config.Auth.Jwt.PrivateKey)
-	if err != nil {
-		return nil, err
-	}
-	authorization, err := tokenService.New(userObj.ID, config.Auth.Jwt.Ttl)
+	authorization, err := InternalToken(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -112,7 +110,7 @@ func BuildEnv(builderConfig BuilderConfig) ([]string, error) {
 	}
 
 	settingService := dao.NewSettingServiceFactory().New()
-	privateKey, err := settingService.Get(ctx, consts.SettingSignPrivateKey)
+	privateKey, err := SigningPrivateKey(ctx, builderConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -140,7 +138,17 @@ func BuildEnv(builderConfig BuilderConfig) ([]string, error) {
 		fmt.Sprintf("BUILDKIT_PLATFORMS=%s", utils.StringsJoin(builderConfig.BuildkitPlatforms, ",")),
 		fmt.Sprintf("BUILDKIT_BUILD_ARGS=%s", strings.Join(builderConfig.BuildkitBuildArgs, ",")),
 
-		fmt.Sprintf("SIGNING_PRIVATE_KEY=%s", crypt.MustEncrypt(fmt.Sprintf("%d-%d", builderConfig.BuilderID, builderConfig.RunnerID), string(privateKey.Val))),
+		fmt.Sprintf("SIGNING_PRIVATE_KEY=%s", crypt.MustEncrypt(fmt.Sprintf("%d-%d", builderConfig.BuilderID, builderConfig.RunnerID), privateKey)),
+	}
+	if builderConfig.SigningType == enums.SigningTypeNotation {
+		certificate, err := settingService.Get(ctx, consts.SettingNotationCertificate)
+		if err != nil {
+			return nil, err
+		}
+		buildConfigEnvs = append(buildConfigEnvs,
+			fmt.Sprintf("SIGNING_TYPE=%s", builderConfig.SigningType.String()),
+			fmt.Sprintf("SIGNING_CERTIFICATE=%s", crypt.MustEncrypt(fmt.Sprintf("%d-%d", builderConfig.BuilderID, builderConfig.RunnerID), string(certificate.Val))),
+		)
 	}
 	if builderConfig.Dockerfile != nil {
 		buildConfigEnvs = append(buildConfigEnvs, fmt.Sprintf("DOCKERFILE=%s", ptr.To(builderConfig.Dockerfile)))
@@ -248,3 +256,44 @@ func ParseContainerID(containerName string) (int64, int64, error) {
 	}
 	return builderID, runnerID, nil
 }
+
+// InternalToken returns the token of the internal user, the builder pushes the built image to sigma with it
+func InternalToken(ctx context.Context) (string, error) {
+	config := configs.GetConfiguration()
+	userObj, err := dao.NewUserServiceFactory().New().GetByUsername(ctx, consts.UserInternal)
+	if err != nil {
+		return "", err
+	}
+	tokenService, err := token.NewTokenService(config.Auth.Jwt.PrivateKey)
+	if err != nil {
+		return "", err
+	}
+	return tokenService.New(userObj.ID, config.Auth.Jwt.Ttl)
+}
+
+// SigningPrivateKey returns the private key to sign the built image, the active signing key of the namespace
+// is used for cosign if it exists, otherwise the global signing key is used.
+func SigningPrivateKey(ctx context.Context, builderConfig BuilderConfig) (string, error) {
+	settingService := dao.NewSettingServiceFactory().New()
+	if builderConfig.SigningType == enums.SigningTypeNotation {
+		privateKey, err := settingService.Get(ctx, consts.SettingNotationPrivateKey)
+		if err != nil {
+			return "", err
+		}
+		return string(privateKey.Val), nil
+	}
+	if builderConfig.NamespaceID != 0 {
+		signingKeyObj, err := dao.NewSigningKeyServiceFactory().New().GetActive(ctx, builderConfig.NamespaceID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", err
+		}
+		if signingKeyObj != nil {
+			return signing.DecryptPrivateKey(builderConfig.NamespaceID, signingKeyObj.PrivateKey)
+		}
+	}
+	privateKey, err := settingService.Get(ctx, consts.SettingSignPrivateKey)
+	if err != nil {
+		return "", err
+	}
+	return string(privateKey.Val), nil
+}
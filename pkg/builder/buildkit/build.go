@@ -0,0 +1,395 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buildkit
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/docker/cli/cli/config/configfile"
+	dockertypes "github.com/docker/cli/cli/config/types"
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/moby/buildkit/client"
+	"github.com/moby/buildkit/client/llb"
+	"github.com/moby/buildkit/exporter/containerimage/exptypes"
+	"github.com/moby/buildkit/session"
+	"github.com/moby/buildkit/session/auth/authprovider"
+	"github.com/moby/buildkit/session/secrets/secretsprovider"
+	"github.com/moby/buildkit/session/sshforward/sshprovider"
+	"github.com/moby/buildkit/util/progress/progressui"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/go-sigma/sigma/pkg/builder"
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/signing"
+	cosignsign "github.com/go-sigma/sigma/pkg/signing/cosign/sign"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/compress"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/utils/referrer"
+)
+
+const (
+	defaultDockerfile = "Dockerfile"
+)
+
+// build solve the dockerfile on the remote buildkitd, push the image to sigma and sign it
+func (i *instance) build(ctx context.Context, writer io.Writer, builderConfig builder.BuilderConfig) error {
+	authorization, err := builder.InternalToken(ctx)
+	if err != nil {
+		return fmt.Errorf("Get internal token failed: %v", err)
+	}
+
+	workspace, err := os.MkdirTemp("", builder.GenContainerID(builderConfig.BuilderID, builderConfig.RunnerID)+"-")
+	if err != nil {
+		return fmt.Errorf("Create workspace failed: %v", err)
+	}
+	defer func() {
+		_ = os.RemoveAll(workspace) // nolint: errcheck
+	}()
+
+	solveOpt := client.SolveOpt{
+		Frontend:      "dockerfile.v0",
+		Ref:           builder.GenContainerID(builderConfig.BuilderID, builderConfig.RunnerID), // other processes read the progress from buildkitd with it
+		FrontendAttrs: frontendAttrs(builderConfig),
+		Session: []session.Attachable{
+			authprovider.NewDockerAuthProvider(dockerConfig(i.config.HTTP.InternalEndpoint, authorization, builderConfig), nil),
+		},
+	}
+
+	var tagOption types.BuildTagOption
+	if builderConfig.Source == enums.BuilderSourceDockerfile {
+		err = writeDockerfile(workspace, ptr.To(builderConfig.Dockerfile))
+		if err != nil {
+			return err
+		}
+		solveOpt.FrontendAttrs["filename"] = defaultDockerfile
+		solveOpt.LocalDirs = map[string]string{ // nolint: staticcheck
+			"context":    workspace,
+			"dockerfile": workspace,
+		}
+	} else {
+		repository := ptr.To(builderConfig.ScmRepository)
+		if repository == "" {
+			return fmt.Errorf("Scm repository is not set")
+		}
+		auth, attachables, err := scmAuth(workspace, i.config.Daemon.Builder.Buildkit.KnownHosts, builderConfig)
+		if err != nil {
+			return err
+		}
+		solveOpt.Session = append(solveOpt.Session, attachables...)
+		tagOption, err = resolveRef(ctx, repository, ptr.To(builderConfig.ScmBranch), auth)
+		if err != nil {
+			return err
+		}
+		solveOpt.FrontendAttrs["context"] = gitContext(repository, tagOption.ScmRef, builderConfig.BuildkitContext)
+	}
+
+	imageName, err := genImageName(i.config.HTTP.InternalEndpoint, builderConfig, tagOption)
+	if err != nil {
+		return err
+	}
+	solveOpt.Exports = []client.ExportEntry{{
+		Type:  client.ExporterImage,
+		Attrs: exportAttrs(imageName, strings.HasPrefix(i.config.HTTP.InternalEndpoint, "http://"), builderConfig),
+	}}
+
+	_, _ = fmt.Fprintf(writer, "#0 building %s on buildkitd %s\n", imageName, i.config.Daemon.Builder.Buildkit.Address) // nolint: errcheck
+
+	display, err := progressui.NewDisplay(writer, progressui.PlainMode)
+	if err != nil {
+		return fmt.Errorf("Create progress display failed: %v", err)
+	}
+	ch := make(chan *client.SolveStatus)
+	displayErr := make(chan error, 1)
+	go func() {
+		_, err := display.UpdateFrom(context.Background(), ch)
+		displayErr <- err
+	}()
+	resp, err := i.client.Solve(ctx, nil, solveOpt, ch) // the status channel is closed by solve
+	if err != nil {
+		<-displayErr
+		return fmt.Errorf("Build image failed: %v", err)
+	}
+	err = <-displayErr
+	if err != nil {
+		return fmt.Errorf("Display build progress failed: %v", err)
+	}
+
+	return i.sign(ctx, writer, authorization, imageName, resp.ExporterResponse, builderConfig)
+}
+
+// sign the pushed image, the cosign signature is created in process and pushed as the referrer of the image,
+// the notation signature is created with the notation binary, the build is failed if the image cannot be signed
+func (i *instance) sign(ctx context.Context, writer io.Writer, authorization, imageName string, exporterResponse map[string]string, builderConfig builder.BuilderConfig) error {
+	privateKey, err := builder.SigningPrivateKey(ctx, builderConfig)
+	if err != nil {
+		return fmt.Errorf("Get signing private key failed: %v", err)
+	}
+	if builderConfig.SigningType != enums.SigningTypeNotation {
+		return i.cosignSign(ctx, writer, authorization, privateKey, exporterResponse, builderConfig)
+	}
+	_, err = exec.LookPath(builderConfig.SigningType.String())
+	if err != nil {
+		return fmt.Errorf("Sign image failed, %s is not installed: %v", builderConfig.SigningType.String(), err)
+	}
+	certificateObj, err := dao.NewSettingServiceFactory().New().Get(ctx, consts.SettingNotationCertificate)
+	if err != nil {
+		return fmt.Errorf("Get notation certificate failed: %v", err)
+	}
+	s := signing.NewSigning(signing.Options{
+		Type:        builderConfig.SigningType,
+		Http:        strings.HasPrefix(i.config.HTTP.InternalEndpoint, "http://"),
+		MultiArch:   len(builderConfig.BuildkitPlatforms) > 1,
+		Certificate: string(certificateObj.Val),
+	})
+	err = s.Sign(ctx, authorization, privateKey, imageName)
+	if err != nil {
+		return fmt.Errorf("Sign image failed: %v", err)
+	}
+	return nil
+}
+
+// cosignSign signs the image pushed by the image exporter, the signature is pushed as the cosign referrer of the image
+func (i *instance) cosignSign(ctx context.Context, writer io.Writer, authorization, privateKey string, exporterResponse map[string]string, builderConfig builder.BuilderConfig) error {
+	descriptorBytes, err := base64.StdEncoding.DecodeString(exporterResponse[exptypes.ExporterImageDescriptorKey])
+	if err != nil {
+		return fmt.Errorf("Decode pushed image descriptor failed: %v", err)
+	}
+	var descriptor imgspecv1.Descriptor
+	err = json.Unmarshal(descriptorBytes, &descriptor)
+	if err != nil {
+		return fmt.Errorf("Unmarshal pushed image descriptor failed: %v", err)
+	}
+	repositoryBytes, err := base64.StdEncoding.DecodeString(builderConfig.Repository)
+	if err != nil {
+		return fmt.Errorf("Decode repository failed: %v", err)
+	}
+	repository := string(repositoryBytes)
+
+	payload, err := cosignsign.Payload(fmt.Sprintf("%s/%s", utils.TrimHTTP(i.config.HTTP.Endpoint), repository), descriptor.Digest.String())
+	if err != nil {
+		return fmt.Errorf("Create signature payload failed: %v", err)
+	}
+	signature, err := cosignsign.SignPayload([]byte(privateKey), payload)
+	if err != nil {
+		return fmt.Errorf("Sign image failed: %v", err)
+	}
+	manifest := cosignsign.Manifest(imgspecv1.Descriptor{MediaType: descriptor.MediaType, Digest: descriptor.Digest, Size: descriptor.Size}, payload, signature)
+	signatureDigest, err := referrer.Push(ctx, i.config.HTTP.InternalEndpoint, authorization, repository, manifest, imgspecv1.DescriptorEmptyJSON.Data, payload)
+	if err != nil {
+		return fmt.Errorf("Push signature failed: %v", err)
+	}
+	_, _ = fmt.Fprintf(writer, "#0 signed %s@%s with signature %s\n", repository, descriptor.Digest, signatureDigest) // nolint: errcheck
+	return nil
+}
+
+// frontendAttrs returns the options of the dockerfile frontend
+func frontendAttrs(builderConfig builder.BuilderConfig) map[string]string {
+	attrs := map[string]string{
+		"filename": defaultDockerfile,
+	}
+	if builderConfig.BuildkitDockerfile != "" {
+		attrs["filename"] = builderConfig.BuildkitDockerfile
+	}
+	if len(builderConfig.BuildkitPlatforms) > 0 {
+		var platforms = make([]string, 0, len(builderConfig.BuildkitPlatforms))
+		for _, platform := range builderConfig.BuildkitPlatforms {
+			platforms = append(platforms, platform.String())
+		}
+		attrs["platform"] = strings.Join(platforms, ",")
+	}
+	for _, arg := range builderConfig.BuildkitBuildArgs {
+		kv := strings.SplitN(arg, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		attrs["build-arg:"+kv[0]] = kv[1]
+	}
+	return attrs
+}
+
+// exportAttrs returns the options of the image exporter which pushes the image to sigma
+func exportAttrs(imageName string, insecure bool, builderConfig builder.BuilderConfig) map[string]string {
+	annotation := "annotation"
+	if len(builderConfig.BuildkitPlatforms) > 1 {
+		annotation = "annotation-index"
+	}
+	attrs := map[string]string{
+		"name":           imageName,
+		"push":           "true",
+		"oci-mediatypes": "true",
+		annotation + ".org.opencontainers.sigma.builder_id": strconv.FormatInt(builderConfig.BuilderID, 10),
+		annotation + ".org.opencontainers.sigma.runner_id":  strconv.FormatInt(builderConfig.RunnerID, 10),
+	}
+	if insecure {
+		attrs["registry.insecure"] = "true"
+	}
+	return attrs
+}
+
+// dockerConfig returns the registry credentials used by buildkitd to push the image and pull the base images
+func dockerConfig(endpoint, authorization string, builderConfig builder.BuilderConfig) *configfile.ConfigFile {
+	cf := &configfile.ConfigFile{AuthConfigs: make(map[string]dockertypes.AuthConfig)}
+	for index, domain := range builderConfig.OciRegistryDomain {
+		if index >= len(builderConfig.OciRegistryUsername) || index >= len(builderConfig.OciRegistryPassword) {
+			break
+		}
+		cf.AuthConfigs[domain] = dockertypes.AuthConfig{
+			Username: builderConfig.OciRegistryUsername[index],
+			Password: builderConfig.OciRegistryPassword[index],
+		}
+	}
+	cf.AuthConfigs[utils.TrimHTTP(endpoint)] = dockertypes.AuthConfig{
+		RegistryToken: authorization,
+	}
+	return cf
+}
+
+// writeDockerfile write the compressed dockerfile into the dir
+func writeDockerfile(dir, dockerfile string) error {
+	base64Bytes, err := base64.StdEncoding.DecodeString(dockerfile)
+	if err != nil {
+		return fmt.Errorf("Decode dockerfile failed: %v", err)
+	}
+	dockerfileStr, err := compress.Decompress(base64Bytes)
+	if err != nil {
+		return fmt.Errorf("Decompress dockerfile failed: %v", err)
+	}
+	err = os.WriteFile(path.Join(dir, defaultDockerfile), []byte(dockerfileStr), 0644)
+	if err != nil {
+		return fmt.Errorf("Write dockerfile failed: %v", err)
+	}
+	return nil
+}
+
+// scmAuth returns the credential to list the references of the repository and the session attachables
+// which provide the same credential to buildkitd, the credential never appears in the build definition.
+// The host key of the ssh repository is verified with the known hosts, the default known_hosts files are used if it's empty.
+func scmAuth(workspace, knownHosts string, builderConfig builder.BuilderConfig) (transport.AuthMethod, []session.Attachable, error) {
+	switch ptr.To(builderConfig.ScmCredentialType) {
+	case enums.ScmCredentialTypeSsh:
+		keyPath := path.Join(workspace, "id_rsa")
+		err := os.WriteFile(keyPath, []byte(ptr.To(builderConfig.ScmSshKey)), 0600)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Write ssh private key failed: %v", err)
+		}
+		sshProvider, err := sshprovider.NewSSHAgentProvider([]sshprovider.AgentConfig{{ID: "default", Paths: []string{keyPath}}})
+		if err != nil {
+			return nil, nil, fmt.Errorf("Create ssh agent provider failed: %v", err)
+		}
+		publicKeys, err := gitssh.NewPublicKeysFromFile("git", keyPath, "")
+		if err != nil {
+			return nil, nil, fmt.Errorf("Parse ssh private key failed: %v", err)
+		}
+		var knownHostsFiles []string
+		if knownHosts != "" {
+			knownHostsFiles = append(knownHostsFiles, knownHosts)
+		}
+		publicKeys.HostKeyCallback, err = gitssh.NewKnownHostsCallback(knownHostsFiles...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Load ssh known hosts failed: %v", err)
+		}
+		return publicKeys, []session.Attachable{sshProvider}, nil
+	case enums.ScmCredentialTypeToken:
+		return &githttp.BasicAuth{Username: "x-access-token", Password: ptr.To(builderConfig.ScmToken)},
+			[]session.Attachable{secretsprovider.FromMap(map[string][]byte{llb.GitAuthTokenKey: []byte(ptr.To(builderConfig.ScmToken))})}, nil
+	case enums.ScmCredentialTypeUsername:
+		header := "basic " + base64.StdEncoding.EncodeToString([]byte(ptr.To(builderConfig.ScmUsername)+":"+ptr.To(builderConfig.ScmPassword)))
+		return &githttp.BasicAuth{Username: ptr.To(builderConfig.ScmUsername), Password: ptr.To(builderConfig.ScmPassword)},
+			[]session.Attachable{secretsprovider.FromMap(map[string][]byte{llb.GitAuthHeaderKey: []byte(header)})}, nil
+	default:
+		return nil, nil, nil
+	}
+}
+
+// resolveRef resolve the commit of the branch and the tag on it from the remote repository, buildkitd
+// builds the resolved commit so the image is exactly the one described by the rendered tag
+func resolveRef(ctx context.Context, repository, branch string, auth transport.AuthMethod) (types.BuildTagOption, error) {
+	remote := git.NewRemote(memory.NewStorage(), &gitconfig.RemoteConfig{
+		Name: git.DefaultRemoteName,
+		URLs: []string{repository},
+	})
+	refs, err := remote.ListContext(ctx, &git.ListOptions{Auth: auth, PeelingOption: git.AppendPeeled})
+	if err != nil {
+		return types.BuildTagOption{}, fmt.Errorf("List remote references failed: %v", err)
+	}
+	option := types.BuildTagOption{ScmBranch: branch}
+	for _, ref := range refs {
+		if ref.Name() == plumbing.NewBranchReferenceName(branch) {
+			option.ScmRef = ref.Hash().String()
+		}
+	}
+	if option.ScmRef == "" {
+		return types.BuildTagOption{}, fmt.Errorf("Branch(%s) not found in the repository", branch)
+	}
+	for _, ref := range refs {
+		if ref.Name().IsTag() && ref.Hash().String() == option.ScmRef {
+			option.ScmTag = strings.TrimSuffix(ref.Name().Short(), "^{}")
+		}
+	}
+	return option, nil
+}
+
+// gitContext returns the git context of the dockerfile frontend, e.g. https://github.com/go-sigma/sigma.git#ref:dir
+func gitContext(repository, ref, dir string) string {
+	dir = strings.Trim(path.Clean("/"+dir), "/")
+	if dir == "" {
+		return fmt.Sprintf("%s#%s", repository, ref)
+	}
+	return fmt.Sprintf("%s#%s:%s", repository, ref, dir)
+}
+
+// genImageName render the tag template and returns the full image name
+func genImageName(endpoint string, builderConfig builder.BuilderConfig, tagOption types.BuildTagOption) (string, error) {
+	tagBytes, err := base64.StdEncoding.DecodeString(builderConfig.Tag)
+	if err != nil {
+		return "", fmt.Errorf("Decode tag failed: %v", err)
+	}
+	t, err := template.New("tag").Funcs(sprig.FuncMap()).Parse(string(tagBytes))
+	if err != nil {
+		return "", fmt.Errorf("Parse tag template failed: %v", err)
+	}
+	var buffer bytes.Buffer
+	err = t.Execute(&buffer, tagOption)
+	if err != nil {
+		return "", fmt.Errorf("Execute tag template failed: %v", err)
+	}
+	repositoryBytes, err := base64.StdEncoding.DecodeString(builderConfig.Repository)
+	if err != nil {
+		return "", fmt.Errorf("Decode repository failed: %v", err)
+	}
+	return fmt.Sprintf("%s/%s:%s", utils.TrimHTTP(endpoint), string(repositoryBytes), buffer.String()), nil
+}
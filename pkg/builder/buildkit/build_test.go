@@ -0,0 +1,123 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buildkit
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/pem"
+	"net"
+	"os"
+	"path"
+	"testing"
+
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/go-sigma/sigma/pkg/builder"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+func TestFrontendAttrs(t *testing.T) {
+	attrs := frontendAttrs(builder.BuilderConfig{})
+	assert.Equal(t, map[string]string{"filename": "Dockerfile"}, attrs)
+
+	attrs = frontendAttrs(builder.BuilderConfig{Builder: types.Builder{
+		BuildkitDockerfile: "build/Dockerfile",
+		BuildkitPlatforms:  []enums.OciPlatform{enums.OciPlatformLinuxAmd64, enums.OciPlatformLinuxArm64},
+		BuildkitBuildArgs:  []string{"VERSION=v1.0.0", "invalid"},
+	}})
+	assert.Equal(t, map[string]string{
+		"filename":          "build/Dockerfile",
+		"platform":          "linux/amd64,linux/arm64",
+		"build-arg:VERSION": "v1.0.0",
+	}, attrs)
+}
+
+func TestExportAttrs(t *testing.T) {
+	attrs := exportAttrs("127.0.0.1:3000/library/busybox:latest", true, builder.BuilderConfig{Builder: types.Builder{BuilderID: 1, RunnerID: 2}})
+	assert.Equal(t, map[string]string{
+		"name":              "127.0.0.1:3000/library/busybox:latest",
+		"push":              "true",
+		"oci-mediatypes":    "true",
+		"registry.insecure": "true",
+		"annotation.org.opencontainers.sigma.builder_id": "1",
+		"annotation.org.opencontainers.sigma.runner_id":  "2",
+	}, attrs)
+
+	attrs = exportAttrs("sigma.test.io/library/busybox:latest", false, builder.BuilderConfig{Builder: types.Builder{
+		BuilderID:         1,
+		RunnerID:          2,
+		BuildkitPlatforms: []enums.OciPlatform{enums.OciPlatformLinuxAmd64, enums.OciPlatformLinuxArm64},
+	}})
+	assert.Equal(t, "1", attrs["annotation-index.org.opencontainers.sigma.builder_id"])
+	assert.NotContains(t, attrs, "registry.insecure")
+}
+
+func TestGitContext(t *testing.T) {
+	const repository = "https://github.com/go-sigma/sigma.git"
+	assert.Equal(t, repository+"#abc", gitContext(repository, "abc", ""))
+	assert.Equal(t, repository+"#abc", gitContext(repository, "abc", "."))
+	assert.Equal(t, repository+"#abc:build/web", gitContext(repository, "abc", "./build/web/"))
+}
+
+func TestGenImageName(t *testing.T) {
+	builderConfig := builder.BuilderConfig{Builder: types.Builder{
+		Repository: base64.StdEncoding.EncodeToString([]byte("library/sigma")),
+		Tag:        base64.StdEncoding.EncodeToString([]byte("{{ .ScmBranch }}-{{ .ScmRef | trunc 7 }}")),
+	}}
+	imageName, err := genImageName("http://127.0.0.1:3000", builderConfig, types.BuildTagOption{ScmBranch: "main", ScmRef: "0123456789abcdef"})
+	assert.NoError(t, err)
+	assert.Equal(t, "127.0.0.1:3000/library/sigma:main-0123456", imageName)
+
+	builderConfig.Tag = base64.StdEncoding.EncodeToString([]byte("{{ .ScmBranch "))
+	_, err = genImageName("http://127.0.0.1:3000", builderConfig, types.BuildTagOption{})
+	assert.Error(t, err)
+}
+
+func TestScmAuthSsh(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	block, err := ssh.MarshalPrivateKey(privateKey, "")
+	assert.NoError(t, err)
+	builderConfig := builder.BuilderConfig{Builder: types.Builder{
+		ScmCredentialType: ptr.Of(enums.ScmCredentialTypeSsh),
+		ScmSshKey:         ptr.Of(string(pem.EncodeToMemory(block))),
+	}}
+
+	workspace := t.TempDir()
+	_, _, err = scmAuth(workspace, path.Join(workspace, "known_hosts"), builderConfig)
+	assert.Error(t, err)
+
+	hostKey, err := ssh.NewPublicKey(privateKey.Public())
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(path.Join(workspace, "known_hosts"), []byte("github.com "+string(ssh.MarshalAuthorizedKey(hostKey))), 0600))
+	auth, attachables, err := scmAuth(workspace, path.Join(workspace, "known_hosts"), builderConfig)
+	assert.NoError(t, err)
+	assert.Len(t, attachables, 1)
+	publicKeys, ok := auth.(*gitssh.PublicKeys)
+	assert.True(t, ok)
+	remote := &net.TCPAddr{IP: net.ParseIP("140.82.112.3"), Port: 22}
+	assert.NoError(t, publicKeys.HostKeyCallback("github.com:22", remote, hostKey))
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	otherHostKey, err := ssh.NewPublicKey(otherKey.Public())
+	assert.NoError(t, err)
+	assert.Error(t, publicKeys.HostKeyCallback("github.com:22", remote, otherHostKey))
+}
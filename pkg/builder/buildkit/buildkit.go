@@ -0,0 +1,335 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buildkit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"sync"
+	"time"
+
+	controlapi "github.com/moby/buildkit/api/services/control"
+	"github.com/moby/buildkit/client"
+	"github.com/moby/buildkit/client/llb"
+	"github.com/moby/buildkit/util/progress/progressui"
+	"github.com/rs/zerolog/log"
+
+	"github.com/go-sigma/sigma/pkg/builder"
+	"github.com/go-sigma/sigma/pkg/builder/logger"
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+)
+
+func init() {
+	builder.DriverFactories[path.Base(reflect.TypeOf(factory{}).PkgPath())] = &factory{}
+}
+
+type factory struct{}
+
+var _ builder.Factory = factory{}
+
+// New returns a new builder driver that solves the build on a remote buildkitd
+func (f factory) New(config configs.Configuration) (builder.Builder, error) {
+	buildkitConfig := config.Daemon.Builder.Buildkit
+	if buildkitConfig.Address == "" {
+		return nil, fmt.Errorf("Buildkit address is not set, check the config daemon.builder.buildkit.address")
+	}
+	var opts []client.ClientOpt
+	if buildkitConfig.CA != "" {
+		opts = append(opts, client.WithServerConfig(buildkitConfig.ServerName, buildkitConfig.CA))
+	} else if buildkitConfig.Cert != "" || buildkitConfig.Key != "" {
+		opts = append(opts, client.WithServerConfigSystem(buildkitConfig.ServerName))
+	}
+	if buildkitConfig.Cert != "" || buildkitConfig.Key != "" {
+		opts = append(opts, client.WithCredentials(buildkitConfig.Cert, buildkitConfig.Key))
+	}
+	cli, err := client.New(context.Background(), buildkitConfig.Address, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("Create buildkit client failed: %v", err)
+	}
+	return &instance{
+		config:                config,
+		client:                cli,
+		runners:               make(map[string]*runner),
+		builderServiceFactory: dao.NewBuilderServiceFactory(),
+	}, nil
+}
+
+// buildkitClient is the part of the buildkit client used by the driver
+type buildkitClient interface {
+	Solve(ctx context.Context, def *llb.Definition, opt client.SolveOpt, statusChan chan *client.SolveStatus) (*client.SolveResponse, error)
+	ControlClient() controlapi.ControlClient
+}
+
+type instance struct {
+	config                configs.Configuration
+	client                buildkitClient
+	builderServiceFactory dao.BuilderServiceFactory
+
+	mutex   sync.Mutex
+	runners map[string]*runner // the builds running in this process, the key is generated by builder.GenContainerID
+}
+
+// runner is a build running on the remote buildkitd
+type runner struct {
+	cancel context.CancelFunc
+	logs   *logBuffer
+	done   chan struct{}
+}
+
+var _ builder.Builder = &instance{}
+
+const (
+	// watchInterval is the interval to check the status of the runner, the stop request may be received by another process
+	watchInterval = time.Second
+	// stopTimeout is the time to wait for the process running the build to stop it
+	stopTimeout = time.Minute
+)
+
+// Start start to solve the build on the remote buildkitd, the build keeps running in background
+func (i *instance) Start(ctx context.Context, builderConfig builder.BuilderConfig) error {
+	id := builder.GenContainerID(builderConfig.BuilderID, builderConfig.RunnerID)
+
+	i.mutex.Lock()
+	if _, ok := i.runners[id]; ok {
+		i.mutex.Unlock()
+		return fmt.Errorf("Builder runner(%s) is already running", id)
+	}
+	buildCtx, cancel := context.WithCancel(log.Logger.WithContext(context.Background()))
+	r := &runner{cancel: cancel, logs: newLogBuffer(), done: make(chan struct{})}
+	i.runners[id] = r
+	i.mutex.Unlock()
+
+	builderService := i.builderServiceFactory.New()
+	err := builderService.UpdateRunner(ctx, builderConfig.BuilderID, builderConfig.RunnerID, map[string]any{
+		query.BuilderRunner.Status.ColumnName().String():    enums.BuildStatusBuilding,
+		query.BuilderRunner.StartedAt.ColumnName().String(): time.Now().UnixMilli(),
+	})
+	if err != nil {
+		cancel()
+		i.remove(id)
+		return fmt.Errorf("Update runner status failed: %v", err)
+	}
+
+	go i.run(buildCtx, id, r, builderConfig)
+
+	return nil
+}
+
+// run solves the build, then stores the logs and updates the runner status
+func (i *instance) run(ctx context.Context, id string, r *runner, builderConfig builder.BuilderConfig) {
+	defer close(r.done)
+	defer i.remove(id)
+
+	go i.watch(r, builderConfig.RunnerID)
+
+	updates := map[string]any{
+		query.BuilderRunner.Status.ColumnName().String(): enums.BuildStatusSuccess,
+	}
+	err := i.build(ctx, r.logs, builderConfig)
+	if err != nil {
+		if errors.Is(ctx.Err(), context.Canceled) {
+			updates[query.BuilderRunner.Status.ColumnName().String()] = enums.BuildStatusStopped
+		} else {
+			log.Error().Err(err).Str("id", id).Msg("Build image with buildkit failed")
+			updates[query.BuilderRunner.Status.ColumnName().String()] = enums.BuildStatusFailed
+			updates[query.BuilderRunner.StatusMessage.ColumnName().String()] = err.Error()
+		}
+		_, _ = fmt.Fprintf(r.logs, "%v\n", err) // nolint: errcheck
+	}
+	updates[query.BuilderRunner.EndedAt.ColumnName().String()] = time.Now().UnixMilli()
+	r.logs.Close()
+
+	err = i.logStore(builderConfig.BuilderID, builderConfig.RunnerID, r.logs.Bytes())
+	if err != nil {
+		log.Error().Err(err).Str("id", id).Msg("Store builder log failed")
+	}
+
+	builderService := i.builderServiceFactory.New()
+	err = builderService.UpdateRunner(log.Logger.WithContext(context.Background()), builderConfig.BuilderID, builderConfig.RunnerID, updates)
+	if err != nil {
+		log.Error().Err(err).Str("id", id).Msg("Update runner status failed")
+	}
+}
+
+// logStore save the whole log of the build with the builder logger
+func (i *instance) logStore(builderID, runnerID int64, data []byte) error {
+	if logger.Driver == nil {
+		return nil
+	}
+	writer := logger.Driver.Write(builderID, runnerID)
+	_, err := writer.Write(data)
+	if err != nil {
+		return fmt.Errorf("Write builder log failed: %v", err)
+	}
+	err = writer.Close()
+	if err != nil {
+		return fmt.Errorf("Close builder log failed: %v", err)
+	}
+	return nil
+}
+
+// watch cancel the build when the runner is marked as stopping, the runner status is the only state shared by the processes
+func (i *instance) watch(r *runner, runnerID int64) {
+	ctx := log.Logger.WithContext(context.Background())
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+	builderService := i.builderServiceFactory.New()
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+		}
+		runnerObj, err := builderService.GetRunner(ctx, runnerID)
+		if err != nil {
+			log.Error().Err(err).Int64("runnerID", runnerID).Msg("Get runner failed")
+			continue
+		}
+		if runnerObj.Status == enums.BuildStatusStopping {
+			r.cancel()
+			return
+		}
+	}
+}
+
+func (i *instance) get(id string) (*runner, bool) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	r, ok := i.runners[id]
+	return r, ok
+}
+
+func (i *instance) remove(id string) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	delete(i.runners, id)
+}
+
+// Stop cancel the build, the status of the runner is updated when the build exited.
+// The build running in another process is stopped by the process itself after the runner is marked as stopping.
+func (i *instance) Stop(ctx context.Context, builderID, runnerID int64) error {
+	r, ok := i.get(builder.GenContainerID(builderID, runnerID))
+	if !ok {
+		return i.stopRemote(ctx, builderID, runnerID)
+	}
+	r.cancel()
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// stopRemote mark the runner as stopping and wait for the process running the build to stop it,
+// the runner is marked as stopped directly if it's not building or the process exited without stopping it
+func (i *instance) stopRemote(ctx context.Context, builderID, runnerID int64) error {
+	builderService := i.builderServiceFactory.New()
+	runnerObj, err := builderService.GetRunner(ctx, runnerID)
+	if err != nil {
+		return fmt.Errorf("Get runner failed: %v", err)
+	}
+	if runnerObj.Status == enums.BuildStatusBuilding {
+		err = builderService.UpdateRunner(ctx, builderID, runnerID, map[string]any{
+			query.BuilderRunner.Status.ColumnName().String(): enums.BuildStatusStopping,
+		})
+		if err != nil {
+			return fmt.Errorf("Update runner status failed: %v", err)
+		}
+		runnerObj.Status = enums.BuildStatusStopping
+	}
+	if runnerObj.Status == enums.BuildStatusStopping {
+		ticker := time.NewTicker(watchInterval)
+		defer ticker.Stop()
+		timeout := time.After(stopTimeout)
+	wait:
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-timeout:
+				log.Info().Str("id", builder.GenContainerID(builderID, runnerID)).Msg("Builder runner is not stopped in time")
+				break wait
+			case <-ticker.C:
+			}
+			runnerObj, err = builderService.GetRunner(ctx, runnerID)
+			if err != nil {
+				return fmt.Errorf("Get runner failed: %v", err)
+			}
+			if runnerObj.Status != enums.BuildStatusStopping {
+				return nil
+			}
+		}
+	}
+	log.Info().Str("id", builder.GenContainerID(builderID, runnerID)).Msg("Builder runner is not running")
+	err = builderService.UpdateRunner(ctx, builderID, runnerID, map[string]any{
+		query.BuilderRunner.Status.ColumnName().String():  enums.BuildStatusStopped,
+		query.BuilderRunner.EndedAt.ColumnName().String(): time.Now().UnixMilli(),
+	})
+	if err != nil {
+		return fmt.Errorf("Update runner status failed: %v", err)
+	}
+	return nil
+}
+
+// Restart wrap stop and start
+func (i *instance) Restart(ctx context.Context, builderConfig builder.BuilderConfig) error {
+	err := i.Stop(ctx, builderConfig.BuilderID, builderConfig.RunnerID)
+	if err != nil {
+		return err
+	}
+	return i.Start(ctx, builderConfig)
+}
+
+// LogStream get the real time log stream, the progress of the build running in another process is read from buildkitd
+func (i *instance) LogStream(ctx context.Context, builderID, runnerID int64, writer io.Writer) error {
+	r, ok := i.get(builder.GenContainerID(builderID, runnerID))
+	if ok {
+		return r.logs.Stream(ctx, writer)
+	}
+	stream, err := i.client.ControlClient().Status(ctx, &controlapi.StatusRequest{Ref: builder.GenContainerID(builderID, runnerID)})
+	if err != nil {
+		return fmt.Errorf("Get build status failed: %v", err)
+	}
+	display, err := progressui.NewDisplay(writer, progressui.PlainMode)
+	if err != nil {
+		return fmt.Errorf("Create progress display failed: %v", err)
+	}
+	ch := make(chan *client.SolveStatus)
+	displayErr := make(chan error, 1)
+	go func() {
+		_, err := display.UpdateFrom(context.Background(), ch)
+		displayErr <- err
+	}()
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			close(ch)
+			<-displayErr
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("Receive build status failed: %v", err)
+		}
+		ch <- client.NewSolveStatus(resp)
+	}
+}
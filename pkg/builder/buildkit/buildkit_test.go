@@ -0,0 +1,312 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buildkit
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	controlapi "github.com/moby/buildkit/api/services/control"
+	"github.com/moby/buildkit/client"
+	"github.com/moby/buildkit/client/llb"
+	"github.com/moby/buildkit/exporter/containerimage/exptypes"
+	"github.com/opencontainers/go-digest"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/go-sigma/sigma/pkg/builder"
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/logger"
+	"github.com/go-sigma/sigma/pkg/signing"
+	"github.com/go-sigma/sigma/pkg/tests"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/compress"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+type fakeClient struct {
+	solve            func(ctx context.Context, opt client.SolveOpt, statusChan chan *client.SolveStatus) error
+	statuses         []*controlapi.StatusResponse
+	exporterResponse map[string]string
+}
+
+func (c *fakeClient) Solve(ctx context.Context, _ *llb.Definition, opt client.SolveOpt, statusChan chan *client.SolveStatus) (*client.SolveResponse, error) {
+	err := c.solve(ctx, opt, statusChan)
+	if err != nil {
+		return nil, err
+	}
+	return &client.SolveResponse{ExporterResponse: c.exporterResponse}, nil
+}
+
+func (c *fakeClient) ControlClient() controlapi.ControlClient {
+	return &fakeControlClient{statuses: c.statuses}
+}
+
+type fakeControlClient struct {
+	controlapi.ControlClient
+	statuses []*controlapi.StatusResponse
+}
+
+func (c *fakeControlClient) Status(_ context.Context, _ *controlapi.StatusRequest, _ ...grpc.CallOption) (controlapi.Control_StatusClient, error) {
+	return &fakeStatusClient{statuses: c.statuses}, nil
+}
+
+type fakeStatusClient struct {
+	controlapi.Control_StatusClient
+	statuses []*controlapi.StatusResponse
+}
+
+func (c *fakeStatusClient) Recv() (*controlapi.StatusResponse, error) {
+	if len(c.statuses) == 0 {
+		return nil, io.EOF
+	}
+	status := c.statuses[0]
+	c.statuses = c.statuses[1:]
+	return status, nil
+}
+
+func TestInstance(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	privateKeyPem := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+	configs.SetConfiguration(&configs.Configuration{Auth: configs.ConfigurationAuth{Jwt: configs.ConfigurationAuthJwt{
+		PrivateKey: base64.StdEncoding.EncodeToString(privateKeyPem),
+		Ttl:        time.Hour,
+	}}})
+
+	ctx := context.Background()
+
+	keyPair, err := signing.GenerateKeyPair()
+	assert.NoError(t, err)
+	assert.NoError(t, dao.NewSettingServiceFactory().New().Create(ctx, consts.SettingSignPrivateKey, keyPair.PrivateKey))
+
+	// the registry receives the signature of the built image
+	var signaturePath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodPut:
+			signaturePath = r.URL.Path
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer server.Close()
+	imageDescriptor, err := json.Marshal(imgspecv1.Descriptor{MediaType: imgspecv1.MediaTypeImageManifest, Digest: digest.FromString("image"), Size: 123})
+	assert.NoError(t, err)
+	exporterResponse := map[string]string{exptypes.ExporterImageDescriptorKey: base64.StdEncoding.EncodeToString(imageDescriptor)}
+
+	userService := dao.NewUserServiceFactory().New()
+	userObj := &models.User{Username: consts.UserInternal, Password: ptr.Of("internal"), Email: ptr.Of("internal@gmail.com")}
+	assert.NoError(t, userService.Create(ctx, userObj))
+	repositoryService := dao.NewRepositoryServiceFactory().New()
+	repositoryObj := &models.Repository{Name: "library/busybox"}
+	assert.NoError(t, repositoryService.Create(ctx, repositoryObj, dao.AutoCreateNamespace{AutoCreate: true, UserID: userObj.ID}))
+	builderService := dao.NewBuilderServiceFactory().New()
+	builderObj := &models.Builder{RepositoryID: repositoryObj.ID, Source: enums.BuilderSourceDockerfile}
+	assert.NoError(t, builderService.Create(ctx, builderObj))
+
+	dockerfile, err := compress.CompressBytes([]byte("FROM busybox"))
+	assert.NoError(t, err)
+	newBuilderConfig := func() builder.BuilderConfig {
+		runnerObj := &models.BuilderRunner{BuilderID: builderObj.ID, RawTag: "latest"}
+		assert.NoError(t, builderService.CreateRunner(ctx, runnerObj))
+		return builder.BuilderConfig{Builder: types.Builder{
+			BuilderID:   builderObj.ID,
+			RunnerID:    runnerObj.ID,
+			Source:      enums.BuilderSourceDockerfile,
+			Dockerfile:  ptr.Of(base64.StdEncoding.EncodeToString(dockerfile)),
+			Repository:  base64.StdEncoding.EncodeToString([]byte("library/busybox")),
+			Tag:         base64.StdEncoding.EncodeToString([]byte("latest")),
+			SigningType: enums.SigningTypeCosign,
+		}}
+	}
+	runnerStatus := func(runnerID int64) enums.BuildStatus {
+		runnerObj, err := builderService.GetRunner(ctx, runnerID)
+		assert.NoError(t, err)
+		return runnerObj.Status
+	}
+
+	now := time.Now()
+	vertex := &client.Vertex{Digest: digest.FromString("busybox"), Name: "[1/1] FROM docker.io/library/busybox", Started: &now, Completed: &now}
+	newInstance := func(c *fakeClient) *instance {
+		return &instance{
+			config:                configs.Configuration{HTTP: configs.ConfigurationHTTP{Endpoint: "http://127.0.0.1:3000", InternalEndpoint: server.URL}},
+			client:                c,
+			runners:               make(map[string]*runner),
+			builderServiceFactory: dao.NewBuilderServiceFactory(),
+		}
+	}
+
+	// solve the dockerfile and push the image to the internal endpoint
+	builderConfig := newBuilderConfig()
+	started := make(chan struct{})
+	i := newInstance(&fakeClient{solve: func(ctx context.Context, opt client.SolveOpt, statusChan chan *client.SolveStatus) error {
+		defer close(statusChan)
+		<-started
+		assert.Equal(t, builder.GenContainerID(builderConfig.BuilderID, builderConfig.RunnerID), opt.Ref)
+		assert.Equal(t, "dockerfile.v0", opt.Frontend)
+		assert.Equal(t, "Dockerfile", opt.FrontendAttrs["filename"])
+		content, err := os.ReadFile(path.Join(opt.LocalDirs["context"], "Dockerfile")) // nolint: staticcheck
+		assert.NoError(t, err)
+		assert.Equal(t, "FROM busybox", string(content))
+		assert.Len(t, opt.Exports, 1)
+		assert.Equal(t, utils.TrimHTTP(server.URL)+"/library/busybox:latest", opt.Exports[0].Attrs["name"])
+		assert.Equal(t, "true", opt.Exports[0].Attrs["registry.insecure"])
+		statusChan <- &client.SolveStatus{Vertexes: []*client.Vertex{vertex}}
+		return nil
+	}, exporterResponse: exporterResponse})
+	assert.NoError(t, i.Start(ctx, builderConfig))
+	r, ok := i.get(builder.GenContainerID(builderConfig.BuilderID, builderConfig.RunnerID))
+	assert.True(t, ok)
+	close(started)
+	<-r.done
+	assert.Equal(t, enums.BuildStatusSuccess, runnerStatus(builderConfig.RunnerID))
+	assert.Contains(t, string(r.logs.Bytes()), "[1/1] FROM docker.io/library/busybox")
+	assert.True(t, strings.HasPrefix(signaturePath, "/v2/library/busybox/manifests/sha256:"))
+	_, ok = i.get(builder.GenContainerID(builderConfig.BuilderID, builderConfig.RunnerID))
+	assert.False(t, ok)
+
+	// the build is failed if the pushed image cannot be signed
+	builderConfig = newBuilderConfig()
+	i = newInstance(&fakeClient{solve: func(ctx context.Context, opt client.SolveOpt, statusChan chan *client.SolveStatus) error {
+		defer close(statusChan)
+		return nil
+	}})
+	assert.NoError(t, i.Start(ctx, builderConfig))
+	r, ok = i.get(builder.GenContainerID(builderConfig.BuilderID, builderConfig.RunnerID))
+	assert.True(t, ok)
+	<-r.done
+	assert.Equal(t, enums.BuildStatusFailed, runnerStatus(builderConfig.RunnerID))
+
+	// the build is stopped by the process running it when the stop request is received by another process
+	builderConfig = newBuilderConfig()
+	owner := newInstance(&fakeClient{solve: func(ctx context.Context, opt client.SolveOpt, statusChan chan *client.SolveStatus) error {
+		defer close(statusChan)
+		<-ctx.Done()
+		return ctx.Err()
+	}})
+	other := newInstance(&fakeClient{statuses: []*controlapi.StatusResponse{{Vertexes: []*controlapi.Vertex{{
+		Digest: vertex.Digest, Name: vertex.Name, Started: vertex.Started, Completed: vertex.Completed,
+	}}}}})
+	assert.NoError(t, owner.Start(ctx, builderConfig))
+	assert.Equal(t, enums.BuildStatusBuilding, runnerStatus(builderConfig.RunnerID))
+
+	var buffer bytes.Buffer
+	assert.NoError(t, other.LogStream(ctx, builderConfig.BuilderID, builderConfig.RunnerID, &buffer))
+	assert.Contains(t, buffer.String(), "[1/1] FROM docker.io/library/busybox")
+
+	stopCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	assert.NoError(t, other.Stop(stopCtx, builderConfig.BuilderID, builderConfig.RunnerID))
+	assert.Equal(t, enums.BuildStatusStopped, runnerStatus(builderConfig.RunnerID))
+	_, ok = owner.get(builder.GenContainerID(builderConfig.BuilderID, builderConfig.RunnerID))
+	assert.False(t, ok)
+
+	// the runner not running is stopped directly
+	builderConfig = newBuilderConfig()
+	assert.NoError(t, other.Stop(ctx, builderConfig.BuilderID, builderConfig.RunnerID))
+	assert.Equal(t, enums.BuildStatusStopped, runnerStatus(builderConfig.RunnerID))
+}
+
+func TestFactoryNew(t *testing.T) {
+	_, err := factory{}.New(configs.Configuration{})
+	assert.Error(t, err)
+
+	b, err := factory{}.New(configs.Configuration{Daemon: configs.ConfigurationDaemon{Builder: configs.ConfigurationDaemonBuilder{
+		Buildkit: configs.ConfigurationDaemonBuildkit{Address: "tcp://127.0.0.1:1234"},
+	}}})
+	assert.NoError(t, err)
+	assert.NotNil(t, b)
+
+	dir := t.TempDir()
+	caPath, certPath, keyPath := path.Join(dir, "ca.pem"), path.Join(dir, "cert.pem"), path.Join(dir, "key.pem")
+
+	newConfig := func(buildkitConfig configs.ConfigurationDaemonBuildkit) configs.Configuration {
+		buildkitConfig.Address = "tcp://127.0.0.1:1234"
+		buildkitConfig.ServerName = "buildkitd"
+		return configs.Configuration{Daemon: configs.ConfigurationDaemon{Builder: configs.ConfigurationDaemonBuilder{Buildkit: buildkitConfig}}}
+	}
+
+	// the certificates are loaded when the client is created
+	_, err = factory{}.New(newConfig(configs.ConfigurationDaemonBuildkit{CA: caPath}))
+	assert.ErrorContains(t, err, "ca certificate")
+	_, err = factory{}.New(newConfig(configs.ConfigurationDaemonBuildkit{CA: caPath, Cert: certPath, Key: keyPath}))
+	assert.Error(t, err)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "buildkitd"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes})
+	assert.NoError(t, os.WriteFile(caPath, certPem, 0600))
+
+	b, err = factory{}.New(newConfig(configs.ConfigurationDaemonBuildkit{CA: caPath}))
+	assert.NoError(t, err)
+	assert.NotNil(t, b)
+
+	_, err = factory{}.New(newConfig(configs.ConfigurationDaemonBuildkit{CA: caPath, Cert: certPath, Key: keyPath}))
+	assert.ErrorContains(t, err, "certificate/key")
+
+	assert.NoError(t, os.WriteFile(certPath, certPem, 0600))
+	assert.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}), 0600))
+	b, err = factory{}.New(newConfig(configs.ConfigurationDaemonBuildkit{CA: caPath, Cert: certPath, Key: keyPath}))
+	assert.NoError(t, err)
+	assert.NotNil(t, b)
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buildkit
+
+import (
+	"context"
+	"io"
+	"sync"
+)
+
+// logBuffer keeps the progress of a running build in memory, every log stream replays it from the beginning
+type logBuffer struct {
+	mutex  sync.Mutex
+	data   []byte
+	notify chan struct{} // closed and replaced on every write to wake up the streams
+	closed bool
+}
+
+func newLogBuffer() *logBuffer {
+	return &logBuffer{notify: make(chan struct{})}
+}
+
+// Write append the data to the buffer
+func (b *logBuffer) Write(p []byte) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.closed {
+		return 0, io.ErrClosedPipe
+	}
+	b.data = append(b.data, p...)
+	close(b.notify)
+	b.notify = make(chan struct{})
+	return len(p), nil
+}
+
+// Close mark the build is finished, the streams return after all of the data is sent
+func (b *logBuffer) Close() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.closed {
+		return
+	}
+	b.closed = true
+	close(b.notify)
+}
+
+// Bytes returns all of the data in the buffer
+func (b *logBuffer) Bytes() []byte {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.data
+}
+
+// Stream copy the data to the writer until the buffer is closed or the ctx is done
+func (b *logBuffer) Stream(ctx context.Context, writer io.Writer) error {
+	var offset int
+	for {
+		b.mutex.Lock()
+		data, notify, closed := b.data[offset:], b.notify, b.closed
+		b.mutex.Unlock()
+		if len(data) > 0 {
+			_, err := writer.Write(data)
+			if err != nil {
+				return err
+			}
+			offset += len(data)
+		}
+		if closed {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-notify:
+		}
+	}
+}
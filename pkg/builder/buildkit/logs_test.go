@@ -0,0 +1,66 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buildkit
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogBuffer(t *testing.T) {
+	buffer := newLogBuffer()
+	_, err := buffer.Write([]byte("#1 load build definition\n"))
+	assert.NoError(t, err)
+
+	var got bytes.Buffer
+	done := make(chan error, 1)
+	go func() {
+		done <- buffer.Stream(context.Background(), &got)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	_, err = buffer.Write([]byte("#1 DONE 0.1s\n"))
+	assert.NoError(t, err)
+	buffer.Close()
+
+	select {
+	case err = <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("stream is not returned after the buffer closed")
+	}
+	assert.Equal(t, "#1 load build definition\n#1 DONE 0.1s\n", got.String())
+	assert.Equal(t, got.Bytes(), buffer.Bytes())
+
+	_, err = buffer.Write([]byte("after close"))
+	assert.Error(t, err)
+
+	// replay the whole log after the build finished
+	var replay bytes.Buffer
+	assert.NoError(t, buffer.Stream(context.Background(), &replay))
+	assert.Equal(t, got.String(), replay.String())
+}
+
+func TestLogBufferCanceled(t *testing.T) {
+	buffer := newLogBuffer()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var got bytes.Buffer
+	assert.ErrorIs(t, buffer.Stream(ctx, &got), context.Canceled)
+}
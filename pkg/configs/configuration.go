@@ -34,20 +34,21 @@ func SetConfiguration(c *Configuration) {
 
 // Configuration ...
 type Configuration struct {
-	Log       ConfigurationLog       `yaml:"log"`
-	Database  ConfigurationDatabase  `yaml:"database"`
-	Deploy    enums.Deploy           `yaml:"deploy"`
-	Redis     ConfigurationRedis     `yaml:"redis"`
-	Badger    ConfigurationBadger    `yaml:"badger"`
-	Cache     ConfigurationCache     `yaml:"cache"`
-	WorkQueue ConfigurationWorkQueue `yaml:"workqueue"`
-	Locker    ConfigurationLocker    `yaml:"locker"`
-	Namespace ConfigurationNamespace `yaml:"namespace"`
-	HTTP      ConfigurationHTTP      `yaml:"http"`
-	Storage   ConfigurationStorage   `yaml:"storage"`
-	Proxy     ConfigurationProxy     `yaml:"proxy"`
-	Daemon    ConfigurationDaemon    `yaml:"daemon"`
-	Auth      ConfigurationAuth      `yaml:"auth"`
+	Log        ConfigurationLog        `yaml:"log"`
+	Database   ConfigurationDatabase   `yaml:"database"`
+	Deploy     enums.Deploy            `yaml:"deploy"`
+	Redis      ConfigurationRedis      `yaml:"redis"`
+	Badger     ConfigurationBadger     `yaml:"badger"`
+	Cache      ConfigurationCache      `yaml:"cache"`
+	WorkQueue  ConfigurationWorkQueue  `yaml:"workqueue"`
+	Locker     ConfigurationLocker     `yaml:"locker"`
+	Namespace  ConfigurationNamespace  `yaml:"namespace"`
+	RecycleBin ConfigurationRecycleBin `yaml:"recycleBin"`
+	HTTP       ConfigurationHTTP       `yaml:"http"`
+	Storage    ConfigurationStorage    `yaml:"storage"`
+	Proxy      ConfigurationProxy      `yaml:"proxy"`
+	Daemon     ConfigurationDaemon     `yaml:"daemon"`
+	Auth       ConfigurationAuth       `yaml:"auth"`
 }
 
 type ConfigurationBuilderK8s struct {
@@ -178,6 +179,35 @@ type ConfigurationLocker struct {
 type ConfigurationNamespace struct {
 	AutoCreate bool             `yaml:"autoCreate"`
 	Visibility enums.Visibility `yaml:"visibility"`
+	// QuotaThresholds the default quota warning threshold percentages of the namespace which not set its own
+	QuotaThresholds []int `yaml:"quotaThresholds"`
+	// UserLimit the default namespace count limit of the new user by the user role
+	UserLimit ConfigurationNamespaceUserLimit `yaml:"userLimit"`
+}
+
+// ConfigurationNamespaceUserLimit the namespace count limit, 0 means unlimited
+type ConfigurationNamespaceUserLimit struct {
+	Admin int64 `yaml:"admin"`
+	User  int64 `yaml:"user"`
+}
+
+// Get returns the default namespace count limit of the user role, the root user is unlimited
+func (c ConfigurationNamespaceUserLimit) Get(role enums.UserRole) int64 {
+	switch role {
+	case enums.UserRoleAdmin:
+		return c.Admin
+	case enums.UserRoleUser, "":
+		return c.User
+	default:
+		return 0
+	}
+}
+
+// ConfigurationRecycleBin ...
+type ConfigurationRecycleBin struct {
+	// Retention the deleted namespaces, repositories, tags and artifacts can be restored within the retention,
+	// and the gc will not reclaim the blobs referenced by them
+	Retention time.Duration `yaml:"retention"`
 }
 
 // ConfigurationHttpTLS ...
@@ -259,8 +289,27 @@ type ConfigurationProxy struct {
 
 // ConfigurationDaemonGc ...
 type ConfigurationDaemonGc struct {
-	Retention time.Duration `yaml:"retention"`
-	Cron      string        `yaml:"cron"`
+	// Cron seeds the gc pipeline rule on first start, leave it empty to disable the scheduled gc pipeline
+	Cron string `yaml:"cron"`
+	// ProtectWindow the blob pushed or checked by the push within the window will never be deleted by the gc blob
+	ProtectWindow time.Duration `yaml:"protectWindow"`
+	// Upload the abandoned blob upload cleanup
+	Upload ConfigurationDaemonGcUpload `yaml:"upload"`
+}
+
+// ConfigurationDaemonGcUpload ...
+type ConfigurationDaemonGcUpload struct {
+	// TTL the upload which has no part uploaded within the ttl is abandoned
+	TTL time.Duration `yaml:"ttl"`
+	// Cron seeds the gc blob upload rule on first start, leave it empty to disable the scheduled cleanup
+	Cron string `yaml:"cron"`
+}
+
+// ConfigurationDaemonQuota ...
+type ConfigurationDaemonQuota struct {
+	// Cron the cron rule of the quota reconciliation which recomputes the namespace and repository size,
+	// leave it empty to disable the scheduled reconciliation
+	Cron string `yaml:"cron"`
 }
 
 // ConfigurationDaemonDocker ...
@@ -275,6 +324,20 @@ type ConfigurationDaemonKubernetes struct {
 	Namespace  string  `yaml:"namespace"`
 }
 
+// ConfigurationDaemonBuildkit the remote buildkitd that builds the images directly from the server
+type ConfigurationDaemonBuildkit struct {
+	// Address the buildkitd address, e.g. tcp://buildkitd:1234
+	Address string `yaml:"address"`
+	// CA, Cert and Key are the paths of the mtls certificates, leave them empty to connect without tls
+	CA         string `yaml:"ca"`
+	Cert       string `yaml:"cert"`
+	Key        string `yaml:"key"`
+	ServerName string `yaml:"serverName"`
+	// KnownHosts the known_hosts file to verify the host keys of the ssh scm repositories,
+	// the default known_hosts files of the user are used if it's empty
+	KnownHosts string `yaml:"knownHosts"`
+}
+
 // ConfigurationDaemonPodman ...
 type ConfigurationDaemonPodman struct {
 	URI string `yaml:"uri"`
@@ -288,11 +351,41 @@ type ConfigurationDaemonBuilder struct {
 	Docker     ConfigurationDaemonDocker     `yaml:"docker"`
 	Kubernetes ConfigurationDaemonKubernetes `yaml:"kubernetes"`
 	Podman     ConfigurationDaemonPodman     `yaml:"podman"`
+	Buildkit   ConfigurationDaemonBuildkit   `yaml:"buildkit"`
+}
+
+// ConfigurationDaemonScannerHarbor ...
+type ConfigurationDaemonScannerHarbor struct {
+	Endpoint      string        `yaml:"endpoint"`
+	Authorization string        `yaml:"authorization"`
+	SkipTlsVerify bool          `yaml:"skipTlsVerify"`
+	Timeout       time.Duration `yaml:"timeout"`
+}
+
+// ConfigurationDaemonScanner ...
+type ConfigurationDaemonScanner struct {
+	Default enums.ScannerType                `yaml:"default"`
+	Harbor  ConfigurationDaemonScannerHarbor `yaml:"harbor"`
+}
+
+// ConfigurationDaemonSbomReferrer ...
+type ConfigurationDaemonSbomReferrer struct {
+	Enabled bool             `yaml:"enabled"`
+	Format  enums.SbomFormat `yaml:"format"`
+}
+
+// ConfigurationDaemonSbom ...
+type ConfigurationDaemonSbom struct {
+	Referrer ConfigurationDaemonSbomReferrer `yaml:"referrer"`
 }
 
 // ConfigurationDaemon ...
 type ConfigurationDaemon struct {
 	Builder ConfigurationDaemonBuilder `yaml:"builder"`
+	Scanner ConfigurationDaemonScanner `yaml:"scanner"`
+	Sbom    ConfigurationDaemonSbom    `yaml:"sbom"`
+	Gc      ConfigurationDaemonGc      `yaml:"gc"`
+	Quota   ConfigurationDaemonQuota   `yaml:"quota"`
 }
 
 // ConfigurationAuthInternalUser ...
@@ -37,12 +37,33 @@ func defaultSettings() {
 	if configuration.Namespace.Visibility.String() == "" {
 		configuration.Namespace.Visibility = enums.VisibilityPrivate
 	}
+	if configuration.Namespace.QuotaThresholds == nil {
+		configuration.Namespace.QuotaThresholds = []int{80, 95}
+	}
+	if configuration.RecycleBin.Retention == 0 {
+		configuration.RecycleBin.Retention = time.Hour * 24 * 7
+	}
 	if configuration.Daemon.Builder.Kubernetes.Namespace == "" {
 		configuration.Daemon.Builder.Kubernetes.Namespace = "default"
 	}
 	if configuration.Daemon.Builder.Podman.URI == "" {
 		configuration.Daemon.Builder.Podman.URI = "unix:///run/podman/podman.sock"
 	}
+	if configuration.Daemon.Scanner.Default.String() == "" {
+		configuration.Daemon.Scanner.Default = enums.ScannerTypeTrivy
+	}
+	if configuration.Daemon.Scanner.Harbor.Timeout == 0 {
+		configuration.Daemon.Scanner.Harbor.Timeout = time.Minute * 5
+	}
+	if configuration.Daemon.Sbom.Referrer.Format.String() == "" {
+		configuration.Daemon.Sbom.Referrer.Format = enums.SbomFormatSpdxJson
+	}
+	if configuration.Daemon.Gc.ProtectWindow == 0 {
+		configuration.Daemon.Gc.ProtectWindow = time.Hour * 24
+	}
+	if configuration.Daemon.Gc.Upload.TTL == 0 {
+		configuration.Daemon.Gc.Upload.TTL = time.Hour * 24
+	}
 	if configuration.WorkQueue.Inmemory.Concurrency == 0 {
 		configuration.WorkQueue.Inmemory.Concurrency = 1024
 	}
@@ -37,6 +37,8 @@ const (
 	UploadUUID = "Docker-Upload-UUID"
 	// ContentDigest represents the content digest in header
 	ContentDigest = "Docker-Content-Digest"
+	// Warning represents the warning header that the client shows to the user
+	Warning = "Warning"
 	// Blobs represents a blobs
 	// file always represent like: blobs/{algo}/xx/xx/{digest}
 	Blobs = "blobs"
@@ -72,6 +74,8 @@ const (
 	PprofPath = "/__debug/pprof"
 	// BuilderImagePath ...
 	BuilderImagePath = "/baseimages/"
+	// DefaultVulnerabilityRescanPulledWithin the default days of the artifacts pulled within will be rescanned
+	DefaultVulnerabilityRescanPulledWithin = 30
 )
 
 const (
@@ -149,8 +153,24 @@ const (
 	LockerMigration = "locker-migration"
 	// LockerCronjobBuilder ...
 	LockerCronjobBuilder = "locker-cronjob-builder"
+	// LockerCronjobVulnerabilityAllowlist ...
+	LockerCronjobVulnerabilityAllowlist = "locker-cronjob-vulnerability-allowlist"
+	// LockerCronjobVulnerabilityRescan ...
+	LockerCronjobVulnerabilityRescan = "locker-cronjob-vulnerability-rescan"
+	// LockerCronjobGc ...
+	LockerCronjobGc = "locker-cronjob-gc"
+	// LockerCronjobGcBlobUpload ...
+	LockerCronjobGcBlobUpload = "locker-cronjob-gc-blob-upload"
+	// LockerCronjobQuota ...
+	LockerCronjobQuota = "locker-cronjob-quota"
 	// LockerBaseimage ...
 	LockerBaseimage = "locker-baseimage"
+	// LockerGcBlob the lock held by the running gc blob runner
+	LockerGcBlob = "locker-gc-blob"
+	// LockerGcBlobUpload the lock held by the running gc blob upload runner
+	LockerGcBlobUpload = "locker-gc-blob-upload"
+	// LockerBlobPrefix the prefix of the lock held while the blob file is written or deleted, the suffix is the digest
+	LockerBlobPrefix = "locker-blob-"
 )
 
 var (
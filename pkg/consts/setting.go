@@ -19,6 +19,10 @@ const (
 	SettingSignPrivateKey = "signing.private_key"
 	// SettingSignPublicKey is the public key for signing
 	SettingSignPublicKey = "signing.public_key"
+	// SettingNotationPrivateKey is the private key for notation signing
+	SettingNotationPrivateKey = "signing.notation.private_key"
+	// SettingNotationCertificate is the self-signed certificate of the notation signing key
+	SettingNotationCertificate = "signing.notation.certificate"
 	// SettingBaseimageDockerfileKey ...
 	SettingBaseimageDockerfileKey = "baseimage.dockerfile"
 	// SettingBaseimageBuilderKey ...
@@ -0,0 +1,131 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cronjob
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/cronjob"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/modules/locker"
+	"github.com/go-sigma/sigma/pkg/modules/timewheel"
+	"github.com/go-sigma/sigma/pkg/modules/workq"
+	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+var allowlistTw timewheel.TimeWheel
+
+func init() {
+	cronjob.Starter = append(cronjob.Starter, allowlistJob)
+	cronjob.Stopper = append(cronjob.Stopper, func() {
+		if allowlistTw != nil {
+			allowlistTw.Stop()
+		}
+	})
+}
+
+func allowlistJob() {
+	allowlistTw = timewheel.NewTimeWheel(context.Background(), cronjob.CronjobIterDuration)
+
+	runner := allowlistRunner{
+		policyServiceFactory: dao.NewPolicyServiceFactory(),
+	}
+	allowlistTw.AddRunner(runner.runner)
+}
+
+type allowlistRunner struct {
+	policyServiceFactory dao.PolicyServiceFactory
+}
+
+// runner removes the expired vulnerability allowlist entries and sends the expired webhook
+func (r allowlistRunner) runner(ctx context.Context, tw timewheel.TimeWheel) {
+	ctx, ctxCancel := context.WithCancel(log.Logger.WithContext(ctx))
+	defer ctxCancel()
+	err := locker.Locker.AcquireWithRenew(ctx, consts.LockerCronjobVulnerabilityAllowlist, time.Second*3, time.Second*5)
+	if err != nil {
+		log.Error().Err(err).Msg("Cronjob vulnerability allowlist get locker failed")
+		return
+	}
+
+	allowlistObjs, err := r.policyServiceFactory.New().ListExpiredVulnerabilityAllowlist(ctx, time.Now(), cronjob.MaxJob)
+	if err != nil {
+		log.Error().Err(err).Msg("List expired vulnerability allowlist failed")
+		return
+	}
+	for _, allowlistObj := range allowlistObjs {
+		// do transaction:
+		// 1. delete the expired entry
+		// 2. publish the webhook event
+		err = query.Q.Transaction(func(tx *query.Query) error {
+			err := r.policyServiceFactory.New(tx).DeleteVulnerabilityAllowlist(ctx, allowlistObj.ID)
+			if err != nil {
+				return err
+			}
+			return workq.ProducerClient.Produce(ctx, enums.DaemonWebhook, types.DaemonWebhookPayload{
+				NamespaceID:  allowlistObj.NamespaceID,
+				Action:       enums.WebhookActionExpired,
+				ResourceType: enums.WebhookResourceTypeVulnerabilityAllowlist,
+				Payload:      utils.MustMarshal(r.packWebhookObj(allowlistObj)),
+			}, definition.ProducerOption{Tx: tx})
+		})
+		if err != nil {
+			log.Error().Interface("allowlist", allowlistObj).Err(err).Msg("Cronjob expire vulnerability allowlist failed")
+		}
+	}
+	if len(allowlistObjs) >= cronjob.MaxJob {
+		tw.TickNext(cronjob.TickNextDuration)
+	}
+}
+
+// packWebhookObj packs the expired allowlist entry into the webhook payload
+func (r allowlistRunner) packWebhookObj(allowlistObj *models.VulnerabilityAllowlist) types.WebhookPayloadVulnerabilityAllowlist {
+	item := types.VulnerabilityAllowlistItem{
+		ID:              allowlistObj.ID,
+		Scope:           enums.VulnerabilityAllowlistScopeSystem,
+		NamespaceID:     allowlistObj.NamespaceID,
+		RepositoryID:    allowlistObj.RepositoryID,
+		VulnerabilityID: allowlistObj.VulnerabilityID,
+		Package:         allowlistObj.Package,
+		Justification:   allowlistObj.Justification,
+		Creator:         allowlistObj.User.Username,
+		CreatedAt:       time.Unix(0, int64(time.Millisecond)*allowlistObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt:       time.Unix(0, int64(time.Millisecond)*allowlistObj.UpdatedAt).UTC().Format(consts.DefaultTimePattern),
+	}
+	if allowlistObj.RepositoryID != nil {
+		item.Scope = enums.VulnerabilityAllowlistScopeRepository
+	} else if allowlistObj.NamespaceID != nil {
+		item.Scope = enums.VulnerabilityAllowlistScopeNamespace
+	}
+	if allowlistObj.ExpiresAt != nil {
+		item.ExpiresAt = ptr.Of(time.Unix(0, int64(time.Millisecond)*ptr.To(allowlistObj.ExpiresAt)).UTC().Format(consts.DefaultTimePattern))
+	}
+	return types.WebhookPayloadVulnerabilityAllowlist{
+		WebhookPayload: types.WebhookPayload{
+			ResourceType: enums.WebhookResourceTypeVulnerabilityAllowlist,
+			Action:       enums.WebhookActionExpired,
+		},
+		Allowlist: item,
+	}
+}
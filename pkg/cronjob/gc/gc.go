@@ -0,0 +1,245 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cronjob
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/cronjob"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/modules/locker"
+	"github.com/go-sigma/sigma/pkg/modules/timewheel"
+	"github.com/go-sigma/sigma/pkg/modules/workq"
+	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+var gcTw timewheel.TimeWheel
+
+func init() {
+	cronjob.Starter = append(cronjob.Starter, gcJob)
+	cronjob.Stopper = append(cronjob.Stopper, func() {
+		if gcTw != nil {
+			gcTw.Stop()
+		}
+	})
+}
+
+func gcJob() {
+	gcTw = timewheel.NewTimeWheel(context.Background(), cronjob.CronjobIterDuration)
+
+	runner := &gcRunner{
+		config:               ptr.To(configs.GetConfiguration()),
+		daemonServiceFactory: dao.NewDaemonServiceFactory(),
+	}
+	gcTw.AddRunner(runner.runner)
+	gcTw.AddRunner(runner.blobUploadRunner)
+}
+
+type gcRunner struct {
+	config               configs.Configuration
+	daemonServiceFactory dao.DaemonServiceFactory
+}
+
+// runner triggers the gc pipeline when the pipeline rule reaches the next trigger time
+func (r *gcRunner) runner(ctx context.Context, _ timewheel.TimeWheel) {
+	ctx, ctxCancel := context.WithCancel(log.Logger.WithContext(ctx))
+	defer ctxCancel()
+	err := locker.Locker.AcquireWithRenew(ctx, consts.LockerCronjobGc, time.Second*3, time.Second*5)
+	if err != nil {
+		log.Error().Err(err).Msg("Cronjob gc get locker failed")
+		return
+	}
+
+	ruleObj, err := r.rule(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Get gc pipeline rule failed")
+		return
+	}
+	if ruleObj == nil || !ruleObj.CronEnabled || ruleObj.CronRule == nil ||
+		ruleObj.CronNextTrigger == nil || ptr.To(ruleObj.CronNextTrigger) > time.Now().UnixMilli() {
+		return
+	}
+	schedule, err := cron.ParseStandard(ptr.To(ruleObj.CronRule))
+	if err != nil {
+		log.Error().Err(err).Interface("rule", ruleObj).Msg("Parse gc pipeline cron rule failed")
+		return
+	}
+	err = r.trigger(ctx, ruleObj, schedule.Next(time.Now()).UnixMilli())
+	if err != nil {
+		log.Error().Err(err).Interface("rule", ruleObj).Msg("Trigger gc pipeline failed")
+		return
+	}
+	log.Info().Interface("rule", ruleObj).Msg("Scheduled gc pipeline enqueued")
+}
+
+// rule gets the gc pipeline rule, the rule is created with the cron in the config if it is not exist
+func (r *gcRunner) rule(ctx context.Context) (*models.DaemonGcPipelineRule, error) {
+	daemonService := r.daemonServiceFactory.New()
+	ruleObj, err := daemonService.GetGcPipelineRule(ctx)
+	if err == nil {
+		return ruleObj, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if r.config.Daemon.Gc.Cron == "" {
+		return nil, nil
+	}
+	schedule, err := cron.ParseStandard(r.config.Daemon.Gc.Cron)
+	if err != nil {
+		return nil, err
+	}
+	ruleObj = &models.DaemonGcPipelineRule{
+		CronEnabled:     true,
+		CronRule:        ptr.Of(r.config.Daemon.Gc.Cron),
+		CronNextTrigger: ptr.Of(schedule.Next(time.Now()).UnixMilli()),
+	}
+	err = daemonService.CreateGcPipelineRule(ctx, ruleObj)
+	if err != nil {
+		return nil, err
+	}
+	return ruleObj, nil
+}
+
+// trigger updates the next trigger time and creates the automatic gc pipeline runner,
+// the runner is skipped if the latest runner is still running
+func (r *gcRunner) trigger(ctx context.Context, ruleObj *models.DaemonGcPipelineRule, nextTrigger int64) error {
+	return query.Q.Transaction(func(tx *query.Query) error {
+		daemonService := r.daemonServiceFactory.New(tx)
+		err := daemonService.UpdateGcPipelineRule(ctx, ruleObj.ID, map[string]any{
+			query.DaemonGcPipelineRule.CronNextTrigger.ColumnName().String(): nextTrigger,
+		})
+		if err != nil {
+			return err
+		}
+		runnerObj, err := daemonService.GetGcPipelineLatestRunner(ctx, ruleObj.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if runnerObj != nil && (runnerObj.Status == enums.TaskCommonStatusPending || runnerObj.Status == enums.TaskCommonStatusDoing) {
+			log.Warn().Int64("runnerID", runnerObj.ID).Msg("The gc pipeline is running, skip this trigger")
+			return nil
+		}
+		runnerObj = &models.DaemonGcPipelineRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending, OperateType: enums.OperateTypeAutomatic}
+		err = daemonService.CreateGcPipelineRunner(ctx, runnerObj)
+		if err != nil {
+			return err
+		}
+		return workq.ProducerClient.Produce(ctx, enums.DaemonGc, types.DaemonGcPayload{RunnerID: runnerObj.ID}, definition.ProducerOption{Tx: tx})
+	})
+}
+
+// blobUploadRunner triggers the gc blob upload runner when the gc blob upload rule reaches the next trigger time
+func (r *gcRunner) blobUploadRunner(ctx context.Context, _ timewheel.TimeWheel) {
+	ctx, ctxCancel := context.WithCancel(log.Logger.WithContext(ctx))
+	defer ctxCancel()
+	err := locker.Locker.AcquireWithRenew(ctx, consts.LockerCronjobGcBlobUpload, time.Second*3, time.Second*5)
+	if err != nil {
+		log.Error().Err(err).Msg("Cronjob gc blob upload get locker failed")
+		return
+	}
+
+	ruleObj, err := r.blobUploadRule(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Get gc blob upload rule failed")
+		return
+	}
+	if ruleObj == nil || !ruleObj.CronEnabled || ruleObj.CronRule == nil ||
+		ruleObj.CronNextTrigger == nil || ptr.To(ruleObj.CronNextTrigger) > time.Now().UnixMilli() {
+		return
+	}
+	schedule, err := cron.ParseStandard(ptr.To(ruleObj.CronRule))
+	if err != nil {
+		log.Error().Err(err).Interface("rule", ruleObj).Msg("Parse gc blob upload cron rule failed")
+		return
+	}
+	err = r.blobUploadTrigger(ctx, ruleObj, schedule.Next(time.Now()).UnixMilli())
+	if err != nil {
+		log.Error().Err(err).Interface("rule", ruleObj).Msg("Trigger gc blob upload failed")
+		return
+	}
+	log.Info().Interface("rule", ruleObj).Msg("Scheduled gc blob upload enqueued")
+}
+
+// blobUploadRule gets the gc blob upload rule, the rule is created with the upload ttl and cron in the config if it is not exist
+func (r *gcRunner) blobUploadRule(ctx context.Context) (*models.DaemonGcBlobUploadRule, error) {
+	daemonService := r.daemonServiceFactory.New()
+	ruleObj, err := daemonService.GetGcBlobUploadRule(ctx)
+	if err == nil {
+		return ruleObj, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if r.config.Daemon.Gc.Upload.Cron == "" {
+		return nil, nil
+	}
+	schedule, err := cron.ParseStandard(r.config.Daemon.Gc.Upload.Cron)
+	if err != nil {
+		return nil, err
+	}
+	ruleObj = &models.DaemonGcBlobUploadRule{
+		RetentionHour:   int(r.config.Daemon.Gc.Upload.TTL / time.Hour),
+		CronEnabled:     true,
+		CronRule:        ptr.Of(r.config.Daemon.Gc.Upload.Cron),
+		CronNextTrigger: ptr.Of(schedule.Next(time.Now()).UnixMilli()),
+	}
+	err = daemonService.CreateGcBlobUploadRule(ctx, ruleObj)
+	if err != nil {
+		return nil, err
+	}
+	return ruleObj, nil
+}
+
+// blobUploadTrigger updates the next trigger time and creates the automatic gc blob upload runner,
+// the runner is skipped if the latest runner is still running
+func (r *gcRunner) blobUploadTrigger(ctx context.Context, ruleObj *models.DaemonGcBlobUploadRule, nextTrigger int64) error {
+	return query.Q.Transaction(func(tx *query.Query) error {
+		daemonService := r.daemonServiceFactory.New(tx)
+		err := daemonService.UpdateGcBlobUploadRule(ctx, ruleObj.ID, map[string]any{
+			query.DaemonGcBlobUploadRule.CronNextTrigger.ColumnName().String(): nextTrigger,
+		})
+		if err != nil {
+			return err
+		}
+		runnerObj, err := daemonService.GetGcBlobUploadLatestRunner(ctx, ruleObj.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if runnerObj != nil && (runnerObj.Status == enums.TaskCommonStatusPending || runnerObj.Status == enums.TaskCommonStatusDoing) {
+			log.Warn().Int64("runnerID", runnerObj.ID).Msg("The gc blob upload is running, skip this trigger")
+			return nil
+		}
+		runnerObj = &models.DaemonGcBlobUploadRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending, OperateType: enums.OperateTypeAutomatic}
+		err = daemonService.CreateGcBlobUploadRunner(ctx, runnerObj)
+		if err != nil {
+			return err
+		}
+		return workq.ProducerClient.Produce(ctx, enums.DaemonGcBlobUpload, types.DaemonGcPayload{RunnerID: runnerObj.ID}, definition.ProducerOption{Tx: tx})
+	})
+}
@@ -0,0 +1,184 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cronjob
+
+import (
+	"context"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/rs/zerolog/log"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/cronjob"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/modules/locker"
+	"github.com/go-sigma/sigma/pkg/modules/timewheel"
+	"github.com/go-sigma/sigma/pkg/modules/workq"
+	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+)
+
+var quotaTw timewheel.TimeWheel
+
+func init() {
+	cronjob.Starter = append(cronjob.Starter, quotaJob)
+	cronjob.Stopper = append(cronjob.Stopper, func() {
+		if quotaTw != nil {
+			quotaTw.Stop()
+		}
+	})
+}
+
+func quotaJob() {
+	config := configs.GetConfiguration()
+	if config.Daemon.Quota.Cron == "" {
+		return
+	}
+	schedule, err := cron.ParseStandard(config.Daemon.Quota.Cron)
+	if err != nil {
+		log.Error().Err(err).Str("cron", config.Daemon.Quota.Cron).Msg("Parse quota reconciliation cron rule failed")
+		return
+	}
+
+	quotaTw = timewheel.NewTimeWheel(context.Background(), cronjob.CronjobIterDuration)
+
+	runner := &quotaRunner{
+		schedule:                 schedule,
+		next:                     schedule.Next(time.Now()),
+		namespaceServiceFactory:  dao.NewNamespaceServiceFactory(),
+		repositoryServiceFactory: dao.NewRepositoryServiceFactory(),
+		artifactServiceFactory:   dao.NewArtifactServiceFactory(),
+	}
+	quotaTw.AddRunner(runner.runner)
+}
+
+type quotaRunner struct {
+	schedule                 cron.Schedule
+	next                     time.Time
+	namespaceServiceFactory  dao.NamespaceServiceFactory
+	repositoryServiceFactory dao.RepositoryServiceFactory
+	artifactServiceFactory   dao.ArtifactServiceFactory
+}
+
+// runner reconciles the quota usage when the cron rule reaches the next trigger time
+func (r *quotaRunner) runner(ctx context.Context, _ timewheel.TimeWheel) {
+	if time.Now().Before(r.next) {
+		return
+	}
+	r.next = r.schedule.Next(time.Now())
+
+	ctx, ctxCancel := context.WithCancel(log.Logger.WithContext(ctx))
+	defer ctxCancel()
+	err := locker.Locker.AcquireWithRenew(ctx, consts.LockerCronjobQuota, time.Second*3, time.Second*5)
+	if err != nil {
+		log.Error().Err(err).Msg("Cronjob quota get locker failed")
+		return
+	}
+
+	count, err := r.reconcile(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Reconcile quota usage failed")
+		return
+	}
+	log.Info().Int("count", count).Msg("Quota usage reconciled")
+}
+
+// reconcile recomputes the size of all of the namespaces and repositories from the artifact blobs,
+// the counter drifted from the actual usage is repaired, it returns the count of the repaired counters.
+func (r *quotaRunner) reconcile(ctx context.Context) (int, error) {
+	var count int
+	var last int64
+	for {
+		namespaceObjs, err := r.namespaceServiceFactory.New().FindWithCursor(ctx, cronjob.MaxJob, last)
+		if err != nil {
+			return count, err
+		}
+		for _, namespaceObj := range namespaceObjs {
+			repaired, err := r.reconcileNamespace(ctx, namespaceObj)
+			if err != nil {
+				log.Error().Err(err).Str("namespace", namespaceObj.Name).Msg("Reconcile namespace quota usage failed")
+				continue
+			}
+			count += repaired
+			// the repaired or deleted usage may cross the quota warning thresholds
+			err = workq.ProducerClient.Produce(ctx, enums.DaemonQuota, types.DaemonQuotaPayload{
+				NamespaceID: namespaceObj.ID,
+			}, definition.ProducerOption{})
+			if err != nil {
+				log.Error().Err(err).Str("namespace", namespaceObj.Name).Msg("Enqueue quota task failed")
+			}
+		}
+		if len(namespaceObjs) < cronjob.MaxJob {
+			return count, nil
+		}
+		last = namespaceObjs[len(namespaceObjs)-1].ID
+	}
+}
+
+// reconcileNamespace recomputes the size of the namespace and the repositories in it
+func (r *quotaRunner) reconcileNamespace(ctx context.Context, namespaceObj *models.Namespace) (int, error) {
+	var count int
+	artifactService := r.artifactServiceFactory.New()
+	repositoryService := r.repositoryServiceFactory.New()
+	var last int64
+	for {
+		repositoryObjs, err := repositoryService.FindAll(ctx, namespaceObj.ID, cronjob.MaxJob, last)
+		if err != nil {
+			return count, err
+		}
+		for _, repositoryObj := range repositoryObjs {
+			size, err := artifactService.GetRepositorySize(ctx, repositoryObj.ID)
+			if err != nil {
+				return count, err
+			}
+			if size == repositoryObj.Size {
+				continue
+			}
+			err = repositoryService.UpdateRepository(ctx, repositoryObj.ID, map[string]any{
+				query.Repository.Size.ColumnName().String(): size,
+			})
+			if err != nil {
+				return count, err
+			}
+			log.Info().Str("repository", repositoryObj.Name).Int64("before", repositoryObj.Size).Int64("after", size).Msg("Repository size repaired")
+			count++
+		}
+		if len(repositoryObjs) < cronjob.MaxJob {
+			break
+		}
+		last = repositoryObjs[len(repositoryObjs)-1].ID
+	}
+
+	size, err := artifactService.GetNamespaceSize(ctx, namespaceObj.ID)
+	if err != nil {
+		return count, err
+	}
+	if size == namespaceObj.Size {
+		return count, nil
+	}
+	err = r.namespaceServiceFactory.New().UpdateByID(ctx, namespaceObj.ID, map[string]any{
+		query.Namespace.Size.ColumnName().String(): size,
+	})
+	if err != nil {
+		return count, err
+	}
+	log.Info().Str("namespace", namespaceObj.Name).Int64("before", namespaceObj.Size).Int64("after", size).Msg("Namespace size repaired")
+	return count + 1, nil
+}
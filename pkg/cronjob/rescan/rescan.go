@@ -0,0 +1,208 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cronjob
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/rs/zerolog/log"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/cronjob"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/modules/locker"
+	"github.com/go-sigma/sigma/pkg/modules/timewheel"
+	"github.com/go-sigma/sigma/pkg/modules/workq"
+	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
+	"github.com/go-sigma/sigma/pkg/scanner/trivy"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+)
+
+var rescanTw timewheel.TimeWheel
+
+func init() {
+	cronjob.Starter = append(cronjob.Starter, rescanJob)
+	cronjob.Stopper = append(cronjob.Stopper, func() {
+		if rescanTw != nil {
+			rescanTw.Stop()
+		}
+	})
+}
+
+func rescanJob() {
+	rescanTw = timewheel.NewTimeWheel(context.Background(), cronjob.CronjobIterDuration)
+
+	runner := &rescanRunner{
+		policyServiceFactory:   dao.NewPolicyServiceFactory(),
+		artifactServiceFactory: dao.NewArtifactServiceFactory(),
+	}
+	rescanTw.AddRunner(runner.runner)
+}
+
+type rescanRunner struct {
+	policyServiceFactory   dao.PolicyServiceFactory
+	artifactServiceFactory dao.ArtifactServiceFactory
+
+	// dbUpdatedAt the updated time of the trivy db that the artifacts have been checked with
+	dbUpdatedAt time.Time
+}
+
+func (r *rescanRunner) runner(ctx context.Context, tw timewheel.TimeWheel) {
+	ctx, ctxCancel := context.WithCancel(log.Logger.WithContext(ctx))
+	defer ctxCancel()
+	err := locker.Locker.AcquireWithRenew(ctx, consts.LockerCronjobVulnerabilityRescan, time.Second*3, time.Second*5)
+	if err != nil {
+		log.Error().Err(err).Msg("Cronjob vulnerability rescan get locker failed")
+		return
+	}
+
+	r.scheduled(ctx, tw)
+	r.dbUpdated(ctx)
+}
+
+// scheduled rescans the artifacts of the policies that reach the next trigger time
+func (r *rescanRunner) scheduled(ctx context.Context, tw timewheel.TimeWheel) {
+	policyObjs, err := r.policyServiceFactory.New().ListVulnerabilityRescanPolicyByNextTrigger(ctx, time.Now(), cronjob.MaxJob)
+	if err != nil {
+		log.Error().Err(err).Msg("Get vulnerability rescan policies by next trigger failed")
+		return
+	}
+	for _, policyObj := range policyObjs {
+		schedule, err := cron.ParseStandard(policyObj.CronRule)
+		if err != nil {
+			log.Error().Err(err).Interface("policy", policyObj).Msg("Parse vulnerability rescan cron rule failed")
+			continue
+		}
+		err = r.policyServiceFactory.New().UpdateVulnerabilityRescanPolicy(ctx, policyObj.ID, map[string]any{
+			query.VulnerabilityRescanPolicy.CronNextTrigger.ColumnName().String(): schedule.Next(time.Now()).UnixMilli(),
+		})
+		if err != nil {
+			log.Error().Err(err).Interface("policy", policyObj).Msg("Update vulnerability rescan next trigger failed")
+			continue
+		}
+		count := r.rescan(ctx, policyObj, nil)
+		log.Info().Interface("policy", policyObj).Int("count", count).Msg("Scheduled vulnerability rescan enqueued")
+	}
+	if len(policyObjs) >= cronjob.MaxJob {
+		tw.TickNext(cronjob.TickNextDuration)
+	}
+}
+
+// dbUpdated rescans the artifacts that scanned with the trivy db older than the installed one
+func (r *rescanRunner) dbUpdated(ctx context.Context) {
+	_, metadata, err := trivy.DBMetadata()
+	if err != nil {
+		log.Debug().Err(err).Msg("Read trivy db metadata failed")
+		return
+	}
+	if !metadata.UpdatedAt.After(r.dbUpdatedAt) {
+		return
+	}
+	policyObjs, err := r.policyServiceFactory.New().ListEnabledVulnerabilityRescanPolicy(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("List enabled vulnerability rescan policies failed")
+		return
+	}
+	for _, policyObj := range policyObjs {
+		count := r.rescan(ctx, policyObj, func(vulnerabilityObj models.ArtifactVulnerability) bool {
+			return scannedBefore(vulnerabilityObj, metadata.UpdatedAt)
+		})
+		log.Info().Interface("policy", policyObj).Time("dbUpdatedAt", metadata.UpdatedAt).Int("count", count).Msg("Trivy db updated vulnerability rescan enqueued")
+	}
+	r.dbUpdatedAt = metadata.UpdatedAt
+}
+
+// scannedBefore checks the artifact was scanned by trivy with the db updated before the specified time,
+// the artifact scanned without the db metadata is treated as outdated.
+func scannedBefore(vulnerabilityObj models.ArtifactVulnerability, updatedAt time.Time) bool {
+	if vulnerabilityObj.Scanner != enums.ScannerTypeTrivy {
+		return false
+	}
+	if len(vulnerabilityObj.Metadata) == 0 {
+		return true
+	}
+	var metadata types.TrivyDBMetadata
+	err := json.Unmarshal(vulnerabilityObj.Metadata, &metadata)
+	if err != nil {
+		return true
+	}
+	return metadata.UpdatedAt.Before(updatedAt)
+}
+
+// rescan enqueues the vulnerability task of the artifacts pulled within the policy days,
+// the artifacts are skipped if the filter returns false. It returns the count of the enqueued artifacts.
+func (r *rescanRunner) rescan(ctx context.Context, policyObj *models.VulnerabilityRescanPolicy, filter func(models.ArtifactVulnerability) bool) int {
+	after := time.Now().Add(-time.Hour * 24 * time.Duration(policyObj.PulledWithin)).UnixMilli()
+	var count int
+	var last int64
+	for {
+		artifactObjs, err := r.artifactServiceFactory.New().FindWithPulledAfter(ctx, policyObj.NamespaceID, after, cronjob.MaxJob, last)
+		if err != nil {
+			log.Error().Err(err).Interface("policy", policyObj).Msg("Find artifacts pulled after failed")
+			return count
+		}
+		for _, artifactObj := range artifactObjs {
+			last = artifactObj.ID
+			status := artifactObj.Vulnerability.ScanStatus
+			if status == enums.TaskCommonStatusPending || status == enums.TaskCommonStatusDoing {
+				continue
+			}
+			if filter != nil && !filter(artifactObj.Vulnerability) {
+				continue
+			}
+			err = r.enqueue(ctx, artifactObj)
+			if err != nil {
+				log.Error().Err(err).Int64("artifactID", artifactObj.ID).Msg("Enqueue vulnerability rescan failed")
+				continue
+			}
+			count++
+		}
+		if len(artifactObjs) < cronjob.MaxJob {
+			return count
+		}
+	}
+}
+
+// enqueue marks the artifact vulnerability scan pending and publish the vulnerability task, the last
+// successful result is kept for the pull policy until the rescan succeeds
+func (r *rescanRunner) enqueue(ctx context.Context, artifactObj *models.Artifact) error {
+	return query.Q.Transaction(func(tx *query.Query) error {
+		artifactService := r.artifactServiceFactory.New(tx)
+		var err error
+		if artifactObj.Vulnerability.ID == 0 {
+			err = artifactService.CreateVulnerability(ctx, &models.ArtifactVulnerability{
+				ArtifactID: artifactObj.ID,
+				Status:     enums.TaskCommonStatusPending,
+				ScanStatus: enums.TaskCommonStatusPending,
+			})
+		} else {
+			err = artifactService.UpdateVulnerability(ctx, artifactObj.ID, map[string]any{
+				query.ArtifactVulnerability.ScanStatus.ColumnName().String(): enums.TaskCommonStatusPending,
+			})
+		}
+		if err != nil {
+			return err
+		}
+		return workq.ProducerClient.Produce(ctx, enums.DaemonVulnerability, types.TaskVulnerability{
+			ArtifactID: artifactObj.ID,
+		}, definition.ProducerOption{Tx: tx})
+	})
+}
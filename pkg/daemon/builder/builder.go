@@ -150,7 +150,10 @@ func (b runner) runner(ctx context.Context, payload types.DaemonBuilderPayload)
 
 			BuildkitPlatforms:          platforms,
 			BuildkitInsecureRegistries: strings.Split(builderObj.BuildkitInsecureRegistries, ","), //  []string{"192.168.31.198:3000@http"},
+
+			SigningType: builderObj.SigningType,
 		},
+		NamespaceID: repositoryObj.NamespaceID,
 	}
 	if builderObj.Source == enums.BuilderSourceCodeRepository {
 		buildConfig.Builder.ScmCredentialType = builderObj.ScmCredentialType
@@ -57,6 +57,8 @@ type inject struct {
 	daemonServiceFactory dao.DaemonServiceFactory
 	storageDriverFactory storage.StorageDriverFactory
 	producerClient       definition.WorkQueueProducer
+	// skipWebhook drops the webhooks of the runner, the gc pipeline sends its own webhook instead of the stages'
+	skipWebhook bool
 }
 
 // Runner ...
@@ -73,6 +75,7 @@ func decorator(daemon enums.Daemon, injects ...inject) func(context.Context, []b
 
 		producerClient := workq.ProducerClient
 		daemonServiceFactory := dao.NewDaemonServiceFactory()
+		var skipWebhook bool
 		if len(injects) > 0 {
 			ij := injects[0]
 			if ij.producerClient != nil {
@@ -81,6 +84,7 @@ func decorator(daemon enums.Daemon, injects ...inject) func(context.Context, []b
 			if ij.daemonServiceFactory != nil {
 				daemonServiceFactory = ij.daemonServiceFactory
 			}
+			skipWebhook = ij.skipWebhook
 		}
 
 		var runnerChan = make(chan decoratorStatus, 3)
@@ -128,6 +132,10 @@ func decorator(daemon enums.Daemon, injects ...inject) func(context.Context, []b
 					err = daemonService.UpdateGcArtifactRunner(ctx, id, updates)
 				case enums.DaemonGcBlob:
 					err = daemonService.UpdateGcBlobRunner(ctx, id, updates)
+				case enums.DaemonGcBlobUpload:
+					err = daemonService.UpdateGcBlobUploadRunner(ctx, id, updates)
+				case enums.DaemonGc:
+					err = daemonService.UpdateGcPipelineRunner(ctx, id, updates)
 				default:
 					continue
 				}
@@ -140,6 +148,9 @@ func decorator(daemon enums.Daemon, injects ...inject) func(context.Context, []b
 		go func() {
 			defer waitAllEvents.Done()
 			for webhook := range webhookChan {
+				if skipWebhook {
+					continue
+				}
 				err := triggerWebhook(ctx, webhook, producerClient)
 				if err != nil {
 					log.Error().Err(err).Msg("Webhook event produce failed")
@@ -162,8 +173,9 @@ func initGc(ctx context.Context, daemon enums.Daemon, runnerChan chan decoratorS
 	switch daemon {
 	case enums.DaemonGcRepository:
 		return &gcRepository{
-			ctx:    log.Logger.WithContext(ctx),
-			config: ptr.To(configs.GetConfiguration()),
+			ctx:     log.Logger.WithContext(ctx),
+			config:  ptr.To(configs.GetConfiguration()),
+			reclaim: newReclaimCounter(dao.NewBlobServiceFactory(), dao.NewTagServiceFactory()),
 
 			daemonServiceFactory:     dao.NewDaemonServiceFactory(),
 			namespaceServiceFactory:  dao.NewNamespaceServiceFactory(),
@@ -186,8 +198,9 @@ func initGc(ctx context.Context, daemon enums.Daemon, runnerChan chan decoratorS
 		}
 	case enums.DaemonGcArtifact:
 		return &gcArtifact{
-			ctx:    log.Logger.WithContext(ctx),
-			config: ptr.To(configs.GetConfiguration()),
+			ctx:     log.Logger.WithContext(ctx),
+			config:  ptr.To(configs.GetConfiguration()),
+			reclaim: newReclaimCounter(dao.NewBlobServiceFactory(), dao.NewTagServiceFactory()),
 
 			namespaceServiceFactory:  dao.NewNamespaceServiceFactory(),
 			repositoryServiceFactory: dao.NewRepositoryServiceFactory(),
@@ -211,8 +224,9 @@ func initGc(ctx context.Context, daemon enums.Daemon, runnerChan chan decoratorS
 		}
 	case enums.DaemonGcTag:
 		return &gcTag{
-			ctx:    log.Logger.WithContext(ctx),
-			config: ptr.To(configs.GetConfiguration()),
+			ctx:     log.Logger.WithContext(ctx),
+			config:  ptr.To(configs.GetConfiguration()),
+			reclaim: newReclaimCounter(dao.NewBlobServiceFactory(), dao.NewTagServiceFactory()),
 
 			daemonServiceFactory:     dao.NewDaemonServiceFactory(),
 			namespaceServiceFactory:  dao.NewNamespaceServiceFactory(),
@@ -242,9 +256,10 @@ func initGc(ctx context.Context, daemon enums.Daemon, runnerChan chan decoratorS
 			ctx:    log.Logger.WithContext(ctx),
 			config: ptr.To(configs.GetConfiguration()),
 
-			blobServiceFactory:   dao.NewBlobServiceFactory(),
-			daemonServiceFactory: dao.NewDaemonServiceFactory(),
-			storageDriverFactory: storage.NewStorageDriverFactory(),
+			blobServiceFactory:       dao.NewBlobServiceFactory(),
+			blobUploadServiceFactory: dao.NewBlobUploadServiceFactory(),
+			daemonServiceFactory:     dao.NewDaemonServiceFactory(),
+			storageDriverFactory:     storage.NewStorageDriverFactory(),
 
 			deleteBlobChan:        make(chan blobTask, pagination),
 			deleteBlobChanOnce:    &sync.Once{},
@@ -263,6 +278,48 @@ func initGc(ctx context.Context, daemon enums.Daemon, runnerChan chan decoratorS
 			}
 		}
 		return runner
+	case enums.DaemonGcBlobUpload:
+		runner := &gcBlobUpload{
+			ctx:    log.Logger.WithContext(ctx),
+			config: ptr.To(configs.GetConfiguration()),
+
+			blobUploadServiceFactory: dao.NewBlobUploadServiceFactory(),
+			daemonServiceFactory:     dao.NewDaemonServiceFactory(),
+			storageDriverFactory:     storage.NewStorageDriverFactory(),
+
+			deleteUploadChan:      make(chan blobUploadTask, pagination),
+			deleteUploadChanOnce:  &sync.Once{},
+			collectRecordChan:     make(chan blobUploadTaskCollectRecord, pagination),
+			collectRecordChanOnce: &sync.Once{},
+
+			runnerChan:  runnerChan,
+			webhookChan: webhookChan,
+
+			waitAllDone: &sync.WaitGroup{},
+		}
+		if len(injects) > 0 {
+			ij := injects[0]
+			if ij.storageDriverFactory != nil {
+				runner.storageDriverFactory = ij.storageDriverFactory
+			}
+		}
+		return runner
+	case enums.DaemonGc:
+		runner := &gcPipeline{
+			ctx:    log.Logger.WithContext(ctx),
+			config: ptr.To(configs.GetConfiguration()),
+
+			daemonServiceFactory:    dao.NewDaemonServiceFactory(),
+			namespaceServiceFactory: dao.NewNamespaceServiceFactory(),
+
+			runnerChan:  runnerChan,
+			webhookChan: webhookChan,
+		}
+		if len(injects) > 0 {
+			runner.stageInject = injects[0]
+		}
+		runner.stageInject.skipWebhook = true
+		return runner
 	default:
 		return nil
 	}
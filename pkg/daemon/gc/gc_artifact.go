@@ -18,6 +18,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 	"sync"
 	"time"
 
@@ -52,12 +54,17 @@ type artifactWithNamespaceTask struct {
 type artifactTask struct {
 	Runner   models.DaemonGcArtifactRunner
 	Artifact models.Artifact
+	Dangling bool // Dangling the artifact is the referrer which subject is deleted, the tags of it will be deleted too
 }
 
+// cosignTagRegexp matches the tag of the cosign signature, attestation and sbom which refers to the subject by tag name
+var cosignTagRegexp = regexp.MustCompile(`^sha256-([a-f0-9]{64})\.(sig|att|sbom)$`)
+
 type artifactTaskCollectRecord struct {
 	Status   enums.GcRecordStatus
 	Runner   models.DaemonGcArtifactRunner
 	Artifact models.Artifact
+	Size     int64
 	Message  *string
 }
 
@@ -66,9 +73,11 @@ type gcArtifact struct {
 	config configs.Configuration
 
 	runnerObj *models.DaemonGcArtifactRunner
+	reclaim   *reclaimCounter
 
 	successCount int64
 	failedCount  int64
+	reclaimSize  int64
 
 	namespaceServiceFactory  dao.NamespaceServiceFactory
 	repositoryServiceFactory dao.RepositoryServiceFactory
@@ -196,6 +205,7 @@ func (g gcArtifact) deleteArtifactWithNamespace() {
 						}
 						artifactCurIndex = artifactObjs[len(artifactObjs)-1].ID
 					}
+					g.deleteDanglingReferrers(task.Runner, repositoryObj)
 				}
 				if len(repositoryObjs) < pagination {
 					break
@@ -206,6 +216,96 @@ func (g gcArtifact) deleteArtifactWithNamespace() {
 	}()
 }
 
+// deleteDanglingReferrers sends the referrers which subject is deleted before the referrer retention days to check,
+// includes the cosign artifacts associated with the subject by the tag name, e.g. sha256-<hex>.sig,
+// the artifact found by both of them is sent only once.
+func (g gcArtifact) deleteDanglingReferrers(runner models.DaemonGcArtifactRunner, repositoryObj *models.Repository) {
+	artifactService := g.artifactServiceFactory.New()
+	tagService := g.tagServiceFactory.New()
+	timeTarget := g.danglingReferrerBefore(time.Now())
+
+	sent := make(map[int64]struct{})
+	send := func(artifactObj *models.Artifact) {
+		if _, ok := sent[artifactObj.ID]; ok {
+			return
+		}
+		sent[artifactObj.ID] = struct{}{}
+		g.deleteArtifactCheckChan <- artifactTask{Runner: runner, Artifact: ptr.To(artifactObj), Dangling: true}
+	}
+
+	var artifactCurIndex int64
+	for {
+		artifactObjs, err := artifactService.FindDanglingReferrers(g.ctx, repositoryObj.ID, timeTarget, pagination, artifactCurIndex)
+		if err != nil {
+			log.Error().Err(err).Int64("repositoryID", repositoryObj.ID).Msg("List dangling referrers failed")
+			break
+		}
+		for _, a := range artifactObjs {
+			send(a)
+		}
+		if len(artifactObjs) < pagination {
+			break
+		}
+		artifactCurIndex = artifactObjs[len(artifactObjs)-1].ID
+	}
+
+	var tagCurIndex int64
+	for {
+		tagObjs, err := tagService.ListByDtPagination(g.ctx, repositoryObj.Name, pagination, tagCurIndex)
+		if err != nil {
+			log.Error().Err(err).Int64("repositoryID", repositoryObj.ID).Msg("List tag failed")
+			break
+		}
+		for _, tagObj := range tagObjs {
+			matches := cosignTagRegexp.FindStringSubmatch(tagObj.Name)
+			if matches == nil {
+				continue
+			}
+			subjectDigest := "sha256:" + matches[1]
+			_, err = artifactService.GetByDigest(g.ctx, repositoryObj.ID, subjectDigest)
+			if err == nil { // the subject still exists
+				continue
+			}
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Error().Err(err).Str("digest", subjectDigest).Msg("Get subject by digest failed")
+				continue
+			}
+			deletedAt := tagObj.PushedAt // the subject may never be pushed to the repository
+			deletedObj, err := artifactService.GetDeletedByDigest(g.ctx, repositoryObj.ID, subjectDigest)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Error().Err(err).Str("digest", subjectDigest).Msg("Get deleted subject by digest failed")
+				continue
+			}
+			if err == nil {
+				deletedAt = int64(deletedObj.DeletedAt)
+			}
+			if deletedAt >= timeTarget {
+				continue
+			}
+			artifactObj, err := artifactService.Get(g.ctx, tagObj.ArtifactID)
+			if err != nil {
+				log.Error().Err(err).Int64("artifactID", tagObj.ArtifactID).Msg("Get artifact by id failed")
+				continue
+			}
+			send(artifactObj)
+		}
+		if len(tagObjs) < pagination {
+			break
+		}
+		tagCurIndex = tagObjs[len(tagObjs)-1].ID
+	}
+}
+
+// danglingReferrerBefore returns the time before which the subject of the dangling referrer is deleted,
+// the subject in the recycle bin can be restored, so its referrers are kept until the recycle bin retention is over.
+func (g gcArtifact) danglingReferrerBefore(now time.Time) int64 {
+	retention := time.Duration(g.runnerObj.Rule.ReferrerRetentionDay) * 24 * time.Hour
+	if g.config.RecycleBin.Retention > retention {
+		retention = g.config.RecycleBin.Retention
+	}
+	return now.Add(-retention).UnixMilli()
+}
+
 func (g gcArtifact) deleteArtifactCheck() {
 	artifactService := g.artifactServiceFactory.New()
 	tagService := g.tagServiceFactory.New()
@@ -213,6 +313,11 @@ func (g gcArtifact) deleteArtifactCheck() {
 		defer g.waitAllDone.Done()
 		defer close(g.deleteArtifactChan)
 		for task := range g.deleteArtifactCheckChan {
+			// 0. the subject of the dangling referrer is deleted, no more check is needed
+			if task.Dangling {
+				g.deleteArtifactChan <- task
+				continue
+			}
 			// 1. check manifest referrer associate with another artifact
 			if task.Artifact.ReferrerID != nil {
 				continue
@@ -236,31 +341,86 @@ func (g gcArtifact) deleteArtifactCheck() {
 					continue
 				}
 			}
-			// 4. delete the artifact that referrer to this artifact
-			delArtifacts, err := artifactService.GetReferrers(g.ctx, task.Artifact.RepositoryID, task.Artifact.Digest, nil)
+			// 4. check tagged referrer associate with this artifact, the referrers are left to the dangling referrer check
+			referredByTag, err := g.referredByTag(ptr.Of(task.Artifact))
 			if err != nil {
-				log.Error().Err(err).Int64("repositoryID", task.Artifact.RepositoryID).Int64("artifactID", task.Artifact.ID).Msg("Get artifact referrers failed")
+				log.Error().Err(err).Int64("repositoryID", task.Artifact.RepositoryID).Int64("artifactID", task.Artifact.ID).Msg("Check tagged referrers failed")
 				continue
 			}
-			// TODO: maybe here should be submit a transaction clear all of the objects, include refers and index
-			for _, a := range delArtifacts {
-				g.deleteArtifactChan <- artifactTask{Runner: task.Runner, Artifact: ptr.To(a)}
+			if referredByTag {
+				continue
 			}
+			// TODO: maybe here should be submit a transaction clear all of the objects, include refers and index
 			g.deleteArtifactChan <- task
 		}
 	}()
 }
 
+// referredByTag checks whether the artifact is the subject of a tagged referrer, the referrer is associated
+// with the subject by the subject field or by the cosign tag name, e.g. sha256-<hex>.sig,
+// the tagged referrer of both schemas keeps the subject alive.
+func (g gcArtifact) referredByTag(artifactObj *models.Artifact) (bool, error) {
+	artifactService := g.artifactServiceFactory.New()
+	tagService := g.tagServiceFactory.New()
+	referrerObjs, err := artifactService.GetReferrers(g.ctx, artifactObj.RepositoryID, artifactObj.Digest, nil)
+	if err != nil {
+		return false, err
+	}
+	for _, referrerObj := range referrerObjs {
+		_, err = tagService.GetByArtifactID(g.ctx, referrerObj.RepositoryID, referrerObj.ID)
+		if err == nil {
+			return true, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, err
+		}
+	}
+	if !strings.HasPrefix(artifactObj.Digest, "sha256:") {
+		return false, nil
+	}
+	for _, suffix := range []string{"sig", "att", "sbom"} {
+		_, err = tagService.GetByName(g.ctx, artifactObj.RepositoryID, fmt.Sprintf("sha256-%s.%s", strings.TrimPrefix(artifactObj.Digest, "sha256:"), suffix))
+		if err == nil {
+			return true, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
 func (g gcArtifact) deleteArtifact() {
 	go func() {
 		defer g.waitAllDone.Done()
 		defer close(g.collectRecordChan)
 		// artifactService := g.artifactServiceFactory.New()
 		for task := range g.deleteArtifactChan {
+			size, err := g.reclaim.artifact(g.ctx, task.Artifact.ID)
+			if err != nil {
+				log.Error().Err(err).Int64("artifactID", task.Artifact.ID).Msg("Count reclaimable size failed")
+			}
+			if task.Runner.DryRun { // just record the artifact would be deleted
+				g.collectRecordChan <- artifactTaskCollectRecord{Status: enums.GcRecordStatusPlanned, Artifact: task.Artifact, Runner: task.Runner, Size: size}
+				continue
+			}
+			if task.Dangling {
+				err = g.tagServiceFactory.New().DeleteByArtifactID(g.ctx, task.Artifact.ID)
+				if err != nil {
+					log.Error().Err(err).Int64("artifactID", task.Artifact.ID).Msg("Delete tags of dangling referrer failed")
+					g.collectRecordChan <- artifactTaskCollectRecord{
+						Status:   enums.GcRecordStatusFailed,
+						Artifact: task.Artifact,
+						Runner:   task.Runner,
+						Message:  ptr.Of(fmt.Sprintf("Delete tags of dangling referrer failed: %v", err)),
+					}
+					continue
+				}
+			}
 			// TODO: we should set a lock for the delete action
 			// otherwise, we should delete the artifact in goroutine
 			// err := query.Q.Transaction(func(tx *query.Query) error {
-			err := g.artifactServiceFactory.New().DeleteByID(g.ctx, task.Artifact.ID)
+			err = g.artifactServiceFactory.New().DeleteByID(g.ctx, task.Artifact.ID)
 			// 	if err != nil {
 			// 		return err
 			// 	}
@@ -277,7 +437,7 @@ func (g gcArtifact) deleteArtifact() {
 				}
 				continue
 			}
-			g.collectRecordChan <- artifactTaskCollectRecord{Status: enums.GcRecordStatusSuccess, Artifact: task.Artifact, Runner: task.Runner}
+			g.collectRecordChan <- artifactTaskCollectRecord{Status: enums.GcRecordStatusSuccess, Artifact: task.Artifact, Runner: task.Runner, Size: size}
 		}
 	}()
 }
@@ -288,25 +448,29 @@ func (g gcArtifact) collectRecord() {
 		defer g.waitAllDone.Done()
 		defer func() {
 			g.runnerChan <- decoratorStatus{Daemon: enums.DaemonGcArtifact, Status: enums.TaskCommonStatusDoing, Updates: map[string]any{
-				"success_count": g.successCount,
-				"failed_count":  g.failedCount,
+				"success_count":    g.successCount,
+				"failed_count":     g.failedCount,
+				"reclaimable_size": g.reclaimSize,
 			}}
 		}()
 		for task := range g.collectRecordChan {
 			err := daemonService.CreateGcArtifactRecords(g.ctx, []*models.DaemonGcArtifactRecord{
 				{
-					RunnerID: task.Runner.ID,
-					Digest:   task.Artifact.Digest,
-					Status:   task.Status,
-					Message:  []byte(ptr.To(task.Message)),
+					RunnerID:    task.Runner.ID,
+					NamespaceID: ptr.Of(task.Artifact.NamespaceID),
+					Digest:      task.Artifact.Digest,
+					Status:      task.Status,
+					Size:        task.Size,
+					Message:     []byte(ptr.To(task.Message)),
 				},
 			})
 			if err != nil {
 				log.Error().Err(err).Msg("Create gc repository record failed")
 				continue
 			}
-			if task.Status == enums.GcRecordStatusSuccess {
+			if task.Status == enums.GcRecordStatusSuccess || task.Status == enums.GcRecordStatusPlanned {
 				g.successCount++
+				g.reclaimSize += task.Size
 			} else {
 				g.failedCount++
 			}
@@ -20,16 +20,21 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
 
+	"github.com/go-sigma/sigma/pkg/configs"
 	"github.com/go-sigma/sigma/pkg/dal"
 	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
 	"github.com/go-sigma/sigma/pkg/logger"
 	"github.com/go-sigma/sigma/pkg/tests"
+	"github.com/go-sigma/sigma/pkg/types"
 	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
 )
 
 func TestGcArtifactNormal(t *testing.T) {
@@ -123,3 +128,179 @@ func TestGcArtifactNormal(t *testing.T) {
 	_, err = artifactService.Get(ctx, 4)
 	assert.Equal(t, gorm.ErrRecordNotFound, err)
 }
+
+func TestGcArtifactDanglingReferrer(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	userObj := &models.User{Username: "gc-artifact", Password: ptr.Of("test"), Email: ptr.Of("test@gmail.com")}
+	assert.NoError(t, dao.NewUserServiceFactory().New().Create(ctx, userObj))
+	namespaceObj := &models.Namespace{Name: "gc-artifact", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+	repositoryObj := &models.Repository{Name: "gc-artifact/busybox", NamespaceID: namespaceObj.ID}
+	assert.NoError(t, dao.NewRepositoryServiceFactory().New().Create(ctx, repositoryObj, dao.AutoCreateNamespace{UserID: userObj.ID}))
+
+	daemonService := dao.NewDaemonServiceFactory().New()
+	ruleObj := &models.DaemonGcArtifactRule{NamespaceID: ptr.Of(namespaceObj.ID)}
+	assert.NoError(t, daemonService.CreateGcArtifactRule(ctx, ruleObj))
+	runnerObj := &models.DaemonGcArtifactRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending, OperateType: enums.OperateTypeManual}
+	assert.NoError(t, daemonService.CreateGcArtifactRunner(ctx, runnerObj))
+
+	artifactService := dao.NewArtifactServiceFactory().New()
+	tagService := dao.NewTagServiceFactory().New()
+	newArtifact := func(dgest string, referrerID *int64) *models.Artifact {
+		artifactObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: dgest,
+			Size: 123, ContentType: "application/vnd.oci.image.manifest.v1+json", Raw: []byte("test"), ReferrerID: referrerID, LastPull: time.Now().UnixMilli()}
+		assert.NoError(t, artifactService.Create(ctx, artifactObj))
+		return artifactObj
+	}
+
+	// the referrer of the deleted subject
+	deletedSubjectObj := newArtifact("sha256:deleted-subject", nil)
+	danglingObj := newArtifact("sha256:dangling", ptr.Of(deletedSubjectObj.ID))
+	assert.NoError(t, artifactService.DeleteByID(ctx, deletedSubjectObj.ID))
+
+	// the cosign signature of the missing subject
+	signatureObj := newArtifact("sha256:signature", nil)
+	assert.NoError(t, tagService.Create(ctx, &models.Tag{RepositoryID: repositoryObj.ID, ArtifactID: signatureObj.ID,
+		Name: "sha256-" + strings.Repeat("a", 64) + ".sig", PushedAt: 1000}))
+
+	// the cosign signature of the deleted subject, found both by the subject and by the tag name
+	deletedSignedObj := newArtifact("sha256:"+strings.Repeat("c", 64), nil)
+	signatureReferrerObj := newArtifact("sha256:signature-referrer", ptr.Of(deletedSignedObj.ID))
+	assert.NoError(t, tagService.Create(ctx, &models.Tag{RepositoryID: repositoryObj.ID, ArtifactID: signatureReferrerObj.ID,
+		Name: "sha256-" + strings.Repeat("c", 64) + ".sig", PushedAt: 1000}))
+	assert.NoError(t, artifactService.DeleteByID(ctx, deletedSignedObj.ID))
+
+	// the untagged subject referenced by the tagged cosign signature
+	signedObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:" + strings.Repeat("b", 64),
+		Size: 123, ContentType: "application/vnd.oci.image.manifest.v1+json", Raw: []byte("test")}
+	assert.NoError(t, artifactService.Create(ctx, signedObj))
+	cosignObj := newArtifact("sha256:cosign", nil)
+	assert.NoError(t, tagService.Create(ctx, &models.Tag{RepositoryID: repositoryObj.ID, ArtifactID: cosignObj.ID,
+		Name: "sha256-" + strings.Repeat("b", 64) + ".sig", PushedAt: time.Now().UnixMilli()}))
+
+	// the untagged subject referenced by the tagged referrer
+	subjectObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:subject",
+		Size: 123, ContentType: "application/vnd.oci.image.manifest.v1+json", Raw: []byte("test")}
+	assert.NoError(t, artifactService.Create(ctx, subjectObj))
+	sbomObj := newArtifact("sha256:sbom", ptr.Of(subjectObj.ID))
+	assert.NoError(t, tagService.Create(ctx, &models.Tag{RepositoryID: repositoryObj.ID, ArtifactID: sbomObj.ID, Name: "sbom"}))
+
+	var runnerChan = make(chan decoratorStatus, 4)
+	var webhookChan = make(chan decoratorWebhook, 4)
+	runner := initGc(ctx, enums.DaemonGcArtifact, runnerChan, webhookChan)
+	assert.NoError(t, runner.Run(runnerObj.ID))
+	for range webhookChan { // nolint:revive
+	}
+	var statusArr = make([]string, 0, 10)
+	for status := range runnerChan {
+		statusArr = append(statusArr, string(status.Status))
+	}
+	assert.Equal(t, []string{"Doing", "Doing", "Success"}, statusArr)
+
+	_, err := artifactService.Get(ctx, danglingObj.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	_, err = artifactService.Get(ctx, signatureObj.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	_, err = tagService.GetByArtifactID(ctx, repositoryObj.ID, signatureObj.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	_, err = artifactService.Get(ctx, subjectObj.ID)
+	assert.NoError(t, err)
+	_, err = artifactService.Get(ctx, sbomObj.ID)
+	assert.NoError(t, err)
+	_, err = artifactService.Get(ctx, signatureReferrerObj.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	_, err = artifactService.Get(ctx, signedObj.ID)
+	assert.NoError(t, err)
+	_, err = artifactService.Get(ctx, cosignObj.ID)
+	assert.NoError(t, err)
+
+	recordObjs, _, err := daemonService.ListGcArtifactRecords(ctx, runnerObj.ID, types.Pagination{Limit: ptr.Of(100), Page: ptr.Of(1)}, types.Sortable{})
+	assert.NoError(t, err)
+	var deletedDigests []string
+	for _, recordObj := range recordObjs {
+		assert.Equal(t, enums.GcRecordStatusSuccess, recordObj.Status)
+		deletedDigests = append(deletedDigests, recordObj.Digest)
+	}
+	assert.ElementsMatch(t, []string{danglingObj.Digest, signatureObj.Digest, signatureReferrerObj.Digest}, deletedDigests)
+}
+
+func TestGcArtifactReferrerRetention(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	config := ptr.To(configs.GetConfiguration())
+	defer configs.SetConfiguration(ptr.Of(config))
+	config.RecycleBin.Retention = time.Hour
+	configs.SetConfiguration(ptr.Of(config))
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	userObj := &models.User{Username: "gc-artifact", Password: ptr.Of("test"), Email: ptr.Of("test@gmail.com")}
+	assert.NoError(t, dao.NewUserServiceFactory().New().Create(ctx, userObj))
+	namespaceObj := &models.Namespace{Name: "gc-artifact", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+	repositoryObj := &models.Repository{Name: "gc-artifact/busybox", NamespaceID: namespaceObj.ID}
+	assert.NoError(t, dao.NewRepositoryServiceFactory().New().Create(ctx, repositoryObj, dao.AutoCreateNamespace{UserID: userObj.ID}))
+
+	daemonService := dao.NewDaemonServiceFactory().New()
+	ruleObj := &models.DaemonGcArtifactRule{NamespaceID: ptr.Of(namespaceObj.ID)}
+	assert.NoError(t, daemonService.CreateGcArtifactRule(ctx, ruleObj))
+	runnerObj := &models.DaemonGcArtifactRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending, OperateType: enums.OperateTypeManual}
+	assert.NoError(t, daemonService.CreateGcArtifactRunner(ctx, runnerObj))
+
+	// the untagged subject with the untagged referrer
+	artifactService := dao.NewArtifactServiceFactory().New()
+	subjectObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:subject",
+		Size: 123, ContentType: "application/vnd.oci.image.manifest.v1+json", Raw: []byte("test")}
+	assert.NoError(t, artifactService.Create(ctx, subjectObj))
+	referrerObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:referrer",
+		Size: 123, ContentType: "application/vnd.oci.image.manifest.v1+json", Raw: []byte("test"), ReferrerID: ptr.Of(subjectObj.ID)}
+	assert.NoError(t, artifactService.Create(ctx, referrerObj))
+
+	var runnerChan = make(chan decoratorStatus, 4)
+	var webhookChan = make(chan decoratorWebhook, 4)
+	runner := initGc(ctx, enums.DaemonGcArtifact, runnerChan, webhookChan)
+	assert.NoError(t, runner.Run(runnerObj.ID))
+	for range webhookChan { // nolint:revive
+	}
+	for range runnerChan { // nolint:revive
+	}
+
+	// the referrer is kept until the subject is deleted before the retention
+	_, err := artifactService.Get(ctx, subjectObj.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	_, err = artifactService.Get(ctx, referrerObj.ID)
+	assert.NoError(t, err)
+}
+
+func TestGcArtifactDanglingReferrerBefore(t *testing.T) {
+	now := time.Now()
+
+	g := gcArtifact{runnerObj: &models.DaemonGcArtifactRunner{Rule: models.DaemonGcArtifactRule{ReferrerRetentionDay: 1}}}
+	assert.Equal(t, now.Add(-24*time.Hour).UnixMilli(), g.danglingReferrerBefore(now))
+
+	// the subject in the recycle bin can be restored with its referrers
+	g.config.RecycleBin.Retention = 72 * time.Hour
+	assert.Equal(t, now.Add(-72*time.Hour).UnixMilli(), g.danglingReferrerBefore(now))
+
+	g.runnerObj.Rule.ReferrerRetentionDay = 7
+	assert.Equal(t, now.Add(-7*24*time.Hour).UnixMilli(), g.danglingReferrerBefore(now))
+}
@@ -16,18 +16,22 @@ package gc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"path"
 	"sync"
 	"time"
 
 	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/opencontainers/go-digest"
 	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
 
 	"github.com/go-sigma/sigma/pkg/configs"
 	"github.com/go-sigma/sigma/pkg/consts"
 	"github.com/go-sigma/sigma/pkg/dal/dao"
 	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/modules/locker"
 	"github.com/go-sigma/sigma/pkg/modules/workq"
 	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
 	"github.com/go-sigma/sigma/pkg/storage"
@@ -63,13 +67,19 @@ type gcBlob struct {
 	config configs.Configuration
 
 	runnerObj *models.DaemonGcBlobRunner
+	// protectBefore the blob pushed or checked after it will not be deleted
+	protectBefore int64
+	// recycleBinAfter the artifacts deleted after it are still in the recycle bin, the blobs referenced by them will not be deleted
+	recycleBinAfter int64
 
 	successCount int64
 	failedCount  int64
+	reclaimSize  int64
 
-	blobServiceFactory   dao.BlobServiceFactory
-	daemonServiceFactory dao.DaemonServiceFactory
-	storageDriverFactory storage.StorageDriverFactory
+	blobServiceFactory       dao.BlobServiceFactory
+	blobUploadServiceFactory dao.BlobUploadServiceFactory
+	daemonServiceFactory     dao.DaemonServiceFactory
+	storageDriverFactory     storage.StorageDriverFactory
 
 	deleteBlobChan        chan blobTask
 	deleteBlobChanOnce    *sync.Once
@@ -105,6 +115,35 @@ func (g gcBlob) Run(runnerID int64) error {
 		Action:       enums.WebhookActionStarted,
 	}, WebhookObj: g.packWebhookObj(enums.WebhookActionStarted)}
 
+	// only one gc blob runner should be running at the same time
+	lockCtx, lockCancel := context.WithCancel(g.ctx)
+	defer lockCancel()
+	err = locker.Locker.AcquireWithRenew(lockCtx, consts.LockerGcBlob, time.Second*3, time.Second*5)
+	if err != nil {
+		g.runnerChan <- decoratorStatus{Daemon: enums.DaemonGcBlob, Status: enums.TaskCommonStatusFailed, Message: fmt.Sprintf("Acquire gc blob lock failed: %v", err), Ended: true}
+		g.webhookChan <- decoratorWebhook{Meta: types.WebhookPayload{
+			ResourceType: enums.WebhookResourceTypeDaemonTaskGcBlobRunner,
+			Action:       enums.WebhookActionFinished,
+		}, WebhookObj: g.packWebhookObj(enums.WebhookActionFinished)}
+		return fmt.Errorf("acquire gc blob lock failed: %v", err)
+	}
+
+	// the blob pushed or checked by the push recently, or uploaded by the push still in progress should be protected
+	g.protectBefore = time.Now().Add(-g.config.Daemon.Gc.ProtectWindow).UnixMilli()
+	g.recycleBinAfter = time.Now().Add(-g.config.RecycleBin.Retention).UnixMilli()
+	uploadObj, err := g.blobUploadServiceFactory.New().GetOldestActive(g.ctx, g.protectBefore)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		g.runnerChan <- decoratorStatus{Daemon: enums.DaemonGcBlob, Status: enums.TaskCommonStatusFailed, Message: fmt.Sprintf("Get active blob upload failed: %v", err), Ended: true}
+		g.webhookChan <- decoratorWebhook{Meta: types.WebhookPayload{
+			ResourceType: enums.WebhookResourceTypeDaemonTaskGcBlobRunner,
+			Action:       enums.WebhookActionFinished,
+		}, WebhookObj: g.packWebhookObj(enums.WebhookActionFinished)}
+		return fmt.Errorf("get active blob upload failed: %v", err)
+	}
+	if uploadObj != nil && uploadObj.CreatedAt < g.protectBefore {
+		g.protectBefore = uploadObj.CreatedAt
+	}
+
 	blobService := g.blobServiceFactory.New()
 
 	timeTarget := time.Now().UnixMilli()
@@ -137,9 +176,19 @@ func (g gcBlob) Run(runnerID int64) error {
 		}
 		var ids []int64
 		for _, blob := range blobs {
+			if blob.PushedAt >= g.protectBefore || blob.LastCheck >= g.protectBefore {
+				continue
+			}
 			ids = append(ids, blob.ID)
 		}
-		associateBlobIDs, err := blobService.FindAssociateWithArtifact(g.ctx, ids)
+		if len(ids) == 0 {
+			if len(blobs) < pagination {
+				break
+			}
+			curIndex = blobs[len(blobs)-1].ID
+			continue
+		}
+		associateBlobIDs, err := blobService.FindAssociateWithArtifact(g.ctx, ids, g.recycleBinAfter)
 		if err != nil {
 			g.runnerChan <- decoratorStatus{Daemon: enums.DaemonGcBlob, Status: enums.TaskCommonStatusFailed, Message: fmt.Sprintf("Check blob associate with artifact failed: %v", err), Ended: true}
 			g.webhookChan <- decoratorWebhook{Meta: types.WebhookPayload{
@@ -190,8 +239,11 @@ func (g gcBlob) deleteBlob() {
 		defer g.waitAllDone.Done()
 		defer close(g.collectRecordChan)
 		for task := range g.deleteBlobChan {
-			// TODO: we should set a lock for the delete action
-			err := blobService.DeleteByID(g.ctx, task.Blob.ID)
+			if task.Runner.DryRun { // just record the blob would be deleted
+				g.collectRecordChan <- blobTaskCollectRecord{Status: enums.GcRecordStatusPlanned, Blob: task.Blob, Runner: task.Runner}
+				continue
+			}
+			deleted, err := g.deleteBlobWithLock(blobService, task.Blob)
 			if err != nil {
 				log.Error().Err(err).Interface("Task", task).Msgf("Delete blob failed: %v", err)
 				g.collectRecordChan <- blobTaskCollectRecord{
@@ -202,25 +254,49 @@ func (g gcBlob) deleteBlob() {
 				}
 				continue
 			}
-			err = g.storageDriverFactory.New().Delete(g.ctx, utils.GenPathByDigest(digest.Digest(task.Blob.Digest)))
-			if err != nil {
-				log.Error().Err(err).Interface("blob", task).Msgf("Delete blob in obs failed: %v", err)
+			if !deleted {
+				log.Info().Str("digest", task.Blob.Digest).Msg("Blob is referenced or checked by the push again, skip it")
+				continue
 			}
-			// TODO: if we delete the file in obs failed, just ignore the error.
-			// so we should check each file in obs associate with database record.
 			g.collectRecordChan <- blobTaskCollectRecord{Status: enums.GcRecordStatusSuccess, Blob: task.Blob, Runner: task.Runner}
 		}
 	}()
 }
 
+// deleteBlobWithLock deletes the blob record and file with the blob lock held, the references of the blob
+// will be verified again right before deletion, the blob referenced or checked by the push will be skipped.
+func (g gcBlob) deleteBlobWithLock(blobService dao.BlobService, blob models.Blob) (bool, error) {
+	lockCtx, lockCancel := context.WithCancel(g.ctx)
+	defer lockCancel()
+	err := locker.Locker.AcquireWithRenew(lockCtx, consts.LockerBlobPrefix+blob.Digest, time.Second*3, time.Second*5)
+	if err != nil {
+		return false, fmt.Errorf("acquire blob lock failed: %v", err)
+	}
+	err = blobService.DeleteUnreferenced(g.ctx, blob.ID, g.protectBefore, g.recycleBinAfter)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	err = g.storageDriverFactory.New().Delete(g.ctx, path.Join(consts.Blobs, utils.GenPathByDigest(digest.Digest(blob.Digest))))
+	if err != nil {
+		log.Error().Err(err).Str("digest", blob.Digest).Msgf("Delete blob in obs failed: %v", err)
+	}
+	// TODO: if we delete the file in obs failed, just ignore the error.
+	// so we should check each file in obs associate with database record.
+	return true, nil
+}
+
 func (g gcBlob) collectRecord() {
 	daemonService := g.daemonServiceFactory.New()
 	go func() {
 		defer g.waitAllDone.Done()
 		defer func() {
 			g.runnerChan <- decoratorStatus{Daemon: enums.DaemonGcBlob, Status: enums.TaskCommonStatusDoing, Updates: map[string]any{
-				"success_count": g.successCount,
-				"failed_count":  g.failedCount,
+				"success_count":    g.successCount,
+				"failed_count":     g.failedCount,
+				"reclaimable_size": g.reclaimSize,
 			}}
 		}()
 		for task := range g.collectRecordChan {
@@ -229,6 +305,7 @@ func (g gcBlob) collectRecord() {
 					RunnerID: task.Runner.ID,
 					Digest:   task.Blob.Digest,
 					Status:   task.Status,
+					Size:     task.Blob.Size,
 					Message:  []byte(ptr.To(task.Message)),
 				},
 			})
@@ -236,8 +313,9 @@ func (g gcBlob) collectRecord() {
 				log.Error().Err(err).Msg("Create gc blob record failed")
 				continue
 			}
-			if task.Status == enums.GcRecordStatusSuccess {
+			if task.Status == enums.GcRecordStatusSuccess || task.Status == enums.GcRecordStatusPlanned {
 				g.successCount++
+				g.reclaimSize += task.Blob.Size
 			} else {
 				g.failedCount++
 			}
@@ -20,19 +20,23 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"gorm.io/gorm"
 
+	"github.com/go-sigma/sigma/pkg/configs"
 	"github.com/go-sigma/sigma/pkg/dal"
 	"github.com/go-sigma/sigma/pkg/dal/dao"
 	"github.com/go-sigma/sigma/pkg/logger"
 	"github.com/go-sigma/sigma/pkg/storage"
 	storagemocks "github.com/go-sigma/sigma/pkg/storage/mocks"
 	"github.com/go-sigma/sigma/pkg/tests"
+	"github.com/go-sigma/sigma/pkg/types"
 	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
 )
 
 func TestGcBlobNormal(t *testing.T) {
@@ -108,3 +112,135 @@ func TestGcBlobNormal(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, int64(1487), blob2.Size)
 }
+
+func TestGcBlobDryRun(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	sql, err := os.ReadFile(fmt.Sprintf("./testdata/gc_blob_normal.%s.sql", tests.DB.GetName()))
+	assert.NoError(t, err)
+
+	for _, s := range strings.Split(string(sql), ";\n") {
+		s := strings.TrimSpace(s)
+		if len(s) == 0 {
+			continue
+		}
+		err = dal.DB.Debug().Exec(s).Error
+		assert.NoError(t, err)
+	}
+	daemonService := dao.NewDaemonServiceFactory().New()
+	assert.NoError(t, daemonService.UpdateGcBlobRunner(ctx, 1, map[string]any{"dry_run": true}))
+
+	storageDriverFactory := storagemocks.NewMockStorageDriverFactory(ctrl) // nothing should be deleted in the storage
+
+	var runnerChan = make(chan decoratorStatus, 4)
+	var webhookChan = make(chan decoratorWebhook, 4)
+
+	runner := initGc(ctx, enums.DaemonGcBlob, runnerChan, webhookChan, inject{storageDriverFactory: storageDriverFactory})
+	err = runner.Run(1)
+	assert.NoError(t, err)
+
+	for range webhookChan { // nolint: revive
+	}
+	var reclaimableSize any
+	for status := range runnerChan {
+		if size, ok := status.Updates["reclaimable_size"]; ok {
+			reclaimableSize = size
+		}
+	}
+	assert.Equal(t, int64(3333361+1487), reclaimableSize)
+
+	blobService := dao.NewBlobServiceFactory().New()
+	for _, dgest := range []string{
+		"sha256:c6b39de5b33961661dc939b997cc1d30cda01e38005a6c6625fd9c7e748bab44",
+		"sha256:33abbf0321492ff7379e60c252c05c4e7ed4dccf46fcca6c558067c25e76dc8b",
+	} {
+		_, err = blobService.FindByDigest(ctx, dgest)
+		assert.NoError(t, err)
+	}
+
+	recordObjs, total, err := daemonService.ListGcBlobRecords(ctx, 1, types.Pagination{Limit: ptr.Of(10), Page: ptr.Of(1)}, types.Sortable{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	for _, recordObj := range recordObjs {
+		assert.Equal(t, enums.GcRecordStatusPlanned, recordObj.Status)
+		assert.NotZero(t, recordObj.Size)
+	}
+}
+
+func TestGcBlobProtect(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	sql, err := os.ReadFile(fmt.Sprintf("./testdata/gc_blob_normal.%s.sql", tests.DB.GetName()))
+	assert.NoError(t, err)
+
+	for _, s := range strings.Split(string(sql), ";\n") {
+		s := strings.TrimSpace(s)
+		if len(s) == 0 {
+			continue
+		}
+		err = dal.DB.Debug().Exec(s).Error
+		assert.NoError(t, err)
+	}
+
+	config := ptr.To(configs.GetConfiguration())
+	defer configs.SetConfiguration(ptr.Of(config))
+	config.Daemon.Gc.ProtectWindow = time.Hour
+	configs.SetConfiguration(ptr.Of(config))
+
+	// the blob is checked by the push recently, it should not be deleted
+	blobService := dao.NewBlobServiceFactory().New()
+	assert.NoError(t, blobService.Touch(ctx, []string{"sha256:c6b39de5b33961661dc939b997cc1d30cda01e38005a6c6625fd9c7e748bab44"}))
+
+	storageDriver := storagemocks.NewMockStorageDriver(ctrl)
+	storageDriver.EXPECT().Delete(gomock.Any(), "blobs/sha256/33/ab/bf0321492ff7379e60c252c05c4e7ed4dccf46fcca6c558067c25e76dc8b").Return(nil).Times(1)
+
+	storageDriverFactory := storagemocks.NewMockStorageDriverFactory(ctrl)
+	storageDriverFactory.EXPECT().New().Return(storageDriver).Times(1)
+
+	var runnerChan = make(chan decoratorStatus, 4)
+	var webhookChan = make(chan decoratorWebhook, 4)
+
+	runner := initGc(ctx, enums.DaemonGcBlob, runnerChan, webhookChan, inject{storageDriverFactory: storageDriverFactory})
+	err = runner.Run(1)
+	assert.NoError(t, err)
+
+	for range webhookChan { // nolint: revive
+	}
+	var statusArr = make([]string, 0, 10)
+	for status := range runnerChan {
+		statusArr = append(statusArr, string(status.Status))
+	}
+	assert.Equal(t, []string{"Doing", "Doing", "Success"}, statusArr)
+
+	_, err = blobService.FindByDigest(ctx, "sha256:c6b39de5b33961661dc939b997cc1d30cda01e38005a6c6625fd9c7e748bab44")
+	assert.NoError(t, err)
+
+	_, err = blobService.FindByDigest(ctx, "sha256:33abbf0321492ff7379e60c252c05c4e7ed4dccf46fcca6c558067c25e76dc8b")
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
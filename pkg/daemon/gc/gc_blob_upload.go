@@ -0,0 +1,308 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/modules/locker"
+	"github.com/go-sigma/sigma/pkg/modules/workq"
+	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
+	"github.com/go-sigma/sigma/pkg/storage"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+func init() {
+	workq.TopicHandlers[enums.DaemonGcBlobUpload] = definition.Consumer{
+		Handler:     decorator(enums.DaemonGcBlobUpload),
+		MaxRetry:    6,
+		Concurrency: 10,
+		Timeout:     time.Minute * 10,
+	}
+}
+
+type blobUploadTask struct {
+	Runner models.DaemonGcBlobUploadRunner
+	Upload models.BlobUpload
+}
+
+type blobUploadTaskCollectRecord struct {
+	Status  enums.GcRecordStatus
+	Runner  models.DaemonGcBlobUploadRunner
+	Upload  models.BlobUpload
+	Size    int64
+	Message *string
+}
+
+type gcBlobUpload struct {
+	ctx    context.Context
+	config configs.Configuration
+
+	runnerObj *models.DaemonGcBlobUploadRunner
+	// abandonedBefore the upload which has no part uploaded after it is abandoned
+	abandonedBefore int64
+
+	successCount int64
+	failedCount  int64
+	reclaimSize  int64
+
+	blobUploadServiceFactory dao.BlobUploadServiceFactory
+	daemonServiceFactory     dao.DaemonServiceFactory
+	storageDriverFactory     storage.StorageDriverFactory
+
+	deleteUploadChan      chan blobUploadTask
+	deleteUploadChanOnce  *sync.Once
+	collectRecordChan     chan blobUploadTaskCollectRecord
+	collectRecordChanOnce *sync.Once
+
+	runnerChan  chan decoratorStatus
+	webhookChan chan decoratorWebhook
+
+	waitAllDone *sync.WaitGroup
+}
+
+// Run ...
+func (g gcBlobUpload) Run(runnerID int64) error {
+	defer close(g.runnerChan)
+	defer close(g.webhookChan)
+	g.runnerChan <- decoratorStatus{Daemon: enums.DaemonGcBlobUpload, Status: enums.TaskCommonStatusDoing, Started: true}
+
+	var err error
+	g.runnerObj, err = g.daemonServiceFactory.New().GetGcBlobUploadRunner(g.ctx, runnerID)
+	if err != nil {
+		g.runnerChan <- decoratorStatus{
+			Daemon:  enums.DaemonGcBlobUpload,
+			Status:  enums.TaskCommonStatusFailed,
+			Message: fmt.Sprintf("Get gc blob upload runner failed: %v", err),
+			Ended:   true,
+		}
+		return fmt.Errorf("get gc blob upload runner failed: %v", err)
+	}
+
+	g.webhookChan <- decoratorWebhook{Meta: types.WebhookPayload{
+		ResourceType: enums.WebhookResourceTypeDaemonTaskGcBlobUploadRunner,
+		Action:       enums.WebhookActionStarted,
+	}, WebhookObj: g.packWebhookObj(enums.WebhookActionStarted)}
+
+	// only one gc blob upload runner should be running at the same time
+	lockCtx, lockCancel := context.WithCancel(g.ctx)
+	defer lockCancel()
+	err = locker.Locker.AcquireWithRenew(lockCtx, consts.LockerGcBlobUpload, time.Second*3, time.Second*5)
+	if err != nil {
+		g.runnerChan <- decoratorStatus{Daemon: enums.DaemonGcBlobUpload, Status: enums.TaskCommonStatusFailed, Message: fmt.Sprintf("Acquire gc blob upload lock failed: %v", err), Ended: true}
+		g.webhookChan <- decoratorWebhook{Meta: types.WebhookPayload{
+			ResourceType: enums.WebhookResourceTypeDaemonTaskGcBlobUploadRunner,
+			Action:       enums.WebhookActionFinished,
+		}, WebhookObj: g.packWebhookObj(enums.WebhookActionFinished)}
+		return fmt.Errorf("acquire gc blob upload lock failed: %v", err)
+	}
+
+	ttl := g.config.Daemon.Gc.Upload.TTL
+	if g.runnerObj.Rule.RetentionHour > 0 {
+		ttl = time.Duration(g.runnerObj.Rule.RetentionHour) * time.Hour
+	}
+	g.abandonedBefore = time.Now().Add(-ttl).UnixMilli()
+
+	blobUploadService := g.blobUploadServiceFactory.New()
+
+	g.deleteUploadChanOnce.Do(g.deleteUpload)
+	g.collectRecordChanOnce.Do(g.collectRecord)
+	g.waitAllDone.Add(2)
+
+	var curIndex int64
+	for {
+		uploads, err := blobUploadService.FindAbandonedWithCursor(g.ctx, g.abandonedBefore, pagination, curIndex)
+		if err != nil {
+			close(g.deleteUploadChan)
+			g.waitAllDone.Wait()
+			g.runnerChan <- decoratorStatus{
+				Daemon:  enums.DaemonGcBlobUpload,
+				Status:  enums.TaskCommonStatusFailed,
+				Message: fmt.Sprintf("Get abandoned blob upload failed: %v", err),
+				Ended:   true,
+			}
+			g.webhookChan <- decoratorWebhook{Meta: types.WebhookPayload{
+				ResourceType: enums.WebhookResourceTypeDaemonTaskGcBlobUploadRunner,
+				Action:       enums.WebhookActionFinished,
+			}, WebhookObj: g.packWebhookObj(enums.WebhookActionFinished)}
+			return fmt.Errorf("get abandoned blob upload failed: %v", err)
+		}
+		for _, upload := range uploads {
+			g.deleteUploadChan <- blobUploadTask{Runner: ptr.To(g.runnerObj), Upload: ptr.To(upload)}
+		}
+		if len(uploads) < pagination {
+			break
+		}
+		curIndex = uploads[len(uploads)-1].ID
+	}
+	close(g.deleteUploadChan)
+	g.waitAllDone.Wait()
+
+	g.runnerChan <- decoratorStatus{Daemon: enums.DaemonGcBlobUpload, Status: enums.TaskCommonStatusSuccess, Ended: true}
+	g.webhookChan <- decoratorWebhook{Meta: types.WebhookPayload{
+		ResourceType: enums.WebhookResourceTypeDaemonTaskGcBlobUploadRunner,
+		Action:       enums.WebhookActionFinished,
+	}, WebhookObj: g.packWebhookObj(enums.WebhookActionFinished)}
+
+	return nil
+}
+
+func (g gcBlobUpload) deleteUpload() {
+	blobUploadService := g.blobUploadServiceFactory.New()
+	go func() {
+		defer g.waitAllDone.Done()
+		defer close(g.collectRecordChan)
+		for task := range g.deleteUploadChan {
+			// the upload may be resumed after it was found, check it again right before deletion
+			lastPart, err := blobUploadService.GetLastPart(g.ctx, task.Upload.UploadID)
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					continue
+				}
+				g.collectRecordChan <- blobUploadTaskCollectRecord{
+					Status:  enums.GcRecordStatusFailed,
+					Upload:  task.Upload,
+					Runner:  task.Runner,
+					Message: ptr.Of(fmt.Sprintf("Get last part of blob upload failed: %v", err)),
+				}
+				continue
+			}
+			if lastPart.UpdatedAt >= g.abandonedBefore {
+				log.Info().Str("uploadID", task.Upload.UploadID).Msg("Blob upload is resumed, skip it")
+				continue
+			}
+			size, err := blobUploadService.TotalSizeByUploadID(g.ctx, task.Upload.UploadID)
+			if err != nil {
+				g.collectRecordChan <- blobUploadTaskCollectRecord{
+					Status:  enums.GcRecordStatusFailed,
+					Upload:  task.Upload,
+					Runner:  task.Runner,
+					Message: ptr.Of(fmt.Sprintf("Get size of blob upload failed: %v", err)),
+				}
+				continue
+			}
+			if task.Runner.DryRun { // just record the upload would be deleted
+				g.collectRecordChan <- blobUploadTaskCollectRecord{Status: enums.GcRecordStatusPlanned, Upload: task.Upload, Runner: task.Runner, Size: size}
+				continue
+			}
+			err = g.deleteUploadObjects(blobUploadService, task.Upload)
+			if err != nil {
+				log.Error().Err(err).Interface("Task", task).Msgf("Delete blob upload failed: %v", err)
+				g.collectRecordChan <- blobUploadTaskCollectRecord{
+					Status:  enums.GcRecordStatusFailed,
+					Upload:  task.Upload,
+					Runner:  task.Runner,
+					Size:    size,
+					Message: ptr.Of(fmt.Sprintf("Delete blob upload failed: %v", err)),
+				}
+				continue
+			}
+			g.collectRecordChan <- blobUploadTaskCollectRecord{Status: enums.GcRecordStatusSuccess, Upload: task.Upload, Runner: task.Runner, Size: size}
+		}
+	}()
+}
+
+// deleteUploadObjects aborts the multipart upload, deletes the partial objects and the upload records
+func (g gcBlobUpload) deleteUploadObjects(blobUploadService dao.BlobUploadService, upload models.BlobUpload) error {
+	storageDriver := g.storageDriverFactory.New()
+	uploadPath := fmt.Sprintf("%s/%s", consts.BlobUploads, upload.FileID)
+	err := storageDriver.AbortUpload(g.ctx, uploadPath, upload.UploadID)
+	if err != nil {
+		// the upload may be already aborted or expired by the storage, the partial objects still should be deleted
+		log.Warn().Err(err).Str("uploadID", upload.UploadID).Msg("Abort blob upload failed")
+	}
+	err = storageDriver.Delete(g.ctx, uploadPath)
+	if err != nil {
+		return fmt.Errorf("delete partial objects failed: %v", err)
+	}
+	err = blobUploadService.DeleteByUploadID(g.ctx, upload.UploadID)
+	if err != nil {
+		return fmt.Errorf("delete blob upload records failed: %v", err)
+	}
+	return nil
+}
+
+func (g gcBlobUpload) collectRecord() {
+	daemonService := g.daemonServiceFactory.New()
+	go func() {
+		defer g.waitAllDone.Done()
+		defer func() {
+			g.runnerChan <- decoratorStatus{Daemon: enums.DaemonGcBlobUpload, Status: enums.TaskCommonStatusDoing, Updates: map[string]any{
+				"success_count":    g.successCount,
+				"failed_count":     g.failedCount,
+				"reclaimable_size": g.reclaimSize,
+			}}
+		}()
+		for task := range g.collectRecordChan {
+			err := daemonService.CreateGcBlobUploadRecords(g.ctx, []*models.DaemonGcBlobUploadRecord{
+				{
+					RunnerID:   task.Runner.ID,
+					UploadID:   task.Upload.UploadID,
+					Repository: task.Upload.Repository,
+					Status:     task.Status,
+					Size:       task.Size,
+					Message:    []byte(ptr.To(task.Message)),
+				},
+			})
+			if err != nil {
+				log.Error().Err(err).Msg("Create gc blob upload record failed")
+				continue
+			}
+			if task.Status == enums.GcRecordStatusSuccess || task.Status == enums.GcRecordStatusPlanned {
+				g.successCount++
+				g.reclaimSize += task.Size
+			} else {
+				g.failedCount++
+			}
+		}
+	}()
+}
+
+func (g gcBlobUpload) packWebhookObj(action enums.WebhookAction) types.WebhookPayloadGcBlobUpload {
+	payload := types.WebhookPayloadGcBlobUpload{
+		WebhookPayload: types.WebhookPayload{
+			ResourceType: enums.WebhookResourceTypeDaemonTaskGcBlobUploadRunner,
+			Action:       action,
+		},
+		OperateType:  g.runnerObj.OperateType,
+		SuccessCount: g.successCount,
+		FailedCount:  g.failedCount,
+	}
+	if g.runnerObj.OperateType == enums.OperateTypeManual && g.runnerObj.OperateUser != nil {
+		payload.OperateUser = &types.WebhookPayloadUser{
+			ID:        g.runnerObj.OperateUser.ID,
+			Username:  g.runnerObj.OperateUser.Username,
+			Email:     ptr.To(g.runnerObj.OperateUser.Email),
+			Status:    g.runnerObj.OperateUser.Status,
+			LastLogin: time.Unix(0, int64(time.Millisecond)*g.runnerObj.OperateUser.LastLogin).UTC().Format(consts.DefaultTimePattern),
+			CreatedAt: time.Unix(0, int64(time.Millisecond)*g.runnerObj.OperateUser.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+			UpdatedAt: time.Unix(0, int64(time.Millisecond)*g.runnerObj.OperateUser.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		}
+	}
+	return payload
+}
@@ -0,0 +1,171 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/logger"
+	"github.com/go-sigma/sigma/pkg/storage"
+	storagemocks "github.com/go-sigma/sigma/pkg/storage/mocks"
+	"github.com/go-sigma/sigma/pkg/tests"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+func initGcBlobUploadData(ctx context.Context, t *testing.T, dryRun bool) {
+	daemonService := dao.NewDaemonServiceFactory().New()
+	ruleObj := &models.DaemonGcBlobUploadRule{RetentionHour: 24}
+	assert.NoError(t, daemonService.CreateGcBlobUploadRule(ctx, ruleObj))
+	runnerObj := &models.DaemonGcBlobUploadRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending,
+		OperateType: enums.OperateTypeAutomatic, DryRun: dryRun}
+	assert.NoError(t, daemonService.CreateGcBlobUploadRunner(ctx, runnerObj))
+
+	blobUploadService := dao.NewBlobUploadServiceFactory().New()
+	abandoned := time.Now().Add(-time.Hour * 48).UnixMilli()
+	for _, upload := range []*models.BlobUpload{
+		{PartNumber: 0, UploadID: "abandoned", Etag: "fake", Repository: "library/busybox", FileID: "abandoned", CreatedAt: abandoned, UpdatedAt: abandoned},
+		{PartNumber: 1, UploadID: "abandoned", Etag: "etag1", Repository: "library/busybox", FileID: "abandoned", Size: 100, CreatedAt: abandoned, UpdatedAt: abandoned},
+		{PartNumber: 2, UploadID: "abandoned", Etag: "etag2", Repository: "library/busybox", FileID: "abandoned", Size: 50, CreatedAt: abandoned, UpdatedAt: abandoned},
+		{PartNumber: 0, UploadID: "resumed", Etag: "fake", Repository: "library/alpine", FileID: "resumed", CreatedAt: abandoned, UpdatedAt: abandoned},
+		{PartNumber: 1, UploadID: "resumed", Etag: "etag1", Repository: "library/alpine", FileID: "resumed", Size: 100},
+	} {
+		assert.NoError(t, blobUploadService.Create(ctx, upload))
+	}
+}
+
+func TestGcBlobUploadNormal(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	initGcBlobUploadData(ctx, t, false)
+
+	uploadPath := fmt.Sprintf("%s/%s", consts.BlobUploads, "abandoned")
+	storageDriver := storagemocks.NewMockStorageDriver(ctrl)
+	storageDriver.EXPECT().AbortUpload(gomock.Any(), uploadPath, "abandoned").Return(nil).Times(1)
+	storageDriver.EXPECT().Delete(gomock.Any(), uploadPath).Return(nil).Times(1)
+
+	storageDriverFactory := storagemocks.NewMockStorageDriverFactory(ctrl)
+	storageDriverFactory.EXPECT().New().DoAndReturn(func() storage.StorageDriver {
+		return storageDriver
+	}).Times(1)
+
+	var runnerChan = make(chan decoratorStatus, 4)
+	var webhookChan = make(chan decoratorWebhook, 4)
+
+	runner := initGc(ctx, enums.DaemonGcBlobUpload, runnerChan, webhookChan, inject{storageDriverFactory: storageDriverFactory})
+	err := runner.Run(1)
+	assert.NoError(t, err)
+
+	var webhookArr = make([]string, 0, 10)
+	for status := range webhookChan {
+		webhookArr = append(webhookArr, string(status.Meta.Action))
+	}
+	assert.Equal(t, []string{"Started", "Finished"}, webhookArr)
+
+	var statusArr = make([]string, 0, 10)
+	var reclaimableSize any
+	for status := range runnerChan {
+		statusArr = append(statusArr, string(status.Status))
+		if size, ok := status.Updates["reclaimable_size"]; ok {
+			reclaimableSize = size
+		}
+	}
+	assert.Equal(t, []string{"Doing", "Doing", "Success"}, statusArr)
+	assert.Equal(t, int64(150), reclaimableSize)
+
+	blobUploadService := dao.NewBlobUploadServiceFactory().New()
+	uploads, err := blobUploadService.FindAllByUploadID(ctx, "abandoned")
+	assert.NoError(t, err)
+	assert.Len(t, uploads, 0)
+	uploads, err = blobUploadService.FindAllByUploadID(ctx, "resumed")
+	assert.NoError(t, err)
+	assert.Len(t, uploads, 2)
+
+	recordObjs, total, err := dao.NewDaemonServiceFactory().New().ListGcBlobUploadRecords(ctx, 1, types.Pagination{Limit: ptr.Of(10), Page: ptr.Of(1)}, types.Sortable{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, "abandoned", recordObjs[0].UploadID)
+	assert.Equal(t, "library/busybox", recordObjs[0].Repository)
+	assert.Equal(t, enums.GcRecordStatusSuccess, recordObjs[0].Status)
+	assert.Equal(t, int64(150), recordObjs[0].Size)
+}
+
+func TestGcBlobUploadDryRun(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	initGcBlobUploadData(ctx, t, true)
+
+	storageDriverFactory := storagemocks.NewMockStorageDriverFactory(ctrl) // nothing should be deleted in the storage
+
+	var runnerChan = make(chan decoratorStatus, 4)
+	var webhookChan = make(chan decoratorWebhook, 4)
+
+	runner := initGc(ctx, enums.DaemonGcBlobUpload, runnerChan, webhookChan, inject{storageDriverFactory: storageDriverFactory})
+	err := runner.Run(1)
+	assert.NoError(t, err)
+
+	for range webhookChan { // nolint: revive
+	}
+	for range runnerChan { // nolint: revive
+	}
+
+	uploads, err := dao.NewBlobUploadServiceFactory().New().FindAllByUploadID(ctx, "abandoned")
+	assert.NoError(t, err)
+	assert.Len(t, uploads, 3)
+
+	recordObjs, total, err := dao.NewDaemonServiceFactory().New().ListGcBlobUploadRecords(ctx, 1, types.Pagination{Limit: ptr.Of(10), Page: ptr.Of(1)}, types.Sortable{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, enums.GcRecordStatusPlanned, recordObjs[0].Status)
+	assert.Equal(t, int64(150), recordObjs[0].Size)
+}
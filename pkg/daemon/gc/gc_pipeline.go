@@ -0,0 +1,389 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/modules/workq"
+	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+func init() {
+	workq.TopicHandlers[enums.DaemonGc] = definition.Consumer{
+		Handler:     decorator(enums.DaemonGc),
+		MaxRetry:    1,
+		Concurrency: 1,
+		Timeout:     time.Hour * 6,
+	}
+}
+
+// gcPipelineStages the stages of the gc pipeline, the order matters:
+// the deleted repositories and tags make the artifacts untagged, and the deleted artifacts make the blobs unreferenced,
+// the abandoned uploads are cleaned before the blobs, so they don't hold the blob protect window any more
+var gcPipelineStages = []enums.Daemon{enums.DaemonGcRepository, enums.DaemonGcTag, enums.DaemonGcArtifact, enums.DaemonGcBlobUpload, enums.DaemonGcBlob}
+
+type gcPipeline struct {
+	ctx    context.Context
+	config configs.Configuration
+
+	runnerObj *models.DaemonGcPipelineRunner
+
+	daemonServiceFactory    dao.DaemonServiceFactory
+	namespaceServiceFactory dao.NamespaceServiceFactory
+
+	// stageInject is passed to the stage runners, the stage webhooks are skipped,
+	// only the gc pipeline webhook is sent
+	stageInject inject
+
+	runnerChan  chan decoratorStatus
+	webhookChan chan decoratorWebhook
+}
+
+// Run ...
+func (g gcPipeline) Run(runnerID int64) error {
+	defer close(g.runnerChan)
+	defer close(g.webhookChan)
+	g.runnerChan <- decoratorStatus{Daemon: enums.DaemonGc, Status: enums.TaskCommonStatusDoing, Started: true}
+
+	daemonService := g.daemonServiceFactory.New()
+
+	var err error
+	g.runnerObj, err = daemonService.GetGcPipelineRunner(g.ctx, runnerID)
+	if err != nil {
+		g.runnerChan <- decoratorStatus{
+			Daemon:  enums.DaemonGc,
+			Status:  enums.TaskCommonStatusFailed,
+			Message: fmt.Sprintf("Get gc pipeline runner failed: %v", err),
+			Ended:   true,
+		}
+		return fmt.Errorf("get gc pipeline runner failed: %v", err)
+	}
+
+	g.webhookChan <- decoratorWebhook{Meta: types.WebhookPayload{
+		ResourceType: enums.WebhookResourceTypeDaemonTaskGcPipelineRunner,
+		Action:       enums.WebhookActionStarted,
+	}, WebhookObj: g.packWebhookObj(enums.WebhookActionStarted, enums.TaskCommonStatusDoing, "", nil)}
+
+	var stageRunnerIDs = make(map[enums.Daemon]int64, len(gcPipelineStages))
+	for _, stage := range gcPipelineStages {
+		runnerID, err := g.runStage(stage)
+		if err != nil {
+			log.Error().Err(err).Str("stage", stage.String()).Int64("runnerID", g.runnerObj.ID).Msg("Gc pipeline stage failed")
+			message := fmt.Sprintf("Gc pipeline stage %s failed: %v", stage.String(), err)
+			g.runnerChan <- decoratorStatus{Daemon: enums.DaemonGc, Status: enums.TaskCommonStatusFailed, Message: message, Ended: true}
+			g.webhookChan <- decoratorWebhook{Meta: types.WebhookPayload{
+				ResourceType: enums.WebhookResourceTypeDaemonTaskGcPipelineRunner,
+				Action:       enums.WebhookActionFinished,
+			}, WebhookObj: g.packWebhookObj(enums.WebhookActionFinished, enums.TaskCommonStatusFailed, message, nil)}
+			return fmt.Errorf("gc pipeline stage %s failed: %v", stage.String(), err)
+		}
+		if runnerID != 0 {
+			stageRunnerIDs[stage] = runnerID
+		}
+	}
+
+	report, err := g.report(stageRunnerIDs)
+	if err != nil {
+		log.Error().Err(err).Int64("runnerID", g.runnerObj.ID).Msg("Summary gc pipeline report failed")
+		message := fmt.Sprintf("Summary gc pipeline report failed: %v", err)
+		g.runnerChan <- decoratorStatus{Daemon: enums.DaemonGc, Status: enums.TaskCommonStatusFailed, Message: message, Ended: true}
+		g.webhookChan <- decoratorWebhook{Meta: types.WebhookPayload{
+			ResourceType: enums.WebhookResourceTypeDaemonTaskGcPipelineRunner,
+			Action:       enums.WebhookActionFinished,
+		}, WebhookObj: g.packWebhookObj(enums.WebhookActionFinished, enums.TaskCommonStatusFailed, message, nil)}
+		return fmt.Errorf("summary gc pipeline report failed: %v", err)
+	}
+
+	g.runnerChan <- decoratorStatus{
+		Daemon:  enums.DaemonGc,
+		Status:  enums.TaskCommonStatusSuccess,
+		Ended:   true,
+		Updates: map[string]any{"report": utils.MustMarshal(report)},
+	}
+	g.webhookChan <- decoratorWebhook{Meta: types.WebhookPayload{
+		ResourceType: enums.WebhookResourceTypeDaemonTaskGcPipelineRunner,
+		Action:       enums.WebhookActionFinished,
+	}, WebhookObj: g.packWebhookObj(enums.WebhookActionFinished, enums.TaskCommonStatusSuccess, "", report)}
+
+	return nil
+}
+
+// runStage creates the stage runner with the global rule and runs it, the stage is skipped if the global rule is not exist,
+// returns the stage runner id, it's zero if the stage is skipped
+func (g gcPipeline) runStage(stage enums.Daemon) (int64, error) {
+	daemonService := g.daemonServiceFactory.New()
+
+	var runnerID int64
+	var runnerIDColumn string
+	switch stage {
+	case enums.DaemonGcRepository:
+		ruleObj, err := daemonService.GetGcRepositoryRule(g.ctx, nil)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return 0, nil
+			}
+			return 0, fmt.Errorf("get gc repository rule failed: %v", err)
+		}
+		runnerObj := &models.DaemonGcRepositoryRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending,
+			OperateType: g.runnerObj.OperateType, OperateUserID: g.runnerObj.OperateUserID}
+		err = daemonService.CreateGcRepositoryRunner(g.ctx, runnerObj)
+		if err != nil {
+			return 0, fmt.Errorf("create gc repository runner failed: %v", err)
+		}
+		runnerID, runnerIDColumn = runnerObj.ID, "repository_runner_id"
+	case enums.DaemonGcTag:
+		ruleObj, err := daemonService.GetGcTagRule(g.ctx, nil)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return 0, nil
+			}
+			return 0, fmt.Errorf("get gc tag rule failed: %v", err)
+		}
+		runnerObj := &models.DaemonGcTagRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending,
+			OperateType: g.runnerObj.OperateType, OperateUserID: g.runnerObj.OperateUserID}
+		err = daemonService.CreateGcTagRunner(g.ctx, runnerObj)
+		if err != nil {
+			return 0, fmt.Errorf("create gc tag runner failed: %v", err)
+		}
+		runnerID, runnerIDColumn = runnerObj.ID, "tag_runner_id"
+	case enums.DaemonGcArtifact:
+		ruleObj, err := daemonService.GetGcArtifactRule(g.ctx, nil)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return 0, nil
+			}
+			return 0, fmt.Errorf("get gc artifact rule failed: %v", err)
+		}
+		runnerObj := &models.DaemonGcArtifactRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending,
+			OperateType: g.runnerObj.OperateType, OperateUserID: g.runnerObj.OperateUserID}
+		err = daemonService.CreateGcArtifactRunner(g.ctx, runnerObj)
+		if err != nil {
+			return 0, fmt.Errorf("create gc artifact runner failed: %v", err)
+		}
+		runnerID, runnerIDColumn = runnerObj.ID, "artifact_runner_id"
+	case enums.DaemonGcBlobUpload:
+		ruleObj, err := daemonService.GetGcBlobUploadRule(g.ctx)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return 0, nil
+			}
+			return 0, fmt.Errorf("get gc blob upload rule failed: %v", err)
+		}
+		runnerObj := &models.DaemonGcBlobUploadRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending,
+			OperateType: g.runnerObj.OperateType, OperateUserID: g.runnerObj.OperateUserID}
+		err = daemonService.CreateGcBlobUploadRunner(g.ctx, runnerObj)
+		if err != nil {
+			return 0, fmt.Errorf("create gc blob upload runner failed: %v", err)
+		}
+		runnerID, runnerIDColumn = runnerObj.ID, "blob_upload_runner_id"
+	case enums.DaemonGcBlob:
+		ruleObj, err := daemonService.GetGcBlobRule(g.ctx)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return 0, nil
+			}
+			return 0, fmt.Errorf("get gc blob rule failed: %v", err)
+		}
+		runnerObj := &models.DaemonGcBlobRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending,
+			OperateType: g.runnerObj.OperateType, OperateUserID: g.runnerObj.OperateUserID}
+		err = daemonService.CreateGcBlobRunner(g.ctx, runnerObj)
+		if err != nil {
+			return 0, fmt.Errorf("create gc blob runner failed: %v", err)
+		}
+		runnerID, runnerIDColumn = runnerObj.ID, "blob_runner_id"
+	default:
+		return 0, fmt.Errorf("gc pipeline stage %s not support", stage.String())
+	}
+
+	g.runnerChan <- decoratorStatus{Daemon: enums.DaemonGc, Status: enums.TaskCommonStatusDoing, Updates: map[string]any{
+		"stage":        stage,
+		runnerIDColumn: runnerID,
+	}}
+
+	err := decorator(stage, g.stageInject)(g.ctx, utils.MustMarshal(map[string]int64{"runner_id": runnerID}))
+	if err != nil {
+		return 0, err
+	}
+
+	var status enums.TaskCommonStatus
+	var message []byte
+	switch stage {
+	case enums.DaemonGcRepository:
+		runnerObj, err := daemonService.GetGcRepositoryRunner(g.ctx, runnerID)
+		if err != nil {
+			return 0, fmt.Errorf("get gc repository runner failed: %v", err)
+		}
+		status, message = runnerObj.Status, runnerObj.Message
+	case enums.DaemonGcTag:
+		runnerObj, err := daemonService.GetGcTagRunner(g.ctx, runnerID)
+		if err != nil {
+			return 0, fmt.Errorf("get gc tag runner failed: %v", err)
+		}
+		status, message = runnerObj.Status, runnerObj.Message
+	case enums.DaemonGcArtifact:
+		runnerObj, err := daemonService.GetGcArtifactRunner(g.ctx, runnerID)
+		if err != nil {
+			return 0, fmt.Errorf("get gc artifact runner failed: %v", err)
+		}
+		status, message = runnerObj.Status, runnerObj.Message
+	case enums.DaemonGcBlobUpload:
+		runnerObj, err := daemonService.GetGcBlobUploadRunner(g.ctx, runnerID)
+		if err != nil {
+			return 0, fmt.Errorf("get gc blob upload runner failed: %v", err)
+		}
+		status, message = runnerObj.Status, runnerObj.Message
+	case enums.DaemonGcBlob:
+		runnerObj, err := daemonService.GetGcBlobRunner(g.ctx, runnerID)
+		if err != nil {
+			return 0, fmt.Errorf("get gc blob runner failed: %v", err)
+		}
+		status, message = runnerObj.Status, runnerObj.Message
+	}
+	if status != enums.TaskCommonStatusSuccess {
+		return 0, fmt.Errorf("stage runner(%d) status is %s: %s", runnerID, status.String(), string(message))
+	}
+
+	return runnerID, nil
+}
+
+// report summaries the succeed records of the stage runners by namespace,
+// the blobs are only freed by the blob stage, so the namespace sizes are reclaimable sizes
+func (g gcPipeline) report(stageRunnerIDs map[enums.Daemon]int64) (*types.GcPipelineReport, error) {
+	daemonService := g.daemonServiceFactory.New()
+	namespaceService := g.namespaceServiceFactory.New()
+
+	var report = &types.GcPipelineReport{Namespaces: make([]types.GcPipelineReportNamespace, 0)}
+	var namespaces = make(map[int64]*types.GcPipelineReportNamespace)
+	var namespaceReport = func(namespaceID int64) (*types.GcPipelineReportNamespace, error) {
+		item, ok := namespaces[namespaceID]
+		if ok {
+			return item, nil
+		}
+		item = &types.GcPipelineReportNamespace{NamespaceID: namespaceID}
+		namespaceObj, err := namespaceService.Get(g.ctx, namespaceID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("get namespace(%d) failed: %v", namespaceID, err)
+		}
+		if namespaceObj != nil {
+			item.Namespace = namespaceObj.Name
+		}
+		namespaces[namespaceID] = item
+		return item, nil
+	}
+
+	for _, stage := range []enums.Daemon{enums.DaemonGcRepository, enums.DaemonGcTag, enums.DaemonGcArtifact} {
+		runnerID, ok := stageRunnerIDs[stage]
+		if !ok {
+			continue
+		}
+		var summaries []dao.GcRecordSummary
+		var err error
+		switch stage {
+		case enums.DaemonGcRepository:
+			summaries, err = daemonService.SummaryGcRepositoryRecords(g.ctx, runnerID)
+		case enums.DaemonGcTag:
+			summaries, err = daemonService.SummaryGcTagRecords(g.ctx, runnerID)
+		case enums.DaemonGcArtifact:
+			summaries, err = daemonService.SummaryGcArtifactRecords(g.ctx, runnerID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("summary %s records failed: %v", stage.String(), err)
+		}
+		for _, summary := range summaries {
+			if summary.NamespaceID == nil { // the records created before the namespace is recorded
+				continue
+			}
+			item, err := namespaceReport(ptr.To(summary.NamespaceID))
+			if err != nil {
+				return nil, err
+			}
+			reportStage := types.GcPipelineReportNamespaceStage{Count: summary.Count, ReclaimableSize: summary.Size}
+			switch stage {
+			case enums.DaemonGcRepository:
+				item.Repository = reportStage
+			case enums.DaemonGcTag:
+				item.Tag = reportStage
+			case enums.DaemonGcArtifact:
+				item.Artifact = reportStage
+			}
+		}
+	}
+	for _, item := range namespaces {
+		report.Namespaces = append(report.Namespaces, ptr.To(item))
+	}
+	sort.Slice(report.Namespaces, func(i, j int) bool {
+		return report.Namespaces[i].NamespaceID < report.Namespaces[j].NamespaceID
+	})
+
+	runnerID, ok := stageRunnerIDs[enums.DaemonGcBlobUpload]
+	if ok {
+		summary, err := daemonService.SummaryGcBlobUploadRecords(g.ctx, runnerID)
+		if err != nil {
+			return nil, fmt.Errorf("summary %s records failed: %v", enums.DaemonGcBlobUpload.String(), err)
+		}
+		report.BlobUpload = types.GcPipelineReportStage{Count: summary.Count, Size: summary.Size}
+	}
+	runnerID, ok = stageRunnerIDs[enums.DaemonGcBlob]
+	if ok {
+		summary, err := daemonService.SummaryGcBlobRecords(g.ctx, runnerID)
+		if err != nil {
+			return nil, fmt.Errorf("summary %s records failed: %v", enums.DaemonGcBlob.String(), err)
+		}
+		report.Blob = types.GcPipelineReportStage{Count: summary.Count, Size: summary.Size}
+	}
+
+	return report, nil
+}
+
+func (g gcPipeline) packWebhookObj(action enums.WebhookAction, status enums.TaskCommonStatus, message string, report *types.GcPipelineReport) types.WebhookPayloadGcPipeline {
+	payload := types.WebhookPayloadGcPipeline{
+		WebhookPayload: types.WebhookPayload{
+			ResourceType: enums.WebhookResourceTypeDaemonTaskGcPipelineRunner,
+			Action:       action,
+		},
+		OperateType: g.runnerObj.OperateType,
+		Status:      status,
+		Message:     message,
+		Report:      report,
+	}
+	if g.runnerObj.OperateType == enums.OperateTypeManual && g.runnerObj.OperateUser != nil {
+		payload.OperateUser = &types.WebhookPayloadUser{
+			ID:        g.runnerObj.OperateUser.ID,
+			Username:  g.runnerObj.OperateUser.Username,
+			Email:     ptr.To(g.runnerObj.OperateUser.Email),
+			Status:    g.runnerObj.OperateUser.Status,
+			LastLogin: time.Unix(0, int64(time.Millisecond)*g.runnerObj.OperateUser.LastLogin).UTC().Format(consts.DefaultTimePattern),
+			CreatedAt: time.Unix(0, int64(time.Millisecond)*g.runnerObj.OperateUser.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+			UpdatedAt: time.Unix(0, int64(time.Millisecond)*g.runnerObj.OperateUser.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		}
+	}
+	return payload
+}
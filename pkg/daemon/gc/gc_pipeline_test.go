@@ -0,0 +1,170 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/dal"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/logger"
+	"github.com/go-sigma/sigma/pkg/storage"
+	storagemocks "github.com/go-sigma/sigma/pkg/storage/mocks"
+	"github.com/go-sigma/sigma/pkg/tests"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+)
+
+func TestGcPipelineNormal(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	sql, err := os.ReadFile(fmt.Sprintf("./testdata/gc_blob_normal.%s.sql", tests.DB.GetName()))
+	assert.NoError(t, err)
+
+	for _, s := range strings.Split(string(sql), ";\n") {
+		s := strings.TrimSpace(s)
+		if len(s) == 0 {
+			continue
+		}
+		err = dal.DB.Debug().Exec(s).Error
+		assert.NoError(t, err)
+	}
+
+	daemonService := dao.NewDaemonServiceFactory().New()
+	assert.NoError(t, daemonService.CreateGcBlobUploadRule(ctx, &models.DaemonGcBlobUploadRule{RetentionHour: 24}))
+	ruleObj := &models.DaemonGcPipelineRule{}
+	assert.NoError(t, daemonService.CreateGcPipelineRule(ctx, ruleObj))
+	runnerObj := &models.DaemonGcPipelineRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending, OperateType: enums.OperateTypeAutomatic}
+	assert.NoError(t, daemonService.CreateGcPipelineRunner(ctx, runnerObj))
+
+	storageDriver := storagemocks.NewMockStorageDriver(ctrl)
+	storageDriver.EXPECT().Delete(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ string) error {
+		return nil
+	}).Times(2)
+
+	storageDriverFactory := storagemocks.NewMockStorageDriverFactory(ctrl)
+	storageDriverFactory.EXPECT().New().DoAndReturn(func() storage.StorageDriver {
+		return storageDriver
+	}).AnyTimes()
+
+	var runnerChan = make(chan decoratorStatus, 10)
+	var webhookChan = make(chan decoratorWebhook, 10)
+
+	runner := initGc(ctx, enums.DaemonGc, runnerChan, webhookChan, inject{storageDriverFactory: storageDriverFactory})
+	err = runner.Run(runnerObj.ID)
+	assert.NoError(t, err)
+
+	var webhookArr = make([]string, 0, 10)
+	var report *types.GcPipelineReport
+	for webhook := range webhookChan {
+		assert.Equal(t, enums.WebhookResourceTypeDaemonTaskGcPipelineRunner, webhook.Meta.ResourceType)
+		webhookArr = append(webhookArr, string(webhook.Meta.Action))
+		payload, ok := webhook.WebhookObj.(types.WebhookPayloadGcPipeline)
+		assert.True(t, ok)
+		if payload.Report != nil {
+			report = payload.Report
+		}
+	}
+	assert.Equal(t, []string{"Started", "Finished"}, webhookArr) // the webhooks of the stages are skipped
+
+	var statusArr = make([]string, 0, 10)
+	var blobUploadRunnerID, blobRunnerID any
+	for status := range runnerChan {
+		statusArr = append(statusArr, string(status.Status))
+		if id, ok := status.Updates["blob_upload_runner_id"]; ok {
+			blobUploadRunnerID = id
+		}
+		if id, ok := status.Updates["blob_runner_id"]; ok {
+			blobRunnerID = id
+		}
+	}
+	assert.Equal(t, []string{"Doing", "Doing", "Doing", "Success"}, statusArr) // only the blob upload and blob stages have the rule
+	assert.NotNil(t, blobUploadRunnerID)
+	assert.NotNil(t, blobRunnerID)
+
+	assert.NotNil(t, report)
+	assert.Equal(t, 0, len(report.Namespaces))
+	assert.Equal(t, int64(0), report.BlobUpload.Count)
+	assert.Equal(t, int64(2), report.Blob.Count)
+	assert.Equal(t, int64(3333361+1487), report.Blob.Size)
+
+	blobService := dao.NewBlobServiceFactory().New()
+	_, err = blobService.FindByDigest(ctx, "sha256:c6b39de5b33961661dc939b997cc1d30cda01e38005a6c6625fd9c7e748bab44")
+	assert.Equal(t, gorm.ErrRecordNotFound, err)
+}
+
+func TestGcPipelineSkipStage(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	daemonService := dao.NewDaemonServiceFactory().New()
+	ruleObj := &models.DaemonGcPipelineRule{}
+	assert.NoError(t, daemonService.CreateGcPipelineRule(ctx, ruleObj))
+	runnerObj := &models.DaemonGcPipelineRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending, OperateType: enums.OperateTypeAutomatic}
+	assert.NoError(t, daemonService.CreateGcPipelineRunner(ctx, runnerObj))
+
+	var runnerChan = make(chan decoratorStatus, 10)
+	var webhookChan = make(chan decoratorWebhook, 10)
+
+	runner := initGc(ctx, enums.DaemonGc, runnerChan, webhookChan)
+	err := runner.Run(runnerObj.ID)
+	assert.NoError(t, err)
+
+	for range webhookChan { // nolint: revive
+	}
+	var statusArr = make([]string, 0, 10)
+	for status := range runnerChan {
+		statusArr = append(statusArr, string(status.Status))
+	}
+	assert.Equal(t, []string{"Doing", "Success"}, statusArr) // all of the stages are skipped without the rule
+
+	// the rules of the skipped stages are not created
+	_, err = daemonService.GetGcBlobRule(ctx)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	_, err = daemonService.GetGcBlobUploadRule(ctx)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
@@ -0,0 +1,119 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+)
+
+// reclaimCounter counts the size of the blobs which would become unreferenced after the tags, the artifacts and
+// the repositories are deleted by the runner, every blob is counted once. The size is counted before the object
+// is deleted, so the dry run and the real run report the same size. It is not safe for concurrent use.
+type reclaimCounter struct {
+	blobServiceFactory dao.BlobServiceFactory
+	tagServiceFactory  dao.TagServiceFactory
+
+	tags         map[int64]struct{} // the tags deleted or planned to be deleted
+	artifacts    map[int64]struct{} // the artifacts deleted or planned to be deleted
+	repositories map[int64]struct{} // the repositories deleted or planned to be deleted
+	blobs        map[int64]struct{} // the blobs have been counted
+}
+
+func newReclaimCounter(blobServiceFactory dao.BlobServiceFactory, tagServiceFactory dao.TagServiceFactory) *reclaimCounter {
+	return &reclaimCounter{
+		blobServiceFactory: blobServiceFactory,
+		tagServiceFactory:  tagServiceFactory,
+		tags:               make(map[int64]struct{}),
+		artifacts:          make(map[int64]struct{}),
+		repositories:       make(map[int64]struct{}),
+		blobs:              make(map[int64]struct{}),
+	}
+}
+
+// tag returns the size reclaimed by deleting the tag, the artifact is reclaimed after all of its tags are deleted
+func (c *reclaimCounter) tag(ctx context.Context, tagObj models.Tag) (int64, error) {
+	c.tags[tagObj.ID] = struct{}{}
+	tagObjs, err := c.tagServiceFactory.New().FindByArtifactID(ctx, tagObj.ArtifactID)
+	if err != nil {
+		return 0, fmt.Errorf("find tags of artifact(%d) failed: %v", tagObj.ArtifactID, err)
+	}
+	for _, t := range tagObjs {
+		if _, ok := c.tags[t.ID]; !ok {
+			return 0, nil
+		}
+	}
+	return c.artifact(ctx, tagObj.ArtifactID)
+}
+
+// artifact returns the size reclaimed by deleting the artifact
+func (c *reclaimCounter) artifact(ctx context.Context, artifactID int64) (int64, error) {
+	c.artifacts[artifactID] = struct{}{}
+	blobObjs, err := c.blobServiceFactory.New().FindByArtifact(ctx, artifactID)
+	if err != nil {
+		return 0, fmt.Errorf("find blobs of artifact(%d) failed: %v", artifactID, err)
+	}
+	return c.unreferenced(ctx, blobObjs)
+}
+
+// repository returns the size reclaimed by deleting the repository
+func (c *reclaimCounter) repository(ctx context.Context, repositoryID int64) (int64, error) {
+	c.repositories[repositoryID] = struct{}{}
+	blobObjs, err := c.blobServiceFactory.New().FindByRepository(ctx, repositoryID)
+	if err != nil {
+		return 0, fmt.Errorf("find blobs of repository(%d) failed: %v", repositoryID, err)
+	}
+	return c.unreferenced(ctx, blobObjs)
+}
+
+// unreferenced counts the blobs not counted yet and only referenced by the deleted objects
+func (c *reclaimCounter) unreferenced(ctx context.Context, blobObjs []*models.Blob) (int64, error) {
+	var ids []int64
+	for _, blobObj := range blobObjs {
+		if _, ok := c.blobs[blobObj.ID]; !ok {
+			ids = append(ids, blobObj.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	references, err := c.blobServiceFactory.New().FindReferences(ctx, ids)
+	if err != nil {
+		return 0, fmt.Errorf("find references of blobs failed: %v", err)
+	}
+	var referenced = make(map[int64]struct{}, len(references))
+	for _, reference := range references {
+		_, artifactDeleted := c.artifacts[reference.ArtifactID]
+		_, repositoryDeleted := c.repositories[reference.RepositoryID]
+		if !artifactDeleted && !repositoryDeleted {
+			referenced[reference.BlobID] = struct{}{}
+		}
+	}
+	var size int64
+	for _, blobObj := range blobObjs {
+		if _, ok := c.blobs[blobObj.ID]; ok {
+			continue
+		}
+		if _, ok := referenced[blobObj.ID]; ok {
+			continue
+		}
+		c.blobs[blobObj.ID] = struct{}{}
+		size += blobObj.Size
+	}
+	return size, nil
+}
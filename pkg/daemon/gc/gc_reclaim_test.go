@@ -0,0 +1,96 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-sigma/sigma/pkg/dal"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/logger"
+	"github.com/go-sigma/sigma/pkg/tests"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+func TestReclaimCounter(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	userObj := &models.User{Username: "gc-reclaim", Password: ptr.Of("test"), Email: ptr.Of("test@gmail.com")}
+	assert.NoError(t, dao.NewUserServiceFactory().New().Create(ctx, userObj))
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+	repositoryObj := &models.Repository{Name: "test/busybox", NamespaceID: namespaceObj.ID}
+	assert.NoError(t, dao.NewRepositoryServiceFactory().New().Create(ctx, repositoryObj, dao.AutoCreateNamespace{UserID: userObj.ID}))
+
+	blobService := dao.NewBlobServiceFactory().New()
+	sharedBlobObj := &models.Blob{Digest: "sha256:shared", Size: 100, ContentType: "test"}
+	assert.NoError(t, blobService.Create(ctx, sharedBlobObj))
+	blob1Obj := &models.Blob{Digest: "sha256:blob1", Size: 10, ContentType: "test"}
+	assert.NoError(t, blobService.Create(ctx, blob1Obj))
+	blob2Obj := &models.Blob{Digest: "sha256:blob2", Size: 1, ContentType: "test"}
+	assert.NoError(t, blobService.Create(ctx, blob2Obj))
+
+	artifactService := dao.NewArtifactServiceFactory().New()
+	tagService := dao.NewTagServiceFactory().New()
+	newArtifact := func(digest string, blobObjs []*models.Blob, tags ...string) (*models.Artifact, []*models.Tag) {
+		artifactObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: digest, Size: 1, ContentType: "test", Raw: []byte("test")}
+		assert.NoError(t, artifactService.Create(ctx, artifactObj))
+		assert.NoError(t, artifactService.AssociateBlobs(ctx, artifactObj, blobObjs))
+		var tagObjs []*models.Tag
+		for _, tag := range tags {
+			tagObj := &models.Tag{RepositoryID: repositoryObj.ID, ArtifactID: artifactObj.ID, Name: tag}
+			assert.NoError(t, tagService.Create(ctx, tagObj))
+			tagObjs = append(tagObjs, tagObj)
+		}
+		return artifactObj, tagObjs
+	}
+	artifact1Obj, tag1Objs := newArtifact("sha256:artifact1", []*models.Blob{sharedBlobObj, blob1Obj}, "v1", "latest")
+	_, tag2Objs := newArtifact("sha256:artifact2", []*models.Blob{sharedBlobObj, blob2Obj}, "v2")
+
+	counter := newReclaimCounter(dao.NewBlobServiceFactory(), dao.NewTagServiceFactory())
+	size, err := counter.tag(ctx, ptr.To(tag1Objs[0]))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), size) // the artifact is still tagged with latest
+	size, err = counter.tag(ctx, ptr.To(tag1Objs[1]))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), size) // the shared blob is still referenced by artifact2
+	size, err = counter.tag(ctx, ptr.To(tag2Objs[0]))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), size)
+	size, err = counter.artifact(ctx, artifact1Obj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), size) // every blob is counted once
+
+	counter = newReclaimCounter(dao.NewBlobServiceFactory(), dao.NewTagServiceFactory())
+	size, err = counter.repository(ctx, repositoryObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(111), size)
+}
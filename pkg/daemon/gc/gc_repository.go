@@ -60,6 +60,7 @@ type repositoryTaskCollectRecord struct {
 	Status     enums.GcRecordStatus
 	Runner     models.DaemonGcRepositoryRunner
 	Repository models.Repository
+	Size       int64
 	Message    *string
 }
 
@@ -68,9 +69,11 @@ type gcRepository struct {
 	config configs.Configuration
 
 	runnerObj *models.DaemonGcRepositoryRunner
+	reclaim   *reclaimCounter
 
 	successCount int64
 	failedCount  int64
+	reclaimSize  int64
 
 	namespaceServiceFactory  dao.NamespaceServiceFactory
 	repositoryServiceFactory dao.RepositoryServiceFactory
@@ -226,8 +229,16 @@ func (g gcRepository) deleteRepository() {
 		defer g.waitAllDone.Done()
 		defer close(g.collectRecordChan)
 		for task := range g.deleteRepositoryChan {
+			size, err := g.reclaim.repository(g.ctx, task.Repository.ID)
+			if err != nil {
+				log.Error().Err(err).Int64("RepositoryID", task.Repository.ID).Msg("Count reclaimable size failed")
+			}
+			if task.Runner.DryRun { // just record the repository would be deleted
+				g.collectRecordChan <- repositoryTaskCollectRecord{Status: enums.GcRecordStatusPlanned, Repository: task.Repository, Runner: task.Runner, Size: size}
+				continue
+			}
 			// TODO: we should set a lock for the delete action
-			err := repositoryService.DeleteByID(g.ctx, task.Repository.ID)
+			err = repositoryService.DeleteByID(g.ctx, task.Repository.ID)
 			if err != nil {
 				log.Error().Err(err).Int64("RepositoryID", task.Repository.ID).Msg("Delete repository by id failed")
 				g.collectRecordChan <- repositoryTaskCollectRecord{
@@ -238,7 +249,7 @@ func (g gcRepository) deleteRepository() {
 				}
 				continue
 			}
-			g.collectRecordChan <- repositoryTaskCollectRecord{Status: enums.GcRecordStatusSuccess, Repository: task.Repository, Runner: task.Runner}
+			g.collectRecordChan <- repositoryTaskCollectRecord{Status: enums.GcRecordStatusSuccess, Repository: task.Repository, Runner: task.Runner, Size: size}
 		}
 	}()
 }
@@ -249,25 +260,29 @@ func (g gcRepository) collectRecord() {
 		defer g.waitAllDone.Done()
 		defer func() {
 			g.runnerChan <- decoratorStatus{Daemon: enums.DaemonGcRepository, Status: enums.TaskCommonStatusDoing, Updates: map[string]any{
-				"success_count": g.successCount,
-				"failed_count":  g.failedCount,
+				"success_count":    g.successCount,
+				"failed_count":     g.failedCount,
+				"reclaimable_size": g.reclaimSize,
 			}}
 		}()
 		for task := range g.collectRecordChan {
 			err := daemonService.CreateGcRepositoryRecords(g.ctx, []*models.DaemonGcRepositoryRecord{
 				{
-					RunnerID:   task.Runner.ID,
-					Repository: task.Repository.Name,
-					Status:     task.Status,
-					Message:    []byte(ptr.To(task.Message)),
+					RunnerID:    task.Runner.ID,
+					NamespaceID: ptr.Of(task.Repository.NamespaceID),
+					Repository:  task.Repository.Name,
+					Status:      task.Status,
+					Size:        task.Size,
+					Message:     []byte(ptr.To(task.Message)),
 				},
 			})
 			if err != nil {
 				log.Error().Err(err).Msg("Create gc repository record failed")
 				continue
 			}
-			if task.Status == enums.GcRecordStatusSuccess {
+			if task.Status == enums.GcRecordStatusSuccess || task.Status == enums.GcRecordStatusPlanned {
 				g.successCount++
+				g.reclaimSize += task.Size
 			} else {
 				g.failedCount++
 			}
@@ -16,12 +16,14 @@ package gc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"sync"
 	"time"
 
 	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
 
 	"github.com/go-sigma/sigma/pkg/configs"
 	"github.com/go-sigma/sigma/pkg/consts"
@@ -35,6 +37,7 @@ import (
 )
 
 // deleteTagWithNamespace -> deleteTagWithRepository -> deleteTagCheckPattern -> deleteTag -> collectRecord
+// the retention rules are evaluated in deleteTagWithRepository if they are configured
 
 func init() {
 	workq.TopicHandlers[enums.DaemonGcTag] = definition.Consumer{
@@ -53,32 +56,41 @@ type tagWithNamespaceTask struct {
 
 // tagWithRepositoryTask ...
 type tagWithRepositoryTask struct {
-	Runner       models.DaemonGcTagRunner
-	RepositoryID int64
+	Runner         models.DaemonGcTagRunner
+	NamespaceID    int64
+	RepositoryID   int64
+	RepositoryName string
 }
 
 // tagTask ...
 type tagTask struct {
-	Runner models.DaemonGcTagRunner
-	Tag    models.Tag
+	Runner      models.DaemonGcTagRunner
+	NamespaceID int64
+	Tag         models.Tag
+	Untagged    bool // Untagged the task deletes the untagged artifact, the tag name is the digest of the artifact
 }
 
 // tagTaskCollectRecord ...
 type tagTaskCollectRecord struct {
-	Status  enums.GcRecordStatus
-	Runner  models.DaemonGcTagRunner
-	Tag     models.Tag
-	Message *string
+	Status      enums.GcRecordStatus
+	Runner      models.DaemonGcTagRunner
+	NamespaceID int64
+	Tag         models.Tag
+	Size        int64
+	Message     *string
 }
 
 type gcTag struct {
 	ctx    context.Context
 	config configs.Configuration
 
-	runnerObj *models.DaemonGcTagRunner
+	runnerObj      *models.DaemonGcTagRunner
+	retentionRules []retentionRule
+	reclaim        *reclaimCounter
 
 	successCount int64
 	failedCount  int64
+	reclaimSize  int64
 
 	namespaceServiceFactory  dao.NamespaceServiceFactory
 	repositoryServiceFactory dao.RepositoryServiceFactory
@@ -126,7 +138,25 @@ func (g gcTag) Run(runnerID int64) error {
 		Action:       enums.WebhookActionStarted,
 	}, WebhookObj: g.packWebhookObj(enums.WebhookActionStarted)}
 
-	if g.runnerObj.Rule.RetentionRuleType != enums.RetentionRuleTypeDay && g.runnerObj.Rule.RetentionRuleType != enums.RetentionRuleTypeQuantity {
+	g.retentionRules, err = compileRetentionRules(g.runnerObj.Rule.RetentionRules)
+	if err != nil {
+		g.runnerChan <- decoratorStatus{
+			Daemon:  enums.DaemonGcTag,
+			Status:  enums.TaskCommonStatusFailed,
+			Message: fmt.Sprintf("Gc tag rule retention rules is invalid: %v", err),
+			Ended:   true,
+		}
+		g.webhookChan <- decoratorWebhook{Meta: types.WebhookPayload{
+			ResourceType: enums.WebhookResourceTypeDaemonTaskGcTagRunner,
+			Action:       enums.WebhookActionFinished,
+		}, WebhookObj: g.packWebhookObj(enums.WebhookActionFinished)}
+		log.Error().Err(err).Msg("Gc tag rule retention rules is invalid")
+		return fmt.Errorf("gc tag rule retention rules is invalid: %v", err)
+	}
+
+	// the retention rule type is only required if the retention rules are not configured
+	if len(g.retentionRules) == 0 &&
+		g.runnerObj.Rule.RetentionRuleType != enums.RetentionRuleTypeDay && g.runnerObj.Rule.RetentionRuleType != enums.RetentionRuleTypeQuantity {
 		g.runnerChan <- decoratorStatus{
 			Daemon:  enums.DaemonGcTag,
 			Status:  enums.TaskCommonStatusFailed,
@@ -137,7 +167,7 @@ func (g gcTag) Run(runnerID int64) error {
 			ResourceType: enums.WebhookResourceTypeDaemonTaskGcTagRunner,
 			Action:       enums.WebhookActionFinished,
 		}, WebhookObj: g.packWebhookObj(enums.WebhookActionFinished)}
-		log.Error().Err(err).Interface("RetentionRuleType", g.runnerObj.Rule.RetentionRuleType).Msg("Gc tag rule retention type is invalid")
+		log.Error().Interface("RetentionRuleType", g.runnerObj.Rule.RetentionRuleType).Msg("Gc tag rule retention type is invalid")
 		return fmt.Errorf("gc tag rule retention type is invalid: %v", g.runnerObj.Rule.RetentionRuleType)
 	}
 
@@ -208,7 +238,7 @@ func (g gcTag) deleteTagWithNamespace() {
 					continue
 				}
 				for _, repositoryObj := range repositoryObjs {
-					g.deleteTagWithRepositoryChan <- tagWithRepositoryTask{Runner: task.Runner, RepositoryID: repositoryObj.ID}
+					g.deleteTagWithRepositoryChan <- tagWithRepositoryTask{Runner: task.Runner, NamespaceID: task.NamespaceID, RepositoryID: repositoryObj.ID, RepositoryName: repositoryObj.Name}
 				}
 				if len(repositoryObjs) < pagination {
 					break
@@ -225,6 +255,10 @@ func (g gcTag) deleteTagWithRepository() {
 		defer g.waitAllDone.Done()
 		defer close(g.deleteTagCheckPatternChan)
 		for task := range g.deleteTagWithRepositoryChan {
+			if len(g.retentionRules) > 0 {
+				g.retainTagWithRepository(task)
+				continue
+			}
 			var artifactCurIndex int64
 			for {
 				var tagObjs []*models.Tag
@@ -239,7 +273,7 @@ func (g gcTag) deleteTagWithRepository() {
 					continue
 				}
 				for _, tagObj := range tagObjs {
-					g.deleteTagCheckPatternChan <- tagTask{Runner: task.Runner, Tag: ptr.To(tagObj)}
+					g.deleteTagCheckPatternChan <- tagTask{Runner: task.Runner, NamespaceID: task.NamespaceID, Tag: ptr.To(tagObj)}
 				}
 				if len(tagObjs) < pagination {
 					break
@@ -250,41 +284,155 @@ func (g gcTag) deleteTagWithRepository() {
 	}()
 }
 
+// retainTagWithRepository evaluates the tags and the untagged artifacts of the repository with the retention rules,
+// the candidates not retained by any of the rules will be deleted.
+func (g gcTag) retainTagWithRepository(task tagWithRepositoryTask) {
+	rules := applicableRetentionRules(g.retentionRules, task.RepositoryName)
+	if len(rules) == 0 {
+		return
+	}
+	tagService := g.tagServiceFactory.New()
+	artifactService := g.artifactServiceFactory.New()
+
+	var candidates []retentionCandidate
+	var tagCurIndex int64
+	for {
+		tagObjs, err := tagService.ListByDtPagination(g.ctx, task.RepositoryName, pagination, tagCurIndex)
+		if err != nil {
+			log.Error().Err(err).Str("repository", task.RepositoryName).Msg("List tag failed")
+			return
+		}
+		for _, tagObj := range tagObjs {
+			candidates = append(candidates, retentionCandidate{Tag: tagObj})
+		}
+		if len(tagObjs) < pagination {
+			break
+		}
+		tagCurIndex = tagObjs[len(tagObjs)-1].ID
+	}
+
+	var untagged bool
+	for _, rule := range rules {
+		untagged = untagged || rule.untagged
+	}
+	if untagged {
+		// the artifacts which tags are still in the recycle bin are not untagged
+		recycleBinAfter := time.Now().Add(-g.config.RecycleBin.Retention).UnixMilli()
+		var artifactCurIndex int64
+		for {
+			artifactObjs, err := artifactService.FindUntaggedWithCursor(g.ctx, task.RepositoryID, recycleBinAfter, pagination, artifactCurIndex)
+			if err != nil {
+				log.Error().Err(err).Str("repository", task.RepositoryName).Msg("List untagged artifact failed")
+				return
+			}
+			for _, artifactObj := range artifactObjs {
+				if !(artifactObj.ContentType == "application/vnd.docker.distribution.manifest.list.v2+json" ||
+					artifactObj.ContentType == "application/vnd.oci.image.index.v1+json") { // the manifest of the index is not untagged
+					err = artifactService.IsArtifactAssociatedWithArtifact(g.ctx, artifactObj.ID)
+					if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+						log.Error().Err(err).Int64("artifactID", artifactObj.ID).Msg("Get manifest associated with manifest index failed")
+					}
+					if err == nil {
+						continue
+					}
+				}
+				candidates = append(candidates, retentionCandidate{Artifact: artifactObj})
+			}
+			if len(artifactObjs) < pagination {
+				break
+			}
+			artifactCurIndex = artifactObjs[len(artifactObjs)-1].ID
+		}
+	}
+
+	for _, candidate := range evaluateRetention(rules, candidates, time.Now()) {
+		if candidate.Tag != nil {
+			g.deleteTagCheckPatternChan <- tagTask{Runner: task.Runner, NamespaceID: task.NamespaceID, Tag: ptr.To(candidate.Tag)}
+			continue
+		}
+		referrerObjs, err := artifactService.GetReferrers(g.ctx, task.RepositoryID, candidate.Artifact.Digest, nil)
+		if err != nil {
+			log.Error().Err(err).Int64("artifactID", candidate.Artifact.ID).Msg("Get artifact referrers failed")
+			continue
+		}
+		for _, artifactObj := range append(referrerObjs, candidate.Artifact) {
+			g.deleteTagCheckPatternChan <- tagTask{Runner: task.Runner, NamespaceID: task.NamespaceID, Untagged: true, Tag: models.Tag{
+				RepositoryID: artifactObj.RepositoryID,
+				ArtifactID:   artifactObj.ID,
+				Name:         artifactObj.Digest,
+			}}
+		}
+	}
+}
+
 func (g gcTag) deleteTagCheckPattern() {
 	go func() {
 		defer g.waitAllDone.Done()
 		defer close(g.deleteTagChan)
 		for task := range g.deleteTagCheckPatternChan {
-			if len(ptr.To(task.Runner.Rule.RetentionPattern)) == 0 {
-				g.deleteTagChan <- tagTask{Runner: task.Runner, Tag: task.Tag}
+			if len(g.retentionRules) > 0 || len(ptr.To(task.Runner.Rule.RetentionPattern)) == 0 { // the retention rules have been evaluated
+				g.deleteTagChan <- task
 				continue
 			}
 			if regexp.MustCompile(ptr.To(task.Runner.Rule.RetentionPattern)).MatchString(task.Tag.Name) {
 				continue
 			}
-			g.deleteTagChan <- tagTask{Runner: task.Runner, Tag: task.Tag} // pattern not match this tag, should delete the tag
+			g.deleteTagChan <- tagTask{Runner: task.Runner, NamespaceID: task.NamespaceID, Tag: task.Tag} // pattern not match this tag, should delete the tag
 		}
 	}()
 }
 
 func (g gcTag) deleteTag() {
 	tagService := g.tagServiceFactory.New()
+	artifactService := g.artifactServiceFactory.New()
 	go func() {
 		defer g.waitAllDone.Done()
 		defer close(g.collectRecordChan)
 		for task := range g.deleteTagChan {
+			var size int64
+			var err error
+			if task.Untagged {
+				size, err = g.reclaim.artifact(g.ctx, task.Tag.ArtifactID)
+			} else {
+				size, err = g.reclaim.tag(g.ctx, task.Tag)
+			}
+			if err != nil {
+				log.Error().Err(err).Str("tag", task.Tag.Name).Msg("Count reclaimable size failed")
+			}
+			if task.Runner.DryRun { // just record the tag would be deleted
+				g.collectRecordChan <- tagTaskCollectRecord{Status: enums.GcRecordStatusPlanned, Tag: task.Tag, Runner: task.Runner, NamespaceID: task.NamespaceID, Size: size}
+				continue
+			}
 			// TODO: we should set a lock for the delete action
-			err := tagService.DeleteByID(g.ctx, task.Tag.ID)
+			if task.Untagged {
+				err = artifactService.DeleteByID(g.ctx, task.Tag.ArtifactID)
+				if err != nil {
+					log.Error().Err(err).Int64("id", task.Tag.ArtifactID).Msg("Delete untagged artifact by id failed")
+					g.collectRecordChan <- tagTaskCollectRecord{
+						Status:      enums.GcRecordStatusFailed,
+						Tag:         task.Tag,
+						Runner:      task.Runner,
+						NamespaceID: task.NamespaceID,
+						Message:     ptr.Of(fmt.Sprintf("Delete untagged artifact by id failed: %v", err)),
+					}
+					continue
+				}
+				g.collectRecordChan <- tagTaskCollectRecord{Status: enums.GcRecordStatusSuccess, Tag: task.Tag, Runner: task.Runner, NamespaceID: task.NamespaceID, Size: size}
+				continue
+			}
+			err = tagService.DeleteByID(g.ctx, task.Tag.ID)
 			if err != nil {
 				log.Error().Err(err).Int64("id", task.Tag.ID).Msg("Delete tag by id failed")
 				g.collectRecordChan <- tagTaskCollectRecord{
-					Status:  enums.GcRecordStatusFailed,
-					Tag:     task.Tag,
-					Runner:  task.Runner,
-					Message: ptr.Of(fmt.Sprintf("Delete tag by id failed: %v", err)),
+					Status:      enums.GcRecordStatusFailed,
+					Tag:         task.Tag,
+					Runner:      task.Runner,
+					NamespaceID: task.NamespaceID,
+					Message:     ptr.Of(fmt.Sprintf("Delete tag by id failed: %v", err)),
 				}
 				continue
 			}
+			g.collectRecordChan <- tagTaskCollectRecord{Status: enums.GcRecordStatusSuccess, Tag: task.Tag, Runner: task.Runner, NamespaceID: task.NamespaceID, Size: size}
 		}
 	}()
 }
@@ -295,25 +443,29 @@ func (g gcTag) collectRecord() {
 		defer g.waitAllDone.Done()
 		defer func() {
 			g.runnerChan <- decoratorStatus{Daemon: enums.DaemonGcTag, Status: enums.TaskCommonStatusDoing, Updates: map[string]any{
-				"success_count": g.successCount,
-				"failed_count":  g.failedCount,
+				"success_count":    g.successCount,
+				"failed_count":     g.failedCount,
+				"reclaimable_size": g.reclaimSize,
 			}}
 		}()
 		for task := range g.collectRecordChan {
 			err := daemonService.CreateGcTagRecords(g.ctx, []*models.DaemonGcTagRecord{
 				{
-					RunnerID: task.Runner.ID,
-					Tag:      task.Tag.Name,
-					Status:   task.Status,
-					Message:  []byte(ptr.To(task.Message)),
+					RunnerID:    task.Runner.ID,
+					NamespaceID: ptr.Of(task.NamespaceID),
+					Tag:         task.Tag.Name,
+					Status:      task.Status,
+					Size:        task.Size,
+					Message:     []byte(ptr.To(task.Message)),
 				},
 			})
 			if err != nil {
 				log.Error().Err(err).Msg("Create gc tag record failed")
 				continue
 			}
-			if task.Status == enums.GcRecordStatusSuccess {
+			if task.Status == enums.GcRecordStatusSuccess || task.Status == enums.GcRecordStatusPlanned {
 				g.successCount++
+				g.reclaimSize += task.Size
 			} else {
 				g.failedCount++
 			}
@@ -0,0 +1,222 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+// retentionRule is the compiled types.TagRetentionRule
+type retentionRule struct {
+	repository []*regexp.Regexp
+	include    []*regexp.Regexp
+	exclude    []*regexp.Regexp
+	untagged   bool
+	keep       enums.TagRetentionKeep
+	amount     int64
+}
+
+// retentionCandidate is the tag or the untagged artifact evaluated by the retention rules
+type retentionCandidate struct {
+	Tag      *models.Tag      // Tag is nil if the candidate is an untagged artifact
+	Artifact *models.Artifact // Artifact is the untagged artifact
+}
+
+func (c retentionCandidate) id() int64 {
+	if c.Tag != nil {
+		return c.Tag.ID
+	}
+	return c.Artifact.ID
+}
+
+func (c retentionCandidate) pushedAt() int64 {
+	if c.Tag != nil {
+		return c.Tag.PushedAt
+	}
+	return c.Artifact.PushedAt
+}
+
+func (c retentionCandidate) lastPull() int64 {
+	if c.Tag != nil {
+		return c.Tag.LastPull
+	}
+	return c.Artifact.LastPull
+}
+
+// compileRetentionRules compiles the retention rules in json format
+func compileRetentionRules(raw []byte) ([]retentionRule, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var rules []types.TagRetentionRule
+	err := json.Unmarshal(raw, &rules)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal retention rules failed: %v", err)
+	}
+	var result = make([]retentionRule, 0, len(rules))
+	for _, rule := range rules {
+		r := retentionRule{untagged: rule.Untagged, keep: rule.Keep, amount: rule.Amount}
+		if r.repository, err = compileRetentionPattern(ptr.To(rule.RepositoryPattern)); err != nil {
+			return nil, err
+		}
+		if r.include, err = compileRetentionPattern(ptr.To(rule.TagInclude)); err != nil {
+			return nil, err
+		}
+		if r.exclude, err = compileRetentionPattern(ptr.To(rule.TagExclude)); err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+// compileRetentionPattern compiles the regular expressions separated by comma, the whole name should be matched
+func compileRetentionPattern(pattern string) ([]*regexp.Regexp, error) {
+	var result []*regexp.Regexp
+	for _, p := range strings.Split(pattern, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		reg, err := regexp.Compile("^(?:" + p + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("compile retention pattern(%s) failed: %v", p, err)
+		}
+		result = append(result, reg)
+	}
+	return result, nil
+}
+
+func matchAny(regs []*regexp.Regexp, name string) bool {
+	for _, reg := range regs {
+		if reg.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// applicableRetentionRules returns the rules take effect on the repository
+func applicableRetentionRules(rules []retentionRule, repository string) []retentionRule {
+	var result []retentionRule
+	for _, rule := range rules {
+		if len(rule.repository) == 0 || matchAny(rule.repository, repository) {
+			result = append(result, rule)
+		}
+	}
+	return result
+}
+
+// selects returns whether the candidate is selected by the rule
+func (r retentionRule) selects(candidate retentionCandidate) bool {
+	if candidate.Tag == nil {
+		return r.untagged
+	}
+	if len(r.include) != 0 && !matchAny(r.include, candidate.Tag.Name) {
+		return false
+	}
+	return !matchAny(r.exclude, candidate.Tag.Name)
+}
+
+// retains returns the index of the candidates retained by the rule
+func (r retentionRule) retains(candidates []retentionCandidate, now time.Time) []int {
+	var selected []int
+	for index, candidate := range candidates {
+		if r.selects(candidate) {
+			selected = append(selected, index)
+		}
+	}
+	switch r.keep {
+	case enums.TagRetentionKeepAll:
+		return selected
+	case enums.TagRetentionKeepLastPushed:
+		sort.SliceStable(selected, func(i, j int) bool {
+			a, b := candidates[selected[i]], candidates[selected[j]]
+			if a.pushedAt() != b.pushedAt() {
+				return a.pushedAt() > b.pushedAt()
+			}
+			return a.id() > b.id()
+		})
+		return selected[:min(int64(len(selected)), r.amount)]
+	case enums.TagRetentionKeepPulledWithin:
+		after := now.Add(-time.Hour * 24 * time.Duration(r.amount)).UnixMilli()
+		var result []int
+		for _, index := range selected {
+			if candidates[index].lastPull() >= after {
+				result = append(result, index)
+			}
+		}
+		return result
+	case enums.TagRetentionKeepNewestSemver:
+		var versioned []int
+		var versions = make(map[int]*semver.Version)
+		for _, index := range selected {
+			if candidates[index].Tag == nil {
+				continue
+			}
+			version, err := semver.NewVersion(candidates[index].Tag.Name)
+			if err != nil {
+				continue
+			}
+			versions[index] = version
+			versioned = append(versioned, index)
+		}
+		sort.SliceStable(versioned, func(i, j int) bool {
+			return versions[versioned[i]].GreaterThan(versions[versioned[j]])
+		})
+		return versioned[:min(int64(len(versioned)), r.amount)]
+	}
+	return nil
+}
+
+// evaluateRetention evaluates the candidates with the rules in OR semantics,
+// returns the candidates which are not retained by any of the rules.
+// The cosign tags (e.g. sha256-<hex>.sig) are never evaluated, they are deleted by the artifact gc with their subject.
+func evaluateRetention(rules []retentionRule, candidates []retentionCandidate, now time.Time) []retentionCandidate {
+	var filtered = make([]retentionCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		if candidate.Tag != nil && cosignTagRegexp.MatchString(candidate.Tag.Name) {
+			continue
+		}
+		filtered = append(filtered, candidate)
+	}
+	candidates = filtered
+
+	var retained = make(map[int]struct{}, len(candidates))
+	for _, rule := range rules {
+		for _, index := range rule.retains(candidates, now) {
+			retained[index] = struct{}{}
+		}
+	}
+	var result []retentionCandidate
+	for index, candidate := range candidates {
+		if _, ok := retained[index]; !ok {
+			result = append(result, candidate)
+		}
+	}
+	return result
+}
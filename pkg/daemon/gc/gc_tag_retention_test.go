@@ -0,0 +1,132 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+func TestEvaluateRetention(t *testing.T) {
+	now := time.Now()
+	day := time.Hour * 24
+
+	// keep the last 2 main-* builds, every v* tag, and anything pulled in the last 30 days
+	rules, err := compileRetentionRules(utils.MustMarshal([]types.TagRetentionRule{
+		{TagInclude: ptr.Of("main-.*"), Keep: enums.TagRetentionKeepLastPushed, Amount: 2},
+		{TagInclude: ptr.Of("v.*"), Keep: enums.TagRetentionKeepAll},
+		{Keep: enums.TagRetentionKeepPulledWithin, Amount: 30},
+	}))
+	assert.NoError(t, err)
+
+	var candidates []retentionCandidate
+	for index, name := range []string{"main-1", "main-2", "main-3", "main-4", "v1.0.0", "latest", "dev"} {
+		candidates = append(candidates, retentionCandidate{Tag: &models.Tag{
+			ID:       int64(index + 1),
+			Name:     name,
+			PushedAt: now.Add(time.Duration(index) * time.Minute).UnixMilli(),
+		}})
+	}
+	candidates[0].Tag.LastPull = now.Add(-day).UnixMilli()      // main-1 pulled recently
+	candidates[5].Tag.LastPull = now.Add(-day * 60).UnixMilli() // latest pulled long ago
+
+	assert.ElementsMatch(t, []string{"main-2", "latest", "dev"}, candidateNames(evaluateRetention(rules, candidates, now)))
+}
+
+func TestEvaluateRetentionSemver(t *testing.T) {
+	rules, err := compileRetentionRules(utils.MustMarshal([]types.TagRetentionRule{
+		{TagExclude: ptr.Of(".*-rc.*"), Keep: enums.TagRetentionKeepNewestSemver, Amount: 2},
+	}))
+	assert.NoError(t, err)
+
+	var candidates []retentionCandidate
+	for index, name := range []string{"v1.10.0", "1.9.0", "v2.0.0-rc.1", "v1.2.0", "latest"} {
+		candidates = append(candidates, retentionCandidate{Tag: &models.Tag{ID: int64(index + 1), Name: name}})
+	}
+	assert.ElementsMatch(t, []string{"v2.0.0-rc.1", "v1.2.0", "latest"}, candidateNames(evaluateRetention(rules, candidates, time.Now())))
+}
+
+func TestEvaluateRetentionUntagged(t *testing.T) {
+	rules, err := compileRetentionRules(utils.MustMarshal([]types.TagRetentionRule{
+		{RepositoryPattern: ptr.Of("library/.*"), TagInclude: ptr.Of("none"), Untagged: true, Keep: enums.TagRetentionKeepLastPushed, Amount: 1},
+	}))
+	assert.NoError(t, err)
+
+	assert.Len(t, applicableRetentionRules(rules, "library/busybox"), 1)
+	assert.Len(t, applicableRetentionRules(rules, "library"), 0)
+	assert.Len(t, applicableRetentionRules(rules, "test/library/busybox"), 0)
+
+	candidates := []retentionCandidate{
+		{Tag: &models.Tag{ID: 1, Name: "latest", PushedAt: 3}},
+		{Artifact: &models.Artifact{ID: 1, Digest: "sha256:1", PushedAt: 1}},
+		{Artifact: &models.Artifact{ID: 2, Digest: "sha256:2", PushedAt: 2}},
+	}
+	assert.ElementsMatch(t, []string{"latest", "sha256:1"}, candidateNames(evaluateRetention(rules, candidates, time.Now())))
+}
+
+func TestEvaluateRetentionCosign(t *testing.T) {
+	rules, err := compileRetentionRules(utils.MustMarshal([]types.TagRetentionRule{
+		{TagInclude: ptr.Of("v.*"), Keep: enums.TagRetentionKeepAll},
+	}))
+	assert.NoError(t, err)
+
+	const hex = "2776ee23722eaabcffed77dafd22b7a1da734971bf268a323b6819926dfe1ebd"
+	candidates := []retentionCandidate{
+		{Tag: &models.Tag{ID: 1, Name: "v1.0.0"}},
+		{Tag: &models.Tag{ID: 2, Name: "sha256-" + hex + ".sig"}},
+		{Tag: &models.Tag{ID: 3, Name: "sha256-" + hex + ".att"}},
+		{Tag: &models.Tag{ID: 4, Name: "sha256-" + hex}},
+		{Tag: &models.Tag{ID: 5, Name: "dev"}},
+	}
+	assert.ElementsMatch(t, []string{"sha256-" + hex, "dev"}, candidateNames(evaluateRetention(rules, candidates, time.Now())))
+}
+
+func TestCompileRetentionRules(t *testing.T) {
+	rules, err := compileRetentionRules(nil)
+	assert.NoError(t, err)
+	assert.Len(t, rules, 0)
+
+	_, err = compileRetentionRules([]byte("{"))
+	assert.Error(t, err)
+
+	_, err = compileRetentionRules(utils.MustMarshal([]types.TagRetentionRule{{TagInclude: ptr.Of("v(")}}))
+	assert.Error(t, err)
+
+	rules, err = compileRetentionRules(utils.MustMarshal([]types.TagRetentionRule{{TagInclude: ptr.Of("v.*, latest")}}))
+	assert.NoError(t, err)
+	assert.True(t, rules[0].selects(retentionCandidate{Tag: &models.Tag{Name: "latest"}}))
+	assert.False(t, rules[0].selects(retentionCandidate{Tag: &models.Tag{Name: "dev-latest"}}))
+	assert.False(t, rules[0].selects(retentionCandidate{Artifact: &models.Artifact{}}))
+}
+
+func candidateNames(candidates []retentionCandidate) []string {
+	var names []string
+	for _, candidate := range candidates {
+		if candidate.Tag != nil {
+			names = append(names, candidate.Tag.Name)
+		} else {
+			names = append(names, candidate.Artifact.Digest)
+		}
+	}
+	return names
+}
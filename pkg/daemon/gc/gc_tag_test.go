@@ -13,3 +13,92 @@
 // limitations under the License.
 
 package gc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/dal"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/logger"
+	"github.com/go-sigma/sigma/pkg/tests"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+func TestGcTagRetentionRules(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	userObj := &models.User{Username: "gc-tag", Password: ptr.Of("test"), Email: ptr.Of("test@gmail.com")}
+	assert.NoError(t, dao.NewUserServiceFactory().New().Create(ctx, userObj))
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+	repositoryObj := &models.Repository{Name: "test/busybox", NamespaceID: namespaceObj.ID}
+	assert.NoError(t, dao.NewRepositoryServiceFactory().New().Create(ctx, repositoryObj, dao.AutoCreateNamespace{UserID: userObj.ID}))
+
+	const hex = "2776ee23722eaabcffed77dafd22b7a1da734971bf268a323b6819926dfe1ebd"
+	artifactService := dao.NewArtifactServiceFactory().New()
+	tagService := dao.NewTagServiceFactory().New()
+	newArtifact := func(digest string, tag string) (*models.Artifact, *models.Tag) {
+		artifactObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: digest, Size: 1,
+			ContentType: "application/vnd.oci.image.manifest.v1+json", Raw: []byte("test")}
+		assert.NoError(t, artifactService.Create(ctx, artifactObj))
+		if tag == "" {
+			return artifactObj, nil
+		}
+		tagObj := &models.Tag{RepositoryID: repositoryObj.ID, ArtifactID: artifactObj.ID, Name: tag}
+		assert.NoError(t, tagService.Create(ctx, tagObj))
+		return artifactObj, tagObj
+	}
+	_, releaseTagObj := newArtifact("sha256:"+hex, "v1.0.0")
+	_, signatureTagObj := newArtifact("sha256:96526aa774ef0126ad0fe9e9a95764c5fc37f409ab9e97021e7b4775d82bf6fa", "sha256-"+hex+".sig")
+	_, devTagObj := newArtifact("sha256:e2ee1b4e3e8e7d2a1a0e5f5b1c4c9e1f6a3b0e6a3f6e2b1d7c8a9e0f1a2b3c4d", "dev")
+	untaggedObj, _ := newArtifact("sha256:0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9", "")
+
+	// the retention rule type and amount are ignored, only the retention rules are used
+	daemonService := dao.NewDaemonServiceFactory().New()
+	ruleObj := &models.DaemonGcTagRule{NamespaceID: ptr.Of(namespaceObj.ID), RetentionRuleType: enums.RetentionRuleTypeQuantity, RetentionRuleAmount: 1, RetentionRules: utils.MustMarshal([]types.TagRetentionRule{
+		{TagInclude: ptr.Of("v.*"), Keep: enums.TagRetentionKeepAll},
+		{Untagged: true, Keep: enums.TagRetentionKeepLastPushed, Amount: 0},
+	})}
+	assert.NoError(t, daemonService.CreateGcTagRule(ctx, ruleObj))
+	runnerObj := &models.DaemonGcTagRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending, OperateType: enums.OperateTypeManual}
+	assert.NoError(t, daemonService.CreateGcTagRunner(ctx, runnerObj))
+
+	var runnerChan = make(chan decoratorStatus, 4)
+	var webhookChan = make(chan decoratorWebhook, 4)
+	runner := initGc(ctx, enums.DaemonGcTag, runnerChan, webhookChan)
+	assert.NoError(t, runner.Run(runnerObj.ID))
+
+	var statusArr = make([]string, 0, 10)
+	for status := range runnerChan {
+		statusArr = append(statusArr, string(status.Status))
+	}
+	assert.Equal(t, []string{"Doing", "Doing", "Success"}, statusArr)
+
+	_, err := tagService.GetByID(ctx, releaseTagObj.ID)
+	assert.NoError(t, err)
+	_, err = tagService.GetByID(ctx, signatureTagObj.ID) // the cosign tag is kept with its subject
+	assert.NoError(t, err)
+	_, err = tagService.GetByID(ctx, devTagObj.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	_, err = artifactService.Get(ctx, untaggedObj.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
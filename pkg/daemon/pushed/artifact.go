@@ -43,6 +43,9 @@ func init() {
 				repositoryServiceFactory: dao.NewRepositoryServiceFactory(),
 				tagServiceFactory:        dao.NewTagServiceFactory(),
 				artifactServiceFactory:   dao.NewArtifactServiceFactory(),
+				policyServiceFactory:     dao.NewPolicyServiceFactory(),
+				signingKeyServiceFactory: dao.NewSigningKeyServiceFactory(),
+				userServiceFactory:       dao.NewUserServiceFactory(),
 			}
 			return r.run(ctx, payload)
 		},
@@ -57,6 +60,9 @@ type runnerArtifact struct {
 	repositoryServiceFactory dao.RepositoryServiceFactory
 	tagServiceFactory        dao.TagServiceFactory
 	artifactServiceFactory   dao.ArtifactServiceFactory
+	policyServiceFactory     dao.PolicyServiceFactory
+	signingKeyServiceFactory dao.SigningKeyServiceFactory
+	userServiceFactory       dao.UserServiceFactory
 }
 
 func (r runnerArtifact) run(ctx context.Context, payload types.DaemonArtifactPushedPayload) error {
@@ -90,5 +96,15 @@ func (r runnerArtifact) run(ctx context.Context, payload types.DaemonArtifactPus
 		log.Error().Err(err).Msg("Update repository failed")
 		return err
 	}
+	err = workq.ProducerClient.Produce(ctx, enums.DaemonQuota, types.DaemonQuotaPayload{
+		NamespaceID: repositoryObj.NamespaceID,
+	}, definition.ProducerOption{})
+	if err != nil { // the quota threshold will be checked again by the next push or the reconciliation
+		log.Error().Err(err).Int64("namespaceID", repositoryObj.NamespaceID).Msg("Enqueue quota task failed")
+	}
+	err = r.sign(ctx, repositoryObj, payload)
+	if err != nil { // the artifact is pushed already, the sign failure should not fail the task
+		log.Error().Err(err).Str("repository", repositoryObj.Name).Int64("artifactID", payload.ArtifactID).Msg("Sign artifact on push failed")
+	}
 	return nil
 }
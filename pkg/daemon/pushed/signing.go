@@ -0,0 +1,164 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pushed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/signing"
+	cosignsign "github.com/go-sigma/sigma/pkg/signing/cosign/sign"
+	cosignverify "github.com/go-sigma/sigma/pkg/signing/cosign/verify"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/utils/referrer"
+	"github.com/go-sigma/sigma/pkg/utils/token"
+)
+
+// sign signs the pushed artifact with the active key of the namespace if the sign-on-push policy matches the tag,
+// the signature is pushed as a cosign referrer artifact of the pushed artifact.
+func (r runnerArtifact) sign(ctx context.Context, repositoryObj *models.Repository, payload types.DaemonArtifactPushedPayload) error {
+	if payload.Tag == "" || payload.ArtifactID == 0 {
+		return nil
+	}
+	policyObj, err := r.policyServiceFactory.New().GetSigningPolicy(ctx, repositoryObj.NamespaceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	if !policyObj.Enabled {
+		return nil
+	}
+	if policyObj.TagPattern != "" {
+		matched, err := regexp.MatchString(policyObj.TagPattern, payload.Tag)
+		if err != nil {
+			return fmt.Errorf("invalid tag pattern: %w", err)
+		}
+		if !matched {
+			return nil
+		}
+	}
+
+	artifactService := r.artifactServiceFactory.New()
+	artifactObj, err := artifactService.Get(ctx, payload.ArtifactID)
+	if err != nil {
+		return err
+	}
+	if artifactObj.Type != enums.ArtifactTypeImage && artifactObj.Type != enums.ArtifactTypeImageIndex {
+		return nil
+	}
+
+	signingKeyService := r.signingKeyServiceFactory.New()
+	signingKeyObjs, err := signingKeyService.ListByNamespace(ctx, repositoryObj.NamespaceID)
+	if err != nil {
+		return err
+	}
+	var publicKeys []string
+	for _, signingKeyObj := range signingKeyObjs {
+		publicKeys = append(publicKeys, signingKeyObj.PublicKey)
+	}
+	signed, err := r.signed(ctx, repositoryObj, artifactObj, strings.Join(publicKeys, "\n"))
+	if err != nil {
+		return err
+	}
+	if signed {
+		log.Info().Str("repository", repositoryObj.Name).Str("digest", artifactObj.Digest).Msg("Artifact already signed with the namespace key, skip sign on push")
+		return nil
+	}
+
+	signingKeyObj, err := signingKeyService.GetActive(ctx, repositoryObj.NamespaceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Warn().Str("repository", repositoryObj.Name).Msg("Namespace has no active signing key, skip sign on push")
+			return nil
+		}
+		return err
+	}
+	privateKey, err := signing.DecryptPrivateKey(repositoryObj.NamespaceID, signingKeyObj.PrivateKey)
+	if err != nil {
+		return fmt.Errorf("decrypt private key failed: %w", err)
+	}
+
+	config := ptr.To(configs.GetConfiguration())
+	signaturePayload, err := cosignsign.Payload(fmt.Sprintf("%s/%s", utils.TrimHTTP(config.HTTP.Endpoint), repositoryObj.Name), artifactObj.Digest)
+	if err != nil {
+		return err
+	}
+	signature, err := cosignsign.SignPayload([]byte(privateKey), signaturePayload)
+	if err != nil {
+		return fmt.Errorf("sign payload failed: %w", err)
+	}
+	manifest := cosignsign.Manifest(imgspecv1.Descriptor{
+		MediaType: artifactObj.ContentType,
+		Digest:    digest.Digest(artifactObj.Digest),
+		Size:      int64(len(artifactObj.Raw)),
+	}, signaturePayload, signature)
+
+	userObj, err := r.userServiceFactory.New().GetByUsername(ctx, consts.UserInternal)
+	if err != nil {
+		return err
+	}
+	tokenService, err := token.NewTokenService(config.Auth.Jwt.PrivateKey)
+	if err != nil {
+		return err
+	}
+	authorization, err := tokenService.New(userObj.ID, config.Auth.Jwt.Ttl)
+	if err != nil {
+		return err
+	}
+	signatureDigest, err := referrer.Push(ctx, config.HTTP.InternalEndpoint, authorization, repositoryObj.Name, manifest, imgspecv1.DescriptorEmptyJSON.Data, signaturePayload)
+	if err != nil {
+		return fmt.Errorf("push signature failed: %w", err)
+	}
+
+	log.Info().Str("repository", repositoryObj.Name).Str("digest", artifactObj.Digest).Str("signature", signatureDigest.String()).
+		Str("fingerprint", signingKeyObj.Fingerprint).Msg("Sign artifact on push success")
+
+	return nil
+}
+
+// signed checks whether the artifact already has a signature trusted by the namespace keys
+func (r runnerArtifact) signed(ctx context.Context, repositoryObj *models.Repository, artifactObj *models.Artifact, publicKeys string) (bool, error) {
+	if publicKeys == "" {
+		return false, nil
+	}
+	signatures, err := signing.ListSignatures(ctx, r.artifactServiceFactory.New(), r.tagServiceFactory.New(), repositoryObj.ID, artifactObj.Digest)
+	if err != nil {
+		return false, err
+	}
+	if len(signatures) == 0 {
+		return false, nil
+	}
+	verifier, err := cosignverify.New(cosignverify.Options{PublicKeys: publicKeys})
+	if err != nil {
+		return false, err
+	}
+	return verifier.Verify(artifactObj.Digest, signatures) == nil, nil
+}
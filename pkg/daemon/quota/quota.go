@@ -0,0 +1,185 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/modules/workq"
+	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/utils/quota"
+)
+
+func init() {
+	workq.TopicHandlers[enums.DaemonQuota] = definition.Consumer{
+		Handler: func(ctx context.Context, data []byte) error {
+			var payload types.DaemonQuotaPayload
+			err := json.Unmarshal(data, &payload)
+			if err != nil {
+				return fmt.Errorf("Unmarshal payload failed: %v", err)
+			}
+			r := runnerQuota{
+				config:                   ptr.To(configs.GetConfiguration()),
+				namespaceServiceFactory:  dao.NewNamespaceServiceFactory(),
+				repositoryServiceFactory: dao.NewRepositoryServiceFactory(),
+				tagServiceFactory:        dao.NewTagServiceFactory(),
+				userServiceFactory:       dao.NewUserServiceFactory(),
+				auditServiceFactory:      dao.NewAuditServiceFactory(),
+				producerClient:           workq.ProducerClient,
+			}
+			return r.run(ctx, payload)
+		},
+		MaxRetry:    1,
+		Concurrency: 10,
+		Timeout:     time.Minute,
+	}
+}
+
+type runnerQuota struct {
+	config                   configs.Configuration
+	namespaceServiceFactory  dao.NamespaceServiceFactory
+	repositoryServiceFactory dao.RepositoryServiceFactory
+	tagServiceFactory        dao.TagServiceFactory
+	userServiceFactory       dao.UserServiceFactory
+	auditServiceFactory      dao.AuditServiceFactory
+	producerClient           definition.WorkQueueProducer
+}
+
+// quotaUsage the usage of the quota kind and the recorded crossed threshold
+type quotaUsage struct {
+	kind  enums.QuotaKind
+	usage int64
+	limit int64
+	state int
+}
+
+// quotaChange the recorded threshold of the quota kind should be changed from the state to the crossed
+type quotaChange struct {
+	quotaUsage
+	crossed int
+}
+
+// run checks the namespace quota usage with the warning thresholds, the notification is sent only when the usage
+// crosses a higher threshold than the recorded one, the recorded threshold goes down silently with the usage,
+// so the next crossing will be notified again. The recorded threshold is updated only if it is not changed since read,
+// the concurrent runners of the same namespace will notify the crossing only once.
+func (r runnerQuota) run(ctx context.Context, payload types.DaemonQuotaPayload) error {
+	ctx = log.Logger.WithContext(ctx)
+
+	namespaceObj, err := r.namespaceServiceFactory.New().Get(ctx, payload.NamespaceID)
+	if err != nil {
+		return err
+	}
+	repositoryCount, err := r.repositoryServiceFactory.New().CountByNamespace(ctx, []int64{namespaceObj.ID})
+	if err != nil {
+		return err
+	}
+	tagCount, err := r.tagServiceFactory.New().CountByNamespace(ctx, []int64{namespaceObj.ID})
+	if err != nil {
+		return err
+	}
+
+	thresholds := quota.ParseThresholds(namespaceObj.QuotaThresholds, r.config.Namespace.QuotaThresholds)
+	usages := []quotaUsage{
+		{kind: enums.QuotaKindSize, usage: namespaceObj.Size, limit: namespaceObj.SizeLimit, state: namespaceObj.SizeQuotaThreshold},
+		{kind: enums.QuotaKindTag, usage: tagCount[namespaceObj.ID], limit: namespaceObj.TagLimit, state: namespaceObj.TagQuotaThreshold},
+		{kind: enums.QuotaKindRepository, usage: repositoryCount[namespaceObj.ID], limit: namespaceObj.RepositoryLimit, state: namespaceObj.RepositoryQuotaThreshold},
+	}
+
+	var changes = make([]quotaChange, 0, len(usages))
+	var reached bool
+	for _, u := range usages {
+		crossed := quota.Crossed(thresholds, u.usage, u.limit)
+		if crossed == u.state {
+			continue
+		}
+		changes = append(changes, quotaChange{quotaUsage: u, crossed: crossed})
+		if crossed > u.state {
+			reached = true
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	var userObj *models.User
+	if reached {
+		userObj, err = r.userServiceFactory.New().GetByUsername(ctx, consts.UserInternal)
+		if err != nil {
+			return err
+		}
+	}
+	return query.Q.Transaction(func(tx *query.Query) error {
+		for _, change := range changes {
+			updated, err := r.namespaceServiceFactory.New(tx).UpdateQuotaThreshold(ctx, namespaceObj.ID, change.kind, change.state, change.crossed)
+			if err != nil {
+				return err
+			}
+			if !updated || change.crossed < change.state { // notified by the concurrent runner, or the usage goes down
+				continue
+			}
+			notification := types.WebhookPayloadQuota{
+				WebhookPayload: types.WebhookPayload{
+					ResourceType: enums.WebhookResourceTypeQuota,
+					Action:       enums.WebhookActionReached,
+				},
+				NamespaceID: namespaceObj.ID,
+				Namespace:   namespaceObj.Name,
+				Kind:        change.kind,
+				Threshold:   change.crossed,
+				Usage:       change.usage,
+				Limit:       change.limit,
+			}
+			log.Info().Str("namespace", namespaceObj.Name).Str("kind", notification.Kind.String()).
+				Int("threshold", notification.Threshold).Int64("usage", notification.Usage).Int64("limit", notification.Limit).
+				Msg("Namespace quota threshold reached")
+			err = r.auditServiceFactory.New(tx).Create(ctx, &models.Audit{
+				UserID:       userObj.ID,
+				NamespaceID:  ptr.Of(namespaceObj.ID),
+				Action:       enums.AuditActionUpdate,
+				ResourceType: enums.AuditResourceTypeQuota,
+				Resource:     namespaceObj.Name,
+				ReqRaw:       utils.MustMarshal(notification),
+			})
+			if err != nil {
+				return err
+			}
+			err = r.producerClient.Produce(ctx, enums.DaemonWebhook, types.DaemonWebhookPayload{
+				NamespaceID:  ptr.Of(namespaceObj.ID),
+				Action:       enums.WebhookActionReached,
+				ResourceType: enums.WebhookResourceTypeQuota,
+				Payload:      utils.MustMarshal(notification),
+			}, definition.ProducerOption{Tx: tx})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
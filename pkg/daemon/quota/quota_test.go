@@ -0,0 +1,127 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	daomocks "github.com/go-sigma/sigma/pkg/dal/dao/mocks"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/logger"
+	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
+	workqmocks "github.com/go-sigma/sigma/pkg/modules/workq/definition/mocks"
+	"github.com/go-sigma/sigma/pkg/tests"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+func TestRunnerQuota(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var reached int
+	producerClient := workqmocks.NewMockWorkQueueProducer(ctrl)
+	producerClient.EXPECT().Produce(gomock.Any(), enums.DaemonWebhook, gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, topic enums.Daemon, payload any, option definition.ProducerOption) error {
+		webhookPayload, ok := payload.(types.DaemonWebhookPayload)
+		assert.True(t, ok)
+		assert.Equal(t, enums.WebhookResourceTypeQuota, webhookPayload.ResourceType)
+		assert.Equal(t, enums.WebhookActionReached, webhookPayload.Action)
+		reached++
+		return nil
+	}).AnyTimes()
+
+	ctx := context.Background()
+
+	userService := dao.NewUserServiceFactory().New()
+	assert.NoError(t, userService.Create(ctx, &models.User{Username: consts.UserInternal, Password: ptr.Of("internal"), Email: ptr.Of("internal@gmail.com")}))
+
+	namespaceService := dao.NewNamespaceServiceFactory().New()
+	namespaceObj := &models.Namespace{Name: "test", SizeLimit: 100, QuotaThresholds: ptr.Of("80,95")}
+	assert.NoError(t, namespaceService.Create(ctx, namespaceObj))
+
+	r := runnerQuota{
+		config:                   configs.Configuration{Namespace: configs.ConfigurationNamespace{QuotaThresholds: []int{50}}},
+		namespaceServiceFactory:  dao.NewNamespaceServiceFactory(),
+		repositoryServiceFactory: dao.NewRepositoryServiceFactory(),
+		tagServiceFactory:        dao.NewTagServiceFactory(),
+		userServiceFactory:       dao.NewUserServiceFactory(),
+		auditServiceFactory:      dao.NewAuditServiceFactory(),
+		producerClient:           producerClient,
+	}
+
+	check := func(size int64, state int, notified int) {
+		assert.NoError(t, namespaceService.UpdateByID(ctx, namespaceObj.ID, map[string]any{query.Namespace.Size.ColumnName().String(): size}))
+		assert.NoError(t, r.run(ctx, types.DaemonQuotaPayload{NamespaceID: namespaceObj.ID}))
+		obj, err := namespaceService.Get(ctx, namespaceObj.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, state, obj.SizeQuotaThreshold)
+		assert.Equal(t, 0, obj.TagQuotaThreshold)
+		assert.Equal(t, 0, obj.RepositoryQuotaThreshold)
+		assert.Equal(t, notified, reached)
+		count, err := query.Audit.WithContext(ctx).Where(query.Audit.ResourceType.Eq(enums.AuditResourceTypeQuota)).Count()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(notified), count)
+	}
+
+	check(10, 0, 0)
+	check(85, 80, 1)
+	check(90, 80, 1)  // the same threshold is notified only once
+	check(96, 95, 2)  // crossed the higher threshold
+	check(85, 80, 2)  // going down is not notified
+	check(10, 0, 2)   // the state is reset with the usage
+	check(100, 95, 3) // notified again after reset
+	check(10, 0, 3)
+
+	// the concurrent runner read the namespace before the state is updated by the other runner
+	assert.NoError(t, namespaceService.UpdateByID(ctx, namespaceObj.ID, map[string]any{query.Namespace.Size.ColumnName().String(): 96}))
+	staleObj, err := namespaceService.Get(ctx, namespaceObj.ID)
+	assert.NoError(t, err)
+	check(96, 95, 4)
+
+	daoMockNamespaceService := daomocks.NewMockNamespaceService(ctrl)
+	daoMockNamespaceService.EXPECT().Get(gomock.Any(), namespaceObj.ID).Return(staleObj, nil).Times(1)
+	daoMockNamespaceService.EXPECT().UpdateQuotaThreshold(gomock.Any(), namespaceObj.ID, enums.QuotaKindSize, 0, 95).DoAndReturn(namespaceService.UpdateQuotaThreshold).Times(1)
+	daoMockNamespaceServiceFactory := daomocks.NewMockNamespaceServiceFactory(ctrl)
+	daoMockNamespaceServiceFactory.EXPECT().New(gomock.Any()).DoAndReturn(func(txs ...*query.Query) dao.NamespaceService {
+		return daoMockNamespaceService
+	}).AnyTimes()
+	staleRunner := r
+	staleRunner.namespaceServiceFactory = daoMockNamespaceServiceFactory
+	assert.NoError(t, staleRunner.run(ctx, types.DaemonQuotaPayload{NamespaceID: namespaceObj.ID}))
+	assert.Equal(t, 4, reached) // the crossing is notified only once
+	count, err := query.Audit.WithContext(ctx).Where(query.Audit.ResourceType.Eq(enums.AuditResourceTypeQuota)).Count()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+}
@@ -0,0 +1,164 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/modules/workq"
+	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
+	"github.com/go-sigma/sigma/pkg/scanner"
+	"github.com/go-sigma/sigma/pkg/scanner/trivy"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/compress"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/utils/token"
+)
+
+func init() {
+	workq.TopicHandlers[enums.DaemonSecret] = definition.Consumer{
+		Handler:     decorator(runnerSecret),
+		MaxRetry:    1,
+		Concurrency: 1,
+		Timeout:     time.Minute * 10,
+	}
+	workq.TopicHandlers[enums.DaemonMisconfiguration] = definition.Consumer{
+		Handler:     decorator(runnerMisconfiguration),
+		MaxRetry:    1,
+		Concurrency: 1,
+		Timeout:     time.Minute * 10,
+	}
+}
+
+// contentScanner scans the image contents and returns the raw report and the severity summary of the findings
+type contentScanner func(ctx context.Context, option scanner.Option) ([]byte, types.SeveritySummary, error)
+
+func runnerSecret(ctx context.Context, artifact *models.Artifact, statusChan chan decoratorArtifactStatus) error {
+	return runContentScan(ctx, artifact, statusChan, enums.DaemonSecret, func(ctx context.Context, option scanner.Option) ([]byte, types.SeveritySummary, error) {
+		raw, err := trivy.ScanSecret(ctx, option)
+		if err != nil {
+			return nil, types.SeveritySummary{}, err
+		}
+		items, err := trivy.ParseSecrets(raw)
+		if err != nil {
+			return nil, types.SeveritySummary{}, err
+		}
+		var summary types.SeveritySummary
+		for _, item := range items {
+			countSeverity(&summary, item.Severity)
+		}
+		return raw, summary, nil
+	})
+}
+
+func runnerMisconfiguration(ctx context.Context, artifact *models.Artifact, statusChan chan decoratorArtifactStatus) error {
+	return runContentScan(ctx, artifact, statusChan, enums.DaemonMisconfiguration, func(ctx context.Context, option scanner.Option) ([]byte, types.SeveritySummary, error) {
+		raw, err := trivy.ScanMisconfiguration(ctx, option)
+		if err != nil {
+			return nil, types.SeveritySummary{}, err
+		}
+		items, err := trivy.ParseMisconfigurations(raw)
+		if err != nil {
+			return nil, types.SeveritySummary{}, err
+		}
+		var summary types.SeveritySummary
+		for _, item := range items {
+			countSeverity(&summary, item.Severity)
+		}
+		return raw, summary, nil
+	})
+}
+
+// runContentScan runs the content scanner against the artifact and reports the status to the decorator
+func runContentScan(ctx context.Context, artifact *models.Artifact, statusChan chan decoratorArtifactStatus, daemon enums.Daemon, scan contentScanner) error {
+	defer close(statusChan)
+	statusChan <- decoratorArtifactStatus{Daemon: daemon, Status: enums.TaskCommonStatusDoing, Message: ""}
+
+	config := ptr.To(configs.GetConfiguration())
+	userService := dao.NewUserServiceFactory().New()
+	userObj, err := userService.GetByUsername(ctx, consts.UserInternal)
+	if err != nil {
+		return err
+	}
+	tokenService, err := token.NewTokenService(config.Auth.Jwt.PrivateKey)
+	if err != nil {
+		return err
+	}
+	authorization, err := tokenService.New(userObj.ID, config.Auth.Jwt.Ttl)
+	if err != nil {
+		return err
+	}
+
+	raw, summary, err := scan(ctx, scanner.Option{
+		Endpoint:   config.HTTP.InternalEndpoint,
+		Repository: artifact.Repository.Name,
+		Digest:     artifact.Digest,
+		MediaType:  artifact.ContentType,
+		Token:      authorization,
+	})
+	if err != nil {
+		status := decoratorArtifactStatus{Daemon: daemon, Status: enums.TaskCommonStatusFailed, Message: fmt.Sprintf("Run trivy failed: %s", err.Error())}
+		var execErr *scanner.ExecError
+		if errors.As(err, &execErr) {
+			status.Stdout, status.Stderr = execErr.Stdout, execErr.Stderr
+		}
+		statusChan <- status
+		return err
+	}
+
+	summaryBytes, err := json.Marshal(summary)
+	if err != nil {
+		log.Error().Err(err).Msg("Marshal summary failed")
+		statusChan <- decoratorArtifactStatus{Daemon: daemon, Status: enums.TaskCommonStatusFailed, Message: err.Error()}
+		return err
+	}
+	compressed, err := compress.CompressBytes(raw)
+	if err != nil {
+		log.Error().Err(err).Msg("Compress file failed")
+		statusChan <- decoratorArtifactStatus{Daemon: daemon, Status: enums.TaskCommonStatusFailed, Message: err.Error()}
+		return err
+	}
+
+	log.Info().Str("artifactDigest", artifact.Digest).Str("daemon", daemon.String()).Msg("Success scan artifact contents")
+
+	statusChan <- decoratorArtifactStatus{Daemon: daemon, Status: enums.TaskCommonStatusSuccess, Message: "", Raw: compressed, Result: summaryBytes}
+
+	return nil
+}
+
+// countSeverity counts the severity into the summary, the unknown severity is ignored
+func countSeverity(summary *types.SeveritySummary, severity enums.VulnerabilitySeverity) {
+	switch severity {
+	case enums.VulnerabilitySeverityCritical:
+		summary.Critical++
+	case enums.VulnerabilitySeverityHigh:
+		summary.High++
+	case enums.VulnerabilitySeverityMedium:
+		summary.Medium++
+	case enums.VulnerabilitySeverityLow:
+		summary.Low++
+	}
+}
@@ -16,10 +16,12 @@ package scan
 
 import (
 	"context"
+	"errors"
 	"sync"
 
 	"github.com/rs/zerolog/log"
 	"github.com/tidwall/gjson"
+	"gorm.io/gorm"
 
 	"github.com/go-sigma/sigma/pkg/dal/dao"
 	"github.com/go-sigma/sigma/pkg/dal/models"
@@ -29,13 +31,15 @@ import (
 
 // decoratorArtifactStatus is a status for decorator
 type decoratorArtifactStatus struct {
-	Daemon  enums.Daemon
-	Status  enums.TaskCommonStatus
-	Raw     []byte
-	Result  []byte
-	Stdout  []byte
-	Stderr  []byte
-	Message string
+	Daemon   enums.Daemon
+	Status   enums.TaskCommonStatus
+	Scanner  enums.ScannerType
+	Raw      []byte
+	Result   []byte
+	Metadata []byte
+	Stdout   []byte
+	Stderr   []byte
+	Message  string
 }
 
 // decorator is a decorator for scan task runners
@@ -62,16 +66,13 @@ func decorator(runner func(context.Context, *models.Artifact, chan decoratorArti
 			for status := range statusChan {
 				switch status.Daemon {
 				case enums.DaemonVulnerability:
-					err = artifactService.UpdateVulnerability(context.Background(), id,
-						map[string]any{
-							query.ArtifactVulnerability.Raw.ColumnName().String():     status.Raw,
-							query.ArtifactVulnerability.Result.ColumnName().String():  status.Result,
-							query.ArtifactVulnerability.Status.ColumnName().String():  status.Status,
-							query.ArtifactVulnerability.Stdout.ColumnName().String():  status.Stdout,
-							query.ArtifactVulnerability.Stderr.ColumnName().String():  status.Stderr,
-							query.ArtifactVulnerability.Message.ColumnName().String(): status.Message,
-						},
-					)
+					var vulnerabilityObj *models.ArtifactVulnerability
+					vulnerabilityObj, err = artifactService.GetVulnerability(context.Background(), id)
+					if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+						break
+					}
+					scanned := vulnerabilityObj != nil && vulnerabilityObj.Status == enums.TaskCommonStatusSuccess
+					err = artifactService.UpdateVulnerability(context.Background(), id, vulnerabilityUpdates(status, scanned))
 				case enums.DaemonSbom:
 					err = artifactService.UpdateSbom(context.Background(),
 						id,
@@ -84,6 +85,30 @@ func decorator(runner func(context.Context, *models.Artifact, chan decoratorArti
 							query.ArtifactSbom.Message.ColumnName().String(): status.Message,
 						},
 					)
+				case enums.DaemonSecret:
+					err = artifactService.UpdateSecret(context.Background(),
+						id,
+						map[string]any{
+							query.ArtifactSecret.Raw.ColumnName().String():     status.Raw,
+							query.ArtifactSecret.Result.ColumnName().String():  status.Result,
+							query.ArtifactSecret.Status.ColumnName().String():  status.Status,
+							query.ArtifactSecret.Stdout.ColumnName().String():  status.Stdout,
+							query.ArtifactSecret.Stderr.ColumnName().String():  status.Stderr,
+							query.ArtifactSecret.Message.ColumnName().String(): status.Message,
+						},
+					)
+				case enums.DaemonMisconfiguration:
+					err = artifactService.UpdateMisconfiguration(context.Background(),
+						id,
+						map[string]any{
+							query.ArtifactMisconfiguration.Raw.ColumnName().String():     status.Raw,
+							query.ArtifactMisconfiguration.Result.ColumnName().String():  status.Result,
+							query.ArtifactMisconfiguration.Status.ColumnName().String():  status.Status,
+							query.ArtifactMisconfiguration.Stdout.ColumnName().String():  status.Stdout,
+							query.ArtifactMisconfiguration.Stderr.ColumnName().String():  status.Stderr,
+							query.ArtifactMisconfiguration.Message.ColumnName().String(): status.Message,
+						},
+					)
 				default:
 					continue
 				}
@@ -103,3 +128,26 @@ func decorator(runner func(context.Context, *models.Artifact, chan decoratorArti
 		return nil
 	}
 }
+
+// vulnerabilityUpdates returns the columns to update with the vulnerability scan status, the status and result of
+// the last successful scan are kept until the new scan succeeds, so the pull policy is never checked against a
+// rescan in progress or a failed one. The progress of the new scan is recorded in the scan status.
+func vulnerabilityUpdates(status decoratorArtifactStatus, scanned bool) map[string]any {
+	updates := map[string]any{
+		query.ArtifactVulnerability.ScanStatus.ColumnName().String(): status.Status,
+		query.ArtifactVulnerability.Stdout.ColumnName().String():     status.Stdout,
+		query.ArtifactVulnerability.Stderr.ColumnName().String():     status.Stderr,
+		query.ArtifactVulnerability.Message.ColumnName().String():    status.Message,
+	}
+	if scanned && status.Status != enums.TaskCommonStatusSuccess {
+		return updates
+	}
+	updates[query.ArtifactVulnerability.Raw.ColumnName().String()] = status.Raw
+	updates[query.ArtifactVulnerability.Result.ColumnName().String()] = status.Result
+	updates[query.ArtifactVulnerability.Metadata.ColumnName().String()] = status.Metadata
+	updates[query.ArtifactVulnerability.Status.ColumnName().String()] = status.Status
+	if status.Scanner != "" {
+		updates[query.ArtifactVulnerability.Scanner.ColumnName().String()] = status.Scanner
+	}
+	return updates
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scan
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-sigma/sigma/pkg/dal"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/logger"
+	"github.com/go-sigma/sigma/pkg/tests"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+func TestDecoratorVulnerabilityRescan(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	userObj := &models.User{Username: "decorator", Password: ptr.Of("test"), Email: ptr.Of("test@gmail.com")}
+	assert.NoError(t, dao.NewUserServiceFactory().New().Create(ctx, userObj))
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+	repositoryObj := &models.Repository{Name: "test/busybox", NamespaceID: namespaceObj.ID}
+	assert.NoError(t, dao.NewRepositoryServiceFactory().New().Create(ctx, repositoryObj, dao.AutoCreateNamespace{UserID: userObj.ID}))
+
+	artifactService := dao.NewArtifactServiceFactory().New()
+	artifactObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:xxxx", Size: 123, ContentType: "test", Raw: []byte("test")}
+	assert.NoError(t, artifactService.Create(ctx, artifactObj))
+	assert.NoError(t, artifactService.CreateVulnerability(ctx, &models.ArtifactVulnerability{ArtifactID: artifactObj.ID, Status: enums.TaskCommonStatusPending}))
+
+	run := func(statuses ...decoratorArtifactStatus) {
+		err := decorator(func(_ context.Context, _ *models.Artifact, statusChan chan decoratorArtifactStatus) error {
+			defer close(statusChan)
+			for _, status := range statuses {
+				statusChan <- status
+			}
+			return nil
+		})(ctx, []byte(fmt.Sprintf(`{"artifact_id":%d}`, artifactObj.ID)))
+		assert.NoError(t, err)
+	}
+	check := func(status, scanStatus enums.TaskCommonStatus, result string) {
+		vulnerabilityObj, err := artifactService.GetVulnerability(ctx, artifactObj.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, status, vulnerabilityObj.Status)
+		assert.Equal(t, scanStatus, vulnerabilityObj.ScanStatus)
+		assert.Equal(t, result, string(vulnerabilityObj.Result))
+	}
+
+	// the first scan is failed, nothing can be kept
+	run(decoratorArtifactStatus{Daemon: enums.DaemonVulnerability, Status: enums.TaskCommonStatusDoing},
+		decoratorArtifactStatus{Daemon: enums.DaemonVulnerability, Status: enums.TaskCommonStatusFailed, Message: "failed"})
+	check(enums.TaskCommonStatusFailed, enums.TaskCommonStatusFailed, "")
+
+	run(decoratorArtifactStatus{Daemon: enums.DaemonVulnerability, Status: enums.TaskCommonStatusDoing},
+		decoratorArtifactStatus{Daemon: enums.DaemonVulnerability, Status: enums.TaskCommonStatusSuccess, Result: []byte("first")})
+	check(enums.TaskCommonStatusSuccess, enums.TaskCommonStatusSuccess, "first")
+
+	// the rescan in progress and the failed rescan keep the last successful result
+	run(decoratorArtifactStatus{Daemon: enums.DaemonVulnerability, Status: enums.TaskCommonStatusDoing})
+	check(enums.TaskCommonStatusSuccess, enums.TaskCommonStatusDoing, "first")
+	run(decoratorArtifactStatus{Daemon: enums.DaemonVulnerability, Status: enums.TaskCommonStatusFailed, Message: "failed"})
+	check(enums.TaskCommonStatusSuccess, enums.TaskCommonStatusFailed, "first")
+
+	run(decoratorArtifactStatus{Daemon: enums.DaemonVulnerability, Status: enums.TaskCommonStatusSuccess, Result: []byte("second")})
+	check(enums.TaskCommonStatusSuccess, enums.TaskCommonStatusSuccess, "second")
+}
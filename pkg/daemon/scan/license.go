@@ -0,0 +1,89 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scan
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/modules/workq"
+	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/license"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+// checkLicensePolicy checks the packages of the artifact with the license policy of the namespace,
+// the result is saved in the artifact sbom and a webhook event is emitted if the policy is violated.
+func checkLicensePolicy(ctx context.Context, artifact *models.Artifact, packages []*models.ArtifactPackage) error {
+	policyObj, err := dao.NewPolicyServiceFactory().New().GetLicensePolicy(ctx, artifact.NamespaceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	if !policyObj.Enabled {
+		return nil
+	}
+	result := license.Check(policyObj, packages)
+	log.Info().Str("artifactDigest", artifact.Digest).Str("status", result.Status.String()).
+		Int("violations", len(result.Violations)).Int("warnings", len(result.Warnings)).Msg("License policy checked")
+
+	namespaceObj, err := dao.NewNamespaceServiceFactory().New().Get(ctx, artifact.NamespaceID)
+	if err != nil {
+		return err
+	}
+	repositoryObj, err := dao.NewRepositoryServiceFactory().New().Get(ctx, artifact.RepositoryID)
+	if err != nil {
+		return err
+	}
+
+	return query.Q.Transaction(func(tx *query.Query) error {
+		err := dao.NewArtifactServiceFactory().New(tx).UpdateSbom(ctx, artifact.ID, map[string]any{
+			query.ArtifactSbom.LicenseStatus.ColumnName().String(): result.Status,
+			query.ArtifactSbom.LicenseResult.ColumnName().String(): utils.MustMarshal(result),
+		})
+		if err != nil {
+			return err
+		}
+		if result.Status != enums.LicenseCheckStatusViolated {
+			return nil
+		}
+		return workq.ProducerClient.Produce(ctx, enums.DaemonWebhook, types.DaemonWebhookPayload{
+			NamespaceID:  ptr.Of(artifact.NamespaceID),
+			Action:       enums.WebhookActionViolated,
+			ResourceType: enums.WebhookResourceTypeArtifact,
+			Payload: utils.MustMarshal(types.WebhookPayloadLicenseViolation{
+				WebhookPayload: types.WebhookPayload{
+					ResourceType: enums.WebhookResourceTypeArtifact,
+					Action:       enums.WebhookActionViolated,
+				},
+				Namespace:  namespaceObj.Name,
+				Repository: repositoryObj.Name,
+				Digest:     artifact.Digest,
+				Violations: result.Violations,
+			}),
+		}, definition.ProducerOption{Tx: tx})
+	})
+}
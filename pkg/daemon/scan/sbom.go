@@ -33,6 +33,7 @@ import (
 	"github.com/go-sigma/sigma/pkg/consts"
 	"github.com/go-sigma/sigma/pkg/dal/dao"
 	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
 	"github.com/go-sigma/sigma/pkg/modules/workq"
 	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
 	"github.com/go-sigma/sigma/pkg/types/enums"
@@ -178,6 +179,23 @@ func runnerSbom(ctx context.Context, artifact *models.Artifact, statusChan chan
 		return err
 	}
 
+	if config.Daemon.Sbom.Referrer.Enabled {
+		err = pushSbomReferrer(ctx, config, authorization, artifact, compressed)
+		if err != nil {
+			log.Error().Err(err).Str("artifactDigest", artifact.Digest).Msg("Push sbom referrer failed")
+		}
+	}
+
+	packages, err := indexSbomPackages(ctx, artifact, syftObj)
+	if err != nil {
+		log.Error().Err(err).Str("artifactDigest", artifact.Digest).Msg("Index sbom packages failed")
+	} else {
+		err = checkLicensePolicy(ctx, artifact, packages)
+		if err != nil {
+			log.Error().Err(err).Str("artifactDigest", artifact.Digest).Msg("Check license policy failed")
+		}
+	}
+
 	log.Info().Str("artifactDigest", artifact.Digest).Msg("Success sbom artifact")
 
 	statusChan <- decoratorArtifactStatus{Daemon: enums.DaemonSbom,
@@ -186,3 +204,38 @@ func runnerSbom(ctx context.Context, artifact *models.Artifact, statusChan chan
 
 	return nil
 }
+
+// indexSbomPackages index the packages of the syft result, so the packages can be searched across all of the sboms
+func indexSbomPackages(ctx context.Context, artifact *models.Artifact, syftObj syftTypes.Document) ([]*models.ArtifactPackage, error) {
+	packages := make([]*models.ArtifactPackage, 0, len(syftObj.Artifacts))
+	for _, p := range syftObj.Artifacts {
+		if p.Name == "" {
+			continue
+		}
+		var licenses = make([]string, 0, len(p.Licenses))
+		for _, l := range p.Licenses {
+			if l.SPDXExpression != "" {
+				licenses = append(licenses, l.SPDXExpression)
+			} else if l.Value != "" {
+				licenses = append(licenses, l.Value)
+			}
+		}
+		packages = append(packages, &models.ArtifactPackage{
+			NamespaceID:  artifact.NamespaceID,
+			RepositoryID: artifact.RepositoryID,
+			ArtifactID:   artifact.ID,
+			Name:         p.Name,
+			Version:      p.Version,
+			Type:         string(p.Type),
+			Purl:         p.PURL,
+			Licenses:     strings.Join(licenses, ","),
+		})
+	}
+	err := query.Q.Transaction(func(tx *query.Query) error {
+		return dao.NewArtifactServiceFactory().New(tx).ReplacePackages(ctx, artifact.ID, packages)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return packages, nil
+}
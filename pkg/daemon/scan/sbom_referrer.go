@@ -0,0 +1,81 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scan
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/rs/zerolog/log"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/utils/referrer"
+	"github.com/go-sigma/sigma/pkg/utils/sbom"
+)
+
+// pushSbomReferrer pushes the sbom to the repository of the artifact as an oci referrer artifact,
+// the subject of the referrer is the artifact, so it can be discovered with the referrers api.
+// The push is skipped if the artifact already has a sbom referrer in the same format, the converted
+// sbom document is not reproducible, so pushing it again would create another referrer.
+func pushSbomReferrer(ctx context.Context, config configs.Configuration, authorization string, artifact *models.Artifact, raw []byte) error {
+	format := config.Daemon.Sbom.Referrer.Format
+	mediaType := sbom.MediaType(format)
+	referrers, err := dao.NewArtifactServiceFactory().New().GetReferrers(ctx, artifact.RepositoryID, artifact.Digest, []string{mediaType})
+	if err != nil {
+		return fmt.Errorf("get sbom referrers failed: %w", err)
+	}
+	if len(referrers) > 0 {
+		log.Info().Str("artifactDigest", artifact.Digest).Str("referrerDigest", referrers[0].Digest).Msg("Sbom referrer already exists, skip pushing")
+		return nil
+	}
+	content, err := sbom.Convert(raw, format)
+	if err != nil {
+		return err
+	}
+	manifest := imgspecv1.Manifest{
+		Versioned:    specs.Versioned{SchemaVersion: 2},
+		MediaType:    imgspecv1.MediaTypeImageManifest,
+		ArtifactType: mediaType,
+		Config:       imgspecv1.DescriptorEmptyJSON,
+		Layers: []imgspecv1.Descriptor{
+			{
+				MediaType:   mediaType,
+				Digest:      digest.FromBytes(content),
+				Size:        int64(len(content)),
+				Annotations: map[string]string{imgspecv1.AnnotationTitle: fmt.Sprintf("sbom.%s", sbom.Extension(format))},
+			},
+		},
+		Subject: &imgspecv1.Descriptor{
+			MediaType: artifact.ContentType,
+			Digest:    digest.Digest(artifact.Digest),
+			Size:      int64(len(artifact.Raw)),
+		},
+		Annotations: map[string]string{imgspecv1.AnnotationCreated: time.UnixMilli(artifact.CreatedAt).UTC().Format(time.RFC3339)},
+	}
+	manifestDigest, err := referrer.Push(ctx, config.HTTP.InternalEndpoint, authorization, artifact.Repository.Name, manifest, imgspecv1.DescriptorEmptyJSON.Data, content)
+	if err != nil {
+		return fmt.Errorf("push sbom referrer failed: %w", err)
+	}
+
+	log.Info().Str("artifactDigest", artifact.Digest).Str("referrerDigest", manifestDigest.String()).Msg("Push sbom referrer success")
+
+	return nil
+}
@@ -15,17 +15,12 @@
 package scan
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"os"
-	"os/exec"
-	"strings"
 	"time"
 
-	"github.com/aquasecurity/trivy/pkg/types"
-	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 
 	"github.com/go-sigma/sigma/pkg/configs"
@@ -34,8 +29,9 @@ import (
 	"github.com/go-sigma/sigma/pkg/dal/models"
 	"github.com/go-sigma/sigma/pkg/modules/workq"
 	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
+	"github.com/go-sigma/sigma/pkg/scanner"
+	"github.com/go-sigma/sigma/pkg/types"
 	"github.com/go-sigma/sigma/pkg/types/enums"
-	"github.com/go-sigma/sigma/pkg/utils"
 	"github.com/go-sigma/sigma/pkg/utils/compress"
 	"github.com/go-sigma/sigma/pkg/utils/ptr"
 	"github.com/go-sigma/sigma/pkg/utils/token"
@@ -50,14 +46,6 @@ func init() {
 	}
 }
 
-// reportVulnerability ...
-type reportVulnerability struct {
-	Critical int64 `json:"critical"`
-	High     int64 `json:"high"`
-	Medium   int64 `json:"medium"`
-	Low      int64 `json:"low"`
-}
-
 func runnerVulnerability(ctx context.Context, artifact *models.Artifact, statusChan chan decoratorArtifactStatus) error {
 	defer close(statusChan)
 	statusChan <- decoratorArtifactStatus{Daemon: enums.DaemonVulnerability, Status: enums.TaskCommonStatusDoing, Message: ""}
@@ -77,90 +65,66 @@ func runnerVulnerability(ctx context.Context, artifact *models.Artifact, statusC
 		return err
 	}
 
-	image := fmt.Sprintf("%s/%s@%s", utils.TrimHTTP(config.HTTP.InternalEndpoint), artifact.Repository.Name, artifact.Digest)
-	filename := fmt.Sprintf("%s.trivy.json", uuid.New().String())
-	cmd := exec.Command("trivy", "image")
-	if strings.HasPrefix(config.HTTP.InternalEndpoint, "https://") {
-		cmd.Args = append(cmd.Args, "--insecure")
-	}
-	cmd.Args = append(cmd.Args, "-q", "--format", "json", "--parallel", "2", "--scanners", "vuln", "--output", filename,
-		"--skip-db-update", "--skip-java-db-update")
-	if utils.IsDir("/opt/trivy") {
-		cmd.Args = append(cmd.Args, "--offline-scan", "--cache-dir", "/opt/trivy")
+	namespaceObj, err := dao.NewNamespaceServiceFactory().New().Get(ctx, artifact.NamespaceID)
+	if err != nil {
+		log.Error().Err(err).Int64("namespaceID", artifact.NamespaceID).Msg("Get namespace failed")
+		statusChan <- decoratorArtifactStatus{Daemon: enums.DaemonVulnerability, Status: enums.TaskCommonStatusFailed, Message: err.Error()}
+		return err
 	}
-	cmd.Args = append(cmd.Args, image)
-	cmd.Env = append(cmd.Env, fmt.Sprintf("TRIVY_REGISTRY_TOKEN=%s", authorization))
-	var stdout bytes.Buffer
-	var stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	log.Info().Str("artifactDigest", artifact.Digest).Str("cmd", cmd.String()).Msg("Start scan artifact")
-
-	defer func() {
-		if utils.IsFile(filename) {
-			err := os.Remove(filename)
-			if err != nil {
-				log.Error().Err(err).Msg("Remove file failed")
-			}
-		}
-	}()
-
-	err = cmd.Run()
+	scannerType := scanner.Type(config, namespaceObj.Scanner)
+	scannerObj, err := scanner.New(config, namespaceObj.Scanner)
 	if err != nil {
-		var stdoutBytes = stdout.Bytes()
-		var stderrBytes = stderr.Bytes()
-		log.Error().Err(err).Str("stdout", string(stdoutBytes)).Str("stderr", string(stderrBytes)).Strs("env", cmd.Env).Str("cmd", cmd.String()).Msg("Run trivy failed")
-		statusChan <- decoratorArtifactStatus{
-			Daemon:  enums.DaemonVulnerability,
-			Status:  enums.TaskCommonStatusFailed,
-			Stdout:  stdoutBytes,
-			Stderr:  stderrBytes,
-			Message: fmt.Sprintf("Run trivy failed: %s", err.Error()),
-		}
+		log.Error().Err(err).Str("scanner", scannerType.String()).Msg("Create scanner failed")
+		statusChan <- decoratorArtifactStatus{Daemon: enums.DaemonVulnerability, Status: enums.TaskCommonStatusFailed, Message: err.Error()}
 		return err
 	}
 
-	var trivyObj types.Report
-	fileContent, err := os.Open(filename)
+	reportObj, err := scannerObj.Scan(ctx, scanner.Option{
+		Endpoint:   config.HTTP.InternalEndpoint,
+		Repository: artifact.Repository.Name,
+		Digest:     artifact.Digest,
+		MediaType:  artifact.ContentType,
+		Token:      authorization,
+	})
 	if err != nil {
-		log.Error().Err(err).Str("filename", filename).Msg("Open trivy file failed")
-		statusChan <- decoratorArtifactStatus{
+		status := decoratorArtifactStatus{
 			Daemon:  enums.DaemonVulnerability,
 			Status:  enums.TaskCommonStatusFailed,
-			Stdout:  []byte(""),
-			Stderr:  []byte(""),
-			Message: fmt.Sprintf("Open trivy file(%s) failed: %v", filename, err),
+			Scanner: scannerType,
+			Message: fmt.Sprintf("Run %s failed: %s", scannerType, err.Error()),
+		}
+		var execErr *scanner.ExecError
+		if errors.As(err, &execErr) {
+			status.Stdout, status.Stderr = execErr.Stdout, execErr.Stderr
 		}
+		statusChan <- status
 		return err
 	}
-	err = json.NewDecoder(fileContent).Decode(&trivyObj)
+
+	items, err := scannerObj.Parse(reportObj.Raw)
 	if err != nil {
-		log.Error().Err(err).Str("filename", filename).Msg("Decode trivy file failed")
-		statusChan <- decoratorArtifactStatus{
-			Daemon:  enums.DaemonVulnerability,
-			Status:  enums.TaskCommonStatusFailed,
-			Stdout:  []byte(""),
-			Stderr:  []byte(""),
-			Message: fmt.Sprintf("Decode trivy file(%s) failed: %v", filename, err),
-		}
+		log.Error().Err(err).Str("scanner", scannerType.String()).Msg("Parse report failed")
+		statusChan <- decoratorArtifactStatus{Daemon: enums.DaemonVulnerability, Status: enums.TaskCommonStatusFailed, Scanner: scannerType, Message: err.Error()}
 		return err
 	}
-	var report reportVulnerability
-	for _, result := range trivyObj.Results {
-		for _, v := range result.Vulnerabilities {
-			switch v.Severity {
-			case "CRITICAL":
-				report.Critical++
-			case "HIGH":
-				report.High++
-			case "MEDIUM":
-				report.Medium++
-			case "LOW":
-				report.Low++
-			}
+	var report types.VulnerabilitySummary
+	for _, item := range items {
+		if item.Severity == enums.VulnerabilitySeverityNone {
+			continue
 		}
+		report.Findings = append(report.Findings, types.VulnerabilityFinding{
+			ID:       item.ID,
+			Package:  item.Package,
+			Severity: item.Severity,
+		})
+	}
+	allowlist, err := dao.NewPolicyServiceFactory().New().ListActiveVulnerabilityAllowlist(ctx, artifact.NamespaceID, artifact.RepositoryID)
+	if err != nil {
+		log.Error().Err(err).Msg("List vulnerability allowlist failed")
+		statusChan <- decoratorArtifactStatus{Daemon: enums.DaemonVulnerability, Status: enums.TaskCommonStatusFailed, Message: err.Error()}
+		return err
 	}
+	report = scanner.SuppressVulnerabilities(report, allowlist)
 	reportBytes, err := json.Marshal(report)
 	if err != nil {
 		log.Error().Err(err).Msg("Marshal report failed")
@@ -168,7 +132,7 @@ func runnerVulnerability(ctx context.Context, artifact *models.Artifact, statusC
 		return err
 	}
 
-	compressed, err := compress.Compress(filename)
+	compressed, err := compress.CompressBytes(reportObj.Raw)
 	if err != nil {
 		log.Error().Err(err).Msg("Compress file failed")
 		statusChan <- decoratorArtifactStatus{Daemon: enums.DaemonVulnerability, Status: enums.TaskCommonStatusFailed, Message: err.Error()}
@@ -177,7 +141,8 @@ func runnerVulnerability(ctx context.Context, artifact *models.Artifact, statusC
 
 	log.Info().Str("artifactDigest", artifact.Digest).Msg("Success scan artifact")
 
-	statusChan <- decoratorArtifactStatus{Daemon: enums.DaemonVulnerability, Status: enums.TaskCommonStatusSuccess, Message: "", Raw: compressed, Result: reportBytes}
+	statusChan <- decoratorArtifactStatus{Daemon: enums.DaemonVulnerability, Status: enums.TaskCommonStatusSuccess, Message: "",
+		Scanner: scannerType, Raw: compressed, Result: reportBytes, Metadata: reportObj.Metadata}
 
 	return nil
 }
@@ -139,20 +139,22 @@ func (w webhook) send(ctx context.Context, payload types.DaemonWebhookPayload) e
 		query.Webhook.NamespaceID.ColumnName().String(): payload.NamespaceID,
 	}
 	switch payload.ResourceType {
-	case enums.WebhookResourceTypeNamespace:
+	case enums.WebhookResourceTypeNamespace, enums.WebhookResourceTypeQuota:
 		filter[query.Webhook.EventNamespace.ColumnName().String()] = true
 	case enums.WebhookResourceTypeRepository:
 		filter[query.Webhook.EventRepository.ColumnName().String()] = true
 	case enums.WebhookResourceTypeTag:
 		filter[query.Webhook.EventTag.ColumnName().String()] = true
-	case enums.WebhookResourceTypeArtifact:
+	case enums.WebhookResourceTypeArtifact, enums.WebhookResourceTypeVulnerabilityAllowlist:
 		filter[query.Webhook.EventArtifact.ColumnName().String()] = true
 	case enums.WebhookResourceTypeMember:
 		filter[query.Webhook.EventMember.ColumnName().String()] = true
 	case enums.WebhookResourceTypeDaemonTaskGcArtifactRule, enums.WebhookResourceTypeDaemonTaskGcArtifactRunner,
 		enums.WebhookResourceTypeDaemonTaskGcBlobRule, enums.WebhookResourceTypeDaemonTaskGcBlobRunner,
 		enums.WebhookResourceTypeDaemonTaskGcRepositoryRule, enums.WebhookResourceTypeDaemonTaskGcRepositoryRunner,
-		enums.WebhookResourceTypeDaemonTaskGcTagRule, enums.WebhookResourceTypeDaemonTaskGcTagRunner:
+		enums.WebhookResourceTypeDaemonTaskGcTagRule, enums.WebhookResourceTypeDaemonTaskGcTagRunner,
+		enums.WebhookResourceTypeDaemonTaskGcBlobUploadRule, enums.WebhookResourceTypeDaemonTaskGcBlobUploadRunner,
+		enums.WebhookResourceTypeDaemonTaskGcPipelineRunner:
 		filter[query.Webhook.EventDaemonTaskGc.ColumnName().String()] = true
 	}
 	webhookObjs, err := webhookService.GetByFilter(ctx, filter)
@@ -40,6 +40,9 @@ func main() {
 		models.Repository{},
 		models.Artifact{},
 		models.ArtifactSbom{},
+		models.ArtifactPackage{},
+		models.ArtifactSecret{},
+		models.ArtifactMisconfiguration{},
 		models.ArtifactVulnerability{},
 		models.Tag{},
 		models.Blob{},
@@ -63,7 +66,19 @@ func main() {
 		models.DaemonGcBlobRule{},
 		models.DaemonGcBlobRunner{},
 		models.DaemonGcBlobRecord{},
+		models.DaemonGcBlobUploadRule{},
+		models.DaemonGcBlobUploadRunner{},
+		models.DaemonGcBlobUploadRecord{},
+		models.DaemonGcPipelineRule{},
+		models.DaemonGcPipelineRunner{},
 		models.NamespaceMember{},
+		models.VulnerabilityPolicy{},
+		models.VulnerabilityAllowlist{},
+		models.VulnerabilityRescanPolicy{},
+		models.LicensePolicy{},
+		models.SignaturePolicy{},
+		models.SigningPolicy{},
+		models.SigningKey{},
 	)
 
 	g.ApplyInterface(func(models.ArtifactSizeByNamespaceOrRepository) {}, models.Artifact{})
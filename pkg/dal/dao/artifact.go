@@ -21,12 +21,17 @@ import (
 
 	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/spf13/cast"
+	"gorm.io/gen"
+	"gorm.io/gen/field"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
+	"github.com/go-sigma/sigma/pkg/consts"
 	"github.com/go-sigma/sigma/pkg/dal/models"
 	"github.com/go-sigma/sigma/pkg/dal/query"
 	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
 	"github.com/go-sigma/sigma/pkg/utils/ptr"
 )
 
@@ -39,6 +44,17 @@ type ArtifactService interface {
 	Create(ctx context.Context, artifact *models.Artifact) error
 	// FindWithLastPull ...
 	FindWithLastPull(ctx context.Context, repositoryID int64, before int64, limit, last int64) ([]*models.Artifact, error)
+	// FindUntaggedWithCursor finds the artifacts of the repository which are neither tagged nor referred to another artifact,
+	// the tags deleted after deletedAfter are still in the recycle bin and keep the artifact tagged.
+	FindUntaggedWithCursor(ctx context.Context, repositoryID int64, deletedAfter int64, limit int, last int64) ([]*models.Artifact, error)
+	// FindDanglingReferrers finds the referrers of the repository which subject is deleted before the specified time,
+	// the specified time should be before the recycle bin retention, the subject in the recycle bin can be restored.
+	FindDanglingReferrers(ctx context.Context, repositoryID int64, before int64, limit int, last int64) ([]*models.Artifact, error)
+	// GetDeletedByDigest gets the latest deleted artifact with the specified digest.
+	GetDeletedByDigest(ctx context.Context, repositoryID int64, digest string) (*models.Artifact, error)
+	// FindWithPulledAfter finds the image artifacts pulled after the specified time with the vulnerability,
+	// the artifacts in all of the namespaces will be found if namespaceID is nil.
+	FindWithPulledAfter(ctx context.Context, namespaceID *int64, after int64, limit, last int64) ([]*models.Artifact, error)
 	// FindAssociateWithTag ...
 	FindAssociateWithTag(ctx context.Context, ids []int64) ([]int64, error)
 	// FindAssociateWithArtifact ...
@@ -49,7 +65,7 @@ type ArtifactService interface {
 	GetByDigest(ctx context.Context, repositoryID int64, digest string) (*models.Artifact, error)
 	// GetByDigests gets the artifacts with the specified digests.
 	GetByDigests(ctx context.Context, repository string, digests []string) ([]*models.Artifact, error)
-	// DeleteByDigest deletes the artifact with the specified digest.
+	// DeleteByDigest deletes the artifact with the specified digest, the blobs associated with the artifact are kept.
 	DeleteByDigest(ctx context.Context, repository, digest string) error
 	// AssociateBlobs associates the blobs with the artifact.
 	AssociateBlobs(ctx context.Context, artifact *models.Artifact, blobs []*models.Blob) error
@@ -69,6 +85,13 @@ type ArtifactService interface {
 	DeleteByID(ctx context.Context, id int64) error
 	// DeleteByIDs deletes the artifact with the specified artifact ID.
 	DeleteByIDs(ctx context.Context, ids []int64) error
+	// ListDeleted lists the artifacts of the namespace deleted after the specified time,
+	// the repository of the artifact is filled even if it is deleted.
+	ListDeleted(ctx context.Context, namespaceID int64, after int64, pagination types.Pagination) ([]*models.Artifact, int64, error)
+	// GetDeleted gets the artifact with the specified artifact ID which is deleted after the specified time.
+	GetDeleted(ctx context.Context, id int64, after int64) (*models.Artifact, error)
+	// Restore restores the deleted artifact with the specified artifact ID.
+	Restore(ctx context.Context, id int64) error
 	// CreateSbom create a new artifact sbom.
 	CreateSbom(ctx context.Context, sbom *models.ArtifactSbom) error
 	// CreateVulnerability save a new artifact vulnerability.
@@ -77,10 +100,39 @@ type ArtifactService interface {
 	UpdateSbom(ctx context.Context, artifactID int64, updates map[string]any) error
 	// UpdateVulnerability update the artifact vulnerability.
 	UpdateVulnerability(ctx context.Context, artifactID int64, updates map[string]any) error
-	// GetNamespaceSize get the specific namespace size
+	// GetSbom get the artifact sbom.
+	GetSbom(ctx context.Context, artifactID int64) (*models.ArtifactSbom, error)
+	// ReplacePackages replace the packages indexed from the artifact sbom.
+	ReplacePackages(ctx context.Context, artifactID int64, packages []*models.ArtifactPackage) error
+	// ListPackages list the packages indexed from the artifact sbom.
+	ListPackages(ctx context.Context, artifactID int64) ([]*models.ArtifactPackage, error)
+	// SearchPackages search the packages with the specified name in all of the artifact sboms which the user can read,
+	// versions is nil means all of the versions are matched.
+	SearchPackages(ctx context.Context, userID int64, name string, pkgType *string, namespaceID *int64, versions []string, pagination types.Pagination) ([]*models.ArtifactPackage, int64, error)
+	// ListPackageVersions list the distinct versions of the packages with the specified name which the user can read.
+	ListPackageVersions(ctx context.Context, userID int64, name string, pkgType *string, namespaceID *int64) ([]string, error)
+	// GetVulnerability get the artifact vulnerability.
+	GetVulnerability(ctx context.Context, artifactID int64) (*models.ArtifactVulnerability, error)
+	// CreateSecret save a new artifact secret scan result.
+	CreateSecret(ctx context.Context, secret *models.ArtifactSecret) error
+	// UpdateSecret update the artifact secret scan result.
+	UpdateSecret(ctx context.Context, artifactID int64, updates map[string]any) error
+	// GetSecret get the artifact secret scan result.
+	GetSecret(ctx context.Context, artifactID int64) (*models.ArtifactSecret, error)
+	// CreateMisconfiguration save a new artifact misconfiguration scan result.
+	CreateMisconfiguration(ctx context.Context, misconfiguration *models.ArtifactMisconfiguration) error
+	// UpdateMisconfiguration update the artifact misconfiguration scan result.
+	UpdateMisconfiguration(ctx context.Context, artifactID int64, updates map[string]any) error
+	// GetMisconfiguration get the artifact misconfiguration scan result.
+	GetMisconfiguration(ctx context.Context, artifactID int64) (*models.ArtifactMisconfiguration, error)
+	// GetNamespaceSize get the specific namespace size, the blob shared by the artifacts is counted once
 	GetNamespaceSize(ctx context.Context, namespaceID int64) (int64, error)
-	// GetRepositorySize get the specific repository size
+	// GetRepositorySize get the specific repository size, the blob shared by the artifacts is counted once
 	GetRepositorySize(ctx context.Context, repositoryID int64) (int64, error)
+	// GetNamespaceSizeIncrease get the size of the blobs that not referenced by the specific namespace yet
+	GetNamespaceSizeIncrease(ctx context.Context, namespaceID int64, blobIDs []int64) (int64, error)
+	// GetRepositorySizeIncrease get the size of the blobs that not referenced by the specific repository yet
+	GetRepositorySizeIncrease(ctx context.Context, repositoryID int64, blobIDs []int64) (int64, error)
 	// GetReferrers ...
 	GetReferrers(ctx context.Context, repositoryID int64, digest string, artifactTypes []string) ([]*models.Artifact, error)
 	// IsArtifactAssociatedWithArtifact ...
@@ -127,6 +179,49 @@ func (s *artifactService) FindWithLastPull(ctx context.Context, repositoryID int
 		Limit(int(limit)).Order(s.tx.Artifact.ID).Find()
 }
 
+// FindUntaggedWithCursor finds the artifacts of the repository which are neither tagged nor referred to another artifact,
+// the tags deleted after deletedAfter are still in the recycle bin and keep the artifact tagged.
+func (s *artifactService) FindUntaggedWithCursor(ctx context.Context, repositoryID int64, deletedAfter int64, limit int, last int64) ([]*models.Artifact, error) {
+	return s.tx.Artifact.WithContext(ctx).
+		Where(s.tx.Artifact.ID.Gt(last), s.tx.Artifact.RepositoryID.Eq(repositoryID), s.tx.Artifact.ReferrerID.IsNull()).
+		Where(s.tx.Artifact.WithContext(ctx).Columns(s.tx.Artifact.ID).NotIn(
+			s.tx.Tag.WithContext(ctx).Unscoped().Select(s.tx.Tag.ArtifactID).
+				Where(s.tx.Tag.RepositoryID.Eq(repositoryID)).
+				Where(s.tx.Tag.WithContext(ctx).Where(s.tx.Tag.DeletedAt.Eq(0)).Or(s.tx.Tag.DeletedAt.Gt(uint64(deletedAfter)))),
+		)).
+		Limit(limit).Order(s.tx.Artifact.ID).Find()
+}
+
+// FindDanglingReferrers finds the referrers of the repository which subject is deleted before the specified time.
+func (s *artifactService) FindDanglingReferrers(ctx context.Context, repositoryID int64, before int64, limit int, last int64) ([]*models.Artifact, error) {
+	subject := s.tx.Artifact.As("subjects")
+	return s.tx.Artifact.WithContext(ctx).
+		Join(subject, s.tx.Artifact.ReferrerID.EqCol(subject.ID)).
+		Where(s.tx.Artifact.ID.Gt(last), s.tx.Artifact.RepositoryID.Eq(repositoryID)).
+		Where(subject.DeletedAt.Neq(0), subject.DeletedAt.Lt(uint64(before))).
+		Limit(limit).Order(s.tx.Artifact.ID).Find()
+}
+
+// GetDeletedByDigest gets the latest deleted artifact with the specified digest.
+func (s *artifactService) GetDeletedByDigest(ctx context.Context, repositoryID int64, digest string) (*models.Artifact, error) {
+	return s.tx.Artifact.WithContext(ctx).Unscoped().
+		Where(s.tx.Artifact.RepositoryID.Eq(repositoryID), s.tx.Artifact.Digest.Eq(digest), s.tx.Artifact.DeletedAt.Neq(0)).
+		Order(s.tx.Artifact.DeletedAt.Desc()).First()
+}
+
+// FindWithPulledAfter finds the image artifacts pulled after the specified time with the vulnerability,
+// the artifacts in all of the namespaces will be found if namespaceID is nil.
+func (s *artifactService) FindWithPulledAfter(ctx context.Context, namespaceID *int64, after int64, limit, last int64) ([]*models.Artifact, error) {
+	q := s.tx.Artifact.WithContext(ctx).
+		Preload(s.tx.Artifact.Vulnerability).
+		Where(s.tx.Artifact.ID.Gt(last), s.tx.Artifact.Type.Eq(enums.ArtifactTypeImage)).
+		Where(s.tx.Artifact.LastPull.Gte(after))
+	if namespaceID != nil {
+		q = q.Where(s.tx.Artifact.NamespaceID.Eq(ptr.To(namespaceID)))
+	}
+	return q.Limit(int(limit)).Order(s.tx.Artifact.ID).Find()
+}
+
 // FindAssociateWithTag ...
 func (s *artifactService) FindAssociateWithTag(ctx context.Context, ids []int64) ([]int64, error) {
 	var result []int64
@@ -178,17 +273,14 @@ func (s *artifactService) GetByDigests(ctx context.Context, repository string, d
 		Find()
 }
 
-// DeleteByDigest deletes the artifact with the specified digest.
+// DeleteByDigest deletes the artifact with the specified digest, the blobs associated with the artifact
+// are kept, so the artifact can be restored from the recycle bin.
 func (s *artifactService) DeleteByDigest(ctx context.Context, repository, digest string) error {
-	artifact, err := s.tx.Artifact.WithContext(ctx).Where(s.tx.Artifact.Digest.Eq(digest)).Preload(s.tx.Artifact.Blobs).First()
+	artifact, err := s.tx.Artifact.WithContext(ctx).Where(s.tx.Artifact.Digest.Eq(digest)).First()
 	if err != nil {
 		return err
 	}
 	err = s.tx.Transaction(func(tx *query.Query) error {
-		err = tx.Artifact.Blobs.Model(artifact).Delete(artifact.Blobs...)
-		if err != nil {
-			return err
-		}
 		_, err = tx.Artifact.WithContext(ctx).Where(tx.Artifact.Digest.Eq(digest)).Delete()
 		if err != nil {
 			return err
@@ -309,6 +401,59 @@ func (s *artifactService) DeleteByIDs(ctx context.Context, ids []int64) error {
 	return err
 }
 
+// ListDeleted lists the artifacts of the namespace deleted after the specified time,
+// the repository of the artifact is filled even if it is deleted.
+func (s *artifactService) ListDeleted(ctx context.Context, namespaceID int64, after int64, pagination types.Pagination) ([]*models.Artifact, int64, error) {
+	pagination = utils.NormalizePagination(pagination)
+	artifactObjs, total, err := s.tx.Artifact.WithContext(ctx).Unscoped().
+		Where(s.tx.Artifact.NamespaceID.Eq(namespaceID), s.tx.Artifact.DeletedAt.Gt(uint64(after))).
+		Order(s.tx.Artifact.DeletedAt.Desc()).
+		FindByPage(ptr.To(pagination.Limit)*(ptr.To(pagination.Page)-1), ptr.To(pagination.Limit))
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(artifactObjs) == 0 {
+		return artifactObjs, total, nil
+	}
+	var repositoryIDs []int64
+	for _, artifactObj := range artifactObjs {
+		repositoryIDs = append(repositoryIDs, artifactObj.RepositoryID)
+	}
+	repositoryObjs, err := s.tx.Repository.WithContext(ctx).Unscoped().Where(s.tx.Repository.ID.In(repositoryIDs...)).Find()
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, artifactObj := range artifactObjs {
+		for _, repositoryObj := range repositoryObjs {
+			if repositoryObj.ID == artifactObj.RepositoryID {
+				artifactObj.Repository = ptr.To(repositoryObj)
+				break
+			}
+		}
+	}
+	return artifactObjs, total, nil
+}
+
+// GetDeleted gets the artifact with the specified artifact ID which is deleted after the specified time.
+func (s *artifactService) GetDeleted(ctx context.Context, id int64, after int64) (*models.Artifact, error) {
+	return s.tx.Artifact.WithContext(ctx).Unscoped().
+		Where(s.tx.Artifact.ID.Eq(id), s.tx.Artifact.DeletedAt.Gt(uint64(after))).First()
+}
+
+// Restore restores the deleted artifact with the specified artifact ID.
+func (s *artifactService) Restore(ctx context.Context, id int64) error {
+	matched, err := s.tx.Artifact.WithContext(ctx).Unscoped().
+		Where(s.tx.Artifact.ID.Eq(id), s.tx.Artifact.DeletedAt.Neq(0)).
+		UpdateColumn(s.tx.Artifact.DeletedAt, 0)
+	if err != nil {
+		return err
+	}
+	if matched.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
 // CreateSbom save a new artifact sbom.
 func (s *artifactService) CreateSbom(ctx context.Context, sbom *models.ArtifactSbom) error {
 	_, err := s.tx.ArtifactSbom.WithContext(ctx).Where(s.tx.ArtifactSbom.ArtifactID.Eq(sbom.ArtifactID)).First()
@@ -351,24 +496,223 @@ func (s *artifactService) UpdateVulnerability(ctx context.Context, artifactID in
 	return err
 }
 
-// GetNamespaceSize get the specific namespace size
-func (s *artifactService) GetNamespaceSize(ctx context.Context, namespaceID int64) (int64, error) {
-	res, err := s.tx.Artifact.WithContext(ctx).Select(s.tx.Artifact.BlobsSize.Sum().As("blobs_size")).
-		Where(s.tx.Artifact.NamespaceID.Eq(namespaceID)).Take()
+// GetSbom get the artifact sbom.
+func (s *artifactService) GetSbom(ctx context.Context, artifactID int64) (*models.ArtifactSbom, error) {
+	return s.tx.ArtifactSbom.WithContext(ctx).Where(s.tx.ArtifactSbom.ArtifactID.Eq(artifactID)).First()
+}
+
+// ReplacePackages replace the packages indexed from the artifact sbom.
+func (s *artifactService) ReplacePackages(ctx context.Context, artifactID int64, packages []*models.ArtifactPackage) error {
+	_, err := s.tx.ArtifactPackage.WithContext(ctx).Unscoped().Where(s.tx.ArtifactPackage.ArtifactID.Eq(artifactID)).Delete()
 	if err != nil {
-		return 0, err
+		return err
+	}
+	if len(packages) == 0 {
+		return nil
 	}
-	return res.BlobsSize, nil
+	return s.tx.ArtifactPackage.WithContext(ctx).CreateInBatches(packages, consts.InsertBatchSize)
 }
 
-// GetRepositorySize get the specific repository size
+// ListPackages list the packages indexed from the artifact sbom.
+func (s *artifactService) ListPackages(ctx context.Context, artifactID int64) ([]*models.ArtifactPackage, error) {
+	return s.tx.ArtifactPackage.WithContext(ctx).Where(s.tx.ArtifactPackage.ArtifactID.Eq(artifactID)).
+		Order(s.tx.ArtifactPackage.ID).Find()
+}
+
+// SearchPackages search the packages with the specified name in all of the artifact sboms which the user can read,
+// versions is nil means all of the versions are matched.
+func (s *artifactService) SearchPackages(ctx context.Context, userID int64, name string, pkgType *string, namespaceID *int64, versions []string, pagination types.Pagination) ([]*models.ArtifactPackage, int64, error) {
+	pagination = utils.NormalizePagination(pagination)
+	conds, err := s.searchPackagesConds(ctx, userID, name, pkgType, namespaceID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if versions != nil {
+		conds = append(conds, s.tx.ArtifactPackage.Version.In(versions...))
+	}
+	return s.tx.ArtifactPackage.WithContext(ctx).
+		Join(s.tx.Artifact, s.tx.Artifact.ID.EqCol(s.tx.ArtifactPackage.ArtifactID), s.tx.Artifact.DeletedAt.Eq(0)).
+		Join(s.tx.Namespace, s.tx.Namespace.ID.EqCol(s.tx.ArtifactPackage.NamespaceID), s.tx.Namespace.DeletedAt.Eq(0)).
+		LeftJoin(s.tx.NamespaceMember, s.tx.NamespaceMember.NamespaceID.EqCol(s.tx.ArtifactPackage.NamespaceID),
+			s.tx.NamespaceMember.UserID.Eq(userID), s.tx.NamespaceMember.DeletedAt.Eq(0)).
+		Where(conds...).
+		Preload(s.tx.ArtifactPackage.Namespace, s.tx.ArtifactPackage.Repository, s.tx.ArtifactPackage.Artifact).
+		Preload(s.tx.ArtifactPackage.Artifact.Tags).
+		Order(s.tx.ArtifactPackage.ID).
+		FindByPage(ptr.To(pagination.Limit)*(ptr.To(pagination.Page)-1), ptr.To(pagination.Limit))
+}
+
+// ListPackageVersions list the distinct versions of the packages with the specified name which the user can read.
+func (s *artifactService) ListPackageVersions(ctx context.Context, userID int64, name string, pkgType *string, namespaceID *int64) ([]string, error) {
+	conds, err := s.searchPackagesConds(ctx, userID, name, pkgType, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	var versions []string
+	err = s.tx.ArtifactPackage.WithContext(ctx).
+		Join(s.tx.Artifact, s.tx.Artifact.ID.EqCol(s.tx.ArtifactPackage.ArtifactID), s.tx.Artifact.DeletedAt.Eq(0)).
+		Join(s.tx.Namespace, s.tx.Namespace.ID.EqCol(s.tx.ArtifactPackage.NamespaceID), s.tx.Namespace.DeletedAt.Eq(0)).
+		LeftJoin(s.tx.NamespaceMember, s.tx.NamespaceMember.NamespaceID.EqCol(s.tx.ArtifactPackage.NamespaceID),
+			s.tx.NamespaceMember.UserID.Eq(userID), s.tx.NamespaceMember.DeletedAt.Eq(0)).
+		Where(conds...).
+		Distinct(s.tx.ArtifactPackage.Version).
+		Pluck(s.tx.ArtifactPackage.Version, &versions)
+	if err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// searchPackagesConds returns the conditions of the package search, the admin can read all of the namespaces,
+// the others can read the public namespaces and the namespaces they are member of.
+func (s *artifactService) searchPackagesConds(ctx context.Context, userID int64, name string, pkgType *string, namespaceID *int64) ([]gen.Condition, error) {
+	userObj, err := s.tx.User.WithContext(ctx).Where(s.tx.User.ID.Eq(userID)).First()
+	if err != nil {
+		return nil, err
+	}
+	conds := []gen.Condition{s.tx.ArtifactPackage.Name.Eq(name)}
+	if pkgType != nil {
+		conds = append(conds, s.tx.ArtifactPackage.Type.Eq(ptr.To(pkgType)))
+	}
+	if namespaceID != nil {
+		conds = append(conds, s.tx.ArtifactPackage.NamespaceID.Eq(ptr.To(namespaceID)))
+	}
+	if !(userObj.Role == enums.UserRoleAdmin || userObj.Role == enums.UserRoleRoot) {
+		conds = append(conds, field.Or(s.tx.Namespace.Visibility.Eq(enums.VisibilityPublic), s.tx.NamespaceMember.ID.IsNotNull()))
+	}
+	return conds, nil
+}
+
+// GetVulnerability get the artifact vulnerability.
+func (s *artifactService) GetVulnerability(ctx context.Context, artifactID int64) (*models.ArtifactVulnerability, error) {
+	return s.tx.ArtifactVulnerability.WithContext(ctx).Where(s.tx.ArtifactVulnerability.ArtifactID.Eq(artifactID)).First()
+}
+
+// CreateSecret save a new artifact secret scan result.
+func (s *artifactService) CreateSecret(ctx context.Context, secret *models.ArtifactSecret) error {
+	_, err := s.tx.ArtifactSecret.WithContext(ctx).Where(s.tx.ArtifactSecret.ArtifactID.Eq(secret.ArtifactID)).First()
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		return s.tx.ArtifactSecret.WithContext(ctx).Create(secret)
+	}
+	return nil
+}
+
+// UpdateSecret update the artifact secret scan result.
+func (s *artifactService) UpdateSecret(ctx context.Context, artifactID int64, updates map[string]any) error {
+	if len(updates) == 0 {
+		return nil
+	}
+	_, err := s.tx.ArtifactSecret.WithContext(ctx).Where(s.tx.ArtifactSecret.ArtifactID.Eq(artifactID)).UpdateColumns(updates)
+	return err
+}
+
+// GetSecret get the artifact secret scan result.
+func (s *artifactService) GetSecret(ctx context.Context, artifactID int64) (*models.ArtifactSecret, error) {
+	return s.tx.ArtifactSecret.WithContext(ctx).Where(s.tx.ArtifactSecret.ArtifactID.Eq(artifactID)).First()
+}
+
+// CreateMisconfiguration save a new artifact misconfiguration scan result.
+func (s *artifactService) CreateMisconfiguration(ctx context.Context, misconfiguration *models.ArtifactMisconfiguration) error {
+	_, err := s.tx.ArtifactMisconfiguration.WithContext(ctx).Where(s.tx.ArtifactMisconfiguration.ArtifactID.Eq(misconfiguration.ArtifactID)).First()
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		return s.tx.ArtifactMisconfiguration.WithContext(ctx).Create(misconfiguration)
+	}
+	return nil
+}
+
+// UpdateMisconfiguration update the artifact misconfiguration scan result.
+func (s *artifactService) UpdateMisconfiguration(ctx context.Context, artifactID int64, updates map[string]any) error {
+	if len(updates) == 0 {
+		return nil
+	}
+	_, err := s.tx.ArtifactMisconfiguration.WithContext(ctx).Where(s.tx.ArtifactMisconfiguration.ArtifactID.Eq(artifactID)).UpdateColumns(updates)
+	return err
+}
+
+// GetMisconfiguration get the artifact misconfiguration scan result.
+func (s *artifactService) GetMisconfiguration(ctx context.Context, artifactID int64) (*models.ArtifactMisconfiguration, error) {
+	return s.tx.ArtifactMisconfiguration.WithContext(ctx).Where(s.tx.ArtifactMisconfiguration.ArtifactID.Eq(artifactID)).First()
+}
+
+// GetNamespaceSize get the specific namespace size, the blob shared by the artifacts is counted once
+func (s *artifactService) GetNamespaceSize(ctx context.Context, namespaceID int64) (int64, error) {
+	return s.getSize(ctx, s.tx.Artifact.NamespaceID, namespaceID)
+}
+
+// GetRepositorySize get the specific repository size, the blob shared by the artifacts is counted once
 func (s *artifactService) GetRepositorySize(ctx context.Context, repositoryID int64) (int64, error) {
-	res, err := s.tx.Artifact.WithContext(ctx).Select(s.tx.Artifact.BlobsSize.Sum().As("blobs_size")).
-		Where(s.tx.Artifact.RepositoryID.Eq(repositoryID)).First()
+	return s.getSize(ctx, s.tx.Artifact.RepositoryID, repositoryID)
+}
+
+// GetNamespaceSizeIncrease get the size of the blobs that not referenced by the specific namespace yet
+func (s *artifactService) GetNamespaceSizeIncrease(ctx context.Context, namespaceID int64, blobIDs []int64) (int64, error) {
+	return s.getSizeIncrease(ctx, s.tx.Artifact.NamespaceID, namespaceID, blobIDs)
+}
+
+// GetRepositorySizeIncrease get the size of the blobs that not referenced by the specific repository yet
+func (s *artifactService) GetRepositorySizeIncrease(ctx context.Context, repositoryID int64, blobIDs []int64) (int64, error) {
+	return s.getSizeIncrease(ctx, s.tx.Artifact.RepositoryID, repositoryID, blobIDs)
+}
+
+// artifactBlobs is the join table of the artifacts and the blobs, which has no generated query
+type artifactBlobs struct{}
+
+// TableName ...
+func (artifactBlobs) TableName() string {
+	return "artifact_blobs"
+}
+
+var (
+	artifactBlobsArtifactID = field.NewInt64("artifact_blobs", "artifact_id")
+	artifactBlobsBlobID     = field.NewInt64("artifact_blobs", "blob_id")
+)
+
+// liveBlobIDs returns the subquery of the blob ids referenced by the artifacts matched the column,
+// the artifacts of the deleted repositories are kept out, the artifacts are not deleted with the repository
+func (s *artifactService) liveBlobIDs(ctx context.Context, column field.Int64, id int64) gen.SubQuery {
+	return s.tx.Artifact.WithContext(ctx).Select(artifactBlobsBlobID).
+		Join(artifactBlobs{}, artifactBlobsArtifactID.EqCol(s.tx.Artifact.ID)).
+		Join(s.tx.Repository, s.tx.Repository.ID.EqCol(s.tx.Artifact.RepositoryID), s.tx.Repository.DeletedAt.Eq(0)).
+		Where(column.Eq(id))
+}
+
+// getSize sums the manifests size and the unique blobs size of the artifacts matched the column
+func (s *artifactService) getSize(ctx context.Context, column field.Int64, id int64) (int64, error) {
+	var manifestsSize int64
+	err := s.tx.Artifact.WithContext(ctx).
+		Join(s.tx.Repository, s.tx.Repository.ID.EqCol(s.tx.Artifact.RepositoryID), s.tx.Repository.DeletedAt.Eq(0)).
+		Where(column.Eq(id)).
+		Select(s.tx.Artifact.Size.Sum().IfNull(0)).Scan(&manifestsSize)
+	if err != nil {
+		return 0, err
+	}
+	var blobsSize int64
+	err = s.tx.Blob.WithContext(ctx).Unscoped().
+		Where(s.tx.Blob.WithContext(ctx).Columns(s.tx.Blob.ID).In(s.liveBlobIDs(ctx, column, id))).
+		Select(s.tx.Blob.Size.Sum().IfNull(0)).Scan(&blobsSize)
 	if err != nil {
 		return 0, err
 	}
-	return res.BlobsSize, nil
+	return manifestsSize + blobsSize, nil
+}
+
+// getSizeIncrease sums the size of the blobs that not referenced by the artifacts matched the column
+func (s *artifactService) getSizeIncrease(ctx context.Context, column field.Int64, id int64, blobIDs []int64) (int64, error) {
+	if len(blobIDs) == 0 {
+		return 0, nil
+	}
+	var size int64
+	err := s.tx.Blob.WithContext(ctx).Unscoped().
+		Where(s.tx.Blob.ID.In(blobIDs...)).
+		Where(s.tx.Blob.WithContext(ctx).Columns(s.tx.Blob.ID).NotIn(s.liveBlobIDs(ctx, column, id))).
+		Select(s.tx.Blob.Size.Sum().IfNull(0)).Scan(&size)
+	return size, err
 }
 
 // GetReferrers ...
@@ -380,7 +724,7 @@ func (s *artifactService) GetReferrers(ctx context.Context, repositoryID int64,
 	}
 	q := s.tx.Artifact.WithContext(ctx).Where(s.tx.Artifact.RepositoryID.Eq(repositoryID))
 	if len(artifactTypes) > 0 {
-		q = q.Where(s.tx.Artifact.ConfigMediaType.In(artifactTypes...))
+		q = q.Where(s.tx.Artifact.ArtifactType.In(artifactTypes...))
 	}
 	return q.Where(s.tx.Artifact.ReferrerID.Eq(artifactObj.ID)).Find()
 }
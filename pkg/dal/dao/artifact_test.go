@@ -17,6 +17,7 @@ package dao_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
@@ -202,6 +203,23 @@ func TestArtifactService(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, artifactCount1, int64(2))
 
+	sbomReferrerObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:sbom", Size: 123, ContentType: "test", Raw: []byte("test"),
+		ReferrerID: ptr.Of(artifactObj.ID), ArtifactType: ptr.Of("application/spdx+json")}
+	assert.NoError(t, artifactService.Create(ctx, sbomReferrerObj))
+	signatureReferrerObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:signature", Size: 123, ContentType: "test", Raw: []byte("test"),
+		ReferrerID: ptr.Of(artifactObj.ID), ConfigMediaType: ptr.Of("application/vnd.oci.image.config.v1+json"), ArtifactType: ptr.Of("application/vnd.cncf.notary.signature")}
+	assert.NoError(t, artifactService.Create(ctx, signatureReferrerObj))
+	referrers, err := artifactService.GetReferrers(ctx, repositoryObj.ID, artifactObj.Digest, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(referrers))
+	referrers, err = artifactService.GetReferrers(ctx, repositoryObj.ID, artifactObj.Digest, []string{"application/spdx+json"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(referrers))
+	assert.Equal(t, sbomReferrerObj.ID, referrers[0].ID)
+	referrers, err = artifactService.GetReferrers(ctx, repositoryObj.ID, artifactObj.Digest, []string{"application/vnd.oci.image.config.v1+json"})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(referrers))
+
 	assert.NoError(t, artifactService.AssociateBlobs(ctx, artifactObj,
 		[]*models.Blob{{
 			Digest:      "sha256:123",
@@ -236,6 +254,9 @@ func TestArtifactService(t *testing.T) {
 	assert.NoError(t, artifactService.UpdateSbom(ctx, artifactObj.ID, map[string]any{
 		query.ArtifactSbom.Status.ColumnName().String(): enums.TaskCommonStatusSuccess,
 	}))
+	sbomObj, err := artifactService.GetSbom(ctx, artifactObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, enums.TaskCommonStatusSuccess, sbomObj.Status)
 
 	assert.NoError(t, artifactService.CreateVulnerability(ctx,
 		&models.ArtifactVulnerability{ArtifactID: artifactObj.ID, Raw: []byte("test"), Status: enums.TaskCommonStatusPending}))
@@ -291,4 +312,481 @@ func TestArtifactServiceGetNamespaceSize(t *testing.T) {
 		assert.Equal(t, int64(123), size)
 		return nil
 	}))
+
+	// the blob shared by the artifacts is counted once
+	blobService := dao.NewBlobServiceFactory().New()
+	sharedBlobObj := &models.Blob{Digest: "sha256:shared", Size: 1000, ContentType: "test"}
+	assert.NoError(t, blobService.Create(ctx, sharedBlobObj))
+	blobObj1 := &models.Blob{Digest: "sha256:blob1", Size: 100, ContentType: "test"}
+	assert.NoError(t, blobService.Create(ctx, blobObj1))
+	blobObj2 := &models.Blob{Digest: "sha256:blob2", Size: 10, ContentType: "test"}
+	assert.NoError(t, blobService.Create(ctx, blobObj2))
+
+	artifactObj1 := &models.Artifact{
+		NamespaceID:  namespaceObj.ID,
+		RepositoryID: repositoryObj.ID,
+		Digest:       "sha256:artifact1",
+		Size:         1,
+		BlobsSize:    1100,
+		ContentType:  "test",
+		Raw:          []byte("test"),
+		Blobs:        []*models.Blob{sharedBlobObj, blobObj1},
+	}
+	assert.NoError(t, artifactService.Create(ctx, artifactObj1))
+	artifactObj2 := &models.Artifact{
+		NamespaceID:  namespaceObj.ID,
+		RepositoryID: repositoryObj.ID,
+		Digest:       "sha256:artifact2",
+		Size:         2,
+		BlobsSize:    1010,
+		ContentType:  "test",
+		Raw:          []byte("test"),
+		Blobs:        []*models.Blob{sharedBlobObj, blobObj2},
+	}
+	assert.NoError(t, artifactService.Create(ctx, artifactObj2))
+
+	size, err := artifactService.GetNamespaceSize(ctx, namespaceObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(123+1+2+1000+100+10), size)
+	size, err = artifactService.GetRepositorySize(ctx, repositoryObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(123+1+2+1000+100+10), size)
+
+	blobObj3 := &models.Blob{Digest: "sha256:blob3", Size: 5, ContentType: "test"}
+	assert.NoError(t, blobService.Create(ctx, blobObj3))
+	increase, err := artifactService.GetNamespaceSizeIncrease(ctx, namespaceObj.ID, []int64{sharedBlobObj.ID, blobObj3.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), increase)
+	increase, err = artifactService.GetRepositorySizeIncrease(ctx, repositoryObj.ID, []int64{sharedBlobObj.ID, blobObj1.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), increase)
+	increase, err = artifactService.GetRepositorySizeIncrease(ctx, repositoryObj.ID, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), increase)
+
+	// the blob referenced by the deleted artifact only is not counted
+	assert.NoError(t, artifactService.DeleteByID(ctx, artifactObj2.ID))
+	size, err = artifactService.GetNamespaceSize(ctx, namespaceObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(123+1+1000+100), size)
+	increase, err = artifactService.GetNamespaceSizeIncrease(ctx, namespaceObj.ID, []int64{blobObj2.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), increase)
+
+	// the artifacts of the deleted repository are not counted
+	repositoryObj2 := &models.Repository{Name: "test/alpine", NamespaceID: namespaceObj.ID}
+	assert.NoError(t, repositoryService.Create(ctx, repositoryObj2, dao.AutoCreateNamespace{UserID: userObj.ID}))
+	artifactObj3 := &models.Artifact{
+		NamespaceID:  namespaceObj.ID,
+		RepositoryID: repositoryObj2.ID,
+		Digest:       "sha256:artifact3",
+		Size:         3,
+		BlobsSize:    10005,
+		ContentType:  "test",
+		Raw:          []byte("test"),
+		Blobs:        []*models.Blob{sharedBlobObj, blobObj3},
+	}
+	assert.NoError(t, artifactService.Create(ctx, artifactObj3))
+	size, err = artifactService.GetNamespaceSize(ctx, namespaceObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(123+1+1000+100+3+5), size)
+
+	assert.NoError(t, repositoryService.DeleteByID(ctx, repositoryObj2.ID))
+	size, err = artifactService.GetNamespaceSize(ctx, namespaceObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(123+1+1000+100), size)
+	size, err = artifactService.GetRepositorySize(ctx, repositoryObj2.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), size)
+	increase, err = artifactService.GetNamespaceSizeIncrease(ctx, namespaceObj.ID, []int64{blobObj3.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), increase)
+}
+
+func TestArtifactServicePackages(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	userService := dao.NewUserServiceFactory().New()
+	namespaceService := dao.NewNamespaceServiceFactory().New()
+	repositoryService := dao.NewRepositoryServiceFactory().New()
+	artifactService := dao.NewArtifactServiceFactory().New()
+
+	userObj := &models.User{Username: "artifact-service", Password: ptr.Of("test"), Email: ptr.Of("test@gmail.com")}
+	assert.NoError(t, userService.Create(ctx, userObj))
+
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, namespaceService.Create(ctx, namespaceObj))
+
+	repositoryObj := &models.Repository{Name: "test/busybox", NamespaceID: namespaceObj.ID}
+	assert.NoError(t, repositoryService.Create(ctx, repositoryObj, dao.AutoCreateNamespace{UserID: userObj.ID}))
+
+	artifactObj := &models.Artifact{
+		NamespaceID:  namespaceObj.ID,
+		RepositoryID: repositoryObj.ID,
+		Digest:       "sha256:xxxx",
+		Size:         123,
+		ContentType:  "test",
+		Raw:          []byte("test"),
+	}
+	assert.NoError(t, artifactService.Create(ctx, artifactObj))
+
+	newPackage := func(name, version string) *models.ArtifactPackage {
+		return &models.ArtifactPackage{
+			NamespaceID:  namespaceObj.ID,
+			RepositoryID: repositoryObj.ID,
+			ArtifactID:   artifactObj.ID,
+			Name:         name,
+			Version:      version,
+			Type:         "apk",
+		}
+	}
+	assert.NoError(t, artifactService.ReplacePackages(ctx, artifactObj.ID,
+		[]*models.ArtifactPackage{newPackage("openssl", "3.0.0"), newPackage("musl", "1.2.3")}))
+	assert.NoError(t, artifactService.ReplacePackages(ctx, artifactObj.ID,
+		[]*models.ArtifactPackage{newPackage("openssl", "3.1.0")}))
+
+	adminObj := &models.User{Username: "artifact-service-admin", Password: ptr.Of("test"), Email: ptr.Of("admin@gmail.com"), Role: enums.UserRoleAdmin}
+	assert.NoError(t, userService.Create(ctx, adminObj))
+
+	packageObjs, total, err := artifactService.SearchPackages(ctx, adminObj.ID, "openssl", nil, nil, nil, types.Pagination{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Len(t, packageObjs, 1)
+	assert.Equal(t, "3.1.0", packageObjs[0].Version)
+	assert.NotNil(t, packageObjs[0].Artifact)
+	assert.NotNil(t, packageObjs[0].Repository)
+
+	versions, err := artifactService.ListPackageVersions(ctx, adminObj.ID, "openssl", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"3.1.0"}, versions)
+
+	packageObjs, total, err = artifactService.SearchPackages(ctx, adminObj.ID, "openssl", nil, nil, []string{"3.0.0"}, types.Pagination{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), total)
+	assert.Len(t, packageObjs, 0)
+
+	// the namespace is private and the user is not the member of it
+	packageObjs, total, err = artifactService.SearchPackages(ctx, userObj.ID, "openssl", nil, nil, nil, types.Pagination{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), total)
+	assert.Len(t, packageObjs, 0)
+	versions, err = artifactService.ListPackageVersions(ctx, userObj.ID, "openssl", nil, nil)
+	assert.NoError(t, err)
+	assert.Len(t, versions, 0)
+
+	namespaceMemberService := dao.NewNamespaceMemberServiceFactory().New()
+	_, err = namespaceMemberService.AddNamespaceMember(ctx, userObj.ID, ptr.To(namespaceObj), enums.NamespaceRoleReader)
+	assert.NoError(t, err)
+	packageObjs, total, err = artifactService.SearchPackages(ctx, userObj.ID, "openssl", nil, nil, nil, types.Pagination{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Len(t, packageObjs, 1)
+
+	packageObjs, total, err = artifactService.SearchPackages(ctx, adminObj.ID, "musl", nil, nil, nil, types.Pagination{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), total)
+	assert.Len(t, packageObjs, 0)
+
+	packageObjs, _, err = artifactService.SearchPackages(ctx, adminObj.ID, "openssl", ptr.Of("deb"), ptr.Of(namespaceObj.ID), nil, types.Pagination{})
+	assert.NoError(t, err)
+	assert.Len(t, packageObjs, 0)
+
+	// the total is counted before the pagination
+	assert.NoError(t, artifactService.ReplacePackages(ctx, artifactObj.ID,
+		[]*models.ArtifactPackage{newPackage("openssl", "3.1.0"), newPackage("openssl", "3.1.1"), newPackage("openssl", "3.1.2")}))
+	packageObjs, err = artifactService.ListPackages(ctx, artifactObj.ID)
+	assert.NoError(t, err)
+	assert.Len(t, packageObjs, 3)
+	packageObjs, total, err = artifactService.SearchPackages(ctx, adminObj.ID, "openssl", nil, nil, nil, types.Pagination{Page: ptr.Of(2), Limit: ptr.Of(2)})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	assert.Len(t, packageObjs, 1)
+	assert.Equal(t, "3.1.2", packageObjs[0].Version)
+
+	assert.NoError(t, artifactService.DeleteByID(ctx, artifactObj.ID))
+	packageObjs, total, err = artifactService.SearchPackages(ctx, adminObj.ID, "openssl", nil, nil, nil, types.Pagination{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), total)
+	assert.Len(t, packageObjs, 0)
+}
+
+func TestArtifactServiceContentScan(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	userService := dao.NewUserServiceFactory().New()
+	namespaceService := dao.NewNamespaceServiceFactory().New()
+	repositoryService := dao.NewRepositoryServiceFactory().New()
+	artifactService := dao.NewArtifactServiceFactory().New()
+
+	userObj := &models.User{Username: "artifact-service", Password: ptr.Of("test"), Email: ptr.Of("test@gmail.com")}
+	assert.NoError(t, userService.Create(ctx, userObj))
+
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate, SecretScan: true}
+	assert.NoError(t, namespaceService.Create(ctx, namespaceObj))
+
+	repositoryObj := &models.Repository{Name: "test/busybox", NamespaceID: namespaceObj.ID}
+	assert.NoError(t, repositoryService.Create(ctx, repositoryObj, dao.AutoCreateNamespace{UserID: userObj.ID}))
+
+	artifactObj := &models.Artifact{
+		NamespaceID:  namespaceObj.ID,
+		RepositoryID: repositoryObj.ID,
+		Digest:       "sha256:xxxx",
+		Size:         123,
+		ContentType:  "test",
+		Raw:          []byte("test"),
+	}
+	assert.NoError(t, artifactService.Create(ctx, artifactObj))
+
+	assert.NoError(t, artifactService.CreateSecret(ctx,
+		&models.ArtifactSecret{ArtifactID: artifactObj.ID, Status: enums.TaskCommonStatusPending}))
+	assert.NoError(t, artifactService.CreateSecret(ctx,
+		&models.ArtifactSecret{ArtifactID: artifactObj.ID, Status: enums.TaskCommonStatusPending}))
+	assert.NoError(t, artifactService.UpdateSecret(ctx, artifactObj.ID, map[string]any{
+		query.ArtifactSecret.Status.ColumnName().String(): enums.TaskCommonStatusSuccess,
+	}))
+	assert.NoError(t, artifactService.UpdateSecret(ctx, artifactObj.ID, nil))
+	secretObj, err := artifactService.GetSecret(ctx, artifactObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, enums.TaskCommonStatusSuccess, secretObj.Status)
+
+	_, err = artifactService.GetMisconfiguration(ctx, artifactObj.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.NoError(t, artifactService.CreateMisconfiguration(ctx,
+		&models.ArtifactMisconfiguration{ArtifactID: artifactObj.ID, Status: enums.TaskCommonStatusPending}))
+	assert.NoError(t, artifactService.UpdateMisconfiguration(ctx, artifactObj.ID, map[string]any{
+		query.ArtifactMisconfiguration.Status.ColumnName().String(): enums.TaskCommonStatusFailed,
+	}))
+	misconfigurationObj, err := artifactService.GetMisconfiguration(ctx, artifactObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, enums.TaskCommonStatusFailed, misconfigurationObj.Status)
+}
+
+func TestArtifactServiceFindWithPulledAfter(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	userObj := &models.User{Username: "artifact-service", Password: ptr.Of("test"), Email: ptr.Of("test@gmail.com")}
+	assert.NoError(t, dao.NewUserServiceFactory().New().Create(ctx, userObj))
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+	repositoryObj := &models.Repository{Name: "test/busybox", NamespaceID: namespaceObj.ID}
+	assert.NoError(t, dao.NewRepositoryServiceFactory().New().Create(ctx, repositoryObj, dao.AutoCreateNamespace{UserID: userObj.ID}))
+
+	artifactService := dao.NewArtifactServiceFactory().New()
+	pulledObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:pulled",
+		Size: 123, ContentType: "test", Raw: []byte("test"), Type: enums.ArtifactTypeImage, LastPull: 2000}
+	assert.NoError(t, artifactService.Create(ctx, pulledObj))
+	assert.NoError(t, artifactService.CreateVulnerability(ctx, &models.ArtifactVulnerability{ArtifactID: pulledObj.ID, Status: enums.TaskCommonStatusSuccess}))
+	staleObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:stale",
+		Size: 123, ContentType: "test", Raw: []byte("test"), Type: enums.ArtifactTypeImage, LastPull: 500}
+	assert.NoError(t, artifactService.Create(ctx, staleObj))
+
+	artifactObjs, err := artifactService.FindWithPulledAfter(ctx, nil, 1000, 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(artifactObjs))
+	assert.Equal(t, pulledObj.ID, artifactObjs[0].ID)
+	assert.Equal(t, enums.TaskCommonStatusSuccess, artifactObjs[0].Vulnerability.Status)
+
+	artifactObjs, err = artifactService.FindWithPulledAfter(ctx, ptr.Of(namespaceObj.ID), 1000, 10, pulledObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(artifactObjs))
+
+	artifactObjs, err = artifactService.FindWithPulledAfter(ctx, ptr.Of(namespaceObj.ID+1), 0, 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(artifactObjs))
+}
+
+func TestArtifactServiceFindUntaggedWithCursor(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	userObj := &models.User{Username: "artifact-service", Password: ptr.Of("test"), Email: ptr.Of("test@gmail.com")}
+	assert.NoError(t, dao.NewUserServiceFactory().New().Create(ctx, userObj))
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+	repositoryObj := &models.Repository{Name: "test/busybox", NamespaceID: namespaceObj.ID}
+	assert.NoError(t, dao.NewRepositoryServiceFactory().New().Create(ctx, repositoryObj, dao.AutoCreateNamespace{UserID: userObj.ID}))
+
+	artifactService := dao.NewArtifactServiceFactory().New()
+	taggedObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:tagged",
+		Size: 123, ContentType: "test", Raw: []byte("test")}
+	assert.NoError(t, artifactService.Create(ctx, taggedObj))
+	assert.NoError(t, dao.NewTagServiceFactory().New().Create(ctx, &models.Tag{RepositoryID: repositoryObj.ID, ArtifactID: taggedObj.ID, Name: "latest"}))
+	untaggedObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:untagged",
+		Size: 123, ContentType: "test", Raw: []byte("test")}
+	assert.NoError(t, artifactService.Create(ctx, untaggedObj))
+	referrerObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:referrer",
+		Size: 123, ContentType: "test", Raw: []byte("test"), ReferrerID: ptr.Of(untaggedObj.ID)}
+	assert.NoError(t, artifactService.Create(ctx, referrerObj))
+
+	recycleBinAfter := time.Now().Add(-time.Hour).UnixMilli()
+	artifactObjs, err := artifactService.FindUntaggedWithCursor(ctx, repositoryObj.ID, recycleBinAfter, 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(artifactObjs))
+	assert.Equal(t, untaggedObj.ID, artifactObjs[0].ID)
+
+	artifactObjs, err = artifactService.FindUntaggedWithCursor(ctx, repositoryObj.ID, recycleBinAfter, 10, untaggedObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(artifactObjs))
+
+	// the tag still in the recycle bin keeps the artifact tagged
+	assert.NoError(t, dao.NewTagServiceFactory().New().DeleteByName(ctx, repositoryObj.ID, "latest"))
+	artifactObjs, err = artifactService.FindUntaggedWithCursor(ctx, repositoryObj.ID, recycleBinAfter, 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(artifactObjs))
+
+	artifactObjs, err = artifactService.FindUntaggedWithCursor(ctx, repositoryObj.ID, time.Now().Add(time.Hour).UnixMilli(), 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(artifactObjs))
+}
+
+func TestArtifactServiceFindDanglingReferrers(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	userObj := &models.User{Username: "artifact-service", Password: ptr.Of("test"), Email: ptr.Of("test@gmail.com")}
+	assert.NoError(t, dao.NewUserServiceFactory().New().Create(ctx, userObj))
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+	repositoryObj := &models.Repository{Name: "test/busybox", NamespaceID: namespaceObj.ID}
+	assert.NoError(t, dao.NewRepositoryServiceFactory().New().Create(ctx, repositoryObj, dao.AutoCreateNamespace{UserID: userObj.ID}))
+
+	artifactService := dao.NewArtifactServiceFactory().New()
+	subjectObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:subject",
+		Size: 123, ContentType: "test", Raw: []byte("test")}
+	assert.NoError(t, artifactService.Create(ctx, subjectObj))
+	referrerObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:referrer",
+		Size: 123, ContentType: "test", Raw: []byte("test"), ReferrerID: ptr.Of(subjectObj.ID)}
+	assert.NoError(t, artifactService.Create(ctx, referrerObj))
+
+	artifactObjs, err := artifactService.FindDanglingReferrers(ctx, repositoryObj.ID, time.Now().Add(time.Hour).UnixMilli(), 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(artifactObjs))
+
+	_, err = artifactService.GetDeletedByDigest(ctx, repositoryObj.ID, subjectObj.Digest)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	assert.NoError(t, artifactService.DeleteByID(ctx, subjectObj.ID))
+
+	artifactObjs, err = artifactService.FindDanglingReferrers(ctx, repositoryObj.ID, time.Now().Add(time.Hour).UnixMilli(), 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(artifactObjs))
+	assert.Equal(t, referrerObj.ID, artifactObjs[0].ID)
+	assert.Equal(t, referrerObj.Digest, artifactObjs[0].Digest)
+
+	artifactObjs, err = artifactService.FindDanglingReferrers(ctx, repositoryObj.ID, time.Now().Add(-time.Hour).UnixMilli(), 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(artifactObjs))
+
+	deletedObj, err := artifactService.GetDeletedByDigest(ctx, repositoryObj.ID, subjectObj.Digest)
+	assert.NoError(t, err)
+	assert.Equal(t, subjectObj.ID, deletedObj.ID)
+	assert.NotZero(t, deletedObj.DeletedAt)
+}
+
+func TestArtifactServiceRecycleBin(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	userObj := &models.User{Username: "artifact-service", Password: ptr.Of("test"), Email: ptr.Of("test@gmail.com")}
+	assert.NoError(t, dao.NewUserServiceFactory().New().Create(ctx, userObj))
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+	repositoryObj := &models.Repository{Name: "test/busybox", NamespaceID: namespaceObj.ID}
+	assert.NoError(t, dao.NewRepositoryServiceFactory().New().Create(ctx, repositoryObj, dao.AutoCreateNamespace{UserID: userObj.ID}))
+
+	blobObj := &models.Blob{Digest: "sha256:blob", Size: 123, ContentType: "test"}
+	assert.NoError(t, dao.NewBlobServiceFactory().New().Create(ctx, blobObj))
+	artifactService := dao.NewArtifactServiceFactory().New()
+	artifactObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:artifact",
+		Size: 123, ContentType: "test", Raw: []byte("test"), Blobs: []*models.Blob{blobObj}}
+	assert.NoError(t, artifactService.Create(ctx, artifactObj))
+
+	after := time.Now().Add(-time.Hour).UnixMilli()
+	pagination := types.Pagination{Limit: ptr.Of(10), Page: ptr.Of(1)}
+
+	assert.ErrorIs(t, artifactService.Restore(ctx, artifactObj.ID), gorm.ErrRecordNotFound)
+
+	// the blobs associated with the artifact are kept after the artifact deleted
+	assert.NoError(t, artifactService.DeleteByDigest(ctx, repositoryObj.Name, artifactObj.Digest))
+	associated, err := dao.NewBlobServiceFactory().New().FindAssociateWithArtifact(ctx, []int64{blobObj.ID}, after)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{blobObj.ID}, associated)
+
+	artifactObjs, total, err := artifactService.ListDeleted(ctx, namespaceObj.ID, after, pagination)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, artifactObj.ID, artifactObjs[0].ID)
+	assert.Equal(t, repositoryObj.Name, artifactObjs[0].Repository.Name)
+
+	_, total, err = artifactService.ListDeleted(ctx, namespaceObj.ID+1, after, pagination)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), total)
+
+	_, err = artifactService.GetDeleted(ctx, artifactObj.ID, time.Now().Add(time.Hour).UnixMilli())
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	deletedObj, err := artifactService.GetDeleted(ctx, artifactObj.ID, after)
+	assert.NoError(t, err)
+	assert.Equal(t, artifactObj.Digest, deletedObj.Digest)
+
+	assert.NoError(t, artifactService.Restore(ctx, artifactObj.ID))
+	restoredObj, err := artifactService.GetByDigest(ctx, repositoryObj.ID, artifactObj.Digest)
+	assert.NoError(t, err)
+	assert.Equal(t, artifactObj.ID, restoredObj.ID)
 }
@@ -34,8 +34,15 @@ type BlobService interface {
 	Create(ctx context.Context, blob *models.Blob) error
 	// FindWithLastPull find with last pull
 	FindWithLastPull(ctx context.Context, before int64, last, limit int64) ([]*models.Blob, error)
-	// FindAssociateWithArtifact ...
-	FindAssociateWithArtifact(ctx context.Context, ids []int64) ([]int64, error)
+	// FindAssociateWithArtifact finds the blobs associated with the live artifacts or the artifacts deleted after deletedAfter,
+	// the deleted artifacts are still in the recycle bin and keep their blobs.
+	FindAssociateWithArtifact(ctx context.Context, ids []int64, deletedAfter int64) ([]int64, error)
+	// FindByArtifact finds the blobs referenced by the artifact.
+	FindByArtifact(ctx context.Context, artifactID int64) ([]*models.Blob, error)
+	// FindByRepository finds the blobs referenced by the live artifacts of the repository.
+	FindByRepository(ctx context.Context, repositoryID int64) ([]*models.Blob, error)
+	// FindReferences finds the live artifacts referencing the blobs.
+	FindReferences(ctx context.Context, ids []int64) ([]BlobReference, error)
 	// FindByDigest finds the blob with the specified digest.
 	FindByDigest(ctx context.Context, digest string) (*models.Blob, error)
 	// FindByDigests finds the blobs with the specified digests.
@@ -44,12 +51,25 @@ type BlobService interface {
 	Exists(ctx context.Context, digest string) (bool, error)
 	// Incr increases the pull times of the artifact.
 	Incr(ctx context.Context, id int64) error
+	// Touch updates the last check time of the blobs with the specified digests.
+	Touch(ctx context.Context, digests []string) error
 	// DeleteByID deletes the blob with the specified blob ID.
 	DeleteByID(ctx context.Context, id int64) error
+	// DeleteUnreferenced deletes the blob with the specified blob ID only if it is not pushed or checked
+	// since before and not referenced by any artifact, the artifacts deleted after deletedAfter are still
+	// in the recycle bin and keep referencing their blobs, gorm.ErrRecordNotFound is returned if nothing deleted.
+	DeleteUnreferenced(ctx context.Context, id int64, before int64, deletedAfter int64) error
 }
 
 var _ BlobService = &blobService{}
 
+// BlobReference is the live artifact referencing the blob
+type BlobReference struct {
+	BlobID       int64
+	ArtifactID   int64
+	RepositoryID int64
+}
+
 type blobService struct {
 	tx *query.Query
 }
@@ -86,15 +106,46 @@ func (s *blobService) Create(ctx context.Context, blob *models.Blob) error {
 func (s *blobService) FindWithLastPull(ctx context.Context, before int64, last, limit int64) ([]*models.Blob, error) {
 	return s.tx.Blob.WithContext(ctx).
 		Where(s.tx.Blob.ID.Gt(last)).
-		Where(s.tx.Blob.LastPull.Lt(before)).
-		Or(s.tx.Blob.LastPull.IsNull(), s.tx.Blob.UpdatedAt.Lt(before)).
-		Order(s.tx.Blob.ID).Find()
+		Where(s.tx.Blob.WithContext(ctx).Where(s.tx.Blob.LastPull.Lt(before)).
+			Or(s.tx.Blob.LastPull.IsNull(), s.tx.Blob.UpdatedAt.Lt(before))).
+		Order(s.tx.Blob.ID).Limit(int(limit)).Find()
 }
 
-// FindAssociateWithArtifact ...
-func (s *blobService) FindAssociateWithArtifact(ctx context.Context, ids []int64) ([]int64, error) {
+// FindAssociateWithArtifact finds the blobs associated with the live artifacts or the artifacts deleted after deletedAfter,
+// the deleted artifacts are still in the recycle bin and keep their blobs.
+func (s *blobService) FindAssociateWithArtifact(ctx context.Context, ids []int64, deletedAfter int64) ([]int64, error) {
 	var result []int64
-	err := s.tx.Blob.WithContext(ctx).UnderlyingDB().Raw("SELECT blob_id FROM artifact_blobs LEFT JOIN artifacts ON artifacts.id = artifact_blobs.artifact_id WHERE artifacts.deleted_at = 0 AND blob_id in (?)", ids).Scan(&result).Error
+	err := s.tx.Blob.WithContext(ctx).UnderlyingDB().Raw("SELECT blob_id FROM artifact_blobs LEFT JOIN artifacts ON artifacts.id = artifact_blobs.artifact_id WHERE (artifacts.deleted_at = 0 OR artifacts.deleted_at > ?) AND blob_id in (?)", deletedAfter, ids).Scan(&result).Error
+	return result, err
+}
+
+// FindByArtifact finds the blobs referenced by the artifact.
+func (s *blobService) FindByArtifact(ctx context.Context, artifactID int64) ([]*models.Blob, error) {
+	var result []*models.Blob
+	err := s.tx.Blob.WithContext(ctx).UnderlyingDB().
+		Where("id IN (SELECT blob_id FROM artifact_blobs WHERE artifact_id = ?)", artifactID).
+		Order("id").Find(&result).Error
+	return result, err
+}
+
+// FindByRepository finds the blobs referenced by the live artifacts of the repository.
+func (s *blobService) FindByRepository(ctx context.Context, repositoryID int64) ([]*models.Blob, error) {
+	var result []*models.Blob
+	err := s.tx.Blob.WithContext(ctx).UnderlyingDB().
+		Where("id IN (SELECT artifact_blobs.blob_id FROM artifact_blobs JOIN artifacts ON artifacts.id = artifact_blobs.artifact_id WHERE artifacts.repository_id = ? AND artifacts.deleted_at = 0)", repositoryID).
+		Order("id").Find(&result).Error
+	return result, err
+}
+
+// FindReferences finds the live artifacts referencing the blobs.
+func (s *blobService) FindReferences(ctx context.Context, ids []int64) ([]BlobReference, error) {
+	var result []BlobReference
+	if len(ids) == 0 {
+		return result, nil
+	}
+	err := s.tx.Blob.WithContext(ctx).UnderlyingDB().
+		Raw("SELECT artifact_blobs.blob_id, artifacts.id AS artifact_id, artifacts.repository_id FROM artifact_blobs JOIN artifacts ON artifacts.id = artifact_blobs.artifact_id WHERE artifacts.deleted_at = 0 AND artifact_blobs.blob_id IN (?)", ids).
+		Scan(&result).Error
 	return result, err
 }
 
@@ -127,6 +178,16 @@ func (s *blobService) Incr(ctx context.Context, id int64) error {
 	return err
 }
 
+// Touch updates the last check time of the blobs with the specified digests.
+func (s *blobService) Touch(ctx context.Context, digests []string) error {
+	if len(digests) == 0 {
+		return nil
+	}
+	_, err := s.tx.Blob.WithContext(ctx).Where(s.tx.Blob.Digest.In(digests...)).
+		UpdateColumn(s.tx.Blob.LastCheck, time.Now().UnixMilli())
+	return err
+}
+
 // DeleteByID deletes the blob with the specified blob ID.
 func (s *blobService) DeleteByID(ctx context.Context, id int64) error {
 	matched, err := s.tx.Blob.WithContext(ctx).Where(s.tx.Blob.ID.Eq(id)).Delete()
@@ -138,3 +199,20 @@ func (s *blobService) DeleteByID(ctx context.Context, id int64) error {
 	}
 	return nil
 }
+
+// DeleteUnreferenced deletes the blob with the specified blob ID only if it is not pushed or checked
+// since before and not referenced by any artifact, the artifacts deleted after deletedAfter are still
+// in the recycle bin and keep referencing their blobs, gorm.ErrRecordNotFound is returned if nothing deleted.
+func (s *blobService) DeleteUnreferenced(ctx context.Context, id int64, before int64, deletedAfter int64) error {
+	result := s.tx.Blob.WithContext(ctx).UnderlyingDB().
+		Where("id = ? AND pushed_at < ? AND last_check < ?", id, before, before).
+		Where("id NOT IN (SELECT blob_id FROM artifact_blobs LEFT JOIN artifacts ON artifacts.id = artifact_blobs.artifact_id WHERE artifacts.deleted_at = 0 OR artifacts.deleted_at > ?)", deletedAfter).
+		Delete(&models.Blob{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
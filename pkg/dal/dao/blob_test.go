@@ -29,6 +29,8 @@ import (
 	"github.com/go-sigma/sigma/pkg/dal/query"
 	"github.com/go-sigma/sigma/pkg/logger"
 	"github.com/go-sigma/sigma/pkg/tests"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
 )
 
 func TestBlobServiceFactory(t *testing.T) {
@@ -81,7 +83,7 @@ func TestBlobService(t *testing.T) {
 		for _, blob := range blobFindWithLastPull {
 			ids = append(ids, blob.ID)
 		}
-		rIds, err := blobService.FindAssociateWithArtifact(ctx, ids)
+		rIds, err := blobService.FindAssociateWithArtifact(ctx, ids, time.Now().UnixMilli())
 		assert.NoError(t, err)
 		log.Info().Interface("ids", rIds).Msg("")
 
@@ -110,3 +112,67 @@ func TestBlobService(t *testing.T) {
 	})
 	assert.NoError(t, err)
 }
+
+func TestBlobServiceDeleteUnreferenced(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	userObj := &models.User{Username: "blob-service", Password: ptr.Of("test"), Email: ptr.Of("test@gmail.com")}
+	assert.NoError(t, dao.NewUserServiceFactory().New().Create(ctx, userObj))
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+	repositoryObj := &models.Repository{Name: "test/busybox", NamespaceID: namespaceObj.ID}
+	assert.NoError(t, dao.NewRepositoryServiceFactory().New().Create(ctx, repositoryObj, dao.AutoCreateNamespace{UserID: userObj.ID}))
+
+	blobService := dao.NewBlobServiceFactory().New()
+	referencedBlobObj := &models.Blob{Digest: "sha256:referenced", Size: 123, ContentType: "test"}
+	assert.NoError(t, blobService.Create(ctx, referencedBlobObj))
+	unreferencedBlobObj := &models.Blob{Digest: "sha256:unreferenced", Size: 123, ContentType: "test"}
+	assert.NoError(t, blobService.Create(ctx, unreferencedBlobObj))
+
+	artifactObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:artifact",
+		Size: 123, ContentType: "test", Raw: []byte("test"), Blobs: []*models.Blob{referencedBlobObj}}
+	assert.NoError(t, dao.NewArtifactServiceFactory().New().Create(ctx, artifactObj))
+
+	before := time.Now().Add(time.Hour).UnixMilli()
+
+	// the blob pushed after before is protected
+	err := blobService.DeleteUnreferenced(ctx, unreferencedBlobObj.ID, time.Now().Add(-time.Hour).UnixMilli(), before)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	err = blobService.DeleteUnreferenced(ctx, referencedBlobObj.ID, before, before)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	// the blob checked after before is protected
+	assert.NoError(t, blobService.Touch(ctx, []string{unreferencedBlobObj.Digest, "sha256:not-exist"}))
+	blobObj, err := blobService.FindByDigest(ctx, unreferencedBlobObj.Digest)
+	assert.NoError(t, err)
+	assert.NotZero(t, blobObj.LastCheck)
+	err = blobService.DeleteUnreferenced(ctx, unreferencedBlobObj.ID, blobObj.LastCheck, before)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	assert.NoError(t, blobService.DeleteUnreferenced(ctx, unreferencedBlobObj.ID, before, before))
+	exist, err := blobService.Exists(ctx, unreferencedBlobObj.Digest)
+	assert.NoError(t, err)
+	assert.False(t, exist)
+
+	// the blob referenced by the deleted artifact still in the recycle bin is protected
+	assert.NoError(t, dao.NewArtifactServiceFactory().New().DeleteByID(ctx, artifactObj.ID))
+	err = blobService.DeleteUnreferenced(ctx, referencedBlobObj.ID, before, time.Now().Add(-time.Hour).UnixMilli())
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	result, err := blobService.FindAssociateWithArtifact(ctx, []int64{referencedBlobObj.ID}, time.Now().Add(-time.Hour).UnixMilli())
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{referencedBlobObj.ID}, result)
+
+	// the blob referenced by the deleted artifact only can be deleted after the recycle bin retention
+	assert.NoError(t, blobService.DeleteUnreferenced(ctx, referencedBlobObj.ID, before, before))
+}
@@ -30,6 +30,10 @@ type BlobUploadService interface {
 	Create(ctx context.Context, blobUpload *models.BlobUpload) error
 	// GetLastPart gets the blob upload with the specified blob upload ID.
 	GetLastPart(ctx context.Context, uploadID string) (*models.BlobUpload, error)
+	// GetOldestActive gets the oldest blob upload part of the uploads which are still updated after the specified time.
+	GetOldestActive(ctx context.Context, after int64) (*models.BlobUpload, error)
+	// FindAbandonedWithCursor finds the first part of the uploads which have no part updated after the specified time.
+	FindAbandonedWithCursor(ctx context.Context, before int64, limit int, last int64) ([]*models.BlobUpload, error)
 	// FindAllByUploadID find all blob uploads with the specified upload ID.
 	FindAllByUploadID(ctx context.Context, uploadID string) ([]*models.BlobUpload, error)
 	// TotalSizeByUploadID gets the total size of the blob uploads with the specified upload ID.
@@ -81,6 +85,25 @@ func (s *blobUploadService) GetLastPart(ctx context.Context, uploadID string) (*
 		Order(s.tx.BlobUpload.PartNumber.Desc()).First()
 }
 
+// GetOldestActive gets the oldest blob upload part of the uploads which are still updated after the specified time.
+func (s *blobUploadService) GetOldestActive(ctx context.Context, after int64) (*models.BlobUpload, error) {
+	return s.tx.BlobUpload.WithContext(ctx).
+		Where(s.tx.BlobUpload.WithContext(ctx).Columns(s.tx.BlobUpload.UploadID).In(
+			s.tx.BlobUpload.WithContext(ctx).Select(s.tx.BlobUpload.UploadID).Where(s.tx.BlobUpload.UpdatedAt.Gte(after)),
+		)).
+		Order(s.tx.BlobUpload.CreatedAt).First()
+}
+
+// FindAbandonedWithCursor finds the first part of the uploads which have no part updated after the specified time.
+func (s *blobUploadService) FindAbandonedWithCursor(ctx context.Context, before int64, limit int, last int64) ([]*models.BlobUpload, error) {
+	return s.tx.BlobUpload.WithContext(ctx).
+		Where(s.tx.BlobUpload.ID.Gt(last), s.tx.BlobUpload.PartNumber.Eq(0)).
+		Where(s.tx.BlobUpload.WithContext(ctx).Columns(s.tx.BlobUpload.UploadID).NotIn(
+			s.tx.BlobUpload.WithContext(ctx).Select(s.tx.BlobUpload.UploadID).Where(s.tx.BlobUpload.UpdatedAt.Gte(before)),
+		)).
+		Limit(limit).Order(s.tx.BlobUpload.ID).Find()
+}
+
 // FindAllByUploadID find all blob uploads with the specified upload ID.
 func (s *blobUploadService) FindAllByUploadID(ctx context.Context, uploadID string) ([]*models.BlobUpload, error) {
 	return s.tx.BlobUpload.WithContext(ctx).
@@ -17,9 +17,11 @@ package dao_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
 
 	"github.com/go-sigma/sigma/pkg/dal"
 	"github.com/go-sigma/sigma/pkg/dal/dao"
@@ -89,5 +91,52 @@ func TestBlobUploadService(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, int64(200), size)
 
+	staleUploadObj := &models.BlobUpload{
+		PartNumber: 1,
+		UploadID:   "test0",
+		Etag:       "test0",
+		Repository: "test/busybox",
+		FileID:     "test0",
+		Size:       100,
+		CreatedAt:  time.Now().Add(-time.Hour * 72).UnixMilli(),
+		UpdatedAt:  time.Now().Add(-time.Hour * 48).UnixMilli(),
+	}
+	assert.NoError(t, blobUploadService.Create(ctx, staleUploadObj))
+
+	oldest, err := blobUploadService.GetOldestActive(ctx, time.Now().Add(-time.Hour).UnixMilli())
+	assert.NoError(t, err)
+	assert.Equal(t, blobUploadObj.ID, oldest.ID)
+
+	oldest, err = blobUploadService.GetOldestActive(ctx, time.Now().Add(-time.Hour*50).UnixMilli())
+	assert.NoError(t, err)
+	assert.Equal(t, staleUploadObj.ID, oldest.ID)
+
+	_, err = blobUploadService.GetOldestActive(ctx, time.Now().Add(time.Hour).UnixMilli())
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	abandonedUploadObj := &models.BlobUpload{
+		PartNumber: 0,
+		UploadID:   "test0",
+		Etag:       "fake",
+		Repository: "test/busybox",
+		FileID:     "test0",
+		CreatedAt:  time.Now().Add(-time.Hour * 72).UnixMilli(),
+		UpdatedAt:  time.Now().Add(-time.Hour * 72).UnixMilli(),
+	}
+	assert.NoError(t, blobUploadService.Create(ctx, abandonedUploadObj))
+
+	abandoned, err := blobUploadService.FindAbandonedWithCursor(ctx, time.Now().Add(-time.Hour).UnixMilli(), 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, abandoned, 1)
+	assert.Equal(t, abandonedUploadObj.ID, abandoned[0].ID)
+
+	abandoned, err = blobUploadService.FindAbandonedWithCursor(ctx, time.Now().Add(-time.Hour*50).UnixMilli(), 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, abandoned, 0)
+
+	abandoned, err = blobUploadService.FindAbandonedWithCursor(ctx, time.Now().Add(-time.Hour).UnixMilli(), 10, abandonedUploadObj.ID)
+	assert.NoError(t, err)
+	assert.Len(t, abandoned, 0)
+
 	assert.NoError(t, blobUploadService.DeleteByUploadID(ctx, "test1"))
 }
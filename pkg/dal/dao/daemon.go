@@ -124,6 +124,64 @@ type DaemonService interface {
 	ListGcBlobRecords(ctx context.Context, runnerID int64, pagination types.Pagination, sort types.Sortable) ([]*models.DaemonGcBlobRecord, int64, error)
 	// GetGcBlobRecord ...
 	GetGcBlobRecord(ctx context.Context, recordID int64) (*models.DaemonGcBlobRecord, error)
+
+	// GetGcBlobUploadRule ...
+	GetGcBlobUploadRule(ctx context.Context) (*models.DaemonGcBlobUploadRule, error)
+	// CreateGcBlobUploadRule ...
+	CreateGcBlobUploadRule(ctx context.Context, ruleObj *models.DaemonGcBlobUploadRule) error
+	// UpdateGcBlobUploadRule ...
+	UpdateGcBlobUploadRule(ctx context.Context, ruleID int64, updates map[string]any) error
+	// GetGcBlobUploadLatestRunner ...
+	GetGcBlobUploadLatestRunner(ctx context.Context, ruleID int64) (*models.DaemonGcBlobUploadRunner, error)
+	// GetGcBlobUploadRunner ...
+	GetGcBlobUploadRunner(ctx context.Context, runnerID int64) (*models.DaemonGcBlobUploadRunner, error)
+	// ListGcBlobUploadRunners ...
+	ListGcBlobUploadRunners(ctx context.Context, ruleID int64, pagination types.Pagination, sort types.Sortable) ([]*models.DaemonGcBlobUploadRunner, int64, error)
+	// CreateGcBlobUploadRunner ...
+	CreateGcBlobUploadRunner(ctx context.Context, runnerObj *models.DaemonGcBlobUploadRunner) error
+	// UpdateGcBlobUploadRunner ...
+	UpdateGcBlobUploadRunner(ctx context.Context, runnerID int64, updates map[string]any) error
+	// CreateGcBlobUploadRecords ...
+	CreateGcBlobUploadRecords(ctx context.Context, records []*models.DaemonGcBlobUploadRecord) error
+	// ListGcBlobUploadRecords ...
+	ListGcBlobUploadRecords(ctx context.Context, runnerID int64, pagination types.Pagination, sort types.Sortable) ([]*models.DaemonGcBlobUploadRecord, int64, error)
+	// GetGcBlobUploadRecord ...
+	GetGcBlobUploadRecord(ctx context.Context, recordID int64) (*models.DaemonGcBlobUploadRecord, error)
+
+	// SummaryGcRepositoryRecords summaries the succeed gc repository records of the runner group by namespace
+	SummaryGcRepositoryRecords(ctx context.Context, runnerID int64) ([]GcRecordSummary, error)
+	// SummaryGcTagRecords summaries the succeed gc tag records of the runner group by namespace
+	SummaryGcTagRecords(ctx context.Context, runnerID int64) ([]GcRecordSummary, error)
+	// SummaryGcArtifactRecords summaries the succeed gc artifact records of the runner group by namespace
+	SummaryGcArtifactRecords(ctx context.Context, runnerID int64) ([]GcRecordSummary, error)
+	// SummaryGcBlobRecords summaries the succeed gc blob records of the runner
+	SummaryGcBlobRecords(ctx context.Context, runnerID int64) (*GcRecordSummary, error)
+	// SummaryGcBlobUploadRecords summaries the succeed gc blob upload records of the runner
+	SummaryGcBlobUploadRecords(ctx context.Context, runnerID int64) (*GcRecordSummary, error)
+
+	// GetGcPipelineRule ...
+	GetGcPipelineRule(ctx context.Context) (*models.DaemonGcPipelineRule, error)
+	// CreateGcPipelineRule ...
+	CreateGcPipelineRule(ctx context.Context, ruleObj *models.DaemonGcPipelineRule) error
+	// UpdateGcPipelineRule ...
+	UpdateGcPipelineRule(ctx context.Context, ruleID int64, updates map[string]any) error
+	// GetGcPipelineLatestRunner ...
+	GetGcPipelineLatestRunner(ctx context.Context, ruleID int64) (*models.DaemonGcPipelineRunner, error)
+	// GetGcPipelineRunner ...
+	GetGcPipelineRunner(ctx context.Context, runnerID int64) (*models.DaemonGcPipelineRunner, error)
+	// ListGcPipelineRunners ...
+	ListGcPipelineRunners(ctx context.Context, ruleID int64, pagination types.Pagination, sort types.Sortable) ([]*models.DaemonGcPipelineRunner, int64, error)
+	// CreateGcPipelineRunner ...
+	CreateGcPipelineRunner(ctx context.Context, runnerObj *models.DaemonGcPipelineRunner) error
+	// UpdateGcPipelineRunner ...
+	UpdateGcPipelineRunner(ctx context.Context, runnerID int64, updates map[string]any) error
+}
+
+// GcRecordSummary is the summary of the gc records, the namespace id is nil for the blob records
+type GcRecordSummary struct {
+	NamespaceID *int64 `gorm:"column:namespace_id"`
+	Count       int64  `gorm:"column:count"`
+	Size        int64  `gorm:"column:size"`
 }
 
 type daemonService struct {
@@ -602,3 +660,254 @@ func (s *daemonService) GetGcBlobRecord(ctx context.Context, recordID int64) (*m
 		Preload(s.tx.DaemonGcBlobRecord.Runner.Rule).
 		First()
 }
+
+// GetGcBlobUploadRule ...
+func (s *daemonService) GetGcBlobUploadRule(ctx context.Context) (*models.DaemonGcBlobUploadRule, error) {
+	return s.tx.DaemonGcBlobUploadRule.WithContext(ctx).First()
+}
+
+// CreateGcBlobUploadRule ...
+func (s *daemonService) CreateGcBlobUploadRule(ctx context.Context, ruleObj *models.DaemonGcBlobUploadRule) error {
+	return s.tx.DaemonGcBlobUploadRule.WithContext(ctx).Create(ruleObj)
+}
+
+// UpdateGcBlobUploadRule ...
+func (s *daemonService) UpdateGcBlobUploadRule(ctx context.Context, ruleID int64, updates map[string]any) error {
+	if len(updates) == 0 {
+		return nil
+	}
+	matched, err := s.tx.DaemonGcBlobUploadRule.WithContext(ctx).Where(s.tx.DaemonGcBlobUploadRule.ID.Eq(ruleID)).Updates(updates)
+	if err != nil {
+		return err
+	}
+	if matched.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetGcBlobUploadLatestRunner ...
+func (s *daemonService) GetGcBlobUploadLatestRunner(ctx context.Context, ruleID int64) (*models.DaemonGcBlobUploadRunner, error) {
+	return s.tx.DaemonGcBlobUploadRunner.WithContext(ctx).
+		Where(s.tx.DaemonGcBlobUploadRunner.RuleID.Eq(ruleID)).
+		Order(s.tx.DaemonGcBlobUploadRunner.CreatedAt.Desc()).First()
+}
+
+// GetGcBlobUploadRunner ...
+func (s *daemonService) GetGcBlobUploadRunner(ctx context.Context, runnerID int64) (*models.DaemonGcBlobUploadRunner, error) {
+	return s.tx.DaemonGcBlobUploadRunner.WithContext(ctx).
+		Where(s.tx.DaemonGcBlobUploadRunner.ID.Eq(runnerID)).
+		Preload(s.tx.DaemonGcBlobUploadRunner.Rule).
+		Preload(s.tx.DaemonGcBlobUploadRunner.OperateUser).
+		First()
+}
+
+// ListGcBlobUploadRunners ...
+func (s *daemonService) ListGcBlobUploadRunners(ctx context.Context, ruleID int64, pagination types.Pagination, sort types.Sortable) ([]*models.DaemonGcBlobUploadRunner, int64, error) {
+	pagination = utils.NormalizePagination(pagination)
+	q := s.tx.DaemonGcBlobUploadRunner.WithContext(ctx).Where(s.tx.DaemonGcBlobUploadRunner.RuleID.Eq(ruleID))
+	field, ok := s.tx.DaemonGcBlobUploadRunner.GetFieldByName(ptr.To(sort.Sort))
+	if ok {
+		switch ptr.To(sort.Method) {
+		case enums.SortMethodDesc:
+			q = q.Order(field.Desc())
+		case enums.SortMethodAsc:
+			q = q.Order(field)
+		default:
+			q = q.Order(s.tx.DaemonGcBlobUploadRunner.UpdatedAt.Desc())
+		}
+	} else {
+		q = q.Order(s.tx.DaemonGcBlobUploadRunner.UpdatedAt.Desc())
+	}
+	return q.FindByPage(ptr.To(pagination.Limit)*(ptr.To(pagination.Page)-1), ptr.To(pagination.Limit))
+}
+
+// CreateGcBlobUploadRunner ...
+func (s *daemonService) CreateGcBlobUploadRunner(ctx context.Context, runnerObj *models.DaemonGcBlobUploadRunner) error {
+	return s.tx.DaemonGcBlobUploadRunner.WithContext(ctx).Create(runnerObj)
+}
+
+// UpdateGcBlobUploadRunner ...
+func (s *daemonService) UpdateGcBlobUploadRunner(ctx context.Context, runnerID int64, updates map[string]any) error {
+	if len(updates) == 0 {
+		return nil
+	}
+	_, err := s.tx.DaemonGcBlobUploadRunner.WithContext(ctx).Where(s.tx.DaemonGcBlobUploadRunner.ID.Eq(runnerID)).Updates(updates)
+	return err
+}
+
+// CreateGcBlobUploadRecords ...
+func (s *daemonService) CreateGcBlobUploadRecords(ctx context.Context, records []*models.DaemonGcBlobUploadRecord) error {
+	return s.tx.DaemonGcBlobUploadRecord.WithContext(ctx).CreateInBatches(records, consts.InsertBatchSize)
+}
+
+// ListGcBlobUploadRecords ...
+func (s *daemonService) ListGcBlobUploadRecords(ctx context.Context, runnerID int64, pagination types.Pagination, sort types.Sortable) ([]*models.DaemonGcBlobUploadRecord, int64, error) {
+	pagination = utils.NormalizePagination(pagination)
+	q := s.tx.DaemonGcBlobUploadRecord.WithContext(ctx).Where(s.tx.DaemonGcBlobUploadRecord.RunnerID.Eq(runnerID))
+	field, ok := s.tx.DaemonGcBlobUploadRecord.GetFieldByName(ptr.To(sort.Sort))
+	if ok {
+		switch ptr.To(sort.Method) {
+		case enums.SortMethodDesc:
+			q = q.Order(field.Desc())
+		case enums.SortMethodAsc:
+			q = q.Order(field)
+		default:
+			q = q.Order(s.tx.DaemonGcBlobUploadRecord.UpdatedAt.Desc())
+		}
+	} else {
+		q = q.Order(s.tx.DaemonGcBlobUploadRecord.UpdatedAt.Desc())
+	}
+	return q.FindByPage(ptr.To(pagination.Limit)*(ptr.To(pagination.Page)-1), ptr.To(pagination.Limit))
+}
+
+// GetGcBlobUploadRecord ...
+func (s *daemonService) GetGcBlobUploadRecord(ctx context.Context, recordID int64) (*models.DaemonGcBlobUploadRecord, error) {
+	return s.tx.DaemonGcBlobUploadRecord.WithContext(ctx).Where(s.tx.DaemonGcBlobUploadRecord.ID.Eq(recordID)).
+		Preload(s.tx.DaemonGcBlobUploadRecord.Runner).
+		Preload(s.tx.DaemonGcBlobUploadRecord.Runner.Rule).
+		First()
+}
+
+// SummaryGcRepositoryRecords summaries the succeed gc repository records of the runner group by namespace
+func (s *daemonService) SummaryGcRepositoryRecords(ctx context.Context, runnerID int64) ([]GcRecordSummary, error) {
+	var result []GcRecordSummary
+	err := s.tx.DaemonGcRepositoryRecord.WithContext(ctx).
+		Where(s.tx.DaemonGcRepositoryRecord.RunnerID.Eq(runnerID), s.tx.DaemonGcRepositoryRecord.Status.Eq(enums.GcRecordStatusSuccess)).
+		Group(s.tx.DaemonGcRepositoryRecord.NamespaceID).
+		Select(s.tx.DaemonGcRepositoryRecord.NamespaceID, s.tx.DaemonGcRepositoryRecord.ID.Count().As("count"), s.tx.DaemonGcRepositoryRecord.Size.Sum().As("size")).
+		Scan(&result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SummaryGcTagRecords summaries the succeed gc tag records of the runner group by namespace
+func (s *daemonService) SummaryGcTagRecords(ctx context.Context, runnerID int64) ([]GcRecordSummary, error) {
+	var result []GcRecordSummary
+	err := s.tx.DaemonGcTagRecord.WithContext(ctx).
+		Where(s.tx.DaemonGcTagRecord.RunnerID.Eq(runnerID), s.tx.DaemonGcTagRecord.Status.Eq(enums.GcRecordStatusSuccess)).
+		Group(s.tx.DaemonGcTagRecord.NamespaceID).
+		Select(s.tx.DaemonGcTagRecord.NamespaceID, s.tx.DaemonGcTagRecord.ID.Count().As("count"), s.tx.DaemonGcTagRecord.Size.Sum().As("size")).
+		Scan(&result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SummaryGcArtifactRecords summaries the succeed gc artifact records of the runner group by namespace
+func (s *daemonService) SummaryGcArtifactRecords(ctx context.Context, runnerID int64) ([]GcRecordSummary, error) {
+	var result []GcRecordSummary
+	err := s.tx.DaemonGcArtifactRecord.WithContext(ctx).
+		Where(s.tx.DaemonGcArtifactRecord.RunnerID.Eq(runnerID), s.tx.DaemonGcArtifactRecord.Status.Eq(enums.GcRecordStatusSuccess)).
+		Group(s.tx.DaemonGcArtifactRecord.NamespaceID).
+		Select(s.tx.DaemonGcArtifactRecord.NamespaceID, s.tx.DaemonGcArtifactRecord.ID.Count().As("count"), s.tx.DaemonGcArtifactRecord.Size.Sum().As("size")).
+		Scan(&result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SummaryGcBlobRecords summaries the succeed gc blob records of the runner
+func (s *daemonService) SummaryGcBlobRecords(ctx context.Context, runnerID int64) (*GcRecordSummary, error) {
+	var result GcRecordSummary
+	err := s.tx.DaemonGcBlobRecord.WithContext(ctx).
+		Where(s.tx.DaemonGcBlobRecord.RunnerID.Eq(runnerID), s.tx.DaemonGcBlobRecord.Status.Eq(enums.GcRecordStatusSuccess)).
+		Select(s.tx.DaemonGcBlobRecord.ID.Count().As("count"), s.tx.DaemonGcBlobRecord.Size.Sum().As("size")).
+		Scan(&result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SummaryGcBlobUploadRecords summaries the succeed gc blob upload records of the runner
+func (s *daemonService) SummaryGcBlobUploadRecords(ctx context.Context, runnerID int64) (*GcRecordSummary, error) {
+	var result GcRecordSummary
+	err := s.tx.DaemonGcBlobUploadRecord.WithContext(ctx).
+		Where(s.tx.DaemonGcBlobUploadRecord.RunnerID.Eq(runnerID), s.tx.DaemonGcBlobUploadRecord.Status.Eq(enums.GcRecordStatusSuccess)).
+		Select(s.tx.DaemonGcBlobUploadRecord.ID.Count().As("count"), s.tx.DaemonGcBlobUploadRecord.Size.Sum().As("size")).
+		Scan(&result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetGcPipelineRule ...
+func (s *daemonService) GetGcPipelineRule(ctx context.Context) (*models.DaemonGcPipelineRule, error) {
+	return s.tx.DaemonGcPipelineRule.WithContext(ctx).First()
+}
+
+// CreateGcPipelineRule ...
+func (s *daemonService) CreateGcPipelineRule(ctx context.Context, ruleObj *models.DaemonGcPipelineRule) error {
+	return s.tx.DaemonGcPipelineRule.WithContext(ctx).Create(ruleObj)
+}
+
+// UpdateGcPipelineRule ...
+func (s *daemonService) UpdateGcPipelineRule(ctx context.Context, ruleID int64, updates map[string]any) error {
+	if len(updates) == 0 {
+		return nil
+	}
+	matched, err := s.tx.DaemonGcPipelineRule.WithContext(ctx).Where(s.tx.DaemonGcPipelineRule.ID.Eq(ruleID)).Updates(updates)
+	if err != nil {
+		return err
+	}
+	if matched.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetGcPipelineLatestRunner ...
+func (s *daemonService) GetGcPipelineLatestRunner(ctx context.Context, ruleID int64) (*models.DaemonGcPipelineRunner, error) {
+	return s.tx.DaemonGcPipelineRunner.WithContext(ctx).
+		Where(s.tx.DaemonGcPipelineRunner.RuleID.Eq(ruleID)).
+		Order(s.tx.DaemonGcPipelineRunner.CreatedAt.Desc()).First()
+}
+
+// GetGcPipelineRunner ...
+func (s *daemonService) GetGcPipelineRunner(ctx context.Context, runnerID int64) (*models.DaemonGcPipelineRunner, error) {
+	return s.tx.DaemonGcPipelineRunner.WithContext(ctx).
+		Where(s.tx.DaemonGcPipelineRunner.ID.Eq(runnerID)).
+		Preload(s.tx.DaemonGcPipelineRunner.Rule).
+		Preload(s.tx.DaemonGcPipelineRunner.OperateUser).
+		First()
+}
+
+// ListGcPipelineRunners ...
+func (s *daemonService) ListGcPipelineRunners(ctx context.Context, ruleID int64, pagination types.Pagination, sort types.Sortable) ([]*models.DaemonGcPipelineRunner, int64, error) {
+	pagination = utils.NormalizePagination(pagination)
+	q := s.tx.DaemonGcPipelineRunner.WithContext(ctx).Where(s.tx.DaemonGcPipelineRunner.RuleID.Eq(ruleID))
+	field, ok := s.tx.DaemonGcPipelineRunner.GetFieldByName(ptr.To(sort.Sort))
+	if ok {
+		switch ptr.To(sort.Method) {
+		case enums.SortMethodDesc:
+			q = q.Order(field.Desc())
+		case enums.SortMethodAsc:
+			q = q.Order(field)
+		default:
+			q = q.Order(s.tx.DaemonGcPipelineRunner.UpdatedAt.Desc())
+		}
+	} else {
+		q = q.Order(s.tx.DaemonGcPipelineRunner.UpdatedAt.Desc())
+	}
+	return q.FindByPage(ptr.To(pagination.Limit)*(ptr.To(pagination.Page)-1), ptr.To(pagination.Limit))
+}
+
+// CreateGcPipelineRunner ...
+func (s *daemonService) CreateGcPipelineRunner(ctx context.Context, runnerObj *models.DaemonGcPipelineRunner) error {
+	return s.tx.DaemonGcPipelineRunner.WithContext(ctx).Create(runnerObj)
+}
+
+// UpdateGcPipelineRunner ...
+func (s *daemonService) UpdateGcPipelineRunner(ctx context.Context, runnerID int64, updates map[string]any) error {
+	if len(updates) == 0 {
+		return nil
+	}
+	_, err := s.tx.DaemonGcPipelineRunner.WithContext(ctx).Where(s.tx.DaemonGcPipelineRunner.ID.Eq(runnerID)).Updates(updates)
+	return err
+}
@@ -15,12 +15,21 @@
 package dao_test
 
 import (
+	"context"
 	"testing"
 
+	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/go-sigma/sigma/pkg/dal"
 	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
 	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/logger"
+	"github.com/go-sigma/sigma/pkg/tests"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
 )
 
 func TestDaemonServiceFactory(t *testing.T) {
@@ -28,3 +37,147 @@ func TestDaemonServiceFactory(t *testing.T) {
 	assert.NotNil(t, f.New())
 	assert.NotNil(t, f.New(query.Q))
 }
+
+func TestDaemonServiceGcPipeline(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	daemonService := dao.NewDaemonServiceFactory().New()
+
+	_, err := daemonService.GetGcPipelineRule(ctx)
+	assert.Error(t, err)
+
+	ruleObj := &models.DaemonGcPipelineRule{CronEnabled: true, CronRule: ptr.Of("0 2 * * 6")}
+	assert.NoError(t, daemonService.CreateGcPipelineRule(ctx, ruleObj))
+	assert.NoError(t, daemonService.UpdateGcPipelineRule(ctx, ruleObj.ID, map[string]any{
+		query.DaemonGcPipelineRule.CronNextTrigger.ColumnName().String(): int64(100),
+	}))
+	assert.Error(t, daemonService.UpdateGcPipelineRule(ctx, 100, map[string]any{
+		query.DaemonGcPipelineRule.CronEnabled.ColumnName().String(): false,
+	}))
+	ruleObj, err = daemonService.GetGcPipelineRule(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), ptr.To(ruleObj.CronNextTrigger))
+
+	runnerObj := &models.DaemonGcPipelineRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending, OperateType: enums.OperateTypeAutomatic}
+	assert.NoError(t, daemonService.CreateGcPipelineRunner(ctx, runnerObj))
+	assert.NoError(t, daemonService.UpdateGcPipelineRunner(ctx, runnerObj.ID, map[string]any{
+		query.DaemonGcPipelineRunner.Status.ColumnName().String(): enums.TaskCommonStatusDoing,
+		query.DaemonGcPipelineRunner.Stage.ColumnName().String():  enums.DaemonGcTag,
+	}))
+	latestRunnerObj, err := daemonService.GetGcPipelineLatestRunner(ctx, ruleObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, runnerObj.ID, latestRunnerObj.ID)
+	runnerObj, err = daemonService.GetGcPipelineRunner(ctx, runnerObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, enums.TaskCommonStatusDoing, runnerObj.Status)
+	assert.Equal(t, enums.DaemonGcTag, ptr.To(runnerObj.Stage))
+	runnerObjs, total, err := daemonService.ListGcPipelineRunners(ctx, ruleObj.ID, types.Pagination{}, types.Sortable{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, 1, len(runnerObjs))
+
+	tagRuleObj := &models.DaemonGcTagRule{RetentionRuleType: enums.RetentionRuleTypeDay, RetentionRuleAmount: 1}
+	assert.NoError(t, daemonService.CreateGcTagRule(ctx, tagRuleObj))
+	tagRunnerObj := &models.DaemonGcTagRunner{RuleID: tagRuleObj.ID, Status: enums.TaskCommonStatusSuccess, OperateType: enums.OperateTypeAutomatic}
+	assert.NoError(t, daemonService.CreateGcTagRunner(ctx, tagRunnerObj))
+	assert.NoError(t, daemonService.CreateGcTagRecords(ctx, []*models.DaemonGcTagRecord{
+		{RunnerID: tagRunnerObj.ID, NamespaceID: ptr.Of(int64(1)), Tag: "v1", Status: enums.GcRecordStatusSuccess, Size: 10},
+		{RunnerID: tagRunnerObj.ID, NamespaceID: ptr.Of(int64(1)), Tag: "v2", Status: enums.GcRecordStatusSuccess, Size: 20},
+		{RunnerID: tagRunnerObj.ID, NamespaceID: ptr.Of(int64(1)), Tag: "v3", Status: enums.GcRecordStatusFailed, Size: 30},
+		{RunnerID: tagRunnerObj.ID, NamespaceID: ptr.Of(int64(2)), Tag: "v1", Status: enums.GcRecordStatusSuccess, Size: 40},
+	}))
+	summaries, err := daemonService.SummaryGcTagRecords(ctx, tagRunnerObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(summaries))
+	for _, summary := range summaries {
+		switch ptr.To(summary.NamespaceID) {
+		case 1:
+			assert.Equal(t, int64(2), summary.Count)
+			assert.Equal(t, int64(30), summary.Size)
+		case 2:
+			assert.Equal(t, int64(1), summary.Count)
+			assert.Equal(t, int64(40), summary.Size)
+		default:
+			t.Errorf("unexpected namespace id: %v", summary.NamespaceID)
+		}
+	}
+
+	blobRuleObj := &models.DaemonGcBlobRule{RetentionDay: 3}
+	assert.NoError(t, daemonService.CreateGcBlobRule(ctx, blobRuleObj))
+	blobRunnerObj := &models.DaemonGcBlobRunner{RuleID: blobRuleObj.ID, Status: enums.TaskCommonStatusSuccess, OperateType: enums.OperateTypeAutomatic}
+	assert.NoError(t, daemonService.CreateGcBlobRunner(ctx, blobRunnerObj))
+	blobSummary, err := daemonService.SummaryGcBlobRecords(ctx, blobRunnerObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), blobSummary.Count)
+	assert.NoError(t, daemonService.CreateGcBlobRecords(ctx, []*models.DaemonGcBlobRecord{
+		{RunnerID: blobRunnerObj.ID, Digest: "sha256:123", Status: enums.GcRecordStatusSuccess, Size: 100},
+		{RunnerID: blobRunnerObj.ID, Digest: "sha256:234", Status: enums.GcRecordStatusSuccess, Size: 200},
+	}))
+	blobSummary, err = daemonService.SummaryGcBlobRecords(ctx, blobRunnerObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), blobSummary.Count)
+	assert.Equal(t, int64(300), blobSummary.Size)
+}
+
+func TestDaemonServiceGcBlobUpload(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	daemonService := dao.NewDaemonServiceFactory().New()
+
+	_, err := daemonService.GetGcBlobUploadRule(ctx)
+	assert.Error(t, err)
+
+	ruleObj := &models.DaemonGcBlobUploadRule{RetentionHour: 12}
+	assert.NoError(t, daemonService.CreateGcBlobUploadRule(ctx, ruleObj))
+	assert.NoError(t, daemonService.UpdateGcBlobUploadRule(ctx, ruleObj.ID, map[string]any{"cron_enabled": true, "cron_rule": "0 * * * *"}))
+	ruleObj, err = daemonService.GetGcBlobUploadRule(ctx)
+	assert.NoError(t, err)
+	assert.True(t, ruleObj.CronEnabled)
+	assert.Equal(t, 12, ruleObj.RetentionHour)
+
+	runnerObj := &models.DaemonGcBlobUploadRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending, OperateType: enums.OperateTypeAutomatic}
+	assert.NoError(t, daemonService.CreateGcBlobUploadRunner(ctx, runnerObj))
+	assert.NoError(t, daemonService.UpdateGcBlobUploadRunner(ctx, runnerObj.ID, map[string]any{"status": enums.TaskCommonStatusSuccess}))
+	latestRunnerObj, err := daemonService.GetGcBlobUploadLatestRunner(ctx, ruleObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, enums.TaskCommonStatusSuccess, latestRunnerObj.Status)
+	_, total, err := daemonService.ListGcBlobUploadRunners(ctx, ruleObj.ID, types.Pagination{Limit: ptr.Of(10), Page: ptr.Of(1)}, types.Sortable{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+
+	assert.NoError(t, daemonService.CreateGcBlobUploadRecords(ctx, []*models.DaemonGcBlobUploadRecord{
+		{RunnerID: runnerObj.ID, UploadID: "upload1", Repository: "library/busybox", Status: enums.GcRecordStatusSuccess, Size: 100},
+		{RunnerID: runnerObj.ID, UploadID: "upload2", Repository: "library/busybox", Status: enums.GcRecordStatusFailed, Size: 200},
+	}))
+	recordObjs, total, err := daemonService.ListGcBlobUploadRecords(ctx, runnerObj.ID, types.Pagination{Limit: ptr.Of(10), Page: ptr.Of(1)}, types.Sortable{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	recordObj, err := daemonService.GetGcBlobUploadRecord(ctx, recordObjs[0].ID)
+	assert.NoError(t, err)
+	assert.Equal(t, ruleObj.ID, recordObj.Runner.Rule.ID)
+
+	summary, err := daemonService.SummaryGcBlobUploadRecords(ctx, runnerObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), summary.Count)
+	assert.Equal(t, int64(100), summary.Size)
+}
@@ -128,6 +128,20 @@ func (mr *MockArtifactServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockArtifactService)(nil).Create), arg0, arg1)
 }
 
+// CreateMisconfiguration mocks base method.
+func (m *MockArtifactService) CreateMisconfiguration(arg0 context.Context, arg1 *models.ArtifactMisconfiguration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMisconfiguration", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMisconfiguration indicates an expected call of CreateMisconfiguration.
+func (mr *MockArtifactServiceMockRecorder) CreateMisconfiguration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMisconfiguration", reflect.TypeOf((*MockArtifactService)(nil).CreateMisconfiguration), arg0, arg1)
+}
+
 // CreateSbom mocks base method.
 func (m *MockArtifactService) CreateSbom(arg0 context.Context, arg1 *models.ArtifactSbom) error {
 	m.ctrl.T.Helper()
@@ -142,6 +156,20 @@ func (mr *MockArtifactServiceMockRecorder) CreateSbom(arg0, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSbom", reflect.TypeOf((*MockArtifactService)(nil).CreateSbom), arg0, arg1)
 }
 
+// CreateSecret mocks base method.
+func (m *MockArtifactService) CreateSecret(arg0 context.Context, arg1 *models.ArtifactSecret) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecret", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSecret indicates an expected call of CreateSecret.
+func (mr *MockArtifactServiceMockRecorder) CreateSecret(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockArtifactService)(nil).CreateSecret), arg0, arg1)
+}
+
 // CreateVulnerability mocks base method.
 func (m *MockArtifactService) CreateVulnerability(arg0 context.Context, arg1 *models.ArtifactVulnerability) error {
 	m.ctrl.T.Helper()
@@ -228,6 +256,36 @@ func (mr *MockArtifactServiceMockRecorder) FindAssociateWithTag(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAssociateWithTag", reflect.TypeOf((*MockArtifactService)(nil).FindAssociateWithTag), arg0, arg1)
 }
 
+// FindDanglingReferrers mocks base method.
+func (m *MockArtifactService) FindDanglingReferrers(arg0 context.Context, arg1, arg2 int64, arg3 int, arg4 int64) ([]*models.Artifact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDanglingReferrers", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*models.Artifact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDanglingReferrers indicates an expected call of FindDanglingReferrers.
+func (mr *MockArtifactServiceMockRecorder) FindDanglingReferrers(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDanglingReferrers", reflect.TypeOf((*MockArtifactService)(nil).FindDanglingReferrers), arg0, arg1, arg2, arg3, arg4)
+}
+
+// FindUntaggedWithCursor mocks base method.
+func (m *MockArtifactService) FindUntaggedWithCursor(arg0 context.Context, arg1, arg2 int64, arg3 int, arg4 int64) ([]*models.Artifact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUntaggedWithCursor", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*models.Artifact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUntaggedWithCursor indicates an expected call of FindUntaggedWithCursor.
+func (mr *MockArtifactServiceMockRecorder) FindUntaggedWithCursor(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUntaggedWithCursor", reflect.TypeOf((*MockArtifactService)(nil).FindUntaggedWithCursor), arg0, arg1, arg2, arg3, arg4)
+}
+
 // FindWithLastPull mocks base method.
 func (m *MockArtifactService) FindWithLastPull(arg0 context.Context, arg1, arg2, arg3, arg4 int64) ([]*models.Artifact, error) {
 	m.ctrl.T.Helper()
@@ -243,6 +301,21 @@ func (mr *MockArtifactServiceMockRecorder) FindWithLastPull(arg0, arg1, arg2, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWithLastPull", reflect.TypeOf((*MockArtifactService)(nil).FindWithLastPull), arg0, arg1, arg2, arg3, arg4)
 }
 
+// FindWithPulledAfter mocks base method.
+func (m *MockArtifactService) FindWithPulledAfter(arg0 context.Context, arg1 *int64, arg2, arg3, arg4 int64) ([]*models.Artifact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWithPulledAfter", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*models.Artifact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWithPulledAfter indicates an expected call of FindWithPulledAfter.
+func (mr *MockArtifactServiceMockRecorder) FindWithPulledAfter(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWithPulledAfter", reflect.TypeOf((*MockArtifactService)(nil).FindWithPulledAfter), arg0, arg1, arg2, arg3, arg4)
+}
+
 // Get mocks base method.
 func (m *MockArtifactService) Get(arg0 context.Context, arg1 int64) (*models.Artifact, error) {
 	m.ctrl.T.Helper()
@@ -288,6 +361,51 @@ func (mr *MockArtifactServiceMockRecorder) GetByDigests(arg0, arg1, arg2 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByDigests", reflect.TypeOf((*MockArtifactService)(nil).GetByDigests), arg0, arg1, arg2)
 }
 
+// GetDeleted mocks base method.
+func (m *MockArtifactService) GetDeleted(arg0 context.Context, arg1, arg2 int64) (*models.Artifact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeleted", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Artifact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeleted indicates an expected call of GetDeleted.
+func (mr *MockArtifactServiceMockRecorder) GetDeleted(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeleted", reflect.TypeOf((*MockArtifactService)(nil).GetDeleted), arg0, arg1, arg2)
+}
+
+// GetDeletedByDigest mocks base method.
+func (m *MockArtifactService) GetDeletedByDigest(arg0 context.Context, arg1 int64, arg2 string) (*models.Artifact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedByDigest", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Artifact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedByDigest indicates an expected call of GetDeletedByDigest.
+func (mr *MockArtifactServiceMockRecorder) GetDeletedByDigest(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedByDigest", reflect.TypeOf((*MockArtifactService)(nil).GetDeletedByDigest), arg0, arg1, arg2)
+}
+
+// GetMisconfiguration mocks base method.
+func (m *MockArtifactService) GetMisconfiguration(arg0 context.Context, arg1 int64) (*models.ArtifactMisconfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMisconfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*models.ArtifactMisconfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMisconfiguration indicates an expected call of GetMisconfiguration.
+func (mr *MockArtifactServiceMockRecorder) GetMisconfiguration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMisconfiguration", reflect.TypeOf((*MockArtifactService)(nil).GetMisconfiguration), arg0, arg1)
+}
+
 // GetNamespaceSize mocks base method.
 func (m *MockArtifactService) GetNamespaceSize(arg0 context.Context, arg1 int64) (int64, error) {
 	m.ctrl.T.Helper()
@@ -303,6 +421,21 @@ func (mr *MockArtifactServiceMockRecorder) GetNamespaceSize(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceSize", reflect.TypeOf((*MockArtifactService)(nil).GetNamespaceSize), arg0, arg1)
 }
 
+// GetNamespaceSizeIncrease mocks base method.
+func (m *MockArtifactService) GetNamespaceSizeIncrease(arg0 context.Context, arg1 int64, arg2 []int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespaceSizeIncrease", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceSizeIncrease indicates an expected call of GetNamespaceSizeIncrease.
+func (mr *MockArtifactServiceMockRecorder) GetNamespaceSizeIncrease(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceSizeIncrease", reflect.TypeOf((*MockArtifactService)(nil).GetNamespaceSizeIncrease), arg0, arg1, arg2)
+}
+
 // GetReferrers mocks base method.
 func (m *MockArtifactService) GetReferrers(arg0 context.Context, arg1 int64, arg2 string, arg3 []string) ([]*models.Artifact, error) {
 	m.ctrl.T.Helper()
@@ -333,6 +466,66 @@ func (mr *MockArtifactServiceMockRecorder) GetRepositorySize(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepositorySize", reflect.TypeOf((*MockArtifactService)(nil).GetRepositorySize), arg0, arg1)
 }
 
+// GetRepositorySizeIncrease mocks base method.
+func (m *MockArtifactService) GetRepositorySizeIncrease(arg0 context.Context, arg1 int64, arg2 []int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepositorySizeIncrease", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepositorySizeIncrease indicates an expected call of GetRepositorySizeIncrease.
+func (mr *MockArtifactServiceMockRecorder) GetRepositorySizeIncrease(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepositorySizeIncrease", reflect.TypeOf((*MockArtifactService)(nil).GetRepositorySizeIncrease), arg0, arg1, arg2)
+}
+
+// GetSbom mocks base method.
+func (m *MockArtifactService) GetSbom(arg0 context.Context, arg1 int64) (*models.ArtifactSbom, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSbom", arg0, arg1)
+	ret0, _ := ret[0].(*models.ArtifactSbom)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSbom indicates an expected call of GetSbom.
+func (mr *MockArtifactServiceMockRecorder) GetSbom(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSbom", reflect.TypeOf((*MockArtifactService)(nil).GetSbom), arg0, arg1)
+}
+
+// GetSecret mocks base method.
+func (m *MockArtifactService) GetSecret(arg0 context.Context, arg1 int64) (*models.ArtifactSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecret", arg0, arg1)
+	ret0, _ := ret[0].(*models.ArtifactSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecret indicates an expected call of GetSecret.
+func (mr *MockArtifactServiceMockRecorder) GetSecret(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockArtifactService)(nil).GetSecret), arg0, arg1)
+}
+
+// GetVulnerability mocks base method.
+func (m *MockArtifactService) GetVulnerability(arg0 context.Context, arg1 int64) (*models.ArtifactVulnerability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVulnerability", arg0, arg1)
+	ret0, _ := ret[0].(*models.ArtifactVulnerability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVulnerability indicates an expected call of GetVulnerability.
+func (mr *MockArtifactServiceMockRecorder) GetVulnerability(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVulnerability", reflect.TypeOf((*MockArtifactService)(nil).GetVulnerability), arg0, arg1)
+}
+
 // Incr mocks base method.
 func (m *MockArtifactService) Incr(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
@@ -376,6 +569,110 @@ func (mr *MockArtifactServiceMockRecorder) ListArtifact(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArtifact", reflect.TypeOf((*MockArtifactService)(nil).ListArtifact), arg0, arg1)
 }
 
+// ListDeleted mocks base method.
+func (m *MockArtifactService) ListDeleted(arg0 context.Context, arg1, arg2 int64, arg3 types.Pagination) ([]*models.Artifact, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.Artifact)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDeleted indicates an expected call of ListDeleted.
+func (mr *MockArtifactServiceMockRecorder) ListDeleted(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockArtifactService)(nil).ListDeleted), arg0, arg1, arg2, arg3)
+}
+
+// ListPackageVersions mocks base method.
+func (m *MockArtifactService) ListPackageVersions(arg0 context.Context, arg1 int64, arg2 string, arg3 *string, arg4 *int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPackageVersions", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPackageVersions indicates an expected call of ListPackageVersions.
+func (mr *MockArtifactServiceMockRecorder) ListPackageVersions(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPackageVersions", reflect.TypeOf((*MockArtifactService)(nil).ListPackageVersions), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ListPackages mocks base method.
+func (m *MockArtifactService) ListPackages(arg0 context.Context, arg1 int64) ([]*models.ArtifactPackage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPackages", arg0, arg1)
+	ret0, _ := ret[0].([]*models.ArtifactPackage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPackages indicates an expected call of ListPackages.
+func (mr *MockArtifactServiceMockRecorder) ListPackages(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPackages", reflect.TypeOf((*MockArtifactService)(nil).ListPackages), arg0, arg1)
+}
+
+// ReplacePackages mocks base method.
+func (m *MockArtifactService) ReplacePackages(arg0 context.Context, arg1 int64, arg2 []*models.ArtifactPackage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplacePackages", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplacePackages indicates an expected call of ReplacePackages.
+func (mr *MockArtifactServiceMockRecorder) ReplacePackages(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplacePackages", reflect.TypeOf((*MockArtifactService)(nil).ReplacePackages), arg0, arg1, arg2)
+}
+
+// Restore mocks base method.
+func (m *MockArtifactService) Restore(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockArtifactServiceMockRecorder) Restore(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockArtifactService)(nil).Restore), arg0, arg1)
+}
+
+// SearchPackages mocks base method.
+func (m *MockArtifactService) SearchPackages(arg0 context.Context, arg1 int64, arg2 string, arg3 *string, arg4 *int64, arg5 []string, arg6 types.Pagination) ([]*models.ArtifactPackage, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchPackages", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].([]*models.ArtifactPackage)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchPackages indicates an expected call of SearchPackages.
+func (mr *MockArtifactServiceMockRecorder) SearchPackages(arg0, arg1, arg2, arg3, arg4, arg5, arg6 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPackages", reflect.TypeOf((*MockArtifactService)(nil).SearchPackages), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// UpdateMisconfiguration mocks base method.
+func (m *MockArtifactService) UpdateMisconfiguration(arg0 context.Context, arg1 int64, arg2 map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMisconfiguration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMisconfiguration indicates an expected call of UpdateMisconfiguration.
+func (mr *MockArtifactServiceMockRecorder) UpdateMisconfiguration(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMisconfiguration", reflect.TypeOf((*MockArtifactService)(nil).UpdateMisconfiguration), arg0, arg1, arg2)
+}
+
 // UpdateSbom mocks base method.
 func (m *MockArtifactService) UpdateSbom(arg0 context.Context, arg1 int64, arg2 map[string]any) error {
 	m.ctrl.T.Helper()
@@ -390,6 +687,20 @@ func (mr *MockArtifactServiceMockRecorder) UpdateSbom(arg0, arg1, arg2 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSbom", reflect.TypeOf((*MockArtifactService)(nil).UpdateSbom), arg0, arg1, arg2)
 }
 
+// UpdateSecret mocks base method.
+func (m *MockArtifactService) UpdateSecret(arg0 context.Context, arg1 int64, arg2 map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecret", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSecret indicates an expected call of UpdateSecret.
+func (mr *MockArtifactServiceMockRecorder) UpdateSecret(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecret", reflect.TypeOf((*MockArtifactService)(nil).UpdateSecret), arg0, arg1, arg2)
+}
+
 // UpdateVulnerability mocks base method.
 func (m *MockArtifactService) UpdateVulnerability(arg0 context.Context, arg1 int64, arg2 map[string]any) error {
 	m.ctrl.T.Helper()
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	dao "github.com/go-sigma/sigma/pkg/dal/dao"
 	models "github.com/go-sigma/sigma/pkg/dal/models"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -68,6 +69,20 @@ func (mr *MockBlobServiceMockRecorder) DeleteByID(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockBlobService)(nil).DeleteByID), arg0, arg1)
 }
 
+// DeleteUnreferenced mocks base method.
+func (m *MockBlobService) DeleteUnreferenced(arg0 context.Context, arg1, arg2, arg3 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUnreferenced", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUnreferenced indicates an expected call of DeleteUnreferenced.
+func (mr *MockBlobServiceMockRecorder) DeleteUnreferenced(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnreferenced", reflect.TypeOf((*MockBlobService)(nil).DeleteUnreferenced), arg0, arg1, arg2, arg3)
+}
+
 // Exists mocks base method.
 func (m *MockBlobService) Exists(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -84,18 +99,33 @@ func (mr *MockBlobServiceMockRecorder) Exists(arg0, arg1 any) *gomock.Call {
 }
 
 // FindAssociateWithArtifact mocks base method.
-func (m *MockBlobService) FindAssociateWithArtifact(arg0 context.Context, arg1 []int64) ([]int64, error) {
+func (m *MockBlobService) FindAssociateWithArtifact(arg0 context.Context, arg1 []int64, arg2 int64) ([]int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAssociateWithArtifact", arg0, arg1)
+	ret := m.ctrl.Call(m, "FindAssociateWithArtifact", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindAssociateWithArtifact indicates an expected call of FindAssociateWithArtifact.
-func (mr *MockBlobServiceMockRecorder) FindAssociateWithArtifact(arg0, arg1 any) *gomock.Call {
+func (mr *MockBlobServiceMockRecorder) FindAssociateWithArtifact(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAssociateWithArtifact", reflect.TypeOf((*MockBlobService)(nil).FindAssociateWithArtifact), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAssociateWithArtifact", reflect.TypeOf((*MockBlobService)(nil).FindAssociateWithArtifact), arg0, arg1, arg2)
+}
+
+// FindByArtifact mocks base method.
+func (m *MockBlobService) FindByArtifact(arg0 context.Context, arg1 int64) ([]*models.Blob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByArtifact", arg0, arg1)
+	ret0, _ := ret[0].([]*models.Blob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByArtifact indicates an expected call of FindByArtifact.
+func (mr *MockBlobServiceMockRecorder) FindByArtifact(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByArtifact", reflect.TypeOf((*MockBlobService)(nil).FindByArtifact), arg0, arg1)
 }
 
 // FindByDigest mocks base method.
@@ -128,6 +158,36 @@ func (mr *MockBlobServiceMockRecorder) FindByDigests(arg0, arg1 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByDigests", reflect.TypeOf((*MockBlobService)(nil).FindByDigests), arg0, arg1)
 }
 
+// FindByRepository mocks base method.
+func (m *MockBlobService) FindByRepository(arg0 context.Context, arg1 int64) ([]*models.Blob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByRepository", arg0, arg1)
+	ret0, _ := ret[0].([]*models.Blob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByRepository indicates an expected call of FindByRepository.
+func (mr *MockBlobServiceMockRecorder) FindByRepository(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByRepository", reflect.TypeOf((*MockBlobService)(nil).FindByRepository), arg0, arg1)
+}
+
+// FindReferences mocks base method.
+func (m *MockBlobService) FindReferences(arg0 context.Context, arg1 []int64) ([]dao.BlobReference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReferences", arg0, arg1)
+	ret0, _ := ret[0].([]dao.BlobReference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReferences indicates an expected call of FindReferences.
+func (mr *MockBlobServiceMockRecorder) FindReferences(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReferences", reflect.TypeOf((*MockBlobService)(nil).FindReferences), arg0, arg1)
+}
+
 // FindWithLastPull mocks base method.
 func (m *MockBlobService) FindWithLastPull(arg0 context.Context, arg1, arg2, arg3 int64) ([]*models.Blob, error) {
 	m.ctrl.T.Helper()
@@ -156,3 +216,17 @@ func (mr *MockBlobServiceMockRecorder) Incr(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Incr", reflect.TypeOf((*MockBlobService)(nil).Incr), arg0, arg1)
 }
+
+// Touch mocks base method.
+func (m *MockBlobService) Touch(arg0 context.Context, arg1 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockBlobServiceMockRecorder) Touch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockBlobService)(nil).Touch), arg0, arg1)
+}
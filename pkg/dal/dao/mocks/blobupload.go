@@ -68,6 +68,21 @@ func (mr *MockBlobUploadServiceMockRecorder) DeleteByUploadID(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUploadID", reflect.TypeOf((*MockBlobUploadService)(nil).DeleteByUploadID), arg0, arg1)
 }
 
+// FindAbandonedWithCursor mocks base method.
+func (m *MockBlobUploadService) FindAbandonedWithCursor(arg0 context.Context, arg1 int64, arg2 int, arg3 int64) ([]*models.BlobUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAbandonedWithCursor", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.BlobUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAbandonedWithCursor indicates an expected call of FindAbandonedWithCursor.
+func (mr *MockBlobUploadServiceMockRecorder) FindAbandonedWithCursor(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAbandonedWithCursor", reflect.TypeOf((*MockBlobUploadService)(nil).FindAbandonedWithCursor), arg0, arg1, arg2, arg3)
+}
+
 // FindAllByUploadID mocks base method.
 func (m *MockBlobUploadService) FindAllByUploadID(arg0 context.Context, arg1 string) ([]*models.BlobUpload, error) {
 	m.ctrl.T.Helper()
@@ -98,6 +113,21 @@ func (mr *MockBlobUploadServiceMockRecorder) GetLastPart(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastPart", reflect.TypeOf((*MockBlobUploadService)(nil).GetLastPart), arg0, arg1)
 }
 
+// GetOldestActive mocks base method.
+func (m *MockBlobUploadService) GetOldestActive(arg0 context.Context, arg1 int64) (*models.BlobUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOldestActive", arg0, arg1)
+	ret0, _ := ret[0].(*models.BlobUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOldestActive indicates an expected call of GetOldestActive.
+func (mr *MockBlobUploadServiceMockRecorder) GetOldestActive(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOldestActive", reflect.TypeOf((*MockBlobUploadService)(nil).GetOldestActive), arg0, arg1)
+}
+
 // TotalEtagsByUploadID mocks base method.
 func (m *MockBlobUploadService) TotalEtagsByUploadID(arg0 context.Context, arg1 string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	dao "github.com/go-sigma/sigma/pkg/dal/dao"
 	models "github.com/go-sigma/sigma/pkg/dal/models"
 	types "github.com/go-sigma/sigma/pkg/types"
 	gomock "go.uber.org/mock/gomock"
@@ -125,6 +126,76 @@ func (mr *MockDaemonServiceMockRecorder) CreateGcBlobRunner(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGcBlobRunner", reflect.TypeOf((*MockDaemonService)(nil).CreateGcBlobRunner), arg0, arg1)
 }
 
+// CreateGcBlobUploadRecords mocks base method.
+func (m *MockDaemonService) CreateGcBlobUploadRecords(arg0 context.Context, arg1 []*models.DaemonGcBlobUploadRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGcBlobUploadRecords", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGcBlobUploadRecords indicates an expected call of CreateGcBlobUploadRecords.
+func (mr *MockDaemonServiceMockRecorder) CreateGcBlobUploadRecords(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGcBlobUploadRecords", reflect.TypeOf((*MockDaemonService)(nil).CreateGcBlobUploadRecords), arg0, arg1)
+}
+
+// CreateGcBlobUploadRule mocks base method.
+func (m *MockDaemonService) CreateGcBlobUploadRule(arg0 context.Context, arg1 *models.DaemonGcBlobUploadRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGcBlobUploadRule", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGcBlobUploadRule indicates an expected call of CreateGcBlobUploadRule.
+func (mr *MockDaemonServiceMockRecorder) CreateGcBlobUploadRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGcBlobUploadRule", reflect.TypeOf((*MockDaemonService)(nil).CreateGcBlobUploadRule), arg0, arg1)
+}
+
+// CreateGcBlobUploadRunner mocks base method.
+func (m *MockDaemonService) CreateGcBlobUploadRunner(arg0 context.Context, arg1 *models.DaemonGcBlobUploadRunner) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGcBlobUploadRunner", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGcBlobUploadRunner indicates an expected call of CreateGcBlobUploadRunner.
+func (mr *MockDaemonServiceMockRecorder) CreateGcBlobUploadRunner(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGcBlobUploadRunner", reflect.TypeOf((*MockDaemonService)(nil).CreateGcBlobUploadRunner), arg0, arg1)
+}
+
+// CreateGcPipelineRule mocks base method.
+func (m *MockDaemonService) CreateGcPipelineRule(arg0 context.Context, arg1 *models.DaemonGcPipelineRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGcPipelineRule", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGcPipelineRule indicates an expected call of CreateGcPipelineRule.
+func (mr *MockDaemonServiceMockRecorder) CreateGcPipelineRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGcPipelineRule", reflect.TypeOf((*MockDaemonService)(nil).CreateGcPipelineRule), arg0, arg1)
+}
+
+// CreateGcPipelineRunner mocks base method.
+func (m *MockDaemonService) CreateGcPipelineRunner(arg0 context.Context, arg1 *models.DaemonGcPipelineRunner) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGcPipelineRunner", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGcPipelineRunner indicates an expected call of CreateGcPipelineRunner.
+func (mr *MockDaemonServiceMockRecorder) CreateGcPipelineRunner(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGcPipelineRunner", reflect.TypeOf((*MockDaemonService)(nil).CreateGcPipelineRunner), arg0, arg1)
+}
+
 // CreateGcRepositoryRecords mocks base method.
 func (m *MockDaemonService) CreateGcRepositoryRecords(arg0 context.Context, arg1 []*models.DaemonGcRepositoryRecord) error {
 	m.ctrl.T.Helper()
@@ -329,6 +400,111 @@ func (mr *MockDaemonServiceMockRecorder) GetGcBlobRunner(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGcBlobRunner", reflect.TypeOf((*MockDaemonService)(nil).GetGcBlobRunner), arg0, arg1)
 }
 
+// GetGcBlobUploadLatestRunner mocks base method.
+func (m *MockDaemonService) GetGcBlobUploadLatestRunner(arg0 context.Context, arg1 int64) (*models.DaemonGcBlobUploadRunner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGcBlobUploadLatestRunner", arg0, arg1)
+	ret0, _ := ret[0].(*models.DaemonGcBlobUploadRunner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGcBlobUploadLatestRunner indicates an expected call of GetGcBlobUploadLatestRunner.
+func (mr *MockDaemonServiceMockRecorder) GetGcBlobUploadLatestRunner(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGcBlobUploadLatestRunner", reflect.TypeOf((*MockDaemonService)(nil).GetGcBlobUploadLatestRunner), arg0, arg1)
+}
+
+// GetGcBlobUploadRecord mocks base method.
+func (m *MockDaemonService) GetGcBlobUploadRecord(arg0 context.Context, arg1 int64) (*models.DaemonGcBlobUploadRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGcBlobUploadRecord", arg0, arg1)
+	ret0, _ := ret[0].(*models.DaemonGcBlobUploadRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGcBlobUploadRecord indicates an expected call of GetGcBlobUploadRecord.
+func (mr *MockDaemonServiceMockRecorder) GetGcBlobUploadRecord(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGcBlobUploadRecord", reflect.TypeOf((*MockDaemonService)(nil).GetGcBlobUploadRecord), arg0, arg1)
+}
+
+// GetGcBlobUploadRule mocks base method.
+func (m *MockDaemonService) GetGcBlobUploadRule(arg0 context.Context) (*models.DaemonGcBlobUploadRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGcBlobUploadRule", arg0)
+	ret0, _ := ret[0].(*models.DaemonGcBlobUploadRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGcBlobUploadRule indicates an expected call of GetGcBlobUploadRule.
+func (mr *MockDaemonServiceMockRecorder) GetGcBlobUploadRule(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGcBlobUploadRule", reflect.TypeOf((*MockDaemonService)(nil).GetGcBlobUploadRule), arg0)
+}
+
+// GetGcBlobUploadRunner mocks base method.
+func (m *MockDaemonService) GetGcBlobUploadRunner(arg0 context.Context, arg1 int64) (*models.DaemonGcBlobUploadRunner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGcBlobUploadRunner", arg0, arg1)
+	ret0, _ := ret[0].(*models.DaemonGcBlobUploadRunner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGcBlobUploadRunner indicates an expected call of GetGcBlobUploadRunner.
+func (mr *MockDaemonServiceMockRecorder) GetGcBlobUploadRunner(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGcBlobUploadRunner", reflect.TypeOf((*MockDaemonService)(nil).GetGcBlobUploadRunner), arg0, arg1)
+}
+
+// GetGcPipelineLatestRunner mocks base method.
+func (m *MockDaemonService) GetGcPipelineLatestRunner(arg0 context.Context, arg1 int64) (*models.DaemonGcPipelineRunner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGcPipelineLatestRunner", arg0, arg1)
+	ret0, _ := ret[0].(*models.DaemonGcPipelineRunner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGcPipelineLatestRunner indicates an expected call of GetGcPipelineLatestRunner.
+func (mr *MockDaemonServiceMockRecorder) GetGcPipelineLatestRunner(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGcPipelineLatestRunner", reflect.TypeOf((*MockDaemonService)(nil).GetGcPipelineLatestRunner), arg0, arg1)
+}
+
+// GetGcPipelineRule mocks base method.
+func (m *MockDaemonService) GetGcPipelineRule(arg0 context.Context) (*models.DaemonGcPipelineRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGcPipelineRule", arg0)
+	ret0, _ := ret[0].(*models.DaemonGcPipelineRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGcPipelineRule indicates an expected call of GetGcPipelineRule.
+func (mr *MockDaemonServiceMockRecorder) GetGcPipelineRule(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGcPipelineRule", reflect.TypeOf((*MockDaemonService)(nil).GetGcPipelineRule), arg0)
+}
+
+// GetGcPipelineRunner mocks base method.
+func (m *MockDaemonService) GetGcPipelineRunner(arg0 context.Context, arg1 int64) (*models.DaemonGcPipelineRunner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGcPipelineRunner", arg0, arg1)
+	ret0, _ := ret[0].(*models.DaemonGcPipelineRunner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGcPipelineRunner indicates an expected call of GetGcPipelineRunner.
+func (mr *MockDaemonServiceMockRecorder) GetGcPipelineRunner(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGcPipelineRunner", reflect.TypeOf((*MockDaemonService)(nil).GetGcPipelineRunner), arg0, arg1)
+}
+
 // GetGcRepositoryLatestRunner mocks base method.
 func (m *MockDaemonService) GetGcRepositoryLatestRunner(arg0 context.Context, arg1 int64) (*models.DaemonGcRepositoryRunner, error) {
 	m.ctrl.T.Helper()
@@ -513,6 +689,54 @@ func (mr *MockDaemonServiceMockRecorder) ListGcBlobRunners(arg0, arg1, arg2, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGcBlobRunners", reflect.TypeOf((*MockDaemonService)(nil).ListGcBlobRunners), arg0, arg1, arg2, arg3)
 }
 
+// ListGcBlobUploadRecords mocks base method.
+func (m *MockDaemonService) ListGcBlobUploadRecords(arg0 context.Context, arg1 int64, arg2 types.Pagination, arg3 types.Sortable) ([]*models.DaemonGcBlobUploadRecord, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGcBlobUploadRecords", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.DaemonGcBlobUploadRecord)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListGcBlobUploadRecords indicates an expected call of ListGcBlobUploadRecords.
+func (mr *MockDaemonServiceMockRecorder) ListGcBlobUploadRecords(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGcBlobUploadRecords", reflect.TypeOf((*MockDaemonService)(nil).ListGcBlobUploadRecords), arg0, arg1, arg2, arg3)
+}
+
+// ListGcBlobUploadRunners mocks base method.
+func (m *MockDaemonService) ListGcBlobUploadRunners(arg0 context.Context, arg1 int64, arg2 types.Pagination, arg3 types.Sortable) ([]*models.DaemonGcBlobUploadRunner, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGcBlobUploadRunners", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.DaemonGcBlobUploadRunner)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListGcBlobUploadRunners indicates an expected call of ListGcBlobUploadRunners.
+func (mr *MockDaemonServiceMockRecorder) ListGcBlobUploadRunners(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGcBlobUploadRunners", reflect.TypeOf((*MockDaemonService)(nil).ListGcBlobUploadRunners), arg0, arg1, arg2, arg3)
+}
+
+// ListGcPipelineRunners mocks base method.
+func (m *MockDaemonService) ListGcPipelineRunners(arg0 context.Context, arg1 int64, arg2 types.Pagination, arg3 types.Sortable) ([]*models.DaemonGcPipelineRunner, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGcPipelineRunners", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.DaemonGcPipelineRunner)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListGcPipelineRunners indicates an expected call of ListGcPipelineRunners.
+func (mr *MockDaemonServiceMockRecorder) ListGcPipelineRunners(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGcPipelineRunners", reflect.TypeOf((*MockDaemonService)(nil).ListGcPipelineRunners), arg0, arg1, arg2, arg3)
+}
+
 // ListGcRepositoryRecords mocks base method.
 func (m *MockDaemonService) ListGcRepositoryRecords(arg0 context.Context, arg1 int64, arg2 types.Pagination, arg3 types.Sortable) ([]*models.DaemonGcRepositoryRecord, int64, error) {
 	m.ctrl.T.Helper()
@@ -577,6 +801,81 @@ func (mr *MockDaemonServiceMockRecorder) ListGcTagRunners(arg0, arg1, arg2, arg3
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGcTagRunners", reflect.TypeOf((*MockDaemonService)(nil).ListGcTagRunners), arg0, arg1, arg2, arg3)
 }
 
+// SummaryGcArtifactRecords mocks base method.
+func (m *MockDaemonService) SummaryGcArtifactRecords(arg0 context.Context, arg1 int64) ([]dao.GcRecordSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummaryGcArtifactRecords", arg0, arg1)
+	ret0, _ := ret[0].([]dao.GcRecordSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummaryGcArtifactRecords indicates an expected call of SummaryGcArtifactRecords.
+func (mr *MockDaemonServiceMockRecorder) SummaryGcArtifactRecords(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummaryGcArtifactRecords", reflect.TypeOf((*MockDaemonService)(nil).SummaryGcArtifactRecords), arg0, arg1)
+}
+
+// SummaryGcBlobRecords mocks base method.
+func (m *MockDaemonService) SummaryGcBlobRecords(arg0 context.Context, arg1 int64) (*dao.GcRecordSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummaryGcBlobRecords", arg0, arg1)
+	ret0, _ := ret[0].(*dao.GcRecordSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummaryGcBlobRecords indicates an expected call of SummaryGcBlobRecords.
+func (mr *MockDaemonServiceMockRecorder) SummaryGcBlobRecords(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummaryGcBlobRecords", reflect.TypeOf((*MockDaemonService)(nil).SummaryGcBlobRecords), arg0, arg1)
+}
+
+// SummaryGcBlobUploadRecords mocks base method.
+func (m *MockDaemonService) SummaryGcBlobUploadRecords(arg0 context.Context, arg1 int64) (*dao.GcRecordSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummaryGcBlobUploadRecords", arg0, arg1)
+	ret0, _ := ret[0].(*dao.GcRecordSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummaryGcBlobUploadRecords indicates an expected call of SummaryGcBlobUploadRecords.
+func (mr *MockDaemonServiceMockRecorder) SummaryGcBlobUploadRecords(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummaryGcBlobUploadRecords", reflect.TypeOf((*MockDaemonService)(nil).SummaryGcBlobUploadRecords), arg0, arg1)
+}
+
+// SummaryGcRepositoryRecords mocks base method.
+func (m *MockDaemonService) SummaryGcRepositoryRecords(arg0 context.Context, arg1 int64) ([]dao.GcRecordSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummaryGcRepositoryRecords", arg0, arg1)
+	ret0, _ := ret[0].([]dao.GcRecordSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummaryGcRepositoryRecords indicates an expected call of SummaryGcRepositoryRecords.
+func (mr *MockDaemonServiceMockRecorder) SummaryGcRepositoryRecords(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummaryGcRepositoryRecords", reflect.TypeOf((*MockDaemonService)(nil).SummaryGcRepositoryRecords), arg0, arg1)
+}
+
+// SummaryGcTagRecords mocks base method.
+func (m *MockDaemonService) SummaryGcTagRecords(arg0 context.Context, arg1 int64) ([]dao.GcRecordSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummaryGcTagRecords", arg0, arg1)
+	ret0, _ := ret[0].([]dao.GcRecordSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummaryGcTagRecords indicates an expected call of SummaryGcTagRecords.
+func (mr *MockDaemonServiceMockRecorder) SummaryGcTagRecords(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummaryGcTagRecords", reflect.TypeOf((*MockDaemonService)(nil).SummaryGcTagRecords), arg0, arg1)
+}
+
 // UpdateGcArtifactRule mocks base method.
 func (m *MockDaemonService) UpdateGcArtifactRule(arg0 context.Context, arg1 int64, arg2 map[string]any) error {
 	m.ctrl.T.Helper()
@@ -633,6 +932,62 @@ func (mr *MockDaemonServiceMockRecorder) UpdateGcBlobRunner(arg0, arg1, arg2 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGcBlobRunner", reflect.TypeOf((*MockDaemonService)(nil).UpdateGcBlobRunner), arg0, arg1, arg2)
 }
 
+// UpdateGcBlobUploadRule mocks base method.
+func (m *MockDaemonService) UpdateGcBlobUploadRule(arg0 context.Context, arg1 int64, arg2 map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGcBlobUploadRule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGcBlobUploadRule indicates an expected call of UpdateGcBlobUploadRule.
+func (mr *MockDaemonServiceMockRecorder) UpdateGcBlobUploadRule(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGcBlobUploadRule", reflect.TypeOf((*MockDaemonService)(nil).UpdateGcBlobUploadRule), arg0, arg1, arg2)
+}
+
+// UpdateGcBlobUploadRunner mocks base method.
+func (m *MockDaemonService) UpdateGcBlobUploadRunner(arg0 context.Context, arg1 int64, arg2 map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGcBlobUploadRunner", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGcBlobUploadRunner indicates an expected call of UpdateGcBlobUploadRunner.
+func (mr *MockDaemonServiceMockRecorder) UpdateGcBlobUploadRunner(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGcBlobUploadRunner", reflect.TypeOf((*MockDaemonService)(nil).UpdateGcBlobUploadRunner), arg0, arg1, arg2)
+}
+
+// UpdateGcPipelineRule mocks base method.
+func (m *MockDaemonService) UpdateGcPipelineRule(arg0 context.Context, arg1 int64, arg2 map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGcPipelineRule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGcPipelineRule indicates an expected call of UpdateGcPipelineRule.
+func (mr *MockDaemonServiceMockRecorder) UpdateGcPipelineRule(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGcPipelineRule", reflect.TypeOf((*MockDaemonService)(nil).UpdateGcPipelineRule), arg0, arg1, arg2)
+}
+
+// UpdateGcPipelineRunner mocks base method.
+func (m *MockDaemonService) UpdateGcPipelineRunner(arg0 context.Context, arg1 int64, arg2 map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGcPipelineRunner", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGcPipelineRunner indicates an expected call of UpdateGcPipelineRunner.
+func (mr *MockDaemonServiceMockRecorder) UpdateGcPipelineRunner(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGcPipelineRunner", reflect.TypeOf((*MockDaemonService)(nil).UpdateGcPipelineRunner), arg0, arg1, arg2)
+}
+
 // UpdateGcRepositoryRule mocks base method.
 func (m *MockDaemonService) UpdateGcRepositoryRule(arg0 context.Context, arg1 int64, arg2 map[string]any) error {
 	m.ctrl.T.Helper()
@@ -15,6 +15,7 @@ import (
 
 	models "github.com/go-sigma/sigma/pkg/dal/models"
 	types "github.com/go-sigma/sigma/pkg/types"
+	enums "github.com/go-sigma/sigma/pkg/types/enums"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -144,6 +145,37 @@ func (mr *MockNamespaceServiceMockRecorder) GetByName(arg0, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockNamespaceService)(nil).GetByName), arg0, arg1)
 }
 
+// GetDeleted mocks base method.
+func (m *MockNamespaceService) GetDeleted(arg0 context.Context, arg1, arg2 int64) (*models.Namespace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeleted", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Namespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeleted indicates an expected call of GetDeleted.
+func (mr *MockNamespaceServiceMockRecorder) GetDeleted(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeleted", reflect.TypeOf((*MockNamespaceService)(nil).GetDeleted), arg0, arg1, arg2)
+}
+
+// ListDeleted mocks base method.
+func (m *MockNamespaceService) ListDeleted(arg0 context.Context, arg1 int64, arg2 types.Pagination) ([]*models.Namespace, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.Namespace)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDeleted indicates an expected call of ListDeleted.
+func (mr *MockNamespaceServiceMockRecorder) ListDeleted(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockNamespaceService)(nil).ListDeleted), arg0, arg1, arg2)
+}
+
 // ListNamespace mocks base method.
 func (m *MockNamespaceService) ListNamespace(arg0 context.Context, arg1 *string, arg2 types.Pagination, arg3 types.Sortable) ([]*models.Namespace, int64, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +208,20 @@ func (mr *MockNamespaceServiceMockRecorder) ListNamespaceWithAuth(arg0, arg1, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaceWithAuth", reflect.TypeOf((*MockNamespaceService)(nil).ListNamespaceWithAuth), arg0, arg1, arg2, arg3, arg4)
 }
 
+// Restore mocks base method.
+func (m *MockNamespaceService) Restore(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockNamespaceServiceMockRecorder) Restore(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockNamespaceService)(nil).Restore), arg0, arg1)
+}
+
 // UpdateByID mocks base method.
 func (m *MockNamespaceService) UpdateByID(arg0 context.Context, arg1 int64, arg2 map[string]any) error {
 	m.ctrl.T.Helper()
@@ -203,3 +249,18 @@ func (mr *MockNamespaceServiceMockRecorder) UpdateQuota(arg0, arg1, arg2 any) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuota", reflect.TypeOf((*MockNamespaceService)(nil).UpdateQuota), arg0, arg1, arg2)
 }
+
+// UpdateQuotaThreshold mocks base method.
+func (m *MockNamespaceService) UpdateQuotaThreshold(arg0 context.Context, arg1 int64, arg2 enums.QuotaKind, arg3, arg4 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQuotaThreshold", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateQuotaThreshold indicates an expected call of UpdateQuotaThreshold.
+func (mr *MockNamespaceServiceMockRecorder) UpdateQuotaThreshold(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuotaThreshold", reflect.TypeOf((*MockNamespaceService)(nil).UpdateQuotaThreshold), arg0, arg1, arg2, arg3, arg4)
+}
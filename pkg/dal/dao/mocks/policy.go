@@ -0,0 +1,377 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/go-sigma/sigma/pkg/dal/dao (interfaces: PolicyService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/policy.go -package=mocks github.com/go-sigma/sigma/pkg/dal/dao PolicyService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/go-sigma/sigma/pkg/dal/models"
+	types "github.com/go-sigma/sigma/pkg/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPolicyService is a mock of PolicyService interface.
+type MockPolicyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyServiceMockRecorder
+}
+
+// MockPolicyServiceMockRecorder is the mock recorder for MockPolicyService.
+type MockPolicyServiceMockRecorder struct {
+	mock *MockPolicyService
+}
+
+// NewMockPolicyService creates a new mock instance.
+func NewMockPolicyService(ctrl *gomock.Controller) *MockPolicyService {
+	mock := &MockPolicyService{ctrl: ctrl}
+	mock.recorder = &MockPolicyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicyService) EXPECT() *MockPolicyServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateLicensePolicy mocks base method.
+func (m *MockPolicyService) CreateLicensePolicy(arg0 context.Context, arg1 *models.LicensePolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLicensePolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateLicensePolicy indicates an expected call of CreateLicensePolicy.
+func (mr *MockPolicyServiceMockRecorder) CreateLicensePolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLicensePolicy", reflect.TypeOf((*MockPolicyService)(nil).CreateLicensePolicy), arg0, arg1)
+}
+
+// CreateSignaturePolicy mocks base method.
+func (m *MockPolicyService) CreateSignaturePolicy(arg0 context.Context, arg1 *models.SignaturePolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSignaturePolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSignaturePolicy indicates an expected call of CreateSignaturePolicy.
+func (mr *MockPolicyServiceMockRecorder) CreateSignaturePolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSignaturePolicy", reflect.TypeOf((*MockPolicyService)(nil).CreateSignaturePolicy), arg0, arg1)
+}
+
+// CreateSigningPolicy mocks base method.
+func (m *MockPolicyService) CreateSigningPolicy(arg0 context.Context, arg1 *models.SigningPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSigningPolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSigningPolicy indicates an expected call of CreateSigningPolicy.
+func (mr *MockPolicyServiceMockRecorder) CreateSigningPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSigningPolicy", reflect.TypeOf((*MockPolicyService)(nil).CreateSigningPolicy), arg0, arg1)
+}
+
+// CreateVulnerabilityAllowlist mocks base method.
+func (m *MockPolicyService) CreateVulnerabilityAllowlist(arg0 context.Context, arg1 *models.VulnerabilityAllowlist) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVulnerabilityAllowlist", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVulnerabilityAllowlist indicates an expected call of CreateVulnerabilityAllowlist.
+func (mr *MockPolicyServiceMockRecorder) CreateVulnerabilityAllowlist(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVulnerabilityAllowlist", reflect.TypeOf((*MockPolicyService)(nil).CreateVulnerabilityAllowlist), arg0, arg1)
+}
+
+// CreateVulnerabilityPolicy mocks base method.
+func (m *MockPolicyService) CreateVulnerabilityPolicy(arg0 context.Context, arg1 *models.VulnerabilityPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVulnerabilityPolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVulnerabilityPolicy indicates an expected call of CreateVulnerabilityPolicy.
+func (mr *MockPolicyServiceMockRecorder) CreateVulnerabilityPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVulnerabilityPolicy", reflect.TypeOf((*MockPolicyService)(nil).CreateVulnerabilityPolicy), arg0, arg1)
+}
+
+// CreateVulnerabilityRescanPolicy mocks base method.
+func (m *MockPolicyService) CreateVulnerabilityRescanPolicy(arg0 context.Context, arg1 *models.VulnerabilityRescanPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVulnerabilityRescanPolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVulnerabilityRescanPolicy indicates an expected call of CreateVulnerabilityRescanPolicy.
+func (mr *MockPolicyServiceMockRecorder) CreateVulnerabilityRescanPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVulnerabilityRescanPolicy", reflect.TypeOf((*MockPolicyService)(nil).CreateVulnerabilityRescanPolicy), arg0, arg1)
+}
+
+// DeleteVulnerabilityAllowlist mocks base method.
+func (m *MockPolicyService) DeleteVulnerabilityAllowlist(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVulnerabilityAllowlist", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVulnerabilityAllowlist indicates an expected call of DeleteVulnerabilityAllowlist.
+func (mr *MockPolicyServiceMockRecorder) DeleteVulnerabilityAllowlist(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVulnerabilityAllowlist", reflect.TypeOf((*MockPolicyService)(nil).DeleteVulnerabilityAllowlist), arg0, arg1)
+}
+
+// GetLicensePolicy mocks base method.
+func (m *MockPolicyService) GetLicensePolicy(arg0 context.Context, arg1 int64) (*models.LicensePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLicensePolicy", arg0, arg1)
+	ret0, _ := ret[0].(*models.LicensePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLicensePolicy indicates an expected call of GetLicensePolicy.
+func (mr *MockPolicyServiceMockRecorder) GetLicensePolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLicensePolicy", reflect.TypeOf((*MockPolicyService)(nil).GetLicensePolicy), arg0, arg1)
+}
+
+// GetSignaturePolicy mocks base method.
+func (m *MockPolicyService) GetSignaturePolicy(arg0 context.Context, arg1 int64) (*models.SignaturePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSignaturePolicy", arg0, arg1)
+	ret0, _ := ret[0].(*models.SignaturePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSignaturePolicy indicates an expected call of GetSignaturePolicy.
+func (mr *MockPolicyServiceMockRecorder) GetSignaturePolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignaturePolicy", reflect.TypeOf((*MockPolicyService)(nil).GetSignaturePolicy), arg0, arg1)
+}
+
+// GetSigningPolicy mocks base method.
+func (m *MockPolicyService) GetSigningPolicy(arg0 context.Context, arg1 int64) (*models.SigningPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSigningPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*models.SigningPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSigningPolicy indicates an expected call of GetSigningPolicy.
+func (mr *MockPolicyServiceMockRecorder) GetSigningPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSigningPolicy", reflect.TypeOf((*MockPolicyService)(nil).GetSigningPolicy), arg0, arg1)
+}
+
+// GetVulnerabilityAllowlist mocks base method.
+func (m *MockPolicyService) GetVulnerabilityAllowlist(arg0 context.Context, arg1 int64) (*models.VulnerabilityAllowlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVulnerabilityAllowlist", arg0, arg1)
+	ret0, _ := ret[0].(*models.VulnerabilityAllowlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVulnerabilityAllowlist indicates an expected call of GetVulnerabilityAllowlist.
+func (mr *MockPolicyServiceMockRecorder) GetVulnerabilityAllowlist(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVulnerabilityAllowlist", reflect.TypeOf((*MockPolicyService)(nil).GetVulnerabilityAllowlist), arg0, arg1)
+}
+
+// GetVulnerabilityPolicy mocks base method.
+func (m *MockPolicyService) GetVulnerabilityPolicy(arg0 context.Context, arg1 int64) (*models.VulnerabilityPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVulnerabilityPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*models.VulnerabilityPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVulnerabilityPolicy indicates an expected call of GetVulnerabilityPolicy.
+func (mr *MockPolicyServiceMockRecorder) GetVulnerabilityPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVulnerabilityPolicy", reflect.TypeOf((*MockPolicyService)(nil).GetVulnerabilityPolicy), arg0, arg1)
+}
+
+// GetVulnerabilityRescanPolicy mocks base method.
+func (m *MockPolicyService) GetVulnerabilityRescanPolicy(arg0 context.Context, arg1 *int64) (*models.VulnerabilityRescanPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVulnerabilityRescanPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*models.VulnerabilityRescanPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVulnerabilityRescanPolicy indicates an expected call of GetVulnerabilityRescanPolicy.
+func (mr *MockPolicyServiceMockRecorder) GetVulnerabilityRescanPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVulnerabilityRescanPolicy", reflect.TypeOf((*MockPolicyService)(nil).GetVulnerabilityRescanPolicy), arg0, arg1)
+}
+
+// ListActiveVulnerabilityAllowlist mocks base method.
+func (m *MockPolicyService) ListActiveVulnerabilityAllowlist(arg0 context.Context, arg1, arg2 int64) ([]*models.VulnerabilityAllowlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveVulnerabilityAllowlist", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.VulnerabilityAllowlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveVulnerabilityAllowlist indicates an expected call of ListActiveVulnerabilityAllowlist.
+func (mr *MockPolicyServiceMockRecorder) ListActiveVulnerabilityAllowlist(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveVulnerabilityAllowlist", reflect.TypeOf((*MockPolicyService)(nil).ListActiveVulnerabilityAllowlist), arg0, arg1, arg2)
+}
+
+// ListEnabledVulnerabilityRescanPolicy mocks base method.
+func (m *MockPolicyService) ListEnabledVulnerabilityRescanPolicy(arg0 context.Context) ([]*models.VulnerabilityRescanPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnabledVulnerabilityRescanPolicy", arg0)
+	ret0, _ := ret[0].([]*models.VulnerabilityRescanPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnabledVulnerabilityRescanPolicy indicates an expected call of ListEnabledVulnerabilityRescanPolicy.
+func (mr *MockPolicyServiceMockRecorder) ListEnabledVulnerabilityRescanPolicy(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnabledVulnerabilityRescanPolicy", reflect.TypeOf((*MockPolicyService)(nil).ListEnabledVulnerabilityRescanPolicy), arg0)
+}
+
+// ListExpiredVulnerabilityAllowlist mocks base method.
+func (m *MockPolicyService) ListExpiredVulnerabilityAllowlist(arg0 context.Context, arg1 time.Time, arg2 int) ([]*models.VulnerabilityAllowlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiredVulnerabilityAllowlist", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.VulnerabilityAllowlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiredVulnerabilityAllowlist indicates an expected call of ListExpiredVulnerabilityAllowlist.
+func (mr *MockPolicyServiceMockRecorder) ListExpiredVulnerabilityAllowlist(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredVulnerabilityAllowlist", reflect.TypeOf((*MockPolicyService)(nil).ListExpiredVulnerabilityAllowlist), arg0, arg1, arg2)
+}
+
+// ListVulnerabilityAllowlist mocks base method.
+func (m *MockPolicyService) ListVulnerabilityAllowlist(arg0 context.Context, arg1, arg2 *int64, arg3 types.Pagination, arg4 types.Sortable) ([]*models.VulnerabilityAllowlist, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVulnerabilityAllowlist", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*models.VulnerabilityAllowlist)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListVulnerabilityAllowlist indicates an expected call of ListVulnerabilityAllowlist.
+func (mr *MockPolicyServiceMockRecorder) ListVulnerabilityAllowlist(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVulnerabilityAllowlist", reflect.TypeOf((*MockPolicyService)(nil).ListVulnerabilityAllowlist), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ListVulnerabilityRescanPolicyByNextTrigger mocks base method.
+func (m *MockPolicyService) ListVulnerabilityRescanPolicyByNextTrigger(arg0 context.Context, arg1 time.Time, arg2 int) ([]*models.VulnerabilityRescanPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVulnerabilityRescanPolicyByNextTrigger", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.VulnerabilityRescanPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVulnerabilityRescanPolicyByNextTrigger indicates an expected call of ListVulnerabilityRescanPolicyByNextTrigger.
+func (mr *MockPolicyServiceMockRecorder) ListVulnerabilityRescanPolicyByNextTrigger(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVulnerabilityRescanPolicyByNextTrigger", reflect.TypeOf((*MockPolicyService)(nil).ListVulnerabilityRescanPolicyByNextTrigger), arg0, arg1, arg2)
+}
+
+// UpdateLicensePolicy mocks base method.
+func (m *MockPolicyService) UpdateLicensePolicy(arg0 context.Context, arg1 int64, arg2 map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLicensePolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLicensePolicy indicates an expected call of UpdateLicensePolicy.
+func (mr *MockPolicyServiceMockRecorder) UpdateLicensePolicy(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLicensePolicy", reflect.TypeOf((*MockPolicyService)(nil).UpdateLicensePolicy), arg0, arg1, arg2)
+}
+
+// UpdateSignaturePolicy mocks base method.
+func (m *MockPolicyService) UpdateSignaturePolicy(arg0 context.Context, arg1 int64, arg2 map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSignaturePolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSignaturePolicy indicates an expected call of UpdateSignaturePolicy.
+func (mr *MockPolicyServiceMockRecorder) UpdateSignaturePolicy(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSignaturePolicy", reflect.TypeOf((*MockPolicyService)(nil).UpdateSignaturePolicy), arg0, arg1, arg2)
+}
+
+// UpdateSigningPolicy mocks base method.
+func (m *MockPolicyService) UpdateSigningPolicy(arg0 context.Context, arg1 int64, arg2 map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSigningPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSigningPolicy indicates an expected call of UpdateSigningPolicy.
+func (mr *MockPolicyServiceMockRecorder) UpdateSigningPolicy(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSigningPolicy", reflect.TypeOf((*MockPolicyService)(nil).UpdateSigningPolicy), arg0, arg1, arg2)
+}
+
+// UpdateVulnerabilityPolicy mocks base method.
+func (m *MockPolicyService) UpdateVulnerabilityPolicy(arg0 context.Context, arg1 int64, arg2 map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVulnerabilityPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVulnerabilityPolicy indicates an expected call of UpdateVulnerabilityPolicy.
+func (mr *MockPolicyServiceMockRecorder) UpdateVulnerabilityPolicy(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVulnerabilityPolicy", reflect.TypeOf((*MockPolicyService)(nil).UpdateVulnerabilityPolicy), arg0, arg1, arg2)
+}
+
+// UpdateVulnerabilityRescanPolicy mocks base method.
+func (m *MockPolicyService) UpdateVulnerabilityRescanPolicy(arg0 context.Context, arg1 int64, arg2 map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVulnerabilityRescanPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVulnerabilityRescanPolicy indicates an expected call of UpdateVulnerabilityRescanPolicy.
+func (mr *MockPolicyServiceMockRecorder) UpdateVulnerabilityRescanPolicy(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVulnerabilityRescanPolicy", reflect.TypeOf((*MockPolicyService)(nil).UpdateVulnerabilityRescanPolicy), arg0, arg1, arg2)
+}
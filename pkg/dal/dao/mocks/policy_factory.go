@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/go-sigma/sigma/pkg/dal/dao (interfaces: PolicyServiceFactory)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/policy_factory.go -package=mocks github.com/go-sigma/sigma/pkg/dal/dao PolicyServiceFactory
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	dao "github.com/go-sigma/sigma/pkg/dal/dao"
+	query "github.com/go-sigma/sigma/pkg/dal/query"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPolicyServiceFactory is a mock of PolicyServiceFactory interface.
+type MockPolicyServiceFactory struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyServiceFactoryMockRecorder
+}
+
+// MockPolicyServiceFactoryMockRecorder is the mock recorder for MockPolicyServiceFactory.
+type MockPolicyServiceFactoryMockRecorder struct {
+	mock *MockPolicyServiceFactory
+}
+
+// NewMockPolicyServiceFactory creates a new mock instance.
+func NewMockPolicyServiceFactory(ctrl *gomock.Controller) *MockPolicyServiceFactory {
+	mock := &MockPolicyServiceFactory{ctrl: ctrl}
+	mock.recorder = &MockPolicyServiceFactoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicyServiceFactory) EXPECT() *MockPolicyServiceFactoryMockRecorder {
+	return m.recorder
+}
+
+// New mocks base method.
+func (m *MockPolicyServiceFactory) New(arg0 ...*query.Query) dao.PolicyService {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "New", varargs...)
+	ret0, _ := ret[0].(dao.PolicyService)
+	return ret0
+}
+
+// New indicates an expected call of New.
+func (mr *MockPolicyServiceFactoryMockRecorder) New(arg0 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "New", reflect.TypeOf((*MockPolicyServiceFactory)(nil).New), arg0...)
+}
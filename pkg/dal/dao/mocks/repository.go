@@ -160,6 +160,21 @@ func (mr *MockRepositoryServiceMockRecorder) GetByName(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockRepositoryService)(nil).GetByName), arg0, arg1)
 }
 
+// GetDeleted mocks base method.
+func (m *MockRepositoryService) GetDeleted(arg0 context.Context, arg1, arg2 int64) (*models.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeleted", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeleted indicates an expected call of GetDeleted.
+func (mr *MockRepositoryServiceMockRecorder) GetDeleted(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeleted", reflect.TypeOf((*MockRepositoryService)(nil).GetDeleted), arg0, arg1, arg2)
+}
+
 // ListByDtPagination mocks base method.
 func (m *MockRepositoryService) ListByDtPagination(arg0 context.Context, arg1 int, arg2 ...int64) ([]*models.Repository, error) {
 	m.ctrl.T.Helper()
@@ -180,6 +195,22 @@ func (mr *MockRepositoryServiceMockRecorder) ListByDtPagination(arg0, arg1 any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByDtPagination", reflect.TypeOf((*MockRepositoryService)(nil).ListByDtPagination), varargs...)
 }
 
+// ListDeleted mocks base method.
+func (m *MockRepositoryService) ListDeleted(arg0 context.Context, arg1, arg2 int64, arg3 types.Pagination) ([]*models.Repository, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.Repository)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDeleted indicates an expected call of ListDeleted.
+func (mr *MockRepositoryServiceMockRecorder) ListDeleted(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockRepositoryService)(nil).ListDeleted), arg0, arg1, arg2, arg3)
+}
+
 // ListRepository mocks base method.
 func (m *MockRepositoryService) ListRepository(arg0 context.Context, arg1 int64, arg2 *string, arg3 types.Pagination, arg4 types.Sortable) ([]*models.Repository, int64, error) {
 	m.ctrl.T.Helper()
@@ -227,6 +258,20 @@ func (mr *MockRepositoryServiceMockRecorder) ListWithScrollable(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithScrollable", reflect.TypeOf((*MockRepositoryService)(nil).ListWithScrollable), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// Restore mocks base method.
+func (m *MockRepositoryService) Restore(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockRepositoryServiceMockRecorder) Restore(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockRepositoryService)(nil).Restore), arg0, arg1)
+}
+
 // UpdateRepository mocks base method.
 func (m *MockRepositoryService) UpdateRepository(arg0 context.Context, arg1 int64, arg2 map[string]any) error {
 	m.ctrl.T.Helper()
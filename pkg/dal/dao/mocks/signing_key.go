@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/go-sigma/sigma/pkg/dal/dao (interfaces: SigningKeyService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/signing_key.go -package=mocks github.com/go-sigma/sigma/pkg/dal/dao SigningKeyService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-sigma/sigma/pkg/dal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSigningKeyService is a mock of SigningKeyService interface.
+type MockSigningKeyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSigningKeyServiceMockRecorder
+}
+
+// MockSigningKeyServiceMockRecorder is the mock recorder for MockSigningKeyService.
+type MockSigningKeyServiceMockRecorder struct {
+	mock *MockSigningKeyService
+}
+
+// NewMockSigningKeyService creates a new mock instance.
+func NewMockSigningKeyService(ctrl *gomock.Controller) *MockSigningKeyService {
+	mock := &MockSigningKeyService{ctrl: ctrl}
+	mock.recorder = &MockSigningKeyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSigningKeyService) EXPECT() *MockSigningKeyServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSigningKeyService) Create(arg0 context.Context, arg1 *models.SigningKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSigningKeyServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSigningKeyService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockSigningKeyService) Delete(arg0 context.Context, arg1, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSigningKeyServiceMockRecorder) Delete(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSigningKeyService)(nil).Delete), arg0, arg1, arg2)
+}
+
+// Get mocks base method.
+func (m *MockSigningKeyService) Get(arg0 context.Context, arg1, arg2 int64) (*models.SigningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.SigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSigningKeyServiceMockRecorder) Get(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSigningKeyService)(nil).Get), arg0, arg1, arg2)
+}
+
+// GetActive mocks base method.
+func (m *MockSigningKeyService) GetActive(arg0 context.Context, arg1 int64) (*models.SigningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActive", arg0, arg1)
+	ret0, _ := ret[0].(*models.SigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActive indicates an expected call of GetActive.
+func (mr *MockSigningKeyServiceMockRecorder) GetActive(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActive", reflect.TypeOf((*MockSigningKeyService)(nil).GetActive), arg0, arg1)
+}
+
+// ListByNamespace mocks base method.
+func (m *MockSigningKeyService) ListByNamespace(arg0 context.Context, arg1 int64) ([]*models.SigningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByNamespace", arg0, arg1)
+	ret0, _ := ret[0].([]*models.SigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByNamespace indicates an expected call of ListByNamespace.
+func (mr *MockSigningKeyServiceMockRecorder) ListByNamespace(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByNamespace", reflect.TypeOf((*MockSigningKeyService)(nil).ListByNamespace), arg0, arg1)
+}
+
+// Retire mocks base method.
+func (m *MockSigningKeyService) Retire(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retire", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Retire indicates an expected call of Retire.
+func (mr *MockSigningKeyServiceMockRecorder) Retire(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retire", reflect.TypeOf((*MockSigningKeyService)(nil).Retire), arg0, arg1)
+}
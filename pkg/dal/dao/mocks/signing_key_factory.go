@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/go-sigma/sigma/pkg/dal/dao (interfaces: SigningKeyServiceFactory)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/signing_key_factory.go -package=mocks github.com/go-sigma/sigma/pkg/dal/dao SigningKeyServiceFactory
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	dao "github.com/go-sigma/sigma/pkg/dal/dao"
+	query "github.com/go-sigma/sigma/pkg/dal/query"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSigningKeyServiceFactory is a mock of SigningKeyServiceFactory interface.
+type MockSigningKeyServiceFactory struct {
+	ctrl     *gomock.Controller
+	recorder *MockSigningKeyServiceFactoryMockRecorder
+}
+
+// MockSigningKeyServiceFactoryMockRecorder is the mock recorder for MockSigningKeyServiceFactory.
+type MockSigningKeyServiceFactoryMockRecorder struct {
+	mock *MockSigningKeyServiceFactory
+}
+
+// NewMockSigningKeyServiceFactory creates a new mock instance.
+func NewMockSigningKeyServiceFactory(ctrl *gomock.Controller) *MockSigningKeyServiceFactory {
+	mock := &MockSigningKeyServiceFactory{ctrl: ctrl}
+	mock.recorder = &MockSigningKeyServiceFactoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSigningKeyServiceFactory) EXPECT() *MockSigningKeyServiceFactoryMockRecorder {
+	return m.recorder
+}
+
+// New mocks base method.
+func (m *MockSigningKeyServiceFactory) New(arg0 ...*query.Query) dao.SigningKeyService {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "New", varargs...)
+	ret0, _ := ret[0].(dao.SigningKeyService)
+	return ret0
+}
+
+// New indicates an expected call of New.
+func (mr *MockSigningKeyServiceFactoryMockRecorder) New(arg0 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "New", reflect.TypeOf((*MockSigningKeyServiceFactory)(nil).New), arg0...)
+}
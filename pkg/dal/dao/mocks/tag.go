@@ -164,6 +164,21 @@ func (mr *MockTagServiceMockRecorder) DeleteByName(arg0, arg1, arg2 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByName", reflect.TypeOf((*MockTagService)(nil).DeleteByName), arg0, arg1, arg2)
 }
 
+// FindByArtifactID mocks base method.
+func (m *MockTagService) FindByArtifactID(arg0 context.Context, arg1 int64) ([]*models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByArtifactID", arg0, arg1)
+	ret0, _ := ret[0].([]*models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByArtifactID indicates an expected call of FindByArtifactID.
+func (mr *MockTagServiceMockRecorder) FindByArtifactID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByArtifactID", reflect.TypeOf((*MockTagService)(nil).FindByArtifactID), arg0, arg1)
+}
+
 // FindWithDayCursor mocks base method.
 func (m *MockTagService) FindWithDayCursor(arg0 context.Context, arg1 int64, arg2, arg3 int, arg4 int64) ([]*models.Tag, error) {
 	m.ctrl.T.Helper()
@@ -239,6 +254,21 @@ func (mr *MockTagServiceMockRecorder) GetByName(arg0, arg1, arg2 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockTagService)(nil).GetByName), arg0, arg1, arg2)
 }
 
+// GetDeleted mocks base method.
+func (m *MockTagService) GetDeleted(arg0 context.Context, arg1, arg2 int64) (*models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeleted", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeleted indicates an expected call of GetDeleted.
+func (mr *MockTagServiceMockRecorder) GetDeleted(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeleted", reflect.TypeOf((*MockTagService)(nil).GetDeleted), arg0, arg1, arg2)
+}
+
 // Incr mocks base method.
 func (m *MockTagService) Incr(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
@@ -273,6 +303,22 @@ func (mr *MockTagServiceMockRecorder) ListByDtPagination(arg0, arg1, arg2 any, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByDtPagination", reflect.TypeOf((*MockTagService)(nil).ListByDtPagination), varargs...)
 }
 
+// ListDeleted mocks base method.
+func (m *MockTagService) ListDeleted(arg0 context.Context, arg1, arg2 int64, arg3 types.Pagination) ([]*models.Tag, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.Tag)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDeleted indicates an expected call of ListDeleted.
+func (mr *MockTagServiceMockRecorder) ListDeleted(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockTagService)(nil).ListDeleted), arg0, arg1, arg2, arg3)
+}
+
 // ListTag mocks base method.
 func (m *MockTagService) ListTag(arg0 context.Context, arg1 int64, arg2 *string, arg3 []enums.ArtifactType, arg4 types.Pagination, arg5 types.Sortable) ([]*models.Tag, int64, error) {
 	m.ctrl.T.Helper()
@@ -288,3 +334,17 @@ func (mr *MockTagServiceMockRecorder) ListTag(arg0, arg1, arg2, arg3, arg4, arg5
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTag", reflect.TypeOf((*MockTagService)(nil).ListTag), arg0, arg1, arg2, arg3, arg4, arg5)
 }
+
+// Restore mocks base method.
+func (m *MockTagService) Restore(arg0 context.Context, arg1, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockTagServiceMockRecorder) Restore(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTagService)(nil).Restore), arg0, arg1, arg2)
+}
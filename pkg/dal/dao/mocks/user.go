@@ -113,6 +113,20 @@ func (mr *MockUserServiceMockRecorder) CreateUser3rdParty(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser3rdParty", reflect.TypeOf((*MockUserService)(nil).CreateUser3rdParty), arg0, arg1)
 }
 
+// DecreaseNamespaceCount mocks base method.
+func (m *MockUserService) DecreaseNamespaceCount(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecreaseNamespaceCount", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DecreaseNamespaceCount indicates an expected call of DecreaseNamespaceCount.
+func (mr *MockUserServiceMockRecorder) DecreaseNamespaceCount(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecreaseNamespaceCount", reflect.TypeOf((*MockUserService)(nil).DecreaseNamespaceCount), arg0, arg1)
+}
+
 // DeletePlatformMember mocks base method.
 func (m *MockUserService) DeletePlatformMember(arg0 context.Context, arg1 int64, arg2 enums.UserRole) error {
 	m.ctrl.T.Helper()
@@ -246,6 +260,20 @@ func (mr *MockUserServiceMockRecorder) GetUser3rdPartyByProvider(arg0, arg1, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser3rdPartyByProvider", reflect.TypeOf((*MockUserService)(nil).GetUser3rdPartyByProvider), arg0, arg1, arg2)
 }
 
+// IncreaseNamespaceCount mocks base method.
+func (m *MockUserService) IncreaseNamespaceCount(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncreaseNamespaceCount", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncreaseNamespaceCount indicates an expected call of IncreaseNamespaceCount.
+func (mr *MockUserServiceMockRecorder) IncreaseNamespaceCount(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseNamespaceCount", reflect.TypeOf((*MockUserService)(nil).IncreaseNamespaceCount), arg0, arg1)
+}
+
 // List mocks base method.
 func (m *MockUserService) List(arg0 context.Context, arg1 *string, arg2 types.Pagination, arg3 types.Sortable) ([]*models.User, int64, error) {
 	m.ctrl.T.Helper()
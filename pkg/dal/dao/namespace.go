@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 
+	"gorm.io/gen/field"
 	"gorm.io/gorm"
 
 	"github.com/go-sigma/sigma/pkg/dal/models"
@@ -55,6 +56,15 @@ type NamespaceService interface {
 	DeleteByID(ctx context.Context, id int64) error
 	// UpdateByID updates the namespace with the specified namespace ID.
 	UpdateByID(ctx context.Context, id int64, updates map[string]interface{}) error
+	// UpdateQuotaThreshold updates the recorded quota threshold of the kind only if it is still the from threshold,
+	// it returns false if the threshold is changed by others.
+	UpdateQuotaThreshold(ctx context.Context, id int64, kind enums.QuotaKind, from, to int) (bool, error)
+	// ListDeleted lists the namespaces deleted after the specified time.
+	ListDeleted(ctx context.Context, after int64, pagination types.Pagination) ([]*models.Namespace, int64, error)
+	// GetDeleted gets the namespace with the specified namespace ID which is deleted after the specified time.
+	GetDeleted(ctx context.Context, id int64, after int64) (*models.Namespace, error)
+	// Restore restores the deleted namespace with the specified namespace ID.
+	Restore(ctx context.Context, id int64) error
 }
 
 type namespaceService struct {
@@ -206,3 +216,53 @@ func (s *namespaceService) UpdateByID(ctx context.Context, id int64, updates map
 	_, err := s.tx.Namespace.WithContext(ctx).Where(s.tx.Namespace.ID.Eq(id)).Updates(updates)
 	return err
 }
+
+// UpdateQuotaThreshold updates the recorded quota threshold of the kind only if it is still the from threshold,
+// it returns false if the threshold is changed by others.
+func (s *namespaceService) UpdateQuotaThreshold(ctx context.Context, id int64, kind enums.QuotaKind, from, to int) (bool, error) {
+	var column field.Int
+	switch kind {
+	case enums.QuotaKindSize:
+		column = s.tx.Namespace.SizeQuotaThreshold
+	case enums.QuotaKindTag:
+		column = s.tx.Namespace.TagQuotaThreshold
+	case enums.QuotaKindRepository:
+		column = s.tx.Namespace.RepositoryQuotaThreshold
+	default:
+		return false, fmt.Errorf("unknown quota kind: %s", kind)
+	}
+	result, err := s.tx.Namespace.WithContext(ctx).Where(s.tx.Namespace.ID.Eq(id), column.Eq(from)).UpdateSimple(column.Value(to))
+	if err != nil {
+		return false, err
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ListDeleted lists the namespaces deleted after the specified time.
+func (s *namespaceService) ListDeleted(ctx context.Context, after int64, pagination types.Pagination) ([]*models.Namespace, int64, error) {
+	pagination = utils.NormalizePagination(pagination)
+	return s.tx.Namespace.WithContext(ctx).Unscoped().
+		Where(s.tx.Namespace.DeletedAt.Gt(uint64(after))).
+		Order(s.tx.Namespace.DeletedAt.Desc()).
+		FindByPage(ptr.To(pagination.Limit)*(ptr.To(pagination.Page)-1), ptr.To(pagination.Limit))
+}
+
+// GetDeleted gets the namespace with the specified namespace ID which is deleted after the specified time.
+func (s *namespaceService) GetDeleted(ctx context.Context, id int64, after int64) (*models.Namespace, error) {
+	return s.tx.Namespace.WithContext(ctx).Unscoped().
+		Where(s.tx.Namespace.ID.Eq(id), s.tx.Namespace.DeletedAt.Gt(uint64(after))).First()
+}
+
+// Restore restores the deleted namespace with the specified namespace ID.
+func (s *namespaceService) Restore(ctx context.Context, id int64) error {
+	matched, err := s.tx.Namespace.WithContext(ctx).Unscoped().
+		Where(s.tx.Namespace.ID.Eq(id), s.tx.Namespace.DeletedAt.Neq(0)).
+		UpdateColumn(s.tx.Namespace.DeletedAt, 0)
+	if err != nil {
+		return err
+	}
+	if matched.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -17,6 +17,7 @@ package dao_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
@@ -135,3 +136,50 @@ func TestNamespaceServiceQuota(t *testing.T) {
 
 	assert.ErrorIs(t, namespaceService.UpdateQuota(ctx, 10, 100), gorm.ErrRecordNotFound)
 }
+
+func TestNamespaceServiceRecycleBin(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	namespaceService := dao.NewNamespaceServiceFactory().New()
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, namespaceService.Create(ctx, namespaceObj))
+
+	after := time.Now().Add(-time.Hour).UnixMilli()
+
+	_, err := namespaceService.GetDeleted(ctx, namespaceObj.ID, after)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.ErrorIs(t, namespaceService.Restore(ctx, namespaceObj.ID), gorm.ErrRecordNotFound)
+
+	assert.NoError(t, namespaceService.DeleteByID(ctx, namespaceObj.ID))
+
+	namespaceObjs, total, err := namespaceService.ListDeleted(ctx, after, types.Pagination{Limit: ptr.Of(10), Page: ptr.Of(1)})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, namespaceObj.ID, namespaceObjs[0].ID)
+
+	// the namespace deleted before the retention is not in the recycle bin
+	_, total, err = namespaceService.ListDeleted(ctx, time.Now().Add(time.Hour).UnixMilli(), types.Pagination{Limit: ptr.Of(10), Page: ptr.Of(1)})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), total)
+	_, err = namespaceService.GetDeleted(ctx, namespaceObj.ID, time.Now().Add(time.Hour).UnixMilli())
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	deletedObj, err := namespaceService.GetDeleted(ctx, namespaceObj.ID, after)
+	assert.NoError(t, err)
+	assert.Equal(t, namespaceObj.Name, deletedObj.Name)
+
+	assert.NoError(t, namespaceService.Restore(ctx, namespaceObj.ID))
+	restoredObj, err := namespaceService.Get(ctx, namespaceObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, namespaceObj.Name, restoredObj.Name)
+}
@@ -0,0 +1,335 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dao
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+//go:generate mockgen -destination=mocks/policy.go -package=mocks github.com/go-sigma/sigma/pkg/dal/dao PolicyService
+//go:generate mockgen -destination=mocks/policy_factory.go -package=mocks github.com/go-sigma/sigma/pkg/dal/dao PolicyServiceFactory
+
+// PolicyService is the interface that provides methods to operate on policy model
+type PolicyService interface {
+	// GetVulnerabilityPolicy gets the vulnerability policy of the namespace.
+	GetVulnerabilityPolicy(ctx context.Context, namespaceID int64) (*models.VulnerabilityPolicy, error)
+	// CreateVulnerabilityPolicy creates a new vulnerability policy.
+	CreateVulnerabilityPolicy(ctx context.Context, policyObj *models.VulnerabilityPolicy) error
+	// UpdateVulnerabilityPolicy updates the vulnerability policy.
+	UpdateVulnerabilityPolicy(ctx context.Context, policyID int64, updates map[string]any) error
+	// GetLicensePolicy gets the license policy of the namespace.
+	GetLicensePolicy(ctx context.Context, namespaceID int64) (*models.LicensePolicy, error)
+	// CreateLicensePolicy creates a new license policy.
+	CreateLicensePolicy(ctx context.Context, policyObj *models.LicensePolicy) error
+	// UpdateLicensePolicy updates the license policy.
+	UpdateLicensePolicy(ctx context.Context, policyID int64, updates map[string]any) error
+	// GetSignaturePolicy gets the signature policy of the namespace.
+	GetSignaturePolicy(ctx context.Context, namespaceID int64) (*models.SignaturePolicy, error)
+	// CreateSignaturePolicy creates a new signature policy.
+	CreateSignaturePolicy(ctx context.Context, policyObj *models.SignaturePolicy) error
+	// UpdateSignaturePolicy updates the signature policy.
+	UpdateSignaturePolicy(ctx context.Context, policyID int64, updates map[string]any) error
+	// GetSigningPolicy gets the sign-on-push policy of the namespace.
+	GetSigningPolicy(ctx context.Context, namespaceID int64) (*models.SigningPolicy, error)
+	// CreateSigningPolicy creates a new sign-on-push policy.
+	CreateSigningPolicy(ctx context.Context, policyObj *models.SigningPolicy) error
+	// UpdateSigningPolicy updates the sign-on-push policy.
+	UpdateSigningPolicy(ctx context.Context, policyID int64, updates map[string]any) error
+	// ListVulnerabilityAllowlist lists the vulnerability allowlist entries of the scope,
+	// the system scope entries will be listed if both namespaceID and repositoryID are nil.
+	ListVulnerabilityAllowlist(ctx context.Context, namespaceID, repositoryID *int64, pagination types.Pagination, sort types.Sortable) ([]*models.VulnerabilityAllowlist, int64, error)
+	// ListActiveVulnerabilityAllowlist lists the unexpired vulnerability allowlist entries that apply to the repository,
+	// includes the system, namespace and repository scope entries.
+	ListActiveVulnerabilityAllowlist(ctx context.Context, namespaceID, repositoryID int64) ([]*models.VulnerabilityAllowlist, error)
+	// ListExpiredVulnerabilityAllowlist lists the vulnerability allowlist entries expired before the specified time.
+	ListExpiredVulnerabilityAllowlist(ctx context.Context, before time.Time, limit int) ([]*models.VulnerabilityAllowlist, error)
+	// GetVulnerabilityAllowlist gets the vulnerability allowlist entry with the specified id.
+	GetVulnerabilityAllowlist(ctx context.Context, id int64) (*models.VulnerabilityAllowlist, error)
+	// CreateVulnerabilityAllowlist creates a new vulnerability allowlist entry.
+	CreateVulnerabilityAllowlist(ctx context.Context, allowlistObj *models.VulnerabilityAllowlist) error
+	// DeleteVulnerabilityAllowlist deletes the vulnerability allowlist entry with the specified id.
+	DeleteVulnerabilityAllowlist(ctx context.Context, id int64) error
+	// GetVulnerabilityRescanPolicy gets the vulnerability rescan policy of the namespace,
+	// the system-wide policy will be returned if namespaceID is nil.
+	GetVulnerabilityRescanPolicy(ctx context.Context, namespaceID *int64) (*models.VulnerabilityRescanPolicy, error)
+	// CreateVulnerabilityRescanPolicy creates a new vulnerability rescan policy.
+	CreateVulnerabilityRescanPolicy(ctx context.Context, policyObj *models.VulnerabilityRescanPolicy) error
+	// UpdateVulnerabilityRescanPolicy updates the vulnerability rescan policy.
+	UpdateVulnerabilityRescanPolicy(ctx context.Context, policyID int64, updates map[string]any) error
+	// ListVulnerabilityRescanPolicyByNextTrigger lists the enabled vulnerability rescan policies that should be triggered.
+	ListVulnerabilityRescanPolicyByNextTrigger(ctx context.Context, now time.Time, limit int) ([]*models.VulnerabilityRescanPolicy, error)
+	// ListEnabledVulnerabilityRescanPolicy lists all of the enabled vulnerability rescan policies.
+	ListEnabledVulnerabilityRescanPolicy(ctx context.Context) ([]*models.VulnerabilityRescanPolicy, error)
+}
+
+type policyService struct {
+	tx *query.Query
+}
+
+// PolicyServiceFactory is the interface that provides the policy service factory methods.
+type PolicyServiceFactory interface {
+	New(txs ...*query.Query) PolicyService
+}
+
+type policyServiceFactory struct{}
+
+// NewPolicyServiceFactory creates a new policy service factory.
+func NewPolicyServiceFactory() PolicyServiceFactory {
+	return &policyServiceFactory{}
+}
+
+// New creates a new policy service.
+func (s *policyServiceFactory) New(txs ...*query.Query) PolicyService {
+	tx := query.Q
+	if len(txs) > 0 {
+		tx = txs[0]
+	}
+	return &policyService{
+		tx: tx,
+	}
+}
+
+// GetVulnerabilityPolicy gets the vulnerability policy of the namespace.
+func (s *policyService) GetVulnerabilityPolicy(ctx context.Context, namespaceID int64) (*models.VulnerabilityPolicy, error) {
+	return s.tx.VulnerabilityPolicy.WithContext(ctx).Where(s.tx.VulnerabilityPolicy.NamespaceID.Eq(namespaceID)).First()
+}
+
+// CreateVulnerabilityPolicy creates a new vulnerability policy.
+func (s *policyService) CreateVulnerabilityPolicy(ctx context.Context, policyObj *models.VulnerabilityPolicy) error {
+	return s.tx.VulnerabilityPolicy.WithContext(ctx).Create(policyObj)
+}
+
+// UpdateVulnerabilityPolicy updates the vulnerability policy.
+func (s *policyService) UpdateVulnerabilityPolicy(ctx context.Context, policyID int64, updates map[string]any) error {
+	if len(updates) == 0 {
+		return nil
+	}
+	matched, err := s.tx.VulnerabilityPolicy.WithContext(ctx).Where(s.tx.VulnerabilityPolicy.ID.Eq(policyID)).Updates(updates)
+	if err != nil {
+		return err
+	}
+	if matched.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetLicensePolicy gets the license policy of the namespace.
+func (s *policyService) GetLicensePolicy(ctx context.Context, namespaceID int64) (*models.LicensePolicy, error) {
+	return s.tx.LicensePolicy.WithContext(ctx).Where(s.tx.LicensePolicy.NamespaceID.Eq(namespaceID)).First()
+}
+
+// CreateLicensePolicy creates a new license policy.
+func (s *policyService) CreateLicensePolicy(ctx context.Context, policyObj *models.LicensePolicy) error {
+	return s.tx.LicensePolicy.WithContext(ctx).Create(policyObj)
+}
+
+// UpdateLicensePolicy updates the license policy.
+func (s *policyService) UpdateLicensePolicy(ctx context.Context, policyID int64, updates map[string]any) error {
+	if len(updates) == 0 {
+		return nil
+	}
+	matched, err := s.tx.LicensePolicy.WithContext(ctx).Where(s.tx.LicensePolicy.ID.Eq(policyID)).Updates(updates)
+	if err != nil {
+		return err
+	}
+	if matched.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetSignaturePolicy gets the signature policy of the namespace.
+func (s *policyService) GetSignaturePolicy(ctx context.Context, namespaceID int64) (*models.SignaturePolicy, error) {
+	return s.tx.SignaturePolicy.WithContext(ctx).Where(s.tx.SignaturePolicy.NamespaceID.Eq(namespaceID)).First()
+}
+
+// CreateSignaturePolicy creates a new signature policy.
+func (s *policyService) CreateSignaturePolicy(ctx context.Context, policyObj *models.SignaturePolicy) error {
+	return s.tx.SignaturePolicy.WithContext(ctx).Create(policyObj)
+}
+
+// UpdateSignaturePolicy updates the signature policy.
+func (s *policyService) UpdateSignaturePolicy(ctx context.Context, policyID int64, updates map[string]any) error {
+	if len(updates) == 0 {
+		return nil
+	}
+	matched, err := s.tx.SignaturePolicy.WithContext(ctx).Where(s.tx.SignaturePolicy.ID.Eq(policyID)).Updates(updates)
+	if err != nil {
+		return err
+	}
+	if matched.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetSigningPolicy gets the sign-on-push policy of the namespace.
+func (s *policyService) GetSigningPolicy(ctx context.Context, namespaceID int64) (*models.SigningPolicy, error) {
+	return s.tx.SigningPolicy.WithContext(ctx).Where(s.tx.SigningPolicy.NamespaceID.Eq(namespaceID)).First()
+}
+
+// CreateSigningPolicy creates a new sign-on-push policy.
+func (s *policyService) CreateSigningPolicy(ctx context.Context, policyObj *models.SigningPolicy) error {
+	return s.tx.SigningPolicy.WithContext(ctx).Create(policyObj)
+}
+
+// UpdateSigningPolicy updates the sign-on-push policy.
+func (s *policyService) UpdateSigningPolicy(ctx context.Context, policyID int64, updates map[string]any) error {
+	if len(updates) == 0 {
+		return nil
+	}
+	matched, err := s.tx.SigningPolicy.WithContext(ctx).Where(s.tx.SigningPolicy.ID.Eq(policyID)).Updates(updates)
+	if err != nil {
+		return err
+	}
+	if matched.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// ListVulnerabilityAllowlist lists the vulnerability allowlist entries of the scope,
+// the system scope entries will be listed if both namespaceID and repositoryID are nil.
+func (s *policyService) ListVulnerabilityAllowlist(ctx context.Context, namespaceID, repositoryID *int64, pagination types.Pagination, sort types.Sortable) ([]*models.VulnerabilityAllowlist, int64, error) {
+	pagination = utils.NormalizePagination(pagination)
+	q := s.tx.VulnerabilityAllowlist.WithContext(ctx).Preload(s.tx.VulnerabilityAllowlist.User)
+	if repositoryID != nil {
+		q = q.Where(s.tx.VulnerabilityAllowlist.RepositoryID.Eq(ptr.To(repositoryID)))
+	} else if namespaceID != nil {
+		q = q.Where(s.tx.VulnerabilityAllowlist.NamespaceID.Eq(ptr.To(namespaceID)), s.tx.VulnerabilityAllowlist.RepositoryID.IsNull())
+	} else {
+		q = q.Where(s.tx.VulnerabilityAllowlist.NamespaceID.IsNull(), s.tx.VulnerabilityAllowlist.RepositoryID.IsNull())
+	}
+	f, ok := s.tx.VulnerabilityAllowlist.GetFieldByName(ptr.To(sort.Sort))
+	if ok {
+		switch ptr.To(sort.Method) {
+		case enums.SortMethodDesc:
+			q = q.Order(f.Desc())
+		case enums.SortMethodAsc:
+			q = q.Order(f)
+		default:
+			q = q.Order(s.tx.VulnerabilityAllowlist.UpdatedAt.Desc())
+		}
+	} else {
+		q = q.Order(s.tx.VulnerabilityAllowlist.UpdatedAt.Desc())
+	}
+	return q.FindByPage(ptr.To(pagination.Limit)*(ptr.To(pagination.Page)-1), ptr.To(pagination.Limit))
+}
+
+// ListActiveVulnerabilityAllowlist lists the unexpired vulnerability allowlist entries that apply to the repository,
+// includes the system, namespace and repository scope entries.
+func (s *policyService) ListActiveVulnerabilityAllowlist(ctx context.Context, namespaceID, repositoryID int64) ([]*models.VulnerabilityAllowlist, error) {
+	a := s.tx.VulnerabilityAllowlist
+	return a.WithContext(ctx).Where(
+		field.Or(
+			field.And(a.NamespaceID.IsNull(), a.RepositoryID.IsNull()),
+			field.And(a.NamespaceID.Eq(namespaceID), a.RepositoryID.IsNull()),
+			a.RepositoryID.Eq(repositoryID),
+		),
+		field.Or(a.ExpiresAt.IsNull(), a.ExpiresAt.Gt(time.Now().UnixMilli())),
+	).Find()
+}
+
+// ListExpiredVulnerabilityAllowlist lists the vulnerability allowlist entries expired before the specified time.
+func (s *policyService) ListExpiredVulnerabilityAllowlist(ctx context.Context, before time.Time, limit int) ([]*models.VulnerabilityAllowlist, error) {
+	return s.tx.VulnerabilityAllowlist.WithContext(ctx).
+		Preload(s.tx.VulnerabilityAllowlist.User).
+		Where(s.tx.VulnerabilityAllowlist.ExpiresAt.Lte(before.UnixMilli())).
+		Order(s.tx.VulnerabilityAllowlist.ExpiresAt).
+		Limit(limit).Find()
+}
+
+// GetVulnerabilityAllowlist gets the vulnerability allowlist entry with the specified id.
+func (s *policyService) GetVulnerabilityAllowlist(ctx context.Context, id int64) (*models.VulnerabilityAllowlist, error) {
+	return s.tx.VulnerabilityAllowlist.WithContext(ctx).
+		Preload(s.tx.VulnerabilityAllowlist.User).
+		Where(s.tx.VulnerabilityAllowlist.ID.Eq(id)).First()
+}
+
+// CreateVulnerabilityAllowlist creates a new vulnerability allowlist entry.
+func (s *policyService) CreateVulnerabilityAllowlist(ctx context.Context, allowlistObj *models.VulnerabilityAllowlist) error {
+	return s.tx.VulnerabilityAllowlist.WithContext(ctx).Create(allowlistObj)
+}
+
+// DeleteVulnerabilityAllowlist deletes the vulnerability allowlist entry with the specified id.
+func (s *policyService) DeleteVulnerabilityAllowlist(ctx context.Context, id int64) error {
+	matched, err := s.tx.VulnerabilityAllowlist.WithContext(ctx).Where(s.tx.VulnerabilityAllowlist.ID.Eq(id)).Delete()
+	if err != nil {
+		return err
+	}
+	if matched.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetVulnerabilityRescanPolicy gets the vulnerability rescan policy of the namespace,
+// the system-wide policy will be returned if namespaceID is nil.
+func (s *policyService) GetVulnerabilityRescanPolicy(ctx context.Context, namespaceID *int64) (*models.VulnerabilityRescanPolicy, error) {
+	q := s.tx.VulnerabilityRescanPolicy.WithContext(ctx)
+	if namespaceID == nil {
+		q = q.Where(s.tx.VulnerabilityRescanPolicy.NamespaceID.IsNull())
+	} else {
+		q = q.Where(s.tx.VulnerabilityRescanPolicy.NamespaceID.Eq(ptr.To(namespaceID)))
+	}
+	return q.First()
+}
+
+// CreateVulnerabilityRescanPolicy creates a new vulnerability rescan policy.
+func (s *policyService) CreateVulnerabilityRescanPolicy(ctx context.Context, policyObj *models.VulnerabilityRescanPolicy) error {
+	return s.tx.VulnerabilityRescanPolicy.WithContext(ctx).Create(policyObj)
+}
+
+// UpdateVulnerabilityRescanPolicy updates the vulnerability rescan policy.
+func (s *policyService) UpdateVulnerabilityRescanPolicy(ctx context.Context, policyID int64, updates map[string]any) error {
+	if len(updates) == 0 {
+		return nil
+	}
+	matched, err := s.tx.VulnerabilityRescanPolicy.WithContext(ctx).Where(s.tx.VulnerabilityRescanPolicy.ID.Eq(policyID)).Updates(updates)
+	if err != nil {
+		return err
+	}
+	if matched.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// ListVulnerabilityRescanPolicyByNextTrigger lists the enabled vulnerability rescan policies that should be triggered.
+func (s *policyService) ListVulnerabilityRescanPolicyByNextTrigger(ctx context.Context, now time.Time, limit int) ([]*models.VulnerabilityRescanPolicy, error) {
+	return s.tx.VulnerabilityRescanPolicy.WithContext(ctx).
+		Where(s.tx.VulnerabilityRescanPolicy.Enabled.Is(true)).
+		Where(s.tx.VulnerabilityRescanPolicy.CronNextTrigger.Lte(now.UnixMilli())).
+		Order(s.tx.VulnerabilityRescanPolicy.CronNextTrigger).
+		Limit(limit).Find()
+}
+
+// ListEnabledVulnerabilityRescanPolicy lists all of the enabled vulnerability rescan policies.
+func (s *policyService) ListEnabledVulnerabilityRescanPolicy(ctx context.Context) ([]*models.VulnerabilityRescanPolicy, error) {
+	return s.tx.VulnerabilityRescanPolicy.WithContext(ctx).
+		Where(s.tx.VulnerabilityRescanPolicy.Enabled.Is(true)).Find()
+}
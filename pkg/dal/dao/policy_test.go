@@ -0,0 +1,348 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dao_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/dal"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/logger"
+	"github.com/go-sigma/sigma/pkg/tests"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+func TestPolicyServiceFactory(t *testing.T) {
+	f := dao.NewPolicyServiceFactory()
+	policyService := f.New()
+	assert.NotNil(t, policyService)
+	policyService = f.New(query.Q)
+	assert.NotNil(t, policyService)
+}
+
+func TestVulnerabilityPolicy(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+
+	policyService := dao.NewPolicyServiceFactory().New()
+
+	_, err := policyService.GetVulnerabilityPolicy(ctx, namespaceObj.ID)
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+
+	policyObj := &models.VulnerabilityPolicy{NamespaceID: namespaceObj.ID, Enabled: true, MaxSeverity: enums.VulnerabilitySeverityHigh}
+	assert.NoError(t, policyService.CreateVulnerabilityPolicy(ctx, policyObj))
+
+	policyObj, err = policyService.GetVulnerabilityPolicy(ctx, namespaceObj.ID)
+	assert.NoError(t, err)
+	assert.True(t, policyObj.Enabled)
+	assert.Equal(t, enums.VulnerabilitySeverityHigh, policyObj.MaxSeverity)
+
+	assert.NoError(t, policyService.UpdateVulnerabilityPolicy(ctx, policyObj.ID, map[string]any{
+		query.VulnerabilityPolicy.MaxSeverity.ColumnName().String():    enums.VulnerabilitySeverityLow,
+		query.VulnerabilityPolicy.BlockUnscanned.ColumnName().String(): true,
+		query.VulnerabilityPolicy.Exemptions.ColumnName().String():     "test/busybox",
+	}))
+	assert.NoError(t, policyService.UpdateVulnerabilityPolicy(ctx, policyObj.ID, nil))
+	assert.ErrorIs(t, policyService.UpdateVulnerabilityPolicy(ctx, 1000, map[string]any{
+		query.VulnerabilityPolicy.Enabled.ColumnName().String(): false,
+	}), gorm.ErrRecordNotFound)
+
+	policyObj, err = policyService.GetVulnerabilityPolicy(ctx, namespaceObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, enums.VulnerabilitySeverityLow, policyObj.MaxSeverity)
+	assert.True(t, policyObj.BlockUnscanned)
+	assert.Equal(t, "test/busybox", policyObj.Exemptions)
+}
+
+func TestLicensePolicy(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+
+	policyService := dao.NewPolicyServiceFactory().New()
+
+	_, err := policyService.GetLicensePolicy(ctx, namespaceObj.ID)
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+
+	policyObj := &models.LicensePolicy{NamespaceID: namespaceObj.ID, Enabled: true, DeniedLicenses: "AGPL-3.0-only"}
+	assert.NoError(t, policyService.CreateLicensePolicy(ctx, policyObj))
+
+	policyObj, err = policyService.GetLicensePolicy(ctx, namespaceObj.ID)
+	assert.NoError(t, err)
+	assert.True(t, policyObj.Enabled)
+	assert.False(t, policyObj.BlockPull)
+	assert.Equal(t, "AGPL-3.0-only", policyObj.DeniedLicenses)
+
+	assert.NoError(t, policyService.UpdateLicensePolicy(ctx, policyObj.ID, map[string]any{
+		query.LicensePolicy.BlockPull.ColumnName().String():       true,
+		query.LicensePolicy.AllowedLicenses.ColumnName().String(): "MIT,Apache-2.0",
+	}))
+	assert.NoError(t, policyService.UpdateLicensePolicy(ctx, policyObj.ID, nil))
+	assert.ErrorIs(t, policyService.UpdateLicensePolicy(ctx, 1000, map[string]any{
+		query.LicensePolicy.Enabled.ColumnName().String(): false,
+	}), gorm.ErrRecordNotFound)
+
+	policyObj, err = policyService.GetLicensePolicy(ctx, namespaceObj.ID)
+	assert.NoError(t, err)
+	assert.True(t, policyObj.BlockPull)
+	assert.Equal(t, "MIT,Apache-2.0", policyObj.AllowedLicenses)
+}
+
+func TestSignaturePolicy(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+
+	policyService := dao.NewPolicyServiceFactory().New()
+
+	_, err := policyService.GetSignaturePolicy(ctx, namespaceObj.ID)
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+
+	policyObj := &models.SignaturePolicy{NamespaceID: namespaceObj.ID, Enabled: true, PublicKeys: "public-key"}
+	assert.NoError(t, policyService.CreateSignaturePolicy(ctx, policyObj))
+
+	policyObj, err = policyService.GetSignaturePolicy(ctx, namespaceObj.ID)
+	assert.NoError(t, err)
+	assert.True(t, policyObj.Enabled)
+	assert.Equal(t, "public-key", policyObj.PublicKeys)
+
+	assert.NoError(t, policyService.UpdateSignaturePolicy(ctx, policyObj.ID, map[string]any{
+		query.SignaturePolicy.Enabled.ColumnName().String():    false,
+		query.SignaturePolicy.Exemptions.ColumnName().String(): "library/busybox",
+	}))
+	assert.NoError(t, policyService.UpdateSignaturePolicy(ctx, policyObj.ID, nil))
+	assert.ErrorIs(t, policyService.UpdateSignaturePolicy(ctx, 1000, map[string]any{
+		query.SignaturePolicy.Enabled.ColumnName().String(): false,
+	}), gorm.ErrRecordNotFound)
+
+	policyObj, err = policyService.GetSignaturePolicy(ctx, namespaceObj.ID)
+	assert.NoError(t, err)
+	assert.False(t, policyObj.Enabled)
+	assert.Equal(t, "library/busybox", policyObj.Exemptions)
+}
+
+func TestSigningPolicy(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+
+	policyService := dao.NewPolicyServiceFactory().New()
+
+	_, err := policyService.GetSigningPolicy(ctx, namespaceObj.ID)
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+
+	policyObj := &models.SigningPolicy{NamespaceID: namespaceObj.ID, Enabled: true, TagPattern: "^v.*"}
+	assert.NoError(t, policyService.CreateSigningPolicy(ctx, policyObj))
+
+	policyObj, err = policyService.GetSigningPolicy(ctx, namespaceObj.ID)
+	assert.NoError(t, err)
+	assert.True(t, policyObj.Enabled)
+	assert.Equal(t, "^v.*", policyObj.TagPattern)
+
+	assert.NoError(t, policyService.UpdateSigningPolicy(ctx, policyObj.ID, map[string]any{
+		query.SigningPolicy.Enabled.ColumnName().String():    false,
+		query.SigningPolicy.TagPattern.ColumnName().String(): "",
+	}))
+	assert.NoError(t, policyService.UpdateSigningPolicy(ctx, policyObj.ID, nil))
+	assert.ErrorIs(t, policyService.UpdateSigningPolicy(ctx, 1000, map[string]any{
+		query.SigningPolicy.Enabled.ColumnName().String(): false,
+	}), gorm.ErrRecordNotFound)
+
+	policyObj, err = policyService.GetSigningPolicy(ctx, namespaceObj.ID)
+	assert.NoError(t, err)
+	assert.False(t, policyObj.Enabled)
+	assert.Equal(t, "", policyObj.TagPattern)
+}
+
+func TestVulnerabilityAllowlist(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	userObj := &models.User{Username: "allowlist-service", Password: ptr.Of("test"), Email: ptr.Of("test@gmail.com")}
+	assert.NoError(t, dao.NewUserServiceFactory().New().Create(ctx, userObj))
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+	repositoryObj := &models.Repository{Name: "test/busybox", NamespaceID: namespaceObj.ID}
+	assert.NoError(t, dao.NewRepositoryServiceFactory().New().Create(ctx, repositoryObj, dao.AutoCreateNamespace{UserID: userObj.ID}))
+
+	policyService := dao.NewPolicyServiceFactory().New()
+
+	now := time.Now().UnixMilli()
+	systemObj := &models.VulnerabilityAllowlist{UserID: userObj.ID, VulnerabilityID: "CVE-2024-0001", Justification: "system"}
+	assert.NoError(t, policyService.CreateVulnerabilityAllowlist(ctx, systemObj))
+	namespaceAllowlistObj := &models.VulnerabilityAllowlist{NamespaceID: ptr.Of(namespaceObj.ID), UserID: userObj.ID,
+		VulnerabilityID: "CVE-2024-0002", Package: ptr.Of("openssl"), Justification: "namespace", ExpiresAt: ptr.Of(now + 3600000)}
+	assert.NoError(t, policyService.CreateVulnerabilityAllowlist(ctx, namespaceAllowlistObj))
+	repositoryAllowlistObj := &models.VulnerabilityAllowlist{NamespaceID: ptr.Of(namespaceObj.ID), RepositoryID: ptr.Of(repositoryObj.ID),
+		UserID: userObj.ID, VulnerabilityID: "CVE-2024-0003", Justification: "repository", ExpiresAt: ptr.Of(now - 1000)}
+	assert.NoError(t, policyService.CreateVulnerabilityAllowlist(ctx, repositoryAllowlistObj))
+
+	allowlistObjs, total, err := policyService.ListVulnerabilityAllowlist(ctx, nil, nil, types.Pagination{Limit: ptr.Of(10), Page: ptr.Of(1)}, types.Sortable{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, "CVE-2024-0001", allowlistObjs[0].VulnerabilityID)
+	assert.Equal(t, userObj.Username, allowlistObjs[0].User.Username)
+
+	_, total, err = policyService.ListVulnerabilityAllowlist(ctx, ptr.Of(namespaceObj.ID), nil, types.Pagination{Limit: ptr.Of(10), Page: ptr.Of(1)}, types.Sortable{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+
+	_, total, err = policyService.ListVulnerabilityAllowlist(ctx, nil, ptr.Of(repositoryObj.ID), types.Pagination{Limit: ptr.Of(10), Page: ptr.Of(1)}, types.Sortable{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+
+	activeObjs, err := policyService.ListActiveVulnerabilityAllowlist(ctx, namespaceObj.ID, repositoryObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(activeObjs))
+
+	expiredObjs, err := policyService.ListExpiredVulnerabilityAllowlist(ctx, time.Now(), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(expiredObjs))
+	assert.Equal(t, repositoryAllowlistObj.ID, expiredObjs[0].ID)
+
+	allowlistObj, err := policyService.GetVulnerabilityAllowlist(ctx, namespaceAllowlistObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "openssl", ptr.To(allowlistObj.Package))
+
+	assert.NoError(t, policyService.DeleteVulnerabilityAllowlist(ctx, repositoryAllowlistObj.ID))
+	assert.ErrorIs(t, policyService.DeleteVulnerabilityAllowlist(ctx, repositoryAllowlistObj.ID), gorm.ErrRecordNotFound)
+	_, err = policyService.GetVulnerabilityAllowlist(ctx, repositoryAllowlistObj.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestVulnerabilityRescanPolicy(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+
+	policyService := dao.NewPolicyServiceFactory().New()
+
+	_, err := policyService.GetVulnerabilityRescanPolicy(ctx, nil)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	now := time.Now()
+	systemObj := &models.VulnerabilityRescanPolicy{Enabled: true, CronRule: "0 2 * * *", PulledWithin: 30, CronNextTrigger: ptr.Of(now.Add(-time.Minute).UnixMilli())}
+	assert.NoError(t, policyService.CreateVulnerabilityRescanPolicy(ctx, systemObj))
+	namespacePolicyObj := &models.VulnerabilityRescanPolicy{NamespaceID: ptr.Of(namespaceObj.ID), CronRule: "0 3 * * *", PulledWithin: 7, CronNextTrigger: ptr.Of(now.Add(-time.Minute).UnixMilli())}
+	assert.NoError(t, policyService.CreateVulnerabilityRescanPolicy(ctx, namespacePolicyObj))
+
+	policyObj, err := policyService.GetVulnerabilityRescanPolicy(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, systemObj.ID, policyObj.ID)
+	policyObj, err = policyService.GetVulnerabilityRescanPolicy(ctx, ptr.Of(namespaceObj.ID))
+	assert.NoError(t, err)
+	assert.Equal(t, namespacePolicyObj.ID, policyObj.ID)
+
+	policyObjs, err := policyService.ListVulnerabilityRescanPolicyByNextTrigger(ctx, now, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(policyObjs))
+	assert.Equal(t, systemObj.ID, policyObjs[0].ID)
+
+	assert.NoError(t, policyService.UpdateVulnerabilityRescanPolicy(ctx, namespacePolicyObj.ID, map[string]any{
+		query.VulnerabilityRescanPolicy.Enabled.ColumnName().String(): true,
+	}))
+	assert.NoError(t, policyService.UpdateVulnerabilityRescanPolicy(ctx, systemObj.ID, map[string]any{
+		query.VulnerabilityRescanPolicy.CronNextTrigger.ColumnName().String(): now.Add(time.Hour).UnixMilli(),
+	}))
+	assert.NoError(t, policyService.UpdateVulnerabilityRescanPolicy(ctx, systemObj.ID, nil))
+	assert.ErrorIs(t, policyService.UpdateVulnerabilityRescanPolicy(ctx, 1000, map[string]any{
+		query.VulnerabilityRescanPolicy.Enabled.ColumnName().String(): false,
+	}), gorm.ErrRecordNotFound)
+
+	policyObjs, err = policyService.ListVulnerabilityRescanPolicyByNextTrigger(ctx, now, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(policyObjs))
+	assert.Equal(t, namespacePolicyObj.ID, policyObjs[0].ID)
+
+	policyObjs, err = policyService.ListEnabledVulnerabilityRescanPolicy(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(policyObjs))
+}
@@ -65,6 +65,12 @@ type RepositoryService interface {
 	DeleteByID(ctx context.Context, id int64) error
 	// DeleteEmpty delete all of empty repository
 	DeleteEmpty(ctx context.Context, namespaceID *int64) ([]string, error)
+	// ListDeleted lists the repositories of the namespace deleted after the specified time.
+	ListDeleted(ctx context.Context, namespaceID int64, after int64, pagination types.Pagination) ([]*models.Repository, int64, error)
+	// GetDeleted gets the repository with the specified repository ID which is deleted after the specified time.
+	GetDeleted(ctx context.Context, id int64, after int64) (*models.Repository, error)
+	// Restore restores the deleted repository with the specified repository ID.
+	Restore(ctx context.Context, id int64) error
 }
 
 type repositoryService struct {
@@ -116,9 +122,14 @@ func (s *repositoryService) Create(ctx context.Context, repositoryObj *models.Re
 		if !autoCreateNamespace.AutoCreate {
 			return fmt.Errorf("namespace %s not found", ns)
 		}
+		err = (&userService{tx: s.tx}).IncreaseNamespaceCount(ctx, autoCreateNamespace.UserID)
+		if err != nil {
+			return err
+		}
 		namespaceObj = &models.Namespace{
 			Name:       ns,
 			Visibility: autoCreateNamespace.Visibility,
+			CreatorID:  ptr.Of(autoCreateNamespace.UserID),
 		}
 		if !namespaceObj.Visibility.IsValid() {
 			namespaceObj.Visibility = enums.VisibilityPrivate
@@ -396,3 +407,32 @@ func (s *repositoryService) DeleteEmpty(ctx context.Context, namespaceID *int64)
 	}
 	return result, nil
 }
+
+// ListDeleted lists the repositories of the namespace deleted after the specified time.
+func (s *repositoryService) ListDeleted(ctx context.Context, namespaceID int64, after int64, pagination types.Pagination) ([]*models.Repository, int64, error) {
+	pagination = utils.NormalizePagination(pagination)
+	return s.tx.Repository.WithContext(ctx).Unscoped().
+		Where(s.tx.Repository.NamespaceID.Eq(namespaceID), s.tx.Repository.DeletedAt.Gt(uint64(after))).
+		Order(s.tx.Repository.DeletedAt.Desc()).
+		FindByPage(ptr.To(pagination.Limit)*(ptr.To(pagination.Page)-1), ptr.To(pagination.Limit))
+}
+
+// GetDeleted gets the repository with the specified repository ID which is deleted after the specified time.
+func (s *repositoryService) GetDeleted(ctx context.Context, id int64, after int64) (*models.Repository, error) {
+	return s.tx.Repository.WithContext(ctx).Unscoped().
+		Where(s.tx.Repository.ID.Eq(id), s.tx.Repository.DeletedAt.Gt(uint64(after))).First()
+}
+
+// Restore restores the deleted repository with the specified repository ID.
+func (s *repositoryService) Restore(ctx context.Context, id int64) error {
+	matched, err := s.tx.Repository.WithContext(ctx).Unscoped().
+		Where(s.tx.Repository.ID.Eq(id), s.tx.Repository.DeletedAt.Neq(0)).
+		UpdateColumn(s.tx.Repository.DeletedAt, 0)
+	if err != nil {
+		return err
+	}
+	if matched.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
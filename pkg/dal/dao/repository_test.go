@@ -17,9 +17,11 @@ package dao_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
 
 	"github.com/go-sigma/sigma/pkg/dal"
 	"github.com/go-sigma/sigma/pkg/dal/dao"
@@ -98,3 +100,52 @@ func TestRepositoryService(t *testing.T) {
 
 	assert.NoError(t, repositoryService.DeleteByID(ctx, repositoryObj.ID))
 }
+
+func TestRepositoryServiceRecycleBin(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	userObj := &models.User{Username: "repository-service", Password: ptr.Of("test"), Email: ptr.Of("test@gmail.com")}
+	assert.NoError(t, dao.NewUserServiceFactory().New().Create(ctx, userObj))
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+	repositoryService := dao.NewRepositoryServiceFactory().New()
+	repositoryObj := &models.Repository{Name: "test/busybox", NamespaceID: namespaceObj.ID}
+	assert.NoError(t, repositoryService.Create(ctx, repositoryObj, dao.AutoCreateNamespace{UserID: userObj.ID}))
+
+	after := time.Now().Add(-time.Hour).UnixMilli()
+	pagination := types.Pagination{Limit: ptr.Of(10), Page: ptr.Of(1)}
+
+	assert.ErrorIs(t, repositoryService.Restore(ctx, repositoryObj.ID), gorm.ErrRecordNotFound)
+
+	assert.NoError(t, repositoryService.DeleteByID(ctx, repositoryObj.ID))
+
+	repositoryObjs, total, err := repositoryService.ListDeleted(ctx, namespaceObj.ID, after, pagination)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, repositoryObj.ID, repositoryObjs[0].ID)
+
+	_, total, err = repositoryService.ListDeleted(ctx, namespaceObj.ID+1, after, pagination)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), total)
+
+	_, err = repositoryService.GetDeleted(ctx, repositoryObj.ID, time.Now().Add(time.Hour).UnixMilli())
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	deletedObj, err := repositoryService.GetDeleted(ctx, repositoryObj.ID, after)
+	assert.NoError(t, err)
+	assert.Equal(t, repositoryObj.Name, deletedObj.Name)
+
+	assert.NoError(t, repositoryService.Restore(ctx, repositoryObj.ID))
+	restoredObj, err := repositoryService.GetByName(ctx, repositoryObj.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, repositoryObj.ID, restoredObj.ID)
+}
@@ -0,0 +1,110 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dao
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+)
+
+//go:generate mockgen -destination=mocks/signing_key.go -package=mocks github.com/go-sigma/sigma/pkg/dal/dao SigningKeyService
+//go:generate mockgen -destination=mocks/signing_key_factory.go -package=mocks github.com/go-sigma/sigma/pkg/dal/dao SigningKeyServiceFactory
+
+// SigningKeyService is the interface that provides methods to operate on signing key model
+type SigningKeyService interface {
+	// Create creates a new signing key record in the database
+	Create(ctx context.Context, signingKeyObj *models.SigningKey) error
+	// Get gets the signing key of the namespace by id
+	Get(ctx context.Context, namespaceID, id int64) (*models.SigningKey, error)
+	// GetActive gets the active signing key of the namespace
+	GetActive(ctx context.Context, namespaceID int64) (*models.SigningKey, error)
+	// ListByNamespace lists all of the active and retired signing keys of the namespace, the newest first
+	ListByNamespace(ctx context.Context, namespaceID int64) ([]*models.SigningKey, error)
+	// Retire retires the active signing key of the namespace
+	Retire(ctx context.Context, namespaceID int64) error
+	// Delete deletes the signing key of the namespace by id
+	Delete(ctx context.Context, namespaceID, id int64) error
+}
+
+type signingKeyService struct {
+	tx *query.Query
+}
+
+// SigningKeyServiceFactory is the interface that provides the signing key service factory methods.
+type SigningKeyServiceFactory interface {
+	New(txs ...*query.Query) SigningKeyService
+}
+
+type signingKeyServiceFactory struct{}
+
+// NewSigningKeyServiceFactory creates a new signing key service factory.
+func NewSigningKeyServiceFactory() SigningKeyServiceFactory {
+	return &signingKeyServiceFactory{}
+}
+
+func (s *signingKeyServiceFactory) New(txs ...*query.Query) SigningKeyService {
+	tx := query.Q
+	if len(txs) > 0 {
+		tx = txs[0]
+	}
+	return &signingKeyService{
+		tx: tx,
+	}
+}
+
+// Create creates a new signing key record in the database
+func (s *signingKeyService) Create(ctx context.Context, signingKeyObj *models.SigningKey) error {
+	return s.tx.SigningKey.WithContext(ctx).Create(signingKeyObj)
+}
+
+// Get gets the signing key of the namespace by id
+func (s *signingKeyService) Get(ctx context.Context, namespaceID, id int64) (*models.SigningKey, error) {
+	return s.tx.SigningKey.WithContext(ctx).Where(s.tx.SigningKey.NamespaceID.Eq(namespaceID), s.tx.SigningKey.ID.Eq(id)).First()
+}
+
+// GetActive gets the active signing key of the namespace
+func (s *signingKeyService) GetActive(ctx context.Context, namespaceID int64) (*models.SigningKey, error) {
+	return s.tx.SigningKey.WithContext(ctx).Where(s.tx.SigningKey.NamespaceID.Eq(namespaceID), s.tx.SigningKey.Active.Is(true)).
+		Order(s.tx.SigningKey.ID.Desc()).First()
+}
+
+// ListByNamespace lists all of the active and retired signing keys of the namespace, the newest first
+func (s *signingKeyService) ListByNamespace(ctx context.Context, namespaceID int64) ([]*models.SigningKey, error) {
+	return s.tx.SigningKey.WithContext(ctx).Where(s.tx.SigningKey.NamespaceID.Eq(namespaceID)).Order(s.tx.SigningKey.ID.Desc()).Find()
+}
+
+// Retire retires the active signing key of the namespace
+func (s *signingKeyService) Retire(ctx context.Context, namespaceID int64) error {
+	_, err := s.tx.SigningKey.WithContext(ctx).Where(s.tx.SigningKey.NamespaceID.Eq(namespaceID), s.tx.SigningKey.Active.Is(true)).
+		UpdateColumnSimple(s.tx.SigningKey.Active.Value(false), s.tx.SigningKey.RetiredAt.Value(time.Now().UnixMilli()))
+	return err
+}
+
+// Delete deletes the signing key of the namespace by id
+func (s *signingKeyService) Delete(ctx context.Context, namespaceID, id int64) error {
+	matched, err := s.tx.SigningKey.WithContext(ctx).Where(s.tx.SigningKey.NamespaceID.Eq(namespaceID), s.tx.SigningKey.ID.Eq(id)).Delete()
+	if err != nil {
+		return err
+	}
+	if matched.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
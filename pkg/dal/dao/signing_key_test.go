@@ -0,0 +1,84 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dao_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/dal"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/logger"
+	"github.com/go-sigma/sigma/pkg/tests"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+)
+
+func TestSigningKey(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+
+	signingKeyService := dao.NewSigningKeyServiceFactory().New()
+
+	_, err := signingKeyService.GetActive(ctx, namespaceObj.ID)
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+
+	oldKeyObj := &models.SigningKey{NamespaceID: namespaceObj.ID, Fingerprint: "old", PublicKey: "public-key", PrivateKey: "private-key", Active: true}
+	assert.NoError(t, signingKeyService.Create(ctx, oldKeyObj))
+
+	assert.NoError(t, signingKeyService.Retire(ctx, namespaceObj.ID))
+	newKeyObj := &models.SigningKey{NamespaceID: namespaceObj.ID, Fingerprint: "new", PublicKey: "public-key", PrivateKey: "private-key", Active: true, Imported: true}
+	assert.NoError(t, signingKeyService.Create(ctx, newKeyObj))
+
+	activeKeyObj, err := signingKeyService.GetActive(ctx, namespaceObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "new", activeKeyObj.Fingerprint)
+
+	signingKeyObjs, err := signingKeyService.ListByNamespace(ctx, namespaceObj.ID)
+	assert.NoError(t, err)
+	assert.Len(t, signingKeyObjs, 2)
+	assert.Equal(t, "new", signingKeyObjs[0].Fingerprint)
+	assert.False(t, signingKeyObjs[1].Active)
+	assert.NotNil(t, signingKeyObjs[1].RetiredAt)
+
+	signingKeyObj, err := signingKeyService.Get(ctx, namespaceObj.ID, oldKeyObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "old", signingKeyObj.Fingerprint)
+	_, err = signingKeyService.Get(ctx, namespaceObj.ID+1, oldKeyObj.ID)
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+
+	assert.NoError(t, signingKeyService.Delete(ctx, namespaceObj.ID, oldKeyObj.ID))
+	assert.ErrorIs(t, signingKeyService.Delete(ctx, namespaceObj.ID, oldKeyObj.ID), gorm.ErrRecordNotFound)
+	signingKeyObjs, err = signingKeyService.ListByNamespace(ctx, namespaceObj.ID)
+	assert.NoError(t, err)
+	assert.Len(t, signingKeyObjs, 1)
+}
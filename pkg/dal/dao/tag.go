@@ -49,6 +49,8 @@ type TagService interface {
 	GetByName(ctx context.Context, repositoryID int64, tag string) (*models.Tag, error)
 	// GetByArtifactID ...
 	GetByArtifactID(ctx context.Context, repositoryID, artifactID int64) (*models.Tag, error)
+	// FindByArtifactID finds all of the tags of the artifact.
+	FindByArtifactID(ctx context.Context, artifactID int64) ([]*models.Tag, error)
 	// DeleteByName deletes the tag with the specified tag name.
 	DeleteByName(ctx context.Context, repositoryID int64, tag string) error
 	// DeleteByArtifactID deletes the tag with the specified artifact ID.
@@ -71,6 +73,13 @@ type TagService interface {
 	DeleteByID(ctx context.Context, id int64) error
 	// CountByArtifact counts the tags by the specified artifact.
 	CountByArtifact(ctx context.Context, artifactIDs []int64) (map[int64]int64, error)
+	// ListDeleted lists the tags of the namespace deleted after the specified time,
+	// the repository and the artifact of the tag are filled even if they are deleted.
+	ListDeleted(ctx context.Context, namespaceID int64, after int64, pagination types.Pagination) ([]*models.Tag, int64, error)
+	// GetDeleted gets the tag with the specified tag ID which is deleted after the specified time.
+	GetDeleted(ctx context.Context, id int64, after int64) (*models.Tag, error)
+	// Restore restores the deleted tag with the specified tag ID and points it to the specified artifact.
+	Restore(ctx context.Context, id int64, artifactID int64) error
 }
 
 type tagService struct {
@@ -137,7 +146,7 @@ func (s *tagService) Create(ctx context.Context, tag *models.Tag, options ...Opt
 	_, err = s.tx.Tag.WithContext(ctx).Where(
 		s.tx.Tag.RepositoryID.Eq(tag.RepositoryID),
 		s.tx.Tag.Name.Eq(tag.Name)).Updates(map[string]any{
-		query.Tag.ArtifactID.ColumnName().String(): tag.ArtifactID,
+		s.tx.Tag.ArtifactID.ColumnName().String(): tag.ArtifactID,
 	})
 	if err != nil {
 		return err
@@ -192,6 +201,11 @@ func (s *tagService) GetByArtifactID(ctx context.Context, repositoryID, artifact
 	return s.tx.Tag.WithContext(ctx).Where(s.tx.Tag.RepositoryID.Eq(repositoryID), s.tx.Tag.ArtifactID.Eq(artifactID)).First()
 }
 
+// FindByArtifactID finds all of the tags of the artifact.
+func (s *tagService) FindByArtifactID(ctx context.Context, artifactID int64) ([]*models.Tag, error) {
+	return s.tx.Tag.WithContext(ctx).Where(s.tx.Tag.ArtifactID.Eq(artifactID)).Find()
+}
+
 // DeleteByName deletes the tag with the specified tag name.
 func (s *tagService) DeleteByName(ctx context.Context, repositoryID int64, tag string) error {
 	tagObj, err := s.tx.Tag.WithContext(ctx).Where(s.tx.Tag.RepositoryID.Eq(repositoryID), s.tx.Tag.Name.Eq(tag)).First()
@@ -372,3 +386,69 @@ func (s *tagService) CountByRepositories(ctx context.Context, repositoryIDs []in
 func (s *tagService) CountByRepository(ctx context.Context, repositoryID int64) (int64, error) {
 	return s.tx.Tag.WithContext(ctx).Where(s.tx.Tag.RepositoryID.Eq(repositoryID)).Count()
 }
+
+// ListDeleted lists the tags of the namespace deleted after the specified time,
+// the repository and the artifact of the tag are filled even if they are deleted.
+func (s *tagService) ListDeleted(ctx context.Context, namespaceID int64, after int64, pagination types.Pagination) ([]*models.Tag, int64, error) {
+	pagination = utils.NormalizePagination(pagination)
+	tagObjs, total, err := s.tx.Tag.WithContext(ctx).Unscoped().
+		Join(s.tx.Repository, s.tx.Repository.ID.EqCol(s.tx.Tag.RepositoryID)).
+		Where(s.tx.Repository.NamespaceID.Eq(namespaceID), s.tx.Tag.DeletedAt.Gt(uint64(after))).
+		Order(s.tx.Tag.DeletedAt.Desc()).
+		FindByPage(ptr.To(pagination.Limit)*(ptr.To(pagination.Page)-1), ptr.To(pagination.Limit))
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(tagObjs) == 0 {
+		return tagObjs, total, nil
+	}
+	var repositoryIDs, artifactIDs []int64
+	for _, tagObj := range tagObjs {
+		repositoryIDs = append(repositoryIDs, tagObj.RepositoryID)
+		artifactIDs = append(artifactIDs, tagObj.ArtifactID)
+	}
+	repositoryObjs, err := s.tx.Repository.WithContext(ctx).Unscoped().Where(s.tx.Repository.ID.In(repositoryIDs...)).Find()
+	if err != nil {
+		return nil, 0, err
+	}
+	artifactObjs, err := s.tx.Artifact.WithContext(ctx).Unscoped().Where(s.tx.Artifact.ID.In(artifactIDs...)).Find()
+	if err != nil {
+		return nil, 0, err
+	}
+	var repositoryMap = make(map[int64]*models.Repository, len(repositoryObjs))
+	for _, repositoryObj := range repositoryObjs {
+		repositoryMap[repositoryObj.ID] = repositoryObj
+	}
+	var artifactMap = make(map[int64]*models.Artifact, len(artifactObjs))
+	for _, artifactObj := range artifactObjs {
+		artifactMap[artifactObj.ID] = artifactObj
+	}
+	for _, tagObj := range tagObjs {
+		tagObj.Repository = repositoryMap[tagObj.RepositoryID]
+		tagObj.Artifact = artifactMap[tagObj.ArtifactID]
+	}
+	return tagObjs, total, nil
+}
+
+// GetDeleted gets the tag with the specified tag ID which is deleted after the specified time.
+func (s *tagService) GetDeleted(ctx context.Context, id int64, after int64) (*models.Tag, error) {
+	return s.tx.Tag.WithContext(ctx).Unscoped().
+		Where(s.tx.Tag.ID.Eq(id), s.tx.Tag.DeletedAt.Gt(uint64(after))).First()
+}
+
+// Restore restores the deleted tag with the specified tag ID and points it to the specified artifact.
+func (s *tagService) Restore(ctx context.Context, id int64, artifactID int64) error {
+	matched, err := s.tx.Tag.WithContext(ctx).Unscoped().
+		Where(s.tx.Tag.ID.Eq(id), s.tx.Tag.DeletedAt.Neq(0)).
+		UpdateColumns(map[string]any{
+			s.tx.Tag.DeletedAt.ColumnName().String():  0,
+			s.tx.Tag.ArtifactID.ColumnName().String(): artifactID,
+		})
+	if err != nil {
+		return err
+	}
+	if matched.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -17,6 +17,7 @@ package dao_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
@@ -168,3 +169,64 @@ func TestTagService(t *testing.T) {
 	assert.Equal(t, len(tagCount1), int(1))
 	assert.Equal(t, tagCount1[tagObj2.ArtifactID], int64(1))
 }
+
+func TestTagServiceRecycleBin(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	userObj := &models.User{Username: "tag-service", Password: ptr.Of("test"), Email: ptr.Of("test@gmail.com")}
+	assert.NoError(t, dao.NewUserServiceFactory().New().Create(ctx, userObj))
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+	repositoryObj := &models.Repository{Name: "test/busybox", NamespaceID: namespaceObj.ID}
+	assert.NoError(t, dao.NewRepositoryServiceFactory().New().Create(ctx, repositoryObj, dao.AutoCreateNamespace{UserID: userObj.ID}))
+	artifactService := dao.NewArtifactServiceFactory().New()
+	artifactObj1 := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:1",
+		Size: 123, ContentType: "test", Raw: []byte("test")}
+	assert.NoError(t, artifactService.Create(ctx, artifactObj1))
+	artifactObj2 := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:2",
+		Size: 123, ContentType: "test", Raw: []byte("test")}
+	assert.NoError(t, artifactService.Create(ctx, artifactObj2))
+	tagService := dao.NewTagServiceFactory().New()
+	tagObj := &models.Tag{RepositoryID: repositoryObj.ID, ArtifactID: artifactObj1.ID, Name: "latest"}
+	assert.NoError(t, tagService.Create(ctx, tagObj))
+
+	after := time.Now().Add(-time.Hour).UnixMilli()
+	pagination := types.Pagination{Limit: ptr.Of(10), Page: ptr.Of(1)}
+
+	assert.ErrorIs(t, tagService.Restore(ctx, tagObj.ID, artifactObj1.ID), gorm.ErrRecordNotFound)
+
+	assert.NoError(t, tagService.DeleteByID(ctx, tagObj.ID))
+	assert.NoError(t, artifactService.DeleteByID(ctx, artifactObj1.ID))
+
+	tagObjs, total, err := tagService.ListDeleted(ctx, namespaceObj.ID, after, pagination)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, tagObj.ID, tagObjs[0].ID)
+	assert.Equal(t, repositoryObj.Name, tagObjs[0].Repository.Name)
+	assert.Equal(t, artifactObj1.Digest, tagObjs[0].Artifact.Digest)
+
+	_, total, err = tagService.ListDeleted(ctx, namespaceObj.ID+1, after, pagination)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), total)
+
+	_, err = tagService.GetDeleted(ctx, tagObj.ID, time.Now().Add(time.Hour).UnixMilli())
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	deletedObj, err := tagService.GetDeleted(ctx, tagObj.ID, after)
+	assert.NoError(t, err)
+	assert.Equal(t, tagObj.Name, deletedObj.Name)
+
+	assert.NoError(t, tagService.Restore(ctx, tagObj.ID, artifactObj2.ID))
+	restoredObj, err := tagService.GetByName(ctx, repositoryObj.ID, tagObj.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, artifactObj2.ID, restoredObj.ArtifactID)
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 
+	"gorm.io/gen/field"
 	"gorm.io/gorm"
 
 	"github.com/go-sigma/sigma/pkg/dal/models"
@@ -26,6 +27,7 @@ import (
 	"github.com/go-sigma/sigma/pkg/types/enums"
 	"github.com/go-sigma/sigma/pkg/utils"
 	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/xerrors"
 )
 
 //go:generate mockgen -destination=mocks/user.go -package=mocks github.com/go-sigma/sigma/pkg/dal/dao UserService
@@ -49,6 +51,11 @@ type UserService interface {
 	ListWithoutUsername(ctx context.Context, except []string, withoutAdmin bool, name *string, pagination types.Pagination, sort types.Sortable) ([]*models.User, int64, error)
 	// UpdateByID updates the namespace with the specified namespace ID.
 	UpdateByID(ctx context.Context, id int64, updates map[string]interface{}) error
+	// IncreaseNamespaceCount increases the namespace count of the user, the namespace limit of the user is checked in the same statement,
+	// returns the namespace count quota exceed error if the user reached the limit.
+	IncreaseNamespaceCount(ctx context.Context, userID int64) error
+	// DecreaseNamespaceCount decreases the namespace count of the user.
+	DecreaseNamespaceCount(ctx context.Context, userID int64) error
 	// AddPlatformMember bind a platform role for user
 	AddPlatformMember(ctx context.Context, userID int64, role enums.UserRole) error
 	// DeletePlatformMember unbind platform role for user
@@ -258,6 +265,32 @@ func (s *userService) UpdateByID(ctx context.Context, id int64, updates map[stri
 	return nil
 }
 
+// IncreaseNamespaceCount increases the namespace count of the user, the namespace limit of the user is checked in the same statement,
+// returns the namespace count quota exceed error if the user reached the limit.
+func (s *userService) IncreaseNamespaceCount(ctx context.Context, userID int64) error {
+	matched, err := s.tx.User.WithContext(ctx).Where(s.tx.User.ID.Eq(userID)).
+		Where(field.Or(s.tx.User.NamespaceLimit.Eq(0), s.tx.User.NamespaceCount.LtCol(s.tx.User.NamespaceLimit))).
+		UpdateSimple(s.tx.User.NamespaceCount.Add(1))
+	if err != nil {
+		return err
+	}
+	if matched.RowsAffected == 0 {
+		userObj, err := s.tx.User.WithContext(ctx).Where(s.tx.User.ID.Eq(userID)).First()
+		if err != nil {
+			return err
+		}
+		return xerrors.GenDSErrCodeResourceCountQuotaExceedUserNamespace(userObj.Username, userObj.NamespaceLimit)
+	}
+	return nil
+}
+
+// DecreaseNamespaceCount decreases the namespace count of the user.
+func (s *userService) DecreaseNamespaceCount(ctx context.Context, userID int64) error {
+	_, err := s.tx.User.WithContext(ctx).Where(s.tx.User.ID.Eq(userID), s.tx.User.NamespaceCount.Gt(0)).
+		UpdateSimple(s.tx.User.NamespaceCount.Sub(1))
+	return err
+}
+
 // AddPlatformMember bind a platform role for user
 func (s *userService) AddPlatformMember(ctx context.Context, userID int64, role enums.UserRole) error {
 	return s.tx.CasbinRule.WithContext(ctx).Create(&models.CasbinRule{
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `vulnerability_policies` (
+  `id` bigint AUTO_INCREMENT PRIMARY KEY,
+  `namespace_id` bigint NOT NULL,
+  `enabled` tinyint NOT NULL DEFAULT 0,
+  `max_severity` ENUM ('None', 'Low', 'Medium', 'High', 'Critical') NOT NULL DEFAULT 'Critical',
+  `block_unscanned` tinyint NOT NULL DEFAULT 0,
+  `exemptions` text,
+  `created_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `updated_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `deleted_at` bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY (`namespace_id`) REFERENCES `namespaces` (`id`),
+  CONSTRAINT `vulnerability_policies_unique_with_ns` UNIQUE (`namespace_id`, `deleted_at`)
+);
+
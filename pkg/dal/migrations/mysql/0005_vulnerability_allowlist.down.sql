@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS `vulnerability_allowlists`;
+
+DELETE FROM `webhook_logs`
+WHERE `resource_type` = 'VulnerabilityAllowlist'
+  OR `action` = 'Expired';
+
+ALTER TABLE `webhook_logs`
+  MODIFY COLUMN `resource_type` ENUM ('Webhook', 'Namespace', 'Repository', 'Tag', 'Artifact', 'Member', 'DaemonTaskGcRepositoryRule', 'DaemonTaskGcTagRule', 'DaemonTaskGcArtifactRule', 'DaemonTaskGcBlobRule', 'DaemonTaskGcRepositoryRunner', 'DaemonTaskGcTagRunner', 'DaemonTaskGcArtifactRunner', 'DaemonTaskGcBlobRunner') NOT NULL,
+  MODIFY COLUMN `action` ENUM ('Create', 'Update', 'Delete', 'Add', 'Remove', 'Ping', 'Started', 'Finished') NOT NULL;
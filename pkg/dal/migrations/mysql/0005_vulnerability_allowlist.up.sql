@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS `vulnerability_allowlists` (
+  `id` bigint AUTO_INCREMENT PRIMARY KEY,
+  `namespace_id` bigint,
+  `repository_id` bigint,
+  `user_id` bigint NOT NULL,
+  `vulnerability_id` varchar(64) NOT NULL,
+  `package` varchar(256),
+  `justification` varchar(512) NOT NULL,
+  `expires_at` bigint,
+  `created_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `updated_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `deleted_at` bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY (`namespace_id`) REFERENCES `namespaces` (`id`),
+  FOREIGN KEY (`repository_id`) REFERENCES `repositories` (`id`),
+  FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
+  INDEX `vulnerability_allowlists_idx_expires_at` (`expires_at`)
+);
+
+ALTER TABLE `webhook_logs`
+  MODIFY COLUMN `resource_type` ENUM ('Webhook', 'Namespace', 'Repository', 'Tag', 'Artifact', 'Member', 'DaemonTaskGcRepositoryRule', 'DaemonTaskGcTagRule', 'DaemonTaskGcArtifactRule', 'DaemonTaskGcBlobRule', 'DaemonTaskGcRepositoryRunner', 'DaemonTaskGcTagRunner', 'DaemonTaskGcArtifactRunner', 'DaemonTaskGcBlobRunner', 'VulnerabilityAllowlist') NOT NULL,
+  MODIFY COLUMN `action` ENUM ('Create', 'Update', 'Delete', 'Add', 'Remove', 'Ping', 'Started', 'Finished', 'Expired') NOT NULL;
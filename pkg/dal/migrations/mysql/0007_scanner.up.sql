@@ -0,0 +1,5 @@
+ALTER TABLE `namespaces`
+  ADD COLUMN `scanner` ENUM ('trivy', 'grype', 'harbor');
+
+ALTER TABLE `artifact_vulnerabilities`
+  ADD COLUMN `scanner` ENUM ('trivy', 'grype', 'harbor') NOT NULL DEFAULT 'trivy';
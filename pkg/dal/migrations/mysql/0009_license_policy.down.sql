@@ -0,0 +1,14 @@
+DROP TABLE IF EXISTS `license_policies`;
+
+ALTER TABLE `artifact_packages`
+  DROP COLUMN `licenses`;
+
+ALTER TABLE `artifact_sboms`
+  DROP COLUMN `license_status`,
+  DROP COLUMN `license_result`;
+
+DELETE FROM `webhook_logs`
+WHERE `action` = 'Violated';
+
+ALTER TABLE `webhook_logs`
+  MODIFY COLUMN `action` ENUM ('Create', 'Update', 'Delete', 'Add', 'Remove', 'Ping', 'Started', 'Finished', 'Expired') NOT NULL;
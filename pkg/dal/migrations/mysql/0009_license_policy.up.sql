@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS `license_policies` (
+  `id` bigint AUTO_INCREMENT PRIMARY KEY,
+  `namespace_id` bigint NOT NULL,
+  `enabled` tinyint NOT NULL DEFAULT 0,
+  `block_pull` tinyint NOT NULL DEFAULT 0,
+  `warn_unknown` tinyint NOT NULL DEFAULT 0,
+  `denied_licenses` text,
+  `allowed_licenses` text,
+  `exemptions` text,
+  `created_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `updated_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `deleted_at` bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY (`namespace_id`) REFERENCES `namespaces` (`id`),
+  CONSTRAINT `license_policies_unique_with_ns` UNIQUE (`namespace_id`, `deleted_at`)
+);
+
+ALTER TABLE `artifact_packages`
+  ADD COLUMN `licenses` text;
+
+ALTER TABLE `artifact_sboms`
+  ADD COLUMN `license_status` ENUM ('Passed', 'Warning', 'Violated'),
+  ADD COLUMN `license_result` MEDIUMBLOB;
+
+ALTER TABLE `webhook_logs`
+  MODIFY COLUMN `action` ENUM ('Create', 'Update', 'Delete', 'Add', 'Remove', 'Ping', 'Started', 'Finished', 'Expired', 'Violated') NOT NULL;
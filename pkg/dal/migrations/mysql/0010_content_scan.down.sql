@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS `artifact_secrets`;
+
+DROP TABLE IF EXISTS `artifact_misconfigurations`;
+
+ALTER TABLE `namespaces`
+  DROP COLUMN `secret_scan`,
+  DROP COLUMN `misconfiguration_scan`;
@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS `artifact_secrets` (
+  `id` bigint AUTO_INCREMENT PRIMARY KEY,
+  `artifact_id` bigint NOT NULL,
+  `raw` MEDIUMBLOB,
+  `result` MEDIUMBLOB,
+  `status` varchar(64) NOT NULL,
+  `stdout` MEDIUMBLOB,
+  `stderr` MEDIUMBLOB,
+  `message` varchar(256),
+  `created_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `updated_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `deleted_at` bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY (`artifact_id`) REFERENCES `artifacts` (`id`),
+  CONSTRAINT `artifact_secret_unique_with_artifact` UNIQUE (`artifact_id`, `deleted_at`)
+);
+
+CREATE TABLE IF NOT EXISTS `artifact_misconfigurations` (
+  `id` bigint AUTO_INCREMENT PRIMARY KEY,
+  `artifact_id` bigint NOT NULL,
+  `raw` MEDIUMBLOB,
+  `result` MEDIUMBLOB,
+  `status` varchar(64) NOT NULL,
+  `stdout` MEDIUMBLOB,
+  `stderr` MEDIUMBLOB,
+  `message` varchar(256),
+  `created_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `updated_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `deleted_at` bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY (`artifact_id`) REFERENCES `artifacts` (`id`),
+  CONSTRAINT `artifact_misconfiguration_unique_with_artifact` UNIQUE (`artifact_id`, `deleted_at`)
+);
+
+ALTER TABLE `namespaces`
+  ADD COLUMN `secret_scan` tinyint NOT NULL DEFAULT 0,
+  ADD COLUMN `misconfiguration_scan` tinyint NOT NULL DEFAULT 0;
@@ -0,0 +1,6 @@
+ALTER TABLE `signature_policies`
+  DROP COLUMN `notation_roots`,
+  DROP COLUMN `notation_identities`;
+
+ALTER TABLE `builders`
+  DROP COLUMN `signing_type`;
@@ -0,0 +1,6 @@
+ALTER TABLE `signature_policies`
+  ADD COLUMN `notation_roots` text,
+  ADD COLUMN `notation_identities` BLOB;
+
+ALTER TABLE `builders`
+  ADD COLUMN `signing_type` varchar(16) NOT NULL DEFAULT 'cosign';
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS `signing_policies` (
+  `id` bigint AUTO_INCREMENT PRIMARY KEY,
+  `namespace_id` bigint NOT NULL,
+  `enabled` tinyint NOT NULL DEFAULT 0,
+  `tag_pattern` varchar(256),
+  `created_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `updated_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `deleted_at` bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY (`namespace_id`) REFERENCES `namespaces` (`id`),
+  CONSTRAINT `signing_policies_unique_with_ns` UNIQUE (`namespace_id`, `deleted_at`)
+);
@@ -0,0 +1,43 @@
+DELETE FROM `daemon_gc_tag_records`
+WHERE `status` = 'Planned';
+
+ALTER TABLE `daemon_gc_tag_records`
+  MODIFY COLUMN `status` ENUM ('Success', 'Failed') NOT NULL DEFAULT 'Success',
+  DROP COLUMN `size`;
+
+ALTER TABLE `daemon_gc_tag_runners`
+  DROP COLUMN `dry_run`,
+  DROP COLUMN `reclaimable_size`;
+
+DELETE FROM `daemon_gc_repository_records`
+WHERE `status` = 'Planned';
+
+ALTER TABLE `daemon_gc_repository_records`
+  MODIFY COLUMN `status` ENUM ('Success', 'Failed') NOT NULL DEFAULT 'Success',
+  DROP COLUMN `size`;
+
+ALTER TABLE `daemon_gc_repository_runners`
+  DROP COLUMN `dry_run`,
+  DROP COLUMN `reclaimable_size`;
+
+DELETE FROM `daemon_gc_artifact_records`
+WHERE `status` = 'Planned';
+
+ALTER TABLE `daemon_gc_artifact_records`
+  MODIFY COLUMN `status` ENUM ('Success', 'Failed') NOT NULL DEFAULT 'Success',
+  DROP COLUMN `size`;
+
+ALTER TABLE `daemon_gc_artifact_runners`
+  DROP COLUMN `dry_run`,
+  DROP COLUMN `reclaimable_size`;
+
+DELETE FROM `daemon_gc_blob_records`
+WHERE `status` = 'Planned';
+
+ALTER TABLE `daemon_gc_blob_records`
+  MODIFY COLUMN `status` ENUM ('Success', 'Failed') NOT NULL DEFAULT 'Success',
+  DROP COLUMN `size`;
+
+ALTER TABLE `daemon_gc_blob_runners`
+  DROP COLUMN `dry_run`,
+  DROP COLUMN `reclaimable_size`;
@@ -0,0 +1,31 @@
+ALTER TABLE `daemon_gc_tag_runners`
+  ADD COLUMN `dry_run` tinyint NOT NULL DEFAULT 0,
+  ADD COLUMN `reclaimable_size` bigint;
+
+ALTER TABLE `daemon_gc_tag_records`
+  MODIFY COLUMN `status` ENUM ('Success', 'Failed', 'Planned') NOT NULL DEFAULT 'Success',
+  ADD COLUMN `size` bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE `daemon_gc_repository_runners`
+  ADD COLUMN `dry_run` tinyint NOT NULL DEFAULT 0,
+  ADD COLUMN `reclaimable_size` bigint;
+
+ALTER TABLE `daemon_gc_repository_records`
+  MODIFY COLUMN `status` ENUM ('Success', 'Failed', 'Planned') NOT NULL DEFAULT 'Success',
+  ADD COLUMN `size` bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE `daemon_gc_artifact_runners`
+  ADD COLUMN `dry_run` tinyint NOT NULL DEFAULT 0,
+  ADD COLUMN `reclaimable_size` bigint;
+
+ALTER TABLE `daemon_gc_artifact_records`
+  MODIFY COLUMN `status` ENUM ('Success', 'Failed', 'Planned') NOT NULL DEFAULT 'Success',
+  ADD COLUMN `size` bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE `daemon_gc_blob_runners`
+  ADD COLUMN `dry_run` tinyint NOT NULL DEFAULT 0,
+  ADD COLUMN `reclaimable_size` bigint;
+
+ALTER TABLE `daemon_gc_blob_records`
+  MODIFY COLUMN `status` ENUM ('Success', 'Failed', 'Planned') NOT NULL DEFAULT 'Success',
+  ADD COLUMN `size` bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+ALTER TABLE `daemon_gc_tag_rules`
+  DROP COLUMN `retention_rules`;
@@ -0,0 +1,2 @@
+ALTER TABLE `daemon_gc_artifact_rules`
+  DROP COLUMN `referrer_retention_day`;
@@ -0,0 +1,2 @@
+ALTER TABLE `daemon_gc_artifact_rules`
+  ADD COLUMN `referrer_retention_day` int NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+ALTER TABLE `blobs`
+  ADD COLUMN `last_check` bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,18 @@
+DROP TABLE IF EXISTS `daemon_gc_pipeline_runners`;
+
+DROP TABLE IF EXISTS `daemon_gc_pipeline_rules`;
+
+ALTER TABLE `daemon_gc_repository_records`
+  DROP COLUMN `namespace_id`;
+
+ALTER TABLE `daemon_gc_tag_records`
+  DROP COLUMN `namespace_id`;
+
+ALTER TABLE `daemon_gc_artifact_records`
+  DROP COLUMN `namespace_id`;
+
+DELETE FROM `webhook_logs`
+WHERE `resource_type` = 'DaemonTaskGcPipelineRunner';
+
+ALTER TABLE `webhook_logs`
+  MODIFY COLUMN `resource_type` ENUM ('Webhook', 'Namespace', 'Repository', 'Tag', 'Artifact', 'Member', 'DaemonTaskGcRepositoryRule', 'DaemonTaskGcTagRule', 'DaemonTaskGcArtifactRule', 'DaemonTaskGcBlobRule', 'DaemonTaskGcRepositoryRunner', 'DaemonTaskGcTagRunner', 'DaemonTaskGcArtifactRunner', 'DaemonTaskGcBlobRunner', 'VulnerabilityAllowlist') NOT NULL;
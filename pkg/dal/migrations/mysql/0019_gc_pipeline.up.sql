@@ -0,0 +1,44 @@
+CREATE TABLE IF NOT EXISTS `daemon_gc_pipeline_rules` (
+  `id` bigint AUTO_INCREMENT PRIMARY KEY,
+  `cron_enabled` tinyint NOT NULL DEFAULT 0,
+  `cron_rule` varchar(30),
+  `cron_next_trigger` bigint,
+  `created_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `updated_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `deleted_at` bigint NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS `daemon_gc_pipeline_runners` (
+  `id` bigint AUTO_INCREMENT PRIMARY KEY,
+  `rule_id` bigint NOT NULL,
+  `message` LONGBLOB,
+  `status` ENUM ('Success', 'Failed', 'Pending', 'Doing') NOT NULL DEFAULT 'Pending',
+  `stage` varchar(32),
+  `operate_type` ENUM ('Automatic', 'Manual') NOT NULL DEFAULT 'Automatic',
+  `operate_user_id` bigint,
+  `started_at` bigint,
+  `ended_at` bigint,
+  `duration` bigint,
+  `repository_runner_id` bigint,
+  `tag_runner_id` bigint,
+  `artifact_runner_id` bigint,
+  `blob_runner_id` bigint,
+  `report` LONGBLOB,
+  `created_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `updated_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `deleted_at` bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY (`rule_id`) REFERENCES `daemon_gc_pipeline_rules` (`id`),
+  FOREIGN KEY (`operate_user_id`) REFERENCES `users` (`id`)
+);
+
+ALTER TABLE `daemon_gc_repository_records`
+  ADD COLUMN `namespace_id` bigint;
+
+ALTER TABLE `daemon_gc_tag_records`
+  ADD COLUMN `namespace_id` bigint;
+
+ALTER TABLE `daemon_gc_artifact_records`
+  ADD COLUMN `namespace_id` bigint;
+
+ALTER TABLE `webhook_logs`
+  MODIFY COLUMN `resource_type` ENUM ('Webhook', 'Namespace', 'Repository', 'Tag', 'Artifact', 'Member', 'DaemonTaskGcRepositoryRule', 'DaemonTaskGcTagRule', 'DaemonTaskGcArtifactRule', 'DaemonTaskGcBlobRule', 'DaemonTaskGcRepositoryRunner', 'DaemonTaskGcTagRunner', 'DaemonTaskGcArtifactRunner', 'DaemonTaskGcBlobRunner', 'VulnerabilityAllowlist', 'DaemonTaskGcPipelineRunner') NOT NULL;
@@ -0,0 +1,14 @@
+DROP TABLE IF EXISTS `daemon_gc_blob_upload_records`;
+
+DROP TABLE IF EXISTS `daemon_gc_blob_upload_runners`;
+
+DROP TABLE IF EXISTS `daemon_gc_blob_upload_rules`;
+
+ALTER TABLE `daemon_gc_pipeline_runners`
+  DROP COLUMN `blob_upload_runner_id`;
+
+DELETE FROM `webhook_logs`
+WHERE `resource_type` IN ('DaemonTaskGcBlobUploadRule', 'DaemonTaskGcBlobUploadRunner');
+
+ALTER TABLE `webhook_logs`
+  MODIFY COLUMN `resource_type` ENUM ('Webhook', 'Namespace', 'Repository', 'Tag', 'Artifact', 'Member', 'DaemonTaskGcRepositoryRule', 'DaemonTaskGcTagRule', 'DaemonTaskGcArtifactRule', 'DaemonTaskGcBlobRule', 'DaemonTaskGcRepositoryRunner', 'DaemonTaskGcTagRunner', 'DaemonTaskGcArtifactRunner', 'DaemonTaskGcBlobRunner', 'VulnerabilityAllowlist', 'DaemonTaskGcPipelineRunner') NOT NULL;
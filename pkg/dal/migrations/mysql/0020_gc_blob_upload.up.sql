@@ -0,0 +1,52 @@
+CREATE TABLE IF NOT EXISTS `daemon_gc_blob_upload_rules` (
+  `id` bigint AUTO_INCREMENT PRIMARY KEY,
+  `is_running` tinyint NOT NULL DEFAULT 0,
+  `retention_hour` int NOT NULL DEFAULT 24,
+  `cron_enabled` tinyint NOT NULL DEFAULT 0,
+  `cron_rule` varchar(30),
+  `cron_next_trigger` bigint,
+  `created_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `updated_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `deleted_at` bigint NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS `daemon_gc_blob_upload_runners` (
+  `id` bigint AUTO_INCREMENT PRIMARY KEY,
+  `rule_id` bigint NOT NULL,
+  `message` LONGBLOB,
+  `status` ENUM ('Success', 'Failed', 'Pending', 'Doing') NOT NULL DEFAULT 'Pending',
+  `operate_type` ENUM ('Automatic', 'Manual') NOT NULL DEFAULT 'Automatic',
+  `operate_user_id` bigint,
+  `started_at` bigint,
+  `ended_at` bigint,
+  `duration` bigint,
+  `success_count` bigint,
+  `failed_count` bigint,
+  `dry_run` tinyint NOT NULL DEFAULT 0,
+  `reclaimable_size` bigint,
+  `created_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `updated_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `deleted_at` bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY (`rule_id`) REFERENCES `daemon_gc_blob_upload_rules` (`id`),
+  FOREIGN KEY (`operate_user_id`) REFERENCES `users` (`id`)
+);
+
+CREATE TABLE IF NOT EXISTS `daemon_gc_blob_upload_records` (
+  `id` bigint AUTO_INCREMENT PRIMARY KEY,
+  `runner_id` bigint NOT NULL,
+  `upload_id` varchar(256) NOT NULL,
+  `repository` varchar(64) NOT NULL,
+  `status` ENUM ('Success', 'Failed', 'Planned') NOT NULL DEFAULT 'Success',
+  `size` bigint NOT NULL DEFAULT 0,
+  `message` LONGBLOB,
+  `created_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `updated_at` bigint NOT NULL DEFAULT (UNIX_TIMESTAMP (CURRENT_TIMESTAMP()) * 1000),
+  `deleted_at` bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY (`runner_id`) REFERENCES `daemon_gc_blob_upload_runners` (`id`)
+);
+
+ALTER TABLE `daemon_gc_pipeline_runners`
+  ADD COLUMN `blob_upload_runner_id` bigint;
+
+ALTER TABLE `webhook_logs`
+  MODIFY COLUMN `resource_type` ENUM ('Webhook', 'Namespace', 'Repository', 'Tag', 'Artifact', 'Member', 'DaemonTaskGcRepositoryRule', 'DaemonTaskGcTagRule', 'DaemonTaskGcArtifactRule', 'DaemonTaskGcBlobRule', 'DaemonTaskGcRepositoryRunner', 'DaemonTaskGcTagRunner', 'DaemonTaskGcArtifactRunner', 'DaemonTaskGcBlobRunner', 'VulnerabilityAllowlist', 'DaemonTaskGcPipelineRunner', 'DaemonTaskGcBlobUploadRule', 'DaemonTaskGcBlobUploadRunner') NOT NULL;
@@ -0,0 +1,9 @@
+DELETE FROM `audits`
+WHERE `action` = 'Restore'
+  OR `resource_type` = 'Artifact';
+
+ALTER TABLE `audits`
+  MODIFY COLUMN `action` ENUM ('Create', 'Update', 'Delete', 'Pull', 'Push') NOT NULL;
+
+ALTER TABLE `audits`
+  MODIFY COLUMN `resource_type` ENUM ('Namespace', 'Repository', 'Tag', 'Builder', 'Webhook', 'NamespaceMember') NOT NULL;
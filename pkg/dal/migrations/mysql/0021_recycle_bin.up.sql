@@ -0,0 +1,5 @@
+ALTER TABLE `audits`
+  MODIFY COLUMN `action` ENUM ('Create', 'Update', 'Delete', 'Pull', 'Push', 'Restore') NOT NULL;
+
+ALTER TABLE `audits`
+  MODIFY COLUMN `resource_type` ENUM ('Namespace', 'Repository', 'Tag', 'Builder', 'Webhook', 'NamespaceMember', 'Artifact') NOT NULL;
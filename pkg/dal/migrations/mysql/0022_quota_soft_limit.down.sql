@@ -0,0 +1,5 @@
+ALTER TABLE `namespaces`
+  DROP COLUMN `size_soft_limit`;
+
+ALTER TABLE `repositories`
+  DROP COLUMN `size_soft_limit`;
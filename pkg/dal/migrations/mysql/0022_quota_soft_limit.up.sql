@@ -0,0 +1,5 @@
+ALTER TABLE `namespaces`
+  ADD COLUMN `size_soft_limit` bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE `repositories`
+  ADD COLUMN `size_soft_limit` bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,21 @@
+ALTER TABLE `namespaces`
+  DROP COLUMN `quota_thresholds`,
+  DROP COLUMN `size_quota_threshold`,
+  DROP COLUMN `tag_quota_threshold`,
+  DROP COLUMN `repository_quota_threshold`;
+
+DELETE FROM `audits`
+WHERE `resource_type` = 'Quota';
+
+ALTER TABLE `audits`
+  MODIFY COLUMN `resource_type` ENUM ('Namespace', 'Repository', 'Tag', 'Builder', 'Webhook', 'NamespaceMember', 'Artifact') NOT NULL;
+
+DELETE FROM `webhook_logs`
+WHERE `resource_type` = 'Quota'
+  OR `action` = 'Reached';
+
+ALTER TABLE `webhook_logs`
+  MODIFY COLUMN `resource_type` ENUM ('Webhook', 'Namespace', 'Repository', 'Tag', 'Artifact', 'Member', 'DaemonTaskGcRepositoryRule', 'DaemonTaskGcTagRule', 'DaemonTaskGcArtifactRule', 'DaemonTaskGcBlobRule', 'DaemonTaskGcRepositoryRunner', 'DaemonTaskGcTagRunner', 'DaemonTaskGcArtifactRunner', 'DaemonTaskGcBlobRunner', 'VulnerabilityAllowlist', 'DaemonTaskGcPipelineRunner', 'DaemonTaskGcBlobUploadRule', 'DaemonTaskGcBlobUploadRunner') NOT NULL;
+
+ALTER TABLE `webhook_logs`
+  MODIFY COLUMN `action` ENUM ('Create', 'Update', 'Delete', 'Add', 'Remove', 'Ping', 'Started', 'Finished', 'Expired', 'Violated') NOT NULL;
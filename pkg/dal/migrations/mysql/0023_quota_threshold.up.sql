@@ -0,0 +1,14 @@
+ALTER TABLE `namespaces`
+  ADD COLUMN `quota_thresholds` varchar(64),
+  ADD COLUMN `size_quota_threshold` int NOT NULL DEFAULT 0,
+  ADD COLUMN `tag_quota_threshold` int NOT NULL DEFAULT 0,
+  ADD COLUMN `repository_quota_threshold` int NOT NULL DEFAULT 0;
+
+ALTER TABLE `audits`
+  MODIFY COLUMN `resource_type` ENUM ('Namespace', 'Repository', 'Tag', 'Builder', 'Webhook', 'NamespaceMember', 'Artifact', 'Quota') NOT NULL;
+
+ALTER TABLE `webhook_logs`
+  MODIFY COLUMN `resource_type` ENUM ('Webhook', 'Namespace', 'Repository', 'Tag', 'Artifact', 'Member', 'DaemonTaskGcRepositoryRule', 'DaemonTaskGcTagRule', 'DaemonTaskGcArtifactRule', 'DaemonTaskGcBlobRule', 'DaemonTaskGcRepositoryRunner', 'DaemonTaskGcTagRunner', 'DaemonTaskGcArtifactRunner', 'DaemonTaskGcBlobRunner', 'VulnerabilityAllowlist', 'DaemonTaskGcPipelineRunner', 'DaemonTaskGcBlobUploadRule', 'DaemonTaskGcBlobUploadRunner', 'Quota') NOT NULL;
+
+ALTER TABLE `webhook_logs`
+  MODIFY COLUMN `action` ENUM ('Create', 'Update', 'Delete', 'Add', 'Remove', 'Ping', 'Started', 'Finished', 'Expired', 'Violated', 'Reached') NOT NULL;
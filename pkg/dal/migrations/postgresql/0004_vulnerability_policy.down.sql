@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "vulnerability_policies";
+
+DROP TYPE IF EXISTS vulnerability_severity;
+
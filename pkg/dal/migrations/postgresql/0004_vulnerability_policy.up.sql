@@ -0,0 +1,22 @@
+CREATE TYPE vulnerability_severity AS ENUM (
+  'None',
+  'Low',
+  'Medium',
+  'High',
+  'Critical'
+);
+
+CREATE TABLE IF NOT EXISTS "vulnerability_policies" (
+  "id" bigserial PRIMARY KEY,
+  "namespace_id" bigint NOT NULL,
+  "enabled" smallint NOT NULL DEFAULT 0,
+  "max_severity" vulnerability_severity NOT NULL DEFAULT 'Critical',
+  "block_unscanned" smallint NOT NULL DEFAULT 0,
+  "exemptions" text,
+  "created_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "updated_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "deleted_at" bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY ("namespace_id") REFERENCES "namespaces" ("id"),
+  CONSTRAINT "vulnerability_policies_unique_with_ns" UNIQUE ("namespace_id", "deleted_at")
+);
+
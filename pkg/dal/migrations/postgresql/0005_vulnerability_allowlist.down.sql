@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "vulnerability_allowlists";
+
+-- postgresql does not support removing values from an enum type,
+-- the 'VulnerabilityAllowlist' and 'Expired' values are kept.
+DELETE FROM "webhook_logs"
+WHERE "resource_type" = 'VulnerabilityAllowlist'
+  OR "action" = 'Expired';
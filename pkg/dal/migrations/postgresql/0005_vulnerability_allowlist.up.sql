@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS "vulnerability_allowlists" (
+  "id" bigserial PRIMARY KEY,
+  "namespace_id" bigint,
+  "repository_id" bigint,
+  "user_id" bigint NOT NULL,
+  "vulnerability_id" varchar(64) NOT NULL,
+  "package" varchar(256),
+  "justification" varchar(512) NOT NULL,
+  "expires_at" bigint,
+  "created_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "updated_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "deleted_at" bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY ("namespace_id") REFERENCES "namespaces" ("id"),
+  FOREIGN KEY ("repository_id") REFERENCES "repositories" ("id"),
+  FOREIGN KEY ("user_id") REFERENCES "users" ("id")
+);
+
+CREATE INDEX "vulnerability_allowlists_idx_expires_at" ON "vulnerability_allowlists" ("expires_at");
+
+ALTER TYPE webhook_resource_type ADD VALUE IF NOT EXISTS 'VulnerabilityAllowlist';
+
+ALTER TYPE webhook_action ADD VALUE IF NOT EXISTS 'Expired';
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS "vulnerability_rescan_policies" (
+  "id" bigserial PRIMARY KEY,
+  "namespace_id" bigint,
+  "enabled" smallint NOT NULL DEFAULT 0,
+  "cron_rule" varchar(30) NOT NULL,
+  "pulled_within" bigint NOT NULL DEFAULT 30,
+  "cron_next_trigger" bigint,
+  "created_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "updated_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "deleted_at" bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY ("namespace_id") REFERENCES "namespaces" ("id"),
+  CONSTRAINT "vulnerability_rescan_policies_unique_with_ns" UNIQUE ("namespace_id", "deleted_at")
+);
+
+CREATE INDEX "vulnerability_rescan_policies_idx_cron_next_trigger" ON "vulnerability_rescan_policies" ("cron_next_trigger");
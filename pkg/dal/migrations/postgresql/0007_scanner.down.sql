@@ -0,0 +1,7 @@
+ALTER TABLE "artifact_vulnerabilities"
+  DROP COLUMN "scanner";
+
+ALTER TABLE "namespaces"
+  DROP COLUMN "scanner";
+
+DROP TYPE IF EXISTS scanner_type;
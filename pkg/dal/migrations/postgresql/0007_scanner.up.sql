@@ -0,0 +1,11 @@
+CREATE TYPE scanner_type AS ENUM (
+  'trivy',
+  'grype',
+  'harbor'
+);
+
+ALTER TABLE "namespaces"
+  ADD COLUMN "scanner" scanner_type;
+
+ALTER TABLE "artifact_vulnerabilities"
+  ADD COLUMN "scanner" scanner_type NOT NULL DEFAULT 'trivy';
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS "artifact_packages" (
+  "id" bigserial PRIMARY KEY,
+  "namespace_id" bigint NOT NULL,
+  "repository_id" bigint NOT NULL,
+  "artifact_id" bigint NOT NULL,
+  "name" varchar(256) NOT NULL,
+  "version" varchar(128) NOT NULL,
+  "type" varchar(64) NOT NULL,
+  "purl" varchar(512) NOT NULL DEFAULT '',
+  "created_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "updated_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "deleted_at" bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY ("namespace_id") REFERENCES "namespaces" ("id"),
+  FOREIGN KEY ("repository_id") REFERENCES "repositories" ("id"),
+  FOREIGN KEY ("artifact_id") REFERENCES "artifacts" ("id")
+);
+
+CREATE INDEX "artifact_packages_idx_name" ON "artifact_packages" ("name");
+
+CREATE INDEX "artifact_packages_idx_artifact_id" ON "artifact_packages" ("artifact_id");
@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS "license_policies";
+
+ALTER TABLE "artifact_packages"
+  DROP COLUMN "licenses";
+
+ALTER TABLE "artifact_sboms"
+  DROP COLUMN "license_status",
+  DROP COLUMN "license_result";
+
+DROP TYPE IF EXISTS license_check_status;
+
+-- postgresql does not support removing values from an enum type,
+-- the 'Violated' value is kept.
+DELETE FROM "webhook_logs"
+WHERE "action" = 'Violated';
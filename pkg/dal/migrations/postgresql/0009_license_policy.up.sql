@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS "license_policies" (
+  "id" bigserial PRIMARY KEY,
+  "namespace_id" bigint NOT NULL,
+  "enabled" smallint NOT NULL DEFAULT 0,
+  "block_pull" smallint NOT NULL DEFAULT 0,
+  "warn_unknown" smallint NOT NULL DEFAULT 0,
+  "denied_licenses" text,
+  "allowed_licenses" text,
+  "exemptions" text,
+  "created_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "updated_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "deleted_at" bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY ("namespace_id") REFERENCES "namespaces" ("id"),
+  CONSTRAINT "license_policies_unique_with_ns" UNIQUE ("namespace_id", "deleted_at")
+);
+
+CREATE TYPE license_check_status AS ENUM (
+  'Passed',
+  'Warning',
+  'Violated'
+);
+
+ALTER TABLE "artifact_packages"
+  ADD COLUMN "licenses" text;
+
+ALTER TABLE "artifact_sboms"
+  ADD COLUMN "license_status" license_check_status,
+  ADD COLUMN "license_result" bytea;
+
+ALTER TYPE webhook_action ADD VALUE IF NOT EXISTS 'Violated';
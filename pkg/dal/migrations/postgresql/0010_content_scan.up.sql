@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS "artifact_secrets" (
+  "id" bigserial PRIMARY KEY,
+  "artifact_id" bigint NOT NULL,
+  "raw" bytea,
+  "result" bytea,
+  "status" daemon_status NOT NULL,
+  "stdout" bytea,
+  "stderr" bytea,
+  "message" varchar(256),
+  "created_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "updated_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "deleted_at" bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY ("artifact_id") REFERENCES "artifacts" ("id"),
+  CONSTRAINT "artifact_secret_unique_with_artifact" UNIQUE ("artifact_id", "deleted_at")
+);
+
+CREATE TABLE IF NOT EXISTS "artifact_misconfigurations" (
+  "id" bigserial PRIMARY KEY,
+  "artifact_id" bigint NOT NULL,
+  "raw" bytea,
+  "result" bytea,
+  "status" daemon_status NOT NULL,
+  "stdout" bytea,
+  "stderr" bytea,
+  "message" varchar(256),
+  "created_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "updated_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "deleted_at" bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY ("artifact_id") REFERENCES "artifacts" ("id"),
+  CONSTRAINT "artifact_misconfiguration_unique_with_artifact" UNIQUE ("artifact_id", "deleted_at")
+);
+
+ALTER TABLE "namespaces"
+  ADD COLUMN "secret_scan" smallint NOT NULL DEFAULT 0,
+  ADD COLUMN "misconfiguration_scan" smallint NOT NULL DEFAULT 0;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS "signature_policies" (
+  "id" bigserial PRIMARY KEY,
+  "namespace_id" bigint NOT NULL,
+  "enabled" smallint NOT NULL DEFAULT 0,
+  "public_keys" text,
+  "keyless_identities" bytea,
+  "keyless_roots" text,
+  "exemptions" text,
+  "created_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "updated_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "deleted_at" bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY ("namespace_id") REFERENCES "namespaces" ("id"),
+  CONSTRAINT "signature_policies_unique_with_ns" UNIQUE ("namespace_id", "deleted_at")
+);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS "signing_keys" (
+  "id" bigserial PRIMARY KEY,
+  "namespace_id" bigint NOT NULL,
+  "fingerprint" varchar(64) NOT NULL,
+  "public_key" text NOT NULL,
+  "private_key" text NOT NULL,
+  "imported" smallint NOT NULL DEFAULT 0,
+  "active" smallint NOT NULL DEFAULT 0,
+  "retired_at" bigint,
+  "created_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "updated_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "deleted_at" bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY ("namespace_id") REFERENCES "namespaces" ("id")
+);
+
+CREATE INDEX "signing_keys_idx_namespace_id" ON "signing_keys" ("namespace_id");
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS "signing_policies" (
+  "id" bigserial PRIMARY KEY,
+  "namespace_id" bigint NOT NULL,
+  "enabled" smallint NOT NULL DEFAULT 0,
+  "tag_pattern" varchar(256),
+  "created_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "updated_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "deleted_at" bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY ("namespace_id") REFERENCES "namespaces" ("id"),
+  CONSTRAINT "signing_policies_unique_with_ns" UNIQUE ("namespace_id", "deleted_at")
+);
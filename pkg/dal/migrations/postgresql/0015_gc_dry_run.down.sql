@@ -0,0 +1,41 @@
+-- the value 'Planned' of the gc_record_status type can not be dropped, it's kept after downgrade
+
+DELETE FROM "daemon_gc_tag_records"
+WHERE "status" = 'Planned';
+
+ALTER TABLE "daemon_gc_tag_records"
+  DROP COLUMN "size";
+
+ALTER TABLE "daemon_gc_tag_runners"
+  DROP COLUMN "dry_run",
+  DROP COLUMN "reclaimable_size";
+
+DELETE FROM "daemon_gc_repository_records"
+WHERE "status" = 'Planned';
+
+ALTER TABLE "daemon_gc_repository_records"
+  DROP COLUMN "size";
+
+ALTER TABLE "daemon_gc_repository_runners"
+  DROP COLUMN "dry_run",
+  DROP COLUMN "reclaimable_size";
+
+DELETE FROM "daemon_gc_artifact_records"
+WHERE "status" = 'Planned';
+
+ALTER TABLE "daemon_gc_artifact_records"
+  DROP COLUMN "size";
+
+ALTER TABLE "daemon_gc_artifact_runners"
+  DROP COLUMN "dry_run",
+  DROP COLUMN "reclaimable_size";
+
+DELETE FROM "daemon_gc_blob_records"
+WHERE "status" = 'Planned';
+
+ALTER TABLE "daemon_gc_blob_records"
+  DROP COLUMN "size";
+
+ALTER TABLE "daemon_gc_blob_runners"
+  DROP COLUMN "dry_run",
+  DROP COLUMN "reclaimable_size";
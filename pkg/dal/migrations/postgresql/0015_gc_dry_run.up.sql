@@ -0,0 +1,29 @@
+ALTER TYPE gc_record_status ADD VALUE IF NOT EXISTS 'Planned';
+
+ALTER TABLE "daemon_gc_tag_runners"
+  ADD COLUMN "dry_run" smallint NOT NULL DEFAULT 0,
+  ADD COLUMN "reclaimable_size" bigint;
+
+ALTER TABLE "daemon_gc_tag_records"
+  ADD COLUMN "size" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "daemon_gc_repository_runners"
+  ADD COLUMN "dry_run" smallint NOT NULL DEFAULT 0,
+  ADD COLUMN "reclaimable_size" bigint;
+
+ALTER TABLE "daemon_gc_repository_records"
+  ADD COLUMN "size" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "daemon_gc_artifact_runners"
+  ADD COLUMN "dry_run" smallint NOT NULL DEFAULT 0,
+  ADD COLUMN "reclaimable_size" bigint;
+
+ALTER TABLE "daemon_gc_artifact_records"
+  ADD COLUMN "size" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "daemon_gc_blob_runners"
+  ADD COLUMN "dry_run" smallint NOT NULL DEFAULT 0,
+  ADD COLUMN "reclaimable_size" bigint;
+
+ALTER TABLE "daemon_gc_blob_records"
+  ADD COLUMN "size" bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+ALTER TABLE "blobs"
+  DROP COLUMN "last_check";
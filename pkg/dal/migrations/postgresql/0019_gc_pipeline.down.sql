@@ -0,0 +1,17 @@
+DROP TABLE IF EXISTS "daemon_gc_pipeline_runners";
+
+DROP TABLE IF EXISTS "daemon_gc_pipeline_rules";
+
+ALTER TABLE "daemon_gc_repository_records"
+  DROP COLUMN "namespace_id";
+
+ALTER TABLE "daemon_gc_tag_records"
+  DROP COLUMN "namespace_id";
+
+ALTER TABLE "daemon_gc_artifact_records"
+  DROP COLUMN "namespace_id";
+
+-- postgresql does not support removing values from an enum type,
+-- the 'DaemonTaskGcPipelineRunner' value is kept.
+DELETE FROM "webhook_logs"
+WHERE "resource_type" = 'DaemonTaskGcPipelineRunner';
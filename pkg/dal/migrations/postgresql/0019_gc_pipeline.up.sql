@@ -0,0 +1,43 @@
+CREATE TABLE IF NOT EXISTS "daemon_gc_pipeline_rules" (
+  "id" bigserial PRIMARY KEY,
+  "cron_enabled" smallint NOT NULL DEFAULT 0,
+  "cron_rule" varchar(30),
+  "cron_next_trigger" bigint,
+  "created_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "updated_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "deleted_at" bigint NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS "daemon_gc_pipeline_runners" (
+  "id" bigserial PRIMARY KEY,
+  "rule_id" bigint NOT NULL,
+  "message" bytea,
+  "status" daemon_status NOT NULL DEFAULT 'Pending',
+  "stage" varchar(32),
+  "operate_type" operate_type NOT NULL DEFAULT 'Automatic',
+  "operate_user_id" bigint,
+  "started_at" bigint,
+  "ended_at" bigint,
+  "duration" bigint,
+  "repository_runner_id" bigint,
+  "tag_runner_id" bigint,
+  "artifact_runner_id" bigint,
+  "blob_runner_id" bigint,
+  "report" bytea,
+  "created_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "updated_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "deleted_at" bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY ("rule_id") REFERENCES "daemon_gc_pipeline_rules" ("id"),
+  FOREIGN KEY ("operate_user_id") REFERENCES "users" ("id")
+);
+
+ALTER TABLE "daemon_gc_repository_records"
+  ADD COLUMN "namespace_id" bigint;
+
+ALTER TABLE "daemon_gc_tag_records"
+  ADD COLUMN "namespace_id" bigint;
+
+ALTER TABLE "daemon_gc_artifact_records"
+  ADD COLUMN "namespace_id" bigint;
+
+ALTER TYPE webhook_resource_type ADD VALUE IF NOT EXISTS 'DaemonTaskGcPipelineRunner';
@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS "daemon_gc_blob_upload_records";
+
+DROP TABLE IF EXISTS "daemon_gc_blob_upload_runners";
+
+DROP TABLE IF EXISTS "daemon_gc_blob_upload_rules";
+
+ALTER TABLE "daemon_gc_pipeline_runners"
+  DROP COLUMN "blob_upload_runner_id";
+
+-- postgresql does not support removing values from an enum type,
+-- the 'DaemonTaskGcBlobUploadRule' and 'DaemonTaskGcBlobUploadRunner' values are kept.
+DELETE FROM "webhook_logs"
+WHERE "resource_type" IN ('DaemonTaskGcBlobUploadRule', 'DaemonTaskGcBlobUploadRunner');
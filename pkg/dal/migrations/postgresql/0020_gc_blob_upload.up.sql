@@ -0,0 +1,53 @@
+CREATE TABLE IF NOT EXISTS "daemon_gc_blob_upload_rules" (
+  "id" bigserial PRIMARY KEY,
+  "is_running" smallint NOT NULL DEFAULT 0,
+  "retention_hour" integer NOT NULL DEFAULT 24,
+  "cron_enabled" smallint NOT NULL DEFAULT 0,
+  "cron_rule" varchar(30),
+  "cron_next_trigger" bigint,
+  "created_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "updated_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "deleted_at" bigint NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS "daemon_gc_blob_upload_runners" (
+  "id" bigserial PRIMARY KEY,
+  "rule_id" bigint NOT NULL,
+  "message" bytea,
+  "status" daemon_status NOT NULL DEFAULT 'Pending',
+  "operate_type" operate_type NOT NULL DEFAULT 'Automatic',
+  "operate_user_id" bigint,
+  "started_at" bigint,
+  "ended_at" bigint,
+  "duration" bigint,
+  "success_count" bigint,
+  "failed_count" bigint,
+  "dry_run" smallint NOT NULL DEFAULT 0,
+  "reclaimable_size" bigint,
+  "created_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "updated_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "deleted_at" bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY ("rule_id") REFERENCES "daemon_gc_blob_upload_rules" ("id"),
+  FOREIGN KEY ("operate_user_id") REFERENCES "users" ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "daemon_gc_blob_upload_records" (
+  "id" bigserial PRIMARY KEY,
+  "runner_id" bigint NOT NULL,
+  "upload_id" varchar(256) NOT NULL,
+  "repository" varchar(64) NOT NULL,
+  "status" gc_record_status NOT NULL DEFAULT 'Success',
+  "size" bigint NOT NULL DEFAULT 0,
+  "message" bytea,
+  "created_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "updated_at" bigint NOT NULL DEFAULT ((EXTRACT(EPOCH FROM CURRENT_TIMESTAMP) * 1000)::bigint),
+  "deleted_at" bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY ("runner_id") REFERENCES "daemon_gc_blob_upload_runners" ("id")
+);
+
+ALTER TABLE "daemon_gc_pipeline_runners"
+  ADD COLUMN "blob_upload_runner_id" bigint;
+
+ALTER TYPE webhook_resource_type ADD VALUE IF NOT EXISTS 'DaemonTaskGcBlobUploadRule';
+
+ALTER TYPE webhook_resource_type ADD VALUE IF NOT EXISTS 'DaemonTaskGcBlobUploadRunner';
@@ -0,0 +1,5 @@
+-- postgresql does not support removing values from an enum type,
+-- the 'Restore' and 'Artifact' values are kept.
+DELETE FROM "audits"
+WHERE "action" = 'Restore'
+  OR "resource_type" = 'Artifact';
@@ -0,0 +1,3 @@
+ALTER TYPE audit_action ADD VALUE IF NOT EXISTS 'Restore';
+
+ALTER TYPE audit_resource_type ADD VALUE IF NOT EXISTS 'Artifact';
@@ -0,0 +1,14 @@
+ALTER TABLE "namespaces"
+  DROP COLUMN "quota_thresholds",
+  DROP COLUMN "size_quota_threshold",
+  DROP COLUMN "tag_quota_threshold",
+  DROP COLUMN "repository_quota_threshold";
+
+-- postgresql does not support removing values from an enum type,
+-- the 'Quota' and 'Reached' values are kept.
+DELETE FROM "audits"
+WHERE "resource_type" = 'Quota';
+
+DELETE FROM "webhook_logs"
+WHERE "resource_type" = 'Quota'
+  OR "action" = 'Reached';
@@ -0,0 +1,11 @@
+ALTER TABLE "namespaces"
+  ADD COLUMN "quota_thresholds" varchar(64),
+  ADD COLUMN "size_quota_threshold" integer NOT NULL DEFAULT 0,
+  ADD COLUMN "tag_quota_threshold" integer NOT NULL DEFAULT 0,
+  ADD COLUMN "repository_quota_threshold" integer NOT NULL DEFAULT 0;
+
+ALTER TYPE audit_resource_type ADD VALUE IF NOT EXISTS 'Quota';
+
+ALTER TYPE webhook_resource_type ADD VALUE IF NOT EXISTS 'Quota';
+
+ALTER TYPE webhook_action ADD VALUE IF NOT EXISTS 'Reached';
@@ -0,0 +1,2 @@
+ALTER TABLE "artifact_vulnerabilities"
+  DROP COLUMN "scan_status";
@@ -0,0 +1,4 @@
+ALTER TABLE "artifact_vulnerabilities"
+  ADD COLUMN "scan_status" daemon_status NOT NULL DEFAULT 'Pending';
+
+UPDATE "artifact_vulnerabilities" SET "scan_status" = "status";
@@ -0,0 +1,2 @@
+ALTER TABLE "artifacts"
+  DROP COLUMN "artifact_type";
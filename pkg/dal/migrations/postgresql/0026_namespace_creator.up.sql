@@ -0,0 +1,14 @@
+ALTER TABLE "namespaces"
+  ADD COLUMN "creator_id" bigint;
+
+-- the creator of the existing namespaces is the user who created it in the audits
+UPDATE "namespaces" SET "creator_id" = (
+  SELECT "audits"."user_id" FROM "audits"
+  WHERE "audits"."namespace_id" = "namespaces"."id" AND "audits"."action" = 'Create' AND "audits"."resource_type" = 'Namespace'
+  ORDER BY "audits"."id" LIMIT 1
+);
+
+UPDATE "users" SET "namespace_count" = (
+  SELECT COUNT(*) FROM "namespaces"
+  WHERE "namespaces"."creator_id" = "users"."id" AND "namespaces"."deleted_at" = 0
+);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `vulnerability_policies` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `namespace_id` integer NOT NULL,
+  `enabled` integer NOT NULL DEFAULT 0,
+  `max_severity` text CHECK (`max_severity` IN ('None', 'Low', 'Medium', 'High', 'Critical')) NOT NULL DEFAULT 'Critical',
+  `block_unscanned` integer NOT NULL DEFAULT 0,
+  `exemptions` text,
+  `created_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `updated_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  FOREIGN KEY (`namespace_id`) REFERENCES `namespaces` (`id`),
+  CONSTRAINT `vulnerability_policies_unique_with_ns` UNIQUE (`namespace_id`, `deleted_at`)
+);
+
@@ -0,0 +1,51 @@
+CREATE TABLE IF NOT EXISTS `vulnerability_allowlists` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `namespace_id` integer,
+  `repository_id` integer,
+  `user_id` integer NOT NULL,
+  `vulnerability_id` varchar(64) NOT NULL,
+  `package` varchar(256),
+  `justification` varchar(512) NOT NULL,
+  `expires_at` integer,
+  `created_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `updated_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  FOREIGN KEY (`namespace_id`) REFERENCES `namespaces` (`id`),
+  FOREIGN KEY (`repository_id`) REFERENCES `repositories` (`id`),
+  FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+);
+
+CREATE INDEX `vulnerability_allowlists_idx_expires_at` ON `vulnerability_allowlists` (`expires_at`);
+
+-- sqlite does not support altering the check constraint, so we rebuild the webhook_logs table
+CREATE TABLE IF NOT EXISTS `webhook_logs_new` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `webhook_id` integer,
+  `resource_type` text CHECK (`resource_type` IN ('Webhook', 'Namespace', 'Repository', 'Tag', 'Artifact', 'Member', 'DaemonTaskGcRepositoryRule', 'DaemonTaskGcTagRule', 'DaemonTaskGcArtifactRule', 'DaemonTaskGcBlobRule', 'DaemonTaskGcRepositoryRunner', 'DaemonTaskGcTagRunner', 'DaemonTaskGcArtifactRunner', 'DaemonTaskGcBlobRunner', 'VulnerabilityAllowlist')) NOT NULL,
+  `action` text CHECK (`action` IN ('Create', 'Update', 'Delete', 'Add', 'Remove', 'Ping', 'Started', 'Finished', 'Expired')) NOT NULL,
+  `status_code` integer NOT NULL,
+  `req_header` BLOB NOT NULL,
+  `req_body` BLOB NOT NULL,
+  `resp_header` BLOB NOT NULL,
+  `resp_body` BLOB,
+  `created_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `updated_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  FOREIGN KEY (`webhook_id`) REFERENCES `webhooks` (`id`)
+);
+
+INSERT INTO `webhook_logs_new`
+SELECT
+  *
+FROM
+  `webhook_logs`;
+
+DROP TABLE `webhook_logs`;
+
+ALTER TABLE `webhook_logs_new` RENAME TO `webhook_logs`;
+
+CREATE INDEX `webhook_logs_idx_created_at` ON `webhook_logs` (`created_at`);
+
+CREATE INDEX `webhook_logs_idx_updated_at` ON `webhook_logs` (`updated_at`);
+
+CREATE INDEX `webhook_logs_idx_deleted_at` ON `webhook_logs` (`deleted_at`);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS `vulnerability_rescan_policies`;
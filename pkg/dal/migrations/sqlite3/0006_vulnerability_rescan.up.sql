@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS `vulnerability_rescan_policies` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `namespace_id` integer,
+  `enabled` integer NOT NULL DEFAULT 0,
+  `cron_rule` varchar(30) NOT NULL,
+  `pulled_within` integer NOT NULL DEFAULT 30,
+  `cron_next_trigger` integer,
+  `created_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `updated_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  FOREIGN KEY (`namespace_id`) REFERENCES `namespaces` (`id`),
+  CONSTRAINT `vulnerability_rescan_policies_unique_with_ns` UNIQUE (`namespace_id`, `deleted_at`)
+);
+
+CREATE INDEX `vulnerability_rescan_policies_idx_cron_next_trigger` ON `vulnerability_rescan_policies` (`cron_next_trigger`);
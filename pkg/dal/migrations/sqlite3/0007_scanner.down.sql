@@ -0,0 +1,5 @@
+ALTER TABLE `artifact_vulnerabilities`
+  DROP COLUMN `scanner`;
+
+ALTER TABLE `namespaces`
+  DROP COLUMN `scanner`;
@@ -0,0 +1,5 @@
+ALTER TABLE `namespaces`
+  ADD COLUMN `scanner` text CHECK (`scanner` IN ('trivy', 'grype', 'harbor'));
+
+ALTER TABLE `artifact_vulnerabilities`
+  ADD COLUMN `scanner` text CHECK (`scanner` IN ('trivy', 'grype', 'harbor')) NOT NULL DEFAULT 'trivy';
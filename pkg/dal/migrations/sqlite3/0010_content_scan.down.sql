@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS `artifact_secrets`;
+
+DROP TABLE IF EXISTS `artifact_misconfigurations`;
+
+ALTER TABLE `namespaces`
+  DROP COLUMN `secret_scan`;
+
+ALTER TABLE `namespaces`
+  DROP COLUMN `misconfiguration_scan`;
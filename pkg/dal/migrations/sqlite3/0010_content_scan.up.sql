@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS `artifact_secrets` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `artifact_id` integer NOT NULL,
+  `raw` BLOB,
+  `result` BLOB,
+  `status` varchar(64) NOT NULL,
+  `stdout` BLOB,
+  `stderr` BLOB,
+  `message` varchar(256),
+  `created_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `updated_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  FOREIGN KEY (`artifact_id`) REFERENCES `artifacts` (`id`),
+  CONSTRAINT `artifact_secret_unique_with_artifact` UNIQUE (`artifact_id`, `deleted_at`)
+);
+
+CREATE TABLE IF NOT EXISTS `artifact_misconfigurations` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `artifact_id` integer NOT NULL,
+  `raw` BLOB,
+  `result` BLOB,
+  `status` varchar(64) NOT NULL,
+  `stdout` BLOB,
+  `stderr` BLOB,
+  `message` varchar(256),
+  `created_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `updated_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  FOREIGN KEY (`artifact_id`) REFERENCES `artifacts` (`id`),
+  CONSTRAINT `artifact_misconfiguration_unique_with_artifact` UNIQUE (`artifact_id`, `deleted_at`)
+);
+
+ALTER TABLE `namespaces`
+  ADD COLUMN `secret_scan` integer NOT NULL DEFAULT 0;
+
+ALTER TABLE `namespaces`
+  ADD COLUMN `misconfiguration_scan` integer NOT NULL DEFAULT 0;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `signature_policies` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `namespace_id` integer NOT NULL,
+  `enabled` integer NOT NULL DEFAULT 0,
+  `public_keys` text,
+  `keyless_identities` BLOB,
+  `keyless_roots` text,
+  `exemptions` text,
+  `created_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `updated_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  FOREIGN KEY (`namespace_id`) REFERENCES `namespaces` (`id`),
+  CONSTRAINT `signature_policies_unique_with_ns` UNIQUE (`namespace_id`, `deleted_at`)
+);
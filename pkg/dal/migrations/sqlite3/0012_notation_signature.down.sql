@@ -0,0 +1,8 @@
+ALTER TABLE `signature_policies`
+  DROP COLUMN `notation_roots`;
+
+ALTER TABLE `signature_policies`
+  DROP COLUMN `notation_identities`;
+
+ALTER TABLE `builders`
+  DROP COLUMN `signing_type`;
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS `signing_keys` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `namespace_id` integer NOT NULL,
+  `fingerprint` varchar(64) NOT NULL,
+  `public_key` text NOT NULL,
+  `private_key` text NOT NULL,
+  `imported` integer NOT NULL DEFAULT 0,
+  `active` integer NOT NULL DEFAULT 0,
+  `retired_at` integer,
+  `created_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `updated_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  FOREIGN KEY (`namespace_id`) REFERENCES `namespaces` (`id`)
+);
+
+CREATE INDEX `signing_keys_idx_namespace_id` ON `signing_keys` (`namespace_id`);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS `signing_policies` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `namespace_id` integer NOT NULL,
+  `enabled` integer NOT NULL DEFAULT 0,
+  `tag_pattern` varchar(256),
+  `created_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `updated_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  FOREIGN KEY (`namespace_id`) REFERENCES `namespaces` (`id`),
+  CONSTRAINT `signing_policies_unique_with_ns` UNIQUE (`namespace_id`, `deleted_at`)
+);
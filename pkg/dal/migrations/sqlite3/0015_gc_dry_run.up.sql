@@ -0,0 +1,119 @@
+ALTER TABLE `daemon_gc_tag_runners`
+  ADD COLUMN `dry_run` integer NOT NULL DEFAULT 0;
+
+ALTER TABLE `daemon_gc_tag_runners`
+  ADD COLUMN `reclaimable_size` integer;
+
+CREATE TABLE `daemon_gc_tag_records_new` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `runner_id` integer NOT NULL,
+  `tag` varchar(128) NOT NULL,
+  `status` text CHECK (`status` IN ('Success', 'Failed', 'Planned')) NOT NULL DEFAULT 'Success',
+  `size` integer NOT NULL DEFAULT 0,
+  `message` BLOB,
+  `created_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `updated_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  FOREIGN KEY (`runner_id`) REFERENCES `daemon_gc_tag_runners` (`id`)
+);
+
+INSERT INTO `daemon_gc_tag_records_new` (`id`, `runner_id`, `tag`, `status`, `message`, `created_at`, `updated_at`, `deleted_at`)
+SELECT
+  `id`, `runner_id`, `tag`, `status`, `message`, `created_at`, `updated_at`, `deleted_at`
+FROM
+  `daemon_gc_tag_records`;
+
+DROP TABLE `daemon_gc_tag_records`;
+
+ALTER TABLE `daemon_gc_tag_records_new`
+  RENAME TO `daemon_gc_tag_records`;
+
+ALTER TABLE `daemon_gc_repository_runners`
+  ADD COLUMN `dry_run` integer NOT NULL DEFAULT 0;
+
+ALTER TABLE `daemon_gc_repository_runners`
+  ADD COLUMN `reclaimable_size` integer;
+
+CREATE TABLE `daemon_gc_repository_records_new` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `runner_id` integer NOT NULL,
+  `repository` varchar(64) NOT NULL,
+  `status` text CHECK (`status` IN ('Success', 'Failed', 'Planned')) NOT NULL DEFAULT 'Success',
+  `size` integer NOT NULL DEFAULT 0,
+  `message` BLOB,
+  `created_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `updated_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  FOREIGN KEY (`runner_id`) REFERENCES `daemon_gc_repository_runners` (`id`)
+);
+
+INSERT INTO `daemon_gc_repository_records_new` (`id`, `runner_id`, `repository`, `status`, `message`, `created_at`, `updated_at`, `deleted_at`)
+SELECT
+  `id`, `runner_id`, `repository`, `status`, `message`, `created_at`, `updated_at`, `deleted_at`
+FROM
+  `daemon_gc_repository_records`;
+
+DROP TABLE `daemon_gc_repository_records`;
+
+ALTER TABLE `daemon_gc_repository_records_new`
+  RENAME TO `daemon_gc_repository_records`;
+
+ALTER TABLE `daemon_gc_artifact_runners`
+  ADD COLUMN `dry_run` integer NOT NULL DEFAULT 0;
+
+ALTER TABLE `daemon_gc_artifact_runners`
+  ADD COLUMN `reclaimable_size` integer;
+
+CREATE TABLE `daemon_gc_artifact_records_new` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `runner_id` integer NOT NULL,
+  `digest` varchar(256) NOT NULL,
+  `status` text CHECK (`status` IN ('Success', 'Failed', 'Planned')) NOT NULL DEFAULT 'Success',
+  `size` integer NOT NULL DEFAULT 0,
+  `message` BLOB,
+  `created_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `updated_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  FOREIGN KEY (`runner_id`) REFERENCES `daemon_gc_artifact_runners` (`id`)
+);
+
+INSERT INTO `daemon_gc_artifact_records_new` (`id`, `runner_id`, `digest`, `status`, `message`, `created_at`, `updated_at`, `deleted_at`)
+SELECT
+  `id`, `runner_id`, `digest`, `status`, `message`, `created_at`, `updated_at`, `deleted_at`
+FROM
+  `daemon_gc_artifact_records`;
+
+DROP TABLE `daemon_gc_artifact_records`;
+
+ALTER TABLE `daemon_gc_artifact_records_new`
+  RENAME TO `daemon_gc_artifact_records`;
+
+ALTER TABLE `daemon_gc_blob_runners`
+  ADD COLUMN `dry_run` integer NOT NULL DEFAULT 0;
+
+ALTER TABLE `daemon_gc_blob_runners`
+  ADD COLUMN `reclaimable_size` integer;
+
+CREATE TABLE `daemon_gc_blob_records_new` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `runner_id` integer NOT NULL,
+  `digest` varchar(256) NOT NULL,
+  `status` text CHECK (`status` IN ('Success', 'Failed', 'Planned')) NOT NULL DEFAULT 'Success',
+  `size` integer NOT NULL DEFAULT 0,
+  `message` BLOB,
+  `created_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `updated_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  FOREIGN KEY (`runner_id`) REFERENCES `daemon_gc_blob_runners` (`id`)
+);
+
+INSERT INTO `daemon_gc_blob_records_new` (`id`, `runner_id`, `digest`, `status`, `message`, `created_at`, `updated_at`, `deleted_at`)
+SELECT
+  `id`, `runner_id`, `digest`, `status`, `message`, `created_at`, `updated_at`, `deleted_at`
+FROM
+  `daemon_gc_blob_records`;
+
+DROP TABLE `daemon_gc_blob_records`;
+
+ALTER TABLE `daemon_gc_blob_records_new`
+  RENAME TO `daemon_gc_blob_records`;
@@ -0,0 +1,2 @@
+ALTER TABLE `blobs`
+  ADD COLUMN `last_check` integer NOT NULL DEFAULT 0;
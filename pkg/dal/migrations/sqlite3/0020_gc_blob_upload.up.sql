@@ -0,0 +1,82 @@
+CREATE TABLE IF NOT EXISTS `daemon_gc_blob_upload_rules` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `is_running` integer NOT NULL DEFAULT 0,
+  `retention_hour` integer NOT NULL DEFAULT 24,
+  `cron_enabled` integer NOT NULL DEFAULT 0,
+  `cron_rule` varchar(30),
+  `cron_next_trigger` integer,
+  `created_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `updated_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `deleted_at` integer NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS `daemon_gc_blob_upload_runners` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `rule_id` integer NOT NULL,
+  `message` BLOB,
+  `status` text CHECK (`status` IN ('Success', 'Failed', 'Pending', 'Doing')) NOT NULL DEFAULT 'Pending',
+  `operate_type` text CHECK (`operate_type` IN ('Automatic', 'Manual')) NOT NULL DEFAULT 'Automatic',
+  `operate_user_id` bigint,
+  `started_at` integer,
+  `ended_at` integer,
+  `duration` integer,
+  `success_count` integer,
+  `failed_count` integer,
+  `dry_run` integer NOT NULL DEFAULT 0,
+  `reclaimable_size` integer,
+  `created_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `updated_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  FOREIGN KEY (`rule_id`) REFERENCES `daemon_gc_blob_upload_rules` (`id`),
+  FOREIGN KEY (`operate_user_id`) REFERENCES `users` (`id`)
+);
+
+CREATE TABLE IF NOT EXISTS `daemon_gc_blob_upload_records` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `runner_id` integer NOT NULL,
+  `upload_id` varchar(256) NOT NULL,
+  `repository` varchar(64) NOT NULL,
+  `status` text CHECK (`status` IN ('Success', 'Failed', 'Planned')) NOT NULL DEFAULT 'Success',
+  `size` integer NOT NULL DEFAULT 0,
+  `message` BLOB,
+  `created_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `updated_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  FOREIGN KEY (`runner_id`) REFERENCES `daemon_gc_blob_upload_runners` (`id`)
+);
+
+ALTER TABLE `daemon_gc_pipeline_runners`
+  ADD COLUMN `blob_upload_runner_id` integer;
+
+-- sqlite does not support altering the check constraint, so we rebuild the webhook_logs table
+CREATE TABLE IF NOT EXISTS `webhook_logs_new` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `webhook_id` integer,
+  `resource_type` text CHECK (`resource_type` IN ('Webhook', 'Namespace', 'Repository', 'Tag', 'Artifact', 'Member', 'DaemonTaskGcRepositoryRule', 'DaemonTaskGcTagRule', 'DaemonTaskGcArtifactRule', 'DaemonTaskGcBlobRule', 'DaemonTaskGcRepositoryRunner', 'DaemonTaskGcTagRunner', 'DaemonTaskGcArtifactRunner', 'DaemonTaskGcBlobRunner', 'VulnerabilityAllowlist', 'DaemonTaskGcPipelineRunner', 'DaemonTaskGcBlobUploadRule', 'DaemonTaskGcBlobUploadRunner')) NOT NULL,
+  `action` text CHECK (`action` IN ('Create', 'Update', 'Delete', 'Add', 'Remove', 'Ping', 'Started', 'Finished', 'Expired', 'Violated')) NOT NULL,
+  `status_code` integer NOT NULL,
+  `req_header` BLOB NOT NULL,
+  `req_body` BLOB NOT NULL,
+  `resp_header` BLOB NOT NULL,
+  `resp_body` BLOB,
+  `created_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `updated_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  FOREIGN KEY (`webhook_id`) REFERENCES `webhooks` (`id`)
+);
+
+INSERT INTO `webhook_logs_new`
+SELECT
+  *
+FROM
+  `webhook_logs`;
+
+DROP TABLE `webhook_logs`;
+
+ALTER TABLE `webhook_logs_new` RENAME TO `webhook_logs`;
+
+CREATE INDEX `webhook_logs_idx_created_at` ON `webhook_logs` (`created_at`);
+
+CREATE INDEX `webhook_logs_idx_updated_at` ON `webhook_logs` (`updated_at`);
+
+CREATE INDEX `webhook_logs_idx_deleted_at` ON `webhook_logs` (`deleted_at`);
@@ -0,0 +1,25 @@
+-- sqlite does not support altering the check constraint, so we rebuild the audits table
+CREATE TABLE IF NOT EXISTS `audits_new` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `user_id` bigint NOT NULL,
+  `namespace_id` bigint,
+  `action` text CHECK (`action` IN ('Create', 'Update', 'Delete', 'Pull', 'Push', 'Restore')) NOT NULL,
+  `resource_type` text CHECK (`resource_type` IN ('Namespace', 'Repository', 'Tag', 'Builder', 'Webhook', 'NamespaceMember', 'Artifact')) NOT NULL,
+  `resource` varchar(256) NOT NULL,
+  `req_raw` BLOB,
+  `created_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `updated_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `deleted_at` bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
+  FOREIGN KEY (`namespace_id`) REFERENCES `namespaces` (`id`)
+);
+
+INSERT INTO `audits_new`
+SELECT
+  *
+FROM
+  `audits`;
+
+DROP TABLE `audits`;
+
+ALTER TABLE `audits_new` RENAME TO `audits`;
@@ -0,0 +1,69 @@
+ALTER TABLE `namespaces`
+  ADD COLUMN `quota_thresholds` varchar(64);
+
+ALTER TABLE `namespaces`
+  ADD COLUMN `size_quota_threshold` integer NOT NULL DEFAULT 0;
+
+ALTER TABLE `namespaces`
+  ADD COLUMN `tag_quota_threshold` integer NOT NULL DEFAULT 0;
+
+ALTER TABLE `namespaces`
+  ADD COLUMN `repository_quota_threshold` integer NOT NULL DEFAULT 0;
+
+-- sqlite does not support altering the check constraint, so we rebuild the audits and webhook_logs table
+CREATE TABLE IF NOT EXISTS `audits_new` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `user_id` bigint NOT NULL,
+  `namespace_id` bigint,
+  `action` text CHECK (`action` IN ('Create', 'Update', 'Delete', 'Pull', 'Push', 'Restore')) NOT NULL,
+  `resource_type` text CHECK (`resource_type` IN ('Namespace', 'Repository', 'Tag', 'Builder', 'Webhook', 'NamespaceMember', 'Artifact', 'Quota')) NOT NULL,
+  `resource` varchar(256) NOT NULL,
+  `req_raw` BLOB,
+  `created_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `updated_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `deleted_at` bigint NOT NULL DEFAULT 0,
+  FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
+  FOREIGN KEY (`namespace_id`) REFERENCES `namespaces` (`id`)
+);
+
+INSERT INTO `audits_new`
+SELECT
+  *
+FROM
+  `audits`;
+
+DROP TABLE `audits`;
+
+ALTER TABLE `audits_new` RENAME TO `audits`;
+
+CREATE TABLE IF NOT EXISTS `webhook_logs_new` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `webhook_id` integer,
+  `resource_type` text CHECK (`resource_type` IN ('Webhook', 'Namespace', 'Repository', 'Tag', 'Artifact', 'Member', 'DaemonTaskGcRepositoryRule', 'DaemonTaskGcTagRule', 'DaemonTaskGcArtifactRule', 'DaemonTaskGcBlobRule', 'DaemonTaskGcRepositoryRunner', 'DaemonTaskGcTagRunner', 'DaemonTaskGcArtifactRunner', 'DaemonTaskGcBlobRunner', 'VulnerabilityAllowlist', 'DaemonTaskGcPipelineRunner', 'DaemonTaskGcBlobUploadRule', 'DaemonTaskGcBlobUploadRunner', 'Quota')) NOT NULL,
+  `action` text CHECK (`action` IN ('Create', 'Update', 'Delete', 'Add', 'Remove', 'Ping', 'Started', 'Finished', 'Expired', 'Violated', 'Reached')) NOT NULL,
+  `status_code` integer NOT NULL,
+  `req_header` BLOB NOT NULL,
+  `req_body` BLOB NOT NULL,
+  `resp_header` BLOB NOT NULL,
+  `resp_body` BLOB,
+  `created_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `updated_at` integer NOT NULL DEFAULT (unixepoch () * 1000),
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  FOREIGN KEY (`webhook_id`) REFERENCES `webhooks` (`id`)
+);
+
+INSERT INTO `webhook_logs_new`
+SELECT
+  *
+FROM
+  `webhook_logs`;
+
+DROP TABLE `webhook_logs`;
+
+ALTER TABLE `webhook_logs_new` RENAME TO `webhook_logs`;
+
+CREATE INDEX `webhook_logs_idx_created_at` ON `webhook_logs` (`created_at`);
+
+CREATE INDEX `webhook_logs_idx_updated_at` ON `webhook_logs` (`updated_at`);
+
+CREATE INDEX `webhook_logs_idx_deleted_at` ON `webhook_logs` (`deleted_at`);
@@ -0,0 +1,4 @@
+ALTER TABLE `artifact_vulnerabilities`
+  ADD COLUMN `scan_status` varchar(64) NOT NULL DEFAULT 'Pending';
+
+UPDATE `artifact_vulnerabilities` SET `scan_status` = `status`;
@@ -0,0 +1,8 @@
+ALTER TABLE `artifacts`
+  ADD COLUMN `artifact_type` varchar(256);
+
+-- the artifactType of the referrers was saved as the config media type before
+UPDATE `artifacts` SET `artifact_type` = `config_media_type` WHERE `config_media_type` IS NOT NULL;
+
+UPDATE `artifacts` SET `config_media_type` = NULL
+WHERE `config_media_type` NOT IN ('application/vnd.oci.image.config.v1+json', 'application/vnd.docker.container.image.v1+json', 'application/vnd.cncf.helm.config.v1+json', 'application/vnd.sylabs.sif.config.v1+json');
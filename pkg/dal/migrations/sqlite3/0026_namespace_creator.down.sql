@@ -0,0 +1,2 @@
+ALTER TABLE `namespaces`
+  DROP COLUMN `creator_id`;
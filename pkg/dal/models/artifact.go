@@ -41,6 +41,7 @@ type Artifact struct {
 	Raw             []byte
 	ConfigRaw       []byte
 	ConfigMediaType *string
+	ArtifactType    *string            // is the artifactType of the oci manifest, fallback to the config media type, used to filter the referrers
 	Type            enums.ArtifactType `gorm:"default:Unknown"`
 
 	LastPull  int64
@@ -173,10 +174,36 @@ type ArtifactSbom struct {
 	Stdout     []byte
 	Stderr     []byte
 	Message    string
+	// LicenseStatus and LicenseResult are the result of the license policy of the namespace when the sbom is indexed,
+	// they are nil if the namespace has no enabled license policy, the pull check always uses the current policy.
+	LicenseStatus *enums.LicenseCheckStatus
+	LicenseResult []byte
 
 	Artifact *Artifact
 }
 
+// ArtifactPackage represents a package indexed from the artifact sbom
+type ArtifactPackage struct {
+	CreatedAt int64                 `gorm:"autoCreateTime:milli"`
+	UpdatedAt int64                 `gorm:"autoUpdateTime:milli"`
+	DeletedAt soft_delete.DeletedAt `gorm:"softDelete:milli"`
+	ID        int64                 `gorm:"primaryKey"`
+
+	NamespaceID  int64
+	RepositoryID int64
+	ArtifactID   int64
+	Name         string
+	Version      string
+	Type         string
+	Purl         string
+	// Licenses the license expressions declared by the package separated by comma
+	Licenses string
+
+	Namespace  *Namespace
+	Repository *Repository
+	Artifact   *Artifact
+}
+
 // ArtifactVulnerability represents an artifact vulnerability
 type ArtifactVulnerability struct {
 	CreatedAt int64                 `gorm:"autoCreateTime:milli"`
@@ -185,7 +212,47 @@ type ArtifactVulnerability struct {
 	ID        int64                 `gorm:"primaryKey"`
 
 	ArtifactID int64
-	Metadata   []byte // is the trivy db metadata
+	Scanner    enums.ScannerType `gorm:"default:trivy"`
+	Metadata   []byte            // is the vulnerability db metadata of the scanner
+	Raw        []byte
+	Result     []byte
+	Status     enums.TaskCommonStatus // is the status of the last finished scan, the result is kept until the next scan succeeds
+	ScanStatus enums.TaskCommonStatus `gorm:"default:Pending"` // is the status of the latest scan, maybe still in progress
+	Stdout     []byte
+	Stderr     []byte
+	Message    string
+
+	Artifact *Artifact
+}
+
+// ArtifactSecret represents the secret scan result of an artifact, the secrets are
+// the leaked keys and tokens found in the image layers.
+type ArtifactSecret struct {
+	CreatedAt int64                 `gorm:"autoCreateTime:milli"`
+	UpdatedAt int64                 `gorm:"autoUpdateTime:milli"`
+	DeletedAt soft_delete.DeletedAt `gorm:"softDelete:milli"`
+	ID        int64                 `gorm:"primaryKey"`
+
+	ArtifactID int64
+	Raw        []byte
+	Result     []byte
+	Status     enums.TaskCommonStatus
+	Stdout     []byte
+	Stderr     []byte
+	Message    string
+
+	Artifact *Artifact
+}
+
+// ArtifactMisconfiguration represents the misconfiguration scan result of an artifact, the misconfigurations
+// are found in the config files (e.g. Dockerfile, kubernetes manifests) inside the image.
+type ArtifactMisconfiguration struct {
+	CreatedAt int64                 `gorm:"autoCreateTime:milli"`
+	UpdatedAt int64                 `gorm:"autoUpdateTime:milli"`
+	DeletedAt soft_delete.DeletedAt `gorm:"softDelete:milli"`
+	ID        int64                 `gorm:"primaryKey"`
+
+	ArtifactID int64
 	Raw        []byte
 	Result     []byte
 	Status     enums.TaskCommonStatus
@@ -33,6 +33,9 @@ type Blob struct {
 	LastPull  int64
 	PushedAt  int64 `gorm:"autoCreateTime:milli"`
 	PullTimes uint  `gorm:"default:0"`
+	// LastCheck the last time the blob is checked or referenced by the push,
+	// the blob will not be deleted by the gc within the daemon.gc.protectWindow
+	LastCheck int64 `gorm:"default:0"`
 
 	Artifacts []*Artifact `gorm:"many2many:artifact_blobs;"`
 }
@@ -68,6 +68,9 @@ type Builder struct {
 	BuildkitPlatforms          string `gorm:"default:linux/amd64"`
 	BuildkitBuildArgs          *string
 
+	// SigningType the signing tool used to sign the built image
+	SigningType enums.SigningType `gorm:"default:cosign"`
+
 	Repository     *Repository
 	CodeRepository *CodeRepository
 }
@@ -37,6 +37,9 @@ type DaemonGcTagRule struct {
 	RetentionRuleType   enums.RetentionRuleType
 	RetentionRuleAmount int64
 	RetentionPattern    *string
+	// RetentionRules the ordered retention rules in json format, see types.TagRetentionRule,
+	// the retention rule type, amount and pattern are ignored if it is not empty
+	RetentionRules []byte
 }
 
 // DaemonGcTagRunner ...
@@ -61,6 +64,9 @@ type DaemonGcTagRunner struct {
 	Duration     *int64
 	SuccessCount *int64
 	FailedCount  *int64
+
+	DryRun          bool `gorm:"default:false"`
+	ReclaimableSize *int64
 }
 
 // DaemonGcTagRecords ...
@@ -73,9 +79,11 @@ type DaemonGcTagRecord struct {
 	RunnerID int64
 	Runner   DaemonGcTagRunner
 
-	Tag     string
-	Status  enums.GcRecordStatus `gorm:"default:Success"`
-	Message []byte
+	NamespaceID *int64
+	Tag         string
+	Status      enums.GcRecordStatus `gorm:"default:Success"`
+	Size        int64                `gorm:"default:0"`
+	Message     []byte
 }
 
 // DaemonGcRepositoryRule ...
@@ -105,7 +113,7 @@ type DaemonGcRepositoryRunner struct {
 	RuleID int64
 	Rule   DaemonGcRepositoryRule
 
-	Status  enums.TaskCommonStatus `gorm:"status"`
+	Status  enums.TaskCommonStatus
 	Message []byte
 
 	OperateType   enums.OperateType
@@ -117,6 +125,9 @@ type DaemonGcRepositoryRunner struct {
 	Duration     *int64
 	SuccessCount *int64
 	FailedCount  *int64
+
+	DryRun          bool `gorm:"default:false"`
+	ReclaimableSize *int64
 }
 
 // DaemonGcRepositoryRecord ...
@@ -129,9 +140,11 @@ type DaemonGcRepositoryRecord struct {
 	RunnerID int64
 	Runner   DaemonGcRepositoryRunner
 
-	Repository string
-	Status     enums.GcRecordStatus `gorm:"default:Success"`
-	Message    []byte
+	NamespaceID *int64
+	Repository  string
+	Status      enums.GcRecordStatus `gorm:"default:Success"`
+	Size        int64                `gorm:"default:0"`
+	Message     []byte
 }
 
 // DaemonGcArtifactRule ...
@@ -149,6 +162,8 @@ type DaemonGcArtifactRule struct {
 	CronEnabled     bool `gorm:"default:false"`
 	CronRule        *string
 	CronNextTrigger *int64
+	// ReferrerRetentionDay the days to keep the referrers after their subject is deleted
+	ReferrerRetentionDay int `gorm:"default:0"`
 }
 
 type DaemonGcArtifactRunner struct {
@@ -160,7 +175,7 @@ type DaemonGcArtifactRunner struct {
 	RuleID int64
 	Rule   DaemonGcArtifactRule
 
-	Status  enums.TaskCommonStatus `gorm:"status"`
+	Status  enums.TaskCommonStatus
 	Message []byte
 
 	OperateType   enums.OperateType
@@ -172,6 +187,9 @@ type DaemonGcArtifactRunner struct {
 	Duration     *int64
 	SuccessCount *int64
 	FailedCount  *int64
+
+	DryRun          bool `gorm:"default:false"`
+	ReclaimableSize *int64
 }
 
 // DaemonGcArtifactRecord ...
@@ -184,9 +202,11 @@ type DaemonGcArtifactRecord struct {
 	RunnerID int64
 	Runner   DaemonGcArtifactRunner
 
-	Digest  string
-	Status  enums.GcRecordStatus `gorm:"default:Success"`
-	Message []byte
+	NamespaceID *int64
+	Digest      string
+	Status      enums.GcRecordStatus `gorm:"default:Success"`
+	Size        int64                `gorm:"default:0"`
+	Message     []byte
 }
 
 // DaemonGcBlobRule ...
@@ -213,7 +233,7 @@ type DaemonGcBlobRunner struct {
 	RuleID int64
 	Rule   DaemonGcBlobRule
 
-	Status  enums.TaskCommonStatus `gorm:"status"`
+	Status  enums.TaskCommonStatus
 	Message []byte
 
 	OperateType   enums.OperateType
@@ -225,6 +245,9 @@ type DaemonGcBlobRunner struct {
 	Duration     *int64
 	SuccessCount *int64
 	FailedCount  *int64
+
+	DryRun          bool `gorm:"default:false"`
+	ReclaimableSize *int64
 }
 
 type DaemonGcBlobRecord struct {
@@ -238,5 +261,108 @@ type DaemonGcBlobRecord struct {
 
 	Digest  string
 	Status  enums.GcRecordStatus `gorm:"default:Success"`
+	Size    int64                `gorm:"default:0"`
+	Message []byte
+}
+
+// DaemonGcBlobUploadRule ...
+type DaemonGcBlobUploadRule struct {
+	CreatedAt int64                 `gorm:"autoCreateTime:milli"`
+	UpdatedAt int64                 `gorm:"autoUpdateTime:milli"`
+	DeletedAt soft_delete.DeletedAt `gorm:"softDelete:milli"`
+	ID        int64                 `gorm:"primaryKey"`
+
+	IsRunning bool `gorm:"default:false"`
+	// RetentionHour the upload which has no part uploaded within the hours is abandoned
+	RetentionHour   int  `gorm:"default:24"`
+	CronEnabled     bool `gorm:"default:false"`
+	CronRule        *string
+	CronNextTrigger *int64
+}
+
+// DaemonGcBlobUploadRunner ...
+type DaemonGcBlobUploadRunner struct {
+	CreatedAt int64                 `gorm:"autoCreateTime:milli"`
+	UpdatedAt int64                 `gorm:"autoUpdateTime:milli"`
+	DeletedAt soft_delete.DeletedAt `gorm:"softDelete:milli"`
+	ID        int64                 `gorm:"primaryKey"`
+
+	RuleID int64
+	Rule   DaemonGcBlobUploadRule
+
+	Status  enums.TaskCommonStatus
+	Message []byte
+
+	OperateType   enums.OperateType
+	OperateUserID *int64
+	OperateUser   *User
+
+	StartedAt    *int64
+	EndedAt      *int64
+	Duration     *int64
+	SuccessCount *int64
+	FailedCount  *int64
+
+	DryRun          bool `gorm:"default:false"`
+	ReclaimableSize *int64
+}
+
+// DaemonGcBlobUploadRecord ...
+type DaemonGcBlobUploadRecord struct {
+	CreatedAt int64                 `gorm:"autoCreateTime:milli"`
+	UpdatedAt int64                 `gorm:"autoUpdateTime:milli"`
+	DeletedAt soft_delete.DeletedAt `gorm:"softDelete:milli"`
+	ID        int64                 `gorm:"primaryKey"`
+
+	RunnerID int64
+	Runner   DaemonGcBlobUploadRunner
+
+	UploadID   string
+	Repository string
+	Status     enums.GcRecordStatus `gorm:"default:Success"`
+	Size       int64                `gorm:"default:0"`
+	Message    []byte
+}
+
+// DaemonGcPipelineRule the instance-wide gc pipeline rule, the stages run in order: repository, tag, artifact, blob upload and blob
+type DaemonGcPipelineRule struct {
+	CreatedAt int64                 `gorm:"autoCreateTime:milli"`
+	UpdatedAt int64                 `gorm:"autoUpdateTime:milli"`
+	DeletedAt soft_delete.DeletedAt `gorm:"softDelete:milli"`
+	ID        int64                 `gorm:"primaryKey"`
+
+	CronEnabled     bool `gorm:"default:false"`
+	CronRule        *string
+	CronNextTrigger *int64
+}
+
+// DaemonGcPipelineRunner ...
+type DaemonGcPipelineRunner struct {
+	CreatedAt int64                 `gorm:"autoCreateTime:milli"`
+	UpdatedAt int64                 `gorm:"autoUpdateTime:milli"`
+	DeletedAt soft_delete.DeletedAt `gorm:"softDelete:milli"`
+	ID        int64                 `gorm:"primaryKey"`
+
+	RuleID int64
+	Rule   DaemonGcPipelineRule
+
+	Status  enums.TaskCommonStatus
+	Stage   *enums.Daemon
 	Message []byte
+
+	OperateType   enums.OperateType
+	OperateUserID *int64
+	OperateUser   *User
+
+	StartedAt *int64
+	EndedAt   *int64
+	Duration  *int64
+
+	RepositoryRunnerID *int64
+	TagRunnerID        *int64
+	ArtifactRunnerID   *int64
+	BlobUploadRunnerID *int64
+	BlobRunnerID       *int64
+
+	Report []byte // in json format, see types.GcPipelineReport
 }
@@ -36,7 +36,21 @@ type Namespace struct {
 	RepositoryLimit int64            `gorm:"default:0"`
 	RepositoryCount int64            `gorm:"default:0"`
 	SizeLimit       int64            `gorm:"default:0"`
+	SizeSoftLimit   int64            `gorm:"default:0"`
 	Size            int64            `gorm:"default:0"`
+	Scanner         *enums.ScannerType
+	// SecretScan and MisconfigurationScan enable scanning the image contents for secrets and misconfigurations
+	SecretScan           bool `gorm:"default:false"`
+	MisconfigurationScan bool `gorm:"default:false"`
+	// QuotaThresholds the comma separated quota warning threshold percentages, nil means the configured default
+	QuotaThresholds *string
+	// SizeQuotaThreshold, TagQuotaThreshold and RepositoryQuotaThreshold are the highest threshold percentages
+	// that the usage has crossed and notified, 0 means no threshold crossed
+	SizeQuotaThreshold       int `gorm:"default:0"`
+	TagQuotaThreshold        int `gorm:"default:0"`
+	RepositoryQuotaThreshold int `gorm:"default:0"`
+	// CreatorID the user who created the namespace, the namespace is counted in the namespace count of the user
+	CreatorID *int64
 }
 
 var policyStatement1 = "INSERT INTO `casbin_rules` (`ptype`, `v0`, `v1`, `v2`, `v3`, `v4`) VALUES ('p', '^_^Namespace^_^_admin', '/namespaces/^_^Namespace^_^', '*', 'allow');"
@@ -0,0 +1,143 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"gorm.io/plugin/soft_delete"
+
+	"github.com/go-sigma/sigma/pkg/types/enums"
+)
+
+// VulnerabilityPolicy represents the vulnerability policy of a namespace,
+// the artifact pulled from the namespace will be checked with the policy.
+type VulnerabilityPolicy struct {
+	CreatedAt int64                 `gorm:"autoCreateTime:milli"`
+	UpdatedAt int64                 `gorm:"autoUpdateTime:milli"`
+	DeletedAt soft_delete.DeletedAt `gorm:"softDelete:milli"`
+	ID        int64                 `gorm:"primaryKey"`
+
+	NamespaceID int64
+	Namespace   Namespace
+
+	Enabled        bool                        `gorm:"default:false"`
+	MaxSeverity    enums.VulnerabilitySeverity `gorm:"default:Critical"`
+	BlockUnscanned bool                        `gorm:"default:false"`
+	// Exemptions the repositories separated by comma, which will never be blocked by the policy
+	Exemptions string
+}
+
+// LicensePolicy represents the license policy of a namespace, the packages indexed from the artifact sbom
+// will be checked with the policy after the sbom daemon finished.
+type LicensePolicy struct {
+	CreatedAt int64                 `gorm:"autoCreateTime:milli"`
+	UpdatedAt int64                 `gorm:"autoUpdateTime:milli"`
+	DeletedAt soft_delete.DeletedAt `gorm:"softDelete:milli"`
+	ID        int64                 `gorm:"primaryKey"`
+
+	NamespaceID int64
+	Namespace   Namespace
+
+	Enabled     bool `gorm:"default:false"`
+	BlockPull   bool `gorm:"default:false"`
+	WarnUnknown bool `gorm:"default:false"`
+	// DeniedLicenses the license ids separated by comma, which are not allowed
+	DeniedLicenses string
+	// AllowedLicenses the license ids separated by comma, only these licenses are allowed if it's not empty
+	AllowedLicenses string
+	// Exemptions the repositories separated by comma, which will never be blocked by the policy
+	Exemptions string
+}
+
+// SignaturePolicy represents the signature policy of a namespace, the artifact pulled by tag from the namespace
+// must have a valid cosign signature signed by one of the trusted keys or keyless identities,
+// or a valid notation signature issued by the trusted roots and identities.
+type SignaturePolicy struct {
+	CreatedAt int64                 `gorm:"autoCreateTime:milli"`
+	UpdatedAt int64                 `gorm:"autoUpdateTime:milli"`
+	DeletedAt soft_delete.DeletedAt `gorm:"softDelete:milli"`
+	ID        int64                 `gorm:"primaryKey"`
+
+	NamespaceID int64
+	Namespace   Namespace
+
+	Enabled bool `gorm:"default:false"`
+	// PublicKeys the trusted public keys in pem format
+	PublicKeys string
+	// KeylessIdentities the trusted keyless identities in json format, see types.SignatureIdentity
+	KeylessIdentities []byte
+	// KeylessRoots the trusted root certificates in pem format which issued the keyless signing certificates
+	KeylessRoots string
+	// NotationRoots the trusted root certificates in pem format of the notation trust store
+	NotationRoots string
+	// NotationIdentities the trusted notation identities in json format, e.g. ["x509.subject: C=US, O=sigma"]
+	NotationIdentities []byte
+	// Exemptions the repositories separated by comma, which will never be blocked by the policy
+	Exemptions string
+}
+
+// SigningPolicy represents the sign-on-push policy of a namespace, the artifact pushed with the tag
+// matched the pattern is signed by the active signing key of the namespace.
+type SigningPolicy struct {
+	CreatedAt int64                 `gorm:"autoCreateTime:milli"`
+	UpdatedAt int64                 `gorm:"autoUpdateTime:milli"`
+	DeletedAt soft_delete.DeletedAt `gorm:"softDelete:milli"`
+	ID        int64                 `gorm:"primaryKey"`
+
+	NamespaceID int64
+	Namespace   Namespace
+
+	Enabled bool `gorm:"default:false"`
+	// TagPattern the regular expression of the tags to sign, all of the tags are matched if it is empty
+	TagPattern string
+}
+
+// VulnerabilityAllowlist represents the accepted vulnerability, the matched findings
+// are suppressed in the vulnerability result and the vulnerability policy.
+// The entry is system scope if both NamespaceID and RepositoryID are nil.
+type VulnerabilityAllowlist struct {
+	CreatedAt int64                 `gorm:"autoCreateTime:milli"`
+	UpdatedAt int64                 `gorm:"autoUpdateTime:milli"`
+	DeletedAt soft_delete.DeletedAt `gorm:"softDelete:milli"`
+	ID        int64                 `gorm:"primaryKey"`
+
+	NamespaceID  *int64
+	Namespace    *Namespace
+	RepositoryID *int64
+	Repository   *Repository
+	UserID       int64
+	User         User
+
+	VulnerabilityID string
+	Package         *string
+	Justification   string
+	ExpiresAt       *int64
+}
+
+// VulnerabilityRescanPolicy represents the schedule that rescans the recently pulled artifacts,
+// the policy is system-wide if NamespaceID is nil.
+type VulnerabilityRescanPolicy struct {
+	CreatedAt int64                 `gorm:"autoCreateTime:milli"`
+	UpdatedAt int64                 `gorm:"autoUpdateTime:milli"`
+	DeletedAt soft_delete.DeletedAt `gorm:"softDelete:milli"`
+	ID        int64                 `gorm:"primaryKey"`
+
+	NamespaceID *int64
+	Namespace   *Namespace
+
+	Enabled         bool
+	CronRule        string
+	PulledWithin    int64 // days, the artifacts pulled within the days will be rescanned
+	CronNextTrigger *int64
+}
@@ -25,14 +25,15 @@ type Repository struct {
 	DeletedAt soft_delete.DeletedAt `gorm:"softDelete:milli"`
 	ID        int64                 `gorm:"primaryKey"`
 
-	NamespaceID int64
-	Name        string
-	Description *string
-	Overview    []byte
-	TagLimit    int64 `gorm:"default:0"`
-	TagCount    int64 `gorm:"default:0"`
-	SizeLimit   int64 `gorm:"default:0"`
-	Size        int64 `gorm:"default:0"`
+	NamespaceID   int64
+	Name          string
+	Description   *string
+	Overview      []byte
+	TagLimit      int64 `gorm:"default:0"`
+	TagCount      int64 `gorm:"default:0"`
+	SizeLimit     int64 `gorm:"default:0"`
+	SizeSoftLimit int64 `gorm:"default:0"`
+	Size          int64 `gorm:"default:0"`
 
 	Namespace Namespace
 	Builder   *Builder
@@ -0,0 +1,43 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"gorm.io/plugin/soft_delete"
+)
+
+// SigningKey represents the cosign signing key of a namespace, only one key of the namespace is active
+// and used to sign the artifacts, the retired keys are kept to verify the artifacts signed before rotation.
+type SigningKey struct {
+	CreatedAt int64                 `gorm:"autoCreateTime:milli"`
+	UpdatedAt int64                 `gorm:"autoUpdateTime:milli"`
+	DeletedAt soft_delete.DeletedAt `gorm:"softDelete:milli"`
+	ID        int64                 `gorm:"primaryKey"`
+
+	NamespaceID int64
+	Namespace   Namespace
+
+	// Fingerprint the sha256 fingerprint of the public key
+	Fingerprint string
+	// PublicKey the public key in pem format
+	PublicKey string
+	// PrivateKey the cosign private key in pem format, encrypted with utils/crypt
+	PrivateKey string
+	// Imported the key is imported by the user or generated by sigma
+	Imported bool `gorm:"default:false"`
+	Active   bool `gorm:"default:false"`
+	// RetiredAt the time in milliseconds the key is rotated
+	RetiredAt *int64
+}
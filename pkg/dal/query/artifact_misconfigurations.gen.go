@@ -0,0 +1,673 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+)
+
+func newArtifactMisconfiguration(db *gorm.DB, opts ...gen.DOOption) artifactMisconfiguration {
+	_artifactMisconfiguration := artifactMisconfiguration{}
+
+	_artifactMisconfiguration.artifactMisconfigurationDo.UseDB(db, opts...)
+	_artifactMisconfiguration.artifactMisconfigurationDo.UseModel(&models.ArtifactMisconfiguration{})
+
+	tableName := _artifactMisconfiguration.artifactMisconfigurationDo.TableName()
+	_artifactMisconfiguration.ALL = field.NewAsterisk(tableName)
+	_artifactMisconfiguration.CreatedAt = field.NewInt64(tableName, "created_at")
+	_artifactMisconfiguration.UpdatedAt = field.NewInt64(tableName, "updated_at")
+	_artifactMisconfiguration.DeletedAt = field.NewUint64(tableName, "deleted_at")
+	_artifactMisconfiguration.ID = field.NewInt64(tableName, "id")
+	_artifactMisconfiguration.ArtifactID = field.NewInt64(tableName, "artifact_id")
+	_artifactMisconfiguration.Raw = field.NewBytes(tableName, "raw")
+	_artifactMisconfiguration.Result = field.NewBytes(tableName, "result")
+	_artifactMisconfiguration.Status = field.NewField(tableName, "status")
+	_artifactMisconfiguration.Stdout = field.NewBytes(tableName, "stdout")
+	_artifactMisconfiguration.Stderr = field.NewBytes(tableName, "stderr")
+	_artifactMisconfiguration.Message = field.NewString(tableName, "message")
+	_artifactMisconfiguration.Artifact = artifactMisconfigurationBelongsToArtifact{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Artifact", "models.Artifact"),
+		Namespace: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Artifact.Namespace", "models.Namespace"),
+		},
+		Repository: struct {
+			field.RelationField
+			Namespace struct {
+				field.RelationField
+			}
+			Builder struct {
+				field.RelationField
+				Repository struct {
+					field.RelationField
+				}
+				CodeRepository struct {
+					field.RelationField
+					User3rdParty struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+						}
+					}
+					Branches struct {
+						field.RelationField
+					}
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Artifact.Repository", "models.Repository"),
+			Namespace: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Artifact.Repository.Namespace", "models.Namespace"),
+			},
+			Builder: struct {
+				field.RelationField
+				Repository struct {
+					field.RelationField
+				}
+				CodeRepository struct {
+					field.RelationField
+					User3rdParty struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+						}
+					}
+					Branches struct {
+						field.RelationField
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Artifact.Repository.Builder", "models.Builder"),
+				Repository: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Artifact.Repository.Builder.Repository", "models.Repository"),
+				},
+				CodeRepository: struct {
+					field.RelationField
+					User3rdParty struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+						}
+					}
+					Branches struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Artifact.Repository.Builder.CodeRepository", "models.CodeRepository"),
+					User3rdParty: struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Artifact.Repository.Builder.CodeRepository.User3rdParty", "models.User3rdParty"),
+						User: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Artifact.Repository.Builder.CodeRepository.User3rdParty.User", "models.User"),
+						},
+					},
+					Branches: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Artifact.Repository.Builder.CodeRepository.Branches", "models.CodeRepositoryBranch"),
+					},
+				},
+			},
+		},
+		Referrer: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Artifact.Referrer", "models.Artifact"),
+		},
+		Vulnerability: struct {
+			field.RelationField
+			Artifact struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Artifact.Vulnerability", "models.ArtifactVulnerability"),
+			Artifact: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Artifact.Vulnerability.Artifact", "models.Artifact"),
+			},
+		},
+		Sbom: struct {
+			field.RelationField
+			Artifact struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Artifact.Sbom", "models.ArtifactSbom"),
+			Artifact: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Artifact.Sbom.Artifact", "models.Artifact"),
+			},
+		},
+		Tags: struct {
+			field.RelationField
+			Repository struct {
+				field.RelationField
+			}
+			Artifact struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Artifact.Tags", "models.Tag"),
+			Repository: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Artifact.Tags.Repository", "models.Repository"),
+			},
+			Artifact: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Artifact.Tags.Artifact", "models.Artifact"),
+			},
+		},
+		ArtifactSubs: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Artifact.ArtifactSubs", "models.Artifact"),
+		},
+		Blobs: struct {
+			field.RelationField
+			Artifacts struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Artifact.Blobs", "models.Blob"),
+			Artifacts: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Artifact.Blobs.Artifacts", "models.Artifact"),
+			},
+		},
+	}
+
+	_artifactMisconfiguration.fillFieldMap()
+
+	return _artifactMisconfiguration
+}
+
+type artifactMisconfiguration struct {
+	artifactMisconfigurationDo artifactMisconfigurationDo
+
+	ALL        field.Asterisk
+	CreatedAt  field.Int64
+	UpdatedAt  field.Int64
+	DeletedAt  field.Uint64
+	ID         field.Int64
+	ArtifactID field.Int64
+	Raw        field.Bytes
+	Result     field.Bytes
+	Status     field.Field
+	Stdout     field.Bytes
+	Stderr     field.Bytes
+	Message    field.String
+	Artifact   artifactMisconfigurationBelongsToArtifact
+
+	fieldMap map[string]field.Expr
+}
+
+func (a artifactMisconfiguration) Table(newTableName string) *artifactMisconfiguration {
+	a.artifactMisconfigurationDo.UseTable(newTableName)
+	return a.updateTableName(newTableName)
+}
+
+func (a artifactMisconfiguration) As(alias string) *artifactMisconfiguration {
+	a.artifactMisconfigurationDo.DO = *(a.artifactMisconfigurationDo.As(alias).(*gen.DO))
+	return a.updateTableName(alias)
+}
+
+func (a *artifactMisconfiguration) updateTableName(table string) *artifactMisconfiguration {
+	a.ALL = field.NewAsterisk(table)
+	a.CreatedAt = field.NewInt64(table, "created_at")
+	a.UpdatedAt = field.NewInt64(table, "updated_at")
+	a.DeletedAt = field.NewUint64(table, "deleted_at")
+	a.ID = field.NewInt64(table, "id")
+	a.ArtifactID = field.NewInt64(table, "artifact_id")
+	a.Raw = field.NewBytes(table, "raw")
+	a.Result = field.NewBytes(table, "result")
+	a.Status = field.NewField(table, "status")
+	a.Stdout = field.NewBytes(table, "stdout")
+	a.Stderr = field.NewBytes(table, "stderr")
+	a.Message = field.NewString(table, "message")
+
+	a.fillFieldMap()
+
+	return a
+}
+
+func (a *artifactMisconfiguration) WithContext(ctx context.Context) *artifactMisconfigurationDo {
+	return a.artifactMisconfigurationDo.WithContext(ctx)
+}
+
+func (a artifactMisconfiguration) TableName() string { return a.artifactMisconfigurationDo.TableName() }
+
+func (a artifactMisconfiguration) Alias() string { return a.artifactMisconfigurationDo.Alias() }
+
+func (a artifactMisconfiguration) Columns(cols ...field.Expr) gen.Columns {
+	return a.artifactMisconfigurationDo.Columns(cols...)
+}
+
+func (a *artifactMisconfiguration) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := a.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (a *artifactMisconfiguration) fillFieldMap() {
+	a.fieldMap = make(map[string]field.Expr, 12)
+	a.fieldMap["created_at"] = a.CreatedAt
+	a.fieldMap["updated_at"] = a.UpdatedAt
+	a.fieldMap["deleted_at"] = a.DeletedAt
+	a.fieldMap["id"] = a.ID
+	a.fieldMap["artifact_id"] = a.ArtifactID
+	a.fieldMap["raw"] = a.Raw
+	a.fieldMap["result"] = a.Result
+	a.fieldMap["status"] = a.Status
+	a.fieldMap["stdout"] = a.Stdout
+	a.fieldMap["stderr"] = a.Stderr
+	a.fieldMap["message"] = a.Message
+
+}
+
+func (a artifactMisconfiguration) clone(db *gorm.DB) artifactMisconfiguration {
+	a.artifactMisconfigurationDo.ReplaceConnPool(db.Statement.ConnPool)
+	return a
+}
+
+func (a artifactMisconfiguration) replaceDB(db *gorm.DB) artifactMisconfiguration {
+	a.artifactMisconfigurationDo.ReplaceDB(db)
+	return a
+}
+
+type artifactMisconfigurationBelongsToArtifact struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Namespace struct {
+		field.RelationField
+	}
+	Repository struct {
+		field.RelationField
+		Namespace struct {
+			field.RelationField
+		}
+		Builder struct {
+			field.RelationField
+			Repository struct {
+				field.RelationField
+			}
+			CodeRepository struct {
+				field.RelationField
+				User3rdParty struct {
+					field.RelationField
+					User struct {
+						field.RelationField
+					}
+				}
+				Branches struct {
+					field.RelationField
+				}
+			}
+		}
+	}
+	Referrer struct {
+		field.RelationField
+	}
+	Vulnerability struct {
+		field.RelationField
+		Artifact struct {
+			field.RelationField
+		}
+	}
+	Sbom struct {
+		field.RelationField
+		Artifact struct {
+			field.RelationField
+		}
+	}
+	Tags struct {
+		field.RelationField
+		Repository struct {
+			field.RelationField
+		}
+		Artifact struct {
+			field.RelationField
+		}
+	}
+	ArtifactSubs struct {
+		field.RelationField
+	}
+	Blobs struct {
+		field.RelationField
+		Artifacts struct {
+			field.RelationField
+		}
+	}
+}
+
+func (a artifactMisconfigurationBelongsToArtifact) Where(conds ...field.Expr) *artifactMisconfigurationBelongsToArtifact {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a artifactMisconfigurationBelongsToArtifact) WithContext(ctx context.Context) *artifactMisconfigurationBelongsToArtifact {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a artifactMisconfigurationBelongsToArtifact) Session(session *gorm.Session) *artifactMisconfigurationBelongsToArtifact {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a artifactMisconfigurationBelongsToArtifact) Model(m *models.ArtifactMisconfiguration) *artifactMisconfigurationBelongsToArtifactTx {
+	return &artifactMisconfigurationBelongsToArtifactTx{a.db.Model(m).Association(a.Name())}
+}
+
+type artifactMisconfigurationBelongsToArtifactTx struct{ tx *gorm.Association }
+
+func (a artifactMisconfigurationBelongsToArtifactTx) Find() (result *models.Artifact, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a artifactMisconfigurationBelongsToArtifactTx) Append(values ...*models.Artifact) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a artifactMisconfigurationBelongsToArtifactTx) Replace(values ...*models.Artifact) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a artifactMisconfigurationBelongsToArtifactTx) Delete(values ...*models.Artifact) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a artifactMisconfigurationBelongsToArtifactTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a artifactMisconfigurationBelongsToArtifactTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type artifactMisconfigurationDo struct{ gen.DO }
+
+func (a artifactMisconfigurationDo) Debug() *artifactMisconfigurationDo {
+	return a.withDO(a.DO.Debug())
+}
+
+func (a artifactMisconfigurationDo) WithContext(ctx context.Context) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.WithContext(ctx))
+}
+
+func (a artifactMisconfigurationDo) ReadDB() *artifactMisconfigurationDo {
+	return a.Clauses(dbresolver.Read)
+}
+
+func (a artifactMisconfigurationDo) WriteDB() *artifactMisconfigurationDo {
+	return a.Clauses(dbresolver.Write)
+}
+
+func (a artifactMisconfigurationDo) Session(config *gorm.Session) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.Session(config))
+}
+
+func (a artifactMisconfigurationDo) Clauses(conds ...clause.Expression) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.Clauses(conds...))
+}
+
+func (a artifactMisconfigurationDo) Returning(value interface{}, columns ...string) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.Returning(value, columns...))
+}
+
+func (a artifactMisconfigurationDo) Not(conds ...gen.Condition) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.Not(conds...))
+}
+
+func (a artifactMisconfigurationDo) Or(conds ...gen.Condition) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.Or(conds...))
+}
+
+func (a artifactMisconfigurationDo) Select(conds ...field.Expr) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.Select(conds...))
+}
+
+func (a artifactMisconfigurationDo) Where(conds ...gen.Condition) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.Where(conds...))
+}
+
+func (a artifactMisconfigurationDo) Order(conds ...field.Expr) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.Order(conds...))
+}
+
+func (a artifactMisconfigurationDo) Distinct(cols ...field.Expr) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.Distinct(cols...))
+}
+
+func (a artifactMisconfigurationDo) Omit(cols ...field.Expr) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.Omit(cols...))
+}
+
+func (a artifactMisconfigurationDo) Join(table schema.Tabler, on ...field.Expr) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.Join(table, on...))
+}
+
+func (a artifactMisconfigurationDo) LeftJoin(table schema.Tabler, on ...field.Expr) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.LeftJoin(table, on...))
+}
+
+func (a artifactMisconfigurationDo) RightJoin(table schema.Tabler, on ...field.Expr) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.RightJoin(table, on...))
+}
+
+func (a artifactMisconfigurationDo) Group(cols ...field.Expr) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.Group(cols...))
+}
+
+func (a artifactMisconfigurationDo) Having(conds ...gen.Condition) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.Having(conds...))
+}
+
+func (a artifactMisconfigurationDo) Limit(limit int) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.Limit(limit))
+}
+
+func (a artifactMisconfigurationDo) Offset(offset int) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.Offset(offset))
+}
+
+func (a artifactMisconfigurationDo) Scopes(funcs ...func(gen.Dao) gen.Dao) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.Scopes(funcs...))
+}
+
+func (a artifactMisconfigurationDo) Unscoped() *artifactMisconfigurationDo {
+	return a.withDO(a.DO.Unscoped())
+}
+
+func (a artifactMisconfigurationDo) Create(values ...*models.ArtifactMisconfiguration) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Create(values)
+}
+
+func (a artifactMisconfigurationDo) CreateInBatches(values []*models.ArtifactMisconfiguration, batchSize int) error {
+	return a.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (a artifactMisconfigurationDo) Save(values ...*models.ArtifactMisconfiguration) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Save(values)
+}
+
+func (a artifactMisconfigurationDo) First() (*models.ArtifactMisconfiguration, error) {
+	if result, err := a.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ArtifactMisconfiguration), nil
+	}
+}
+
+func (a artifactMisconfigurationDo) Take() (*models.ArtifactMisconfiguration, error) {
+	if result, err := a.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ArtifactMisconfiguration), nil
+	}
+}
+
+func (a artifactMisconfigurationDo) Last() (*models.ArtifactMisconfiguration, error) {
+	if result, err := a.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ArtifactMisconfiguration), nil
+	}
+}
+
+func (a artifactMisconfigurationDo) Find() ([]*models.ArtifactMisconfiguration, error) {
+	result, err := a.DO.Find()
+	return result.([]*models.ArtifactMisconfiguration), err
+}
+
+func (a artifactMisconfigurationDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.ArtifactMisconfiguration, err error) {
+	buf := make([]*models.ArtifactMisconfiguration, 0, batchSize)
+	err = a.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (a artifactMisconfigurationDo) FindInBatches(result *[]*models.ArtifactMisconfiguration, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return a.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (a artifactMisconfigurationDo) Attrs(attrs ...field.AssignExpr) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.Attrs(attrs...))
+}
+
+func (a artifactMisconfigurationDo) Assign(attrs ...field.AssignExpr) *artifactMisconfigurationDo {
+	return a.withDO(a.DO.Assign(attrs...))
+}
+
+func (a artifactMisconfigurationDo) Joins(fields ...field.RelationField) *artifactMisconfigurationDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Joins(_f))
+	}
+	return &a
+}
+
+func (a artifactMisconfigurationDo) Preload(fields ...field.RelationField) *artifactMisconfigurationDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Preload(_f))
+	}
+	return &a
+}
+
+func (a artifactMisconfigurationDo) FirstOrInit() (*models.ArtifactMisconfiguration, error) {
+	if result, err := a.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ArtifactMisconfiguration), nil
+	}
+}
+
+func (a artifactMisconfigurationDo) FirstOrCreate() (*models.ArtifactMisconfiguration, error) {
+	if result, err := a.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ArtifactMisconfiguration), nil
+	}
+}
+
+func (a artifactMisconfigurationDo) FindByPage(offset int, limit int) (result []*models.ArtifactMisconfiguration, count int64, err error) {
+	result, err = a.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = a.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (a artifactMisconfigurationDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = a.Count()
+	if err != nil {
+		return
+	}
+
+	err = a.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (a artifactMisconfigurationDo) Scan(result interface{}) (err error) {
+	return a.DO.Scan(result)
+}
+
+func (a artifactMisconfigurationDo) Delete(models ...*models.ArtifactMisconfiguration) (result gen.ResultInfo, err error) {
+	return a.DO.Delete(models)
+}
+
+func (a *artifactMisconfigurationDo) withDO(do gen.Dao) *artifactMisconfigurationDo {
+	a.DO = *do.(*gen.DO)
+	return a
+}
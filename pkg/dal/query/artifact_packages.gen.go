@@ -0,0 +1,815 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+)
+
+func newArtifactPackage(db *gorm.DB, opts ...gen.DOOption) artifactPackage {
+	_artifactPackage := artifactPackage{}
+
+	_artifactPackage.artifactPackageDo.UseDB(db, opts...)
+	_artifactPackage.artifactPackageDo.UseModel(&models.ArtifactPackage{})
+
+	tableName := _artifactPackage.artifactPackageDo.TableName()
+	_artifactPackage.ALL = field.NewAsterisk(tableName)
+	_artifactPackage.CreatedAt = field.NewInt64(tableName, "created_at")
+	_artifactPackage.UpdatedAt = field.NewInt64(tableName, "updated_at")
+	_artifactPackage.DeletedAt = field.NewUint64(tableName, "deleted_at")
+	_artifactPackage.ID = field.NewInt64(tableName, "id")
+	_artifactPackage.NamespaceID = field.NewInt64(tableName, "namespace_id")
+	_artifactPackage.RepositoryID = field.NewInt64(tableName, "repository_id")
+	_artifactPackage.ArtifactID = field.NewInt64(tableName, "artifact_id")
+	_artifactPackage.Name = field.NewString(tableName, "name")
+	_artifactPackage.Version = field.NewString(tableName, "version")
+	_artifactPackage.Type = field.NewString(tableName, "type")
+	_artifactPackage.Purl = field.NewString(tableName, "purl")
+	_artifactPackage.Licenses = field.NewString(tableName, "licenses")
+	_artifactPackage.Namespace = artifactPackageBelongsToNamespace{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Namespace", "models.Namespace"),
+	}
+
+	_artifactPackage.Repository = artifactPackageBelongsToRepository{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Repository", "models.Repository"),
+		Namespace: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Repository.Namespace", "models.Namespace"),
+		},
+		Builder: struct {
+			field.RelationField
+			Repository struct {
+				field.RelationField
+			}
+			CodeRepository struct {
+				field.RelationField
+				User3rdParty struct {
+					field.RelationField
+					User struct {
+						field.RelationField
+					}
+				}
+				Branches struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Repository.Builder", "models.Builder"),
+			Repository: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Repository.Builder.Repository", "models.Repository"),
+			},
+			CodeRepository: struct {
+				field.RelationField
+				User3rdParty struct {
+					field.RelationField
+					User struct {
+						field.RelationField
+					}
+				}
+				Branches struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Repository.Builder.CodeRepository", "models.CodeRepository"),
+				User3rdParty: struct {
+					field.RelationField
+					User struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Repository.Builder.CodeRepository.User3rdParty", "models.User3rdParty"),
+					User: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Repository.Builder.CodeRepository.User3rdParty.User", "models.User"),
+					},
+				},
+				Branches: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Repository.Builder.CodeRepository.Branches", "models.CodeRepositoryBranch"),
+				},
+			},
+		},
+	}
+
+	_artifactPackage.Artifact = artifactPackageBelongsToArtifact{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Artifact", "models.Artifact"),
+		Namespace: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Artifact.Namespace", "models.Namespace"),
+		},
+		Repository: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Artifact.Repository", "models.Repository"),
+		},
+		Referrer: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Artifact.Referrer", "models.Artifact"),
+		},
+		Vulnerability: struct {
+			field.RelationField
+			Artifact struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Artifact.Vulnerability", "models.ArtifactVulnerability"),
+			Artifact: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Artifact.Vulnerability.Artifact", "models.Artifact"),
+			},
+		},
+		Sbom: struct {
+			field.RelationField
+			Artifact struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Artifact.Sbom", "models.ArtifactSbom"),
+			Artifact: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Artifact.Sbom.Artifact", "models.Artifact"),
+			},
+		},
+		Tags: struct {
+			field.RelationField
+			Repository struct {
+				field.RelationField
+			}
+			Artifact struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Artifact.Tags", "models.Tag"),
+			Repository: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Artifact.Tags.Repository", "models.Repository"),
+			},
+			Artifact: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Artifact.Tags.Artifact", "models.Artifact"),
+			},
+		},
+		ArtifactSubs: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Artifact.ArtifactSubs", "models.Artifact"),
+		},
+		Blobs: struct {
+			field.RelationField
+			Artifacts struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Artifact.Blobs", "models.Blob"),
+			Artifacts: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Artifact.Blobs.Artifacts", "models.Artifact"),
+			},
+		},
+	}
+
+	_artifactPackage.fillFieldMap()
+
+	return _artifactPackage
+}
+
+type artifactPackage struct {
+	artifactPackageDo artifactPackageDo
+
+	ALL          field.Asterisk
+	CreatedAt    field.Int64
+	UpdatedAt    field.Int64
+	DeletedAt    field.Uint64
+	ID           field.Int64
+	NamespaceID  field.Int64
+	RepositoryID field.Int64
+	ArtifactID   field.Int64
+	Name         field.String
+	Version      field.String
+	Type         field.String
+	Purl         field.String
+	Licenses     field.String
+	Namespace    artifactPackageBelongsToNamespace
+
+	Repository artifactPackageBelongsToRepository
+
+	Artifact artifactPackageBelongsToArtifact
+
+	fieldMap map[string]field.Expr
+}
+
+func (a artifactPackage) Table(newTableName string) *artifactPackage {
+	a.artifactPackageDo.UseTable(newTableName)
+	return a.updateTableName(newTableName)
+}
+
+func (a artifactPackage) As(alias string) *artifactPackage {
+	a.artifactPackageDo.DO = *(a.artifactPackageDo.As(alias).(*gen.DO))
+	return a.updateTableName(alias)
+}
+
+func (a *artifactPackage) updateTableName(table string) *artifactPackage {
+	a.ALL = field.NewAsterisk(table)
+	a.CreatedAt = field.NewInt64(table, "created_at")
+	a.UpdatedAt = field.NewInt64(table, "updated_at")
+	a.DeletedAt = field.NewUint64(table, "deleted_at")
+	a.ID = field.NewInt64(table, "id")
+	a.NamespaceID = field.NewInt64(table, "namespace_id")
+	a.RepositoryID = field.NewInt64(table, "repository_id")
+	a.ArtifactID = field.NewInt64(table, "artifact_id")
+	a.Name = field.NewString(table, "name")
+	a.Version = field.NewString(table, "version")
+	a.Type = field.NewString(table, "type")
+	a.Purl = field.NewString(table, "purl")
+	a.Licenses = field.NewString(table, "licenses")
+
+	a.fillFieldMap()
+
+	return a
+}
+
+func (a *artifactPackage) WithContext(ctx context.Context) *artifactPackageDo {
+	return a.artifactPackageDo.WithContext(ctx)
+}
+
+func (a artifactPackage) TableName() string { return a.artifactPackageDo.TableName() }
+
+func (a artifactPackage) Alias() string { return a.artifactPackageDo.Alias() }
+
+func (a artifactPackage) Columns(cols ...field.Expr) gen.Columns {
+	return a.artifactPackageDo.Columns(cols...)
+}
+
+func (a *artifactPackage) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := a.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (a *artifactPackage) fillFieldMap() {
+	a.fieldMap = make(map[string]field.Expr, 15)
+	a.fieldMap["created_at"] = a.CreatedAt
+	a.fieldMap["updated_at"] = a.UpdatedAt
+	a.fieldMap["deleted_at"] = a.DeletedAt
+	a.fieldMap["id"] = a.ID
+	a.fieldMap["namespace_id"] = a.NamespaceID
+	a.fieldMap["repository_id"] = a.RepositoryID
+	a.fieldMap["artifact_id"] = a.ArtifactID
+	a.fieldMap["name"] = a.Name
+	a.fieldMap["version"] = a.Version
+	a.fieldMap["type"] = a.Type
+	a.fieldMap["purl"] = a.Purl
+	a.fieldMap["licenses"] = a.Licenses
+
+}
+
+func (a artifactPackage) clone(db *gorm.DB) artifactPackage {
+	a.artifactPackageDo.ReplaceConnPool(db.Statement.ConnPool)
+	return a
+}
+
+func (a artifactPackage) replaceDB(db *gorm.DB) artifactPackage {
+	a.artifactPackageDo.ReplaceDB(db)
+	return a
+}
+
+type artifactPackageBelongsToNamespace struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a artifactPackageBelongsToNamespace) Where(conds ...field.Expr) *artifactPackageBelongsToNamespace {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a artifactPackageBelongsToNamespace) WithContext(ctx context.Context) *artifactPackageBelongsToNamespace {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a artifactPackageBelongsToNamespace) Session(session *gorm.Session) *artifactPackageBelongsToNamespace {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a artifactPackageBelongsToNamespace) Model(m *models.ArtifactPackage) *artifactPackageBelongsToNamespaceTx {
+	return &artifactPackageBelongsToNamespaceTx{a.db.Model(m).Association(a.Name())}
+}
+
+type artifactPackageBelongsToNamespaceTx struct{ tx *gorm.Association }
+
+func (a artifactPackageBelongsToNamespaceTx) Find() (result *models.Namespace, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a artifactPackageBelongsToNamespaceTx) Append(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a artifactPackageBelongsToNamespaceTx) Replace(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a artifactPackageBelongsToNamespaceTx) Delete(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a artifactPackageBelongsToNamespaceTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a artifactPackageBelongsToNamespaceTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type artifactPackageBelongsToRepository struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Namespace struct {
+		field.RelationField
+	}
+	Builder struct {
+		field.RelationField
+		Repository struct {
+			field.RelationField
+		}
+		CodeRepository struct {
+			field.RelationField
+			User3rdParty struct {
+				field.RelationField
+				User struct {
+					field.RelationField
+				}
+			}
+			Branches struct {
+				field.RelationField
+			}
+		}
+	}
+}
+
+func (a artifactPackageBelongsToRepository) Where(conds ...field.Expr) *artifactPackageBelongsToRepository {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a artifactPackageBelongsToRepository) WithContext(ctx context.Context) *artifactPackageBelongsToRepository {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a artifactPackageBelongsToRepository) Session(session *gorm.Session) *artifactPackageBelongsToRepository {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a artifactPackageBelongsToRepository) Model(m *models.ArtifactPackage) *artifactPackageBelongsToRepositoryTx {
+	return &artifactPackageBelongsToRepositoryTx{a.db.Model(m).Association(a.Name())}
+}
+
+type artifactPackageBelongsToRepositoryTx struct{ tx *gorm.Association }
+
+func (a artifactPackageBelongsToRepositoryTx) Find() (result *models.Repository, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a artifactPackageBelongsToRepositoryTx) Append(values ...*models.Repository) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a artifactPackageBelongsToRepositoryTx) Replace(values ...*models.Repository) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a artifactPackageBelongsToRepositoryTx) Delete(values ...*models.Repository) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a artifactPackageBelongsToRepositoryTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a artifactPackageBelongsToRepositoryTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type artifactPackageBelongsToArtifact struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Namespace struct {
+		field.RelationField
+	}
+	Repository struct {
+		field.RelationField
+	}
+	Referrer struct {
+		field.RelationField
+	}
+	Vulnerability struct {
+		field.RelationField
+		Artifact struct {
+			field.RelationField
+		}
+	}
+	Sbom struct {
+		field.RelationField
+		Artifact struct {
+			field.RelationField
+		}
+	}
+	Tags struct {
+		field.RelationField
+		Repository struct {
+			field.RelationField
+		}
+		Artifact struct {
+			field.RelationField
+		}
+	}
+	ArtifactSubs struct {
+		field.RelationField
+	}
+	Blobs struct {
+		field.RelationField
+		Artifacts struct {
+			field.RelationField
+		}
+	}
+}
+
+func (a artifactPackageBelongsToArtifact) Where(conds ...field.Expr) *artifactPackageBelongsToArtifact {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a artifactPackageBelongsToArtifact) WithContext(ctx context.Context) *artifactPackageBelongsToArtifact {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a artifactPackageBelongsToArtifact) Session(session *gorm.Session) *artifactPackageBelongsToArtifact {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a artifactPackageBelongsToArtifact) Model(m *models.ArtifactPackage) *artifactPackageBelongsToArtifactTx {
+	return &artifactPackageBelongsToArtifactTx{a.db.Model(m).Association(a.Name())}
+}
+
+type artifactPackageBelongsToArtifactTx struct{ tx *gorm.Association }
+
+func (a artifactPackageBelongsToArtifactTx) Find() (result *models.Artifact, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a artifactPackageBelongsToArtifactTx) Append(values ...*models.Artifact) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a artifactPackageBelongsToArtifactTx) Replace(values ...*models.Artifact) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a artifactPackageBelongsToArtifactTx) Delete(values ...*models.Artifact) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a artifactPackageBelongsToArtifactTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a artifactPackageBelongsToArtifactTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type artifactPackageDo struct{ gen.DO }
+
+func (a artifactPackageDo) Debug() *artifactPackageDo {
+	return a.withDO(a.DO.Debug())
+}
+
+func (a artifactPackageDo) WithContext(ctx context.Context) *artifactPackageDo {
+	return a.withDO(a.DO.WithContext(ctx))
+}
+
+func (a artifactPackageDo) ReadDB() *artifactPackageDo {
+	return a.Clauses(dbresolver.Read)
+}
+
+func (a artifactPackageDo) WriteDB() *artifactPackageDo {
+	return a.Clauses(dbresolver.Write)
+}
+
+func (a artifactPackageDo) Session(config *gorm.Session) *artifactPackageDo {
+	return a.withDO(a.DO.Session(config))
+}
+
+func (a artifactPackageDo) Clauses(conds ...clause.Expression) *artifactPackageDo {
+	return a.withDO(a.DO.Clauses(conds...))
+}
+
+func (a artifactPackageDo) Returning(value interface{}, columns ...string) *artifactPackageDo {
+	return a.withDO(a.DO.Returning(value, columns...))
+}
+
+func (a artifactPackageDo) Not(conds ...gen.Condition) *artifactPackageDo {
+	return a.withDO(a.DO.Not(conds...))
+}
+
+func (a artifactPackageDo) Or(conds ...gen.Condition) *artifactPackageDo {
+	return a.withDO(a.DO.Or(conds...))
+}
+
+func (a artifactPackageDo) Select(conds ...field.Expr) *artifactPackageDo {
+	return a.withDO(a.DO.Select(conds...))
+}
+
+func (a artifactPackageDo) Where(conds ...gen.Condition) *artifactPackageDo {
+	return a.withDO(a.DO.Where(conds...))
+}
+
+func (a artifactPackageDo) Order(conds ...field.Expr) *artifactPackageDo {
+	return a.withDO(a.DO.Order(conds...))
+}
+
+func (a artifactPackageDo) Distinct(cols ...field.Expr) *artifactPackageDo {
+	return a.withDO(a.DO.Distinct(cols...))
+}
+
+func (a artifactPackageDo) Omit(cols ...field.Expr) *artifactPackageDo {
+	return a.withDO(a.DO.Omit(cols...))
+}
+
+func (a artifactPackageDo) Join(table schema.Tabler, on ...field.Expr) *artifactPackageDo {
+	return a.withDO(a.DO.Join(table, on...))
+}
+
+func (a artifactPackageDo) LeftJoin(table schema.Tabler, on ...field.Expr) *artifactPackageDo {
+	return a.withDO(a.DO.LeftJoin(table, on...))
+}
+
+func (a artifactPackageDo) RightJoin(table schema.Tabler, on ...field.Expr) *artifactPackageDo {
+	return a.withDO(a.DO.RightJoin(table, on...))
+}
+
+func (a artifactPackageDo) Group(cols ...field.Expr) *artifactPackageDo {
+	return a.withDO(a.DO.Group(cols...))
+}
+
+func (a artifactPackageDo) Having(conds ...gen.Condition) *artifactPackageDo {
+	return a.withDO(a.DO.Having(conds...))
+}
+
+func (a artifactPackageDo) Limit(limit int) *artifactPackageDo {
+	return a.withDO(a.DO.Limit(limit))
+}
+
+func (a artifactPackageDo) Offset(offset int) *artifactPackageDo {
+	return a.withDO(a.DO.Offset(offset))
+}
+
+func (a artifactPackageDo) Scopes(funcs ...func(gen.Dao) gen.Dao) *artifactPackageDo {
+	return a.withDO(a.DO.Scopes(funcs...))
+}
+
+func (a artifactPackageDo) Unscoped() *artifactPackageDo {
+	return a.withDO(a.DO.Unscoped())
+}
+
+func (a artifactPackageDo) Create(values ...*models.ArtifactPackage) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Create(values)
+}
+
+func (a artifactPackageDo) CreateInBatches(values []*models.ArtifactPackage, batchSize int) error {
+	return a.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (a artifactPackageDo) Save(values ...*models.ArtifactPackage) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Save(values)
+}
+
+func (a artifactPackageDo) First() (*models.ArtifactPackage, error) {
+	if result, err := a.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ArtifactPackage), nil
+	}
+}
+
+func (a artifactPackageDo) Take() (*models.ArtifactPackage, error) {
+	if result, err := a.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ArtifactPackage), nil
+	}
+}
+
+func (a artifactPackageDo) Last() (*models.ArtifactPackage, error) {
+	if result, err := a.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ArtifactPackage), nil
+	}
+}
+
+func (a artifactPackageDo) Find() ([]*models.ArtifactPackage, error) {
+	result, err := a.DO.Find()
+	return result.([]*models.ArtifactPackage), err
+}
+
+func (a artifactPackageDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.ArtifactPackage, err error) {
+	buf := make([]*models.ArtifactPackage, 0, batchSize)
+	err = a.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (a artifactPackageDo) FindInBatches(result *[]*models.ArtifactPackage, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return a.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (a artifactPackageDo) Attrs(attrs ...field.AssignExpr) *artifactPackageDo {
+	return a.withDO(a.DO.Attrs(attrs...))
+}
+
+func (a artifactPackageDo) Assign(attrs ...field.AssignExpr) *artifactPackageDo {
+	return a.withDO(a.DO.Assign(attrs...))
+}
+
+func (a artifactPackageDo) Joins(fields ...field.RelationField) *artifactPackageDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Joins(_f))
+	}
+	return &a
+}
+
+func (a artifactPackageDo) Preload(fields ...field.RelationField) *artifactPackageDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Preload(_f))
+	}
+	return &a
+}
+
+func (a artifactPackageDo) FirstOrInit() (*models.ArtifactPackage, error) {
+	if result, err := a.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ArtifactPackage), nil
+	}
+}
+
+func (a artifactPackageDo) FirstOrCreate() (*models.ArtifactPackage, error) {
+	if result, err := a.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ArtifactPackage), nil
+	}
+}
+
+func (a artifactPackageDo) FindByPage(offset int, limit int) (result []*models.ArtifactPackage, count int64, err error) {
+	result, err = a.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = a.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (a artifactPackageDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = a.Count()
+	if err != nil {
+		return
+	}
+
+	err = a.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (a artifactPackageDo) Scan(result interface{}) (err error) {
+	return a.DO.Scan(result)
+}
+
+func (a artifactPackageDo) Delete(models ...*models.ArtifactPackage) (result gen.ResultInfo, err error) {
+	return a.DO.Delete(models)
+}
+
+func (a *artifactPackageDo) withDO(do gen.Dao) *artifactPackageDo {
+	a.DO = *do.(*gen.DO)
+	return a
+}
@@ -38,6 +38,8 @@ func newArtifactSbom(db *gorm.DB, opts ...gen.DOOption) artifactSbom {
 	_artifactSbom.Stdout = field.NewBytes(tableName, "stdout")
 	_artifactSbom.Stderr = field.NewBytes(tableName, "stderr")
 	_artifactSbom.Message = field.NewString(tableName, "message")
+	_artifactSbom.LicenseStatus = field.NewField(tableName, "license_status")
+	_artifactSbom.LicenseResult = field.NewBytes(tableName, "license_result")
 	_artifactSbom.Artifact = artifactSbomBelongsToArtifact{
 		db: db.Session(&gorm.Session{}),
 
@@ -215,19 +217,21 @@ func newArtifactSbom(db *gorm.DB, opts ...gen.DOOption) artifactSbom {
 type artifactSbom struct {
 	artifactSbomDo artifactSbomDo
 
-	ALL        field.Asterisk
-	CreatedAt  field.Int64
-	UpdatedAt  field.Int64
-	DeletedAt  field.Uint64
-	ID         field.Int64
-	ArtifactID field.Int64
-	Raw        field.Bytes
-	Result     field.Bytes
-	Status     field.Field
-	Stdout     field.Bytes
-	Stderr     field.Bytes
-	Message    field.String
-	Artifact   artifactSbomBelongsToArtifact
+	ALL           field.Asterisk
+	CreatedAt     field.Int64
+	UpdatedAt     field.Int64
+	DeletedAt     field.Uint64
+	ID            field.Int64
+	ArtifactID    field.Int64
+	Raw           field.Bytes
+	Result        field.Bytes
+	Status        field.Field
+	Stdout        field.Bytes
+	Stderr        field.Bytes
+	Message       field.String
+	LicenseStatus field.Field
+	LicenseResult field.Bytes
+	Artifact      artifactSbomBelongsToArtifact
 
 	fieldMap map[string]field.Expr
 }
@@ -255,6 +259,8 @@ func (a *artifactSbom) updateTableName(table string) *artifactSbom {
 	a.Stdout = field.NewBytes(table, "stdout")
 	a.Stderr = field.NewBytes(table, "stderr")
 	a.Message = field.NewString(table, "message")
+	a.LicenseStatus = field.NewField(table, "license_status")
+	a.LicenseResult = field.NewBytes(table, "license_result")
 
 	a.fillFieldMap()
 
@@ -283,7 +289,7 @@ func (a *artifactSbom) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (a *artifactSbom) fillFieldMap() {
-	a.fieldMap = make(map[string]field.Expr, 12)
+	a.fieldMap = make(map[string]field.Expr, 14)
 	a.fieldMap["created_at"] = a.CreatedAt
 	a.fieldMap["updated_at"] = a.UpdatedAt
 	a.fieldMap["deleted_at"] = a.DeletedAt
@@ -295,6 +301,8 @@ func (a *artifactSbom) fillFieldMap() {
 	a.fieldMap["stdout"] = a.Stdout
 	a.fieldMap["stderr"] = a.Stderr
 	a.fieldMap["message"] = a.Message
+	a.fieldMap["license_status"] = a.LicenseStatus
+	a.fieldMap["license_result"] = a.LicenseResult
 
 }
 
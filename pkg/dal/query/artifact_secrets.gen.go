@@ -0,0 +1,673 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+)
+
+func newArtifactSecret(db *gorm.DB, opts ...gen.DOOption) artifactSecret {
+	_artifactSecret := artifactSecret{}
+
+	_artifactSecret.artifactSecretDo.UseDB(db, opts...)
+	_artifactSecret.artifactSecretDo.UseModel(&models.ArtifactSecret{})
+
+	tableName := _artifactSecret.artifactSecretDo.TableName()
+	_artifactSecret.ALL = field.NewAsterisk(tableName)
+	_artifactSecret.CreatedAt = field.NewInt64(tableName, "created_at")
+	_artifactSecret.UpdatedAt = field.NewInt64(tableName, "updated_at")
+	_artifactSecret.DeletedAt = field.NewUint64(tableName, "deleted_at")
+	_artifactSecret.ID = field.NewInt64(tableName, "id")
+	_artifactSecret.ArtifactID = field.NewInt64(tableName, "artifact_id")
+	_artifactSecret.Raw = field.NewBytes(tableName, "raw")
+	_artifactSecret.Result = field.NewBytes(tableName, "result")
+	_artifactSecret.Status = field.NewField(tableName, "status")
+	_artifactSecret.Stdout = field.NewBytes(tableName, "stdout")
+	_artifactSecret.Stderr = field.NewBytes(tableName, "stderr")
+	_artifactSecret.Message = field.NewString(tableName, "message")
+	_artifactSecret.Artifact = artifactSecretBelongsToArtifact{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Artifact", "models.Artifact"),
+		Namespace: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Artifact.Namespace", "models.Namespace"),
+		},
+		Repository: struct {
+			field.RelationField
+			Namespace struct {
+				field.RelationField
+			}
+			Builder struct {
+				field.RelationField
+				Repository struct {
+					field.RelationField
+				}
+				CodeRepository struct {
+					field.RelationField
+					User3rdParty struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+						}
+					}
+					Branches struct {
+						field.RelationField
+					}
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Artifact.Repository", "models.Repository"),
+			Namespace: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Artifact.Repository.Namespace", "models.Namespace"),
+			},
+			Builder: struct {
+				field.RelationField
+				Repository struct {
+					field.RelationField
+				}
+				CodeRepository struct {
+					field.RelationField
+					User3rdParty struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+						}
+					}
+					Branches struct {
+						field.RelationField
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Artifact.Repository.Builder", "models.Builder"),
+				Repository: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Artifact.Repository.Builder.Repository", "models.Repository"),
+				},
+				CodeRepository: struct {
+					field.RelationField
+					User3rdParty struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+						}
+					}
+					Branches struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Artifact.Repository.Builder.CodeRepository", "models.CodeRepository"),
+					User3rdParty: struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Artifact.Repository.Builder.CodeRepository.User3rdParty", "models.User3rdParty"),
+						User: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Artifact.Repository.Builder.CodeRepository.User3rdParty.User", "models.User"),
+						},
+					},
+					Branches: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Artifact.Repository.Builder.CodeRepository.Branches", "models.CodeRepositoryBranch"),
+					},
+				},
+			},
+		},
+		Referrer: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Artifact.Referrer", "models.Artifact"),
+		},
+		Vulnerability: struct {
+			field.RelationField
+			Artifact struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Artifact.Vulnerability", "models.ArtifactVulnerability"),
+			Artifact: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Artifact.Vulnerability.Artifact", "models.Artifact"),
+			},
+		},
+		Sbom: struct {
+			field.RelationField
+			Artifact struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Artifact.Sbom", "models.ArtifactSbom"),
+			Artifact: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Artifact.Sbom.Artifact", "models.Artifact"),
+			},
+		},
+		Tags: struct {
+			field.RelationField
+			Repository struct {
+				field.RelationField
+			}
+			Artifact struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Artifact.Tags", "models.Tag"),
+			Repository: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Artifact.Tags.Repository", "models.Repository"),
+			},
+			Artifact: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Artifact.Tags.Artifact", "models.Artifact"),
+			},
+		},
+		ArtifactSubs: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Artifact.ArtifactSubs", "models.Artifact"),
+		},
+		Blobs: struct {
+			field.RelationField
+			Artifacts struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Artifact.Blobs", "models.Blob"),
+			Artifacts: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Artifact.Blobs.Artifacts", "models.Artifact"),
+			},
+		},
+	}
+
+	_artifactSecret.fillFieldMap()
+
+	return _artifactSecret
+}
+
+type artifactSecret struct {
+	artifactSecretDo artifactSecretDo
+
+	ALL        field.Asterisk
+	CreatedAt  field.Int64
+	UpdatedAt  field.Int64
+	DeletedAt  field.Uint64
+	ID         field.Int64
+	ArtifactID field.Int64
+	Raw        field.Bytes
+	Result     field.Bytes
+	Status     field.Field
+	Stdout     field.Bytes
+	Stderr     field.Bytes
+	Message    field.String
+	Artifact   artifactSecretBelongsToArtifact
+
+	fieldMap map[string]field.Expr
+}
+
+func (a artifactSecret) Table(newTableName string) *artifactSecret {
+	a.artifactSecretDo.UseTable(newTableName)
+	return a.updateTableName(newTableName)
+}
+
+func (a artifactSecret) As(alias string) *artifactSecret {
+	a.artifactSecretDo.DO = *(a.artifactSecretDo.As(alias).(*gen.DO))
+	return a.updateTableName(alias)
+}
+
+func (a *artifactSecret) updateTableName(table string) *artifactSecret {
+	a.ALL = field.NewAsterisk(table)
+	a.CreatedAt = field.NewInt64(table, "created_at")
+	a.UpdatedAt = field.NewInt64(table, "updated_at")
+	a.DeletedAt = field.NewUint64(table, "deleted_at")
+	a.ID = field.NewInt64(table, "id")
+	a.ArtifactID = field.NewInt64(table, "artifact_id")
+	a.Raw = field.NewBytes(table, "raw")
+	a.Result = field.NewBytes(table, "result")
+	a.Status = field.NewField(table, "status")
+	a.Stdout = field.NewBytes(table, "stdout")
+	a.Stderr = field.NewBytes(table, "stderr")
+	a.Message = field.NewString(table, "message")
+
+	a.fillFieldMap()
+
+	return a
+}
+
+func (a *artifactSecret) WithContext(ctx context.Context) *artifactSecretDo {
+	return a.artifactSecretDo.WithContext(ctx)
+}
+
+func (a artifactSecret) TableName() string { return a.artifactSecretDo.TableName() }
+
+func (a artifactSecret) Alias() string { return a.artifactSecretDo.Alias() }
+
+func (a artifactSecret) Columns(cols ...field.Expr) gen.Columns {
+	return a.artifactSecretDo.Columns(cols...)
+}
+
+func (a *artifactSecret) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := a.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (a *artifactSecret) fillFieldMap() {
+	a.fieldMap = make(map[string]field.Expr, 12)
+	a.fieldMap["created_at"] = a.CreatedAt
+	a.fieldMap["updated_at"] = a.UpdatedAt
+	a.fieldMap["deleted_at"] = a.DeletedAt
+	a.fieldMap["id"] = a.ID
+	a.fieldMap["artifact_id"] = a.ArtifactID
+	a.fieldMap["raw"] = a.Raw
+	a.fieldMap["result"] = a.Result
+	a.fieldMap["status"] = a.Status
+	a.fieldMap["stdout"] = a.Stdout
+	a.fieldMap["stderr"] = a.Stderr
+	a.fieldMap["message"] = a.Message
+
+}
+
+func (a artifactSecret) clone(db *gorm.DB) artifactSecret {
+	a.artifactSecretDo.ReplaceConnPool(db.Statement.ConnPool)
+	return a
+}
+
+func (a artifactSecret) replaceDB(db *gorm.DB) artifactSecret {
+	a.artifactSecretDo.ReplaceDB(db)
+	return a
+}
+
+type artifactSecretBelongsToArtifact struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Namespace struct {
+		field.RelationField
+	}
+	Repository struct {
+		field.RelationField
+		Namespace struct {
+			field.RelationField
+		}
+		Builder struct {
+			field.RelationField
+			Repository struct {
+				field.RelationField
+			}
+			CodeRepository struct {
+				field.RelationField
+				User3rdParty struct {
+					field.RelationField
+					User struct {
+						field.RelationField
+					}
+				}
+				Branches struct {
+					field.RelationField
+				}
+			}
+		}
+	}
+	Referrer struct {
+		field.RelationField
+	}
+	Vulnerability struct {
+		field.RelationField
+		Artifact struct {
+			field.RelationField
+		}
+	}
+	Sbom struct {
+		field.RelationField
+		Artifact struct {
+			field.RelationField
+		}
+	}
+	Tags struct {
+		field.RelationField
+		Repository struct {
+			field.RelationField
+		}
+		Artifact struct {
+			field.RelationField
+		}
+	}
+	ArtifactSubs struct {
+		field.RelationField
+	}
+	Blobs struct {
+		field.RelationField
+		Artifacts struct {
+			field.RelationField
+		}
+	}
+}
+
+func (a artifactSecretBelongsToArtifact) Where(conds ...field.Expr) *artifactSecretBelongsToArtifact {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a artifactSecretBelongsToArtifact) WithContext(ctx context.Context) *artifactSecretBelongsToArtifact {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a artifactSecretBelongsToArtifact) Session(session *gorm.Session) *artifactSecretBelongsToArtifact {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a artifactSecretBelongsToArtifact) Model(m *models.ArtifactSecret) *artifactSecretBelongsToArtifactTx {
+	return &artifactSecretBelongsToArtifactTx{a.db.Model(m).Association(a.Name())}
+}
+
+type artifactSecretBelongsToArtifactTx struct{ tx *gorm.Association }
+
+func (a artifactSecretBelongsToArtifactTx) Find() (result *models.Artifact, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a artifactSecretBelongsToArtifactTx) Append(values ...*models.Artifact) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a artifactSecretBelongsToArtifactTx) Replace(values ...*models.Artifact) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a artifactSecretBelongsToArtifactTx) Delete(values ...*models.Artifact) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a artifactSecretBelongsToArtifactTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a artifactSecretBelongsToArtifactTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type artifactSecretDo struct{ gen.DO }
+
+func (a artifactSecretDo) Debug() *artifactSecretDo {
+	return a.withDO(a.DO.Debug())
+}
+
+func (a artifactSecretDo) WithContext(ctx context.Context) *artifactSecretDo {
+	return a.withDO(a.DO.WithContext(ctx))
+}
+
+func (a artifactSecretDo) ReadDB() *artifactSecretDo {
+	return a.Clauses(dbresolver.Read)
+}
+
+func (a artifactSecretDo) WriteDB() *artifactSecretDo {
+	return a.Clauses(dbresolver.Write)
+}
+
+func (a artifactSecretDo) Session(config *gorm.Session) *artifactSecretDo {
+	return a.withDO(a.DO.Session(config))
+}
+
+func (a artifactSecretDo) Clauses(conds ...clause.Expression) *artifactSecretDo {
+	return a.withDO(a.DO.Clauses(conds...))
+}
+
+func (a artifactSecretDo) Returning(value interface{}, columns ...string) *artifactSecretDo {
+	return a.withDO(a.DO.Returning(value, columns...))
+}
+
+func (a artifactSecretDo) Not(conds ...gen.Condition) *artifactSecretDo {
+	return a.withDO(a.DO.Not(conds...))
+}
+
+func (a artifactSecretDo) Or(conds ...gen.Condition) *artifactSecretDo {
+	return a.withDO(a.DO.Or(conds...))
+}
+
+func (a artifactSecretDo) Select(conds ...field.Expr) *artifactSecretDo {
+	return a.withDO(a.DO.Select(conds...))
+}
+
+func (a artifactSecretDo) Where(conds ...gen.Condition) *artifactSecretDo {
+	return a.withDO(a.DO.Where(conds...))
+}
+
+func (a artifactSecretDo) Order(conds ...field.Expr) *artifactSecretDo {
+	return a.withDO(a.DO.Order(conds...))
+}
+
+func (a artifactSecretDo) Distinct(cols ...field.Expr) *artifactSecretDo {
+	return a.withDO(a.DO.Distinct(cols...))
+}
+
+func (a artifactSecretDo) Omit(cols ...field.Expr) *artifactSecretDo {
+	return a.withDO(a.DO.Omit(cols...))
+}
+
+func (a artifactSecretDo) Join(table schema.Tabler, on ...field.Expr) *artifactSecretDo {
+	return a.withDO(a.DO.Join(table, on...))
+}
+
+func (a artifactSecretDo) LeftJoin(table schema.Tabler, on ...field.Expr) *artifactSecretDo {
+	return a.withDO(a.DO.LeftJoin(table, on...))
+}
+
+func (a artifactSecretDo) RightJoin(table schema.Tabler, on ...field.Expr) *artifactSecretDo {
+	return a.withDO(a.DO.RightJoin(table, on...))
+}
+
+func (a artifactSecretDo) Group(cols ...field.Expr) *artifactSecretDo {
+	return a.withDO(a.DO.Group(cols...))
+}
+
+func (a artifactSecretDo) Having(conds ...gen.Condition) *artifactSecretDo {
+	return a.withDO(a.DO.Having(conds...))
+}
+
+func (a artifactSecretDo) Limit(limit int) *artifactSecretDo {
+	return a.withDO(a.DO.Limit(limit))
+}
+
+func (a artifactSecretDo) Offset(offset int) *artifactSecretDo {
+	return a.withDO(a.DO.Offset(offset))
+}
+
+func (a artifactSecretDo) Scopes(funcs ...func(gen.Dao) gen.Dao) *artifactSecretDo {
+	return a.withDO(a.DO.Scopes(funcs...))
+}
+
+func (a artifactSecretDo) Unscoped() *artifactSecretDo {
+	return a.withDO(a.DO.Unscoped())
+}
+
+func (a artifactSecretDo) Create(values ...*models.ArtifactSecret) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Create(values)
+}
+
+func (a artifactSecretDo) CreateInBatches(values []*models.ArtifactSecret, batchSize int) error {
+	return a.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (a artifactSecretDo) Save(values ...*models.ArtifactSecret) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Save(values)
+}
+
+func (a artifactSecretDo) First() (*models.ArtifactSecret, error) {
+	if result, err := a.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ArtifactSecret), nil
+	}
+}
+
+func (a artifactSecretDo) Take() (*models.ArtifactSecret, error) {
+	if result, err := a.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ArtifactSecret), nil
+	}
+}
+
+func (a artifactSecretDo) Last() (*models.ArtifactSecret, error) {
+	if result, err := a.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ArtifactSecret), nil
+	}
+}
+
+func (a artifactSecretDo) Find() ([]*models.ArtifactSecret, error) {
+	result, err := a.DO.Find()
+	return result.([]*models.ArtifactSecret), err
+}
+
+func (a artifactSecretDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.ArtifactSecret, err error) {
+	buf := make([]*models.ArtifactSecret, 0, batchSize)
+	err = a.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (a artifactSecretDo) FindInBatches(result *[]*models.ArtifactSecret, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return a.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (a artifactSecretDo) Attrs(attrs ...field.AssignExpr) *artifactSecretDo {
+	return a.withDO(a.DO.Attrs(attrs...))
+}
+
+func (a artifactSecretDo) Assign(attrs ...field.AssignExpr) *artifactSecretDo {
+	return a.withDO(a.DO.Assign(attrs...))
+}
+
+func (a artifactSecretDo) Joins(fields ...field.RelationField) *artifactSecretDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Joins(_f))
+	}
+	return &a
+}
+
+func (a artifactSecretDo) Preload(fields ...field.RelationField) *artifactSecretDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Preload(_f))
+	}
+	return &a
+}
+
+func (a artifactSecretDo) FirstOrInit() (*models.ArtifactSecret, error) {
+	if result, err := a.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ArtifactSecret), nil
+	}
+}
+
+func (a artifactSecretDo) FirstOrCreate() (*models.ArtifactSecret, error) {
+	if result, err := a.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ArtifactSecret), nil
+	}
+}
+
+func (a artifactSecretDo) FindByPage(offset int, limit int) (result []*models.ArtifactSecret, count int64, err error) {
+	result, err = a.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = a.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (a artifactSecretDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = a.Count()
+	if err != nil {
+		return
+	}
+
+	err = a.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (a artifactSecretDo) Scan(result interface{}) (err error) {
+	return a.DO.Scan(result)
+}
+
+func (a artifactSecretDo) Delete(models ...*models.ArtifactSecret) (result gen.ResultInfo, err error) {
+	return a.DO.Delete(models)
+}
+
+func (a *artifactSecretDo) withDO(do gen.Dao) *artifactSecretDo {
+	a.DO = *do.(*gen.DO)
+	return a
+}
@@ -32,10 +32,12 @@ func newArtifactVulnerability(db *gorm.DB, opts ...gen.DOOption) artifactVulnera
 	_artifactVulnerability.DeletedAt = field.NewUint64(tableName, "deleted_at")
 	_artifactVulnerability.ID = field.NewInt64(tableName, "id")
 	_artifactVulnerability.ArtifactID = field.NewInt64(tableName, "artifact_id")
+	_artifactVulnerability.Scanner = field.NewField(tableName, "scanner")
 	_artifactVulnerability.Metadata = field.NewBytes(tableName, "metadata")
 	_artifactVulnerability.Raw = field.NewBytes(tableName, "raw")
 	_artifactVulnerability.Result = field.NewBytes(tableName, "result")
 	_artifactVulnerability.Status = field.NewField(tableName, "status")
+	_artifactVulnerability.ScanStatus = field.NewField(tableName, "scan_status")
 	_artifactVulnerability.Stdout = field.NewBytes(tableName, "stdout")
 	_artifactVulnerability.Stderr = field.NewBytes(tableName, "stderr")
 	_artifactVulnerability.Message = field.NewString(tableName, "message")
@@ -222,10 +224,12 @@ type artifactVulnerability struct {
 	DeletedAt  field.Uint64
 	ID         field.Int64
 	ArtifactID field.Int64
+	Scanner    field.Field
 	Metadata   field.Bytes
 	Raw        field.Bytes
 	Result     field.Bytes
 	Status     field.Field
+	ScanStatus field.Field
 	Stdout     field.Bytes
 	Stderr     field.Bytes
 	Message    field.String
@@ -251,10 +255,12 @@ func (a *artifactVulnerability) updateTableName(table string) *artifactVulnerabi
 	a.DeletedAt = field.NewUint64(table, "deleted_at")
 	a.ID = field.NewInt64(table, "id")
 	a.ArtifactID = field.NewInt64(table, "artifact_id")
+	a.Scanner = field.NewField(table, "scanner")
 	a.Metadata = field.NewBytes(table, "metadata")
 	a.Raw = field.NewBytes(table, "raw")
 	a.Result = field.NewBytes(table, "result")
 	a.Status = field.NewField(table, "status")
+	a.ScanStatus = field.NewField(table, "scan_status")
 	a.Stdout = field.NewBytes(table, "stdout")
 	a.Stderr = field.NewBytes(table, "stderr")
 	a.Message = field.NewString(table, "message")
@@ -286,16 +292,18 @@ func (a *artifactVulnerability) GetFieldByName(fieldName string) (field.OrderExp
 }
 
 func (a *artifactVulnerability) fillFieldMap() {
-	a.fieldMap = make(map[string]field.Expr, 13)
+	a.fieldMap = make(map[string]field.Expr, 15)
 	a.fieldMap["created_at"] = a.CreatedAt
 	a.fieldMap["updated_at"] = a.UpdatedAt
 	a.fieldMap["deleted_at"] = a.DeletedAt
 	a.fieldMap["id"] = a.ID
 	a.fieldMap["artifact_id"] = a.ArtifactID
+	a.fieldMap["scanner"] = a.Scanner
 	a.fieldMap["metadata"] = a.Metadata
 	a.fieldMap["raw"] = a.Raw
 	a.fieldMap["result"] = a.Result
 	a.fieldMap["status"] = a.Status
+	a.fieldMap["scan_status"] = a.ScanStatus
 	a.fieldMap["stdout"] = a.Stdout
 	a.fieldMap["stderr"] = a.Stderr
 	a.fieldMap["message"] = a.Message
@@ -41,6 +41,7 @@ func newArtifact(db *gorm.DB, opts ...gen.DOOption) artifact {
 	_artifact.Raw = field.NewBytes(tableName, "raw")
 	_artifact.ConfigRaw = field.NewBytes(tableName, "config_raw")
 	_artifact.ConfigMediaType = field.NewString(tableName, "config_media_type")
+	_artifact.ArtifactType = field.NewString(tableName, "artifact_type")
 	_artifact.Type = field.NewField(tableName, "type")
 	_artifact.LastPull = field.NewInt64(tableName, "last_pull")
 	_artifact.PushedAt = field.NewInt64(tableName, "pushed_at")
@@ -333,6 +334,7 @@ type artifact struct {
 	Raw             field.Bytes
 	ConfigRaw       field.Bytes
 	ConfigMediaType field.String
+	ArtifactType    field.String
 	Type            field.Field
 	LastPull        field.Int64
 	PushedAt        field.Int64
@@ -382,6 +384,7 @@ func (a *artifact) updateTableName(table string) *artifact {
 	a.Raw = field.NewBytes(table, "raw")
 	a.ConfigRaw = field.NewBytes(table, "config_raw")
 	a.ConfigMediaType = field.NewString(table, "config_media_type")
+	a.ArtifactType = field.NewString(table, "artifact_type")
 	a.Type = field.NewField(table, "type")
 	a.LastPull = field.NewInt64(table, "last_pull")
 	a.PushedAt = field.NewInt64(table, "pushed_at")
@@ -411,7 +414,7 @@ func (a *artifact) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (a *artifact) fillFieldMap() {
-	a.fieldMap = make(map[string]field.Expr, 26)
+	a.fieldMap = make(map[string]field.Expr, 27)
 	a.fieldMap["created_at"] = a.CreatedAt
 	a.fieldMap["updated_at"] = a.UpdatedAt
 	a.fieldMap["deleted_at"] = a.DeletedAt
@@ -425,6 +428,7 @@ func (a *artifact) fillFieldMap() {
 	a.fieldMap["raw"] = a.Raw
 	a.fieldMap["config_raw"] = a.ConfigRaw
 	a.fieldMap["config_media_type"] = a.ConfigMediaType
+	a.fieldMap["artifact_type"] = a.ArtifactType
 	a.fieldMap["type"] = a.Type
 	a.fieldMap["last_pull"] = a.LastPull
 	a.fieldMap["pushed_at"] = a.PushedAt
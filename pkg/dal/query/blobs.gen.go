@@ -38,6 +38,7 @@ func newBlob(db *gorm.DB, opts ...gen.DOOption) blob {
 	_blob.LastPull = field.NewInt64(tableName, "last_pull")
 	_blob.PushedAt = field.NewInt64(tableName, "pushed_at")
 	_blob.PullTimes = field.NewUint(tableName, "pull_times")
+	_blob.LastCheck = field.NewInt64(tableName, "last_check")
 	_blob.Artifacts = blobManyToManyArtifacts{
 		db: db.Session(&gorm.Session{}),
 
@@ -226,6 +227,7 @@ type blob struct {
 	LastPull    field.Int64
 	PushedAt    field.Int64
 	PullTimes   field.Uint
+	LastCheck   field.Int64
 	Artifacts   blobManyToManyArtifacts
 
 	fieldMap map[string]field.Expr
@@ -253,6 +255,7 @@ func (b *blob) updateTableName(table string) *blob {
 	b.LastPull = field.NewInt64(table, "last_pull")
 	b.PushedAt = field.NewInt64(table, "pushed_at")
 	b.PullTimes = field.NewUint(table, "pull_times")
+	b.LastCheck = field.NewInt64(table, "last_check")
 
 	b.fillFieldMap()
 
@@ -277,7 +280,7 @@ func (b *blob) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (b *blob) fillFieldMap() {
-	b.fieldMap = make(map[string]field.Expr, 11)
+	b.fieldMap = make(map[string]field.Expr, 12)
 	b.fieldMap["created_at"] = b.CreatedAt
 	b.fieldMap["updated_at"] = b.UpdatedAt
 	b.fieldMap["deleted_at"] = b.DeletedAt
@@ -288,6 +291,7 @@ func (b *blob) fillFieldMap() {
 	b.fieldMap["last_pull"] = b.LastPull
 	b.fieldMap["pushed_at"] = b.PushedAt
 	b.fieldMap["pull_times"] = b.PullTimes
+	b.fieldMap["last_check"] = b.LastCheck
 
 }
 
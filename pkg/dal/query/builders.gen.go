@@ -56,6 +56,7 @@ func newBuilder(db *gorm.DB, opts ...gen.DOOption) builder {
 	_builder.BuildkitDockerfile = field.NewString(tableName, "buildkit_dockerfile")
 	_builder.BuildkitPlatforms = field.NewString(tableName, "buildkit_platforms")
 	_builder.BuildkitBuildArgs = field.NewString(tableName, "buildkit_build_args")
+	_builder.SigningType = field.NewField(tableName, "signing_type")
 	_builder.Repository = builderBelongsToRepository{
 		db: db.Session(&gorm.Session{}),
 
@@ -168,6 +169,7 @@ type builder struct {
 	BuildkitDockerfile         field.String
 	BuildkitPlatforms          field.String
 	BuildkitBuildArgs          field.String
+	SigningType                field.Field
 	Repository                 builderBelongsToRepository
 
 	CodeRepository builderBelongsToCodeRepository
@@ -216,6 +218,7 @@ func (b *builder) updateTableName(table string) *builder {
 	b.BuildkitDockerfile = field.NewString(table, "buildkit_dockerfile")
 	b.BuildkitPlatforms = field.NewString(table, "buildkit_platforms")
 	b.BuildkitBuildArgs = field.NewString(table, "buildkit_build_args")
+	b.SigningType = field.NewField(table, "signing_type")
 
 	b.fillFieldMap()
 
@@ -240,7 +243,7 @@ func (b *builder) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (b *builder) fillFieldMap() {
-	b.fieldMap = make(map[string]field.Expr, 31)
+	b.fieldMap = make(map[string]field.Expr, 32)
 	b.fieldMap["created_at"] = b.CreatedAt
 	b.fieldMap["updated_at"] = b.UpdatedAt
 	b.fieldMap["deleted_at"] = b.DeletedAt
@@ -270,6 +273,7 @@ func (b *builder) fillFieldMap() {
 	b.fieldMap["buildkit_dockerfile"] = b.BuildkitDockerfile
 	b.fieldMap["buildkit_platforms"] = b.BuildkitPlatforms
 	b.fieldMap["buildkit_build_args"] = b.BuildkitBuildArgs
+	b.fieldMap["signing_type"] = b.SigningType
 
 }
 
@@ -32,8 +32,10 @@ func newDaemonGcArtifactRecord(db *gorm.DB, opts ...gen.DOOption) daemonGcArtifa
 	_daemonGcArtifactRecord.DeletedAt = field.NewUint64(tableName, "deleted_at")
 	_daemonGcArtifactRecord.ID = field.NewInt64(tableName, "id")
 	_daemonGcArtifactRecord.RunnerID = field.NewInt64(tableName, "runner_id")
+	_daemonGcArtifactRecord.NamespaceID = field.NewInt64(tableName, "namespace_id")
 	_daemonGcArtifactRecord.Digest = field.NewString(tableName, "digest")
 	_daemonGcArtifactRecord.Status = field.NewField(tableName, "status")
+	_daemonGcArtifactRecord.Size = field.NewInt64(tableName, "size")
 	_daemonGcArtifactRecord.Message = field.NewBytes(tableName, "message")
 	_daemonGcArtifactRecord.Runner = daemonGcArtifactRecordBelongsToRunner{
 		db: db.Session(&gorm.Session{}),
@@ -67,16 +69,18 @@ func newDaemonGcArtifactRecord(db *gorm.DB, opts ...gen.DOOption) daemonGcArtifa
 type daemonGcArtifactRecord struct {
 	daemonGcArtifactRecordDo daemonGcArtifactRecordDo
 
-	ALL       field.Asterisk
-	CreatedAt field.Int64
-	UpdatedAt field.Int64
-	DeletedAt field.Uint64
-	ID        field.Int64
-	RunnerID  field.Int64
-	Digest    field.String
-	Status    field.Field
-	Message   field.Bytes
-	Runner    daemonGcArtifactRecordBelongsToRunner
+	ALL         field.Asterisk
+	CreatedAt   field.Int64
+	UpdatedAt   field.Int64
+	DeletedAt   field.Uint64
+	ID          field.Int64
+	RunnerID    field.Int64
+	NamespaceID field.Int64
+	Digest      field.String
+	Status      field.Field
+	Size        field.Int64
+	Message     field.Bytes
+	Runner      daemonGcArtifactRecordBelongsToRunner
 
 	fieldMap map[string]field.Expr
 }
@@ -98,8 +102,10 @@ func (d *daemonGcArtifactRecord) updateTableName(table string) *daemonGcArtifact
 	d.DeletedAt = field.NewUint64(table, "deleted_at")
 	d.ID = field.NewInt64(table, "id")
 	d.RunnerID = field.NewInt64(table, "runner_id")
+	d.NamespaceID = field.NewInt64(table, "namespace_id")
 	d.Digest = field.NewString(table, "digest")
 	d.Status = field.NewField(table, "status")
+	d.Size = field.NewInt64(table, "size")
 	d.Message = field.NewBytes(table, "message")
 
 	d.fillFieldMap()
@@ -129,14 +135,16 @@ func (d *daemonGcArtifactRecord) GetFieldByName(fieldName string) (field.OrderEx
 }
 
 func (d *daemonGcArtifactRecord) fillFieldMap() {
-	d.fieldMap = make(map[string]field.Expr, 9)
+	d.fieldMap = make(map[string]field.Expr, 11)
 	d.fieldMap["created_at"] = d.CreatedAt
 	d.fieldMap["updated_at"] = d.UpdatedAt
 	d.fieldMap["deleted_at"] = d.DeletedAt
 	d.fieldMap["id"] = d.ID
 	d.fieldMap["runner_id"] = d.RunnerID
+	d.fieldMap["namespace_id"] = d.NamespaceID
 	d.fieldMap["digest"] = d.Digest
 	d.fieldMap["status"] = d.Status
+	d.fieldMap["size"] = d.Size
 	d.fieldMap["message"] = d.Message
 
 }
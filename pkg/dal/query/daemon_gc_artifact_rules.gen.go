@@ -37,6 +37,7 @@ func newDaemonGcArtifactRule(db *gorm.DB, opts ...gen.DOOption) daemonGcArtifact
 	_daemonGcArtifactRule.CronEnabled = field.NewBool(tableName, "cron_enabled")
 	_daemonGcArtifactRule.CronRule = field.NewString(tableName, "cron_rule")
 	_daemonGcArtifactRule.CronNextTrigger = field.NewInt64(tableName, "cron_next_trigger")
+	_daemonGcArtifactRule.ReferrerRetentionDay = field.NewInt(tableName, "referrer_retention_day")
 	_daemonGcArtifactRule.Namespace = daemonGcArtifactRuleBelongsToNamespace{
 		db: db.Session(&gorm.Session{}),
 
@@ -51,18 +52,19 @@ func newDaemonGcArtifactRule(db *gorm.DB, opts ...gen.DOOption) daemonGcArtifact
 type daemonGcArtifactRule struct {
 	daemonGcArtifactRuleDo daemonGcArtifactRuleDo
 
-	ALL             field.Asterisk
-	CreatedAt       field.Int64
-	UpdatedAt       field.Int64
-	DeletedAt       field.Uint64
-	ID              field.Int64
-	NamespaceID     field.Int64
-	IsRunning       field.Bool
-	RetentionDay    field.Int
-	CronEnabled     field.Bool
-	CronRule        field.String
-	CronNextTrigger field.Int64
-	Namespace       daemonGcArtifactRuleBelongsToNamespace
+	ALL                  field.Asterisk
+	CreatedAt            field.Int64
+	UpdatedAt            field.Int64
+	DeletedAt            field.Uint64
+	ID                   field.Int64
+	NamespaceID          field.Int64
+	IsRunning            field.Bool
+	RetentionDay         field.Int
+	CronEnabled          field.Bool
+	CronRule             field.String
+	CronNextTrigger      field.Int64
+	ReferrerRetentionDay field.Int
+	Namespace            daemonGcArtifactRuleBelongsToNamespace
 
 	fieldMap map[string]field.Expr
 }
@@ -89,6 +91,7 @@ func (d *daemonGcArtifactRule) updateTableName(table string) *daemonGcArtifactRu
 	d.CronEnabled = field.NewBool(table, "cron_enabled")
 	d.CronRule = field.NewString(table, "cron_rule")
 	d.CronNextTrigger = field.NewInt64(table, "cron_next_trigger")
+	d.ReferrerRetentionDay = field.NewInt(table, "referrer_retention_day")
 
 	d.fillFieldMap()
 
@@ -117,7 +120,7 @@ func (d *daemonGcArtifactRule) GetFieldByName(fieldName string) (field.OrderExpr
 }
 
 func (d *daemonGcArtifactRule) fillFieldMap() {
-	d.fieldMap = make(map[string]field.Expr, 11)
+	d.fieldMap = make(map[string]field.Expr, 12)
 	d.fieldMap["created_at"] = d.CreatedAt
 	d.fieldMap["updated_at"] = d.UpdatedAt
 	d.fieldMap["deleted_at"] = d.DeletedAt
@@ -128,6 +131,7 @@ func (d *daemonGcArtifactRule) fillFieldMap() {
 	d.fieldMap["cron_enabled"] = d.CronEnabled
 	d.fieldMap["cron_rule"] = d.CronRule
 	d.fieldMap["cron_next_trigger"] = d.CronNextTrigger
+	d.fieldMap["referrer_retention_day"] = d.ReferrerRetentionDay
 
 }
 
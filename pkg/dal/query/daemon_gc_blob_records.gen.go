@@ -34,6 +34,7 @@ func newDaemonGcBlobRecord(db *gorm.DB, opts ...gen.DOOption) daemonGcBlobRecord
 	_daemonGcBlobRecord.RunnerID = field.NewInt64(tableName, "runner_id")
 	_daemonGcBlobRecord.Digest = field.NewString(tableName, "digest")
 	_daemonGcBlobRecord.Status = field.NewField(tableName, "status")
+	_daemonGcBlobRecord.Size = field.NewInt64(tableName, "size")
 	_daemonGcBlobRecord.Message = field.NewBytes(tableName, "message")
 	_daemonGcBlobRecord.Runner = daemonGcBlobRecordBelongsToRunner{
 		db: db.Session(&gorm.Session{}),
@@ -67,6 +68,7 @@ type daemonGcBlobRecord struct {
 	RunnerID  field.Int64
 	Digest    field.String
 	Status    field.Field
+	Size      field.Int64
 	Message   field.Bytes
 	Runner    daemonGcBlobRecordBelongsToRunner
 
@@ -92,6 +94,7 @@ func (d *daemonGcBlobRecord) updateTableName(table string) *daemonGcBlobRecord {
 	d.RunnerID = field.NewInt64(table, "runner_id")
 	d.Digest = field.NewString(table, "digest")
 	d.Status = field.NewField(table, "status")
+	d.Size = field.NewInt64(table, "size")
 	d.Message = field.NewBytes(table, "message")
 
 	d.fillFieldMap()
@@ -121,7 +124,7 @@ func (d *daemonGcBlobRecord) GetFieldByName(fieldName string) (field.OrderExpr,
 }
 
 func (d *daemonGcBlobRecord) fillFieldMap() {
-	d.fieldMap = make(map[string]field.Expr, 9)
+	d.fieldMap = make(map[string]field.Expr, 10)
 	d.fieldMap["created_at"] = d.CreatedAt
 	d.fieldMap["updated_at"] = d.UpdatedAt
 	d.fieldMap["deleted_at"] = d.DeletedAt
@@ -129,6 +132,7 @@ func (d *daemonGcBlobRecord) fillFieldMap() {
 	d.fieldMap["runner_id"] = d.RunnerID
 	d.fieldMap["digest"] = d.Digest
 	d.fieldMap["status"] = d.Status
+	d.fieldMap["size"] = d.Size
 	d.fieldMap["message"] = d.Message
 
 }
@@ -41,6 +41,8 @@ func newDaemonGcBlobRunner(db *gorm.DB, opts ...gen.DOOption) daemonGcBlobRunner
 	_daemonGcBlobRunner.Duration = field.NewInt64(tableName, "duration")
 	_daemonGcBlobRunner.SuccessCount = field.NewInt64(tableName, "success_count")
 	_daemonGcBlobRunner.FailedCount = field.NewInt64(tableName, "failed_count")
+	_daemonGcBlobRunner.DryRun = field.NewBool(tableName, "dry_run")
+	_daemonGcBlobRunner.ReclaimableSize = field.NewInt64(tableName, "reclaimable_size")
 	_daemonGcBlobRunner.Rule = daemonGcBlobRunnerBelongsToRule{
 		db: db.Session(&gorm.Session{}),
 
@@ -61,22 +63,24 @@ func newDaemonGcBlobRunner(db *gorm.DB, opts ...gen.DOOption) daemonGcBlobRunner
 type daemonGcBlobRunner struct {
 	daemonGcBlobRunnerDo daemonGcBlobRunnerDo
 
-	ALL           field.Asterisk
-	CreatedAt     field.Int64
-	UpdatedAt     field.Int64
-	DeletedAt     field.Uint64
-	ID            field.Int64
-	RuleID        field.Int64
-	Status        field.Field
-	Message       field.Bytes
-	OperateType   field.Field
-	OperateUserID field.Int64
-	StartedAt     field.Int64
-	EndedAt       field.Int64
-	Duration      field.Int64
-	SuccessCount  field.Int64
-	FailedCount   field.Int64
-	Rule          daemonGcBlobRunnerBelongsToRule
+	ALL             field.Asterisk
+	CreatedAt       field.Int64
+	UpdatedAt       field.Int64
+	DeletedAt       field.Uint64
+	ID              field.Int64
+	RuleID          field.Int64
+	Status          field.Field
+	Message         field.Bytes
+	OperateType     field.Field
+	OperateUserID   field.Int64
+	StartedAt       field.Int64
+	EndedAt         field.Int64
+	Duration        field.Int64
+	SuccessCount    field.Int64
+	FailedCount     field.Int64
+	DryRun          field.Bool
+	ReclaimableSize field.Int64
+	Rule            daemonGcBlobRunnerBelongsToRule
 
 	OperateUser daemonGcBlobRunnerBelongsToOperateUser
 
@@ -109,6 +113,8 @@ func (d *daemonGcBlobRunner) updateTableName(table string) *daemonGcBlobRunner {
 	d.Duration = field.NewInt64(table, "duration")
 	d.SuccessCount = field.NewInt64(table, "success_count")
 	d.FailedCount = field.NewInt64(table, "failed_count")
+	d.DryRun = field.NewBool(table, "dry_run")
+	d.ReclaimableSize = field.NewInt64(table, "reclaimable_size")
 
 	d.fillFieldMap()
 
@@ -137,7 +143,7 @@ func (d *daemonGcBlobRunner) GetFieldByName(fieldName string) (field.OrderExpr,
 }
 
 func (d *daemonGcBlobRunner) fillFieldMap() {
-	d.fieldMap = make(map[string]field.Expr, 16)
+	d.fieldMap = make(map[string]field.Expr, 18)
 	d.fieldMap["created_at"] = d.CreatedAt
 	d.fieldMap["updated_at"] = d.UpdatedAt
 	d.fieldMap["deleted_at"] = d.DeletedAt
@@ -152,6 +158,8 @@ func (d *daemonGcBlobRunner) fillFieldMap() {
 	d.fieldMap["duration"] = d.Duration
 	d.fieldMap["success_count"] = d.SuccessCount
 	d.fieldMap["failed_count"] = d.FailedCount
+	d.fieldMap["dry_run"] = d.DryRun
+	d.fieldMap["reclaimable_size"] = d.ReclaimableSize
 
 }
 
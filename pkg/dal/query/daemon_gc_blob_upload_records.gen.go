@@ -0,0 +1,462 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+)
+
+func newDaemonGcBlobUploadRecord(db *gorm.DB, opts ...gen.DOOption) daemonGcBlobUploadRecord {
+	_daemonGcBlobUploadRecord := daemonGcBlobUploadRecord{}
+
+	_daemonGcBlobUploadRecord.daemonGcBlobUploadRecordDo.UseDB(db, opts...)
+	_daemonGcBlobUploadRecord.daemonGcBlobUploadRecordDo.UseModel(&models.DaemonGcBlobUploadRecord{})
+
+	tableName := _daemonGcBlobUploadRecord.daemonGcBlobUploadRecordDo.TableName()
+	_daemonGcBlobUploadRecord.ALL = field.NewAsterisk(tableName)
+	_daemonGcBlobUploadRecord.CreatedAt = field.NewInt64(tableName, "created_at")
+	_daemonGcBlobUploadRecord.UpdatedAt = field.NewInt64(tableName, "updated_at")
+	_daemonGcBlobUploadRecord.DeletedAt = field.NewUint64(tableName, "deleted_at")
+	_daemonGcBlobUploadRecord.ID = field.NewInt64(tableName, "id")
+	_daemonGcBlobUploadRecord.RunnerID = field.NewInt64(tableName, "runner_id")
+	_daemonGcBlobUploadRecord.UploadID = field.NewString(tableName, "upload_id")
+	_daemonGcBlobUploadRecord.Repository = field.NewString(tableName, "repository")
+	_daemonGcBlobUploadRecord.Status = field.NewField(tableName, "status")
+	_daemonGcBlobUploadRecord.Size = field.NewInt64(tableName, "size")
+	_daemonGcBlobUploadRecord.Message = field.NewBytes(tableName, "message")
+	_daemonGcBlobUploadRecord.Runner = daemonGcBlobUploadRecordBelongsToRunner{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Runner", "models.DaemonGcBlobUploadRunner"),
+		Rule: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Runner.Rule", "models.DaemonGcBlobUploadRule"),
+		},
+		OperateUser: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Runner.OperateUser", "models.User"),
+		},
+	}
+
+	_daemonGcBlobUploadRecord.fillFieldMap()
+
+	return _daemonGcBlobUploadRecord
+}
+
+type daemonGcBlobUploadRecord struct {
+	daemonGcBlobUploadRecordDo daemonGcBlobUploadRecordDo
+
+	ALL        field.Asterisk
+	CreatedAt  field.Int64
+	UpdatedAt  field.Int64
+	DeletedAt  field.Uint64
+	ID         field.Int64
+	RunnerID   field.Int64
+	UploadID   field.String
+	Repository field.String
+	Status     field.Field
+	Size       field.Int64
+	Message    field.Bytes
+	Runner     daemonGcBlobUploadRecordBelongsToRunner
+
+	fieldMap map[string]field.Expr
+}
+
+func (d daemonGcBlobUploadRecord) Table(newTableName string) *daemonGcBlobUploadRecord {
+	d.daemonGcBlobUploadRecordDo.UseTable(newTableName)
+	return d.updateTableName(newTableName)
+}
+
+func (d daemonGcBlobUploadRecord) As(alias string) *daemonGcBlobUploadRecord {
+	d.daemonGcBlobUploadRecordDo.DO = *(d.daemonGcBlobUploadRecordDo.As(alias).(*gen.DO))
+	return d.updateTableName(alias)
+}
+
+func (d *daemonGcBlobUploadRecord) updateTableName(table string) *daemonGcBlobUploadRecord {
+	d.ALL = field.NewAsterisk(table)
+	d.CreatedAt = field.NewInt64(table, "created_at")
+	d.UpdatedAt = field.NewInt64(table, "updated_at")
+	d.DeletedAt = field.NewUint64(table, "deleted_at")
+	d.ID = field.NewInt64(table, "id")
+	d.RunnerID = field.NewInt64(table, "runner_id")
+	d.UploadID = field.NewString(table, "upload_id")
+	d.Repository = field.NewString(table, "repository")
+	d.Status = field.NewField(table, "status")
+	d.Size = field.NewInt64(table, "size")
+	d.Message = field.NewBytes(table, "message")
+
+	d.fillFieldMap()
+
+	return d
+}
+
+func (d *daemonGcBlobUploadRecord) WithContext(ctx context.Context) *daemonGcBlobUploadRecordDo {
+	return d.daemonGcBlobUploadRecordDo.WithContext(ctx)
+}
+
+func (d daemonGcBlobUploadRecord) TableName() string { return d.daemonGcBlobUploadRecordDo.TableName() }
+
+func (d daemonGcBlobUploadRecord) Alias() string { return d.daemonGcBlobUploadRecordDo.Alias() }
+
+func (d daemonGcBlobUploadRecord) Columns(cols ...field.Expr) gen.Columns {
+	return d.daemonGcBlobUploadRecordDo.Columns(cols...)
+}
+
+func (d *daemonGcBlobUploadRecord) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := d.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (d *daemonGcBlobUploadRecord) fillFieldMap() {
+	d.fieldMap = make(map[string]field.Expr, 11)
+	d.fieldMap["created_at"] = d.CreatedAt
+	d.fieldMap["updated_at"] = d.UpdatedAt
+	d.fieldMap["deleted_at"] = d.DeletedAt
+	d.fieldMap["id"] = d.ID
+	d.fieldMap["runner_id"] = d.RunnerID
+	d.fieldMap["upload_id"] = d.UploadID
+	d.fieldMap["repository"] = d.Repository
+	d.fieldMap["status"] = d.Status
+	d.fieldMap["size"] = d.Size
+	d.fieldMap["message"] = d.Message
+
+}
+
+func (d daemonGcBlobUploadRecord) clone(db *gorm.DB) daemonGcBlobUploadRecord {
+	d.daemonGcBlobUploadRecordDo.ReplaceConnPool(db.Statement.ConnPool)
+	return d
+}
+
+func (d daemonGcBlobUploadRecord) replaceDB(db *gorm.DB) daemonGcBlobUploadRecord {
+	d.daemonGcBlobUploadRecordDo.ReplaceDB(db)
+	return d
+}
+
+type daemonGcBlobUploadRecordBelongsToRunner struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Rule struct {
+		field.RelationField
+	}
+	OperateUser struct {
+		field.RelationField
+	}
+}
+
+func (a daemonGcBlobUploadRecordBelongsToRunner) Where(conds ...field.Expr) *daemonGcBlobUploadRecordBelongsToRunner {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a daemonGcBlobUploadRecordBelongsToRunner) WithContext(ctx context.Context) *daemonGcBlobUploadRecordBelongsToRunner {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a daemonGcBlobUploadRecordBelongsToRunner) Session(session *gorm.Session) *daemonGcBlobUploadRecordBelongsToRunner {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a daemonGcBlobUploadRecordBelongsToRunner) Model(m *models.DaemonGcBlobUploadRecord) *daemonGcBlobUploadRecordBelongsToRunnerTx {
+	return &daemonGcBlobUploadRecordBelongsToRunnerTx{a.db.Model(m).Association(a.Name())}
+}
+
+type daemonGcBlobUploadRecordBelongsToRunnerTx struct{ tx *gorm.Association }
+
+func (a daemonGcBlobUploadRecordBelongsToRunnerTx) Find() (result *models.DaemonGcBlobUploadRunner, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a daemonGcBlobUploadRecordBelongsToRunnerTx) Append(values ...*models.DaemonGcBlobUploadRunner) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a daemonGcBlobUploadRecordBelongsToRunnerTx) Replace(values ...*models.DaemonGcBlobUploadRunner) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a daemonGcBlobUploadRecordBelongsToRunnerTx) Delete(values ...*models.DaemonGcBlobUploadRunner) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a daemonGcBlobUploadRecordBelongsToRunnerTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a daemonGcBlobUploadRecordBelongsToRunnerTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type daemonGcBlobUploadRecordDo struct{ gen.DO }
+
+func (d daemonGcBlobUploadRecordDo) Debug() *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.Debug())
+}
+
+func (d daemonGcBlobUploadRecordDo) WithContext(ctx context.Context) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.WithContext(ctx))
+}
+
+func (d daemonGcBlobUploadRecordDo) ReadDB() *daemonGcBlobUploadRecordDo {
+	return d.Clauses(dbresolver.Read)
+}
+
+func (d daemonGcBlobUploadRecordDo) WriteDB() *daemonGcBlobUploadRecordDo {
+	return d.Clauses(dbresolver.Write)
+}
+
+func (d daemonGcBlobUploadRecordDo) Session(config *gorm.Session) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.Session(config))
+}
+
+func (d daemonGcBlobUploadRecordDo) Clauses(conds ...clause.Expression) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.Clauses(conds...))
+}
+
+func (d daemonGcBlobUploadRecordDo) Returning(value interface{}, columns ...string) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.Returning(value, columns...))
+}
+
+func (d daemonGcBlobUploadRecordDo) Not(conds ...gen.Condition) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.Not(conds...))
+}
+
+func (d daemonGcBlobUploadRecordDo) Or(conds ...gen.Condition) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.Or(conds...))
+}
+
+func (d daemonGcBlobUploadRecordDo) Select(conds ...field.Expr) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.Select(conds...))
+}
+
+func (d daemonGcBlobUploadRecordDo) Where(conds ...gen.Condition) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.Where(conds...))
+}
+
+func (d daemonGcBlobUploadRecordDo) Order(conds ...field.Expr) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.Order(conds...))
+}
+
+func (d daemonGcBlobUploadRecordDo) Distinct(cols ...field.Expr) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.Distinct(cols...))
+}
+
+func (d daemonGcBlobUploadRecordDo) Omit(cols ...field.Expr) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.Omit(cols...))
+}
+
+func (d daemonGcBlobUploadRecordDo) Join(table schema.Tabler, on ...field.Expr) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.Join(table, on...))
+}
+
+func (d daemonGcBlobUploadRecordDo) LeftJoin(table schema.Tabler, on ...field.Expr) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.LeftJoin(table, on...))
+}
+
+func (d daemonGcBlobUploadRecordDo) RightJoin(table schema.Tabler, on ...field.Expr) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.RightJoin(table, on...))
+}
+
+func (d daemonGcBlobUploadRecordDo) Group(cols ...field.Expr) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.Group(cols...))
+}
+
+func (d daemonGcBlobUploadRecordDo) Having(conds ...gen.Condition) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.Having(conds...))
+}
+
+func (d daemonGcBlobUploadRecordDo) Limit(limit int) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.Limit(limit))
+}
+
+func (d daemonGcBlobUploadRecordDo) Offset(offset int) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.Offset(offset))
+}
+
+func (d daemonGcBlobUploadRecordDo) Scopes(funcs ...func(gen.Dao) gen.Dao) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.Scopes(funcs...))
+}
+
+func (d daemonGcBlobUploadRecordDo) Unscoped() *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.Unscoped())
+}
+
+func (d daemonGcBlobUploadRecordDo) Create(values ...*models.DaemonGcBlobUploadRecord) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return d.DO.Create(values)
+}
+
+func (d daemonGcBlobUploadRecordDo) CreateInBatches(values []*models.DaemonGcBlobUploadRecord, batchSize int) error {
+	return d.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (d daemonGcBlobUploadRecordDo) Save(values ...*models.DaemonGcBlobUploadRecord) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return d.DO.Save(values)
+}
+
+func (d daemonGcBlobUploadRecordDo) First() (*models.DaemonGcBlobUploadRecord, error) {
+	if result, err := d.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcBlobUploadRecord), nil
+	}
+}
+
+func (d daemonGcBlobUploadRecordDo) Take() (*models.DaemonGcBlobUploadRecord, error) {
+	if result, err := d.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcBlobUploadRecord), nil
+	}
+}
+
+func (d daemonGcBlobUploadRecordDo) Last() (*models.DaemonGcBlobUploadRecord, error) {
+	if result, err := d.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcBlobUploadRecord), nil
+	}
+}
+
+func (d daemonGcBlobUploadRecordDo) Find() ([]*models.DaemonGcBlobUploadRecord, error) {
+	result, err := d.DO.Find()
+	return result.([]*models.DaemonGcBlobUploadRecord), err
+}
+
+func (d daemonGcBlobUploadRecordDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.DaemonGcBlobUploadRecord, err error) {
+	buf := make([]*models.DaemonGcBlobUploadRecord, 0, batchSize)
+	err = d.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (d daemonGcBlobUploadRecordDo) FindInBatches(result *[]*models.DaemonGcBlobUploadRecord, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return d.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (d daemonGcBlobUploadRecordDo) Attrs(attrs ...field.AssignExpr) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.Attrs(attrs...))
+}
+
+func (d daemonGcBlobUploadRecordDo) Assign(attrs ...field.AssignExpr) *daemonGcBlobUploadRecordDo {
+	return d.withDO(d.DO.Assign(attrs...))
+}
+
+func (d daemonGcBlobUploadRecordDo) Joins(fields ...field.RelationField) *daemonGcBlobUploadRecordDo {
+	for _, _f := range fields {
+		d = *d.withDO(d.DO.Joins(_f))
+	}
+	return &d
+}
+
+func (d daemonGcBlobUploadRecordDo) Preload(fields ...field.RelationField) *daemonGcBlobUploadRecordDo {
+	for _, _f := range fields {
+		d = *d.withDO(d.DO.Preload(_f))
+	}
+	return &d
+}
+
+func (d daemonGcBlobUploadRecordDo) FirstOrInit() (*models.DaemonGcBlobUploadRecord, error) {
+	if result, err := d.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcBlobUploadRecord), nil
+	}
+}
+
+func (d daemonGcBlobUploadRecordDo) FirstOrCreate() (*models.DaemonGcBlobUploadRecord, error) {
+	if result, err := d.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcBlobUploadRecord), nil
+	}
+}
+
+func (d daemonGcBlobUploadRecordDo) FindByPage(offset int, limit int) (result []*models.DaemonGcBlobUploadRecord, count int64, err error) {
+	result, err = d.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = d.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (d daemonGcBlobUploadRecordDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = d.Count()
+	if err != nil {
+		return
+	}
+
+	err = d.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (d daemonGcBlobUploadRecordDo) Scan(result interface{}) (err error) {
+	return d.DO.Scan(result)
+}
+
+func (d daemonGcBlobUploadRecordDo) Delete(models ...*models.DaemonGcBlobUploadRecord) (result gen.ResultInfo, err error) {
+	return d.DO.Delete(models)
+}
+
+func (d *daemonGcBlobUploadRecordDo) withDO(do gen.Dao) *daemonGcBlobUploadRecordDo {
+	d.DO = *do.(*gen.DO)
+	return d
+}
@@ -0,0 +1,363 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+)
+
+func newDaemonGcBlobUploadRule(db *gorm.DB, opts ...gen.DOOption) daemonGcBlobUploadRule {
+	_daemonGcBlobUploadRule := daemonGcBlobUploadRule{}
+
+	_daemonGcBlobUploadRule.daemonGcBlobUploadRuleDo.UseDB(db, opts...)
+	_daemonGcBlobUploadRule.daemonGcBlobUploadRuleDo.UseModel(&models.DaemonGcBlobUploadRule{})
+
+	tableName := _daemonGcBlobUploadRule.daemonGcBlobUploadRuleDo.TableName()
+	_daemonGcBlobUploadRule.ALL = field.NewAsterisk(tableName)
+	_daemonGcBlobUploadRule.CreatedAt = field.NewInt64(tableName, "created_at")
+	_daemonGcBlobUploadRule.UpdatedAt = field.NewInt64(tableName, "updated_at")
+	_daemonGcBlobUploadRule.DeletedAt = field.NewUint64(tableName, "deleted_at")
+	_daemonGcBlobUploadRule.ID = field.NewInt64(tableName, "id")
+	_daemonGcBlobUploadRule.IsRunning = field.NewBool(tableName, "is_running")
+	_daemonGcBlobUploadRule.RetentionHour = field.NewInt(tableName, "retention_hour")
+	_daemonGcBlobUploadRule.CronEnabled = field.NewBool(tableName, "cron_enabled")
+	_daemonGcBlobUploadRule.CronRule = field.NewString(tableName, "cron_rule")
+	_daemonGcBlobUploadRule.CronNextTrigger = field.NewInt64(tableName, "cron_next_trigger")
+
+	_daemonGcBlobUploadRule.fillFieldMap()
+
+	return _daemonGcBlobUploadRule
+}
+
+type daemonGcBlobUploadRule struct {
+	daemonGcBlobUploadRuleDo daemonGcBlobUploadRuleDo
+
+	ALL             field.Asterisk
+	CreatedAt       field.Int64
+	UpdatedAt       field.Int64
+	DeletedAt       field.Uint64
+	ID              field.Int64
+	IsRunning       field.Bool
+	RetentionHour   field.Int
+	CronEnabled     field.Bool
+	CronRule        field.String
+	CronNextTrigger field.Int64
+
+	fieldMap map[string]field.Expr
+}
+
+func (d daemonGcBlobUploadRule) Table(newTableName string) *daemonGcBlobUploadRule {
+	d.daemonGcBlobUploadRuleDo.UseTable(newTableName)
+	return d.updateTableName(newTableName)
+}
+
+func (d daemonGcBlobUploadRule) As(alias string) *daemonGcBlobUploadRule {
+	d.daemonGcBlobUploadRuleDo.DO = *(d.daemonGcBlobUploadRuleDo.As(alias).(*gen.DO))
+	return d.updateTableName(alias)
+}
+
+func (d *daemonGcBlobUploadRule) updateTableName(table string) *daemonGcBlobUploadRule {
+	d.ALL = field.NewAsterisk(table)
+	d.CreatedAt = field.NewInt64(table, "created_at")
+	d.UpdatedAt = field.NewInt64(table, "updated_at")
+	d.DeletedAt = field.NewUint64(table, "deleted_at")
+	d.ID = field.NewInt64(table, "id")
+	d.IsRunning = field.NewBool(table, "is_running")
+	d.RetentionHour = field.NewInt(table, "retention_hour")
+	d.CronEnabled = field.NewBool(table, "cron_enabled")
+	d.CronRule = field.NewString(table, "cron_rule")
+	d.CronNextTrigger = field.NewInt64(table, "cron_next_trigger")
+
+	d.fillFieldMap()
+
+	return d
+}
+
+func (d *daemonGcBlobUploadRule) WithContext(ctx context.Context) *daemonGcBlobUploadRuleDo {
+	return d.daemonGcBlobUploadRuleDo.WithContext(ctx)
+}
+
+func (d daemonGcBlobUploadRule) TableName() string { return d.daemonGcBlobUploadRuleDo.TableName() }
+
+func (d daemonGcBlobUploadRule) Alias() string { return d.daemonGcBlobUploadRuleDo.Alias() }
+
+func (d daemonGcBlobUploadRule) Columns(cols ...field.Expr) gen.Columns {
+	return d.daemonGcBlobUploadRuleDo.Columns(cols...)
+}
+
+func (d *daemonGcBlobUploadRule) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := d.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (d *daemonGcBlobUploadRule) fillFieldMap() {
+	d.fieldMap = make(map[string]field.Expr, 9)
+	d.fieldMap["created_at"] = d.CreatedAt
+	d.fieldMap["updated_at"] = d.UpdatedAt
+	d.fieldMap["deleted_at"] = d.DeletedAt
+	d.fieldMap["id"] = d.ID
+	d.fieldMap["is_running"] = d.IsRunning
+	d.fieldMap["retention_hour"] = d.RetentionHour
+	d.fieldMap["cron_enabled"] = d.CronEnabled
+	d.fieldMap["cron_rule"] = d.CronRule
+	d.fieldMap["cron_next_trigger"] = d.CronNextTrigger
+}
+
+func (d daemonGcBlobUploadRule) clone(db *gorm.DB) daemonGcBlobUploadRule {
+	d.daemonGcBlobUploadRuleDo.ReplaceConnPool(db.Statement.ConnPool)
+	return d
+}
+
+func (d daemonGcBlobUploadRule) replaceDB(db *gorm.DB) daemonGcBlobUploadRule {
+	d.daemonGcBlobUploadRuleDo.ReplaceDB(db)
+	return d
+}
+
+type daemonGcBlobUploadRuleDo struct{ gen.DO }
+
+func (d daemonGcBlobUploadRuleDo) Debug() *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.Debug())
+}
+
+func (d daemonGcBlobUploadRuleDo) WithContext(ctx context.Context) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.WithContext(ctx))
+}
+
+func (d daemonGcBlobUploadRuleDo) ReadDB() *daemonGcBlobUploadRuleDo {
+	return d.Clauses(dbresolver.Read)
+}
+
+func (d daemonGcBlobUploadRuleDo) WriteDB() *daemonGcBlobUploadRuleDo {
+	return d.Clauses(dbresolver.Write)
+}
+
+func (d daemonGcBlobUploadRuleDo) Session(config *gorm.Session) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.Session(config))
+}
+
+func (d daemonGcBlobUploadRuleDo) Clauses(conds ...clause.Expression) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.Clauses(conds...))
+}
+
+func (d daemonGcBlobUploadRuleDo) Returning(value interface{}, columns ...string) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.Returning(value, columns...))
+}
+
+func (d daemonGcBlobUploadRuleDo) Not(conds ...gen.Condition) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.Not(conds...))
+}
+
+func (d daemonGcBlobUploadRuleDo) Or(conds ...gen.Condition) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.Or(conds...))
+}
+
+func (d daemonGcBlobUploadRuleDo) Select(conds ...field.Expr) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.Select(conds...))
+}
+
+func (d daemonGcBlobUploadRuleDo) Where(conds ...gen.Condition) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.Where(conds...))
+}
+
+func (d daemonGcBlobUploadRuleDo) Order(conds ...field.Expr) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.Order(conds...))
+}
+
+func (d daemonGcBlobUploadRuleDo) Distinct(cols ...field.Expr) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.Distinct(cols...))
+}
+
+func (d daemonGcBlobUploadRuleDo) Omit(cols ...field.Expr) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.Omit(cols...))
+}
+
+func (d daemonGcBlobUploadRuleDo) Join(table schema.Tabler, on ...field.Expr) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.Join(table, on...))
+}
+
+func (d daemonGcBlobUploadRuleDo) LeftJoin(table schema.Tabler, on ...field.Expr) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.LeftJoin(table, on...))
+}
+
+func (d daemonGcBlobUploadRuleDo) RightJoin(table schema.Tabler, on ...field.Expr) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.RightJoin(table, on...))
+}
+
+func (d daemonGcBlobUploadRuleDo) Group(cols ...field.Expr) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.Group(cols...))
+}
+
+func (d daemonGcBlobUploadRuleDo) Having(conds ...gen.Condition) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.Having(conds...))
+}
+
+func (d daemonGcBlobUploadRuleDo) Limit(limit int) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.Limit(limit))
+}
+
+func (d daemonGcBlobUploadRuleDo) Offset(offset int) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.Offset(offset))
+}
+
+func (d daemonGcBlobUploadRuleDo) Scopes(funcs ...func(gen.Dao) gen.Dao) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.Scopes(funcs...))
+}
+
+func (d daemonGcBlobUploadRuleDo) Unscoped() *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.Unscoped())
+}
+
+func (d daemonGcBlobUploadRuleDo) Create(values ...*models.DaemonGcBlobUploadRule) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return d.DO.Create(values)
+}
+
+func (d daemonGcBlobUploadRuleDo) CreateInBatches(values []*models.DaemonGcBlobUploadRule, batchSize int) error {
+	return d.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (d daemonGcBlobUploadRuleDo) Save(values ...*models.DaemonGcBlobUploadRule) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return d.DO.Save(values)
+}
+
+func (d daemonGcBlobUploadRuleDo) First() (*models.DaemonGcBlobUploadRule, error) {
+	if result, err := d.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcBlobUploadRule), nil
+	}
+}
+
+func (d daemonGcBlobUploadRuleDo) Take() (*models.DaemonGcBlobUploadRule, error) {
+	if result, err := d.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcBlobUploadRule), nil
+	}
+}
+
+func (d daemonGcBlobUploadRuleDo) Last() (*models.DaemonGcBlobUploadRule, error) {
+	if result, err := d.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcBlobUploadRule), nil
+	}
+}
+
+func (d daemonGcBlobUploadRuleDo) Find() ([]*models.DaemonGcBlobUploadRule, error) {
+	result, err := d.DO.Find()
+	return result.([]*models.DaemonGcBlobUploadRule), err
+}
+
+func (d daemonGcBlobUploadRuleDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.DaemonGcBlobUploadRule, err error) {
+	buf := make([]*models.DaemonGcBlobUploadRule, 0, batchSize)
+	err = d.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (d daemonGcBlobUploadRuleDo) FindInBatches(result *[]*models.DaemonGcBlobUploadRule, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return d.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (d daemonGcBlobUploadRuleDo) Attrs(attrs ...field.AssignExpr) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.Attrs(attrs...))
+}
+
+func (d daemonGcBlobUploadRuleDo) Assign(attrs ...field.AssignExpr) *daemonGcBlobUploadRuleDo {
+	return d.withDO(d.DO.Assign(attrs...))
+}
+
+func (d daemonGcBlobUploadRuleDo) Joins(fields ...field.RelationField) *daemonGcBlobUploadRuleDo {
+	for _, _f := range fields {
+		d = *d.withDO(d.DO.Joins(_f))
+	}
+	return &d
+}
+
+func (d daemonGcBlobUploadRuleDo) Preload(fields ...field.RelationField) *daemonGcBlobUploadRuleDo {
+	for _, _f := range fields {
+		d = *d.withDO(d.DO.Preload(_f))
+	}
+	return &d
+}
+
+func (d daemonGcBlobUploadRuleDo) FirstOrInit() (*models.DaemonGcBlobUploadRule, error) {
+	if result, err := d.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcBlobUploadRule), nil
+	}
+}
+
+func (d daemonGcBlobUploadRuleDo) FirstOrCreate() (*models.DaemonGcBlobUploadRule, error) {
+	if result, err := d.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcBlobUploadRule), nil
+	}
+}
+
+func (d daemonGcBlobUploadRuleDo) FindByPage(offset int, limit int) (result []*models.DaemonGcBlobUploadRule, count int64, err error) {
+	result, err = d.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = d.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (d daemonGcBlobUploadRuleDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = d.Count()
+	if err != nil {
+		return
+	}
+
+	err = d.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (d daemonGcBlobUploadRuleDo) Scan(result interface{}) (err error) {
+	return d.DO.Scan(result)
+}
+
+func (d daemonGcBlobUploadRuleDo) Delete(models ...*models.DaemonGcBlobUploadRule) (result gen.ResultInfo, err error) {
+	return d.DO.Delete(models)
+}
+
+func (d *daemonGcBlobUploadRuleDo) withDO(do gen.Dao) *daemonGcBlobUploadRuleDo {
+	d.DO = *do.(*gen.DO)
+	return d
+}
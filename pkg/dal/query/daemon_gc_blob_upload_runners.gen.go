@@ -0,0 +1,548 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+)
+
+func newDaemonGcBlobUploadRunner(db *gorm.DB, opts ...gen.DOOption) daemonGcBlobUploadRunner {
+	_daemonGcBlobUploadRunner := daemonGcBlobUploadRunner{}
+
+	_daemonGcBlobUploadRunner.daemonGcBlobUploadRunnerDo.UseDB(db, opts...)
+	_daemonGcBlobUploadRunner.daemonGcBlobUploadRunnerDo.UseModel(&models.DaemonGcBlobUploadRunner{})
+
+	tableName := _daemonGcBlobUploadRunner.daemonGcBlobUploadRunnerDo.TableName()
+	_daemonGcBlobUploadRunner.ALL = field.NewAsterisk(tableName)
+	_daemonGcBlobUploadRunner.CreatedAt = field.NewInt64(tableName, "created_at")
+	_daemonGcBlobUploadRunner.UpdatedAt = field.NewInt64(tableName, "updated_at")
+	_daemonGcBlobUploadRunner.DeletedAt = field.NewUint64(tableName, "deleted_at")
+	_daemonGcBlobUploadRunner.ID = field.NewInt64(tableName, "id")
+	_daemonGcBlobUploadRunner.RuleID = field.NewInt64(tableName, "rule_id")
+	_daemonGcBlobUploadRunner.Status = field.NewField(tableName, "status")
+	_daemonGcBlobUploadRunner.Message = field.NewBytes(tableName, "message")
+	_daemonGcBlobUploadRunner.OperateType = field.NewField(tableName, "operate_type")
+	_daemonGcBlobUploadRunner.OperateUserID = field.NewInt64(tableName, "operate_user_id")
+	_daemonGcBlobUploadRunner.StartedAt = field.NewInt64(tableName, "started_at")
+	_daemonGcBlobUploadRunner.EndedAt = field.NewInt64(tableName, "ended_at")
+	_daemonGcBlobUploadRunner.Duration = field.NewInt64(tableName, "duration")
+	_daemonGcBlobUploadRunner.SuccessCount = field.NewInt64(tableName, "success_count")
+	_daemonGcBlobUploadRunner.FailedCount = field.NewInt64(tableName, "failed_count")
+	_daemonGcBlobUploadRunner.DryRun = field.NewBool(tableName, "dry_run")
+	_daemonGcBlobUploadRunner.ReclaimableSize = field.NewInt64(tableName, "reclaimable_size")
+	_daemonGcBlobUploadRunner.Rule = daemonGcBlobUploadRunnerBelongsToRule{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Rule", "models.DaemonGcBlobUploadRule"),
+	}
+
+	_daemonGcBlobUploadRunner.OperateUser = daemonGcBlobUploadRunnerBelongsToOperateUser{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("OperateUser", "models.User"),
+	}
+
+	_daemonGcBlobUploadRunner.fillFieldMap()
+
+	return _daemonGcBlobUploadRunner
+}
+
+type daemonGcBlobUploadRunner struct {
+	daemonGcBlobUploadRunnerDo daemonGcBlobUploadRunnerDo
+
+	ALL             field.Asterisk
+	CreatedAt       field.Int64
+	UpdatedAt       field.Int64
+	DeletedAt       field.Uint64
+	ID              field.Int64
+	RuleID          field.Int64
+	Status          field.Field
+	Message         field.Bytes
+	OperateType     field.Field
+	OperateUserID   field.Int64
+	StartedAt       field.Int64
+	EndedAt         field.Int64
+	Duration        field.Int64
+	SuccessCount    field.Int64
+	FailedCount     field.Int64
+	DryRun          field.Bool
+	ReclaimableSize field.Int64
+	Rule            daemonGcBlobUploadRunnerBelongsToRule
+
+	OperateUser daemonGcBlobUploadRunnerBelongsToOperateUser
+
+	fieldMap map[string]field.Expr
+}
+
+func (d daemonGcBlobUploadRunner) Table(newTableName string) *daemonGcBlobUploadRunner {
+	d.daemonGcBlobUploadRunnerDo.UseTable(newTableName)
+	return d.updateTableName(newTableName)
+}
+
+func (d daemonGcBlobUploadRunner) As(alias string) *daemonGcBlobUploadRunner {
+	d.daemonGcBlobUploadRunnerDo.DO = *(d.daemonGcBlobUploadRunnerDo.As(alias).(*gen.DO))
+	return d.updateTableName(alias)
+}
+
+func (d *daemonGcBlobUploadRunner) updateTableName(table string) *daemonGcBlobUploadRunner {
+	d.ALL = field.NewAsterisk(table)
+	d.CreatedAt = field.NewInt64(table, "created_at")
+	d.UpdatedAt = field.NewInt64(table, "updated_at")
+	d.DeletedAt = field.NewUint64(table, "deleted_at")
+	d.ID = field.NewInt64(table, "id")
+	d.RuleID = field.NewInt64(table, "rule_id")
+	d.Status = field.NewField(table, "status")
+	d.Message = field.NewBytes(table, "message")
+	d.OperateType = field.NewField(table, "operate_type")
+	d.OperateUserID = field.NewInt64(table, "operate_user_id")
+	d.StartedAt = field.NewInt64(table, "started_at")
+	d.EndedAt = field.NewInt64(table, "ended_at")
+	d.Duration = field.NewInt64(table, "duration")
+	d.SuccessCount = field.NewInt64(table, "success_count")
+	d.FailedCount = field.NewInt64(table, "failed_count")
+	d.DryRun = field.NewBool(table, "dry_run")
+	d.ReclaimableSize = field.NewInt64(table, "reclaimable_size")
+
+	d.fillFieldMap()
+
+	return d
+}
+
+func (d *daemonGcBlobUploadRunner) WithContext(ctx context.Context) *daemonGcBlobUploadRunnerDo {
+	return d.daemonGcBlobUploadRunnerDo.WithContext(ctx)
+}
+
+func (d daemonGcBlobUploadRunner) TableName() string { return d.daemonGcBlobUploadRunnerDo.TableName() }
+
+func (d daemonGcBlobUploadRunner) Alias() string { return d.daemonGcBlobUploadRunnerDo.Alias() }
+
+func (d daemonGcBlobUploadRunner) Columns(cols ...field.Expr) gen.Columns {
+	return d.daemonGcBlobUploadRunnerDo.Columns(cols...)
+}
+
+func (d *daemonGcBlobUploadRunner) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := d.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (d *daemonGcBlobUploadRunner) fillFieldMap() {
+	d.fieldMap = make(map[string]field.Expr, 18)
+	d.fieldMap["created_at"] = d.CreatedAt
+	d.fieldMap["updated_at"] = d.UpdatedAt
+	d.fieldMap["deleted_at"] = d.DeletedAt
+	d.fieldMap["id"] = d.ID
+	d.fieldMap["rule_id"] = d.RuleID
+	d.fieldMap["status"] = d.Status
+	d.fieldMap["message"] = d.Message
+	d.fieldMap["operate_type"] = d.OperateType
+	d.fieldMap["operate_user_id"] = d.OperateUserID
+	d.fieldMap["started_at"] = d.StartedAt
+	d.fieldMap["ended_at"] = d.EndedAt
+	d.fieldMap["duration"] = d.Duration
+	d.fieldMap["success_count"] = d.SuccessCount
+	d.fieldMap["failed_count"] = d.FailedCount
+	d.fieldMap["dry_run"] = d.DryRun
+	d.fieldMap["reclaimable_size"] = d.ReclaimableSize
+
+}
+
+func (d daemonGcBlobUploadRunner) clone(db *gorm.DB) daemonGcBlobUploadRunner {
+	d.daemonGcBlobUploadRunnerDo.ReplaceConnPool(db.Statement.ConnPool)
+	return d
+}
+
+func (d daemonGcBlobUploadRunner) replaceDB(db *gorm.DB) daemonGcBlobUploadRunner {
+	d.daemonGcBlobUploadRunnerDo.ReplaceDB(db)
+	return d
+}
+
+type daemonGcBlobUploadRunnerBelongsToRule struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a daemonGcBlobUploadRunnerBelongsToRule) Where(conds ...field.Expr) *daemonGcBlobUploadRunnerBelongsToRule {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a daemonGcBlobUploadRunnerBelongsToRule) WithContext(ctx context.Context) *daemonGcBlobUploadRunnerBelongsToRule {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a daemonGcBlobUploadRunnerBelongsToRule) Session(session *gorm.Session) *daemonGcBlobUploadRunnerBelongsToRule {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a daemonGcBlobUploadRunnerBelongsToRule) Model(m *models.DaemonGcBlobUploadRunner) *daemonGcBlobUploadRunnerBelongsToRuleTx {
+	return &daemonGcBlobUploadRunnerBelongsToRuleTx{a.db.Model(m).Association(a.Name())}
+}
+
+type daemonGcBlobUploadRunnerBelongsToRuleTx struct{ tx *gorm.Association }
+
+func (a daemonGcBlobUploadRunnerBelongsToRuleTx) Find() (result *models.DaemonGcBlobUploadRule, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a daemonGcBlobUploadRunnerBelongsToRuleTx) Append(values ...*models.DaemonGcBlobUploadRule) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a daemonGcBlobUploadRunnerBelongsToRuleTx) Replace(values ...*models.DaemonGcBlobUploadRule) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a daemonGcBlobUploadRunnerBelongsToRuleTx) Delete(values ...*models.DaemonGcBlobUploadRule) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a daemonGcBlobUploadRunnerBelongsToRuleTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a daemonGcBlobUploadRunnerBelongsToRuleTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type daemonGcBlobUploadRunnerBelongsToOperateUser struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a daemonGcBlobUploadRunnerBelongsToOperateUser) Where(conds ...field.Expr) *daemonGcBlobUploadRunnerBelongsToOperateUser {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a daemonGcBlobUploadRunnerBelongsToOperateUser) WithContext(ctx context.Context) *daemonGcBlobUploadRunnerBelongsToOperateUser {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a daemonGcBlobUploadRunnerBelongsToOperateUser) Session(session *gorm.Session) *daemonGcBlobUploadRunnerBelongsToOperateUser {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a daemonGcBlobUploadRunnerBelongsToOperateUser) Model(m *models.DaemonGcBlobUploadRunner) *daemonGcBlobUploadRunnerBelongsToOperateUserTx {
+	return &daemonGcBlobUploadRunnerBelongsToOperateUserTx{a.db.Model(m).Association(a.Name())}
+}
+
+type daemonGcBlobUploadRunnerBelongsToOperateUserTx struct{ tx *gorm.Association }
+
+func (a daemonGcBlobUploadRunnerBelongsToOperateUserTx) Find() (result *models.User, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a daemonGcBlobUploadRunnerBelongsToOperateUserTx) Append(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a daemonGcBlobUploadRunnerBelongsToOperateUserTx) Replace(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a daemonGcBlobUploadRunnerBelongsToOperateUserTx) Delete(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a daemonGcBlobUploadRunnerBelongsToOperateUserTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a daemonGcBlobUploadRunnerBelongsToOperateUserTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type daemonGcBlobUploadRunnerDo struct{ gen.DO }
+
+func (d daemonGcBlobUploadRunnerDo) Debug() *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.Debug())
+}
+
+func (d daemonGcBlobUploadRunnerDo) WithContext(ctx context.Context) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.WithContext(ctx))
+}
+
+func (d daemonGcBlobUploadRunnerDo) ReadDB() *daemonGcBlobUploadRunnerDo {
+	return d.Clauses(dbresolver.Read)
+}
+
+func (d daemonGcBlobUploadRunnerDo) WriteDB() *daemonGcBlobUploadRunnerDo {
+	return d.Clauses(dbresolver.Write)
+}
+
+func (d daemonGcBlobUploadRunnerDo) Session(config *gorm.Session) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.Session(config))
+}
+
+func (d daemonGcBlobUploadRunnerDo) Clauses(conds ...clause.Expression) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.Clauses(conds...))
+}
+
+func (d daemonGcBlobUploadRunnerDo) Returning(value interface{}, columns ...string) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.Returning(value, columns...))
+}
+
+func (d daemonGcBlobUploadRunnerDo) Not(conds ...gen.Condition) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.Not(conds...))
+}
+
+func (d daemonGcBlobUploadRunnerDo) Or(conds ...gen.Condition) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.Or(conds...))
+}
+
+func (d daemonGcBlobUploadRunnerDo) Select(conds ...field.Expr) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.Select(conds...))
+}
+
+func (d daemonGcBlobUploadRunnerDo) Where(conds ...gen.Condition) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.Where(conds...))
+}
+
+func (d daemonGcBlobUploadRunnerDo) Order(conds ...field.Expr) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.Order(conds...))
+}
+
+func (d daemonGcBlobUploadRunnerDo) Distinct(cols ...field.Expr) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.Distinct(cols...))
+}
+
+func (d daemonGcBlobUploadRunnerDo) Omit(cols ...field.Expr) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.Omit(cols...))
+}
+
+func (d daemonGcBlobUploadRunnerDo) Join(table schema.Tabler, on ...field.Expr) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.Join(table, on...))
+}
+
+func (d daemonGcBlobUploadRunnerDo) LeftJoin(table schema.Tabler, on ...field.Expr) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.LeftJoin(table, on...))
+}
+
+func (d daemonGcBlobUploadRunnerDo) RightJoin(table schema.Tabler, on ...field.Expr) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.RightJoin(table, on...))
+}
+
+func (d daemonGcBlobUploadRunnerDo) Group(cols ...field.Expr) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.Group(cols...))
+}
+
+func (d daemonGcBlobUploadRunnerDo) Having(conds ...gen.Condition) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.Having(conds...))
+}
+
+func (d daemonGcBlobUploadRunnerDo) Limit(limit int) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.Limit(limit))
+}
+
+func (d daemonGcBlobUploadRunnerDo) Offset(offset int) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.Offset(offset))
+}
+
+func (d daemonGcBlobUploadRunnerDo) Scopes(funcs ...func(gen.Dao) gen.Dao) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.Scopes(funcs...))
+}
+
+func (d daemonGcBlobUploadRunnerDo) Unscoped() *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.Unscoped())
+}
+
+func (d daemonGcBlobUploadRunnerDo) Create(values ...*models.DaemonGcBlobUploadRunner) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return d.DO.Create(values)
+}
+
+func (d daemonGcBlobUploadRunnerDo) CreateInBatches(values []*models.DaemonGcBlobUploadRunner, batchSize int) error {
+	return d.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (d daemonGcBlobUploadRunnerDo) Save(values ...*models.DaemonGcBlobUploadRunner) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return d.DO.Save(values)
+}
+
+func (d daemonGcBlobUploadRunnerDo) First() (*models.DaemonGcBlobUploadRunner, error) {
+	if result, err := d.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcBlobUploadRunner), nil
+	}
+}
+
+func (d daemonGcBlobUploadRunnerDo) Take() (*models.DaemonGcBlobUploadRunner, error) {
+	if result, err := d.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcBlobUploadRunner), nil
+	}
+}
+
+func (d daemonGcBlobUploadRunnerDo) Last() (*models.DaemonGcBlobUploadRunner, error) {
+	if result, err := d.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcBlobUploadRunner), nil
+	}
+}
+
+func (d daemonGcBlobUploadRunnerDo) Find() ([]*models.DaemonGcBlobUploadRunner, error) {
+	result, err := d.DO.Find()
+	return result.([]*models.DaemonGcBlobUploadRunner), err
+}
+
+func (d daemonGcBlobUploadRunnerDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.DaemonGcBlobUploadRunner, err error) {
+	buf := make([]*models.DaemonGcBlobUploadRunner, 0, batchSize)
+	err = d.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (d daemonGcBlobUploadRunnerDo) FindInBatches(result *[]*models.DaemonGcBlobUploadRunner, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return d.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (d daemonGcBlobUploadRunnerDo) Attrs(attrs ...field.AssignExpr) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.Attrs(attrs...))
+}
+
+func (d daemonGcBlobUploadRunnerDo) Assign(attrs ...field.AssignExpr) *daemonGcBlobUploadRunnerDo {
+	return d.withDO(d.DO.Assign(attrs...))
+}
+
+func (d daemonGcBlobUploadRunnerDo) Joins(fields ...field.RelationField) *daemonGcBlobUploadRunnerDo {
+	for _, _f := range fields {
+		d = *d.withDO(d.DO.Joins(_f))
+	}
+	return &d
+}
+
+func (d daemonGcBlobUploadRunnerDo) Preload(fields ...field.RelationField) *daemonGcBlobUploadRunnerDo {
+	for _, _f := range fields {
+		d = *d.withDO(d.DO.Preload(_f))
+	}
+	return &d
+}
+
+func (d daemonGcBlobUploadRunnerDo) FirstOrInit() (*models.DaemonGcBlobUploadRunner, error) {
+	if result, err := d.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcBlobUploadRunner), nil
+	}
+}
+
+func (d daemonGcBlobUploadRunnerDo) FirstOrCreate() (*models.DaemonGcBlobUploadRunner, error) {
+	if result, err := d.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcBlobUploadRunner), nil
+	}
+}
+
+func (d daemonGcBlobUploadRunnerDo) FindByPage(offset int, limit int) (result []*models.DaemonGcBlobUploadRunner, count int64, err error) {
+	result, err = d.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = d.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (d daemonGcBlobUploadRunnerDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = d.Count()
+	if err != nil {
+		return
+	}
+
+	err = d.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (d daemonGcBlobUploadRunnerDo) Scan(result interface{}) (err error) {
+	return d.DO.Scan(result)
+}
+
+func (d daemonGcBlobUploadRunnerDo) Delete(models ...*models.DaemonGcBlobUploadRunner) (result gen.ResultInfo, err error) {
+	return d.DO.Delete(models)
+}
+
+func (d *daemonGcBlobUploadRunnerDo) withDO(do gen.Dao) *daemonGcBlobUploadRunnerDo {
+	d.DO = *do.(*gen.DO)
+	return d
+}
@@ -0,0 +1,355 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+)
+
+func newDaemonGcPipelineRule(db *gorm.DB, opts ...gen.DOOption) daemonGcPipelineRule {
+	_daemonGcPipelineRule := daemonGcPipelineRule{}
+
+	_daemonGcPipelineRule.daemonGcPipelineRuleDo.UseDB(db, opts...)
+	_daemonGcPipelineRule.daemonGcPipelineRuleDo.UseModel(&models.DaemonGcPipelineRule{})
+
+	tableName := _daemonGcPipelineRule.daemonGcPipelineRuleDo.TableName()
+	_daemonGcPipelineRule.ALL = field.NewAsterisk(tableName)
+	_daemonGcPipelineRule.CreatedAt = field.NewInt64(tableName, "created_at")
+	_daemonGcPipelineRule.UpdatedAt = field.NewInt64(tableName, "updated_at")
+	_daemonGcPipelineRule.DeletedAt = field.NewUint64(tableName, "deleted_at")
+	_daemonGcPipelineRule.ID = field.NewInt64(tableName, "id")
+	_daemonGcPipelineRule.CronEnabled = field.NewBool(tableName, "cron_enabled")
+	_daemonGcPipelineRule.CronRule = field.NewString(tableName, "cron_rule")
+	_daemonGcPipelineRule.CronNextTrigger = field.NewInt64(tableName, "cron_next_trigger")
+
+	_daemonGcPipelineRule.fillFieldMap()
+
+	return _daemonGcPipelineRule
+}
+
+type daemonGcPipelineRule struct {
+	daemonGcPipelineRuleDo daemonGcPipelineRuleDo
+
+	ALL             field.Asterisk
+	CreatedAt       field.Int64
+	UpdatedAt       field.Int64
+	DeletedAt       field.Uint64
+	ID              field.Int64
+	CronEnabled     field.Bool
+	CronRule        field.String
+	CronNextTrigger field.Int64
+
+	fieldMap map[string]field.Expr
+}
+
+func (d daemonGcPipelineRule) Table(newTableName string) *daemonGcPipelineRule {
+	d.daemonGcPipelineRuleDo.UseTable(newTableName)
+	return d.updateTableName(newTableName)
+}
+
+func (d daemonGcPipelineRule) As(alias string) *daemonGcPipelineRule {
+	d.daemonGcPipelineRuleDo.DO = *(d.daemonGcPipelineRuleDo.As(alias).(*gen.DO))
+	return d.updateTableName(alias)
+}
+
+func (d *daemonGcPipelineRule) updateTableName(table string) *daemonGcPipelineRule {
+	d.ALL = field.NewAsterisk(table)
+	d.CreatedAt = field.NewInt64(table, "created_at")
+	d.UpdatedAt = field.NewInt64(table, "updated_at")
+	d.DeletedAt = field.NewUint64(table, "deleted_at")
+	d.ID = field.NewInt64(table, "id")
+	d.CronEnabled = field.NewBool(table, "cron_enabled")
+	d.CronRule = field.NewString(table, "cron_rule")
+	d.CronNextTrigger = field.NewInt64(table, "cron_next_trigger")
+
+	d.fillFieldMap()
+
+	return d
+}
+
+func (d *daemonGcPipelineRule) WithContext(ctx context.Context) *daemonGcPipelineRuleDo {
+	return d.daemonGcPipelineRuleDo.WithContext(ctx)
+}
+
+func (d daemonGcPipelineRule) TableName() string { return d.daemonGcPipelineRuleDo.TableName() }
+
+func (d daemonGcPipelineRule) Alias() string { return d.daemonGcPipelineRuleDo.Alias() }
+
+func (d daemonGcPipelineRule) Columns(cols ...field.Expr) gen.Columns {
+	return d.daemonGcPipelineRuleDo.Columns(cols...)
+}
+
+func (d *daemonGcPipelineRule) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := d.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (d *daemonGcPipelineRule) fillFieldMap() {
+	d.fieldMap = make(map[string]field.Expr, 7)
+	d.fieldMap["created_at"] = d.CreatedAt
+	d.fieldMap["updated_at"] = d.UpdatedAt
+	d.fieldMap["deleted_at"] = d.DeletedAt
+	d.fieldMap["id"] = d.ID
+	d.fieldMap["cron_enabled"] = d.CronEnabled
+	d.fieldMap["cron_rule"] = d.CronRule
+	d.fieldMap["cron_next_trigger"] = d.CronNextTrigger
+}
+
+func (d daemonGcPipelineRule) clone(db *gorm.DB) daemonGcPipelineRule {
+	d.daemonGcPipelineRuleDo.ReplaceConnPool(db.Statement.ConnPool)
+	return d
+}
+
+func (d daemonGcPipelineRule) replaceDB(db *gorm.DB) daemonGcPipelineRule {
+	d.daemonGcPipelineRuleDo.ReplaceDB(db)
+	return d
+}
+
+type daemonGcPipelineRuleDo struct{ gen.DO }
+
+func (d daemonGcPipelineRuleDo) Debug() *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.Debug())
+}
+
+func (d daemonGcPipelineRuleDo) WithContext(ctx context.Context) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.WithContext(ctx))
+}
+
+func (d daemonGcPipelineRuleDo) ReadDB() *daemonGcPipelineRuleDo {
+	return d.Clauses(dbresolver.Read)
+}
+
+func (d daemonGcPipelineRuleDo) WriteDB() *daemonGcPipelineRuleDo {
+	return d.Clauses(dbresolver.Write)
+}
+
+func (d daemonGcPipelineRuleDo) Session(config *gorm.Session) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.Session(config))
+}
+
+func (d daemonGcPipelineRuleDo) Clauses(conds ...clause.Expression) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.Clauses(conds...))
+}
+
+func (d daemonGcPipelineRuleDo) Returning(value interface{}, columns ...string) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.Returning(value, columns...))
+}
+
+func (d daemonGcPipelineRuleDo) Not(conds ...gen.Condition) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.Not(conds...))
+}
+
+func (d daemonGcPipelineRuleDo) Or(conds ...gen.Condition) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.Or(conds...))
+}
+
+func (d daemonGcPipelineRuleDo) Select(conds ...field.Expr) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.Select(conds...))
+}
+
+func (d daemonGcPipelineRuleDo) Where(conds ...gen.Condition) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.Where(conds...))
+}
+
+func (d daemonGcPipelineRuleDo) Order(conds ...field.Expr) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.Order(conds...))
+}
+
+func (d daemonGcPipelineRuleDo) Distinct(cols ...field.Expr) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.Distinct(cols...))
+}
+
+func (d daemonGcPipelineRuleDo) Omit(cols ...field.Expr) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.Omit(cols...))
+}
+
+func (d daemonGcPipelineRuleDo) Join(table schema.Tabler, on ...field.Expr) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.Join(table, on...))
+}
+
+func (d daemonGcPipelineRuleDo) LeftJoin(table schema.Tabler, on ...field.Expr) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.LeftJoin(table, on...))
+}
+
+func (d daemonGcPipelineRuleDo) RightJoin(table schema.Tabler, on ...field.Expr) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.RightJoin(table, on...))
+}
+
+func (d daemonGcPipelineRuleDo) Group(cols ...field.Expr) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.Group(cols...))
+}
+
+func (d daemonGcPipelineRuleDo) Having(conds ...gen.Condition) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.Having(conds...))
+}
+
+func (d daemonGcPipelineRuleDo) Limit(limit int) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.Limit(limit))
+}
+
+func (d daemonGcPipelineRuleDo) Offset(offset int) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.Offset(offset))
+}
+
+func (d daemonGcPipelineRuleDo) Scopes(funcs ...func(gen.Dao) gen.Dao) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.Scopes(funcs...))
+}
+
+func (d daemonGcPipelineRuleDo) Unscoped() *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.Unscoped())
+}
+
+func (d daemonGcPipelineRuleDo) Create(values ...*models.DaemonGcPipelineRule) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return d.DO.Create(values)
+}
+
+func (d daemonGcPipelineRuleDo) CreateInBatches(values []*models.DaemonGcPipelineRule, batchSize int) error {
+	return d.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (d daemonGcPipelineRuleDo) Save(values ...*models.DaemonGcPipelineRule) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return d.DO.Save(values)
+}
+
+func (d daemonGcPipelineRuleDo) First() (*models.DaemonGcPipelineRule, error) {
+	if result, err := d.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcPipelineRule), nil
+	}
+}
+
+func (d daemonGcPipelineRuleDo) Take() (*models.DaemonGcPipelineRule, error) {
+	if result, err := d.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcPipelineRule), nil
+	}
+}
+
+func (d daemonGcPipelineRuleDo) Last() (*models.DaemonGcPipelineRule, error) {
+	if result, err := d.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcPipelineRule), nil
+	}
+}
+
+func (d daemonGcPipelineRuleDo) Find() ([]*models.DaemonGcPipelineRule, error) {
+	result, err := d.DO.Find()
+	return result.([]*models.DaemonGcPipelineRule), err
+}
+
+func (d daemonGcPipelineRuleDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.DaemonGcPipelineRule, err error) {
+	buf := make([]*models.DaemonGcPipelineRule, 0, batchSize)
+	err = d.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (d daemonGcPipelineRuleDo) FindInBatches(result *[]*models.DaemonGcPipelineRule, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return d.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (d daemonGcPipelineRuleDo) Attrs(attrs ...field.AssignExpr) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.Attrs(attrs...))
+}
+
+func (d daemonGcPipelineRuleDo) Assign(attrs ...field.AssignExpr) *daemonGcPipelineRuleDo {
+	return d.withDO(d.DO.Assign(attrs...))
+}
+
+func (d daemonGcPipelineRuleDo) Joins(fields ...field.RelationField) *daemonGcPipelineRuleDo {
+	for _, _f := range fields {
+		d = *d.withDO(d.DO.Joins(_f))
+	}
+	return &d
+}
+
+func (d daemonGcPipelineRuleDo) Preload(fields ...field.RelationField) *daemonGcPipelineRuleDo {
+	for _, _f := range fields {
+		d = *d.withDO(d.DO.Preload(_f))
+	}
+	return &d
+}
+
+func (d daemonGcPipelineRuleDo) FirstOrInit() (*models.DaemonGcPipelineRule, error) {
+	if result, err := d.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcPipelineRule), nil
+	}
+}
+
+func (d daemonGcPipelineRuleDo) FirstOrCreate() (*models.DaemonGcPipelineRule, error) {
+	if result, err := d.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcPipelineRule), nil
+	}
+}
+
+func (d daemonGcPipelineRuleDo) FindByPage(offset int, limit int) (result []*models.DaemonGcPipelineRule, count int64, err error) {
+	result, err = d.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = d.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (d daemonGcPipelineRuleDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = d.Count()
+	if err != nil {
+		return
+	}
+
+	err = d.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (d daemonGcPipelineRuleDo) Scan(result interface{}) (err error) {
+	return d.DO.Scan(result)
+}
+
+func (d daemonGcPipelineRuleDo) Delete(models ...*models.DaemonGcPipelineRule) (result gen.ResultInfo, err error) {
+	return d.DO.Delete(models)
+}
+
+func (d *daemonGcPipelineRuleDo) withDO(do gen.Dao) *daemonGcPipelineRuleDo {
+	d.DO = *do.(*gen.DO)
+	return d
+}
@@ -0,0 +1,560 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+)
+
+func newDaemonGcPipelineRunner(db *gorm.DB, opts ...gen.DOOption) daemonGcPipelineRunner {
+	_daemonGcPipelineRunner := daemonGcPipelineRunner{}
+
+	_daemonGcPipelineRunner.daemonGcPipelineRunnerDo.UseDB(db, opts...)
+	_daemonGcPipelineRunner.daemonGcPipelineRunnerDo.UseModel(&models.DaemonGcPipelineRunner{})
+
+	tableName := _daemonGcPipelineRunner.daemonGcPipelineRunnerDo.TableName()
+	_daemonGcPipelineRunner.ALL = field.NewAsterisk(tableName)
+	_daemonGcPipelineRunner.CreatedAt = field.NewInt64(tableName, "created_at")
+	_daemonGcPipelineRunner.UpdatedAt = field.NewInt64(tableName, "updated_at")
+	_daemonGcPipelineRunner.DeletedAt = field.NewUint64(tableName, "deleted_at")
+	_daemonGcPipelineRunner.ID = field.NewInt64(tableName, "id")
+	_daemonGcPipelineRunner.RuleID = field.NewInt64(tableName, "rule_id")
+	_daemonGcPipelineRunner.Status = field.NewField(tableName, "status")
+	_daemonGcPipelineRunner.Stage = field.NewField(tableName, "stage")
+	_daemonGcPipelineRunner.Message = field.NewBytes(tableName, "message")
+	_daemonGcPipelineRunner.OperateType = field.NewField(tableName, "operate_type")
+	_daemonGcPipelineRunner.OperateUserID = field.NewInt64(tableName, "operate_user_id")
+	_daemonGcPipelineRunner.StartedAt = field.NewInt64(tableName, "started_at")
+	_daemonGcPipelineRunner.EndedAt = field.NewInt64(tableName, "ended_at")
+	_daemonGcPipelineRunner.Duration = field.NewInt64(tableName, "duration")
+	_daemonGcPipelineRunner.RepositoryRunnerID = field.NewInt64(tableName, "repository_runner_id")
+	_daemonGcPipelineRunner.TagRunnerID = field.NewInt64(tableName, "tag_runner_id")
+	_daemonGcPipelineRunner.ArtifactRunnerID = field.NewInt64(tableName, "artifact_runner_id")
+	_daemonGcPipelineRunner.BlobUploadRunnerID = field.NewInt64(tableName, "blob_upload_runner_id")
+	_daemonGcPipelineRunner.BlobRunnerID = field.NewInt64(tableName, "blob_runner_id")
+	_daemonGcPipelineRunner.Report = field.NewBytes(tableName, "report")
+	_daemonGcPipelineRunner.Rule = daemonGcPipelineRunnerBelongsToRule{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Rule", "models.DaemonGcPipelineRule"),
+	}
+
+	_daemonGcPipelineRunner.OperateUser = daemonGcPipelineRunnerBelongsToOperateUser{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("OperateUser", "models.User"),
+	}
+
+	_daemonGcPipelineRunner.fillFieldMap()
+
+	return _daemonGcPipelineRunner
+}
+
+type daemonGcPipelineRunner struct {
+	daemonGcPipelineRunnerDo daemonGcPipelineRunnerDo
+
+	ALL                field.Asterisk
+	CreatedAt          field.Int64
+	UpdatedAt          field.Int64
+	DeletedAt          field.Uint64
+	ID                 field.Int64
+	RuleID             field.Int64
+	Status             field.Field
+	Stage              field.Field
+	Message            field.Bytes
+	OperateType        field.Field
+	OperateUserID      field.Int64
+	StartedAt          field.Int64
+	EndedAt            field.Int64
+	Duration           field.Int64
+	RepositoryRunnerID field.Int64
+	TagRunnerID        field.Int64
+	ArtifactRunnerID   field.Int64
+	BlobUploadRunnerID field.Int64
+	BlobRunnerID       field.Int64
+	Report             field.Bytes
+	Rule               daemonGcPipelineRunnerBelongsToRule
+
+	OperateUser daemonGcPipelineRunnerBelongsToOperateUser
+
+	fieldMap map[string]field.Expr
+}
+
+func (d daemonGcPipelineRunner) Table(newTableName string) *daemonGcPipelineRunner {
+	d.daemonGcPipelineRunnerDo.UseTable(newTableName)
+	return d.updateTableName(newTableName)
+}
+
+func (d daemonGcPipelineRunner) As(alias string) *daemonGcPipelineRunner {
+	d.daemonGcPipelineRunnerDo.DO = *(d.daemonGcPipelineRunnerDo.As(alias).(*gen.DO))
+	return d.updateTableName(alias)
+}
+
+func (d *daemonGcPipelineRunner) updateTableName(table string) *daemonGcPipelineRunner {
+	d.ALL = field.NewAsterisk(table)
+	d.CreatedAt = field.NewInt64(table, "created_at")
+	d.UpdatedAt = field.NewInt64(table, "updated_at")
+	d.DeletedAt = field.NewUint64(table, "deleted_at")
+	d.ID = field.NewInt64(table, "id")
+	d.RuleID = field.NewInt64(table, "rule_id")
+	d.Status = field.NewField(table, "status")
+	d.Stage = field.NewField(table, "stage")
+	d.Message = field.NewBytes(table, "message")
+	d.OperateType = field.NewField(table, "operate_type")
+	d.OperateUserID = field.NewInt64(table, "operate_user_id")
+	d.StartedAt = field.NewInt64(table, "started_at")
+	d.EndedAt = field.NewInt64(table, "ended_at")
+	d.Duration = field.NewInt64(table, "duration")
+	d.RepositoryRunnerID = field.NewInt64(table, "repository_runner_id")
+	d.TagRunnerID = field.NewInt64(table, "tag_runner_id")
+	d.ArtifactRunnerID = field.NewInt64(table, "artifact_runner_id")
+	d.BlobUploadRunnerID = field.NewInt64(table, "blob_upload_runner_id")
+	d.BlobRunnerID = field.NewInt64(table, "blob_runner_id")
+	d.Report = field.NewBytes(table, "report")
+
+	d.fillFieldMap()
+
+	return d
+}
+
+func (d *daemonGcPipelineRunner) WithContext(ctx context.Context) *daemonGcPipelineRunnerDo {
+	return d.daemonGcPipelineRunnerDo.WithContext(ctx)
+}
+
+func (d daemonGcPipelineRunner) TableName() string { return d.daemonGcPipelineRunnerDo.TableName() }
+
+func (d daemonGcPipelineRunner) Alias() string { return d.daemonGcPipelineRunnerDo.Alias() }
+
+func (d daemonGcPipelineRunner) Columns(cols ...field.Expr) gen.Columns {
+	return d.daemonGcPipelineRunnerDo.Columns(cols...)
+}
+
+func (d *daemonGcPipelineRunner) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := d.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (d *daemonGcPipelineRunner) fillFieldMap() {
+	d.fieldMap = make(map[string]field.Expr, 21)
+	d.fieldMap["created_at"] = d.CreatedAt
+	d.fieldMap["updated_at"] = d.UpdatedAt
+	d.fieldMap["deleted_at"] = d.DeletedAt
+	d.fieldMap["id"] = d.ID
+	d.fieldMap["rule_id"] = d.RuleID
+	d.fieldMap["status"] = d.Status
+	d.fieldMap["stage"] = d.Stage
+	d.fieldMap["message"] = d.Message
+	d.fieldMap["operate_type"] = d.OperateType
+	d.fieldMap["operate_user_id"] = d.OperateUserID
+	d.fieldMap["started_at"] = d.StartedAt
+	d.fieldMap["ended_at"] = d.EndedAt
+	d.fieldMap["duration"] = d.Duration
+	d.fieldMap["repository_runner_id"] = d.RepositoryRunnerID
+	d.fieldMap["tag_runner_id"] = d.TagRunnerID
+	d.fieldMap["artifact_runner_id"] = d.ArtifactRunnerID
+	d.fieldMap["blob_upload_runner_id"] = d.BlobUploadRunnerID
+	d.fieldMap["blob_runner_id"] = d.BlobRunnerID
+	d.fieldMap["report"] = d.Report
+
+}
+
+func (d daemonGcPipelineRunner) clone(db *gorm.DB) daemonGcPipelineRunner {
+	d.daemonGcPipelineRunnerDo.ReplaceConnPool(db.Statement.ConnPool)
+	return d
+}
+
+func (d daemonGcPipelineRunner) replaceDB(db *gorm.DB) daemonGcPipelineRunner {
+	d.daemonGcPipelineRunnerDo.ReplaceDB(db)
+	return d
+}
+
+type daemonGcPipelineRunnerBelongsToRule struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a daemonGcPipelineRunnerBelongsToRule) Where(conds ...field.Expr) *daemonGcPipelineRunnerBelongsToRule {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a daemonGcPipelineRunnerBelongsToRule) WithContext(ctx context.Context) *daemonGcPipelineRunnerBelongsToRule {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a daemonGcPipelineRunnerBelongsToRule) Session(session *gorm.Session) *daemonGcPipelineRunnerBelongsToRule {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a daemonGcPipelineRunnerBelongsToRule) Model(m *models.DaemonGcPipelineRunner) *daemonGcPipelineRunnerBelongsToRuleTx {
+	return &daemonGcPipelineRunnerBelongsToRuleTx{a.db.Model(m).Association(a.Name())}
+}
+
+type daemonGcPipelineRunnerBelongsToRuleTx struct{ tx *gorm.Association }
+
+func (a daemonGcPipelineRunnerBelongsToRuleTx) Find() (result *models.DaemonGcPipelineRule, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a daemonGcPipelineRunnerBelongsToRuleTx) Append(values ...*models.DaemonGcPipelineRule) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a daemonGcPipelineRunnerBelongsToRuleTx) Replace(values ...*models.DaemonGcPipelineRule) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a daemonGcPipelineRunnerBelongsToRuleTx) Delete(values ...*models.DaemonGcPipelineRule) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a daemonGcPipelineRunnerBelongsToRuleTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a daemonGcPipelineRunnerBelongsToRuleTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type daemonGcPipelineRunnerBelongsToOperateUser struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a daemonGcPipelineRunnerBelongsToOperateUser) Where(conds ...field.Expr) *daemonGcPipelineRunnerBelongsToOperateUser {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a daemonGcPipelineRunnerBelongsToOperateUser) WithContext(ctx context.Context) *daemonGcPipelineRunnerBelongsToOperateUser {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a daemonGcPipelineRunnerBelongsToOperateUser) Session(session *gorm.Session) *daemonGcPipelineRunnerBelongsToOperateUser {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a daemonGcPipelineRunnerBelongsToOperateUser) Model(m *models.DaemonGcPipelineRunner) *daemonGcPipelineRunnerBelongsToOperateUserTx {
+	return &daemonGcPipelineRunnerBelongsToOperateUserTx{a.db.Model(m).Association(a.Name())}
+}
+
+type daemonGcPipelineRunnerBelongsToOperateUserTx struct{ tx *gorm.Association }
+
+func (a daemonGcPipelineRunnerBelongsToOperateUserTx) Find() (result *models.User, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a daemonGcPipelineRunnerBelongsToOperateUserTx) Append(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a daemonGcPipelineRunnerBelongsToOperateUserTx) Replace(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a daemonGcPipelineRunnerBelongsToOperateUserTx) Delete(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a daemonGcPipelineRunnerBelongsToOperateUserTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a daemonGcPipelineRunnerBelongsToOperateUserTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type daemonGcPipelineRunnerDo struct{ gen.DO }
+
+func (d daemonGcPipelineRunnerDo) Debug() *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.Debug())
+}
+
+func (d daemonGcPipelineRunnerDo) WithContext(ctx context.Context) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.WithContext(ctx))
+}
+
+func (d daemonGcPipelineRunnerDo) ReadDB() *daemonGcPipelineRunnerDo {
+	return d.Clauses(dbresolver.Read)
+}
+
+func (d daemonGcPipelineRunnerDo) WriteDB() *daemonGcPipelineRunnerDo {
+	return d.Clauses(dbresolver.Write)
+}
+
+func (d daemonGcPipelineRunnerDo) Session(config *gorm.Session) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.Session(config))
+}
+
+func (d daemonGcPipelineRunnerDo) Clauses(conds ...clause.Expression) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.Clauses(conds...))
+}
+
+func (d daemonGcPipelineRunnerDo) Returning(value interface{}, columns ...string) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.Returning(value, columns...))
+}
+
+func (d daemonGcPipelineRunnerDo) Not(conds ...gen.Condition) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.Not(conds...))
+}
+
+func (d daemonGcPipelineRunnerDo) Or(conds ...gen.Condition) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.Or(conds...))
+}
+
+func (d daemonGcPipelineRunnerDo) Select(conds ...field.Expr) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.Select(conds...))
+}
+
+func (d daemonGcPipelineRunnerDo) Where(conds ...gen.Condition) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.Where(conds...))
+}
+
+func (d daemonGcPipelineRunnerDo) Order(conds ...field.Expr) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.Order(conds...))
+}
+
+func (d daemonGcPipelineRunnerDo) Distinct(cols ...field.Expr) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.Distinct(cols...))
+}
+
+func (d daemonGcPipelineRunnerDo) Omit(cols ...field.Expr) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.Omit(cols...))
+}
+
+func (d daemonGcPipelineRunnerDo) Join(table schema.Tabler, on ...field.Expr) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.Join(table, on...))
+}
+
+func (d daemonGcPipelineRunnerDo) LeftJoin(table schema.Tabler, on ...field.Expr) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.LeftJoin(table, on...))
+}
+
+func (d daemonGcPipelineRunnerDo) RightJoin(table schema.Tabler, on ...field.Expr) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.RightJoin(table, on...))
+}
+
+func (d daemonGcPipelineRunnerDo) Group(cols ...field.Expr) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.Group(cols...))
+}
+
+func (d daemonGcPipelineRunnerDo) Having(conds ...gen.Condition) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.Having(conds...))
+}
+
+func (d daemonGcPipelineRunnerDo) Limit(limit int) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.Limit(limit))
+}
+
+func (d daemonGcPipelineRunnerDo) Offset(offset int) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.Offset(offset))
+}
+
+func (d daemonGcPipelineRunnerDo) Scopes(funcs ...func(gen.Dao) gen.Dao) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.Scopes(funcs...))
+}
+
+func (d daemonGcPipelineRunnerDo) Unscoped() *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.Unscoped())
+}
+
+func (d daemonGcPipelineRunnerDo) Create(values ...*models.DaemonGcPipelineRunner) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return d.DO.Create(values)
+}
+
+func (d daemonGcPipelineRunnerDo) CreateInBatches(values []*models.DaemonGcPipelineRunner, batchSize int) error {
+	return d.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (d daemonGcPipelineRunnerDo) Save(values ...*models.DaemonGcPipelineRunner) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return d.DO.Save(values)
+}
+
+func (d daemonGcPipelineRunnerDo) First() (*models.DaemonGcPipelineRunner, error) {
+	if result, err := d.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcPipelineRunner), nil
+	}
+}
+
+func (d daemonGcPipelineRunnerDo) Take() (*models.DaemonGcPipelineRunner, error) {
+	if result, err := d.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcPipelineRunner), nil
+	}
+}
+
+func (d daemonGcPipelineRunnerDo) Last() (*models.DaemonGcPipelineRunner, error) {
+	if result, err := d.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcPipelineRunner), nil
+	}
+}
+
+func (d daemonGcPipelineRunnerDo) Find() ([]*models.DaemonGcPipelineRunner, error) {
+	result, err := d.DO.Find()
+	return result.([]*models.DaemonGcPipelineRunner), err
+}
+
+func (d daemonGcPipelineRunnerDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.DaemonGcPipelineRunner, err error) {
+	buf := make([]*models.DaemonGcPipelineRunner, 0, batchSize)
+	err = d.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (d daemonGcPipelineRunnerDo) FindInBatches(result *[]*models.DaemonGcPipelineRunner, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return d.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (d daemonGcPipelineRunnerDo) Attrs(attrs ...field.AssignExpr) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.Attrs(attrs...))
+}
+
+func (d daemonGcPipelineRunnerDo) Assign(attrs ...field.AssignExpr) *daemonGcPipelineRunnerDo {
+	return d.withDO(d.DO.Assign(attrs...))
+}
+
+func (d daemonGcPipelineRunnerDo) Joins(fields ...field.RelationField) *daemonGcPipelineRunnerDo {
+	for _, _f := range fields {
+		d = *d.withDO(d.DO.Joins(_f))
+	}
+	return &d
+}
+
+func (d daemonGcPipelineRunnerDo) Preload(fields ...field.RelationField) *daemonGcPipelineRunnerDo {
+	for _, _f := range fields {
+		d = *d.withDO(d.DO.Preload(_f))
+	}
+	return &d
+}
+
+func (d daemonGcPipelineRunnerDo) FirstOrInit() (*models.DaemonGcPipelineRunner, error) {
+	if result, err := d.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcPipelineRunner), nil
+	}
+}
+
+func (d daemonGcPipelineRunnerDo) FirstOrCreate() (*models.DaemonGcPipelineRunner, error) {
+	if result, err := d.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.DaemonGcPipelineRunner), nil
+	}
+}
+
+func (d daemonGcPipelineRunnerDo) FindByPage(offset int, limit int) (result []*models.DaemonGcPipelineRunner, count int64, err error) {
+	result, err = d.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = d.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (d daemonGcPipelineRunnerDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = d.Count()
+	if err != nil {
+		return
+	}
+
+	err = d.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (d daemonGcPipelineRunnerDo) Scan(result interface{}) (err error) {
+	return d.DO.Scan(result)
+}
+
+func (d daemonGcPipelineRunnerDo) Delete(models ...*models.DaemonGcPipelineRunner) (result gen.ResultInfo, err error) {
+	return d.DO.Delete(models)
+}
+
+func (d *daemonGcPipelineRunnerDo) withDO(do gen.Dao) *daemonGcPipelineRunnerDo {
+	d.DO = *do.(*gen.DO)
+	return d
+}
@@ -32,8 +32,10 @@ func newDaemonGcRepositoryRecord(db *gorm.DB, opts ...gen.DOOption) daemonGcRepo
 	_daemonGcRepositoryRecord.DeletedAt = field.NewUint64(tableName, "deleted_at")
 	_daemonGcRepositoryRecord.ID = field.NewInt64(tableName, "id")
 	_daemonGcRepositoryRecord.RunnerID = field.NewInt64(tableName, "runner_id")
+	_daemonGcRepositoryRecord.NamespaceID = field.NewInt64(tableName, "namespace_id")
 	_daemonGcRepositoryRecord.Repository = field.NewString(tableName, "repository")
 	_daemonGcRepositoryRecord.Status = field.NewField(tableName, "status")
+	_daemonGcRepositoryRecord.Size = field.NewInt64(tableName, "size")
 	_daemonGcRepositoryRecord.Message = field.NewBytes(tableName, "message")
 	_daemonGcRepositoryRecord.Runner = daemonGcRepositoryRecordBelongsToRunner{
 		db: db.Session(&gorm.Session{}),
@@ -67,16 +69,18 @@ func newDaemonGcRepositoryRecord(db *gorm.DB, opts ...gen.DOOption) daemonGcRepo
 type daemonGcRepositoryRecord struct {
 	daemonGcRepositoryRecordDo daemonGcRepositoryRecordDo
 
-	ALL        field.Asterisk
-	CreatedAt  field.Int64
-	UpdatedAt  field.Int64
-	DeletedAt  field.Uint64
-	ID         field.Int64
-	RunnerID   field.Int64
-	Repository field.String
-	Status     field.Field
-	Message    field.Bytes
-	Runner     daemonGcRepositoryRecordBelongsToRunner
+	ALL         field.Asterisk
+	CreatedAt   field.Int64
+	UpdatedAt   field.Int64
+	DeletedAt   field.Uint64
+	ID          field.Int64
+	RunnerID    field.Int64
+	NamespaceID field.Int64
+	Repository  field.String
+	Status      field.Field
+	Size        field.Int64
+	Message     field.Bytes
+	Runner      daemonGcRepositoryRecordBelongsToRunner
 
 	fieldMap map[string]field.Expr
 }
@@ -98,8 +102,10 @@ func (d *daemonGcRepositoryRecord) updateTableName(table string) *daemonGcReposi
 	d.DeletedAt = field.NewUint64(table, "deleted_at")
 	d.ID = field.NewInt64(table, "id")
 	d.RunnerID = field.NewInt64(table, "runner_id")
+	d.NamespaceID = field.NewInt64(table, "namespace_id")
 	d.Repository = field.NewString(table, "repository")
 	d.Status = field.NewField(table, "status")
+	d.Size = field.NewInt64(table, "size")
 	d.Message = field.NewBytes(table, "message")
 
 	d.fillFieldMap()
@@ -129,14 +135,16 @@ func (d *daemonGcRepositoryRecord) GetFieldByName(fieldName string) (field.Order
 }
 
 func (d *daemonGcRepositoryRecord) fillFieldMap() {
-	d.fieldMap = make(map[string]field.Expr, 9)
+	d.fieldMap = make(map[string]field.Expr, 11)
 	d.fieldMap["created_at"] = d.CreatedAt
 	d.fieldMap["updated_at"] = d.UpdatedAt
 	d.fieldMap["deleted_at"] = d.DeletedAt
 	d.fieldMap["id"] = d.ID
 	d.fieldMap["runner_id"] = d.RunnerID
+	d.fieldMap["namespace_id"] = d.NamespaceID
 	d.fieldMap["repository"] = d.Repository
 	d.fieldMap["status"] = d.Status
+	d.fieldMap["size"] = d.Size
 	d.fieldMap["message"] = d.Message
 
 }
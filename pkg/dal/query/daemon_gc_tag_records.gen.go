@@ -32,8 +32,10 @@ func newDaemonGcTagRecord(db *gorm.DB, opts ...gen.DOOption) daemonGcTagRecord {
 	_daemonGcTagRecord.DeletedAt = field.NewUint64(tableName, "deleted_at")
 	_daemonGcTagRecord.ID = field.NewInt64(tableName, "id")
 	_daemonGcTagRecord.RunnerID = field.NewInt64(tableName, "runner_id")
+	_daemonGcTagRecord.NamespaceID = field.NewInt64(tableName, "namespace_id")
 	_daemonGcTagRecord.Tag = field.NewString(tableName, "tag")
 	_daemonGcTagRecord.Status = field.NewField(tableName, "status")
+	_daemonGcTagRecord.Size = field.NewInt64(tableName, "size")
 	_daemonGcTagRecord.Message = field.NewBytes(tableName, "message")
 	_daemonGcTagRecord.Runner = daemonGcTagRecordBelongsToRunner{
 		db: db.Session(&gorm.Session{}),
@@ -67,16 +69,18 @@ func newDaemonGcTagRecord(db *gorm.DB, opts ...gen.DOOption) daemonGcTagRecord {
 type daemonGcTagRecord struct {
 	daemonGcTagRecordDo daemonGcTagRecordDo
 
-	ALL       field.Asterisk
-	CreatedAt field.Int64
-	UpdatedAt field.Int64
-	DeletedAt field.Uint64
-	ID        field.Int64
-	RunnerID  field.Int64
-	Tag       field.String
-	Status    field.Field
-	Message   field.Bytes
-	Runner    daemonGcTagRecordBelongsToRunner
+	ALL         field.Asterisk
+	CreatedAt   field.Int64
+	UpdatedAt   field.Int64
+	DeletedAt   field.Uint64
+	ID          field.Int64
+	RunnerID    field.Int64
+	NamespaceID field.Int64
+	Tag         field.String
+	Status      field.Field
+	Size        field.Int64
+	Message     field.Bytes
+	Runner      daemonGcTagRecordBelongsToRunner
 
 	fieldMap map[string]field.Expr
 }
@@ -98,8 +102,10 @@ func (d *daemonGcTagRecord) updateTableName(table string) *daemonGcTagRecord {
 	d.DeletedAt = field.NewUint64(table, "deleted_at")
 	d.ID = field.NewInt64(table, "id")
 	d.RunnerID = field.NewInt64(table, "runner_id")
+	d.NamespaceID = field.NewInt64(table, "namespace_id")
 	d.Tag = field.NewString(table, "tag")
 	d.Status = field.NewField(table, "status")
+	d.Size = field.NewInt64(table, "size")
 	d.Message = field.NewBytes(table, "message")
 
 	d.fillFieldMap()
@@ -129,14 +135,16 @@ func (d *daemonGcTagRecord) GetFieldByName(fieldName string) (field.OrderExpr, b
 }
 
 func (d *daemonGcTagRecord) fillFieldMap() {
-	d.fieldMap = make(map[string]field.Expr, 9)
+	d.fieldMap = make(map[string]field.Expr, 11)
 	d.fieldMap["created_at"] = d.CreatedAt
 	d.fieldMap["updated_at"] = d.UpdatedAt
 	d.fieldMap["deleted_at"] = d.DeletedAt
 	d.fieldMap["id"] = d.ID
 	d.fieldMap["runner_id"] = d.RunnerID
+	d.fieldMap["namespace_id"] = d.NamespaceID
 	d.fieldMap["tag"] = d.Tag
 	d.fieldMap["status"] = d.Status
+	d.fieldMap["size"] = d.Size
 	d.fieldMap["message"] = d.Message
 
 }
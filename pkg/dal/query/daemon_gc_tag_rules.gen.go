@@ -39,6 +39,7 @@ func newDaemonGcTagRule(db *gorm.DB, opts ...gen.DOOption) daemonGcTagRule {
 	_daemonGcTagRule.RetentionRuleType = field.NewField(tableName, "retention_rule_type")
 	_daemonGcTagRule.RetentionRuleAmount = field.NewInt64(tableName, "retention_rule_amount")
 	_daemonGcTagRule.RetentionPattern = field.NewString(tableName, "retention_pattern")
+	_daemonGcTagRule.RetentionRules = field.NewBytes(tableName, "retention_rules")
 	_daemonGcTagRule.Namespace = daemonGcTagRuleBelongsToNamespace{
 		db: db.Session(&gorm.Session{}),
 
@@ -66,6 +67,7 @@ type daemonGcTagRule struct {
 	RetentionRuleType   field.Field
 	RetentionRuleAmount field.Int64
 	RetentionPattern    field.String
+	RetentionRules      field.Bytes
 	Namespace           daemonGcTagRuleBelongsToNamespace
 
 	fieldMap map[string]field.Expr
@@ -95,6 +97,7 @@ func (d *daemonGcTagRule) updateTableName(table string) *daemonGcTagRule {
 	d.RetentionRuleType = field.NewField(table, "retention_rule_type")
 	d.RetentionRuleAmount = field.NewInt64(table, "retention_rule_amount")
 	d.RetentionPattern = field.NewString(table, "retention_pattern")
+	d.RetentionRules = field.NewBytes(table, "retention_rules")
 
 	d.fillFieldMap()
 
@@ -123,7 +126,7 @@ func (d *daemonGcTagRule) GetFieldByName(fieldName string) (field.OrderExpr, boo
 }
 
 func (d *daemonGcTagRule) fillFieldMap() {
-	d.fieldMap = make(map[string]field.Expr, 13)
+	d.fieldMap = make(map[string]field.Expr, 14)
 	d.fieldMap["created_at"] = d.CreatedAt
 	d.fieldMap["updated_at"] = d.UpdatedAt
 	d.fieldMap["deleted_at"] = d.DeletedAt
@@ -136,6 +139,7 @@ func (d *daemonGcTagRule) fillFieldMap() {
 	d.fieldMap["retention_rule_type"] = d.RetentionRuleType
 	d.fieldMap["retention_rule_amount"] = d.RetentionRuleAmount
 	d.fieldMap["retention_pattern"] = d.RetentionPattern
+	d.fieldMap["retention_rules"] = d.RetentionRules
 
 }
 
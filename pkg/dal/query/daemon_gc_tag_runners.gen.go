@@ -41,6 +41,8 @@ func newDaemonGcTagRunner(db *gorm.DB, opts ...gen.DOOption) daemonGcTagRunner {
 	_daemonGcTagRunner.Duration = field.NewInt64(tableName, "duration")
 	_daemonGcTagRunner.SuccessCount = field.NewInt64(tableName, "success_count")
 	_daemonGcTagRunner.FailedCount = field.NewInt64(tableName, "failed_count")
+	_daemonGcTagRunner.DryRun = field.NewBool(tableName, "dry_run")
+	_daemonGcTagRunner.ReclaimableSize = field.NewInt64(tableName, "reclaimable_size")
 	_daemonGcTagRunner.Rule = daemonGcTagRunnerBelongsToRule{
 		db: db.Session(&gorm.Session{}),
 
@@ -66,22 +68,24 @@ func newDaemonGcTagRunner(db *gorm.DB, opts ...gen.DOOption) daemonGcTagRunner {
 type daemonGcTagRunner struct {
 	daemonGcTagRunnerDo daemonGcTagRunnerDo
 
-	ALL           field.Asterisk
-	CreatedAt     field.Int64
-	UpdatedAt     field.Int64
-	DeletedAt     field.Uint64
-	ID            field.Int64
-	RuleID        field.Int64
-	Message       field.Bytes
-	Status        field.Field
-	OperateType   field.Field
-	OperateUserID field.Int64
-	StartedAt     field.Int64
-	EndedAt       field.Int64
-	Duration      field.Int64
-	SuccessCount  field.Int64
-	FailedCount   field.Int64
-	Rule          daemonGcTagRunnerBelongsToRule
+	ALL             field.Asterisk
+	CreatedAt       field.Int64
+	UpdatedAt       field.Int64
+	DeletedAt       field.Uint64
+	ID              field.Int64
+	RuleID          field.Int64
+	Message         field.Bytes
+	Status          field.Field
+	OperateType     field.Field
+	OperateUserID   field.Int64
+	StartedAt       field.Int64
+	EndedAt         field.Int64
+	Duration        field.Int64
+	SuccessCount    field.Int64
+	FailedCount     field.Int64
+	DryRun          field.Bool
+	ReclaimableSize field.Int64
+	Rule            daemonGcTagRunnerBelongsToRule
 
 	OperateUser daemonGcTagRunnerBelongsToOperateUser
 
@@ -114,6 +118,8 @@ func (d *daemonGcTagRunner) updateTableName(table string) *daemonGcTagRunner {
 	d.Duration = field.NewInt64(table, "duration")
 	d.SuccessCount = field.NewInt64(table, "success_count")
 	d.FailedCount = field.NewInt64(table, "failed_count")
+	d.DryRun = field.NewBool(table, "dry_run")
+	d.ReclaimableSize = field.NewInt64(table, "reclaimable_size")
 
 	d.fillFieldMap()
 
@@ -142,7 +148,7 @@ func (d *daemonGcTagRunner) GetFieldByName(fieldName string) (field.OrderExpr, b
 }
 
 func (d *daemonGcTagRunner) fillFieldMap() {
-	d.fieldMap = make(map[string]field.Expr, 16)
+	d.fieldMap = make(map[string]field.Expr, 18)
 	d.fieldMap["created_at"] = d.CreatedAt
 	d.fieldMap["updated_at"] = d.UpdatedAt
 	d.fieldMap["deleted_at"] = d.DeletedAt
@@ -157,6 +163,8 @@ func (d *daemonGcTagRunner) fillFieldMap() {
 	d.fieldMap["duration"] = d.Duration
 	d.fieldMap["success_count"] = d.SuccessCount
 	d.fieldMap["failed_count"] = d.FailedCount
+	d.fieldMap["dry_run"] = d.DryRun
+	d.fieldMap["reclaimable_size"] = d.ReclaimableSize
 
 }
 
@@ -18,7 +18,10 @@ import (
 var (
 	Q                             = new(Query)
 	Artifact                      *artifact
+	ArtifactMisconfiguration      *artifactMisconfiguration
+	ArtifactPackage               *artifactPackage
 	ArtifactSbom                  *artifactSbom
+	ArtifactSecret                *artifactSecret
 	ArtifactVulnerability         *artifactVulnerability
 	Audit                         *audit
 	Blob                          *blob
@@ -36,20 +39,32 @@ var (
 	DaemonGcBlobRecord            *daemonGcBlobRecord
 	DaemonGcBlobRule              *daemonGcBlobRule
 	DaemonGcBlobRunner            *daemonGcBlobRunner
+	DaemonGcBlobUploadRecord      *daemonGcBlobUploadRecord
+	DaemonGcBlobUploadRule        *daemonGcBlobUploadRule
+	DaemonGcBlobUploadRunner      *daemonGcBlobUploadRunner
+	DaemonGcPipelineRule          *daemonGcPipelineRule
+	DaemonGcPipelineRunner        *daemonGcPipelineRunner
 	DaemonGcRepositoryRecord      *daemonGcRepositoryRecord
 	DaemonGcRepositoryRule        *daemonGcRepositoryRule
 	DaemonGcRepositoryRunner      *daemonGcRepositoryRunner
 	DaemonGcTagRecord             *daemonGcTagRecord
 	DaemonGcTagRule               *daemonGcTagRule
 	DaemonGcTagRunner             *daemonGcTagRunner
+	LicensePolicy                 *licensePolicy
 	Namespace                     *namespace
 	NamespaceMember               *namespaceMember
 	Repository                    *repository
 	Setting                       *setting
+	SignaturePolicy               *signaturePolicy
+	SigningKey                    *signingKey
+	SigningPolicy                 *signingPolicy
 	Tag                           *tag
 	User                          *user
 	User3rdParty                  *user3rdParty
 	UserRecoverCode               *userRecoverCode
+	VulnerabilityAllowlist        *vulnerabilityAllowlist
+	VulnerabilityPolicy           *vulnerabilityPolicy
+	VulnerabilityRescanPolicy     *vulnerabilityRescanPolicy
 	Webhook                       *webhook
 	WebhookLog                    *webhookLog
 	WorkQueue                     *workQueue
@@ -58,7 +73,10 @@ var (
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
 	Artifact = &Q.Artifact
+	ArtifactMisconfiguration = &Q.ArtifactMisconfiguration
+	ArtifactPackage = &Q.ArtifactPackage
 	ArtifactSbom = &Q.ArtifactSbom
+	ArtifactSecret = &Q.ArtifactSecret
 	ArtifactVulnerability = &Q.ArtifactVulnerability
 	Audit = &Q.Audit
 	Blob = &Q.Blob
@@ -76,20 +94,32 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	DaemonGcBlobRecord = &Q.DaemonGcBlobRecord
 	DaemonGcBlobRule = &Q.DaemonGcBlobRule
 	DaemonGcBlobRunner = &Q.DaemonGcBlobRunner
+	DaemonGcBlobUploadRecord = &Q.DaemonGcBlobUploadRecord
+	DaemonGcBlobUploadRule = &Q.DaemonGcBlobUploadRule
+	DaemonGcBlobUploadRunner = &Q.DaemonGcBlobUploadRunner
+	DaemonGcPipelineRule = &Q.DaemonGcPipelineRule
+	DaemonGcPipelineRunner = &Q.DaemonGcPipelineRunner
 	DaemonGcRepositoryRecord = &Q.DaemonGcRepositoryRecord
 	DaemonGcRepositoryRule = &Q.DaemonGcRepositoryRule
 	DaemonGcRepositoryRunner = &Q.DaemonGcRepositoryRunner
 	DaemonGcTagRecord = &Q.DaemonGcTagRecord
 	DaemonGcTagRule = &Q.DaemonGcTagRule
 	DaemonGcTagRunner = &Q.DaemonGcTagRunner
+	LicensePolicy = &Q.LicensePolicy
 	Namespace = &Q.Namespace
 	NamespaceMember = &Q.NamespaceMember
 	Repository = &Q.Repository
 	Setting = &Q.Setting
+	SignaturePolicy = &Q.SignaturePolicy
+	SigningKey = &Q.SigningKey
+	SigningPolicy = &Q.SigningPolicy
 	Tag = &Q.Tag
 	User = &Q.User
 	User3rdParty = &Q.User3rdParty
 	UserRecoverCode = &Q.UserRecoverCode
+	VulnerabilityAllowlist = &Q.VulnerabilityAllowlist
+	VulnerabilityPolicy = &Q.VulnerabilityPolicy
+	VulnerabilityRescanPolicy = &Q.VulnerabilityRescanPolicy
 	Webhook = &Q.Webhook
 	WebhookLog = &Q.WebhookLog
 	WorkQueue = &Q.WorkQueue
@@ -99,7 +129,10 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
 		db:                            db,
 		Artifact:                      newArtifact(db, opts...),
+		ArtifactMisconfiguration:      newArtifactMisconfiguration(db, opts...),
+		ArtifactPackage:               newArtifactPackage(db, opts...),
 		ArtifactSbom:                  newArtifactSbom(db, opts...),
+		ArtifactSecret:                newArtifactSecret(db, opts...),
 		ArtifactVulnerability:         newArtifactVulnerability(db, opts...),
 		Audit:                         newAudit(db, opts...),
 		Blob:                          newBlob(db, opts...),
@@ -117,20 +150,32 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 		DaemonGcBlobRecord:            newDaemonGcBlobRecord(db, opts...),
 		DaemonGcBlobRule:              newDaemonGcBlobRule(db, opts...),
 		DaemonGcBlobRunner:            newDaemonGcBlobRunner(db, opts...),
+		DaemonGcBlobUploadRecord:      newDaemonGcBlobUploadRecord(db, opts...),
+		DaemonGcBlobUploadRule:        newDaemonGcBlobUploadRule(db, opts...),
+		DaemonGcBlobUploadRunner:      newDaemonGcBlobUploadRunner(db, opts...),
+		DaemonGcPipelineRule:          newDaemonGcPipelineRule(db, opts...),
+		DaemonGcPipelineRunner:        newDaemonGcPipelineRunner(db, opts...),
 		DaemonGcRepositoryRecord:      newDaemonGcRepositoryRecord(db, opts...),
 		DaemonGcRepositoryRule:        newDaemonGcRepositoryRule(db, opts...),
 		DaemonGcRepositoryRunner:      newDaemonGcRepositoryRunner(db, opts...),
 		DaemonGcTagRecord:             newDaemonGcTagRecord(db, opts...),
 		DaemonGcTagRule:               newDaemonGcTagRule(db, opts...),
 		DaemonGcTagRunner:             newDaemonGcTagRunner(db, opts...),
+		LicensePolicy:                 newLicensePolicy(db, opts...),
 		Namespace:                     newNamespace(db, opts...),
 		NamespaceMember:               newNamespaceMember(db, opts...),
 		Repository:                    newRepository(db, opts...),
 		Setting:                       newSetting(db, opts...),
+		SignaturePolicy:               newSignaturePolicy(db, opts...),
+		SigningKey:                    newSigningKey(db, opts...),
+		SigningPolicy:                 newSigningPolicy(db, opts...),
 		Tag:                           newTag(db, opts...),
 		User:                          newUser(db, opts...),
 		User3rdParty:                  newUser3rdParty(db, opts...),
 		UserRecoverCode:               newUserRecoverCode(db, opts...),
+		VulnerabilityAllowlist:        newVulnerabilityAllowlist(db, opts...),
+		VulnerabilityPolicy:           newVulnerabilityPolicy(db, opts...),
+		VulnerabilityRescanPolicy:     newVulnerabilityRescanPolicy(db, opts...),
 		Webhook:                       newWebhook(db, opts...),
 		WebhookLog:                    newWebhookLog(db, opts...),
 		WorkQueue:                     newWorkQueue(db, opts...),
@@ -141,7 +186,10 @@ type Query struct {
 	db *gorm.DB
 
 	Artifact                      artifact
+	ArtifactMisconfiguration      artifactMisconfiguration
+	ArtifactPackage               artifactPackage
 	ArtifactSbom                  artifactSbom
+	ArtifactSecret                artifactSecret
 	ArtifactVulnerability         artifactVulnerability
 	Audit                         audit
 	Blob                          blob
@@ -159,20 +207,32 @@ type Query struct {
 	DaemonGcBlobRecord            daemonGcBlobRecord
 	DaemonGcBlobRule              daemonGcBlobRule
 	DaemonGcBlobRunner            daemonGcBlobRunner
+	DaemonGcBlobUploadRecord      daemonGcBlobUploadRecord
+	DaemonGcBlobUploadRule        daemonGcBlobUploadRule
+	DaemonGcBlobUploadRunner      daemonGcBlobUploadRunner
+	DaemonGcPipelineRule          daemonGcPipelineRule
+	DaemonGcPipelineRunner        daemonGcPipelineRunner
 	DaemonGcRepositoryRecord      daemonGcRepositoryRecord
 	DaemonGcRepositoryRule        daemonGcRepositoryRule
 	DaemonGcRepositoryRunner      daemonGcRepositoryRunner
 	DaemonGcTagRecord             daemonGcTagRecord
 	DaemonGcTagRule               daemonGcTagRule
 	DaemonGcTagRunner             daemonGcTagRunner
+	LicensePolicy                 licensePolicy
 	Namespace                     namespace
 	NamespaceMember               namespaceMember
 	Repository                    repository
 	Setting                       setting
+	SignaturePolicy               signaturePolicy
+	SigningKey                    signingKey
+	SigningPolicy                 signingPolicy
 	Tag                           tag
 	User                          user
 	User3rdParty                  user3rdParty
 	UserRecoverCode               userRecoverCode
+	VulnerabilityAllowlist        vulnerabilityAllowlist
+	VulnerabilityPolicy           vulnerabilityPolicy
+	VulnerabilityRescanPolicy     vulnerabilityRescanPolicy
 	Webhook                       webhook
 	WebhookLog                    webhookLog
 	WorkQueue                     workQueue
@@ -184,7 +244,10 @@ func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
 		db:                            db,
 		Artifact:                      q.Artifact.clone(db),
+		ArtifactMisconfiguration:      q.ArtifactMisconfiguration.clone(db),
+		ArtifactPackage:               q.ArtifactPackage.clone(db),
 		ArtifactSbom:                  q.ArtifactSbom.clone(db),
+		ArtifactSecret:                q.ArtifactSecret.clone(db),
 		ArtifactVulnerability:         q.ArtifactVulnerability.clone(db),
 		Audit:                         q.Audit.clone(db),
 		Blob:                          q.Blob.clone(db),
@@ -202,20 +265,32 @@ func (q *Query) clone(db *gorm.DB) *Query {
 		DaemonGcBlobRecord:            q.DaemonGcBlobRecord.clone(db),
 		DaemonGcBlobRule:              q.DaemonGcBlobRule.clone(db),
 		DaemonGcBlobRunner:            q.DaemonGcBlobRunner.clone(db),
+		DaemonGcBlobUploadRecord:      q.DaemonGcBlobUploadRecord.clone(db),
+		DaemonGcBlobUploadRule:        q.DaemonGcBlobUploadRule.clone(db),
+		DaemonGcBlobUploadRunner:      q.DaemonGcBlobUploadRunner.clone(db),
+		DaemonGcPipelineRule:          q.DaemonGcPipelineRule.clone(db),
+		DaemonGcPipelineRunner:        q.DaemonGcPipelineRunner.clone(db),
 		DaemonGcRepositoryRecord:      q.DaemonGcRepositoryRecord.clone(db),
 		DaemonGcRepositoryRule:        q.DaemonGcRepositoryRule.clone(db),
 		DaemonGcRepositoryRunner:      q.DaemonGcRepositoryRunner.clone(db),
 		DaemonGcTagRecord:             q.DaemonGcTagRecord.clone(db),
 		DaemonGcTagRule:               q.DaemonGcTagRule.clone(db),
 		DaemonGcTagRunner:             q.DaemonGcTagRunner.clone(db),
+		LicensePolicy:                 q.LicensePolicy.clone(db),
 		Namespace:                     q.Namespace.clone(db),
 		NamespaceMember:               q.NamespaceMember.clone(db),
 		Repository:                    q.Repository.clone(db),
 		Setting:                       q.Setting.clone(db),
+		SignaturePolicy:               q.SignaturePolicy.clone(db),
+		SigningKey:                    q.SigningKey.clone(db),
+		SigningPolicy:                 q.SigningPolicy.clone(db),
 		Tag:                           q.Tag.clone(db),
 		User:                          q.User.clone(db),
 		User3rdParty:                  q.User3rdParty.clone(db),
 		UserRecoverCode:               q.UserRecoverCode.clone(db),
+		VulnerabilityAllowlist:        q.VulnerabilityAllowlist.clone(db),
+		VulnerabilityPolicy:           q.VulnerabilityPolicy.clone(db),
+		VulnerabilityRescanPolicy:     q.VulnerabilityRescanPolicy.clone(db),
 		Webhook:                       q.Webhook.clone(db),
 		WebhookLog:                    q.WebhookLog.clone(db),
 		WorkQueue:                     q.WorkQueue.clone(db),
@@ -234,7 +309,10 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
 		db:                            db,
 		Artifact:                      q.Artifact.replaceDB(db),
+		ArtifactMisconfiguration:      q.ArtifactMisconfiguration.replaceDB(db),
+		ArtifactPackage:               q.ArtifactPackage.replaceDB(db),
 		ArtifactSbom:                  q.ArtifactSbom.replaceDB(db),
+		ArtifactSecret:                q.ArtifactSecret.replaceDB(db),
 		ArtifactVulnerability:         q.ArtifactVulnerability.replaceDB(db),
 		Audit:                         q.Audit.replaceDB(db),
 		Blob:                          q.Blob.replaceDB(db),
@@ -252,20 +330,32 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 		DaemonGcBlobRecord:            q.DaemonGcBlobRecord.replaceDB(db),
 		DaemonGcBlobRule:              q.DaemonGcBlobRule.replaceDB(db),
 		DaemonGcBlobRunner:            q.DaemonGcBlobRunner.replaceDB(db),
+		DaemonGcBlobUploadRecord:      q.DaemonGcBlobUploadRecord.replaceDB(db),
+		DaemonGcBlobUploadRule:        q.DaemonGcBlobUploadRule.replaceDB(db),
+		DaemonGcBlobUploadRunner:      q.DaemonGcBlobUploadRunner.replaceDB(db),
+		DaemonGcPipelineRule:          q.DaemonGcPipelineRule.replaceDB(db),
+		DaemonGcPipelineRunner:        q.DaemonGcPipelineRunner.replaceDB(db),
 		DaemonGcRepositoryRecord:      q.DaemonGcRepositoryRecord.replaceDB(db),
 		DaemonGcRepositoryRule:        q.DaemonGcRepositoryRule.replaceDB(db),
 		DaemonGcRepositoryRunner:      q.DaemonGcRepositoryRunner.replaceDB(db),
 		DaemonGcTagRecord:             q.DaemonGcTagRecord.replaceDB(db),
 		DaemonGcTagRule:               q.DaemonGcTagRule.replaceDB(db),
 		DaemonGcTagRunner:             q.DaemonGcTagRunner.replaceDB(db),
+		LicensePolicy:                 q.LicensePolicy.replaceDB(db),
 		Namespace:                     q.Namespace.replaceDB(db),
 		NamespaceMember:               q.NamespaceMember.replaceDB(db),
 		Repository:                    q.Repository.replaceDB(db),
 		Setting:                       q.Setting.replaceDB(db),
+		SignaturePolicy:               q.SignaturePolicy.replaceDB(db),
+		SigningKey:                    q.SigningKey.replaceDB(db),
+		SigningPolicy:                 q.SigningPolicy.replaceDB(db),
 		Tag:                           q.Tag.replaceDB(db),
 		User:                          q.User.replaceDB(db),
 		User3rdParty:                  q.User3rdParty.replaceDB(db),
 		UserRecoverCode:               q.UserRecoverCode.replaceDB(db),
+		VulnerabilityAllowlist:        q.VulnerabilityAllowlist.replaceDB(db),
+		VulnerabilityPolicy:           q.VulnerabilityPolicy.replaceDB(db),
+		VulnerabilityRescanPolicy:     q.VulnerabilityRescanPolicy.replaceDB(db),
 		Webhook:                       q.Webhook.replaceDB(db),
 		WebhookLog:                    q.WebhookLog.replaceDB(db),
 		WorkQueue:                     q.WorkQueue.replaceDB(db),
@@ -274,7 +364,10 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 
 type queryCtx struct {
 	Artifact                      *artifactDo
+	ArtifactMisconfiguration      *artifactMisconfigurationDo
+	ArtifactPackage               *artifactPackageDo
 	ArtifactSbom                  *artifactSbomDo
+	ArtifactSecret                *artifactSecretDo
 	ArtifactVulnerability         *artifactVulnerabilityDo
 	Audit                         *auditDo
 	Blob                          *blobDo
@@ -292,20 +385,32 @@ type queryCtx struct {
 	DaemonGcBlobRecord            *daemonGcBlobRecordDo
 	DaemonGcBlobRule              *daemonGcBlobRuleDo
 	DaemonGcBlobRunner            *daemonGcBlobRunnerDo
+	DaemonGcBlobUploadRecord      *daemonGcBlobUploadRecordDo
+	DaemonGcBlobUploadRule        *daemonGcBlobUploadRuleDo
+	DaemonGcBlobUploadRunner      *daemonGcBlobUploadRunnerDo
+	DaemonGcPipelineRule          *daemonGcPipelineRuleDo
+	DaemonGcPipelineRunner        *daemonGcPipelineRunnerDo
 	DaemonGcRepositoryRecord      *daemonGcRepositoryRecordDo
 	DaemonGcRepositoryRule        *daemonGcRepositoryRuleDo
 	DaemonGcRepositoryRunner      *daemonGcRepositoryRunnerDo
 	DaemonGcTagRecord             *daemonGcTagRecordDo
 	DaemonGcTagRule               *daemonGcTagRuleDo
 	DaemonGcTagRunner             *daemonGcTagRunnerDo
+	LicensePolicy                 *licensePolicyDo
 	Namespace                     *namespaceDo
 	NamespaceMember               *namespaceMemberDo
 	Repository                    *repositoryDo
 	Setting                       *settingDo
+	SignaturePolicy               *signaturePolicyDo
+	SigningKey                    *signingKeyDo
+	SigningPolicy                 *signingPolicyDo
 	Tag                           *tagDo
 	User                          *userDo
 	User3rdParty                  *user3rdPartyDo
 	UserRecoverCode               *userRecoverCodeDo
+	VulnerabilityAllowlist        *vulnerabilityAllowlistDo
+	VulnerabilityPolicy           *vulnerabilityPolicyDo
+	VulnerabilityRescanPolicy     *vulnerabilityRescanPolicyDo
 	Webhook                       *webhookDo
 	WebhookLog                    *webhookLogDo
 	WorkQueue                     *workQueueDo
@@ -314,7 +419,10 @@ type queryCtx struct {
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
 		Artifact:                      q.Artifact.WithContext(ctx),
+		ArtifactMisconfiguration:      q.ArtifactMisconfiguration.WithContext(ctx),
+		ArtifactPackage:               q.ArtifactPackage.WithContext(ctx),
 		ArtifactSbom:                  q.ArtifactSbom.WithContext(ctx),
+		ArtifactSecret:                q.ArtifactSecret.WithContext(ctx),
 		ArtifactVulnerability:         q.ArtifactVulnerability.WithContext(ctx),
 		Audit:                         q.Audit.WithContext(ctx),
 		Blob:                          q.Blob.WithContext(ctx),
@@ -332,20 +440,32 @@ func (q *Query) WithContext(ctx context.Context) *queryCtx {
 		DaemonGcBlobRecord:            q.DaemonGcBlobRecord.WithContext(ctx),
 		DaemonGcBlobRule:              q.DaemonGcBlobRule.WithContext(ctx),
 		DaemonGcBlobRunner:            q.DaemonGcBlobRunner.WithContext(ctx),
+		DaemonGcBlobUploadRecord:      q.DaemonGcBlobUploadRecord.WithContext(ctx),
+		DaemonGcBlobUploadRule:        q.DaemonGcBlobUploadRule.WithContext(ctx),
+		DaemonGcBlobUploadRunner:      q.DaemonGcBlobUploadRunner.WithContext(ctx),
+		DaemonGcPipelineRule:          q.DaemonGcPipelineRule.WithContext(ctx),
+		DaemonGcPipelineRunner:        q.DaemonGcPipelineRunner.WithContext(ctx),
 		DaemonGcRepositoryRecord:      q.DaemonGcRepositoryRecord.WithContext(ctx),
 		DaemonGcRepositoryRule:        q.DaemonGcRepositoryRule.WithContext(ctx),
 		DaemonGcRepositoryRunner:      q.DaemonGcRepositoryRunner.WithContext(ctx),
 		DaemonGcTagRecord:             q.DaemonGcTagRecord.WithContext(ctx),
 		DaemonGcTagRule:               q.DaemonGcTagRule.WithContext(ctx),
 		DaemonGcTagRunner:             q.DaemonGcTagRunner.WithContext(ctx),
+		LicensePolicy:                 q.LicensePolicy.WithContext(ctx),
 		Namespace:                     q.Namespace.WithContext(ctx),
 		NamespaceMember:               q.NamespaceMember.WithContext(ctx),
 		Repository:                    q.Repository.WithContext(ctx),
 		Setting:                       q.Setting.WithContext(ctx),
+		SignaturePolicy:               q.SignaturePolicy.WithContext(ctx),
+		SigningKey:                    q.SigningKey.WithContext(ctx),
+		SigningPolicy:                 q.SigningPolicy.WithContext(ctx),
 		Tag:                           q.Tag.WithContext(ctx),
 		User:                          q.User.WithContext(ctx),
 		User3rdParty:                  q.User3rdParty.WithContext(ctx),
 		UserRecoverCode:               q.UserRecoverCode.WithContext(ctx),
+		VulnerabilityAllowlist:        q.VulnerabilityAllowlist.WithContext(ctx),
+		VulnerabilityPolicy:           q.VulnerabilityPolicy.WithContext(ctx),
+		VulnerabilityRescanPolicy:     q.VulnerabilityRescanPolicy.WithContext(ctx),
 		Webhook:                       q.Webhook.WithContext(ctx),
 		WebhookLog:                    q.WebhookLog.WithContext(ctx),
 		WorkQueue:                     q.WorkQueue.WithContext(ctx),
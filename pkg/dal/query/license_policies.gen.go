@@ -0,0 +1,449 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+)
+
+func newLicensePolicy(db *gorm.DB, opts ...gen.DOOption) licensePolicy {
+	_licensePolicy := licensePolicy{}
+
+	_licensePolicy.licensePolicyDo.UseDB(db, opts...)
+	_licensePolicy.licensePolicyDo.UseModel(&models.LicensePolicy{})
+
+	tableName := _licensePolicy.licensePolicyDo.TableName()
+	_licensePolicy.ALL = field.NewAsterisk(tableName)
+	_licensePolicy.CreatedAt = field.NewInt64(tableName, "created_at")
+	_licensePolicy.UpdatedAt = field.NewInt64(tableName, "updated_at")
+	_licensePolicy.DeletedAt = field.NewUint64(tableName, "deleted_at")
+	_licensePolicy.ID = field.NewInt64(tableName, "id")
+	_licensePolicy.NamespaceID = field.NewInt64(tableName, "namespace_id")
+	_licensePolicy.Enabled = field.NewBool(tableName, "enabled")
+	_licensePolicy.BlockPull = field.NewBool(tableName, "block_pull")
+	_licensePolicy.WarnUnknown = field.NewBool(tableName, "warn_unknown")
+	_licensePolicy.DeniedLicenses = field.NewString(tableName, "denied_licenses")
+	_licensePolicy.AllowedLicenses = field.NewString(tableName, "allowed_licenses")
+	_licensePolicy.Exemptions = field.NewString(tableName, "exemptions")
+	_licensePolicy.Namespace = licensePolicyBelongsToNamespace{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Namespace", "models.Namespace"),
+	}
+
+	_licensePolicy.fillFieldMap()
+
+	return _licensePolicy
+}
+
+type licensePolicy struct {
+	licensePolicyDo licensePolicyDo
+
+	ALL             field.Asterisk
+	CreatedAt       field.Int64
+	UpdatedAt       field.Int64
+	DeletedAt       field.Uint64
+	ID              field.Int64
+	NamespaceID     field.Int64
+	Enabled         field.Bool
+	BlockPull       field.Bool
+	WarnUnknown     field.Bool
+	DeniedLicenses  field.String
+	AllowedLicenses field.String
+	Exemptions      field.String
+	Namespace       licensePolicyBelongsToNamespace
+
+	fieldMap map[string]field.Expr
+}
+
+func (l licensePolicy) Table(newTableName string) *licensePolicy {
+	l.licensePolicyDo.UseTable(newTableName)
+	return l.updateTableName(newTableName)
+}
+
+func (l licensePolicy) As(alias string) *licensePolicy {
+	l.licensePolicyDo.DO = *(l.licensePolicyDo.As(alias).(*gen.DO))
+	return l.updateTableName(alias)
+}
+
+func (l *licensePolicy) updateTableName(table string) *licensePolicy {
+	l.ALL = field.NewAsterisk(table)
+	l.CreatedAt = field.NewInt64(table, "created_at")
+	l.UpdatedAt = field.NewInt64(table, "updated_at")
+	l.DeletedAt = field.NewUint64(table, "deleted_at")
+	l.ID = field.NewInt64(table, "id")
+	l.NamespaceID = field.NewInt64(table, "namespace_id")
+	l.Enabled = field.NewBool(table, "enabled")
+	l.BlockPull = field.NewBool(table, "block_pull")
+	l.WarnUnknown = field.NewBool(table, "warn_unknown")
+	l.DeniedLicenses = field.NewString(table, "denied_licenses")
+	l.AllowedLicenses = field.NewString(table, "allowed_licenses")
+	l.Exemptions = field.NewString(table, "exemptions")
+
+	l.fillFieldMap()
+
+	return l
+}
+
+func (l *licensePolicy) WithContext(ctx context.Context) *licensePolicyDo {
+	return l.licensePolicyDo.WithContext(ctx)
+}
+
+func (l licensePolicy) TableName() string { return l.licensePolicyDo.TableName() }
+
+func (l licensePolicy) Alias() string { return l.licensePolicyDo.Alias() }
+
+func (l licensePolicy) Columns(cols ...field.Expr) gen.Columns {
+	return l.licensePolicyDo.Columns(cols...)
+}
+
+func (l *licensePolicy) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := l.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (l *licensePolicy) fillFieldMap() {
+	l.fieldMap = make(map[string]field.Expr, 12)
+	l.fieldMap["created_at"] = l.CreatedAt
+	l.fieldMap["updated_at"] = l.UpdatedAt
+	l.fieldMap["deleted_at"] = l.DeletedAt
+	l.fieldMap["id"] = l.ID
+	l.fieldMap["namespace_id"] = l.NamespaceID
+	l.fieldMap["enabled"] = l.Enabled
+	l.fieldMap["block_pull"] = l.BlockPull
+	l.fieldMap["warn_unknown"] = l.WarnUnknown
+	l.fieldMap["denied_licenses"] = l.DeniedLicenses
+	l.fieldMap["allowed_licenses"] = l.AllowedLicenses
+	l.fieldMap["exemptions"] = l.Exemptions
+
+}
+
+func (l licensePolicy) clone(db *gorm.DB) licensePolicy {
+	l.licensePolicyDo.ReplaceConnPool(db.Statement.ConnPool)
+	return l
+}
+
+func (l licensePolicy) replaceDB(db *gorm.DB) licensePolicy {
+	l.licensePolicyDo.ReplaceDB(db)
+	return l
+}
+
+type licensePolicyBelongsToNamespace struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a licensePolicyBelongsToNamespace) Where(conds ...field.Expr) *licensePolicyBelongsToNamespace {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a licensePolicyBelongsToNamespace) WithContext(ctx context.Context) *licensePolicyBelongsToNamespace {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a licensePolicyBelongsToNamespace) Session(session *gorm.Session) *licensePolicyBelongsToNamespace {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a licensePolicyBelongsToNamespace) Model(m *models.LicensePolicy) *licensePolicyBelongsToNamespaceTx {
+	return &licensePolicyBelongsToNamespaceTx{a.db.Model(m).Association(a.Name())}
+}
+
+type licensePolicyBelongsToNamespaceTx struct{ tx *gorm.Association }
+
+func (a licensePolicyBelongsToNamespaceTx) Find() (result *models.Namespace, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a licensePolicyBelongsToNamespaceTx) Append(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a licensePolicyBelongsToNamespaceTx) Replace(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a licensePolicyBelongsToNamespaceTx) Delete(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a licensePolicyBelongsToNamespaceTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a licensePolicyBelongsToNamespaceTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type licensePolicyDo struct{ gen.DO }
+
+func (l licensePolicyDo) Debug() *licensePolicyDo {
+	return l.withDO(l.DO.Debug())
+}
+
+func (l licensePolicyDo) WithContext(ctx context.Context) *licensePolicyDo {
+	return l.withDO(l.DO.WithContext(ctx))
+}
+
+func (l licensePolicyDo) ReadDB() *licensePolicyDo {
+	return l.Clauses(dbresolver.Read)
+}
+
+func (l licensePolicyDo) WriteDB() *licensePolicyDo {
+	return l.Clauses(dbresolver.Write)
+}
+
+func (l licensePolicyDo) Session(config *gorm.Session) *licensePolicyDo {
+	return l.withDO(l.DO.Session(config))
+}
+
+func (l licensePolicyDo) Clauses(conds ...clause.Expression) *licensePolicyDo {
+	return l.withDO(l.DO.Clauses(conds...))
+}
+
+func (l licensePolicyDo) Returning(value interface{}, columns ...string) *licensePolicyDo {
+	return l.withDO(l.DO.Returning(value, columns...))
+}
+
+func (l licensePolicyDo) Not(conds ...gen.Condition) *licensePolicyDo {
+	return l.withDO(l.DO.Not(conds...))
+}
+
+func (l licensePolicyDo) Or(conds ...gen.Condition) *licensePolicyDo {
+	return l.withDO(l.DO.Or(conds...))
+}
+
+func (l licensePolicyDo) Select(conds ...field.Expr) *licensePolicyDo {
+	return l.withDO(l.DO.Select(conds...))
+}
+
+func (l licensePolicyDo) Where(conds ...gen.Condition) *licensePolicyDo {
+	return l.withDO(l.DO.Where(conds...))
+}
+
+func (l licensePolicyDo) Order(conds ...field.Expr) *licensePolicyDo {
+	return l.withDO(l.DO.Order(conds...))
+}
+
+func (l licensePolicyDo) Distinct(cols ...field.Expr) *licensePolicyDo {
+	return l.withDO(l.DO.Distinct(cols...))
+}
+
+func (l licensePolicyDo) Omit(cols ...field.Expr) *licensePolicyDo {
+	return l.withDO(l.DO.Omit(cols...))
+}
+
+func (l licensePolicyDo) Join(table schema.Tabler, on ...field.Expr) *licensePolicyDo {
+	return l.withDO(l.DO.Join(table, on...))
+}
+
+func (l licensePolicyDo) LeftJoin(table schema.Tabler, on ...field.Expr) *licensePolicyDo {
+	return l.withDO(l.DO.LeftJoin(table, on...))
+}
+
+func (l licensePolicyDo) RightJoin(table schema.Tabler, on ...field.Expr) *licensePolicyDo {
+	return l.withDO(l.DO.RightJoin(table, on...))
+}
+
+func (l licensePolicyDo) Group(cols ...field.Expr) *licensePolicyDo {
+	return l.withDO(l.DO.Group(cols...))
+}
+
+func (l licensePolicyDo) Having(conds ...gen.Condition) *licensePolicyDo {
+	return l.withDO(l.DO.Having(conds...))
+}
+
+func (l licensePolicyDo) Limit(limit int) *licensePolicyDo {
+	return l.withDO(l.DO.Limit(limit))
+}
+
+func (l licensePolicyDo) Offset(offset int) *licensePolicyDo {
+	return l.withDO(l.DO.Offset(offset))
+}
+
+func (l licensePolicyDo) Scopes(funcs ...func(gen.Dao) gen.Dao) *licensePolicyDo {
+	return l.withDO(l.DO.Scopes(funcs...))
+}
+
+func (l licensePolicyDo) Unscoped() *licensePolicyDo {
+	return l.withDO(l.DO.Unscoped())
+}
+
+func (l licensePolicyDo) Create(values ...*models.LicensePolicy) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return l.DO.Create(values)
+}
+
+func (l licensePolicyDo) CreateInBatches(values []*models.LicensePolicy, batchSize int) error {
+	return l.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (l licensePolicyDo) Save(values ...*models.LicensePolicy) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return l.DO.Save(values)
+}
+
+func (l licensePolicyDo) First() (*models.LicensePolicy, error) {
+	if result, err := l.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.LicensePolicy), nil
+	}
+}
+
+func (l licensePolicyDo) Take() (*models.LicensePolicy, error) {
+	if result, err := l.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.LicensePolicy), nil
+	}
+}
+
+func (l licensePolicyDo) Last() (*models.LicensePolicy, error) {
+	if result, err := l.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.LicensePolicy), nil
+	}
+}
+
+func (l licensePolicyDo) Find() ([]*models.LicensePolicy, error) {
+	result, err := l.DO.Find()
+	return result.([]*models.LicensePolicy), err
+}
+
+func (l licensePolicyDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.LicensePolicy, err error) {
+	buf := make([]*models.LicensePolicy, 0, batchSize)
+	err = l.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (l licensePolicyDo) FindInBatches(result *[]*models.LicensePolicy, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return l.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (l licensePolicyDo) Attrs(attrs ...field.AssignExpr) *licensePolicyDo {
+	return l.withDO(l.DO.Attrs(attrs...))
+}
+
+func (l licensePolicyDo) Assign(attrs ...field.AssignExpr) *licensePolicyDo {
+	return l.withDO(l.DO.Assign(attrs...))
+}
+
+func (l licensePolicyDo) Joins(fields ...field.RelationField) *licensePolicyDo {
+	for _, _f := range fields {
+		l = *l.withDO(l.DO.Joins(_f))
+	}
+	return &l
+}
+
+func (l licensePolicyDo) Preload(fields ...field.RelationField) *licensePolicyDo {
+	for _, _f := range fields {
+		l = *l.withDO(l.DO.Preload(_f))
+	}
+	return &l
+}
+
+func (l licensePolicyDo) FirstOrInit() (*models.LicensePolicy, error) {
+	if result, err := l.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.LicensePolicy), nil
+	}
+}
+
+func (l licensePolicyDo) FirstOrCreate() (*models.LicensePolicy, error) {
+	if result, err := l.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.LicensePolicy), nil
+	}
+}
+
+func (l licensePolicyDo) FindByPage(offset int, limit int) (result []*models.LicensePolicy, count int64, err error) {
+	result, err = l.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = l.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (l licensePolicyDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = l.Count()
+	if err != nil {
+		return
+	}
+
+	err = l.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (l licensePolicyDo) Scan(result interface{}) (err error) {
+	return l.DO.Scan(result)
+}
+
+func (l licensePolicyDo) Delete(models ...*models.LicensePolicy) (result gen.ResultInfo, err error) {
+	return l.DO.Delete(models)
+}
+
+func (l *licensePolicyDo) withDO(do gen.Dao) *licensePolicyDo {
+	l.DO = *do.(*gen.DO)
+	return l
+}
@@ -40,7 +40,16 @@ func newNamespace(db *gorm.DB, opts ...gen.DOOption) namespace {
 	_namespace.RepositoryLimit = field.NewInt64(tableName, "repository_limit")
 	_namespace.RepositoryCount = field.NewInt64(tableName, "repository_count")
 	_namespace.SizeLimit = field.NewInt64(tableName, "size_limit")
+	_namespace.SizeSoftLimit = field.NewInt64(tableName, "size_soft_limit")
 	_namespace.Size = field.NewInt64(tableName, "size")
+	_namespace.Scanner = field.NewField(tableName, "scanner")
+	_namespace.SecretScan = field.NewBool(tableName, "secret_scan")
+	_namespace.MisconfigurationScan = field.NewBool(tableName, "misconfiguration_scan")
+	_namespace.QuotaThresholds = field.NewString(tableName, "quota_thresholds")
+	_namespace.SizeQuotaThreshold = field.NewInt(tableName, "size_quota_threshold")
+	_namespace.TagQuotaThreshold = field.NewInt(tableName, "tag_quota_threshold")
+	_namespace.RepositoryQuotaThreshold = field.NewInt(tableName, "repository_quota_threshold")
+	_namespace.CreatorID = field.NewInt64(tableName, "creator_id")
 
 	_namespace.fillFieldMap()
 
@@ -50,21 +59,30 @@ func newNamespace(db *gorm.DB, opts ...gen.DOOption) namespace {
 type namespace struct {
 	namespaceDo namespaceDo
 
-	ALL             field.Asterisk
-	CreatedAt       field.Int64
-	UpdatedAt       field.Int64
-	DeletedAt       field.Uint64
-	ID              field.Int64
-	Name            field.String
-	Description     field.String
-	Overview        field.Bytes
-	Visibility      field.Field
-	TagLimit        field.Int64
-	TagCount        field.Int64
-	RepositoryLimit field.Int64
-	RepositoryCount field.Int64
-	SizeLimit       field.Int64
-	Size            field.Int64
+	ALL                      field.Asterisk
+	CreatedAt                field.Int64
+	UpdatedAt                field.Int64
+	DeletedAt                field.Uint64
+	ID                       field.Int64
+	Name                     field.String
+	Description              field.String
+	Overview                 field.Bytes
+	Visibility               field.Field
+	TagLimit                 field.Int64
+	TagCount                 field.Int64
+	RepositoryLimit          field.Int64
+	RepositoryCount          field.Int64
+	SizeLimit                field.Int64
+	SizeSoftLimit            field.Int64
+	Size                     field.Int64
+	Scanner                  field.Field
+	SecretScan               field.Bool
+	MisconfigurationScan     field.Bool
+	QuotaThresholds          field.String
+	SizeQuotaThreshold       field.Int
+	TagQuotaThreshold        field.Int
+	RepositoryQuotaThreshold field.Int
+	CreatorID                field.Int64
 
 	fieldMap map[string]field.Expr
 }
@@ -94,7 +112,16 @@ func (n *namespace) updateTableName(table string) *namespace {
 	n.RepositoryLimit = field.NewInt64(table, "repository_limit")
 	n.RepositoryCount = field.NewInt64(table, "repository_count")
 	n.SizeLimit = field.NewInt64(table, "size_limit")
+	n.SizeSoftLimit = field.NewInt64(table, "size_soft_limit")
 	n.Size = field.NewInt64(table, "size")
+	n.Scanner = field.NewField(table, "scanner")
+	n.SecretScan = field.NewBool(table, "secret_scan")
+	n.MisconfigurationScan = field.NewBool(table, "misconfiguration_scan")
+	n.QuotaThresholds = field.NewString(table, "quota_thresholds")
+	n.SizeQuotaThreshold = field.NewInt(table, "size_quota_threshold")
+	n.TagQuotaThreshold = field.NewInt(table, "tag_quota_threshold")
+	n.RepositoryQuotaThreshold = field.NewInt(table, "repository_quota_threshold")
+	n.CreatorID = field.NewInt64(table, "creator_id")
 
 	n.fillFieldMap()
 
@@ -121,7 +148,7 @@ func (n *namespace) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (n *namespace) fillFieldMap() {
-	n.fieldMap = make(map[string]field.Expr, 14)
+	n.fieldMap = make(map[string]field.Expr, 23)
 	n.fieldMap["created_at"] = n.CreatedAt
 	n.fieldMap["updated_at"] = n.UpdatedAt
 	n.fieldMap["deleted_at"] = n.DeletedAt
@@ -135,7 +162,16 @@ func (n *namespace) fillFieldMap() {
 	n.fieldMap["repository_limit"] = n.RepositoryLimit
 	n.fieldMap["repository_count"] = n.RepositoryCount
 	n.fieldMap["size_limit"] = n.SizeLimit
+	n.fieldMap["size_soft_limit"] = n.SizeSoftLimit
 	n.fieldMap["size"] = n.Size
+	n.fieldMap["scanner"] = n.Scanner
+	n.fieldMap["secret_scan"] = n.SecretScan
+	n.fieldMap["misconfiguration_scan"] = n.MisconfigurationScan
+	n.fieldMap["quota_thresholds"] = n.QuotaThresholds
+	n.fieldMap["size_quota_threshold"] = n.SizeQuotaThreshold
+	n.fieldMap["tag_quota_threshold"] = n.TagQuotaThreshold
+	n.fieldMap["repository_quota_threshold"] = n.RepositoryQuotaThreshold
+	n.fieldMap["creator_id"] = n.CreatorID
 }
 
 func (n namespace) clone(db *gorm.DB) namespace {
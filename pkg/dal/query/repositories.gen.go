@@ -38,6 +38,7 @@ func newRepository(db *gorm.DB, opts ...gen.DOOption) repository {
 	_repository.TagLimit = field.NewInt64(tableName, "tag_limit")
 	_repository.TagCount = field.NewInt64(tableName, "tag_count")
 	_repository.SizeLimit = field.NewInt64(tableName, "size_limit")
+	_repository.SizeSoftLimit = field.NewInt64(tableName, "size_soft_limit")
 	_repository.Size = field.NewInt64(tableName, "size")
 	_repository.Builder = repositoryHasOneBuilder{
 		db: db.Session(&gorm.Session{}),
@@ -112,20 +113,21 @@ func newRepository(db *gorm.DB, opts ...gen.DOOption) repository {
 type repository struct {
 	repositoryDo repositoryDo
 
-	ALL         field.Asterisk
-	CreatedAt   field.Int64
-	UpdatedAt   field.Int64
-	DeletedAt   field.Uint64
-	ID          field.Int64
-	NamespaceID field.Int64
-	Name        field.String
-	Description field.String
-	Overview    field.Bytes
-	TagLimit    field.Int64
-	TagCount    field.Int64
-	SizeLimit   field.Int64
-	Size        field.Int64
-	Builder     repositoryHasOneBuilder
+	ALL           field.Asterisk
+	CreatedAt     field.Int64
+	UpdatedAt     field.Int64
+	DeletedAt     field.Uint64
+	ID            field.Int64
+	NamespaceID   field.Int64
+	Name          field.String
+	Description   field.String
+	Overview      field.Bytes
+	TagLimit      field.Int64
+	TagCount      field.Int64
+	SizeLimit     field.Int64
+	SizeSoftLimit field.Int64
+	Size          field.Int64
+	Builder       repositoryHasOneBuilder
 
 	Namespace repositoryBelongsToNamespace
 
@@ -155,6 +157,7 @@ func (r *repository) updateTableName(table string) *repository {
 	r.TagLimit = field.NewInt64(table, "tag_limit")
 	r.TagCount = field.NewInt64(table, "tag_count")
 	r.SizeLimit = field.NewInt64(table, "size_limit")
+	r.SizeSoftLimit = field.NewInt64(table, "size_soft_limit")
 	r.Size = field.NewInt64(table, "size")
 
 	r.fillFieldMap()
@@ -182,7 +185,7 @@ func (r *repository) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (r *repository) fillFieldMap() {
-	r.fieldMap = make(map[string]field.Expr, 14)
+	r.fieldMap = make(map[string]field.Expr, 15)
 	r.fieldMap["created_at"] = r.CreatedAt
 	r.fieldMap["updated_at"] = r.UpdatedAt
 	r.fieldMap["deleted_at"] = r.DeletedAt
@@ -194,6 +197,7 @@ func (r *repository) fillFieldMap() {
 	r.fieldMap["tag_limit"] = r.TagLimit
 	r.fieldMap["tag_count"] = r.TagCount
 	r.fieldMap["size_limit"] = r.SizeLimit
+	r.fieldMap["size_soft_limit"] = r.SizeSoftLimit
 	r.fieldMap["size"] = r.Size
 
 }
@@ -0,0 +1,453 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+)
+
+func newSignaturePolicy(db *gorm.DB, opts ...gen.DOOption) signaturePolicy {
+	_signaturePolicy := signaturePolicy{}
+
+	_signaturePolicy.signaturePolicyDo.UseDB(db, opts...)
+	_signaturePolicy.signaturePolicyDo.UseModel(&models.SignaturePolicy{})
+
+	tableName := _signaturePolicy.signaturePolicyDo.TableName()
+	_signaturePolicy.ALL = field.NewAsterisk(tableName)
+	_signaturePolicy.CreatedAt = field.NewInt64(tableName, "created_at")
+	_signaturePolicy.UpdatedAt = field.NewInt64(tableName, "updated_at")
+	_signaturePolicy.DeletedAt = field.NewUint64(tableName, "deleted_at")
+	_signaturePolicy.ID = field.NewInt64(tableName, "id")
+	_signaturePolicy.NamespaceID = field.NewInt64(tableName, "namespace_id")
+	_signaturePolicy.Enabled = field.NewBool(tableName, "enabled")
+	_signaturePolicy.PublicKeys = field.NewString(tableName, "public_keys")
+	_signaturePolicy.KeylessIdentities = field.NewBytes(tableName, "keyless_identities")
+	_signaturePolicy.KeylessRoots = field.NewString(tableName, "keyless_roots")
+	_signaturePolicy.NotationRoots = field.NewString(tableName, "notation_roots")
+	_signaturePolicy.NotationIdentities = field.NewBytes(tableName, "notation_identities")
+	_signaturePolicy.Exemptions = field.NewString(tableName, "exemptions")
+	_signaturePolicy.Namespace = signaturePolicyBelongsToNamespace{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Namespace", "models.Namespace"),
+	}
+
+	_signaturePolicy.fillFieldMap()
+
+	return _signaturePolicy
+}
+
+type signaturePolicy struct {
+	signaturePolicyDo signaturePolicyDo
+
+	ALL                field.Asterisk
+	CreatedAt          field.Int64
+	UpdatedAt          field.Int64
+	DeletedAt          field.Uint64
+	ID                 field.Int64
+	NamespaceID        field.Int64
+	Enabled            field.Bool
+	PublicKeys         field.String
+	KeylessIdentities  field.Bytes
+	KeylessRoots       field.String
+	NotationRoots      field.String
+	NotationIdentities field.Bytes
+	Exemptions         field.String
+	Namespace          signaturePolicyBelongsToNamespace
+
+	fieldMap map[string]field.Expr
+}
+
+func (s signaturePolicy) Table(newTableName string) *signaturePolicy {
+	s.signaturePolicyDo.UseTable(newTableName)
+	return s.updateTableName(newTableName)
+}
+
+func (s signaturePolicy) As(alias string) *signaturePolicy {
+	s.signaturePolicyDo.DO = *(s.signaturePolicyDo.As(alias).(*gen.DO))
+	return s.updateTableName(alias)
+}
+
+func (s *signaturePolicy) updateTableName(table string) *signaturePolicy {
+	s.ALL = field.NewAsterisk(table)
+	s.CreatedAt = field.NewInt64(table, "created_at")
+	s.UpdatedAt = field.NewInt64(table, "updated_at")
+	s.DeletedAt = field.NewUint64(table, "deleted_at")
+	s.ID = field.NewInt64(table, "id")
+	s.NamespaceID = field.NewInt64(table, "namespace_id")
+	s.Enabled = field.NewBool(table, "enabled")
+	s.PublicKeys = field.NewString(table, "public_keys")
+	s.KeylessIdentities = field.NewBytes(table, "keyless_identities")
+	s.KeylessRoots = field.NewString(table, "keyless_roots")
+	s.NotationRoots = field.NewString(table, "notation_roots")
+	s.NotationIdentities = field.NewBytes(table, "notation_identities")
+	s.Exemptions = field.NewString(table, "exemptions")
+
+	s.fillFieldMap()
+
+	return s
+}
+
+func (s *signaturePolicy) WithContext(ctx context.Context) *signaturePolicyDo {
+	return s.signaturePolicyDo.WithContext(ctx)
+}
+
+func (s signaturePolicy) TableName() string { return s.signaturePolicyDo.TableName() }
+
+func (s signaturePolicy) Alias() string { return s.signaturePolicyDo.Alias() }
+
+func (s signaturePolicy) Columns(cols ...field.Expr) gen.Columns {
+	return s.signaturePolicyDo.Columns(cols...)
+}
+
+func (s *signaturePolicy) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := s.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (s *signaturePolicy) fillFieldMap() {
+	s.fieldMap = make(map[string]field.Expr, 13)
+	s.fieldMap["created_at"] = s.CreatedAt
+	s.fieldMap["updated_at"] = s.UpdatedAt
+	s.fieldMap["deleted_at"] = s.DeletedAt
+	s.fieldMap["id"] = s.ID
+	s.fieldMap["namespace_id"] = s.NamespaceID
+	s.fieldMap["enabled"] = s.Enabled
+	s.fieldMap["public_keys"] = s.PublicKeys
+	s.fieldMap["keyless_identities"] = s.KeylessIdentities
+	s.fieldMap["keyless_roots"] = s.KeylessRoots
+	s.fieldMap["notation_roots"] = s.NotationRoots
+	s.fieldMap["notation_identities"] = s.NotationIdentities
+	s.fieldMap["exemptions"] = s.Exemptions
+
+}
+
+func (s signaturePolicy) clone(db *gorm.DB) signaturePolicy {
+	s.signaturePolicyDo.ReplaceConnPool(db.Statement.ConnPool)
+	return s
+}
+
+func (s signaturePolicy) replaceDB(db *gorm.DB) signaturePolicy {
+	s.signaturePolicyDo.ReplaceDB(db)
+	return s
+}
+
+type signaturePolicyBelongsToNamespace struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a signaturePolicyBelongsToNamespace) Where(conds ...field.Expr) *signaturePolicyBelongsToNamespace {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a signaturePolicyBelongsToNamespace) WithContext(ctx context.Context) *signaturePolicyBelongsToNamespace {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a signaturePolicyBelongsToNamespace) Session(session *gorm.Session) *signaturePolicyBelongsToNamespace {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a signaturePolicyBelongsToNamespace) Model(m *models.SignaturePolicy) *signaturePolicyBelongsToNamespaceTx {
+	return &signaturePolicyBelongsToNamespaceTx{a.db.Model(m).Association(a.Name())}
+}
+
+type signaturePolicyBelongsToNamespaceTx struct{ tx *gorm.Association }
+
+func (a signaturePolicyBelongsToNamespaceTx) Find() (result *models.Namespace, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a signaturePolicyBelongsToNamespaceTx) Append(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a signaturePolicyBelongsToNamespaceTx) Replace(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a signaturePolicyBelongsToNamespaceTx) Delete(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a signaturePolicyBelongsToNamespaceTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a signaturePolicyBelongsToNamespaceTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type signaturePolicyDo struct{ gen.DO }
+
+func (s signaturePolicyDo) Debug() *signaturePolicyDo {
+	return s.withDO(s.DO.Debug())
+}
+
+func (s signaturePolicyDo) WithContext(ctx context.Context) *signaturePolicyDo {
+	return s.withDO(s.DO.WithContext(ctx))
+}
+
+func (s signaturePolicyDo) ReadDB() *signaturePolicyDo {
+	return s.Clauses(dbresolver.Read)
+}
+
+func (s signaturePolicyDo) WriteDB() *signaturePolicyDo {
+	return s.Clauses(dbresolver.Write)
+}
+
+func (s signaturePolicyDo) Session(config *gorm.Session) *signaturePolicyDo {
+	return s.withDO(s.DO.Session(config))
+}
+
+func (s signaturePolicyDo) Clauses(conds ...clause.Expression) *signaturePolicyDo {
+	return s.withDO(s.DO.Clauses(conds...))
+}
+
+func (s signaturePolicyDo) Returning(value interface{}, columns ...string) *signaturePolicyDo {
+	return s.withDO(s.DO.Returning(value, columns...))
+}
+
+func (s signaturePolicyDo) Not(conds ...gen.Condition) *signaturePolicyDo {
+	return s.withDO(s.DO.Not(conds...))
+}
+
+func (s signaturePolicyDo) Or(conds ...gen.Condition) *signaturePolicyDo {
+	return s.withDO(s.DO.Or(conds...))
+}
+
+func (s signaturePolicyDo) Select(conds ...field.Expr) *signaturePolicyDo {
+	return s.withDO(s.DO.Select(conds...))
+}
+
+func (s signaturePolicyDo) Where(conds ...gen.Condition) *signaturePolicyDo {
+	return s.withDO(s.DO.Where(conds...))
+}
+
+func (s signaturePolicyDo) Order(conds ...field.Expr) *signaturePolicyDo {
+	return s.withDO(s.DO.Order(conds...))
+}
+
+func (s signaturePolicyDo) Distinct(cols ...field.Expr) *signaturePolicyDo {
+	return s.withDO(s.DO.Distinct(cols...))
+}
+
+func (s signaturePolicyDo) Omit(cols ...field.Expr) *signaturePolicyDo {
+	return s.withDO(s.DO.Omit(cols...))
+}
+
+func (s signaturePolicyDo) Join(table schema.Tabler, on ...field.Expr) *signaturePolicyDo {
+	return s.withDO(s.DO.Join(table, on...))
+}
+
+func (s signaturePolicyDo) LeftJoin(table schema.Tabler, on ...field.Expr) *signaturePolicyDo {
+	return s.withDO(s.DO.LeftJoin(table, on...))
+}
+
+func (s signaturePolicyDo) RightJoin(table schema.Tabler, on ...field.Expr) *signaturePolicyDo {
+	return s.withDO(s.DO.RightJoin(table, on...))
+}
+
+func (s signaturePolicyDo) Group(cols ...field.Expr) *signaturePolicyDo {
+	return s.withDO(s.DO.Group(cols...))
+}
+
+func (s signaturePolicyDo) Having(conds ...gen.Condition) *signaturePolicyDo {
+	return s.withDO(s.DO.Having(conds...))
+}
+
+func (s signaturePolicyDo) Limit(limit int) *signaturePolicyDo {
+	return s.withDO(s.DO.Limit(limit))
+}
+
+func (s signaturePolicyDo) Offset(offset int) *signaturePolicyDo {
+	return s.withDO(s.DO.Offset(offset))
+}
+
+func (s signaturePolicyDo) Scopes(funcs ...func(gen.Dao) gen.Dao) *signaturePolicyDo {
+	return s.withDO(s.DO.Scopes(funcs...))
+}
+
+func (s signaturePolicyDo) Unscoped() *signaturePolicyDo {
+	return s.withDO(s.DO.Unscoped())
+}
+
+func (s signaturePolicyDo) Create(values ...*models.SignaturePolicy) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Create(values)
+}
+
+func (s signaturePolicyDo) CreateInBatches(values []*models.SignaturePolicy, batchSize int) error {
+	return s.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (s signaturePolicyDo) Save(values ...*models.SignaturePolicy) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Save(values)
+}
+
+func (s signaturePolicyDo) First() (*models.SignaturePolicy, error) {
+	if result, err := s.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SignaturePolicy), nil
+	}
+}
+
+func (s signaturePolicyDo) Take() (*models.SignaturePolicy, error) {
+	if result, err := s.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SignaturePolicy), nil
+	}
+}
+
+func (s signaturePolicyDo) Last() (*models.SignaturePolicy, error) {
+	if result, err := s.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SignaturePolicy), nil
+	}
+}
+
+func (s signaturePolicyDo) Find() ([]*models.SignaturePolicy, error) {
+	result, err := s.DO.Find()
+	return result.([]*models.SignaturePolicy), err
+}
+
+func (s signaturePolicyDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.SignaturePolicy, err error) {
+	buf := make([]*models.SignaturePolicy, 0, batchSize)
+	err = s.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (s signaturePolicyDo) FindInBatches(result *[]*models.SignaturePolicy, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return s.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (s signaturePolicyDo) Attrs(attrs ...field.AssignExpr) *signaturePolicyDo {
+	return s.withDO(s.DO.Attrs(attrs...))
+}
+
+func (s signaturePolicyDo) Assign(attrs ...field.AssignExpr) *signaturePolicyDo {
+	return s.withDO(s.DO.Assign(attrs...))
+}
+
+func (s signaturePolicyDo) Joins(fields ...field.RelationField) *signaturePolicyDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Joins(_f))
+	}
+	return &s
+}
+
+func (s signaturePolicyDo) Preload(fields ...field.RelationField) *signaturePolicyDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Preload(_f))
+	}
+	return &s
+}
+
+func (s signaturePolicyDo) FirstOrInit() (*models.SignaturePolicy, error) {
+	if result, err := s.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SignaturePolicy), nil
+	}
+}
+
+func (s signaturePolicyDo) FirstOrCreate() (*models.SignaturePolicy, error) {
+	if result, err := s.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SignaturePolicy), nil
+	}
+}
+
+func (s signaturePolicyDo) FindByPage(offset int, limit int) (result []*models.SignaturePolicy, count int64, err error) {
+	result, err = s.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = s.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (s signaturePolicyDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = s.Count()
+	if err != nil {
+		return
+	}
+
+	err = s.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (s signaturePolicyDo) Scan(result interface{}) (err error) {
+	return s.DO.Scan(result)
+}
+
+func (s signaturePolicyDo) Delete(models ...*models.SignaturePolicy) (result gen.ResultInfo, err error) {
+	return s.DO.Delete(models)
+}
+
+func (s *signaturePolicyDo) withDO(do gen.Dao) *signaturePolicyDo {
+	s.DO = *do.(*gen.DO)
+	return s
+}
@@ -0,0 +1,447 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+)
+
+func newSigningKey(db *gorm.DB, opts ...gen.DOOption) signingKey {
+	_signingKey := signingKey{}
+
+	_signingKey.signingKeyDo.UseDB(db, opts...)
+	_signingKey.signingKeyDo.UseModel(&models.SigningKey{})
+
+	tableName := _signingKey.signingKeyDo.TableName()
+	_signingKey.ALL = field.NewAsterisk(tableName)
+	_signingKey.CreatedAt = field.NewInt64(tableName, "created_at")
+	_signingKey.UpdatedAt = field.NewInt64(tableName, "updated_at")
+	_signingKey.DeletedAt = field.NewUint64(tableName, "deleted_at")
+	_signingKey.ID = field.NewInt64(tableName, "id")
+	_signingKey.NamespaceID = field.NewInt64(tableName, "namespace_id")
+	_signingKey.Fingerprint = field.NewString(tableName, "fingerprint")
+	_signingKey.PublicKey = field.NewString(tableName, "public_key")
+	_signingKey.PrivateKey = field.NewString(tableName, "private_key")
+	_signingKey.Imported = field.NewBool(tableName, "imported")
+	_signingKey.Active = field.NewBool(tableName, "active")
+	_signingKey.RetiredAt = field.NewInt64(tableName, "retired_at")
+	_signingKey.Namespace = signingKeyBelongsToNamespace{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Namespace", "models.Namespace"),
+	}
+
+	_signingKey.fillFieldMap()
+
+	return _signingKey
+}
+
+type signingKey struct {
+	signingKeyDo signingKeyDo
+
+	ALL         field.Asterisk
+	CreatedAt   field.Int64
+	UpdatedAt   field.Int64
+	DeletedAt   field.Uint64
+	ID          field.Int64
+	NamespaceID field.Int64
+	Fingerprint field.String
+	PublicKey   field.String
+	PrivateKey  field.String
+	Imported    field.Bool
+	Active      field.Bool
+	RetiredAt   field.Int64
+	Namespace   signingKeyBelongsToNamespace
+
+	fieldMap map[string]field.Expr
+}
+
+func (s signingKey) Table(newTableName string) *signingKey {
+	s.signingKeyDo.UseTable(newTableName)
+	return s.updateTableName(newTableName)
+}
+
+func (s signingKey) As(alias string) *signingKey {
+	s.signingKeyDo.DO = *(s.signingKeyDo.As(alias).(*gen.DO))
+	return s.updateTableName(alias)
+}
+
+func (s *signingKey) updateTableName(table string) *signingKey {
+	s.ALL = field.NewAsterisk(table)
+	s.CreatedAt = field.NewInt64(table, "created_at")
+	s.UpdatedAt = field.NewInt64(table, "updated_at")
+	s.DeletedAt = field.NewUint64(table, "deleted_at")
+	s.ID = field.NewInt64(table, "id")
+	s.NamespaceID = field.NewInt64(table, "namespace_id")
+	s.Fingerprint = field.NewString(table, "fingerprint")
+	s.PublicKey = field.NewString(table, "public_key")
+	s.PrivateKey = field.NewString(table, "private_key")
+	s.Imported = field.NewBool(table, "imported")
+	s.Active = field.NewBool(table, "active")
+	s.RetiredAt = field.NewInt64(table, "retired_at")
+
+	s.fillFieldMap()
+
+	return s
+}
+
+func (s *signingKey) WithContext(ctx context.Context) *signingKeyDo {
+	return s.signingKeyDo.WithContext(ctx)
+}
+
+func (s signingKey) TableName() string { return s.signingKeyDo.TableName() }
+
+func (s signingKey) Alias() string { return s.signingKeyDo.Alias() }
+
+func (s signingKey) Columns(cols ...field.Expr) gen.Columns { return s.signingKeyDo.Columns(cols...) }
+
+func (s *signingKey) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := s.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (s *signingKey) fillFieldMap() {
+	s.fieldMap = make(map[string]field.Expr, 12)
+	s.fieldMap["created_at"] = s.CreatedAt
+	s.fieldMap["updated_at"] = s.UpdatedAt
+	s.fieldMap["deleted_at"] = s.DeletedAt
+	s.fieldMap["id"] = s.ID
+	s.fieldMap["namespace_id"] = s.NamespaceID
+	s.fieldMap["fingerprint"] = s.Fingerprint
+	s.fieldMap["public_key"] = s.PublicKey
+	s.fieldMap["private_key"] = s.PrivateKey
+	s.fieldMap["imported"] = s.Imported
+	s.fieldMap["active"] = s.Active
+	s.fieldMap["retired_at"] = s.RetiredAt
+
+}
+
+func (s signingKey) clone(db *gorm.DB) signingKey {
+	s.signingKeyDo.ReplaceConnPool(db.Statement.ConnPool)
+	return s
+}
+
+func (s signingKey) replaceDB(db *gorm.DB) signingKey {
+	s.signingKeyDo.ReplaceDB(db)
+	return s
+}
+
+type signingKeyBelongsToNamespace struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a signingKeyBelongsToNamespace) Where(conds ...field.Expr) *signingKeyBelongsToNamespace {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a signingKeyBelongsToNamespace) WithContext(ctx context.Context) *signingKeyBelongsToNamespace {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a signingKeyBelongsToNamespace) Session(session *gorm.Session) *signingKeyBelongsToNamespace {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a signingKeyBelongsToNamespace) Model(m *models.SigningKey) *signingKeyBelongsToNamespaceTx {
+	return &signingKeyBelongsToNamespaceTx{a.db.Model(m).Association(a.Name())}
+}
+
+type signingKeyBelongsToNamespaceTx struct{ tx *gorm.Association }
+
+func (a signingKeyBelongsToNamespaceTx) Find() (result *models.Namespace, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a signingKeyBelongsToNamespaceTx) Append(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a signingKeyBelongsToNamespaceTx) Replace(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a signingKeyBelongsToNamespaceTx) Delete(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a signingKeyBelongsToNamespaceTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a signingKeyBelongsToNamespaceTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type signingKeyDo struct{ gen.DO }
+
+func (s signingKeyDo) Debug() *signingKeyDo {
+	return s.withDO(s.DO.Debug())
+}
+
+func (s signingKeyDo) WithContext(ctx context.Context) *signingKeyDo {
+	return s.withDO(s.DO.WithContext(ctx))
+}
+
+func (s signingKeyDo) ReadDB() *signingKeyDo {
+	return s.Clauses(dbresolver.Read)
+}
+
+func (s signingKeyDo) WriteDB() *signingKeyDo {
+	return s.Clauses(dbresolver.Write)
+}
+
+func (s signingKeyDo) Session(config *gorm.Session) *signingKeyDo {
+	return s.withDO(s.DO.Session(config))
+}
+
+func (s signingKeyDo) Clauses(conds ...clause.Expression) *signingKeyDo {
+	return s.withDO(s.DO.Clauses(conds...))
+}
+
+func (s signingKeyDo) Returning(value interface{}, columns ...string) *signingKeyDo {
+	return s.withDO(s.DO.Returning(value, columns...))
+}
+
+func (s signingKeyDo) Not(conds ...gen.Condition) *signingKeyDo {
+	return s.withDO(s.DO.Not(conds...))
+}
+
+func (s signingKeyDo) Or(conds ...gen.Condition) *signingKeyDo {
+	return s.withDO(s.DO.Or(conds...))
+}
+
+func (s signingKeyDo) Select(conds ...field.Expr) *signingKeyDo {
+	return s.withDO(s.DO.Select(conds...))
+}
+
+func (s signingKeyDo) Where(conds ...gen.Condition) *signingKeyDo {
+	return s.withDO(s.DO.Where(conds...))
+}
+
+func (s signingKeyDo) Order(conds ...field.Expr) *signingKeyDo {
+	return s.withDO(s.DO.Order(conds...))
+}
+
+func (s signingKeyDo) Distinct(cols ...field.Expr) *signingKeyDo {
+	return s.withDO(s.DO.Distinct(cols...))
+}
+
+func (s signingKeyDo) Omit(cols ...field.Expr) *signingKeyDo {
+	return s.withDO(s.DO.Omit(cols...))
+}
+
+func (s signingKeyDo) Join(table schema.Tabler, on ...field.Expr) *signingKeyDo {
+	return s.withDO(s.DO.Join(table, on...))
+}
+
+func (s signingKeyDo) LeftJoin(table schema.Tabler, on ...field.Expr) *signingKeyDo {
+	return s.withDO(s.DO.LeftJoin(table, on...))
+}
+
+func (s signingKeyDo) RightJoin(table schema.Tabler, on ...field.Expr) *signingKeyDo {
+	return s.withDO(s.DO.RightJoin(table, on...))
+}
+
+func (s signingKeyDo) Group(cols ...field.Expr) *signingKeyDo {
+	return s.withDO(s.DO.Group(cols...))
+}
+
+func (s signingKeyDo) Having(conds ...gen.Condition) *signingKeyDo {
+	return s.withDO(s.DO.Having(conds...))
+}
+
+func (s signingKeyDo) Limit(limit int) *signingKeyDo {
+	return s.withDO(s.DO.Limit(limit))
+}
+
+func (s signingKeyDo) Offset(offset int) *signingKeyDo {
+	return s.withDO(s.DO.Offset(offset))
+}
+
+func (s signingKeyDo) Scopes(funcs ...func(gen.Dao) gen.Dao) *signingKeyDo {
+	return s.withDO(s.DO.Scopes(funcs...))
+}
+
+func (s signingKeyDo) Unscoped() *signingKeyDo {
+	return s.withDO(s.DO.Unscoped())
+}
+
+func (s signingKeyDo) Create(values ...*models.SigningKey) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Create(values)
+}
+
+func (s signingKeyDo) CreateInBatches(values []*models.SigningKey, batchSize int) error {
+	return s.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (s signingKeyDo) Save(values ...*models.SigningKey) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Save(values)
+}
+
+func (s signingKeyDo) First() (*models.SigningKey, error) {
+	if result, err := s.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SigningKey), nil
+	}
+}
+
+func (s signingKeyDo) Take() (*models.SigningKey, error) {
+	if result, err := s.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SigningKey), nil
+	}
+}
+
+func (s signingKeyDo) Last() (*models.SigningKey, error) {
+	if result, err := s.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SigningKey), nil
+	}
+}
+
+func (s signingKeyDo) Find() ([]*models.SigningKey, error) {
+	result, err := s.DO.Find()
+	return result.([]*models.SigningKey), err
+}
+
+func (s signingKeyDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.SigningKey, err error) {
+	buf := make([]*models.SigningKey, 0, batchSize)
+	err = s.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (s signingKeyDo) FindInBatches(result *[]*models.SigningKey, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return s.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (s signingKeyDo) Attrs(attrs ...field.AssignExpr) *signingKeyDo {
+	return s.withDO(s.DO.Attrs(attrs...))
+}
+
+func (s signingKeyDo) Assign(attrs ...field.AssignExpr) *signingKeyDo {
+	return s.withDO(s.DO.Assign(attrs...))
+}
+
+func (s signingKeyDo) Joins(fields ...field.RelationField) *signingKeyDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Joins(_f))
+	}
+	return &s
+}
+
+func (s signingKeyDo) Preload(fields ...field.RelationField) *signingKeyDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Preload(_f))
+	}
+	return &s
+}
+
+func (s signingKeyDo) FirstOrInit() (*models.SigningKey, error) {
+	if result, err := s.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SigningKey), nil
+	}
+}
+
+func (s signingKeyDo) FirstOrCreate() (*models.SigningKey, error) {
+	if result, err := s.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SigningKey), nil
+	}
+}
+
+func (s signingKeyDo) FindByPage(offset int, limit int) (result []*models.SigningKey, count int64, err error) {
+	result, err = s.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = s.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (s signingKeyDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = s.Count()
+	if err != nil {
+		return
+	}
+
+	err = s.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (s signingKeyDo) Scan(result interface{}) (err error) {
+	return s.DO.Scan(result)
+}
+
+func (s signingKeyDo) Delete(models ...*models.SigningKey) (result gen.ResultInfo, err error) {
+	return s.DO.Delete(models)
+}
+
+func (s *signingKeyDo) withDO(do gen.Dao) *signingKeyDo {
+	s.DO = *do.(*gen.DO)
+	return s
+}
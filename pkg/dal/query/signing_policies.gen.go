@@ -0,0 +1,433 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+)
+
+func newSigningPolicy(db *gorm.DB, opts ...gen.DOOption) signingPolicy {
+	_signingPolicy := signingPolicy{}
+
+	_signingPolicy.signingPolicyDo.UseDB(db, opts...)
+	_signingPolicy.signingPolicyDo.UseModel(&models.SigningPolicy{})
+
+	tableName := _signingPolicy.signingPolicyDo.TableName()
+	_signingPolicy.ALL = field.NewAsterisk(tableName)
+	_signingPolicy.CreatedAt = field.NewInt64(tableName, "created_at")
+	_signingPolicy.UpdatedAt = field.NewInt64(tableName, "updated_at")
+	_signingPolicy.DeletedAt = field.NewUint64(tableName, "deleted_at")
+	_signingPolicy.ID = field.NewInt64(tableName, "id")
+	_signingPolicy.NamespaceID = field.NewInt64(tableName, "namespace_id")
+	_signingPolicy.Enabled = field.NewBool(tableName, "enabled")
+	_signingPolicy.TagPattern = field.NewString(tableName, "tag_pattern")
+	_signingPolicy.Namespace = signingPolicyBelongsToNamespace{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Namespace", "models.Namespace"),
+	}
+
+	_signingPolicy.fillFieldMap()
+
+	return _signingPolicy
+}
+
+type signingPolicy struct {
+	signingPolicyDo signingPolicyDo
+
+	ALL         field.Asterisk
+	CreatedAt   field.Int64
+	UpdatedAt   field.Int64
+	DeletedAt   field.Uint64
+	ID          field.Int64
+	NamespaceID field.Int64
+	Enabled     field.Bool
+	TagPattern  field.String
+	Namespace   signingPolicyBelongsToNamespace
+
+	fieldMap map[string]field.Expr
+}
+
+func (s signingPolicy) Table(newTableName string) *signingPolicy {
+	s.signingPolicyDo.UseTable(newTableName)
+	return s.updateTableName(newTableName)
+}
+
+func (s signingPolicy) As(alias string) *signingPolicy {
+	s.signingPolicyDo.DO = *(s.signingPolicyDo.As(alias).(*gen.DO))
+	return s.updateTableName(alias)
+}
+
+func (s *signingPolicy) updateTableName(table string) *signingPolicy {
+	s.ALL = field.NewAsterisk(table)
+	s.CreatedAt = field.NewInt64(table, "created_at")
+	s.UpdatedAt = field.NewInt64(table, "updated_at")
+	s.DeletedAt = field.NewUint64(table, "deleted_at")
+	s.ID = field.NewInt64(table, "id")
+	s.NamespaceID = field.NewInt64(table, "namespace_id")
+	s.Enabled = field.NewBool(table, "enabled")
+	s.TagPattern = field.NewString(table, "tag_pattern")
+
+	s.fillFieldMap()
+
+	return s
+}
+
+func (s *signingPolicy) WithContext(ctx context.Context) *signingPolicyDo {
+	return s.signingPolicyDo.WithContext(ctx)
+}
+
+func (s signingPolicy) TableName() string { return s.signingPolicyDo.TableName() }
+
+func (s signingPolicy) Alias() string { return s.signingPolicyDo.Alias() }
+
+func (s signingPolicy) Columns(cols ...field.Expr) gen.Columns {
+	return s.signingPolicyDo.Columns(cols...)
+}
+
+func (s *signingPolicy) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := s.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (s *signingPolicy) fillFieldMap() {
+	s.fieldMap = make(map[string]field.Expr, 8)
+	s.fieldMap["created_at"] = s.CreatedAt
+	s.fieldMap["updated_at"] = s.UpdatedAt
+	s.fieldMap["deleted_at"] = s.DeletedAt
+	s.fieldMap["id"] = s.ID
+	s.fieldMap["namespace_id"] = s.NamespaceID
+	s.fieldMap["enabled"] = s.Enabled
+	s.fieldMap["tag_pattern"] = s.TagPattern
+
+}
+
+func (s signingPolicy) clone(db *gorm.DB) signingPolicy {
+	s.signingPolicyDo.ReplaceConnPool(db.Statement.ConnPool)
+	return s
+}
+
+func (s signingPolicy) replaceDB(db *gorm.DB) signingPolicy {
+	s.signingPolicyDo.ReplaceDB(db)
+	return s
+}
+
+type signingPolicyBelongsToNamespace struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a signingPolicyBelongsToNamespace) Where(conds ...field.Expr) *signingPolicyBelongsToNamespace {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a signingPolicyBelongsToNamespace) WithContext(ctx context.Context) *signingPolicyBelongsToNamespace {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a signingPolicyBelongsToNamespace) Session(session *gorm.Session) *signingPolicyBelongsToNamespace {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a signingPolicyBelongsToNamespace) Model(m *models.SigningPolicy) *signingPolicyBelongsToNamespaceTx {
+	return &signingPolicyBelongsToNamespaceTx{a.db.Model(m).Association(a.Name())}
+}
+
+type signingPolicyBelongsToNamespaceTx struct{ tx *gorm.Association }
+
+func (a signingPolicyBelongsToNamespaceTx) Find() (result *models.Namespace, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a signingPolicyBelongsToNamespaceTx) Append(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a signingPolicyBelongsToNamespaceTx) Replace(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a signingPolicyBelongsToNamespaceTx) Delete(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a signingPolicyBelongsToNamespaceTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a signingPolicyBelongsToNamespaceTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type signingPolicyDo struct{ gen.DO }
+
+func (s signingPolicyDo) Debug() *signingPolicyDo {
+	return s.withDO(s.DO.Debug())
+}
+
+func (s signingPolicyDo) WithContext(ctx context.Context) *signingPolicyDo {
+	return s.withDO(s.DO.WithContext(ctx))
+}
+
+func (s signingPolicyDo) ReadDB() *signingPolicyDo {
+	return s.Clauses(dbresolver.Read)
+}
+
+func (s signingPolicyDo) WriteDB() *signingPolicyDo {
+	return s.Clauses(dbresolver.Write)
+}
+
+func (s signingPolicyDo) Session(config *gorm.Session) *signingPolicyDo {
+	return s.withDO(s.DO.Session(config))
+}
+
+func (s signingPolicyDo) Clauses(conds ...clause.Expression) *signingPolicyDo {
+	return s.withDO(s.DO.Clauses(conds...))
+}
+
+func (s signingPolicyDo) Returning(value interface{}, columns ...string) *signingPolicyDo {
+	return s.withDO(s.DO.Returning(value, columns...))
+}
+
+func (s signingPolicyDo) Not(conds ...gen.Condition) *signingPolicyDo {
+	return s.withDO(s.DO.Not(conds...))
+}
+
+func (s signingPolicyDo) Or(conds ...gen.Condition) *signingPolicyDo {
+	return s.withDO(s.DO.Or(conds...))
+}
+
+func (s signingPolicyDo) Select(conds ...field.Expr) *signingPolicyDo {
+	return s.withDO(s.DO.Select(conds...))
+}
+
+func (s signingPolicyDo) Where(conds ...gen.Condition) *signingPolicyDo {
+	return s.withDO(s.DO.Where(conds...))
+}
+
+func (s signingPolicyDo) Order(conds ...field.Expr) *signingPolicyDo {
+	return s.withDO(s.DO.Order(conds...))
+}
+
+func (s signingPolicyDo) Distinct(cols ...field.Expr) *signingPolicyDo {
+	return s.withDO(s.DO.Distinct(cols...))
+}
+
+func (s signingPolicyDo) Omit(cols ...field.Expr) *signingPolicyDo {
+	return s.withDO(s.DO.Omit(cols...))
+}
+
+func (s signingPolicyDo) Join(table schema.Tabler, on ...field.Expr) *signingPolicyDo {
+	return s.withDO(s.DO.Join(table, on...))
+}
+
+func (s signingPolicyDo) LeftJoin(table schema.Tabler, on ...field.Expr) *signingPolicyDo {
+	return s.withDO(s.DO.LeftJoin(table, on...))
+}
+
+func (s signingPolicyDo) RightJoin(table schema.Tabler, on ...field.Expr) *signingPolicyDo {
+	return s.withDO(s.DO.RightJoin(table, on...))
+}
+
+func (s signingPolicyDo) Group(cols ...field.Expr) *signingPolicyDo {
+	return s.withDO(s.DO.Group(cols...))
+}
+
+func (s signingPolicyDo) Having(conds ...gen.Condition) *signingPolicyDo {
+	return s.withDO(s.DO.Having(conds...))
+}
+
+func (s signingPolicyDo) Limit(limit int) *signingPolicyDo {
+	return s.withDO(s.DO.Limit(limit))
+}
+
+func (s signingPolicyDo) Offset(offset int) *signingPolicyDo {
+	return s.withDO(s.DO.Offset(offset))
+}
+
+func (s signingPolicyDo) Scopes(funcs ...func(gen.Dao) gen.Dao) *signingPolicyDo {
+	return s.withDO(s.DO.Scopes(funcs...))
+}
+
+func (s signingPolicyDo) Unscoped() *signingPolicyDo {
+	return s.withDO(s.DO.Unscoped())
+}
+
+func (s signingPolicyDo) Create(values ...*models.SigningPolicy) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Create(values)
+}
+
+func (s signingPolicyDo) CreateInBatches(values []*models.SigningPolicy, batchSize int) error {
+	return s.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (s signingPolicyDo) Save(values ...*models.SigningPolicy) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Save(values)
+}
+
+func (s signingPolicyDo) First() (*models.SigningPolicy, error) {
+	if result, err := s.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SigningPolicy), nil
+	}
+}
+
+func (s signingPolicyDo) Take() (*models.SigningPolicy, error) {
+	if result, err := s.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SigningPolicy), nil
+	}
+}
+
+func (s signingPolicyDo) Last() (*models.SigningPolicy, error) {
+	if result, err := s.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SigningPolicy), nil
+	}
+}
+
+func (s signingPolicyDo) Find() ([]*models.SigningPolicy, error) {
+	result, err := s.DO.Find()
+	return result.([]*models.SigningPolicy), err
+}
+
+func (s signingPolicyDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.SigningPolicy, err error) {
+	buf := make([]*models.SigningPolicy, 0, batchSize)
+	err = s.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (s signingPolicyDo) FindInBatches(result *[]*models.SigningPolicy, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return s.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (s signingPolicyDo) Attrs(attrs ...field.AssignExpr) *signingPolicyDo {
+	return s.withDO(s.DO.Attrs(attrs...))
+}
+
+func (s signingPolicyDo) Assign(attrs ...field.AssignExpr) *signingPolicyDo {
+	return s.withDO(s.DO.Assign(attrs...))
+}
+
+func (s signingPolicyDo) Joins(fields ...field.RelationField) *signingPolicyDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Joins(_f))
+	}
+	return &s
+}
+
+func (s signingPolicyDo) Preload(fields ...field.RelationField) *signingPolicyDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Preload(_f))
+	}
+	return &s
+}
+
+func (s signingPolicyDo) FirstOrInit() (*models.SigningPolicy, error) {
+	if result, err := s.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SigningPolicy), nil
+	}
+}
+
+func (s signingPolicyDo) FirstOrCreate() (*models.SigningPolicy, error) {
+	if result, err := s.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SigningPolicy), nil
+	}
+}
+
+func (s signingPolicyDo) FindByPage(offset int, limit int) (result []*models.SigningPolicy, count int64, err error) {
+	result, err = s.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = s.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (s signingPolicyDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = s.Count()
+	if err != nil {
+		return
+	}
+
+	err = s.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (s signingPolicyDo) Scan(result interface{}) (err error) {
+	return s.DO.Scan(result)
+}
+
+func (s signingPolicyDo) Delete(models ...*models.SigningPolicy) (result gen.ResultInfo, err error) {
+	return s.DO.Delete(models)
+}
+
+func (s *signingPolicyDo) withDO(do gen.Dao) *signingPolicyDo {
+	s.DO = *do.(*gen.DO)
+	return s
+}
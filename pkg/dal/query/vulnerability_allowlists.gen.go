@@ -0,0 +1,691 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+)
+
+func newVulnerabilityAllowlist(db *gorm.DB, opts ...gen.DOOption) vulnerabilityAllowlist {
+	_vulnerabilityAllowlist := vulnerabilityAllowlist{}
+
+	_vulnerabilityAllowlist.vulnerabilityAllowlistDo.UseDB(db, opts...)
+	_vulnerabilityAllowlist.vulnerabilityAllowlistDo.UseModel(&models.VulnerabilityAllowlist{})
+
+	tableName := _vulnerabilityAllowlist.vulnerabilityAllowlistDo.TableName()
+	_vulnerabilityAllowlist.ALL = field.NewAsterisk(tableName)
+	_vulnerabilityAllowlist.CreatedAt = field.NewInt64(tableName, "created_at")
+	_vulnerabilityAllowlist.UpdatedAt = field.NewInt64(tableName, "updated_at")
+	_vulnerabilityAllowlist.DeletedAt = field.NewUint64(tableName, "deleted_at")
+	_vulnerabilityAllowlist.ID = field.NewInt64(tableName, "id")
+	_vulnerabilityAllowlist.NamespaceID = field.NewInt64(tableName, "namespace_id")
+	_vulnerabilityAllowlist.RepositoryID = field.NewInt64(tableName, "repository_id")
+	_vulnerabilityAllowlist.UserID = field.NewInt64(tableName, "user_id")
+	_vulnerabilityAllowlist.VulnerabilityID = field.NewString(tableName, "vulnerability_id")
+	_vulnerabilityAllowlist.Package = field.NewString(tableName, "package")
+	_vulnerabilityAllowlist.Justification = field.NewString(tableName, "justification")
+	_vulnerabilityAllowlist.ExpiresAt = field.NewInt64(tableName, "expires_at")
+	_vulnerabilityAllowlist.Namespace = vulnerabilityAllowlistBelongsToNamespace{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Namespace", "models.Namespace"),
+	}
+
+	_vulnerabilityAllowlist.Repository = vulnerabilityAllowlistBelongsToRepository{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Repository", "models.Repository"),
+		Namespace: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Repository.Namespace", "models.Namespace"),
+		},
+		Builder: struct {
+			field.RelationField
+			Repository struct {
+				field.RelationField
+			}
+			CodeRepository struct {
+				field.RelationField
+				User3rdParty struct {
+					field.RelationField
+					User struct {
+						field.RelationField
+					}
+				}
+				Branches struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Repository.Builder", "models.Builder"),
+			Repository: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Repository.Builder.Repository", "models.Repository"),
+			},
+			CodeRepository: struct {
+				field.RelationField
+				User3rdParty struct {
+					field.RelationField
+					User struct {
+						field.RelationField
+					}
+				}
+				Branches struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Repository.Builder.CodeRepository", "models.CodeRepository"),
+				User3rdParty: struct {
+					field.RelationField
+					User struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Repository.Builder.CodeRepository.User3rdParty", "models.User3rdParty"),
+					User: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Repository.Builder.CodeRepository.User3rdParty.User", "models.User"),
+					},
+				},
+				Branches: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Repository.Builder.CodeRepository.Branches", "models.CodeRepositoryBranch"),
+				},
+			},
+		},
+	}
+
+	_vulnerabilityAllowlist.User = vulnerabilityAllowlistBelongsToUser{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("User", "models.User"),
+	}
+
+	_vulnerabilityAllowlist.fillFieldMap()
+
+	return _vulnerabilityAllowlist
+}
+
+type vulnerabilityAllowlist struct {
+	vulnerabilityAllowlistDo vulnerabilityAllowlistDo
+
+	ALL             field.Asterisk
+	CreatedAt       field.Int64
+	UpdatedAt       field.Int64
+	DeletedAt       field.Uint64
+	ID              field.Int64
+	NamespaceID     field.Int64
+	RepositoryID    field.Int64
+	UserID          field.Int64
+	VulnerabilityID field.String
+	Package         field.String
+	Justification   field.String
+	ExpiresAt       field.Int64
+	Namespace       vulnerabilityAllowlistBelongsToNamespace
+
+	Repository vulnerabilityAllowlistBelongsToRepository
+
+	User vulnerabilityAllowlistBelongsToUser
+
+	fieldMap map[string]field.Expr
+}
+
+func (v vulnerabilityAllowlist) Table(newTableName string) *vulnerabilityAllowlist {
+	v.vulnerabilityAllowlistDo.UseTable(newTableName)
+	return v.updateTableName(newTableName)
+}
+
+func (v vulnerabilityAllowlist) As(alias string) *vulnerabilityAllowlist {
+	v.vulnerabilityAllowlistDo.DO = *(v.vulnerabilityAllowlistDo.As(alias).(*gen.DO))
+	return v.updateTableName(alias)
+}
+
+func (v *vulnerabilityAllowlist) updateTableName(table string) *vulnerabilityAllowlist {
+	v.ALL = field.NewAsterisk(table)
+	v.CreatedAt = field.NewInt64(table, "created_at")
+	v.UpdatedAt = field.NewInt64(table, "updated_at")
+	v.DeletedAt = field.NewUint64(table, "deleted_at")
+	v.ID = field.NewInt64(table, "id")
+	v.NamespaceID = field.NewInt64(table, "namespace_id")
+	v.RepositoryID = field.NewInt64(table, "repository_id")
+	v.UserID = field.NewInt64(table, "user_id")
+	v.VulnerabilityID = field.NewString(table, "vulnerability_id")
+	v.Package = field.NewString(table, "package")
+	v.Justification = field.NewString(table, "justification")
+	v.ExpiresAt = field.NewInt64(table, "expires_at")
+
+	v.fillFieldMap()
+
+	return v
+}
+
+func (v *vulnerabilityAllowlist) WithContext(ctx context.Context) *vulnerabilityAllowlistDo {
+	return v.vulnerabilityAllowlistDo.WithContext(ctx)
+}
+
+func (v vulnerabilityAllowlist) TableName() string { return v.vulnerabilityAllowlistDo.TableName() }
+
+func (v vulnerabilityAllowlist) Alias() string { return v.vulnerabilityAllowlistDo.Alias() }
+
+func (v vulnerabilityAllowlist) Columns(cols ...field.Expr) gen.Columns {
+	return v.vulnerabilityAllowlistDo.Columns(cols...)
+}
+
+func (v *vulnerabilityAllowlist) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := v.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (v *vulnerabilityAllowlist) fillFieldMap() {
+	v.fieldMap = make(map[string]field.Expr, 14)
+	v.fieldMap["created_at"] = v.CreatedAt
+	v.fieldMap["updated_at"] = v.UpdatedAt
+	v.fieldMap["deleted_at"] = v.DeletedAt
+	v.fieldMap["id"] = v.ID
+	v.fieldMap["namespace_id"] = v.NamespaceID
+	v.fieldMap["repository_id"] = v.RepositoryID
+	v.fieldMap["user_id"] = v.UserID
+	v.fieldMap["vulnerability_id"] = v.VulnerabilityID
+	v.fieldMap["package"] = v.Package
+	v.fieldMap["justification"] = v.Justification
+	v.fieldMap["expires_at"] = v.ExpiresAt
+
+}
+
+func (v vulnerabilityAllowlist) clone(db *gorm.DB) vulnerabilityAllowlist {
+	v.vulnerabilityAllowlistDo.ReplaceConnPool(db.Statement.ConnPool)
+	return v
+}
+
+func (v vulnerabilityAllowlist) replaceDB(db *gorm.DB) vulnerabilityAllowlist {
+	v.vulnerabilityAllowlistDo.ReplaceDB(db)
+	return v
+}
+
+type vulnerabilityAllowlistBelongsToNamespace struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a vulnerabilityAllowlistBelongsToNamespace) Where(conds ...field.Expr) *vulnerabilityAllowlistBelongsToNamespace {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a vulnerabilityAllowlistBelongsToNamespace) WithContext(ctx context.Context) *vulnerabilityAllowlistBelongsToNamespace {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a vulnerabilityAllowlistBelongsToNamespace) Session(session *gorm.Session) *vulnerabilityAllowlistBelongsToNamespace {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a vulnerabilityAllowlistBelongsToNamespace) Model(m *models.VulnerabilityAllowlist) *vulnerabilityAllowlistBelongsToNamespaceTx {
+	return &vulnerabilityAllowlistBelongsToNamespaceTx{a.db.Model(m).Association(a.Name())}
+}
+
+type vulnerabilityAllowlistBelongsToNamespaceTx struct{ tx *gorm.Association }
+
+func (a vulnerabilityAllowlistBelongsToNamespaceTx) Find() (result *models.Namespace, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a vulnerabilityAllowlistBelongsToNamespaceTx) Append(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a vulnerabilityAllowlistBelongsToNamespaceTx) Replace(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a vulnerabilityAllowlistBelongsToNamespaceTx) Delete(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a vulnerabilityAllowlistBelongsToNamespaceTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a vulnerabilityAllowlistBelongsToNamespaceTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type vulnerabilityAllowlistBelongsToRepository struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Namespace struct {
+		field.RelationField
+	}
+	Builder struct {
+		field.RelationField
+		Repository struct {
+			field.RelationField
+		}
+		CodeRepository struct {
+			field.RelationField
+			User3rdParty struct {
+				field.RelationField
+				User struct {
+					field.RelationField
+				}
+			}
+			Branches struct {
+				field.RelationField
+			}
+		}
+	}
+}
+
+func (a vulnerabilityAllowlistBelongsToRepository) Where(conds ...field.Expr) *vulnerabilityAllowlistBelongsToRepository {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a vulnerabilityAllowlistBelongsToRepository) WithContext(ctx context.Context) *vulnerabilityAllowlistBelongsToRepository {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a vulnerabilityAllowlistBelongsToRepository) Session(session *gorm.Session) *vulnerabilityAllowlistBelongsToRepository {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a vulnerabilityAllowlistBelongsToRepository) Model(m *models.VulnerabilityAllowlist) *vulnerabilityAllowlistBelongsToRepositoryTx {
+	return &vulnerabilityAllowlistBelongsToRepositoryTx{a.db.Model(m).Association(a.Name())}
+}
+
+type vulnerabilityAllowlistBelongsToRepositoryTx struct{ tx *gorm.Association }
+
+func (a vulnerabilityAllowlistBelongsToRepositoryTx) Find() (result *models.Repository, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a vulnerabilityAllowlistBelongsToRepositoryTx) Append(values ...*models.Repository) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a vulnerabilityAllowlistBelongsToRepositoryTx) Replace(values ...*models.Repository) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a vulnerabilityAllowlistBelongsToRepositoryTx) Delete(values ...*models.Repository) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a vulnerabilityAllowlistBelongsToRepositoryTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a vulnerabilityAllowlistBelongsToRepositoryTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type vulnerabilityAllowlistBelongsToUser struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a vulnerabilityAllowlistBelongsToUser) Where(conds ...field.Expr) *vulnerabilityAllowlistBelongsToUser {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a vulnerabilityAllowlistBelongsToUser) WithContext(ctx context.Context) *vulnerabilityAllowlistBelongsToUser {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a vulnerabilityAllowlistBelongsToUser) Session(session *gorm.Session) *vulnerabilityAllowlistBelongsToUser {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a vulnerabilityAllowlistBelongsToUser) Model(m *models.VulnerabilityAllowlist) *vulnerabilityAllowlistBelongsToUserTx {
+	return &vulnerabilityAllowlistBelongsToUserTx{a.db.Model(m).Association(a.Name())}
+}
+
+type vulnerabilityAllowlistBelongsToUserTx struct{ tx *gorm.Association }
+
+func (a vulnerabilityAllowlistBelongsToUserTx) Find() (result *models.User, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a vulnerabilityAllowlistBelongsToUserTx) Append(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a vulnerabilityAllowlistBelongsToUserTx) Replace(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a vulnerabilityAllowlistBelongsToUserTx) Delete(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a vulnerabilityAllowlistBelongsToUserTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a vulnerabilityAllowlistBelongsToUserTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type vulnerabilityAllowlistDo struct{ gen.DO }
+
+func (v vulnerabilityAllowlistDo) Debug() *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.Debug())
+}
+
+func (v vulnerabilityAllowlistDo) WithContext(ctx context.Context) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.WithContext(ctx))
+}
+
+func (v vulnerabilityAllowlistDo) ReadDB() *vulnerabilityAllowlistDo {
+	return v.Clauses(dbresolver.Read)
+}
+
+func (v vulnerabilityAllowlistDo) WriteDB() *vulnerabilityAllowlistDo {
+	return v.Clauses(dbresolver.Write)
+}
+
+func (v vulnerabilityAllowlistDo) Session(config *gorm.Session) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.Session(config))
+}
+
+func (v vulnerabilityAllowlistDo) Clauses(conds ...clause.Expression) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.Clauses(conds...))
+}
+
+func (v vulnerabilityAllowlistDo) Returning(value interface{}, columns ...string) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.Returning(value, columns...))
+}
+
+func (v vulnerabilityAllowlistDo) Not(conds ...gen.Condition) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.Not(conds...))
+}
+
+func (v vulnerabilityAllowlistDo) Or(conds ...gen.Condition) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.Or(conds...))
+}
+
+func (v vulnerabilityAllowlistDo) Select(conds ...field.Expr) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.Select(conds...))
+}
+
+func (v vulnerabilityAllowlistDo) Where(conds ...gen.Condition) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.Where(conds...))
+}
+
+func (v vulnerabilityAllowlistDo) Order(conds ...field.Expr) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.Order(conds...))
+}
+
+func (v vulnerabilityAllowlistDo) Distinct(cols ...field.Expr) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.Distinct(cols...))
+}
+
+func (v vulnerabilityAllowlistDo) Omit(cols ...field.Expr) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.Omit(cols...))
+}
+
+func (v vulnerabilityAllowlistDo) Join(table schema.Tabler, on ...field.Expr) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.Join(table, on...))
+}
+
+func (v vulnerabilityAllowlistDo) LeftJoin(table schema.Tabler, on ...field.Expr) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.LeftJoin(table, on...))
+}
+
+func (v vulnerabilityAllowlistDo) RightJoin(table schema.Tabler, on ...field.Expr) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.RightJoin(table, on...))
+}
+
+func (v vulnerabilityAllowlistDo) Group(cols ...field.Expr) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.Group(cols...))
+}
+
+func (v vulnerabilityAllowlistDo) Having(conds ...gen.Condition) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.Having(conds...))
+}
+
+func (v vulnerabilityAllowlistDo) Limit(limit int) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.Limit(limit))
+}
+
+func (v vulnerabilityAllowlistDo) Offset(offset int) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.Offset(offset))
+}
+
+func (v vulnerabilityAllowlistDo) Scopes(funcs ...func(gen.Dao) gen.Dao) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.Scopes(funcs...))
+}
+
+func (v vulnerabilityAllowlistDo) Unscoped() *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.Unscoped())
+}
+
+func (v vulnerabilityAllowlistDo) Create(values ...*models.VulnerabilityAllowlist) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return v.DO.Create(values)
+}
+
+func (v vulnerabilityAllowlistDo) CreateInBatches(values []*models.VulnerabilityAllowlist, batchSize int) error {
+	return v.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (v vulnerabilityAllowlistDo) Save(values ...*models.VulnerabilityAllowlist) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return v.DO.Save(values)
+}
+
+func (v vulnerabilityAllowlistDo) First() (*models.VulnerabilityAllowlist, error) {
+	if result, err := v.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.VulnerabilityAllowlist), nil
+	}
+}
+
+func (v vulnerabilityAllowlistDo) Take() (*models.VulnerabilityAllowlist, error) {
+	if result, err := v.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.VulnerabilityAllowlist), nil
+	}
+}
+
+func (v vulnerabilityAllowlistDo) Last() (*models.VulnerabilityAllowlist, error) {
+	if result, err := v.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.VulnerabilityAllowlist), nil
+	}
+}
+
+func (v vulnerabilityAllowlistDo) Find() ([]*models.VulnerabilityAllowlist, error) {
+	result, err := v.DO.Find()
+	return result.([]*models.VulnerabilityAllowlist), err
+}
+
+func (v vulnerabilityAllowlistDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.VulnerabilityAllowlist, err error) {
+	buf := make([]*models.VulnerabilityAllowlist, 0, batchSize)
+	err = v.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (v vulnerabilityAllowlistDo) FindInBatches(result *[]*models.VulnerabilityAllowlist, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return v.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (v vulnerabilityAllowlistDo) Attrs(attrs ...field.AssignExpr) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.Attrs(attrs...))
+}
+
+func (v vulnerabilityAllowlistDo) Assign(attrs ...field.AssignExpr) *vulnerabilityAllowlistDo {
+	return v.withDO(v.DO.Assign(attrs...))
+}
+
+func (v vulnerabilityAllowlistDo) Joins(fields ...field.RelationField) *vulnerabilityAllowlistDo {
+	for _, _f := range fields {
+		v = *v.withDO(v.DO.Joins(_f))
+	}
+	return &v
+}
+
+func (v vulnerabilityAllowlistDo) Preload(fields ...field.RelationField) *vulnerabilityAllowlistDo {
+	for _, _f := range fields {
+		v = *v.withDO(v.DO.Preload(_f))
+	}
+	return &v
+}
+
+func (v vulnerabilityAllowlistDo) FirstOrInit() (*models.VulnerabilityAllowlist, error) {
+	if result, err := v.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.VulnerabilityAllowlist), nil
+	}
+}
+
+func (v vulnerabilityAllowlistDo) FirstOrCreate() (*models.VulnerabilityAllowlist, error) {
+	if result, err := v.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.VulnerabilityAllowlist), nil
+	}
+}
+
+func (v vulnerabilityAllowlistDo) FindByPage(offset int, limit int) (result []*models.VulnerabilityAllowlist, count int64, err error) {
+	result, err = v.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = v.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (v vulnerabilityAllowlistDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = v.Count()
+	if err != nil {
+		return
+	}
+
+	err = v.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (v vulnerabilityAllowlistDo) Scan(result interface{}) (err error) {
+	return v.DO.Scan(result)
+}
+
+func (v vulnerabilityAllowlistDo) Delete(models ...*models.VulnerabilityAllowlist) (result gen.ResultInfo, err error) {
+	return v.DO.Delete(models)
+}
+
+func (v *vulnerabilityAllowlistDo) withDO(do gen.Dao) *vulnerabilityAllowlistDo {
+	v.DO = *do.(*gen.DO)
+	return v
+}
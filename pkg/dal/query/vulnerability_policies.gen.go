@@ -0,0 +1,441 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+)
+
+func newVulnerabilityPolicy(db *gorm.DB, opts ...gen.DOOption) vulnerabilityPolicy {
+	_vulnerabilityPolicy := vulnerabilityPolicy{}
+
+	_vulnerabilityPolicy.vulnerabilityPolicyDo.UseDB(db, opts...)
+	_vulnerabilityPolicy.vulnerabilityPolicyDo.UseModel(&models.VulnerabilityPolicy{})
+
+	tableName := _vulnerabilityPolicy.vulnerabilityPolicyDo.TableName()
+	_vulnerabilityPolicy.ALL = field.NewAsterisk(tableName)
+	_vulnerabilityPolicy.CreatedAt = field.NewInt64(tableName, "created_at")
+	_vulnerabilityPolicy.UpdatedAt = field.NewInt64(tableName, "updated_at")
+	_vulnerabilityPolicy.DeletedAt = field.NewUint64(tableName, "deleted_at")
+	_vulnerabilityPolicy.ID = field.NewInt64(tableName, "id")
+	_vulnerabilityPolicy.NamespaceID = field.NewInt64(tableName, "namespace_id")
+	_vulnerabilityPolicy.Enabled = field.NewBool(tableName, "enabled")
+	_vulnerabilityPolicy.MaxSeverity = field.NewField(tableName, "max_severity")
+	_vulnerabilityPolicy.BlockUnscanned = field.NewBool(tableName, "block_unscanned")
+	_vulnerabilityPolicy.Exemptions = field.NewString(tableName, "exemptions")
+	_vulnerabilityPolicy.Namespace = vulnerabilityPolicyBelongsToNamespace{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Namespace", "models.Namespace"),
+	}
+
+	_vulnerabilityPolicy.fillFieldMap()
+
+	return _vulnerabilityPolicy
+}
+
+type vulnerabilityPolicy struct {
+	vulnerabilityPolicyDo vulnerabilityPolicyDo
+
+	ALL            field.Asterisk
+	CreatedAt      field.Int64
+	UpdatedAt      field.Int64
+	DeletedAt      field.Uint64
+	ID             field.Int64
+	NamespaceID    field.Int64
+	Enabled        field.Bool
+	MaxSeverity    field.Field
+	BlockUnscanned field.Bool
+	Exemptions     field.String
+	Namespace      vulnerabilityPolicyBelongsToNamespace
+
+	fieldMap map[string]field.Expr
+}
+
+func (v vulnerabilityPolicy) Table(newTableName string) *vulnerabilityPolicy {
+	v.vulnerabilityPolicyDo.UseTable(newTableName)
+	return v.updateTableName(newTableName)
+}
+
+func (v vulnerabilityPolicy) As(alias string) *vulnerabilityPolicy {
+	v.vulnerabilityPolicyDo.DO = *(v.vulnerabilityPolicyDo.As(alias).(*gen.DO))
+	return v.updateTableName(alias)
+}
+
+func (v *vulnerabilityPolicy) updateTableName(table string) *vulnerabilityPolicy {
+	v.ALL = field.NewAsterisk(table)
+	v.CreatedAt = field.NewInt64(table, "created_at")
+	v.UpdatedAt = field.NewInt64(table, "updated_at")
+	v.DeletedAt = field.NewUint64(table, "deleted_at")
+	v.ID = field.NewInt64(table, "id")
+	v.NamespaceID = field.NewInt64(table, "namespace_id")
+	v.Enabled = field.NewBool(table, "enabled")
+	v.MaxSeverity = field.NewField(table, "max_severity")
+	v.BlockUnscanned = field.NewBool(table, "block_unscanned")
+	v.Exemptions = field.NewString(table, "exemptions")
+
+	v.fillFieldMap()
+
+	return v
+}
+
+func (v *vulnerabilityPolicy) WithContext(ctx context.Context) *vulnerabilityPolicyDo {
+	return v.vulnerabilityPolicyDo.WithContext(ctx)
+}
+
+func (v vulnerabilityPolicy) TableName() string { return v.vulnerabilityPolicyDo.TableName() }
+
+func (v vulnerabilityPolicy) Alias() string { return v.vulnerabilityPolicyDo.Alias() }
+
+func (v vulnerabilityPolicy) Columns(cols ...field.Expr) gen.Columns {
+	return v.vulnerabilityPolicyDo.Columns(cols...)
+}
+
+func (v *vulnerabilityPolicy) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := v.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (v *vulnerabilityPolicy) fillFieldMap() {
+	v.fieldMap = make(map[string]field.Expr, 10)
+	v.fieldMap["created_at"] = v.CreatedAt
+	v.fieldMap["updated_at"] = v.UpdatedAt
+	v.fieldMap["deleted_at"] = v.DeletedAt
+	v.fieldMap["id"] = v.ID
+	v.fieldMap["namespace_id"] = v.NamespaceID
+	v.fieldMap["enabled"] = v.Enabled
+	v.fieldMap["max_severity"] = v.MaxSeverity
+	v.fieldMap["block_unscanned"] = v.BlockUnscanned
+	v.fieldMap["exemptions"] = v.Exemptions
+
+}
+
+func (v vulnerabilityPolicy) clone(db *gorm.DB) vulnerabilityPolicy {
+	v.vulnerabilityPolicyDo.ReplaceConnPool(db.Statement.ConnPool)
+	return v
+}
+
+func (v vulnerabilityPolicy) replaceDB(db *gorm.DB) vulnerabilityPolicy {
+	v.vulnerabilityPolicyDo.ReplaceDB(db)
+	return v
+}
+
+type vulnerabilityPolicyBelongsToNamespace struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a vulnerabilityPolicyBelongsToNamespace) Where(conds ...field.Expr) *vulnerabilityPolicyBelongsToNamespace {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a vulnerabilityPolicyBelongsToNamespace) WithContext(ctx context.Context) *vulnerabilityPolicyBelongsToNamespace {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a vulnerabilityPolicyBelongsToNamespace) Session(session *gorm.Session) *vulnerabilityPolicyBelongsToNamespace {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a vulnerabilityPolicyBelongsToNamespace) Model(m *models.VulnerabilityPolicy) *vulnerabilityPolicyBelongsToNamespaceTx {
+	return &vulnerabilityPolicyBelongsToNamespaceTx{a.db.Model(m).Association(a.Name())}
+}
+
+type vulnerabilityPolicyBelongsToNamespaceTx struct{ tx *gorm.Association }
+
+func (a vulnerabilityPolicyBelongsToNamespaceTx) Find() (result *models.Namespace, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a vulnerabilityPolicyBelongsToNamespaceTx) Append(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a vulnerabilityPolicyBelongsToNamespaceTx) Replace(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a vulnerabilityPolicyBelongsToNamespaceTx) Delete(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a vulnerabilityPolicyBelongsToNamespaceTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a vulnerabilityPolicyBelongsToNamespaceTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type vulnerabilityPolicyDo struct{ gen.DO }
+
+func (v vulnerabilityPolicyDo) Debug() *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.Debug())
+}
+
+func (v vulnerabilityPolicyDo) WithContext(ctx context.Context) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.WithContext(ctx))
+}
+
+func (v vulnerabilityPolicyDo) ReadDB() *vulnerabilityPolicyDo {
+	return v.Clauses(dbresolver.Read)
+}
+
+func (v vulnerabilityPolicyDo) WriteDB() *vulnerabilityPolicyDo {
+	return v.Clauses(dbresolver.Write)
+}
+
+func (v vulnerabilityPolicyDo) Session(config *gorm.Session) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.Session(config))
+}
+
+func (v vulnerabilityPolicyDo) Clauses(conds ...clause.Expression) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.Clauses(conds...))
+}
+
+func (v vulnerabilityPolicyDo) Returning(value interface{}, columns ...string) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.Returning(value, columns...))
+}
+
+func (v vulnerabilityPolicyDo) Not(conds ...gen.Condition) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.Not(conds...))
+}
+
+func (v vulnerabilityPolicyDo) Or(conds ...gen.Condition) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.Or(conds...))
+}
+
+func (v vulnerabilityPolicyDo) Select(conds ...field.Expr) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.Select(conds...))
+}
+
+func (v vulnerabilityPolicyDo) Where(conds ...gen.Condition) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.Where(conds...))
+}
+
+func (v vulnerabilityPolicyDo) Order(conds ...field.Expr) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.Order(conds...))
+}
+
+func (v vulnerabilityPolicyDo) Distinct(cols ...field.Expr) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.Distinct(cols...))
+}
+
+func (v vulnerabilityPolicyDo) Omit(cols ...field.Expr) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.Omit(cols...))
+}
+
+func (v vulnerabilityPolicyDo) Join(table schema.Tabler, on ...field.Expr) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.Join(table, on...))
+}
+
+func (v vulnerabilityPolicyDo) LeftJoin(table schema.Tabler, on ...field.Expr) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.LeftJoin(table, on...))
+}
+
+func (v vulnerabilityPolicyDo) RightJoin(table schema.Tabler, on ...field.Expr) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.RightJoin(table, on...))
+}
+
+func (v vulnerabilityPolicyDo) Group(cols ...field.Expr) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.Group(cols...))
+}
+
+func (v vulnerabilityPolicyDo) Having(conds ...gen.Condition) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.Having(conds...))
+}
+
+func (v vulnerabilityPolicyDo) Limit(limit int) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.Limit(limit))
+}
+
+func (v vulnerabilityPolicyDo) Offset(offset int) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.Offset(offset))
+}
+
+func (v vulnerabilityPolicyDo) Scopes(funcs ...func(gen.Dao) gen.Dao) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.Scopes(funcs...))
+}
+
+func (v vulnerabilityPolicyDo) Unscoped() *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.Unscoped())
+}
+
+func (v vulnerabilityPolicyDo) Create(values ...*models.VulnerabilityPolicy) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return v.DO.Create(values)
+}
+
+func (v vulnerabilityPolicyDo) CreateInBatches(values []*models.VulnerabilityPolicy, batchSize int) error {
+	return v.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (v vulnerabilityPolicyDo) Save(values ...*models.VulnerabilityPolicy) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return v.DO.Save(values)
+}
+
+func (v vulnerabilityPolicyDo) First() (*models.VulnerabilityPolicy, error) {
+	if result, err := v.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.VulnerabilityPolicy), nil
+	}
+}
+
+func (v vulnerabilityPolicyDo) Take() (*models.VulnerabilityPolicy, error) {
+	if result, err := v.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.VulnerabilityPolicy), nil
+	}
+}
+
+func (v vulnerabilityPolicyDo) Last() (*models.VulnerabilityPolicy, error) {
+	if result, err := v.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.VulnerabilityPolicy), nil
+	}
+}
+
+func (v vulnerabilityPolicyDo) Find() ([]*models.VulnerabilityPolicy, error) {
+	result, err := v.DO.Find()
+	return result.([]*models.VulnerabilityPolicy), err
+}
+
+func (v vulnerabilityPolicyDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.VulnerabilityPolicy, err error) {
+	buf := make([]*models.VulnerabilityPolicy, 0, batchSize)
+	err = v.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (v vulnerabilityPolicyDo) FindInBatches(result *[]*models.VulnerabilityPolicy, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return v.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (v vulnerabilityPolicyDo) Attrs(attrs ...field.AssignExpr) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.Attrs(attrs...))
+}
+
+func (v vulnerabilityPolicyDo) Assign(attrs ...field.AssignExpr) *vulnerabilityPolicyDo {
+	return v.withDO(v.DO.Assign(attrs...))
+}
+
+func (v vulnerabilityPolicyDo) Joins(fields ...field.RelationField) *vulnerabilityPolicyDo {
+	for _, _f := range fields {
+		v = *v.withDO(v.DO.Joins(_f))
+	}
+	return &v
+}
+
+func (v vulnerabilityPolicyDo) Preload(fields ...field.RelationField) *vulnerabilityPolicyDo {
+	for _, _f := range fields {
+		v = *v.withDO(v.DO.Preload(_f))
+	}
+	return &v
+}
+
+func (v vulnerabilityPolicyDo) FirstOrInit() (*models.VulnerabilityPolicy, error) {
+	if result, err := v.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.VulnerabilityPolicy), nil
+	}
+}
+
+func (v vulnerabilityPolicyDo) FirstOrCreate() (*models.VulnerabilityPolicy, error) {
+	if result, err := v.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.VulnerabilityPolicy), nil
+	}
+}
+
+func (v vulnerabilityPolicyDo) FindByPage(offset int, limit int) (result []*models.VulnerabilityPolicy, count int64, err error) {
+	result, err = v.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = v.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (v vulnerabilityPolicyDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = v.Count()
+	if err != nil {
+		return
+	}
+
+	err = v.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (v vulnerabilityPolicyDo) Scan(result interface{}) (err error) {
+	return v.DO.Scan(result)
+}
+
+func (v vulnerabilityPolicyDo) Delete(models ...*models.VulnerabilityPolicy) (result gen.ResultInfo, err error) {
+	return v.DO.Delete(models)
+}
+
+func (v *vulnerabilityPolicyDo) withDO(do gen.Dao) *vulnerabilityPolicyDo {
+	v.DO = *do.(*gen.DO)
+	return v
+}
@@ -0,0 +1,443 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+)
+
+func newVulnerabilityRescanPolicy(db *gorm.DB, opts ...gen.DOOption) vulnerabilityRescanPolicy {
+	_vulnerabilityRescanPolicy := vulnerabilityRescanPolicy{}
+
+	_vulnerabilityRescanPolicy.vulnerabilityRescanPolicyDo.UseDB(db, opts...)
+	_vulnerabilityRescanPolicy.vulnerabilityRescanPolicyDo.UseModel(&models.VulnerabilityRescanPolicy{})
+
+	tableName := _vulnerabilityRescanPolicy.vulnerabilityRescanPolicyDo.TableName()
+	_vulnerabilityRescanPolicy.ALL = field.NewAsterisk(tableName)
+	_vulnerabilityRescanPolicy.CreatedAt = field.NewInt64(tableName, "created_at")
+	_vulnerabilityRescanPolicy.UpdatedAt = field.NewInt64(tableName, "updated_at")
+	_vulnerabilityRescanPolicy.DeletedAt = field.NewUint64(tableName, "deleted_at")
+	_vulnerabilityRescanPolicy.ID = field.NewInt64(tableName, "id")
+	_vulnerabilityRescanPolicy.NamespaceID = field.NewInt64(tableName, "namespace_id")
+	_vulnerabilityRescanPolicy.Enabled = field.NewBool(tableName, "enabled")
+	_vulnerabilityRescanPolicy.CronRule = field.NewString(tableName, "cron_rule")
+	_vulnerabilityRescanPolicy.PulledWithin = field.NewInt64(tableName, "pulled_within")
+	_vulnerabilityRescanPolicy.CronNextTrigger = field.NewInt64(tableName, "cron_next_trigger")
+	_vulnerabilityRescanPolicy.Namespace = vulnerabilityRescanPolicyBelongsToNamespace{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Namespace", "models.Namespace"),
+	}
+
+	_vulnerabilityRescanPolicy.fillFieldMap()
+
+	return _vulnerabilityRescanPolicy
+}
+
+type vulnerabilityRescanPolicy struct {
+	vulnerabilityRescanPolicyDo vulnerabilityRescanPolicyDo
+
+	ALL             field.Asterisk
+	CreatedAt       field.Int64
+	UpdatedAt       field.Int64
+	DeletedAt       field.Uint64
+	ID              field.Int64
+	NamespaceID     field.Int64
+	Enabled         field.Bool
+	CronRule        field.String
+	PulledWithin    field.Int64
+	CronNextTrigger field.Int64
+	Namespace       vulnerabilityRescanPolicyBelongsToNamespace
+
+	fieldMap map[string]field.Expr
+}
+
+func (v vulnerabilityRescanPolicy) Table(newTableName string) *vulnerabilityRescanPolicy {
+	v.vulnerabilityRescanPolicyDo.UseTable(newTableName)
+	return v.updateTableName(newTableName)
+}
+
+func (v vulnerabilityRescanPolicy) As(alias string) *vulnerabilityRescanPolicy {
+	v.vulnerabilityRescanPolicyDo.DO = *(v.vulnerabilityRescanPolicyDo.As(alias).(*gen.DO))
+	return v.updateTableName(alias)
+}
+
+func (v *vulnerabilityRescanPolicy) updateTableName(table string) *vulnerabilityRescanPolicy {
+	v.ALL = field.NewAsterisk(table)
+	v.CreatedAt = field.NewInt64(table, "created_at")
+	v.UpdatedAt = field.NewInt64(table, "updated_at")
+	v.DeletedAt = field.NewUint64(table, "deleted_at")
+	v.ID = field.NewInt64(table, "id")
+	v.NamespaceID = field.NewInt64(table, "namespace_id")
+	v.Enabled = field.NewBool(table, "enabled")
+	v.CronRule = field.NewString(table, "cron_rule")
+	v.PulledWithin = field.NewInt64(table, "pulled_within")
+	v.CronNextTrigger = field.NewInt64(table, "cron_next_trigger")
+
+	v.fillFieldMap()
+
+	return v
+}
+
+func (v *vulnerabilityRescanPolicy) WithContext(ctx context.Context) *vulnerabilityRescanPolicyDo {
+	return v.vulnerabilityRescanPolicyDo.WithContext(ctx)
+}
+
+func (v vulnerabilityRescanPolicy) TableName() string {
+	return v.vulnerabilityRescanPolicyDo.TableName()
+}
+
+func (v vulnerabilityRescanPolicy) Alias() string { return v.vulnerabilityRescanPolicyDo.Alias() }
+
+func (v vulnerabilityRescanPolicy) Columns(cols ...field.Expr) gen.Columns {
+	return v.vulnerabilityRescanPolicyDo.Columns(cols...)
+}
+
+func (v *vulnerabilityRescanPolicy) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := v.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (v *vulnerabilityRescanPolicy) fillFieldMap() {
+	v.fieldMap = make(map[string]field.Expr, 10)
+	v.fieldMap["created_at"] = v.CreatedAt
+	v.fieldMap["updated_at"] = v.UpdatedAt
+	v.fieldMap["deleted_at"] = v.DeletedAt
+	v.fieldMap["id"] = v.ID
+	v.fieldMap["namespace_id"] = v.NamespaceID
+	v.fieldMap["enabled"] = v.Enabled
+	v.fieldMap["cron_rule"] = v.CronRule
+	v.fieldMap["pulled_within"] = v.PulledWithin
+	v.fieldMap["cron_next_trigger"] = v.CronNextTrigger
+
+}
+
+func (v vulnerabilityRescanPolicy) clone(db *gorm.DB) vulnerabilityRescanPolicy {
+	v.vulnerabilityRescanPolicyDo.ReplaceConnPool(db.Statement.ConnPool)
+	return v
+}
+
+func (v vulnerabilityRescanPolicy) replaceDB(db *gorm.DB) vulnerabilityRescanPolicy {
+	v.vulnerabilityRescanPolicyDo.ReplaceDB(db)
+	return v
+}
+
+type vulnerabilityRescanPolicyBelongsToNamespace struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a vulnerabilityRescanPolicyBelongsToNamespace) Where(conds ...field.Expr) *vulnerabilityRescanPolicyBelongsToNamespace {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a vulnerabilityRescanPolicyBelongsToNamespace) WithContext(ctx context.Context) *vulnerabilityRescanPolicyBelongsToNamespace {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a vulnerabilityRescanPolicyBelongsToNamespace) Session(session *gorm.Session) *vulnerabilityRescanPolicyBelongsToNamespace {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a vulnerabilityRescanPolicyBelongsToNamespace) Model(m *models.VulnerabilityRescanPolicy) *vulnerabilityRescanPolicyBelongsToNamespaceTx {
+	return &vulnerabilityRescanPolicyBelongsToNamespaceTx{a.db.Model(m).Association(a.Name())}
+}
+
+type vulnerabilityRescanPolicyBelongsToNamespaceTx struct{ tx *gorm.Association }
+
+func (a vulnerabilityRescanPolicyBelongsToNamespaceTx) Find() (result *models.Namespace, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a vulnerabilityRescanPolicyBelongsToNamespaceTx) Append(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a vulnerabilityRescanPolicyBelongsToNamespaceTx) Replace(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a vulnerabilityRescanPolicyBelongsToNamespaceTx) Delete(values ...*models.Namespace) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a vulnerabilityRescanPolicyBelongsToNamespaceTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a vulnerabilityRescanPolicyBelongsToNamespaceTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type vulnerabilityRescanPolicyDo struct{ gen.DO }
+
+func (v vulnerabilityRescanPolicyDo) Debug() *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.Debug())
+}
+
+func (v vulnerabilityRescanPolicyDo) WithContext(ctx context.Context) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.WithContext(ctx))
+}
+
+func (v vulnerabilityRescanPolicyDo) ReadDB() *vulnerabilityRescanPolicyDo {
+	return v.Clauses(dbresolver.Read)
+}
+
+func (v vulnerabilityRescanPolicyDo) WriteDB() *vulnerabilityRescanPolicyDo {
+	return v.Clauses(dbresolver.Write)
+}
+
+func (v vulnerabilityRescanPolicyDo) Session(config *gorm.Session) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.Session(config))
+}
+
+func (v vulnerabilityRescanPolicyDo) Clauses(conds ...clause.Expression) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.Clauses(conds...))
+}
+
+func (v vulnerabilityRescanPolicyDo) Returning(value interface{}, columns ...string) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.Returning(value, columns...))
+}
+
+func (v vulnerabilityRescanPolicyDo) Not(conds ...gen.Condition) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.Not(conds...))
+}
+
+func (v vulnerabilityRescanPolicyDo) Or(conds ...gen.Condition) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.Or(conds...))
+}
+
+func (v vulnerabilityRescanPolicyDo) Select(conds ...field.Expr) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.Select(conds...))
+}
+
+func (v vulnerabilityRescanPolicyDo) Where(conds ...gen.Condition) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.Where(conds...))
+}
+
+func (v vulnerabilityRescanPolicyDo) Order(conds ...field.Expr) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.Order(conds...))
+}
+
+func (v vulnerabilityRescanPolicyDo) Distinct(cols ...field.Expr) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.Distinct(cols...))
+}
+
+func (v vulnerabilityRescanPolicyDo) Omit(cols ...field.Expr) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.Omit(cols...))
+}
+
+func (v vulnerabilityRescanPolicyDo) Join(table schema.Tabler, on ...field.Expr) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.Join(table, on...))
+}
+
+func (v vulnerabilityRescanPolicyDo) LeftJoin(table schema.Tabler, on ...field.Expr) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.LeftJoin(table, on...))
+}
+
+func (v vulnerabilityRescanPolicyDo) RightJoin(table schema.Tabler, on ...field.Expr) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.RightJoin(table, on...))
+}
+
+func (v vulnerabilityRescanPolicyDo) Group(cols ...field.Expr) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.Group(cols...))
+}
+
+func (v vulnerabilityRescanPolicyDo) Having(conds ...gen.Condition) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.Having(conds...))
+}
+
+func (v vulnerabilityRescanPolicyDo) Limit(limit int) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.Limit(limit))
+}
+
+func (v vulnerabilityRescanPolicyDo) Offset(offset int) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.Offset(offset))
+}
+
+func (v vulnerabilityRescanPolicyDo) Scopes(funcs ...func(gen.Dao) gen.Dao) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.Scopes(funcs...))
+}
+
+func (v vulnerabilityRescanPolicyDo) Unscoped() *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.Unscoped())
+}
+
+func (v vulnerabilityRescanPolicyDo) Create(values ...*models.VulnerabilityRescanPolicy) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return v.DO.Create(values)
+}
+
+func (v vulnerabilityRescanPolicyDo) CreateInBatches(values []*models.VulnerabilityRescanPolicy, batchSize int) error {
+	return v.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (v vulnerabilityRescanPolicyDo) Save(values ...*models.VulnerabilityRescanPolicy) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return v.DO.Save(values)
+}
+
+func (v vulnerabilityRescanPolicyDo) First() (*models.VulnerabilityRescanPolicy, error) {
+	if result, err := v.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.VulnerabilityRescanPolicy), nil
+	}
+}
+
+func (v vulnerabilityRescanPolicyDo) Take() (*models.VulnerabilityRescanPolicy, error) {
+	if result, err := v.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.VulnerabilityRescanPolicy), nil
+	}
+}
+
+func (v vulnerabilityRescanPolicyDo) Last() (*models.VulnerabilityRescanPolicy, error) {
+	if result, err := v.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.VulnerabilityRescanPolicy), nil
+	}
+}
+
+func (v vulnerabilityRescanPolicyDo) Find() ([]*models.VulnerabilityRescanPolicy, error) {
+	result, err := v.DO.Find()
+	return result.([]*models.VulnerabilityRescanPolicy), err
+}
+
+func (v vulnerabilityRescanPolicyDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.VulnerabilityRescanPolicy, err error) {
+	buf := make([]*models.VulnerabilityRescanPolicy, 0, batchSize)
+	err = v.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (v vulnerabilityRescanPolicyDo) FindInBatches(result *[]*models.VulnerabilityRescanPolicy, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return v.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (v vulnerabilityRescanPolicyDo) Attrs(attrs ...field.AssignExpr) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.Attrs(attrs...))
+}
+
+func (v vulnerabilityRescanPolicyDo) Assign(attrs ...field.AssignExpr) *vulnerabilityRescanPolicyDo {
+	return v.withDO(v.DO.Assign(attrs...))
+}
+
+func (v vulnerabilityRescanPolicyDo) Joins(fields ...field.RelationField) *vulnerabilityRescanPolicyDo {
+	for _, _f := range fields {
+		v = *v.withDO(v.DO.Joins(_f))
+	}
+	return &v
+}
+
+func (v vulnerabilityRescanPolicyDo) Preload(fields ...field.RelationField) *vulnerabilityRescanPolicyDo {
+	for _, _f := range fields {
+		v = *v.withDO(v.DO.Preload(_f))
+	}
+	return &v
+}
+
+func (v vulnerabilityRescanPolicyDo) FirstOrInit() (*models.VulnerabilityRescanPolicy, error) {
+	if result, err := v.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.VulnerabilityRescanPolicy), nil
+	}
+}
+
+func (v vulnerabilityRescanPolicyDo) FirstOrCreate() (*models.VulnerabilityRescanPolicy, error) {
+	if result, err := v.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.VulnerabilityRescanPolicy), nil
+	}
+}
+
+func (v vulnerabilityRescanPolicyDo) FindByPage(offset int, limit int) (result []*models.VulnerabilityRescanPolicy, count int64, err error) {
+	result, err = v.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = v.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (v vulnerabilityRescanPolicyDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = v.Count()
+	if err != nil {
+		return
+	}
+
+	err = v.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (v vulnerabilityRescanPolicyDo) Scan(result interface{}) (err error) {
+	return v.DO.Scan(result)
+}
+
+func (v vulnerabilityRescanPolicyDo) Delete(models ...*models.VulnerabilityRescanPolicy) (result gen.ResultInfo, err error) {
+	return v.DO.Delete(models)
+}
+
+func (v *vulnerabilityRescanPolicyDo) withDO(do gen.Dao) *vulnerabilityRescanPolicyDo {
+	v.DO = *do.(*gen.DO)
+	return v
+}
@@ -0,0 +1,128 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package allowlists
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// PostVulnerabilityAllowlist handles the post vulnerability allowlist request
+//
+//	@Summary	Create vulnerability allowlist entry
+//	@security	BasicAuth
+//	@Tags		VulnerabilityAllowlist
+//	@Accept		json
+//	@Produce	json
+//	@Router		/vulnerability-allowlists/ [post]
+//	@Param		message	body		types.PostVulnerabilityAllowlistRequest	true	"Vulnerability allowlist object"
+//	@Success	201		{object}	types.PostVulnerabilityAllowlistResponse
+//	@Failure	400		{object}	xerrors.ErrCode
+//	@Failure	401		{object}	xerrors.ErrCode
+//	@Failure	404		{object}	xerrors.ErrCode
+//	@Failure	500		{object}	xerrors.ErrCode
+func (h *handler) PostVulnerabilityAllowlist(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+
+	var req types.PostVulnerabilityAllowlistRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, err.Error())
+	}
+	if req.ExpiresAt != nil && ptr.To(req.ExpiresAt) <= time.Now().UnixMilli() {
+		log.Error().Int64("ExpiresAt", ptr.To(req.ExpiresAt)).Msg("Expires at must be in the future")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, "Expires at must be in the future")
+	}
+
+	namespaceID, err := h.checkScope(ctx, user, req.NamespaceID, req.RepositoryID, enums.AuthAdmin)
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+
+	allowlistObj := &models.VulnerabilityAllowlist{
+		NamespaceID:     namespaceID,
+		RepositoryID:    req.RepositoryID,
+		UserID:          user.ID,
+		User:            ptr.To(user),
+		VulnerabilityID: req.VulnerabilityID,
+		Package:         req.Package,
+		Justification:   req.Justification,
+		ExpiresAt:       req.ExpiresAt,
+	}
+	err = query.Q.Transaction(func(tx *query.Query) error {
+		err = h.policyServiceFactory.New(tx).CreateVulnerabilityAllowlist(ctx, allowlistObj)
+		if err != nil {
+			log.Error().Err(err).Msg("Create vulnerability allowlist failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Create vulnerability allowlist failed: %v", err))
+		}
+		err = h.producerClient.Produce(ctx, enums.DaemonWebhook, types.DaemonWebhookPayload{
+			NamespaceID:  namespaceID,
+			Action:       enums.WebhookActionCreate,
+			ResourceType: enums.WebhookResourceTypeVulnerabilityAllowlist,
+			Payload: utils.MustMarshal(types.WebhookPayloadVulnerabilityAllowlist{
+				WebhookPayload: types.WebhookPayload{
+					ResourceType: enums.WebhookResourceTypeVulnerabilityAllowlist,
+					Action:       enums.WebhookActionCreate,
+				},
+				Allowlist: convertAllowlist(allowlistObj),
+			}),
+		}, definition.ProducerOption{Tx: tx})
+		if err != nil {
+			log.Error().Err(err).Msg("Webhook event produce failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Webhook event produce failed: %v", err))
+		}
+		return nil
+	})
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+
+	return c.JSON(http.StatusCreated, types.PostVulnerabilityAllowlistResponse{ID: allowlistObj.ID})
+}
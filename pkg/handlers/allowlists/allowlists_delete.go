@@ -0,0 +1,122 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package allowlists
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// DeleteVulnerabilityAllowlist handles the delete vulnerability allowlist request
+//
+//	@Summary	Delete vulnerability allowlist entry
+//	@security	BasicAuth
+//	@Tags		VulnerabilityAllowlist
+//	@Accept		json
+//	@Produce	json
+//	@Router		/vulnerability-allowlists/{id} [delete]
+//	@Param		id	path	int64	true	"Vulnerability allowlist id"
+//	@Success	204
+//	@Failure	401	{object}	xerrors.ErrCode
+//	@Failure	404	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) DeleteVulnerabilityAllowlist(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+
+	var req types.DeleteVulnerabilityAllowlistRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, err.Error())
+	}
+
+	allowlistObj, err := h.policyServiceFactory.New().GetVulnerabilityAllowlist(ctx, req.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Int64("id", req.ID).Msg("Vulnerability allowlist not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Vulnerability allowlist(%d) not found", req.ID))
+		}
+		log.Error().Err(err).Msg("Get vulnerability allowlist failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get vulnerability allowlist(%d) failed", req.ID))
+	}
+
+	_, err = h.checkScope(ctx, user, allowlistObj.NamespaceID, allowlistObj.RepositoryID, enums.AuthAdmin)
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+
+	err = query.Q.Transaction(func(tx *query.Query) error {
+		err = h.policyServiceFactory.New(tx).DeleteVulnerabilityAllowlist(ctx, allowlistObj.ID)
+		if err != nil {
+			log.Error().Err(err).Msg("Delete vulnerability allowlist failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Delete vulnerability allowlist failed: %v", err))
+		}
+		err = h.producerClient.Produce(ctx, enums.DaemonWebhook, types.DaemonWebhookPayload{
+			NamespaceID:  allowlistObj.NamespaceID,
+			Action:       enums.WebhookActionDelete,
+			ResourceType: enums.WebhookResourceTypeVulnerabilityAllowlist,
+			Payload: utils.MustMarshal(types.WebhookPayloadVulnerabilityAllowlist{
+				WebhookPayload: types.WebhookPayload{
+					ResourceType: enums.WebhookResourceTypeVulnerabilityAllowlist,
+					Action:       enums.WebhookActionDelete,
+				},
+				Allowlist: convertAllowlist(allowlistObj),
+			}),
+		}, definition.ProducerOption{Tx: tx})
+		if err != nil {
+			log.Error().Err(err).Msg("Webhook event produce failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Webhook event produce failed: %v", err))
+		}
+		return nil
+	})
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,90 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package allowlists
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// ListVulnerabilityAllowlist handles the list vulnerability allowlist request
+//
+//	@Summary	List vulnerability allowlist
+//	@security	BasicAuth
+//	@Tags		VulnerabilityAllowlist
+//	@Accept		json
+//	@Produce	json
+//	@Router		/vulnerability-allowlists/ [get]
+//	@Param		limit			query		int64	false	"limit"	minimum(10)	maximum(100)	default(10)
+//	@Param		page			query		int64	false	"page"	minimum(1)	default(1)
+//	@Param		sort			query		string	false	"sort field"
+//	@Param		method			query		string	false	"sort method"	Enums(asc, desc)
+//	@Param		namespace_id	query		int64	false	"filter by namespace id, list the system scope if namespace id and repository id are both empty"
+//	@Param		repository_id	query		int64	false	"filter by repository id"
+//	@Success	200				{object}	types.CommonList{items=[]types.VulnerabilityAllowlistItem}
+//	@Failure	401				{object}	xerrors.ErrCode
+//	@Failure	404				{object}	xerrors.ErrCode
+//	@Failure	500				{object}	xerrors.ErrCode
+func (h *handler) ListVulnerabilityAllowlist(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+
+	var req types.ListVulnerabilityAllowlistRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, err.Error())
+	}
+
+	_, err = h.checkScope(ctx, user, req.NamespaceID, req.RepositoryID, enums.AuthRead)
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+
+	allowlistObjs, total, err := h.policyServiceFactory.New().ListVulnerabilityAllowlist(ctx, req.NamespaceID, req.RepositoryID, req.Pagination, req.Sortable)
+	if err != nil {
+		log.Error().Err(err).Msg("List vulnerability allowlist failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, "List vulnerability allowlist failed")
+	}
+	var resp = make([]any, 0, len(allowlistObjs))
+	for _, allowlistObj := range allowlistObjs {
+		resp = append(resp, convertAllowlist(allowlistObj))
+	}
+	return c.JSON(http.StatusOK, types.CommonList{Total: total, Items: resp})
+}
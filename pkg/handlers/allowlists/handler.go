@@ -0,0 +1,108 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package allowlists
+
+import (
+	"path"
+	"reflect"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/go-sigma/sigma/pkg/auth"
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/handlers"
+	"github.com/go-sigma/sigma/pkg/middlewares"
+	"github.com/go-sigma/sigma/pkg/modules/workq"
+	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
+	"github.com/go-sigma/sigma/pkg/utils"
+)
+
+// Handler is the interface for the vulnerability allowlist handlers
+type Handler interface {
+	// ListVulnerabilityAllowlist handles the list vulnerability allowlist request
+	ListVulnerabilityAllowlist(c echo.Context) error
+	// PostVulnerabilityAllowlist handles the post vulnerability allowlist request
+	PostVulnerabilityAllowlist(c echo.Context) error
+	// DeleteVulnerabilityAllowlist handles the delete vulnerability allowlist request
+	DeleteVulnerabilityAllowlist(c echo.Context) error
+}
+
+var _ Handler = &handler{}
+
+type handler struct {
+	authServiceFactory       auth.AuthServiceFactory
+	repositoryServiceFactory dao.RepositoryServiceFactory
+	policyServiceFactory     dao.PolicyServiceFactory
+
+	producerClient definition.WorkQueueProducer
+}
+
+type inject struct {
+	authServiceFactory       auth.AuthServiceFactory
+	repositoryServiceFactory dao.RepositoryServiceFactory
+	policyServiceFactory     dao.PolicyServiceFactory
+
+	producerClient definition.WorkQueueProducer
+}
+
+// handlerNew creates a new instance of the vulnerability allowlist handlers
+func handlerNew(injects ...inject) Handler {
+	authServiceFactory := auth.NewAuthServiceFactory()
+	repositoryServiceFactory := dao.NewRepositoryServiceFactory()
+	policyServiceFactory := dao.NewPolicyServiceFactory()
+	producerClient := workq.ProducerClient
+	if len(injects) > 0 {
+		ij := injects[0]
+		if ij.authServiceFactory != nil {
+			authServiceFactory = ij.authServiceFactory
+		}
+		if ij.repositoryServiceFactory != nil {
+			repositoryServiceFactory = ij.repositoryServiceFactory
+		}
+		if ij.policyServiceFactory != nil {
+			policyServiceFactory = ij.policyServiceFactory
+		}
+		if ij.producerClient != nil {
+			producerClient = ij.producerClient
+		}
+	}
+	return &handler{
+		authServiceFactory:       authServiceFactory,
+		repositoryServiceFactory: repositoryServiceFactory,
+		policyServiceFactory:     policyServiceFactory,
+
+		producerClient: producerClient,
+	}
+}
+
+type factory struct{}
+
+// Initialize initializes the vulnerability allowlist handlers
+func (f factory) Initialize(e *echo.Echo) error {
+	allowlistGroup := e.Group(consts.APIV1+"/vulnerability-allowlists", middlewares.AuthWithConfig(middlewares.AuthConfig{}))
+
+	allowlistHandler := handlerNew()
+
+	allowlistGroup.GET("/", allowlistHandler.ListVulnerabilityAllowlist)
+	allowlistGroup.POST("/", allowlistHandler.PostVulnerabilityAllowlist)
+	allowlistGroup.DELETE("/:id", allowlistHandler.DeleteVulnerabilityAllowlist)
+
+	return nil
+}
+
+func init() {
+	utils.PanicIf(handlers.RegisterRouterFactory(path.Base(reflect.TypeOf(factory{}).PkgPath()), &factory{}))
+}
@@ -0,0 +1,99 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package allowlists
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// checkScope checks the user has the permission of the allowlist scope,
+// the repository scope is checked with the namespace which the repository belongs to.
+// It returns the namespace id of the scope, nil means system scope.
+func (h *handler) checkScope(ctx context.Context, user *models.User, namespaceID, repositoryID *int64, auth enums.Auth) (*int64, error) {
+	if repositoryID != nil {
+		repositoryObj, err := h.repositoryServiceFactory.New().Get(ctx, ptr.To(repositoryID))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Error().Err(err).Int64("RepositoryID", ptr.To(repositoryID)).Msg("Repository not found")
+				return nil, xerrors.HTTPErrCodeNotFound.Detail(fmt.Sprintf("Repository(%d) not found", ptr.To(repositoryID)))
+			}
+			log.Error().Err(err).Int64("RepositoryID", ptr.To(repositoryID)).Msg("Repository find failed")
+			return nil, xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Repository(%d) find failed: %v", ptr.To(repositoryID), err))
+		}
+		if namespaceID != nil && ptr.To(namespaceID) != repositoryObj.NamespaceID {
+			log.Error().Int64("NamespaceID", ptr.To(namespaceID)).Int64("RepositoryID", ptr.To(repositoryID)).Msg("Repository not belongs to the namespace")
+			return nil, xerrors.HTTPErrCodeBadRequest.Detail("Repository not belongs to the namespace")
+		}
+		namespaceID = ptr.Of(repositoryObj.NamespaceID)
+	}
+	if namespaceID == nil {
+		if !(user.Role == enums.UserRoleAdmin || user.Role == enums.UserRoleRoot) {
+			return nil, xerrors.HTTPErrCodeUnauthorized.Detail("No permission with this api")
+		}
+		return nil, nil
+	}
+	authChecked, err := h.authServiceFactory.New().Namespace(ptr.To(user), ptr.To(namespaceID), auth)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Int64("NamespaceID", ptr.To(namespaceID)).Msg("Namespace not found")
+			return nil, xerrors.HTTPErrCodeNotFound.Detail(fmt.Sprintf("Namespace(%d) not found", ptr.To(namespaceID)))
+		}
+		log.Error().Err(err).Int64("NamespaceID", ptr.To(namespaceID)).Msg("Namespace find failed")
+		return nil, xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Namespace(%d) find failed: %v", ptr.To(namespaceID), err))
+	}
+	if !authChecked {
+		log.Error().Int64("UserID", user.ID).Int64("NamespaceID", ptr.To(namespaceID)).Msg("Auth check failed")
+		return nil, xerrors.HTTPErrCodeUnauthorized.Detail("No permission with this api")
+	}
+	return namespaceID, nil
+}
+
+// convertAllowlist converts the vulnerability allowlist model to the api item
+func convertAllowlist(allowlistObj *models.VulnerabilityAllowlist) types.VulnerabilityAllowlistItem {
+	item := types.VulnerabilityAllowlistItem{
+		ID:              allowlistObj.ID,
+		Scope:           enums.VulnerabilityAllowlistScopeSystem,
+		NamespaceID:     allowlistObj.NamespaceID,
+		RepositoryID:    allowlistObj.RepositoryID,
+		VulnerabilityID: allowlistObj.VulnerabilityID,
+		Package:         allowlistObj.Package,
+		Justification:   allowlistObj.Justification,
+		Creator:         allowlistObj.User.Username,
+		CreatedAt:       time.Unix(0, int64(time.Millisecond)*allowlistObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt:       time.Unix(0, int64(time.Millisecond)*allowlistObj.UpdatedAt).UTC().Format(consts.DefaultTimePattern),
+	}
+	if allowlistObj.RepositoryID != nil {
+		item.Scope = enums.VulnerabilityAllowlistScopeRepository
+	} else if allowlistObj.NamespaceID != nil {
+		item.Scope = enums.VulnerabilityAllowlistScopeNamespace
+	}
+	if allowlistObj.ExpiresAt != nil {
+		item.ExpiresAt = ptr.Of(time.Unix(0, int64(time.Millisecond)*ptr.To(allowlistObj.ExpiresAt)).UTC().Format(consts.DefaultTimePattern))
+	}
+	return item
+}
@@ -0,0 +1,139 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifact
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/signing"
+	cosignverify "github.com/go-sigma/sigma/pkg/signing/cosign/verify"
+	"github.com/go-sigma/sigma/pkg/signing/definition"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// ListArtifactAttestations handles the list artifact in-toto attestations request
+//
+//	@Summary	List artifact in-toto attestations
+//	@security	BasicAuth
+//	@Tags		Artifact
+//	@Accept		json
+//	@Produce	json
+//	@Router		/artifacts/{id}/attestations [get]
+//	@Param		id				path		number	true	"Artifact id"
+//	@Param		predicate_type	query		string	false	"Filter by the predicate type"
+//	@Success	200				{object}	types.CommonList{items=[]types.ArtifactAttestationItem}
+//	@Failure	400				{object}	xerrors.ErrCode
+//	@Failure	401				{object}	xerrors.ErrCode
+//	@Failure	404				{object}	xerrors.ErrCode
+//	@Failure	500				{object}	xerrors.ErrCode
+func (h *handler) ListArtifactAttestations(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+
+	var req types.ListArtifactAttestationRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, err.Error())
+	}
+
+	artifactObj, err := h.getArtifact(ctx, user, req.ID)
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+
+	attestations, err := signing.ListAttestations(ctx, h.artifactServiceFactory.New(), h.tagServiceFactory.New(), artifactObj)
+	if err != nil {
+		log.Error().Err(err).Int64("ArtifactID", artifactObj.ID).Msg("List artifact attestations failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("List artifact attestations failed: %v", err))
+	}
+
+	verifier, err := h.attestationVerifier(ctx, artifactObj.NamespaceID)
+	if err != nil {
+		log.Error().Err(err).Int64("NamespaceID", artifactObj.NamespaceID).Msg("Create attestation verifier failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Create attestation verifier failed: %v", err))
+	}
+
+	var resp = make([]any, 0, len(attestations))
+	for _, attestation := range attestations {
+		item, err := signing.InspectAttestation(attestation, verifier)
+		if err != nil {
+			log.Warn().Err(err).Str("digest", attestation.Digest).Msg("Inspect artifact attestation failed")
+			continue
+		}
+		if req.PredicateType != nil && item.PredicateType != ptr.To(req.PredicateType) {
+			continue
+		}
+		resp = append(resp, item)
+	}
+
+	return c.JSON(http.StatusOK, types.CommonList{Total: int64(len(resp)), Items: resp})
+}
+
+// attestationVerifier creates the attestation verifier with the trusted materials of the namespace signature policy
+// and the public keys of the namespace signing keys.
+func (h *handler) attestationVerifier(ctx context.Context, namespaceID int64) (definition.EnvelopeVerifying, error) {
+	var opt cosignverify.Options
+	policyObj, err := h.policyServiceFactory.New().GetSignaturePolicy(ctx, namespaceID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if policyObj != nil {
+		opt.PublicKeys = policyObj.PublicKeys
+		opt.Roots = policyObj.KeylessRoots
+		if len(policyObj.KeylessIdentities) > 0 {
+			err = json.Unmarshal(policyObj.KeylessIdentities, &opt.Identities)
+			if err != nil {
+				return nil, fmt.Errorf("unmarshal keyless identities failed: %w", err)
+			}
+		}
+	}
+	signingKeyObjs, err := h.signingKeyServiceFactory.New().ListByNamespace(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, signingKeyObj := range signingKeyObjs {
+		opt.PublicKeys = strings.TrimSpace(opt.PublicKeys + "\n" + signingKeyObj.PublicKey)
+	}
+	return cosignverify.NewEnvelope(opt)
+}
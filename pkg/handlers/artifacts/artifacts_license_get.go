@@ -0,0 +1,114 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifact
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/license"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// GetArtifactLicense handles the get artifact license check result request
+//
+//	@Summary	Get artifact license check result
+//	@security	BasicAuth
+//	@Tags		Artifact
+//	@Accept		json
+//	@Produce	json
+//	@Router		/artifacts/{id}/licenses [get]
+//	@Param		id	path		number	true	"Artifact id"
+//	@Success	200	{object}	types.LicenseCheckResult
+//	@Failure	400	{object}	xerrors.ErrCode
+//	@Failure	401	{object}	xerrors.ErrCode
+//	@Failure	404	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) GetArtifactLicense(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+
+	var req types.GetArtifactLicenseRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, err.Error())
+	}
+
+	artifactObj, err := h.getArtifact(ctx, user, req.ID)
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+
+	sbomObj, err := h.artifactServiceFactory.New().GetSbom(ctx, artifactObj.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error().Err(err).Int64("ArtifactID", artifactObj.ID).Msg("Get artifact sbom failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get artifact sbom failed: %v", err))
+	}
+
+	// the policy may be changed after the sbom is checked, so check the packages with the current policy
+	policyObj, err := h.policyServiceFactory.New().GetLicensePolicy(ctx, artifactObj.NamespaceID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error().Err(err).Int64("NamespaceID", artifactObj.NamespaceID).Msg("Get license policy failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get license policy failed: %v", err))
+	}
+	if sbomObj != nil && sbomObj.Status == enums.TaskCommonStatusSuccess && policyObj != nil && policyObj.Enabled {
+		packageObjs, err := h.artifactServiceFactory.New().ListPackages(ctx, artifactObj.ID)
+		if err != nil {
+			log.Error().Err(err).Int64("ArtifactID", artifactObj.ID).Msg("List artifact packages failed")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("List artifact packages failed: %v", err))
+		}
+		return c.JSON(http.StatusOK, license.Check(policyObj, packageObjs))
+	}
+
+	if sbomObj == nil || sbomObj.LicenseStatus == nil || len(sbomObj.LicenseResult) == 0 {
+		log.Error().Int64("ArtifactID", artifactObj.ID).Msg("Artifact license has not been checked")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Artifact(%d) license has not been checked", artifactObj.ID))
+	}
+
+	var result types.LicenseCheckResult
+	err = json.Unmarshal(sbomObj.LicenseResult, &result)
+	if err != nil {
+		log.Error().Err(err).Int64("ArtifactID", artifactObj.ID).Msg("Unmarshal license result failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Unmarshal license result failed: %v", err))
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
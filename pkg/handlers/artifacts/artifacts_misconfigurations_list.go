@@ -0,0 +1,131 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifact
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/scanner/trivy"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/compress"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// ListArtifactMisconfigurations handles the list artifact misconfiguration scan findings request
+//
+//	@Summary	List artifact misconfiguration scan findings
+//	@security	BasicAuth
+//	@Tags		Artifact
+//	@Accept		json
+//	@Produce	json
+//	@Router		/artifacts/{id}/misconfigurations [get]
+//	@Param		id			path		number	true	"Artifact id"
+//	@Param		limit		query		int64	false	"limit"					minimum(10)	maximum(100)	default(10)
+//	@Param		page		query		int64	false	"page"					minimum(1)	default(1)
+//	@Param		severity	query		string	false	"filter by severity"	Enums(None, Low, Medium, High, Critical)
+//	@Success	200			{object}	types.ListArtifactMisconfigurationResponse
+//	@Failure	400			{object}	xerrors.ErrCode
+//	@Failure	401			{object}	xerrors.ErrCode
+//	@Failure	404			{object}	xerrors.ErrCode
+//	@Failure	500			{object}	xerrors.ErrCode
+func (h *handler) ListArtifactMisconfigurations(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+
+	var req types.ListArtifactContentScanRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, err.Error())
+	}
+	req.Pagination = utils.NormalizePagination(req.Pagination)
+
+	artifactObj, err := h.getArtifact(ctx, user, req.ID)
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+
+	misconfigurationObj, err := h.artifactServiceFactory.New().GetMisconfiguration(ctx, artifactObj.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Int64("ArtifactID", artifactObj.ID).Msg("Artifact misconfiguration scan not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Artifact(%d) misconfiguration scan not found", artifactObj.ID))
+		}
+		log.Error().Err(err).Int64("ArtifactID", artifactObj.ID).Msg("Get artifact misconfiguration scan failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get artifact misconfiguration scan failed: %v", err))
+	}
+
+	var resp = types.ListArtifactMisconfigurationResponse{Status: misconfigurationObj.Status, Items: make([]types.MisconfigurationReportItem, 0)}
+	if misconfigurationObj.Status != enums.TaskCommonStatusSuccess || len(misconfigurationObj.Raw) == 0 {
+		return c.JSON(http.StatusOK, resp)
+	}
+	if len(misconfigurationObj.Result) > 0 {
+		err = json.Unmarshal(misconfigurationObj.Result, &resp.Summary)
+		if err != nil {
+			log.Error().Err(err).Int64("ArtifactID", artifactObj.ID).Msg("Unmarshal misconfiguration summary failed")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Unmarshal misconfiguration summary failed: %v", err))
+		}
+	}
+	raw, err := compress.Decompress(misconfigurationObj.Raw)
+	if err != nil {
+		log.Error().Err(err).Int64("ArtifactID", artifactObj.ID).Msg("Decompress misconfiguration report failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Decompress misconfiguration report failed: %v", err))
+	}
+	items, err := trivy.ParseMisconfigurations([]byte(raw))
+	if err != nil {
+		log.Error().Err(err).Int64("ArtifactID", artifactObj.ID).Msg("Parse misconfiguration report failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Parse misconfiguration report failed: %v", err))
+	}
+	var filtered = make([]types.MisconfigurationReportItem, 0, len(items))
+	for _, item := range items {
+		if req.Severity != nil && item.Severity != ptr.To(req.Severity) {
+			continue
+		}
+		filtered = append(filtered, item)
+	}
+
+	var total = len(filtered)
+	var start = min((ptr.To(req.Page)-1)*ptr.To(req.Limit), total)
+	var end = min(start+ptr.To(req.Limit), total)
+	resp.Total = int64(total)
+	resp.Items = filtered[start:end]
+	return c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,131 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifact
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/constraint"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// SearchArtifactPackages handles the search package in all of the artifact sboms request
+//
+//	@Summary	Search the artifacts contain the package
+//	@security	BasicAuth
+//	@Tags		Artifact
+//	@Accept		json
+//	@Produce	json
+//	@Router		/artifacts/packages [get]
+//	@Param		limit			query		int64	false	"limit"	minimum(10)	maximum(100)	default(10)
+//	@Param		page			query		int64	false	"page"	minimum(1)	default(1)
+//	@Param		name			query		string	true	"package name"
+//	@Param		version			query		string	false	"version constraint, e.g. >=1.0, <2.0 || >=3.0"
+//	@Param		type			query		string	false	"package type, e.g. apk, deb, go-module"
+//	@Param		namespace_id	query		int64	false	"filter by namespace id"
+//	@Success	200				{object}	types.CommonList{items=[]types.ArtifactPackageItem}
+//	@Failure	400				{object}	xerrors.ErrCode
+//	@Failure	401				{object}	xerrors.ErrCode
+//	@Failure	500				{object}	xerrors.ErrCode
+func (h *handler) SearchArtifactPackages(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+
+	var req types.SearchArtifactPackageRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, err.Error())
+	}
+	req.Pagination = utils.NormalizePagination(req.Pagination)
+
+	artifactService := h.artifactServiceFactory.New()
+
+	var versions []string // nil means all of the versions are matched
+	if req.Version != nil && *req.Version != "" {
+		versionConstraint, err := constraint.Parse(ptr.To(req.Version))
+		if err != nil {
+			log.Error().Err(err).Str("version", ptr.To(req.Version)).Msg("Parse version constraint failed")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, err.Error())
+		}
+		// the version constraint cannot be evaluated in the database, so check the distinct versions first
+		allVersions, err := artifactService.ListPackageVersions(ctx, user.ID, req.Name, req.Type, req.NamespaceID)
+		if err != nil {
+			log.Error().Err(err).Str("name", req.Name).Msg("List artifact package versions failed")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, err.Error())
+		}
+		versions = make([]string, 0, len(allVersions))
+		for _, version := range allVersions {
+			if versionConstraint.Check(version) {
+				versions = append(versions, version)
+			}
+		}
+		if len(versions) == 0 {
+			return c.JSON(http.StatusOK, types.CommonList{Total: 0, Items: make([]any, 0)})
+		}
+	}
+
+	packageObjs, total, err := artifactService.SearchPackages(ctx, user.ID, req.Name, req.Type, req.NamespaceID, versions, req.Pagination)
+	if err != nil {
+		log.Error().Err(err).Str("name", req.Name).Msg("Search artifact packages failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, err.Error())
+	}
+
+	var resp = make([]any, 0, len(packageObjs))
+	for _, packageObj := range packageObjs {
+		item := types.ArtifactPackageItem{
+			Name:         packageObj.Name,
+			Version:      packageObj.Version,
+			Type:         packageObj.Type,
+			Purl:         packageObj.Purl,
+			NamespaceID:  packageObj.NamespaceID,
+			RepositoryID: packageObj.RepositoryID,
+			ArtifactID:   packageObj.ArtifactID,
+			Tags:         make([]string, 0),
+		}
+		if packageObj.Namespace != nil {
+			item.Namespace = packageObj.Namespace.Name
+		}
+		if packageObj.Repository != nil {
+			item.Repository = packageObj.Repository.Name
+		}
+		if packageObj.Artifact != nil {
+			item.Digest = packageObj.Artifact.Digest
+			for _, tag := range packageObj.Artifact.Tags {
+				item.Tags = append(item.Tags, tag.Name)
+			}
+		}
+		resp = append(resp, item)
+	}
+	return c.JSON(http.StatusOK, types.CommonList{Total: total, Items: resp})
+}
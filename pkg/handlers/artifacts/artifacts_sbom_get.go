@@ -0,0 +1,103 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifact
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/utils/sbom"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// GetArtifactSbom handles the get artifact sbom request
+//
+//	@Summary	Get artifact sbom in spdx or cyclonedx format
+//	@security	BasicAuth
+//	@Tags		Artifact
+//	@Accept		json
+//	@Produce	json
+//	@Router		/artifacts/{id}/sbom [get]
+//	@Param		id		path	number	true	"Artifact id"
+//	@Param		format	query	string	false	"sbom format, default is spdx-json"	Enums(spdx-json, cyclonedx-json)
+//	@Success	200
+//	@Failure	400	{object}	xerrors.ErrCode
+//	@Failure	401	{object}	xerrors.ErrCode
+//	@Failure	404	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) GetArtifactSbom(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+
+	var req types.GetArtifactSbomRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, err.Error())
+	}
+	format := ptr.To(req.Format)
+	if format == "" {
+		format = enums.SbomFormatSpdxJson
+	}
+
+	artifactObj, err := h.getArtifact(ctx, user, req.ID)
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+
+	sbomObj, err := h.artifactServiceFactory.New().GetSbom(ctx, artifactObj.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error().Err(err).Int64("ArtifactID", artifactObj.ID).Msg("Get artifact sbom failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get artifact sbom failed: %v", err))
+	}
+	if sbomObj == nil || sbomObj.Status != enums.TaskCommonStatusSuccess || len(sbomObj.Raw) == 0 {
+		log.Error().Int64("ArtifactID", artifactObj.ID).Msg("Artifact sbom has not been generated")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Artifact(%d) sbom has not been generated", artifactObj.ID))
+	}
+
+	content, err := sbom.Convert(sbomObj.Raw, format)
+	if err != nil {
+		log.Error().Err(err).Str("format", format.String()).Msg("Convert sbom failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Convert sbom failed: %v", err))
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("sbom-%d.%s", artifactObj.ID, sbom.Extension(format))))
+	return c.Blob(http.StatusOK, sbom.MediaType(format), content)
+}
@@ -0,0 +1,93 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifact
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/signing"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// ListArtifactSignatures handles the list artifact signatures request
+//
+//	@Summary	List artifact cosign and notation signatures
+//	@security	BasicAuth
+//	@Tags		Artifact
+//	@Accept		json
+//	@Produce	json
+//	@Router		/artifacts/{id}/signatures [get]
+//	@Param		id	path		number	true	"Artifact id"
+//	@Success	200	{object}	types.CommonList{items=[]types.ArtifactSignatureItem}
+//	@Failure	400	{object}	xerrors.ErrCode
+//	@Failure	401	{object}	xerrors.ErrCode
+//	@Failure	404	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) ListArtifactSignatures(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+
+	var req types.ListArtifactSignatureRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, err.Error())
+	}
+
+	artifactObj, err := h.getArtifact(ctx, user, req.ID)
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+
+	signatures, err := signing.ListSignatures(ctx, h.artifactServiceFactory.New(), h.tagServiceFactory.New(), artifactObj.RepositoryID, artifactObj.Digest)
+	if err != nil {
+		log.Error().Err(err).Int64("ArtifactID", artifactObj.ID).Msg("List artifact signatures failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("List artifact signatures failed: %v", err))
+	}
+
+	var resp = make([]any, 0, len(signatures))
+	for _, signature := range signatures {
+		item, err := signing.Inspect(signature)
+		if err != nil {
+			log.Warn().Err(err).Str("digest", signature.Digest).Msg("Inspect artifact signature failed")
+		}
+		resp = append(resp, item)
+	}
+
+	return c.JSON(http.StatusOK, types.CommonList{Total: int64(len(resp)), Items: resp})
+}
@@ -0,0 +1,109 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifact
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/report"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// ExportArtifactVulnerabilities handles the export artifact vulnerabilities request
+//
+//	@Summary	Export artifact vulnerabilities
+//	@security	BasicAuth
+//	@Tags		Artifact
+//	@Accept		json
+//	@Produce	json
+//	@Produce	text/csv
+//	@Router		/artifacts/{id}/vulnerabilities/export [get]
+//	@Param		id			path	number	true	"Artifact id"
+//	@Param		format		query	string	true	"export format"			Enums(sarif, csv, cyclonedx-vex)
+//	@Param		sort		query	string	false	"sort field"			Enums(id, package, severity, cvss)
+//	@Param		method		query	string	false	"sort method"			Enums(asc, desc)
+//	@Param		severity	query	string	false	"filter by severity"	Enums(None, Low, Medium, High, Critical)
+//	@Param		package		query	string	false	"filter by package name"
+//	@Param		keyword		query	string	false	"filter by vulnerability id or title"
+//	@Param		fixable		query	bool	false	"filter by the fixed version available or not"
+//	@Success	200
+//	@Failure	400	{object}	xerrors.ErrCode
+//	@Failure	401	{object}	xerrors.ErrCode
+//	@Failure	404	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) ExportArtifactVulnerabilities(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+
+	var req types.ExportArtifactVulnerabilityRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, err.Error())
+	}
+
+	artifactObj, items, err := h.getVulnerabilityReport(ctx, user, req.ID)
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+	items = report.Filter(items, req.VulnerabilityReportFilter)
+	report.Sort(items, req.Sortable)
+
+	name := fmt.Sprintf("%s@%s", artifactObj.Repository.Name, artifactObj.Digest)
+	var content []byte
+	var contentType, extension string
+	switch req.Format {
+	case enums.VulnerabilityReportFormatSarif:
+		content, err = report.Sarif(name, artifactObj.Vulnerability.Scanner, items)
+		contentType, extension = echo.MIMEApplicationJSON, "sarif"
+	case enums.VulnerabilityReportFormatCsv:
+		content, err = report.Csv(items)
+		contentType, extension = "text/csv", "csv"
+	case enums.VulnerabilityReportFormatCyclonedxVex:
+		content, err = report.CycloneDXVex(name, items)
+		contentType, extension = "application/vnd.cyclonedx+json", "cdx.json"
+	}
+	if err != nil {
+		log.Error().Err(err).Str("format", req.Format.String()).Msg("Export vulnerability report failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Export vulnerability report failed: %v", err))
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("vulnerabilities-%d.%s", artifactObj.ID, extension)))
+	return c.Blob(http.StatusOK, contentType, content)
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifact
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/utils/report"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// ListArtifactVulnerabilities handles the list artifact vulnerabilities request
+//
+//	@Summary	List artifact vulnerabilities
+//	@security	BasicAuth
+//	@Tags		Artifact
+//	@Accept		json
+//	@Produce	json
+//	@Router		/artifacts/{id}/vulnerabilities [get]
+//	@Param		id			path		number	true	"Artifact id"
+//	@Param		limit		query		int64	false	"limit"					minimum(10)	maximum(100)	default(10)
+//	@Param		page		query		int64	false	"page"					minimum(1)	default(1)
+//	@Param		sort		query		string	false	"sort field"			Enums(id, package, severity, cvss)
+//	@Param		method		query		string	false	"sort method"			Enums(asc, desc)
+//	@Param		severity	query		string	false	"filter by severity"	Enums(None, Low, Medium, High, Critical)
+//	@Param		package		query		string	false	"filter by package name"
+//	@Param		keyword		query		string	false	"filter by vulnerability id or title"
+//	@Param		fixable		query		bool	false	"filter by the fixed version available or not"
+//	@Success	200			{object}	types.CommonList{items=[]types.VulnerabilityReportItem}
+//	@Failure	400			{object}	xerrors.ErrCode
+//	@Failure	401			{object}	xerrors.ErrCode
+//	@Failure	404			{object}	xerrors.ErrCode
+//	@Failure	500			{object}	xerrors.ErrCode
+func (h *handler) ListArtifactVulnerabilities(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+
+	var req types.ListArtifactVulnerabilityRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, err.Error())
+	}
+	req.Pagination = utils.NormalizePagination(req.Pagination)
+
+	_, items, err := h.getVulnerabilityReport(ctx, user, req.ID)
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+	items = report.Filter(items, req.VulnerabilityReportFilter)
+	report.Sort(items, req.Sortable)
+
+	var total = len(items)
+	var start = min((ptr.To(req.Page)-1)*ptr.To(req.Limit), total)
+	var end = min(start+ptr.To(req.Limit), total)
+	var resp = make([]any, 0, end-start)
+	for _, item := range items[start:end] {
+		resp = append(resp, item)
+	}
+	return c.JSON(http.StatusOK, types.CommonList{Total: int64(total), Items: resp})
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/go-sigma/sigma/pkg/auth"
 	"github.com/go-sigma/sigma/pkg/consts"
 	"github.com/go-sigma/sigma/pkg/dal/dao"
 	"github.com/go-sigma/sigma/pkg/handlers"
@@ -35,32 +36,62 @@ type Handler interface {
 	GetArtifact(c echo.Context) error
 	// DeleteArtifact handles the delete artifact request
 	DeleteArtifact(c echo.Context) error
+	// ListArtifactVulnerabilities handles the list artifact vulnerabilities request
+	ListArtifactVulnerabilities(c echo.Context) error
+	// ExportArtifactVulnerabilities handles the export artifact vulnerabilities request
+	ExportArtifactVulnerabilities(c echo.Context) error
+	// GetArtifactSbom handles the get artifact sbom request
+	GetArtifactSbom(c echo.Context) error
+	// GetArtifactLicense handles the get artifact license check result request
+	GetArtifactLicense(c echo.Context) error
+	// ListArtifactSecrets handles the list artifact secret scan findings request
+	ListArtifactSecrets(c echo.Context) error
+	// ListArtifactMisconfigurations handles the list artifact misconfiguration scan findings request
+	ListArtifactMisconfigurations(c echo.Context) error
+	// ListArtifactSignatures handles the list artifact signatures request
+	ListArtifactSignatures(c echo.Context) error
+	// ListArtifactAttestations handles the list artifact in-toto attestations request
+	ListArtifactAttestations(c echo.Context) error
+	// SearchArtifactPackages handles the search package in all of the artifact sboms request
+	SearchArtifactPackages(c echo.Context) error
 }
 
 var _ Handler = &handler{}
 
 type handler struct {
+	authServiceFactory       auth.AuthServiceFactory
 	namespaceServiceFactory  dao.NamespaceServiceFactory
 	artifactServiceFactory   dao.ArtifactServiceFactory
 	tagServiceFactory        dao.TagServiceFactory
 	repositoryServiceFactory dao.RepositoryServiceFactory
+	policyServiceFactory     dao.PolicyServiceFactory
+	signingKeyServiceFactory dao.SigningKeyServiceFactory
 }
 
 type inject struct {
+	authServiceFactory       auth.AuthServiceFactory
 	namespaceServiceFactory  dao.NamespaceServiceFactory
 	artifactServiceFactory   dao.ArtifactServiceFactory
 	tagServiceFactory        dao.TagServiceFactory
 	repositoryServiceFactory dao.RepositoryServiceFactory
+	policyServiceFactory     dao.PolicyServiceFactory
+	signingKeyServiceFactory dao.SigningKeyServiceFactory
 }
 
 // handlerNew creates a new instance of the distribution handlers
 func handlerNew(injects ...inject) Handler {
+	authServiceFactory := auth.NewAuthServiceFactory()
 	namespaceServiceFactory := dao.NewNamespaceServiceFactory()
 	tagServiceFactory := dao.NewTagServiceFactory()
 	artifactServiceFactory := dao.NewArtifactServiceFactory()
 	repositoryServiceFactory := dao.NewRepositoryServiceFactory()
+	policyServiceFactory := dao.NewPolicyServiceFactory()
+	signingKeyServiceFactory := dao.NewSigningKeyServiceFactory()
 	if len(injects) > 0 {
 		ij := injects[0]
+		if ij.authServiceFactory != nil {
+			authServiceFactory = ij.authServiceFactory
+		}
 		if ij.namespaceServiceFactory != nil {
 			namespaceServiceFactory = ij.namespaceServiceFactory
 		}
@@ -73,12 +104,21 @@ func handlerNew(injects ...inject) Handler {
 		if ij.repositoryServiceFactory != nil {
 			repositoryServiceFactory = ij.repositoryServiceFactory
 		}
+		if ij.policyServiceFactory != nil {
+			policyServiceFactory = ij.policyServiceFactory
+		}
+		if ij.signingKeyServiceFactory != nil {
+			signingKeyServiceFactory = ij.signingKeyServiceFactory
+		}
 	}
 	return &handler{
+		authServiceFactory:       authServiceFactory,
 		namespaceServiceFactory:  namespaceServiceFactory,
 		tagServiceFactory:        tagServiceFactory,
 		artifactServiceFactory:   artifactServiceFactory,
 		repositoryServiceFactory: repositoryServiceFactory,
+		policyServiceFactory:     policyServiceFactory,
+		signingKeyServiceFactory: signingKeyServiceFactory,
 	}
 }
 
@@ -91,6 +131,17 @@ func (f factory) Initialize(e *echo.Echo) error {
 	artifactGroup.GET("/", artifactHandler.ListArtifact)
 	artifactGroup.GET("/:digest", artifactHandler.GetArtifact)
 	artifactGroup.DELETE("/:digest", artifactHandler.DeleteArtifact)
+
+	artifactIDGroup := e.Group(consts.APIV1+"/artifacts", middlewares.AuthWithConfig(middlewares.AuthConfig{}))
+	artifactIDGroup.GET("/packages", artifactHandler.SearchArtifactPackages)
+	artifactIDGroup.GET("/:id/vulnerabilities", artifactHandler.ListArtifactVulnerabilities)
+	artifactIDGroup.GET("/:id/vulnerabilities/export", artifactHandler.ExportArtifactVulnerabilities)
+	artifactIDGroup.GET("/:id/sbom", artifactHandler.GetArtifactSbom)
+	artifactIDGroup.GET("/:id/licenses", artifactHandler.GetArtifactLicense)
+	artifactIDGroup.GET("/:id/secrets", artifactHandler.ListArtifactSecrets)
+	artifactIDGroup.GET("/:id/signatures", artifactHandler.ListArtifactSignatures)
+	artifactIDGroup.GET("/:id/attestations", artifactHandler.ListArtifactAttestations)
+	artifactIDGroup.GET("/:id/misconfigurations", artifactHandler.ListArtifactMisconfigurations)
 	return nil
 }
 
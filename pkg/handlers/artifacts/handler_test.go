@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	authmocks "github.com/go-sigma/sigma/pkg/auth/mocks"
 	daomocks "github.com/go-sigma/sigma/pkg/dal/dao/mocks"
 )
 
@@ -32,8 +33,12 @@ func TestFactory(t *testing.T) {
 	daoMockArtifactServiceFactory := daomocks.NewMockArtifactServiceFactory(ctrl)
 	daoMockNamespaceServiceFactory := daomocks.NewMockNamespaceServiceFactory(ctrl)
 	daoMockRepositoryServiceFactory := daomocks.NewMockRepositoryServiceFactory(ctrl)
+	daoMockPolicyServiceFactory := daomocks.NewMockPolicyServiceFactory(ctrl)
+	authMockServiceFactory := authmocks.NewMockAuthServiceFactory(ctrl)
 
 	handler := handlerNew(inject{
+		authServiceFactory:       authMockServiceFactory,
+		policyServiceFactory:     daoMockPolicyServiceFactory,
 		tagServiceFactory:        daoMockTagServiceFactory,
 		artifactServiceFactory:   daoMockArtifactServiceFactory,
 		namespaceServiceFactory:  daoMockNamespaceServiceFactory,
@@ -0,0 +1,92 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifact
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/utils/report"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// getArtifact gets the artifact and checks the user has the read permission of the artifact's namespace
+func (h *handler) getArtifact(ctx context.Context, user *models.User, id int64) (*models.Artifact, error) {
+	artifactService := h.artifactServiceFactory.New()
+	artifactObj, err := artifactService.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Int64("ArtifactID", id).Msg("Artifact not found")
+			return nil, xerrors.HTTPErrCodeNotFound.Detail(fmt.Sprintf("Artifact(%d) not found", id))
+		}
+		log.Error().Err(err).Int64("ArtifactID", id).Msg("Get artifact failed")
+		return nil, xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Get artifact(%d) failed: %v", id, err))
+	}
+
+	authChecked, err := h.authServiceFactory.New().Namespace(ptr.To(user), artifactObj.NamespaceID, enums.AuthRead)
+	if err != nil {
+		log.Error().Err(err).Int64("NamespaceID", artifactObj.NamespaceID).Msg("Auth check failed")
+		return nil, xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Auth check failed: %v", err))
+	}
+	if !authChecked {
+		log.Error().Int64("UserID", user.ID).Int64("NamespaceID", artifactObj.NamespaceID).Msg("Auth check failed")
+		return nil, xerrors.HTTPErrCodeUnauthorized.Detail("No permission with this api")
+	}
+
+	return artifactObj, nil
+}
+
+// getVulnerabilityReport gets the artifact and the normalized findings parsed from the stored scanner report,
+// the findings matched the active allowlist are marked as suppressed.
+func (h *handler) getVulnerabilityReport(ctx context.Context, user *models.User, id int64) (*models.Artifact, []types.VulnerabilityReportItem, error) {
+	artifactObj, err := h.getArtifact(ctx, user, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	artifactService := h.artifactServiceFactory.New()
+	vulnerabilityObj, err := artifactService.GetVulnerability(ctx, artifactObj.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error().Err(err).Int64("ArtifactID", id).Msg("Get artifact vulnerability failed")
+		return nil, nil, xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Get artifact vulnerability failed: %v", err))
+	}
+	if vulnerabilityObj == nil || vulnerabilityObj.Status != enums.TaskCommonStatusSuccess || len(vulnerabilityObj.Raw) == 0 {
+		log.Error().Int64("ArtifactID", id).Msg("Artifact has not been scanned")
+		return nil, nil, xerrors.HTTPErrCodeNotFound.Detail(fmt.Sprintf("Artifact(%d) has not been scanned", id))
+	}
+
+	allowlist, err := h.policyServiceFactory.New().ListActiveVulnerabilityAllowlist(ctx, artifactObj.NamespaceID, artifactObj.RepositoryID)
+	if err != nil {
+		log.Error().Err(err).Msg("List vulnerability allowlist failed")
+		return nil, nil, xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("List vulnerability allowlist failed: %v", err))
+	}
+
+	artifactObj.Vulnerability = ptr.To(vulnerabilityObj)
+
+	items, err := report.Parse(vulnerabilityObj.Raw, vulnerabilityObj.Scanner, allowlist)
+	if err != nil {
+		log.Error().Err(err).Int64("ArtifactID", id).Msg("Parse vulnerability report failed")
+		return nil, nil, xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Parse vulnerability report failed: %v", err))
+	}
+	return artifactObj, items, nil
+}
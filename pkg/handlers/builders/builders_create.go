@@ -139,6 +139,8 @@ func (h *handler) CreateBuilder(c echo.Context) error {
 		BuildkitContext:            req.BuildkitContext,
 		BuildkitDockerfile:         req.BuildkitDockerfile,
 		BuildkitPlatforms:          utils.StringsJoin(req.BuildkitPlatforms, ","),
+
+		SigningType: ptr.ToDef(req.SigningType, enums.SigningTypeCosign),
 	}
 	if builderObj.Source == enums.BuilderSourceCodeRepository && req.ScmCredentialType == nil {
 		codeRepositoryService := h.codeRepositoryServiceFactory.New()
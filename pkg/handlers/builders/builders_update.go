@@ -88,6 +88,9 @@ func (h *handler) UpdateBuilder(c echo.Context) error {
 		query.Builder.BuildkitDockerfile.ColumnName().String():         req.BuildkitDockerfile,
 		query.Builder.BuildkitPlatforms.ColumnName().String():          utils.StringsJoin(req.BuildkitPlatforms, ","),
 	}
+	if req.SigningType != nil {
+		updates[query.Builder.SigningType.ColumnName().String()] = ptr.To(req.SigningType)
+	}
 	if req.Source == enums.BuilderSourceCodeRepository && req.ScmCredentialType == nil {
 		codeRepositoryService := h.codeRepositoryServiceFactory.New()
 		codeRepositoryObj, err := codeRepositoryService.Get(ctx, ptr.To(req.CodeRepositoryID))
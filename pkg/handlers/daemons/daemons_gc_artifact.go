@@ -82,6 +82,7 @@ func (h *handler) UpdateGcArtifactRule(c echo.Context) error {
 	}
 	updates := make(map[string]any, 5)
 	updates[query.DaemonGcArtifactRule.RetentionDay.ColumnName().String()] = req.RetentionDay
+	updates[query.DaemonGcArtifactRule.ReferrerRetentionDay.ColumnName().String()] = req.ReferrerRetentionDay
 	updates[query.DaemonGcArtifactRule.CronEnabled.ColumnName().String()] = req.CronEnabled
 	if req.CronEnabled {
 		updates[query.DaemonGcArtifactRule.CronRule.ColumnName().String()] = ptr.To(req.CronRule)
@@ -91,11 +92,12 @@ func (h *handler) UpdateGcArtifactRule(c echo.Context) error {
 		daemonService := h.daemonServiceFactory.New(tx)
 		if ruleObj == nil { // rule not found, we need create the rule
 			err = daemonService.CreateGcArtifactRule(ctx, &models.DaemonGcArtifactRule{
-				NamespaceID:     namespaceID,
-				RetentionDay:    req.RetentionDay,
-				CronEnabled:     req.CronEnabled,
-				CronRule:        req.CronRule,
-				CronNextTrigger: nextTrigger,
+				NamespaceID:          namespaceID,
+				RetentionDay:         req.RetentionDay,
+				ReferrerRetentionDay: req.ReferrerRetentionDay,
+				CronEnabled:          req.CronEnabled,
+				CronRule:             req.CronRule,
+				CronNextTrigger:      nextTrigger,
 			})
 			if err != nil {
 				log.Error().Err(err).Msg("Create gc artifact rule failed")
@@ -171,12 +173,13 @@ func (h *handler) GetGcArtifactRule(c echo.Context) error {
 		nextTrigger = ptr.Of(time.Unix(0, int64(time.Millisecond)*ptr.To(ruleObj.CronNextTrigger)).UTC().Format(consts.DefaultTimePattern))
 	}
 	return c.JSON(http.StatusOK, types.GetGcArtifactRuleResponse{
-		RetentionDay:    ruleObj.RetentionDay,
-		CronEnabled:     ruleObj.CronEnabled,
-		CronRule:        ruleObj.CronRule,
-		CronNextTrigger: nextTrigger,
-		CreatedAt:       time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
-		UpdatedAt:       time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		RetentionDay:         ruleObj.RetentionDay,
+		ReferrerRetentionDay: ruleObj.ReferrerRetentionDay,
+		CronEnabled:          ruleObj.CronEnabled,
+		CronRule:             ruleObj.CronRule,
+		CronNextTrigger:      nextTrigger,
+		CreatedAt:            time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt:            time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 	})
 }
 
@@ -237,17 +240,19 @@ func (h *handler) GetGcArtifactLatestRunner(c echo.Context) error {
 		duration = ptr.Of(durafmt.ParseShort(time.Millisecond * time.Duration(ptr.To(runnerObj.Duration))).String())
 	}
 	return c.JSON(http.StatusOK, types.GcArtifactRunnerItem{
-		ID:           runnerObj.ID,
-		Status:       runnerObj.Status,
-		Message:      string(runnerObj.Message),
-		FailedCount:  runnerObj.FailedCount,
-		SuccessCount: runnerObj.SuccessCount,
-		RawDuration:  runnerObj.Duration,
-		Duration:     duration,
-		StartedAt:    startedAt,
-		EndedAt:      endedAt,
-		CreatedAt:    time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
-		UpdatedAt:    time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		ID:              runnerObj.ID,
+		Status:          runnerObj.Status,
+		Message:         string(runnerObj.Message),
+		FailedCount:     runnerObj.FailedCount,
+		DryRun:          runnerObj.DryRun,
+		ReclaimableSize: runnerObj.ReclaimableSize,
+		SuccessCount:    runnerObj.SuccessCount,
+		RawDuration:     runnerObj.Duration,
+		Duration:        duration,
+		StartedAt:       startedAt,
+		EndedAt:         endedAt,
+		CreatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 	})
 }
 
@@ -293,7 +298,7 @@ func (h *handler) CreateGcArtifactRunner(c echo.Context) error {
 		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, "The gc artifact rule is running")
 	}
 	err = query.Q.Transaction(func(tx *query.Query) error {
-		runnerObj := &models.DaemonGcArtifactRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending, OperateType: enums.OperateTypeManual}
+		runnerObj := &models.DaemonGcArtifactRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending, DryRun: req.DryRun, OperateType: enums.OperateTypeManual}
 		err = daemonService.CreateGcArtifactRunner(ctx, runnerObj)
 		if err != nil {
 			log.Error().Int64("ruleID", ruleObj.ID).Msgf("Create gc artifact runner failed: %v", err)
@@ -386,17 +391,19 @@ func (h *handler) ListGcArtifactRunners(c echo.Context) error {
 			duration = ptr.Of(durafmt.ParseShort(time.Millisecond * time.Duration(ptr.To(runnerObj.Duration))).String())
 		}
 		resp = append(resp, types.GcArtifactRunnerItem{
-			ID:           runnerObj.ID,
-			Status:       runnerObj.Status,
-			Message:      string(runnerObj.Message),
-			SuccessCount: runnerObj.SuccessCount,
-			FailedCount:  runnerObj.FailedCount,
-			RawDuration:  runnerObj.Duration,
-			Duration:     duration,
-			StartedAt:    startedAt,
-			EndedAt:      endedAt,
-			CreatedAt:    time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
-			UpdatedAt:    time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+			ID:              runnerObj.ID,
+			Status:          runnerObj.Status,
+			Message:         string(runnerObj.Message),
+			SuccessCount:    runnerObj.SuccessCount,
+			FailedCount:     runnerObj.FailedCount,
+			DryRun:          runnerObj.DryRun,
+			ReclaimableSize: runnerObj.ReclaimableSize,
+			RawDuration:     runnerObj.Duration,
+			Duration:        duration,
+			StartedAt:       startedAt,
+			EndedAt:         endedAt,
+			CreatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+			UpdatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 		})
 	}
 	return c.JSON(http.StatusOK, types.CommonList{Total: total, Items: resp})
@@ -451,17 +458,19 @@ func (h *handler) GetGcArtifactRunner(c echo.Context) error {
 		duration = ptr.Of(durafmt.ParseShort(time.Millisecond * time.Duration(ptr.To(runnerObj.Duration))).String())
 	}
 	return c.JSON(http.StatusOK, types.GcArtifactRunnerItem{
-		ID:           runnerObj.ID,
-		Status:       runnerObj.Status,
-		Message:      string(runnerObj.Message),
-		SuccessCount: runnerObj.SuccessCount,
-		FailedCount:  runnerObj.FailedCount,
-		RawDuration:  runnerObj.Duration,
-		Duration:     duration,
-		StartedAt:    startedAt,
-		EndedAt:      endedAt,
-		CreatedAt:    time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
-		UpdatedAt:    time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		ID:              runnerObj.ID,
+		Status:          runnerObj.Status,
+		Message:         string(runnerObj.Message),
+		SuccessCount:    runnerObj.SuccessCount,
+		FailedCount:     runnerObj.FailedCount,
+		DryRun:          runnerObj.DryRun,
+		ReclaimableSize: runnerObj.ReclaimableSize,
+		RawDuration:     runnerObj.Duration,
+		Duration:        duration,
+		StartedAt:       startedAt,
+		EndedAt:         endedAt,
+		CreatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 	})
 }
 
@@ -504,6 +513,7 @@ func (h *handler) ListGcArtifactRecords(c echo.Context) error {
 			ID:        recordObj.ID,
 			Digest:    recordObj.Digest,
 			Status:    recordObj.Status,
+			Size:      recordObj.Size,
 			Message:   string(recordObj.Message),
 			CreatedAt: time.Unix(0, int64(time.Millisecond)*recordObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 			UpdatedAt: time.Unix(0, int64(time.Millisecond)*recordObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
@@ -567,6 +577,7 @@ func (h *handler) GetGcArtifactRecord(c echo.Context) error {
 		ID:        recordObj.ID,
 		Digest:    recordObj.Digest,
 		Status:    recordObj.Status,
+		Size:      recordObj.Size,
 		Message:   string(recordObj.Message),
 		CreatedAt: time.Unix(0, int64(time.Millisecond)*recordObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 		UpdatedAt: time.Unix(0, int64(time.Millisecond)*recordObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
@@ -234,17 +234,19 @@ func (h *handler) GetGcBlobLatestRunner(c echo.Context) error {
 		duration = ptr.Of(durafmt.ParseShort(time.Millisecond * time.Duration(ptr.To(runnerObj.Duration))).String())
 	}
 	return c.JSON(http.StatusOK, types.GcBlobRunnerItem{
-		ID:           runnerObj.ID,
-		Status:       runnerObj.Status,
-		Message:      string(runnerObj.Message),
-		FailedCount:  runnerObj.FailedCount,
-		SuccessCount: runnerObj.SuccessCount,
-		RawDuration:  runnerObj.Duration,
-		Duration:     duration,
-		StartedAt:    startedAt,
-		EndedAt:      endedAt,
-		CreatedAt:    time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
-		UpdatedAt:    time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		ID:              runnerObj.ID,
+		Status:          runnerObj.Status,
+		Message:         string(runnerObj.Message),
+		FailedCount:     runnerObj.FailedCount,
+		DryRun:          runnerObj.DryRun,
+		ReclaimableSize: runnerObj.ReclaimableSize,
+		SuccessCount:    runnerObj.SuccessCount,
+		RawDuration:     runnerObj.Duration,
+		Duration:        duration,
+		StartedAt:       startedAt,
+		EndedAt:         endedAt,
+		CreatedAt:       time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt:       time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 	})
 }
 
@@ -297,7 +299,7 @@ func (h *handler) CreateGcBlobRunner(c echo.Context) error {
 		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, "The gc blob rule is running")
 	}
 	err = query.Q.Transaction(func(tx *query.Query) error {
-		runnerObj := &models.DaemonGcBlobRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending,
+		runnerObj := &models.DaemonGcBlobRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending, DryRun: req.DryRun,
 			OperateType:   enums.OperateTypeManual,
 			OperateUserID: ptr.Of(user.ID)}
 		err = daemonService.CreateGcBlobRunner(ctx, runnerObj)
@@ -388,17 +390,19 @@ func (h *handler) ListGcBlobRunners(c echo.Context) error {
 			duration = ptr.Of(durafmt.ParseShort(time.Millisecond * time.Duration(ptr.To(runnerObj.Duration))).String())
 		}
 		resp = append(resp, types.GcBlobRunnerItem{
-			ID:           runnerObj.ID,
-			Status:       runnerObj.Status,
-			Message:      string(runnerObj.Message),
-			SuccessCount: runnerObj.SuccessCount,
-			FailedCount:  runnerObj.FailedCount,
-			RawDuration:  runnerObj.Duration,
-			Duration:     duration,
-			StartedAt:    startedAt,
-			EndedAt:      endedAt,
-			CreatedAt:    time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
-			UpdatedAt:    time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+			ID:              runnerObj.ID,
+			Status:          runnerObj.Status,
+			Message:         string(runnerObj.Message),
+			SuccessCount:    runnerObj.SuccessCount,
+			FailedCount:     runnerObj.FailedCount,
+			DryRun:          runnerObj.DryRun,
+			ReclaimableSize: runnerObj.ReclaimableSize,
+			RawDuration:     runnerObj.Duration,
+			Duration:        duration,
+			StartedAt:       startedAt,
+			EndedAt:         endedAt,
+			CreatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+			UpdatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 		})
 	}
 	return c.JSON(http.StatusOK, types.CommonList{Total: total, Items: resp})
@@ -449,17 +453,19 @@ func (h *handler) GetGcBlobRunner(c echo.Context) error {
 		duration = ptr.Of(durafmt.ParseShort(time.Millisecond * time.Duration(ptr.To(runnerObj.Duration))).String())
 	}
 	return c.JSON(http.StatusOK, types.GcBlobRunnerItem{
-		ID:           runnerObj.ID,
-		Status:       runnerObj.Status,
-		Message:      string(runnerObj.Message),
-		SuccessCount: runnerObj.SuccessCount,
-		FailedCount:  runnerObj.FailedCount,
-		RawDuration:  runnerObj.Duration,
-		Duration:     duration,
-		StartedAt:    startedAt,
-		EndedAt:      endedAt,
-		CreatedAt:    time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
-		UpdatedAt:    time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		ID:              runnerObj.ID,
+		Status:          runnerObj.Status,
+		Message:         string(runnerObj.Message),
+		SuccessCount:    runnerObj.SuccessCount,
+		FailedCount:     runnerObj.FailedCount,
+		DryRun:          runnerObj.DryRun,
+		ReclaimableSize: runnerObj.ReclaimableSize,
+		RawDuration:     runnerObj.Duration,
+		Duration:        duration,
+		StartedAt:       startedAt,
+		EndedAt:         endedAt,
+		CreatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 	})
 }
 
@@ -502,6 +508,7 @@ func (h *handler) ListGcBlobRecords(c echo.Context) error {
 			ID:        recordObj.ID,
 			Digest:    recordObj.Digest,
 			Status:    recordObj.Status,
+			Size:      recordObj.Size,
 			Message:   string(recordObj.Message),
 			CreatedAt: time.Unix(0, int64(time.Millisecond)*recordObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 			UpdatedAt: time.Unix(0, int64(time.Millisecond)*recordObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
@@ -561,6 +568,7 @@ func (h *handler) GetGcBlobRecord(c echo.Context) error {
 		ID:        recordObj.ID,
 		Digest:    recordObj.Digest,
 		Status:    recordObj.Status,
+		Size:      recordObj.Size,
 		Message:   string(recordObj.Message),
 		CreatedAt: time.Unix(0, int64(time.Millisecond)*recordObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 		UpdatedAt: time.Unix(0, int64(time.Millisecond)*recordObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
@@ -0,0 +1,578 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemons
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hako/durafmt"
+	"github.com/labstack/echo/v4"
+	"github.com/robfig/cron/v3"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/modules/workq"
+	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// UpdateGcBlobUploadRule handles the update gc blob upload rule request
+//
+//	@Summary	Update gc blob upload rule
+//	@security	BasicAuth
+//	@Tags		Daemon
+//	@Accept		json
+//	@Produce	json
+//	@Router		/daemons/gc-blob-upload/{namespace_id}/ [put]
+//	@Param		namespace_id	path	int64								true	"Namespace id"
+//	@Param		message			body	types.UpdateGcBlobUploadRuleRequest	true	"Gc blob rule object"
+//	@Success	204
+//	@Failure	400	{object}	xerrors.ErrCode
+//	@Failure	404	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) UpdateGcBlobUploadRule(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	// We still keep the "NamespaceID" field because we want to ensure API consistency and it can also be used for permission verification.
+	var req types.UpdateGcBlobUploadRuleRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+	if req.NamespaceID != 0 {
+		log.Error().Msg("NamespaceID should always be 0 in action UpdateGcBlobUploadRule")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+
+	daemonService := h.daemonServiceFactory.New()
+	ruleObj, err := daemonService.GetGcBlobUploadRule(ctx)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error().Err(err).Msg("Get gc blob upload rule failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get gc blob upload rule failed: %v", err))
+	}
+	if ruleObj != nil && ruleObj.IsRunning {
+		log.Error().Msg("The gc blob upload rule is running")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, "The gc blob upload rule is running")
+	}
+	var nextTrigger *int64
+	if req.CronRule != nil {
+		schedule, _ := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow).Parse(ptr.To(req.CronRule))
+		nextTrigger = ptr.Of(schedule.Next(time.Now()).UnixMilli())
+	}
+	updates := make(map[string]any, 5)
+	updates[query.DaemonGcBlobUploadRule.RetentionHour.ColumnName().String()] = req.RetentionHour
+	updates[query.DaemonGcBlobUploadRule.CronEnabled.ColumnName().String()] = req.CronEnabled
+	if req.CronEnabled {
+		updates[query.DaemonGcBlobUploadRule.CronRule.ColumnName().String()] = ptr.To(req.CronRule)
+		updates[query.DaemonGcBlobUploadRule.CronNextTrigger.ColumnName().String()] = ptr.To(nextTrigger)
+	}
+	err = query.Q.Transaction(func(tx *query.Query) error {
+		daemonService := h.daemonServiceFactory.New(tx)
+		if ruleObj == nil { // rule not found, we need create the rule
+			err = daemonService.CreateGcBlobUploadRule(ctx, &models.DaemonGcBlobUploadRule{
+				CronEnabled:     req.CronEnabled,
+				RetentionHour:   req.RetentionHour,
+				CronRule:        req.CronRule,
+				CronNextTrigger: nextTrigger,
+			})
+			if err != nil {
+				log.Error().Err(err).Msg("Create gc blob upload rule failed")
+				return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Create gc blob upload rule failed: %v", err))
+			}
+			return nil
+		}
+		err = daemonService.UpdateGcBlobUploadRule(ctx, ruleObj.ID, updates)
+		if err != nil {
+			log.Error().Err(err).Msg("Update gc blob upload rule failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Update gc blob upload rule failed: %v", err))
+		}
+		err = h.producerClient.Produce(ctx, enums.DaemonWebhook, types.DaemonWebhookPayload{
+			Action:       enums.WebhookActionUpdate,
+			ResourceType: enums.WebhookResourceTypeDaemonTaskGcBlobUploadRule,
+			Payload:      utils.MustMarshal(req),
+		}, definition.ProducerOption{Tx: tx})
+		if err != nil {
+			log.Error().Err(err).Msg("Webhook event produce failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Webhook event produce failed: %v", err))
+		}
+		return nil
+	})
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetGcBlobUploadRule handles the get gc blob upload rule request
+//
+//	@Summary	Get gc blob upload rule
+//	@security	BasicAuth
+//	@Tags		Daemon
+//	@Accept		json
+//	@Produce	json
+//	@Router		/daemons/gc-blob-upload/{namespace_id}/ [get]
+//	@Param		namespace_id	path		int64	true	"Namespace id"
+//	@Success	200				{object}	types.GetGcBlobUploadRuleResponse
+//	@Failure	400				{object}	xerrors.ErrCode
+//	@Failure	404				{object}	xerrors.ErrCode
+//	@Failure	500				{object}	xerrors.ErrCode
+func (h *handler) GetGcBlobUploadRule(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	var req types.GetGcBlobUploadRuleRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+
+	daemonService := h.daemonServiceFactory.New()
+	ruleObj, err := daemonService.GetGcBlobUploadRule(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Int64("NamespaceID", req.NamespaceID).Msg("Get gc blob upload rule not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Get gc blob upload rule not found: %v", err))
+		}
+		log.Error().Err(err).Msg("Get gc blob upload rule failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get gc blob upload rule failed: %v", err))
+	}
+	var nextTrigger *string
+	if ruleObj.CronNextTrigger != nil {
+		nextTrigger = ptr.Of(time.Unix(0, int64(time.Millisecond)*ptr.To(ruleObj.CronNextTrigger)).UTC().Format(consts.DefaultTimePattern))
+	}
+	return c.JSON(http.StatusOK, types.GetGcBlobUploadRuleResponse{
+		RetentionHour:   ruleObj.RetentionHour,
+		CronEnabled:     ruleObj.CronEnabled,
+		CronRule:        ruleObj.CronRule,
+		CronNextTrigger: nextTrigger,
+		CreatedAt:       time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt:       time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+	})
+}
+
+// GetGcBlobUploadLatestRunner handles the get gc blob upload latest runner request
+//
+//	@Summary	Get gc blob upload latest runner
+//	@security	BasicAuth
+//	@Tags		Daemon
+//	@Accept		json
+//	@Produce	json
+//	@Router		/daemons/gc-blob-upload/{namespace_id}/runners/latest [get]
+//	@Param		namespace_id	path		int64	true	"Namespace id"
+//	@Success	200				{object}	types.GcBlobUploadRunnerItem
+//	@Failure	400				{object}	xerrors.ErrCode
+//	@Failure	404				{object}	xerrors.ErrCode
+//	@Failure	500				{object}	xerrors.ErrCode
+func (h *handler) GetGcBlobUploadLatestRunner(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	var req types.GetGcBlobUploadLatestRunnerRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+	if req.NamespaceID != 0 {
+		log.Error().Msg("NamespaceID should always be 0 in action GetGcBlobUploadLatestRunner")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	daemonService := h.daemonServiceFactory.New()
+	ruleObj, err := daemonService.GetGcBlobUploadRule(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Int64("NamespaceID", req.NamespaceID).Msg("Get gc blob upload rule not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Get gc blob upload rule not found: %v", err))
+		}
+		log.Error().Err(err).Msg("Get gc blob upload rule failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get gc blob upload rule failed: %v", err))
+	}
+	runnerObj, err := daemonService.GetGcBlobUploadLatestRunner(ctx, ruleObj.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Msg("Get gc blob upload latest runner not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Get gc blob upload latest runner not found: %v", err))
+		}
+		log.Error().Err(err).Msg("Get gc blob upload latest runner failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get gc blob upload latest runner failed: %v", err))
+	}
+	var startedAt, endedAt *string
+	if runnerObj.StartedAt != nil {
+		startedAt = ptr.Of(time.Unix(0, int64(time.Millisecond)*ptr.To(runnerObj.StartedAt)).UTC().Format(consts.DefaultTimePattern))
+	}
+	if runnerObj.EndedAt != nil {
+		endedAt = ptr.Of(time.Unix(0, int64(time.Millisecond)*ptr.To(runnerObj.EndedAt)).UTC().Format(consts.DefaultTimePattern))
+	}
+	var duration *string
+	if runnerObj.Duration != nil {
+		duration = ptr.Of(durafmt.ParseShort(time.Millisecond * time.Duration(ptr.To(runnerObj.Duration))).String())
+	}
+	return c.JSON(http.StatusOK, types.GcBlobUploadRunnerItem{
+		ID:              runnerObj.ID,
+		Status:          runnerObj.Status,
+		Message:         string(runnerObj.Message),
+		FailedCount:     runnerObj.FailedCount,
+		DryRun:          runnerObj.DryRun,
+		ReclaimableSize: runnerObj.ReclaimableSize,
+		SuccessCount:    runnerObj.SuccessCount,
+		RawDuration:     runnerObj.Duration,
+		Duration:        duration,
+		StartedAt:       startedAt,
+		EndedAt:         endedAt,
+		CreatedAt:       time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt:       time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+	})
+}
+
+// CreateGcBlobUploadRunner handles the create gc blob upload runner request
+//
+//	@Summary	Create gc blob upload runner
+//	@security	BasicAuth
+//	@Tags		Daemon
+//	@Accept		json
+//	@Produce	json
+//	@Router		/daemons/gc-blob-upload/{namespace_id}/runners/ [post]
+//	@Param		namespace_id	path	int64									true	"Namespace id"
+//	@Param		message			body	types.CreateGcBlobUploadRunnerRequest	true	"Gc blob runner object"
+//	@Success	201
+//	@Failure	400	{object}	xerrors.ErrCode
+//	@Failure	404	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) CreateGcBlobUploadRunner(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+
+	var req types.CreateGcBlobUploadRunnerRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+	daemonService := h.daemonServiceFactory.New()
+	ruleObj, err := daemonService.GetGcBlobUploadRule(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Msg("Get gc blob upload rule not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Get gc blob upload rule not found: %v", err))
+		}
+		log.Error().Err(err).Msg("Get gc blob upload rule failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get gc blob upload rule failed: %v", err))
+	}
+	if ruleObj != nil && ruleObj.IsRunning {
+		log.Error().Msg("The gc blob upload rule is running")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, "The gc blob upload rule is running")
+	}
+	err = query.Q.Transaction(func(tx *query.Query) error {
+		runnerObj := &models.DaemonGcBlobUploadRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending, DryRun: req.DryRun,
+			OperateType:   enums.OperateTypeManual,
+			OperateUserID: ptr.Of(user.ID)}
+		err = daemonService.CreateGcBlobUploadRunner(ctx, runnerObj)
+		if err != nil {
+			log.Error().Int64("RuleID", ruleObj.ID).Msgf("Create gc blob upload runner failed: %v", err)
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Create gc blob upload runner failed: %v", err))
+		}
+		err = workq.ProducerClient.Produce(ctx, enums.DaemonGcBlobUpload,
+			types.DaemonGcPayload{RunnerID: runnerObj.ID}, definition.ProducerOption{Tx: tx})
+		if err != nil {
+			log.Error().Err(err).Msgf("Send topic %s to work queue failed", enums.DaemonGcBlobUpload.String())
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Send topic %s to work queue failed", enums.DaemonGcBlobUpload.String()))
+		}
+		err = h.producerClient.Produce(ctx, enums.DaemonWebhook, types.DaemonWebhookPayload{
+			Action:       enums.WebhookActionCreate,
+			ResourceType: enums.WebhookResourceTypeDaemonTaskGcBlobUploadRunner,
+			Payload:      utils.MustMarshal(req),
+		}, definition.ProducerOption{Tx: tx})
+		if err != nil {
+			log.Error().Err(err).Msg("Webhook event produce failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Webhook event produce failed: %v", err))
+		}
+		return nil
+	})
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+
+	return c.NoContent(http.StatusCreated)
+}
+
+// ListGcBlobUploadRunners handles the list gc blob upload runners request
+//
+//	@Summary	List gc blob upload runners
+//	@security	BasicAuth
+//	@Tags		Daemon
+//	@Accept		json
+//	@Produce	json
+//	@Router		/daemons/gc-blob-upload/{namespace_id}/runners/ [get]
+//	@Param		namespace_id	path		int64	true	"Namespace id"
+//	@Param		limit			query		int64	false	"limit"	minimum(10)	maximum(100)	default(10)
+//	@Param		page			query		int64	false	"page"	minimum(1)	default(1)
+//	@Param		sort			query		string	false	"sort field"
+//	@Param		method			query		string	false	"sort method"	Enums(asc, desc)
+//	@Success	200				{object}	types.CommonList{items=[]types.GcBlobUploadRunnerItem}
+//	@Failure	400				{object}	xerrors.ErrCode
+//	@Failure	404				{object}	xerrors.ErrCode
+//	@Failure	500				{object}	xerrors.ErrCode
+func (h *handler) ListGcBlobUploadRunners(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	var req types.ListGcBlobUploadRunnersRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+	daemonService := h.daemonServiceFactory.New()
+	ruleObj, err := daemonService.GetGcBlobUploadRule(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Int64("NamespaceID", req.NamespaceID).Msg("Get gc blob upload rule not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Get gc blob upload rule not found: %v", err))
+		}
+		log.Error().Err(err).Msg("Get gc blob upload rule failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get gc blob upload rule failed: %v", err))
+	}
+	runnerObjs, total, err := daemonService.ListGcBlobUploadRunners(ctx, ruleObj.ID, req.Pagination, req.Sortable)
+	if err != nil {
+		log.Error().Err(err).Msg("List gc blob upload rule failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("List gc blob upload rule failed: %v", err))
+	}
+	var resp = make([]any, 0, len(runnerObjs))
+	for _, runnerObj := range runnerObjs {
+		var startedAt, endedAt *string
+		if runnerObj.StartedAt != nil {
+			startedAt = ptr.Of(time.Unix(0, int64(time.Millisecond)*ptr.To(runnerObj.StartedAt)).UTC().Format(consts.DefaultTimePattern))
+		}
+		if runnerObj.EndedAt != nil {
+			endedAt = ptr.Of(time.Unix(0, int64(time.Millisecond)*ptr.To(runnerObj.EndedAt)).UTC().Format(consts.DefaultTimePattern))
+		}
+		var duration *string
+		if runnerObj.Duration != nil {
+			duration = ptr.Of(durafmt.ParseShort(time.Millisecond * time.Duration(ptr.To(runnerObj.Duration))).String())
+		}
+		resp = append(resp, types.GcBlobUploadRunnerItem{
+			ID:              runnerObj.ID,
+			Status:          runnerObj.Status,
+			Message:         string(runnerObj.Message),
+			SuccessCount:    runnerObj.SuccessCount,
+			FailedCount:     runnerObj.FailedCount,
+			DryRun:          runnerObj.DryRun,
+			ReclaimableSize: runnerObj.ReclaimableSize,
+			RawDuration:     runnerObj.Duration,
+			Duration:        duration,
+			StartedAt:       startedAt,
+			EndedAt:         endedAt,
+			CreatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+			UpdatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		})
+	}
+	return c.JSON(http.StatusOK, types.CommonList{Total: total, Items: resp})
+}
+
+// GetGcBlobUploadRunner handles the get gc blob upload runner request
+//
+//	@Summary	Get gc blob upload runner
+//	@security	BasicAuth
+//	@Tags		Daemon
+//	@Accept		json
+//	@Produce	json
+//	@Router		/daemons/gc-blob-upload/{namespace_id}/runners/{runner_id} [get]
+//	@Param		namespace_id	path		int64	true	"Namespace id"
+//	@Param		runner_id		path		int64	true	"Runner id"
+//	@Success	200				{object}	types.GcBlobUploadRunnerItem
+//	@Failure	400				{object}	xerrors.ErrCode
+//	@Failure	404				{object}	xerrors.ErrCode
+//	@Failure	500				{object}	xerrors.ErrCode
+func (h *handler) GetGcBlobUploadRunner(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	var req types.GetGcBlobUploadRunnerRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+	daemonService := h.daemonServiceFactory.New()
+	runnerObj, err := daemonService.GetGcBlobUploadRunner(ctx, req.RunnerID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Int64("NamespaceID", req.NamespaceID).Int64("runnerID", req.RunnerID).Msg("Get gc blob upload runner not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Get gc blob upload runner not found: %v", err))
+		}
+		log.Error().Err(err).Msg("Get gc blob upload runner failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get gc blob upload runner failed: %v", err))
+	}
+	var startedAt, endedAt *string
+	if runnerObj.StartedAt != nil {
+		startedAt = ptr.Of(time.Unix(0, int64(time.Millisecond)*ptr.To(runnerObj.StartedAt)).UTC().Format(consts.DefaultTimePattern))
+	}
+	if runnerObj.EndedAt != nil {
+		endedAt = ptr.Of(time.Unix(0, int64(time.Millisecond)*ptr.To(runnerObj.EndedAt)).UTC().Format(consts.DefaultTimePattern))
+	}
+	var duration *string
+	if runnerObj.Duration != nil {
+		duration = ptr.Of(durafmt.ParseShort(time.Millisecond * time.Duration(ptr.To(runnerObj.Duration))).String())
+	}
+	return c.JSON(http.StatusOK, types.GcBlobUploadRunnerItem{
+		ID:              runnerObj.ID,
+		Status:          runnerObj.Status,
+		Message:         string(runnerObj.Message),
+		SuccessCount:    runnerObj.SuccessCount,
+		FailedCount:     runnerObj.FailedCount,
+		DryRun:          runnerObj.DryRun,
+		ReclaimableSize: runnerObj.ReclaimableSize,
+		RawDuration:     runnerObj.Duration,
+		Duration:        duration,
+		StartedAt:       startedAt,
+		EndedAt:         endedAt,
+		CreatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+	})
+}
+
+// ListGcBlobUploadRecords handles the list gc blob upload records request
+//
+//	@Summary	List gc blob upload records
+//	@security	BasicAuth
+//	@Tags		Daemon
+//	@Accept		json
+//	@Produce	json
+//	@Router		/daemons/gc-blob-upload/{namespace_id}/runners/{runner_id}/records/ [get]
+//	@Param		namespace_id	path		int64	true	"Namespace id"
+//	@Param		runner_id		path		int64	true	"Runner id"
+//	@Param		limit			query		int64	false	"limit"	minimum(10)	maximum(100)	default(10)
+//	@Param		page			query		int64	false	"page"	minimum(1)	default(1)
+//	@Param		sort			query		string	false	"sort field"
+//	@Param		method			query		string	false	"sort method"	Enums(asc, desc)
+//	@Success	200				{object}	types.CommonList{items=[]types.GcBlobUploadRecordItem}
+//	@Failure	400				{object}	xerrors.ErrCode
+//	@Failure	404				{object}	xerrors.ErrCode
+//	@Failure	500				{object}	xerrors.ErrCode
+func (h *handler) ListGcBlobUploadRecords(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	var req types.ListGcBlobUploadRecordsRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+	daemonService := h.daemonServiceFactory.New()
+	recordObjs, total, err := daemonService.ListGcBlobUploadRecords(ctx, req.RunnerID, req.Pagination, req.Sortable)
+	if err != nil {
+		log.Error().Err(err).Int64("RuleID", req.RunnerID).Msgf("List gc blob upload records failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("List gc blob upload records failed: %v", err))
+	}
+	var resp = make([]any, 0, len(recordObjs))
+	for _, recordObj := range recordObjs {
+		resp = append(resp, types.GcBlobUploadRecordItem{
+			ID:         recordObj.ID,
+			UploadID:   recordObj.UploadID,
+			Repository: recordObj.Repository,
+			Status:     recordObj.Status,
+			Size:       recordObj.Size,
+			Message:    string(recordObj.Message),
+			CreatedAt:  time.Unix(0, int64(time.Millisecond)*recordObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+			UpdatedAt:  time.Unix(0, int64(time.Millisecond)*recordObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		})
+	}
+	return c.JSON(http.StatusOK, types.CommonList{Total: total, Items: resp})
+}
+
+// GetGcBlobUploadRecord handles the get gc blob upload record request
+//
+//	@Summary	Get gc blob upload record
+//	@security	BasicAuth
+//	@Tags		Daemon
+//	@Accept		json
+//	@Produce	json
+//	@Router		/daemons/gc-blob-upload/{namespace_id}/runners/{runner_id}/records/{record_id} [get]
+//	@Param		namespace_id	path		int64	true	"Namespace id"
+//	@Param		runner_id		path		int64	true	"Runner id"
+//	@Param		record_id		path		int64	true	"Record id"
+//	@Success	200				{object}	types.GcBlobUploadRecordItem
+//	@Failure	400				{object}	xerrors.ErrCode
+//	@Failure	404				{object}	xerrors.ErrCode
+//	@Failure	500				{object}	xerrors.ErrCode
+func (h *handler) GetGcBlobUploadRecord(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	var req types.GetGcBlobUploadRecordRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+	daemonService := h.daemonServiceFactory.New()
+	ruleObj, err := daemonService.GetGcBlobUploadRule(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Int64("NamespaceID", req.NamespaceID).Msg("Get gc blob upload rule not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Get gc blob upload rule not found: %v", err))
+		}
+		log.Error().Err(err).Msg("Get gc blob upload rule failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get gc blob upload rule failed: %v", err))
+	}
+	recordObj, err := daemonService.GetGcBlobUploadRecord(ctx, req.RecordID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Int64("NamespaceID", req.NamespaceID).Int64("runnerID", req.RunnerID).Msg("Get gc blob upload record not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Get gc blob upload record not found: %v", err))
+		}
+		log.Error().Err(err).Msg("Get gc blob upload record failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get gc blob upload record failed: %v", err))
+	}
+	if recordObj.Runner.ID != req.RunnerID || recordObj.Runner.Rule.ID != ruleObj.ID {
+		log.Error().Err(err).Int64("NamespaceID", req.NamespaceID).Int64("runnerID", req.RunnerID).Msg("Get gc blob upload record not found")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Get gc blob upload record not found: %v", err))
+	}
+	return c.JSON(http.StatusOK, types.GcBlobUploadRecordItem{
+		ID:         recordObj.ID,
+		UploadID:   recordObj.UploadID,
+		Repository: recordObj.Repository,
+		Status:     recordObj.Status,
+		Size:       recordObj.Size,
+		Message:    string(recordObj.Message),
+		CreatedAt:  time.Unix(0, int64(time.Millisecond)*recordObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt:  time.Unix(0, int64(time.Millisecond)*recordObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+	})
+}
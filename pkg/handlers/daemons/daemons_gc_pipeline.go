@@ -0,0 +1,433 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemons
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hako/durafmt"
+	"github.com/labstack/echo/v4"
+	"github.com/robfig/cron/v3"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// UpdateGcPipelineRule handles the update gc pipeline rule request
+//
+//	@Summary	Update gc pipeline rule
+//	@security	BasicAuth
+//	@Tags		Daemon
+//	@Accept		json
+//	@Produce	json
+//	@Router		/daemons/gc/ [put]
+//	@Param		message	body	types.UpdateGcPipelineRuleRequest	true	"Gc pipeline rule object"
+//	@Success	204
+//	@Failure	400	{object}	xerrors.ErrCode
+//	@Failure	401	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) UpdateGcPipelineRule(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	_, errCode := h.adminUser(c)
+	if errCode != nil {
+		return xerrors.NewHTTPError(c, ptr.To(errCode))
+	}
+
+	var req types.UpdateGcPipelineRuleRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+	if req.CronEnabled && req.CronRule == nil {
+		log.Error().Msg("The cron rule is required when the cron is enabled")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, "The cron rule is required when the cron is enabled")
+	}
+
+	daemonService := h.daemonServiceFactory.New()
+	ruleObj, err := daemonService.GetGcPipelineRule(ctx)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error().Err(err).Msg("Get gc pipeline rule failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get gc pipeline rule failed: %v", err))
+	}
+	var nextTrigger *int64
+	if req.CronRule != nil {
+		schedule, _ := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow).Parse(ptr.To(req.CronRule))
+		nextTrigger = ptr.Of(schedule.Next(time.Now()).UnixMilli())
+	}
+	updates := make(map[string]any, 3)
+	updates[query.DaemonGcPipelineRule.CronEnabled.ColumnName().String()] = req.CronEnabled
+	if req.CronEnabled {
+		updates[query.DaemonGcPipelineRule.CronRule.ColumnName().String()] = ptr.To(req.CronRule)
+		updates[query.DaemonGcPipelineRule.CronNextTrigger.ColumnName().String()] = ptr.To(nextTrigger)
+	}
+	err = query.Q.Transaction(func(tx *query.Query) error {
+		daemonService := h.daemonServiceFactory.New(tx)
+		if ruleObj == nil { // rule not found, we need create the rule
+			err = daemonService.CreateGcPipelineRule(ctx, &models.DaemonGcPipelineRule{
+				CronEnabled:     req.CronEnabled,
+				CronRule:        req.CronRule,
+				CronNextTrigger: nextTrigger,
+			})
+			if err != nil {
+				log.Error().Err(err).Msg("Create gc pipeline rule failed")
+				return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Create gc pipeline rule failed: %v", err))
+			}
+			return nil
+		}
+		err = daemonService.UpdateGcPipelineRule(ctx, ruleObj.ID, updates)
+		if err != nil {
+			log.Error().Err(err).Msg("Update gc pipeline rule failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Update gc pipeline rule failed: %v", err))
+		}
+		return nil
+	})
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetGcPipelineRule handles the get gc pipeline rule request
+//
+//	@Summary	Get gc pipeline rule
+//	@security	BasicAuth
+//	@Tags		Daemon
+//	@Accept		json
+//	@Produce	json
+//	@Router		/daemons/gc/ [get]
+//	@Success	200	{object}	types.GetGcPipelineRuleResponse
+//	@Failure	401	{object}	xerrors.ErrCode
+//	@Failure	404	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) GetGcPipelineRule(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	_, errCode := h.adminUser(c)
+	if errCode != nil {
+		return xerrors.NewHTTPError(c, ptr.To(errCode))
+	}
+
+	daemonService := h.daemonServiceFactory.New()
+	ruleObj, err := daemonService.GetGcPipelineRule(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Msg("Get gc pipeline rule not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Get gc pipeline rule not found: %v", err))
+		}
+		log.Error().Err(err).Msg("Get gc pipeline rule failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get gc pipeline rule failed: %v", err))
+	}
+	var nextTrigger *string
+	if ruleObj.CronNextTrigger != nil {
+		nextTrigger = ptr.Of(time.Unix(0, int64(time.Millisecond)*ptr.To(ruleObj.CronNextTrigger)).UTC().Format(consts.DefaultTimePattern))
+	}
+	return c.JSON(http.StatusOK, types.GetGcPipelineRuleResponse{
+		CronEnabled:     ruleObj.CronEnabled,
+		CronRule:        ruleObj.CronRule,
+		CronNextTrigger: nextTrigger,
+		CreatedAt:       time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt:       time.Unix(0, int64(time.Millisecond)*ruleObj.UpdatedAt).UTC().Format(consts.DefaultTimePattern),
+	})
+}
+
+// GetGcPipelineLatestRunner handles the get gc pipeline latest runner request
+//
+//	@Summary	Get gc pipeline latest runner
+//	@security	BasicAuth
+//	@Tags		Daemon
+//	@Accept		json
+//	@Produce	json
+//	@Router		/daemons/gc/runners/latest [get]
+//	@Success	200	{object}	types.GcPipelineRunnerItem
+//	@Failure	401	{object}	xerrors.ErrCode
+//	@Failure	404	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) GetGcPipelineLatestRunner(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	_, errCode := h.adminUser(c)
+	if errCode != nil {
+		return xerrors.NewHTTPError(c, ptr.To(errCode))
+	}
+
+	daemonService := h.daemonServiceFactory.New()
+	ruleObj, err := daemonService.GetGcPipelineRule(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Msg("Get gc pipeline rule not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Get gc pipeline rule not found: %v", err))
+		}
+		log.Error().Err(err).Msg("Get gc pipeline rule failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get gc pipeline rule failed: %v", err))
+	}
+	runnerObj, err := daemonService.GetGcPipelineLatestRunner(ctx, ruleObj.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Msg("Get gc pipeline latest runner not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Get gc pipeline latest runner not found: %v", err))
+		}
+		log.Error().Err(err).Msg("Get gc pipeline latest runner failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get gc pipeline latest runner failed: %v", err))
+	}
+	return c.JSON(http.StatusOK, gcPipelineRunnerItem(runnerObj))
+}
+
+// CreateGcPipelineRunner handles the create gc pipeline runner request
+//
+//	@Summary	Create gc pipeline runner
+//	@security	BasicAuth
+//	@Tags		Daemon
+//	@Accept		json
+//	@Produce	json
+//	@Router		/daemons/gc/runners/ [post]
+//	@Success	201
+//	@Failure	400	{object}	xerrors.ErrCode
+//	@Failure	401	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) CreateGcPipelineRunner(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	user, errCode := h.adminUser(c)
+	if errCode != nil {
+		return xerrors.NewHTTPError(c, ptr.To(errCode))
+	}
+
+	daemonService := h.daemonServiceFactory.New()
+	ruleObj, err := daemonService.GetGcPipelineRule(ctx)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error().Err(err).Msg("Get gc pipeline rule failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get gc pipeline rule failed: %v", err))
+	}
+	if ruleObj != nil {
+		runnerObj, err := daemonService.GetGcPipelineLatestRunner(ctx, ruleObj.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Msg("Get gc pipeline latest runner failed")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get gc pipeline latest runner failed: %v", err))
+		}
+		if runnerObj != nil && (runnerObj.Status == enums.TaskCommonStatusPending || runnerObj.Status == enums.TaskCommonStatusDoing) {
+			log.Error().Int64("RunnerID", runnerObj.ID).Msg("The gc pipeline is running")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, "The gc pipeline is running")
+		}
+	}
+	err = query.Q.Transaction(func(tx *query.Query) error {
+		daemonService := h.daemonServiceFactory.New(tx)
+		if ruleObj == nil { // the rule is created without cron, the pipeline can be triggered manually
+			ruleObj = &models.DaemonGcPipelineRule{}
+			err = daemonService.CreateGcPipelineRule(ctx, ruleObj)
+			if err != nil {
+				log.Error().Err(err).Msg("Create gc pipeline rule failed")
+				return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Create gc pipeline rule failed: %v", err))
+			}
+		}
+		runnerObj := &models.DaemonGcPipelineRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending,
+			OperateType:   enums.OperateTypeManual,
+			OperateUserID: ptr.Of(user.ID)}
+		err = daemonService.CreateGcPipelineRunner(ctx, runnerObj)
+		if err != nil {
+			log.Error().Int64("RuleID", ruleObj.ID).Msgf("Create gc pipeline runner failed: %v", err)
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Create gc pipeline runner failed: %v", err))
+		}
+		err = h.producerClient.Produce(ctx, enums.DaemonGc,
+			types.DaemonGcPayload{RunnerID: runnerObj.ID}, definition.ProducerOption{Tx: tx})
+		if err != nil {
+			log.Error().Err(err).Msgf("Send topic %s to work queue failed", enums.DaemonGc.String())
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Send topic %s to work queue failed", enums.DaemonGc.String()))
+		}
+		return nil
+	})
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+
+	return c.NoContent(http.StatusCreated)
+}
+
+// ListGcPipelineRunners handles the list gc pipeline runners request
+//
+//	@Summary	List gc pipeline runners
+//	@security	BasicAuth
+//	@Tags		Daemon
+//	@Accept		json
+//	@Produce	json
+//	@Router		/daemons/gc/runners/ [get]
+//	@Param		limit	query		int64	false	"limit"	minimum(10)	maximum(100)	default(10)
+//	@Param		page	query		int64	false	"page"	minimum(1)	default(1)
+//	@Param		sort	query		string	false	"sort field"
+//	@Param		method	query		string	false	"sort method"	Enums(asc, desc)
+//	@Success	200		{object}	types.CommonList{items=[]types.GcPipelineRunnerItem}
+//	@Failure	400		{object}	xerrors.ErrCode
+//	@Failure	401		{object}	xerrors.ErrCode
+//	@Failure	404		{object}	xerrors.ErrCode
+//	@Failure	500		{object}	xerrors.ErrCode
+func (h *handler) ListGcPipelineRunners(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	_, errCode := h.adminUser(c)
+	if errCode != nil {
+		return xerrors.NewHTTPError(c, ptr.To(errCode))
+	}
+
+	var req types.ListGcPipelineRunnersRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+	daemonService := h.daemonServiceFactory.New()
+	ruleObj, err := daemonService.GetGcPipelineRule(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Msg("Get gc pipeline rule not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Get gc pipeline rule not found: %v", err))
+		}
+		log.Error().Err(err).Msg("Get gc pipeline rule failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get gc pipeline rule failed: %v", err))
+	}
+	runnerObjs, total, err := daemonService.ListGcPipelineRunners(ctx, ruleObj.ID, req.Pagination, req.Sortable)
+	if err != nil {
+		log.Error().Err(err).Msg("List gc pipeline runners failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("List gc pipeline runners failed: %v", err))
+	}
+	var resp = make([]any, 0, len(runnerObjs))
+	for _, runnerObj := range runnerObjs {
+		resp = append(resp, gcPipelineRunnerItem(runnerObj))
+	}
+	return c.JSON(http.StatusOK, types.CommonList{Total: total, Items: resp})
+}
+
+// GetGcPipelineRunner handles the get gc pipeline runner request
+//
+//	@Summary	Get gc pipeline runner
+//	@security	BasicAuth
+//	@Tags		Daemon
+//	@Accept		json
+//	@Produce	json
+//	@Router		/daemons/gc/runners/{runner_id} [get]
+//	@Param		runner_id	path		int64	true	"Runner id"
+//	@Success	200			{object}	types.GcPipelineRunnerItem
+//	@Failure	400			{object}	xerrors.ErrCode
+//	@Failure	401			{object}	xerrors.ErrCode
+//	@Failure	404			{object}	xerrors.ErrCode
+//	@Failure	500			{object}	xerrors.ErrCode
+func (h *handler) GetGcPipelineRunner(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	_, errCode := h.adminUser(c)
+	if errCode != nil {
+		return xerrors.NewHTTPError(c, ptr.To(errCode))
+	}
+
+	var req types.GetGcPipelineRunnerRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+	daemonService := h.daemonServiceFactory.New()
+	runnerObj, err := daemonService.GetGcPipelineRunner(ctx, req.RunnerID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Int64("RunnerID", req.RunnerID).Msg("Get gc pipeline runner not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Get gc pipeline runner not found: %v", err))
+		}
+		log.Error().Err(err).Msg("Get gc pipeline runner failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get gc pipeline runner failed: %v", err))
+	}
+	return c.JSON(http.StatusOK, gcPipelineRunnerItem(runnerObj))
+}
+
+// adminUser gets the user from the context, the gc pipeline works on the whole instance, so only admin can operate it
+func (h *handler) adminUser(c echo.Context) (*models.User, *xerrors.ErrCode) {
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return nil, ptr.Of(xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return nil, ptr.Of(xerrors.HTTPErrCodeUnauthorized)
+	}
+	if !(user.Role == enums.UserRoleAdmin || user.Role == enums.UserRoleRoot) {
+		log.Error().Int64("UserID", user.ID).Msg("Auth check failed")
+		return nil, ptr.Of(xerrors.HTTPErrCodeUnauthorized.Detail("No permission with this api"))
+	}
+	return user, nil
+}
+
+func gcPipelineRunnerItem(runnerObj *models.DaemonGcPipelineRunner) types.GcPipelineRunnerItem {
+	var startedAt, endedAt *string
+	if runnerObj.StartedAt != nil {
+		startedAt = ptr.Of(time.Unix(0, int64(time.Millisecond)*ptr.To(runnerObj.StartedAt)).UTC().Format(consts.DefaultTimePattern))
+	}
+	if runnerObj.EndedAt != nil {
+		endedAt = ptr.Of(time.Unix(0, int64(time.Millisecond)*ptr.To(runnerObj.EndedAt)).UTC().Format(consts.DefaultTimePattern))
+	}
+	var duration *string
+	if runnerObj.Duration != nil {
+		duration = ptr.Of(durafmt.ParseShort(time.Millisecond * time.Duration(ptr.To(runnerObj.Duration))).String())
+	}
+	var report *types.GcPipelineReport
+	if len(runnerObj.Report) != 0 {
+		report = &types.GcPipelineReport{}
+		err := json.Unmarshal(runnerObj.Report, report)
+		if err != nil {
+			log.Error().Err(err).Int64("RunnerID", runnerObj.ID).Msg("Unmarshal gc pipeline report failed")
+			report = nil
+		}
+	}
+	return types.GcPipelineRunnerItem{
+		ID:                 runnerObj.ID,
+		Status:             runnerObj.Status,
+		Stage:              runnerObj.Stage,
+		Message:            string(runnerObj.Message),
+		OperateType:        runnerObj.OperateType,
+		RepositoryRunnerID: runnerObj.RepositoryRunnerID,
+		TagRunnerID:        runnerObj.TagRunnerID,
+		ArtifactRunnerID:   runnerObj.ArtifactRunnerID,
+		BlobUploadRunnerID: runnerObj.BlobUploadRunnerID,
+		BlobRunnerID:       runnerObj.BlobRunnerID,
+		Report:             report,
+		RawDuration:        runnerObj.Duration,
+		Duration:           duration,
+		StartedAt:          startedAt,
+		EndedAt:            endedAt,
+		CreatedAt:          time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt:          time.Unix(0, int64(time.Millisecond)*runnerObj.UpdatedAt).UTC().Format(consts.DefaultTimePattern),
+	}
+}
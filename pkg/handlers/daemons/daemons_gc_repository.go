@@ -237,17 +237,19 @@ func (h *handler) GetGcRepositoryLatestRunner(c echo.Context) error {
 		duration = ptr.Of(durafmt.ParseShort(time.Millisecond * time.Duration(ptr.To(runnerObj.Duration))).String())
 	}
 	return c.JSON(http.StatusOK, types.GcRepositoryRunnerItem{
-		ID:           runnerObj.ID,
-		Status:       runnerObj.Status,
-		Message:      string(runnerObj.Message),
-		FailedCount:  runnerObj.FailedCount,
-		SuccessCount: runnerObj.SuccessCount,
-		RawDuration:  runnerObj.Duration,
-		Duration:     duration,
-		StartedAt:    startedAt,
-		EndedAt:      endedAt,
-		CreatedAt:    time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
-		UpdatedAt:    time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		ID:              runnerObj.ID,
+		Status:          runnerObj.Status,
+		Message:         string(runnerObj.Message),
+		FailedCount:     runnerObj.FailedCount,
+		DryRun:          runnerObj.DryRun,
+		ReclaimableSize: runnerObj.ReclaimableSize,
+		SuccessCount:    runnerObj.SuccessCount,
+		RawDuration:     runnerObj.Duration,
+		Duration:        duration,
+		StartedAt:       startedAt,
+		EndedAt:         endedAt,
+		CreatedAt:       time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt:       time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 	})
 }
 
@@ -293,7 +295,7 @@ func (h *handler) CreateGcRepositoryRunner(c echo.Context) error {
 		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, "The gc repository rule is running")
 	}
 	err = query.Q.Transaction(func(tx *query.Query) error {
-		runnerObj := &models.DaemonGcRepositoryRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending, OperateType: enums.OperateTypeManual}
+		runnerObj := &models.DaemonGcRepositoryRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending, DryRun: req.DryRun, OperateType: enums.OperateTypeManual}
 		err = daemonService.CreateGcRepositoryRunner(ctx, runnerObj)
 		if err != nil {
 			log.Error().Int64("ruleID", ruleObj.ID).Msgf("Create gc repository runner failed: %v", err)
@@ -387,17 +389,19 @@ func (h *handler) ListGcRepositoryRunners(c echo.Context) error {
 			duration = ptr.Of(durafmt.ParseShort(time.Millisecond * time.Duration(ptr.To(runnerObj.Duration))).String())
 		}
 		resp = append(resp, types.GcRepositoryRunnerItem{
-			ID:           runnerObj.ID,
-			Status:       runnerObj.Status,
-			Message:      string(runnerObj.Message),
-			SuccessCount: runnerObj.SuccessCount,
-			FailedCount:  runnerObj.FailedCount,
-			RawDuration:  runnerObj.Duration,
-			Duration:     duration,
-			StartedAt:    startedAt,
-			EndedAt:      endedAt,
-			CreatedAt:    time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
-			UpdatedAt:    time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+			ID:              runnerObj.ID,
+			Status:          runnerObj.Status,
+			Message:         string(runnerObj.Message),
+			SuccessCount:    runnerObj.SuccessCount,
+			FailedCount:     runnerObj.FailedCount,
+			DryRun:          runnerObj.DryRun,
+			ReclaimableSize: runnerObj.ReclaimableSize,
+			RawDuration:     runnerObj.Duration,
+			Duration:        duration,
+			StartedAt:       startedAt,
+			EndedAt:         endedAt,
+			CreatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+			UpdatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 		})
 	}
 	return c.JSON(http.StatusOK, types.CommonList{Total: total, Items: resp})
@@ -452,17 +456,19 @@ func (h *handler) GetGcRepositoryRunner(c echo.Context) error {
 		duration = ptr.Of(durafmt.ParseShort(time.Millisecond * time.Duration(ptr.To(runnerObj.Duration))).String())
 	}
 	return c.JSON(http.StatusOK, types.GcRepositoryRunnerItem{
-		ID:           runnerObj.ID,
-		Status:       runnerObj.Status,
-		Message:      string(runnerObj.Message),
-		SuccessCount: runnerObj.SuccessCount,
-		FailedCount:  runnerObj.FailedCount,
-		RawDuration:  runnerObj.Duration,
-		Duration:     duration,
-		StartedAt:    startedAt,
-		EndedAt:      endedAt,
-		CreatedAt:    time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
-		UpdatedAt:    time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		ID:              runnerObj.ID,
+		Status:          runnerObj.Status,
+		Message:         string(runnerObj.Message),
+		SuccessCount:    runnerObj.SuccessCount,
+		FailedCount:     runnerObj.FailedCount,
+		DryRun:          runnerObj.DryRun,
+		ReclaimableSize: runnerObj.ReclaimableSize,
+		RawDuration:     runnerObj.Duration,
+		Duration:        duration,
+		StartedAt:       startedAt,
+		EndedAt:         endedAt,
+		CreatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 	})
 }
 
@@ -505,6 +511,7 @@ func (h *handler) ListGcRepositoryRecords(c echo.Context) error {
 			ID:         recordObj.ID,
 			Repository: recordObj.Repository,
 			Status:     recordObj.Status,
+			Size:       recordObj.Size,
 			Message:    string(recordObj.Message),
 			CreatedAt:  time.Unix(0, int64(time.Millisecond)*recordObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 			UpdatedAt:  time.Unix(0, int64(time.Millisecond)*recordObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
@@ -568,6 +575,7 @@ func (h *handler) GetGcRepositoryRecord(c echo.Context) error {
 		ID:         recordObj.ID,
 		Repository: recordObj.Repository,
 		Status:     recordObj.Status,
+		Size:       recordObj.Size,
 		Message:    string(recordObj.Message),
 		CreatedAt:  time.Unix(0, int64(time.Millisecond)*recordObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 		UpdatedAt:  time.Unix(0, int64(time.Millisecond)*recordObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
@@ -15,6 +15,7 @@
 package daemons
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -93,6 +94,11 @@ func (h *handler) UpdateGcTagRule(c echo.Context) error {
 	if req.RetentionPattern != nil {
 		updates[query.DaemonGcTagRule.RetentionPattern.ColumnName().String()] = ptr.To(req.RetentionPattern)
 	}
+	var retentionRules []byte
+	if len(req.RetentionRules) > 0 {
+		retentionRules = utils.MustMarshal(req.RetentionRules)
+	}
+	updates[query.DaemonGcTagRule.RetentionRules.ColumnName().String()] = retentionRules
 	err = query.Q.Transaction(func(tx *query.Query) error {
 		daemonService := h.daemonServiceFactory.New(tx)
 		if ruleObj == nil { // rule not found, we need create the rule
@@ -104,6 +110,7 @@ func (h *handler) UpdateGcTagRule(c echo.Context) error {
 				RetentionPattern:    req.RetentionPattern,
 				RetentionRuleType:   req.RetentionRuleType,
 				RetentionRuleAmount: req.RetentionRuleAmount,
+				RetentionRules:      retentionRules,
 			})
 			if err != nil {
 				log.Error().Err(err).Msg("Create gc tag rule failed")
@@ -178,6 +185,14 @@ func (h *handler) GetGcTagRule(c echo.Context) error {
 	if ruleObj.CronNextTrigger != nil {
 		nextTrigger = ptr.Of(time.Unix(0, int64(time.Millisecond)*ptr.To(ruleObj.CronNextTrigger)).UTC().Format(consts.DefaultTimePattern))
 	}
+	var retentionRules = make([]types.TagRetentionRule, 0)
+	if len(ruleObj.RetentionRules) > 0 {
+		err = json.Unmarshal(ruleObj.RetentionRules, &retentionRules)
+		if err != nil {
+			log.Error().Err(err).Msg("Unmarshal gc tag retention rules failed")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Unmarshal gc tag retention rules failed: %v", err))
+		}
+	}
 	return c.JSON(http.StatusOK, types.GetGcTagRuleResponse{
 		CronEnabled:         ruleObj.CronEnabled,
 		CronRule:            ruleObj.CronRule,
@@ -185,6 +200,7 @@ func (h *handler) GetGcTagRule(c echo.Context) error {
 		RetentionRuleType:   ruleObj.RetentionRuleType,
 		RetentionRuleAmount: ruleObj.RetentionRuleAmount,
 		RetentionPattern:    ruleObj.RetentionPattern,
+		RetentionRules:      retentionRules,
 		CreatedAt:           time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 		UpdatedAt:           time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 	})
@@ -247,17 +263,19 @@ func (h *handler) GetGcTagLatestRunner(c echo.Context) error {
 		duration = ptr.Of(durafmt.ParseShort(time.Millisecond * time.Duration(ptr.To(runnerObj.Duration))).String())
 	}
 	return c.JSON(http.StatusOK, types.GcTagRunnerItem{
-		ID:           runnerObj.ID,
-		Status:       runnerObj.Status,
-		Message:      string(runnerObj.Message),
-		FailedCount:  runnerObj.FailedCount,
-		SuccessCount: runnerObj.SuccessCount,
-		RawDuration:  runnerObj.Duration,
-		Duration:     duration,
-		StartedAt:    startedAt,
-		EndedAt:      endedAt,
-		CreatedAt:    time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
-		UpdatedAt:    time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		ID:              runnerObj.ID,
+		Status:          runnerObj.Status,
+		Message:         string(runnerObj.Message),
+		FailedCount:     runnerObj.FailedCount,
+		DryRun:          runnerObj.DryRun,
+		ReclaimableSize: runnerObj.ReclaimableSize,
+		SuccessCount:    runnerObj.SuccessCount,
+		RawDuration:     runnerObj.Duration,
+		Duration:        duration,
+		StartedAt:       startedAt,
+		EndedAt:         endedAt,
+		CreatedAt:       time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt:       time.Unix(0, int64(time.Millisecond)*ruleObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 	})
 }
 
@@ -303,7 +321,7 @@ func (h *handler) CreateGcTagRunner(c echo.Context) error {
 		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, "The gc tag rule is running")
 	}
 	err = query.Q.Transaction(func(tx *query.Query) error {
-		runnerObj := &models.DaemonGcTagRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending, OperateType: enums.OperateTypeManual}
+		runnerObj := &models.DaemonGcTagRunner{RuleID: ruleObj.ID, Status: enums.TaskCommonStatusPending, DryRun: req.DryRun, OperateType: enums.OperateTypeManual}
 		err = daemonService.CreateGcTagRunner(ctx, runnerObj)
 		if err != nil {
 			log.Error().Int64("RuleID", ruleObj.ID).Msgf("Create gc tag runner failed: %v", err)
@@ -397,17 +415,19 @@ func (h *handler) ListGcTagRunners(c echo.Context) error {
 			duration = ptr.Of(durafmt.ParseShort(time.Millisecond * time.Duration(ptr.To(runnerObj.Duration))).String())
 		}
 		resp = append(resp, types.GcTagRunnerItem{
-			ID:           runnerObj.ID,
-			Status:       runnerObj.Status,
-			Message:      string(runnerObj.Message),
-			SuccessCount: runnerObj.SuccessCount,
-			FailedCount:  runnerObj.FailedCount,
-			RawDuration:  runnerObj.Duration,
-			Duration:     duration,
-			StartedAt:    startedAt,
-			EndedAt:      endedAt,
-			CreatedAt:    time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
-			UpdatedAt:    time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+			ID:              runnerObj.ID,
+			Status:          runnerObj.Status,
+			Message:         string(runnerObj.Message),
+			SuccessCount:    runnerObj.SuccessCount,
+			FailedCount:     runnerObj.FailedCount,
+			DryRun:          runnerObj.DryRun,
+			ReclaimableSize: runnerObj.ReclaimableSize,
+			RawDuration:     runnerObj.Duration,
+			Duration:        duration,
+			StartedAt:       startedAt,
+			EndedAt:         endedAt,
+			CreatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+			UpdatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 		})
 	}
 	return c.JSON(http.StatusOK, types.CommonList{Total: total, Items: resp})
@@ -462,17 +482,19 @@ func (h *handler) GetGcTagRunner(c echo.Context) error {
 		duration = ptr.Of(durafmt.ParseShort(time.Millisecond * time.Duration(ptr.To(runnerObj.Duration))).String())
 	}
 	return c.JSON(http.StatusOK, types.GcTagRunnerItem{
-		ID:           runnerObj.ID,
-		Status:       runnerObj.Status,
-		Message:      string(runnerObj.Message),
-		SuccessCount: runnerObj.SuccessCount,
-		FailedCount:  runnerObj.FailedCount,
-		RawDuration:  runnerObj.Duration,
-		Duration:     duration,
-		StartedAt:    startedAt,
-		EndedAt:      endedAt,
-		CreatedAt:    time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
-		UpdatedAt:    time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		ID:              runnerObj.ID,
+		Status:          runnerObj.Status,
+		Message:         string(runnerObj.Message),
+		SuccessCount:    runnerObj.SuccessCount,
+		FailedCount:     runnerObj.FailedCount,
+		DryRun:          runnerObj.DryRun,
+		ReclaimableSize: runnerObj.ReclaimableSize,
+		RawDuration:     runnerObj.Duration,
+		Duration:        duration,
+		StartedAt:       startedAt,
+		EndedAt:         endedAt,
+		CreatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt:       time.Unix(0, int64(time.Millisecond)*runnerObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 	})
 }
 
@@ -515,6 +537,7 @@ func (h *handler) ListGcTagRecords(c echo.Context) error {
 			ID:        recordObj.ID,
 			Tag:       recordObj.Tag,
 			Status:    recordObj.Status,
+			Size:      recordObj.Size,
 			Message:   string(recordObj.Message),
 			CreatedAt: time.Unix(0, int64(time.Millisecond)*recordObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 			UpdatedAt: time.Unix(0, int64(time.Millisecond)*recordObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
@@ -578,6 +601,7 @@ func (h *handler) GetGcTagRecord(c echo.Context) error {
 		ID:        recordObj.ID,
 		Tag:       recordObj.Tag,
 		Status:    recordObj.Status,
+		Size:      recordObj.Size,
 		Message:   string(recordObj.Message),
 		CreatedAt: time.Unix(0, int64(time.Millisecond)*recordObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 		UpdatedAt: time.Unix(0, int64(time.Millisecond)*recordObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
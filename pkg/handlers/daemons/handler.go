@@ -98,6 +98,36 @@ type Handler interface {
 	ListGcBlobRecords(c echo.Context) error
 	// GetGcBlobRecord ...
 	GetGcBlobRecord(c echo.Context) error
+
+	// UpdateGcBlobUploadRule ...
+	UpdateGcBlobUploadRule(c echo.Context) error
+	// GetGcBlobUploadRule ...
+	GetGcBlobUploadRule(c echo.Context) error
+	// GetGcBlobUploadLatestRunner ...
+	GetGcBlobUploadLatestRunner(c echo.Context) error
+	// CreateGcBlobUploadRunner ...
+	CreateGcBlobUploadRunner(c echo.Context) error
+	// ListGcBlobUploadRunners ...
+	ListGcBlobUploadRunners(c echo.Context) error
+	// GetGcBlobUploadRunner ...
+	GetGcBlobUploadRunner(c echo.Context) error
+	// ListGcBlobUploadRecords ...
+	ListGcBlobUploadRecords(c echo.Context) error
+	// GetGcBlobUploadRecord ...
+	GetGcBlobUploadRecord(c echo.Context) error
+
+	// UpdateGcPipelineRule ...
+	UpdateGcPipelineRule(c echo.Context) error
+	// GetGcPipelineRule ...
+	GetGcPipelineRule(c echo.Context) error
+	// GetGcPipelineLatestRunner ...
+	GetGcPipelineLatestRunner(c echo.Context) error
+	// CreateGcPipelineRunner ...
+	CreateGcPipelineRunner(c echo.Context) error
+	// ListGcPipelineRunners ...
+	ListGcPipelineRunners(c echo.Context) error
+	// GetGcPipelineRunner ...
+	GetGcPipelineRunner(c echo.Context) error
 }
 
 var _ Handler = &handler{}
@@ -177,6 +207,22 @@ func (f factory) Initialize(e *echo.Echo) error {
 	daemonGroup.GET("/gc-blob/:namespace_id/runners/:runner_id/records/", daemonHandler.ListGcBlobRecords)
 	daemonGroup.GET("/gc-blob/:namespace_id/runners/:runner_id/records/:record_id", daemonHandler.GetGcBlobRecord)
 
+	daemonGroup.PUT("/gc-blob-upload/:namespace_id/", daemonHandler.UpdateGcBlobUploadRule)
+	daemonGroup.GET("/gc-blob-upload/:namespace_id/", daemonHandler.GetGcBlobUploadRule)
+	daemonGroup.GET("/gc-blob-upload/:namespace_id/runners/latest", daemonHandler.GetGcBlobUploadLatestRunner)
+	daemonGroup.POST("/gc-blob-upload/:namespace_id/runners/", daemonHandler.CreateGcBlobUploadRunner)
+	daemonGroup.GET("/gc-blob-upload/:namespace_id/runners/", daemonHandler.ListGcBlobUploadRunners)
+	daemonGroup.GET("/gc-blob-upload/:namespace_id/runners/:runner_id", daemonHandler.GetGcBlobUploadRunner)
+	daemonGroup.GET("/gc-blob-upload/:namespace_id/runners/:runner_id/records/", daemonHandler.ListGcBlobUploadRecords)
+	daemonGroup.GET("/gc-blob-upload/:namespace_id/runners/:runner_id/records/:record_id", daemonHandler.GetGcBlobUploadRecord)
+
+	daemonGroup.PUT("/gc/", daemonHandler.UpdateGcPipelineRule)
+	daemonGroup.GET("/gc/", daemonHandler.GetGcPipelineRule)
+	daemonGroup.GET("/gc/runners/latest", daemonHandler.GetGcPipelineLatestRunner)
+	daemonGroup.POST("/gc/runners/", daemonHandler.CreateGcPipelineRunner)
+	daemonGroup.GET("/gc/runners/", daemonHandler.ListGcPipelineRunners)
+	daemonGroup.GET("/gc/runners/:runner_id", daemonHandler.GetGcPipelineRunner)
+
 	return nil
 }
 
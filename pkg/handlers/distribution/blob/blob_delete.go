@@ -18,6 +18,7 @@ import (
 	"errors"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/opencontainers/go-digest"
@@ -92,7 +93,9 @@ func (h *handler) DeleteBlob(c echo.Context) error {
 		log.Error().Err(err).Str("digest", dgest.String()).Msg("Parse content length failed")
 		return xerrors.NewDSError(c, xerrors.DSErrCodeBlobUnknown)
 	}
-	result, err := blobService.FindAssociateWithArtifact(ctx, []int64{blobObj.ID})
+	// the blob referenced by the artifact still in the recycle bin can not be deleted
+	recycleBinAfter := time.Now().Add(-h.config.RecycleBin.Retention).UnixMilli()
+	result, err := blobService.FindAssociateWithArtifact(ctx, []int64{blobObj.ID}, recycleBinAfter)
 	if err != nil {
 		log.Error().Err(err).Str("digest", dgest.String()).Msg("Find associate with artifact failed")
 		return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
@@ -97,6 +97,27 @@ func (h *handler) HeadBlob(c echo.Context) error {
 		return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
 	}
 
+	// the push client will skip the blob upload if the blob exists, so we mark the blob as checked
+	// to protect it from the gc, and then verify the blob is not deleted by the gc in the meantime.
+	blobService := h.blobServiceFactory.New()
+	err = blobService.Touch(ctx, []string{dgest.String()})
+	if err != nil {
+		log.Error().Err(err).Str("digest", dgest.String()).Msg("Touch blob failed")
+		return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
+	}
+	exist, err := blobService.Exists(ctx, dgest.String())
+	if err != nil {
+		log.Error().Err(err).Str("digest", dgest.String()).Msg("Check blob exist failed")
+		return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
+	}
+	if !exist {
+		err = cacher.Del(ctx, dgest.String())
+		if err != nil {
+			log.Error().Err(err).Str("digest", dgest.String()).Msg("Delete blob cache failed")
+		}
+		return xerrors.NewDSError(c, xerrors.DSErrCodeBlobUnknown)
+	}
+
 	c.Response().Header().Set("Content-Length", fmt.Sprintf("%d", blobObj.Size))
 	return c.NoContent(http.StatusOK)
 }
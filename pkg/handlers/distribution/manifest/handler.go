@@ -52,6 +52,8 @@ type handler struct {
 	tagServiceFactory        dao.TagServiceFactory
 	artifactServiceFactory   dao.ArtifactServiceFactory
 	blobServiceFactory       dao.BlobServiceFactory
+	policyServiceFactory     dao.PolicyServiceFactory
+	signingKeyServiceFactory dao.SigningKeyServiceFactory
 }
 
 type inject struct {
@@ -63,6 +65,8 @@ type inject struct {
 	tagServiceFactory        dao.TagServiceFactory
 	artifactServiceFactory   dao.ArtifactServiceFactory
 	blobServiceFactory       dao.BlobServiceFactory
+	policyServiceFactory     dao.PolicyServiceFactory
+	signingKeyServiceFactory dao.SigningKeyServiceFactory
 }
 
 // New creates a new instance of the distribution manifest handlers
@@ -75,6 +79,8 @@ func handlerNew(injects ...inject) Handler {
 	tagServiceFactory := dao.NewTagServiceFactory()
 	artifactServiceFactory := dao.NewArtifactServiceFactory()
 	blobServiceFactory := dao.NewBlobServiceFactory()
+	policyServiceFactory := dao.NewPolicyServiceFactory()
+	signingKeyServiceFactory := dao.NewSigningKeyServiceFactory()
 	if len(injects) > 0 {
 		ij := injects[0]
 		if ij.config != nil {
@@ -101,6 +107,12 @@ func handlerNew(injects ...inject) Handler {
 		if ij.blobServiceFactory != nil {
 			blobServiceFactory = ij.blobServiceFactory
 		}
+		if ij.policyServiceFactory != nil {
+			policyServiceFactory = ij.policyServiceFactory
+		}
+		if ij.signingKeyServiceFactory != nil {
+			signingKeyServiceFactory = ij.signingKeyServiceFactory
+		}
 	}
 	return &handler{
 		config:                   config,
@@ -111,6 +123,8 @@ func handlerNew(injects ...inject) Handler {
 		artifactServiceFactory:   artifactServiceFactory,
 		tagServiceFactory:        tagServiceFactory,
 		blobServiceFactory:       blobServiceFactory,
+		policyServiceFactory:     policyServiceFactory,
+		signingKeyServiceFactory: signingKeyServiceFactory,
 	}
 }
 
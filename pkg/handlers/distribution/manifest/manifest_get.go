@@ -25,6 +25,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
 	"github.com/go-sigma/sigma/pkg/types/enums"
 	"github.com/go-sigma/sigma/pkg/utils"
 	"github.com/go-sigma/sigma/pkg/utils/imagerefs"
@@ -95,28 +96,22 @@ func (h *handler) GetManifest(c echo.Context) error {
 
 	var refs = h.parseRef(ref)
 
+	checkPolicies := func(artifactObj *models.Artifact) (*xerrors.ErrCode, error) {
+		return h.checkPolicies(ctx, user, namespaceObj, repositoryObj, artifactObj, refs.Tag != "")
+	}
+
+	var tagID int64
 	if refs.Tag != "" {
 		tagService := h.tagServiceFactory.New()
 		tag, err := tagService.GetByName(ctx, repositoryObj.ID, ref)
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) && h.config.Proxy.Enabled {
-				return h.getManifestFallbackProxy(c, refs)
+				return h.getManifestFallbackProxy(c, refs, nil, checkPolicies)
 			}
 			log.Error().Err(err).Str("ref", ref).Msg("Get artifact failed")
 			return xerrors.NewDSError(c, xerrors.DSErrCodeManifestUnknown)
 		}
-		if h.config.Proxy.Enabled { // we also check the manifest in remote proxy server
-			err = h.getManifestFallbackProxy(c, refs)
-			if err != nil {
-				log.Error().Err(err).Msg("Additional check remote proxy server failed")
-			} else {
-				return nil
-			}
-		}
-		err = tagService.Incr(ctx, tag.ID)
-		if err != nil {
-			log.Error().Err(err).Str("ref", ref).Msg("Incr tag failed")
-		}
+		tagID = tag.ID
 		refs.Digest = digest.Digest(tag.Artifact.Digest)
 	}
 
@@ -124,13 +119,23 @@ func (h *handler) GetManifest(c echo.Context) error {
 	artifact, err := artifactService.GetByDigest(ctx, repositoryObj.ID, refs.Digest.String())
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) && h.config.Proxy.Enabled {
-			return h.getManifestFallbackProxy(c, refs)
+			return h.getManifestFallbackProxy(c, refs, nil, checkPolicies)
 		}
 		log.Error().Err(err).Str("ref", ref).Msg("Get artifact failed")
 		return xerrors.NewDSError(c, xerrors.DSErrCodeManifestUnknown)
 	}
+
+	errCode, err := checkPolicies(artifact)
+	if err != nil {
+		log.Error().Err(err).Str("ref", ref).Msg("Check policies failed")
+		return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
+	}
+	if errCode != nil {
+		return xerrors.NewDSError(c, *errCode)
+	}
+
 	if h.config.Proxy.Enabled { // we also check the manifest in remote proxy server
-		err = h.getManifestFallbackProxy(c, refs)
+		err = h.getManifestFallbackProxy(c, refs, artifact, checkPolicies)
 		if err != nil {
 			log.Error().Err(err).Msg("Additional check remote proxy server failed")
 		} else {
@@ -138,17 +143,46 @@ func (h *handler) GetManifest(c echo.Context) error {
 		}
 	}
 
+	if tagID != 0 { // only the pulls passed the policies are counted
+		err = h.tagServiceFactory.New().Incr(ctx, tagID)
+		if err != nil {
+			log.Error().Err(err).Str("ref", ref).Msg("Incr tag failed")
+		}
+	}
+
 	return c.Blob(http.StatusOK, artifact.ContentType, artifact.Raw)
 }
 
-// getManifestFallbackProxy ...
-func (h *handler) getManifestFallbackProxy(c echo.Context, refs Refs) error {
+// getManifestFallbackProxy returns the manifest from the remote proxy server, the pull policies are checked
+// unless the manifest is the same as the local artifact which is checked already, the artifact is nil if not exist locally.
+func (h *handler) getManifestFallbackProxy(c echo.Context, refs Refs, artifactObj *models.Artifact,
+	checkPolicies func(artifactObj *models.Artifact) (*xerrors.ErrCode, error)) error {
+	if artifactObj == nil { // the proxied manifest is not stored before it's returned, so it's checked without any evidence
+		errCode, err := checkPolicies(nil)
+		if err != nil {
+			log.Error().Err(err).Interface("refs", refs).Msg("Check policies failed")
+			return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
+		}
+		if errCode != nil {
+			return xerrors.NewDSError(c, *errCode)
+		}
+	}
 	statusCode, header, bodyBytes, err := h.fallbackProxy(c)
 	if err != nil {
 		log.Error().Err(err).Interface("refs", refs).Int("status", statusCode).Msg("Fallback proxy failed")
 		return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
 	}
 	if statusCode == http.StatusOK {
+		if artifactObj != nil && header.Get(consts.ContentDigest) != artifactObj.Digest { // the manifest has been changed in the proxy server
+			errCode, err := checkPolicies(nil)
+			if err != nil {
+				log.Error().Err(err).Interface("refs", refs).Msg("Check policies failed")
+				return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
+			}
+			if errCode != nil {
+				return xerrors.NewDSError(c, *errCode)
+			}
+		}
 		c.Response().Header().Set(consts.ContentDigest, header.Get(consts.ContentDigest))
 		c.Response().Header().Set("ETag", header.Get("ETag"))
 		return c.Blob(http.StatusOK, header.Get(echo.HeaderContentType), bodyBytes)
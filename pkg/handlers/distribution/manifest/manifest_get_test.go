@@ -15,6 +15,7 @@
 package manifest
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -22,7 +23,19 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/opencontainers/go-digest"
+	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/logger"
+	"github.com/go-sigma/sigma/pkg/tests"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/xerrors"
 )
 
 func TestGetManifestFallbackProxyAuthError(t *testing.T) {
@@ -42,7 +55,7 @@ func TestGetManifestFallbackProxyAuthError(t *testing.T) {
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
 	c := echo.New().NewContext(req, rec)
-	err := h.getManifestFallbackProxy(c, Refs{Digest: digest.Digest("sha256:f7d81d5be30e617068bf53a9b136400b13d91c0f54d097a72bf91127f43d0151")})
+	err := h.getManifestFallbackProxy(c, Refs{Digest: digest.Digest("sha256:f7d81d5be30e617068bf53a9b136400b13d91c0f54d097a72bf91127f43d0151")}, nil, func(*models.Artifact) (*xerrors.ErrCode, error) { return nil, nil })
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusInternalServerError, rec.Code)
 }
@@ -50,3 +63,100 @@ func TestGetManifestFallbackProxyAuthError(t *testing.T) {
 func TestGetManifest(t *testing.T) {
 
 }
+
+func TestGetManifestProxyPolicies(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	const (
+		namespaceName  = "test"
+		repositoryName = "test/busybox"
+		digestName     = "sha256:2776ee23722eaabcffed77dafd22b7a1da734971bf268a323b6819926dfe1ebd" // nolint: gosec
+		proxyDigest    = "sha256:f7d81d5be30e617068bf53a9b136400b13d91c0f54d097a72bf91127f43d0151" // nolint: gosec
+		tagName        = "latest"
+		rawManifest    = `{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","config":{"mediaType":"application/vnd.oci.image.config.v1+json","digest":"sha256:a61fd63bebd559934a60e30d1e7b832a136ac6bae3a11ca97ade20bfb3645796","size":800},"layers":[]}`
+	)
+
+	rootObj := &models.User{Username: "get-manifest-root", Password: ptr.Of("test"), Role: enums.UserRoleRoot, Email: ptr.Of("root@gmail.com")}
+	assert.NoError(t, dao.NewUserServiceFactory().New().Create(ctx, rootObj))
+	userObj := &models.User{Username: "get-manifest", Password: ptr.Of("test"), Role: enums.UserRoleUser, Email: ptr.Of("test@gmail.com")}
+	assert.NoError(t, dao.NewUserServiceFactory().New().Create(ctx, userObj))
+	namespaceObj := &models.Namespace{Name: namespaceName, Visibility: enums.VisibilityPublic}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+	repositoryObj := &models.Repository{NamespaceID: namespaceObj.ID, Name: repositoryName}
+	assert.NoError(t, dao.NewRepositoryServiceFactory().New().Create(ctx, repositoryObj, dao.AutoCreateNamespace{UserID: rootObj.ID}))
+	artifactObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: digestName, Size: 123, Type: enums.ArtifactTypeImage,
+		ContentType: "application/vnd.oci.image.manifest.v1+json", Raw: []byte(rawManifest)}
+	assert.NoError(t, dao.NewArtifactServiceFactory().New().Create(ctx, artifactObj))
+	assert.NoError(t, dao.NewTagServiceFactory().New().Create(ctx, &models.Tag{RepositoryID: repositoryObj.ID, ArtifactID: artifactObj.ID, Name: tagName}))
+	assert.NoError(t, dao.NewPolicyServiceFactory().New().CreateSignaturePolicy(ctx, &models.SignaturePolicy{NamespaceID: namespaceObj.ID, Enabled: true}))
+
+	var proxied int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/" {
+			proxied++
+		}
+		w.Header().Set(echo.HeaderContentType, "application/vnd.oci.image.manifest.v1+json")
+		w.Header().Set(consts.ContentDigest, proxyDigest)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(rawManifest)) // nolint: errcheck
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	h := handlerNew(inject{config: &configs.Configuration{
+		Log:   configs.ConfigurationLog{ProxyLevel: enums.LogLevelDebug},
+		Proxy: configs.ConfigurationProxy{Enabled: true, Endpoint: s.URL, TlsVerify: true},
+	}})
+
+	getManifest := func(user *models.User, ref string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/v2/%s/manifests/%s", repositoryName, ref), nil)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.Set(consts.ContextUser, user)
+		assert.NoError(t, h.GetManifest(c))
+		return rec
+	}
+
+	// the unsigned local artifact is denied before the proxy server is checked
+	rec := getManifest(userObj, tagName)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Contains(t, rec.Body.String(), "signature policy")
+	assert.Equal(t, 0, proxied)
+
+	// the manifest not exist locally is denied, it has no signature in sigma
+	rec = getManifest(userObj, "proxied")
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Contains(t, rec.Body.String(), "signature policy")
+	assert.Equal(t, 0, proxied)
+
+	// the policies are not checked for the root user, the manifest is returned by the proxy server
+	rec = getManifest(rootObj, "proxied")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, proxyDigest, rec.Header().Get(consts.ContentDigest))
+	assert.Equal(t, 1, proxied)
+
+	// the manifest changed in the proxy server is checked again even if the local artifact passed the policies
+	policyService := dao.NewPolicyServiceFactory().New()
+	signaturePolicyObj, err := policyService.GetSignaturePolicy(ctx, namespaceObj.ID)
+	assert.NoError(t, err)
+	assert.NoError(t, policyService.UpdateSignaturePolicy(ctx, signaturePolicyObj.ID, map[string]any{"enabled": false}))
+	assert.NoError(t, policyService.CreateVulnerabilityPolicy(ctx, &models.VulnerabilityPolicy{NamespaceID: namespaceObj.ID, Enabled: true,
+		MaxSeverity: enums.VulnerabilitySeverityCritical, BlockUnscanned: true}))
+	assert.NoError(t, dao.NewArtifactServiceFactory().New().CreateVulnerability(ctx, &models.ArtifactVulnerability{ArtifactID: artifactObj.ID,
+		Result: []byte(`{}`), Status: enums.TaskCommonStatusSuccess}))
+	rec = getManifest(userObj, tagName)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Contains(t, rec.Body.String(), "vulnerability policy")
+	assert.Equal(t, 2, proxied)
+}
@@ -0,0 +1,276 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/scanner"
+	"github.com/go-sigma/sigma/pkg/signing"
+	cosignverify "github.com/go-sigma/sigma/pkg/signing/cosign/verify"
+	"github.com/go-sigma/sigma/pkg/signing/definition"
+	notationverify "github.com/go-sigma/sigma/pkg/signing/notation/verify"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/license"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// checkPolicies checks the policies of the namespace before the artifact is pulled,
+// returns the error code if the artifact is denied by any policy.
+// The signature policy is only checked if the artifact is pulled by tag.
+// The artifact is nil if the manifest is pulled from the proxy server and not exist locally,
+// it's checked as an image without any signature, vulnerability result or package.
+func (h *handler) checkPolicies(ctx context.Context, user *models.User, namespaceObj *models.Namespace,
+	repositoryObj *models.Repository, artifactObj *models.Artifact, tagged bool) (*xerrors.ErrCode, error) {
+	if artifactObj != nil && artifactObj.Type != enums.ArtifactTypeImage && artifactObj.Type != enums.ArtifactTypeImageIndex {
+		return nil, nil
+	}
+	var artifactDigest string
+	if artifactObj != nil {
+		artifactDigest = artifactObj.Digest
+	}
+	if user != nil && (user.Username == consts.UserInternal || user.Role == enums.UserRoleRoot || user.Role == enums.UserRoleAdmin) {
+		return nil, nil
+	}
+	if tagged {
+		reason, err := h.signaturePolicyReason(ctx, namespaceObj, repositoryObj, artifactObj)
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			log.Info().Str("repository", repositoryObj.Name).Str("digest", artifactDigest).Str("reason", reason).Msg("Artifact denied by signature policy")
+			errCode := xerrors.GenDSErrCodeSignaturePolicyDenied(namespaceObj.Name, reason)
+			return &errCode, nil
+		}
+	}
+	if artifactObj != nil && artifactObj.Type != enums.ArtifactTypeImage {
+		return nil, nil
+	}
+	reason, err := h.vulnerabilityPolicyReason(ctx, namespaceObj, repositoryObj, artifactObj)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		log.Info().Str("repository", repositoryObj.Name).Str("digest", artifactDigest).Str("reason", reason).Msg("Artifact denied by vulnerability policy")
+		errCode := xerrors.GenDSErrCodeVulnerabilityPolicyDenied(namespaceObj.Name, reason)
+		return &errCode, nil
+	}
+	reason, err = h.licensePolicyReason(ctx, namespaceObj, repositoryObj, artifactObj)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		log.Info().Str("repository", repositoryObj.Name).Str("digest", artifactDigest).Str("reason", reason).Msg("Artifact denied by license policy")
+		errCode := xerrors.GenDSErrCodeLicensePolicyDenied(namespaceObj.Name, reason)
+		return &errCode, nil
+	}
+	return nil, nil
+}
+
+// vulnerabilityPolicyReason returns the reason if the artifact violates the vulnerability policy of the namespace
+func (h *handler) vulnerabilityPolicyReason(ctx context.Context, namespaceObj *models.Namespace,
+	repositoryObj *models.Repository, artifactObj *models.Artifact) (string, error) {
+	policyService := h.policyServiceFactory.New()
+	policyObj, err := policyService.GetVulnerabilityPolicy(ctx, namespaceObj.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	if !policyObj.Enabled || policyExempted(policyObj.Exemptions, repositoryObj.Name) {
+		return "", nil
+	}
+	var vulnerabilityObj *models.ArtifactVulnerability
+	if artifactObj != nil {
+		vulnerabilityObj, err = h.artifactServiceFactory.New().GetVulnerability(ctx, artifactObj.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", err
+		}
+	}
+	allowlist, err := policyService.ListActiveVulnerabilityAllowlist(ctx, namespaceObj.ID, repositoryObj.ID)
+	if err != nil {
+		return "", err
+	}
+	return checkVulnerabilityPolicy(policyObj, vulnerabilityObj, allowlist), nil
+}
+
+// licensePolicyReason returns the reason if the artifact violates the license policy of the namespace,
+// the artifact is only blocked if the policy is configured to block pull.
+func (h *handler) licensePolicyReason(ctx context.Context, namespaceObj *models.Namespace,
+	repositoryObj *models.Repository, artifactObj *models.Artifact) (string, error) {
+	policyObj, err := h.policyServiceFactory.New().GetLicensePolicy(ctx, namespaceObj.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	if !policyObj.Enabled || !policyObj.BlockPull || policyExempted(policyObj.Exemptions, repositoryObj.Name) {
+		return "", nil
+	}
+	// the policy may be changed after the sbom is checked, so check the packages with the current policy
+	var packageObjs []*models.ArtifactPackage
+	if artifactObj != nil {
+		packageObjs, err = h.artifactServiceFactory.New().ListPackages(ctx, artifactObj.ID)
+		if err != nil {
+			return "", err
+		}
+	}
+	return checkLicensePolicy(license.Check(policyObj, packageObjs)), nil
+}
+
+// signaturePolicyReason returns the reason if the artifact has no trusted signature required by the signature policy of the namespace
+func (h *handler) signaturePolicyReason(ctx context.Context, namespaceObj *models.Namespace,
+	repositoryObj *models.Repository, artifactObj *models.Artifact) (string, error) {
+	policyObj, err := h.policyServiceFactory.New().GetSignaturePolicy(ctx, namespaceObj.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	if !policyObj.Enabled || policyExempted(policyObj.Exemptions, repositoryObj.Name) {
+		return "", nil
+	}
+	signingKeyObjs, err := h.signingKeyServiceFactory.New().ListByNamespace(ctx, namespaceObj.ID)
+	if err != nil {
+		return "", err
+	}
+	for _, signingKeyObj := range signingKeyObjs { // the artifacts signed by the namespace keys are trusted, including the retired keys
+		policyObj.PublicKeys = strings.TrimSpace(policyObj.PublicKeys + "\n" + signingKeyObj.PublicKey)
+	}
+	if artifactObj == nil {
+		return checkSignaturePolicy(policyObj, "", nil), nil
+	}
+	signatures, err := signing.ListSignatures(ctx, h.artifactServiceFactory.New(), h.tagServiceFactory.New(), repositoryObj.ID, artifactObj.Digest)
+	if err != nil {
+		return "", err
+	}
+	return checkSignaturePolicy(policyObj, artifactObj.Digest, signatures), nil
+}
+
+// policyExempted checks the repository is in the exemptions or not
+func policyExempted(exemptions, repository string) bool {
+	for _, exemption := range strings.Split(exemptions, ",") {
+		if strings.TrimSpace(exemption) == repository {
+			return true
+		}
+	}
+	return false
+}
+
+// severityRanks the rank of the vulnerability severity, higher is more serious
+var severityRanks = map[enums.VulnerabilitySeverity]int{
+	enums.VulnerabilitySeverityNone:     0,
+	enums.VulnerabilitySeverityLow:      1,
+	enums.VulnerabilitySeverityMedium:   2,
+	enums.VulnerabilitySeverityHigh:     3,
+	enums.VulnerabilitySeverityCritical: 4,
+}
+
+// checkVulnerabilityPolicy checks the vulnerability of the artifact with the policy, the findings matched
+// the allowlist are ignored, returns the reason if the artifact violates the policy, otherwise returns empty string.
+func checkVulnerabilityPolicy(policyObj *models.VulnerabilityPolicy, vulnerabilityObj *models.ArtifactVulnerability, allowlist []*models.VulnerabilityAllowlist) string {
+	if vulnerabilityObj == nil || vulnerabilityObj.Status != enums.TaskCommonStatusSuccess {
+		if policyObj.BlockUnscanned {
+			return "artifact has not been scanned"
+		}
+		return ""
+	}
+	var summary types.VulnerabilitySummary
+	err := json.Unmarshal(vulnerabilityObj.Result, &summary)
+	if err != nil {
+		log.Error().Err(err).Int64("artifactID", vulnerabilityObj.ArtifactID).Msg("Unmarshal vulnerability result failed")
+		if policyObj.BlockUnscanned {
+			return "artifact vulnerability result is invalid"
+		}
+		return ""
+	}
+	summary = scanner.SuppressVulnerabilities(summary, allowlist)
+	maxRank := severityRanks[policyObj.MaxSeverity]
+	for _, item := range []struct {
+		severity enums.VulnerabilitySeverity
+		count    int64
+	}{
+		{enums.VulnerabilitySeverityCritical, summary.Critical},
+		{enums.VulnerabilitySeverityHigh, summary.High},
+		{enums.VulnerabilitySeverityMedium, summary.Medium},
+		{enums.VulnerabilitySeverityLow, summary.Low},
+	} {
+		if item.count > 0 && severityRanks[item.severity] > maxRank {
+			return fmt.Sprintf("found %d %s vulnerabilities, max allowed severity is %s", item.count, item.severity, policyObj.MaxSeverity)
+		}
+	}
+	return ""
+}
+
+// checkLicensePolicy checks the license check result of the artifact packages,
+// returns the reason if the artifact violates the policy, otherwise returns empty string.
+func checkLicensePolicy(result types.LicenseCheckResult) string {
+	if result.Status != enums.LicenseCheckStatusViolated || len(result.Violations) == 0 {
+		return ""
+	}
+	violation := result.Violations[0]
+	return fmt.Sprintf("found %d packages violate the license policy, %s@%s: %s", len(result.Violations), violation.Name, violation.Version, violation.Reason)
+}
+
+// checkSignaturePolicy verifies the signatures of the artifact with the trusted materials of the policy,
+// returns the reason if the artifact has no trusted signature, otherwise returns empty string.
+func checkSignaturePolicy(policyObj *models.SignaturePolicy, digest string, signatures []definition.Signature) string {
+	var identities []types.SignatureIdentity
+	if len(policyObj.KeylessIdentities) > 0 {
+		err := json.Unmarshal(policyObj.KeylessIdentities, &identities)
+		if err != nil {
+			log.Error().Err(err).Int64("policyID", policyObj.ID).Msg("Unmarshal keyless identities failed")
+			return "signature policy is invalid"
+		}
+	}
+	var notationIdentities []string
+	if len(policyObj.NotationIdentities) > 0 {
+		err := json.Unmarshal(policyObj.NotationIdentities, &notationIdentities)
+		if err != nil {
+			log.Error().Err(err).Int64("policyID", policyObj.ID).Msg("Unmarshal notation identities failed")
+			return "signature policy is invalid"
+		}
+	}
+	verifier, err := signing.NewVerifying(signing.VerifyingOptions{
+		Cosign:   cosignverify.Options{PublicKeys: policyObj.PublicKeys, Identities: identities, Roots: policyObj.KeylessRoots},
+		Notation: notationverify.Options{Roots: policyObj.NotationRoots, Identities: notationIdentities},
+	})
+	if err != nil {
+		log.Error().Err(err).Int64("policyID", policyObj.ID).Msg("Create signature verifier failed")
+		return "signature policy is invalid"
+	}
+	if len(signatures) == 0 {
+		return "artifact is not signed"
+	}
+	err = verifier.Verify(digest, signatures)
+	if err != nil {
+		log.Info().Err(err).Str("digest", digest).Msg("Verify artifact signatures failed")
+		return "artifact is not signed by the trusted keys or identities"
+	}
+	return ""
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/signing/definition"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/license"
+)
+
+func TestPolicyExempted(t *testing.T) {
+	assert.True(t, policyExempted("library/busybox,library/alpine", "library/alpine"))
+	assert.True(t, policyExempted("library/busybox, library/alpine", "library/alpine"))
+	assert.False(t, policyExempted("library/busybox", "library/alpine"))
+	assert.False(t, policyExempted("", "library/alpine"))
+}
+
+func TestCheckVulnerabilityPolicy(t *testing.T) {
+	policyObj := &models.VulnerabilityPolicy{Enabled: true, MaxSeverity: enums.VulnerabilitySeverityMedium}
+	assert.Equal(t, "", checkVulnerabilityPolicy(policyObj, nil, nil))
+
+	policyObj.BlockUnscanned = true
+	assert.NotEqual(t, "", checkVulnerabilityPolicy(policyObj, nil, nil))
+	assert.NotEqual(t, "", checkVulnerabilityPolicy(policyObj, &models.ArtifactVulnerability{Status: enums.TaskCommonStatusFailed}, nil))
+	assert.NotEqual(t, "", checkVulnerabilityPolicy(policyObj, &models.ArtifactVulnerability{Status: enums.TaskCommonStatusSuccess, Result: []byte("{")}, nil))
+
+	vulnerabilityObj := &models.ArtifactVulnerability{Status: enums.TaskCommonStatusSuccess, Result: []byte(`{"critical":0,"high":0,"medium":3,"low":10}`)}
+	assert.Equal(t, "", checkVulnerabilityPolicy(policyObj, vulnerabilityObj, nil))
+
+	vulnerabilityObj.Result = []byte(`{"critical":0,"high":1,"medium":3,"low":10}`)
+	assert.Equal(t, "found 1 High vulnerabilities, max allowed severity is Medium", checkVulnerabilityPolicy(policyObj, vulnerabilityObj, nil))
+
+	policyObj.MaxSeverity = enums.VulnerabilitySeverityCritical
+	assert.Equal(t, "", checkVulnerabilityPolicy(policyObj, vulnerabilityObj, nil))
+
+	policyObj.MaxSeverity = enums.VulnerabilitySeverityNone
+	vulnerabilityObj.Result = []byte(`{"critical":0,"high":0,"medium":0,"low":1}`)
+	assert.NotEqual(t, "", checkVulnerabilityPolicy(policyObj, vulnerabilityObj, nil))
+
+	vulnerabilityObj.Result = []byte(`{"critical":0,"high":0,"medium":0,"low":1,"findings":[{"id":"CVE-2023-0001","package":"zlib","severity":"Low"}]}`)
+	assert.NotEqual(t, "", checkVulnerabilityPolicy(policyObj, vulnerabilityObj, nil))
+	assert.Equal(t, "", checkVulnerabilityPolicy(policyObj, vulnerabilityObj, []*models.VulnerabilityAllowlist{{VulnerabilityID: "CVE-2023-0001"}}))
+}
+
+func TestCheckLicensePolicy(t *testing.T) {
+	packages := []*models.ArtifactPackage{
+		{Name: "musl", Version: "1.2.4-r2", Type: "apk", Licenses: "MIT"},
+		{Name: "mongo", Version: "7.0.0", Type: "go-module", Licenses: "AGPL-3.0-only"},
+	}
+	assert.Equal(t, "", checkLicensePolicy(types.LicenseCheckResult{}))
+	assert.Equal(t, "", checkLicensePolicy(types.LicenseCheckResult{Status: enums.LicenseCheckStatusWarning}))
+	assert.Equal(t, "", checkLicensePolicy(license.Check(&models.LicensePolicy{DeniedLicenses: "GPL-2.0-only"}, packages)))
+	assert.Equal(t, "found 1 packages violate the license policy, mongo@7.0.0: license AGPL-3.0-only is denied",
+		checkLicensePolicy(license.Check(&models.LicensePolicy{DeniedLicenses: "AGPL-3.0-only"}, packages)))
+}
+
+func TestCheckSignaturePolicy(t *testing.T) {
+	const digest = "sha256:2776ee23722eaabcffed77dafd22b7a1da734971bf268a323b6819926dfe1ebd"
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	assert.NoError(t, err)
+	policyObj := &models.SignaturePolicy{Enabled: true, PublicKeys: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))}
+
+	payload := []byte(fmt.Sprintf(`{"critical":{"image":{"docker-manifest-digest":"%s"},"type":"cosign container image signature"}}`, digest))
+	hashed := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, hashed[:])
+	assert.NoError(t, err)
+	signature := definition.Signature{Payload: payload, Signature: base64.StdEncoding.EncodeToString(sig)}
+
+	assert.Equal(t, "", checkSignaturePolicy(policyObj, digest, []definition.Signature{signature}))
+	assert.Equal(t, "artifact is not signed", checkSignaturePolicy(policyObj, digest, nil))
+	assert.Equal(t, "artifact is not signed by the trusted keys or identities",
+		checkSignaturePolicy(policyObj, "sha256:96526aa774ef0126ad0fe9e9a95764c5fc37f409ab9e97021e7b4775d82bf6fa", []definition.Signature{signature}))
+	assert.Equal(t, "signature policy is invalid", checkSignaturePolicy(&models.SignaturePolicy{Enabled: true, PublicKeys: "invalid"}, digest, []definition.Signature{signature}))
+	assert.Equal(t, "signature policy is invalid", checkSignaturePolicy(&models.SignaturePolicy{Enabled: true, KeylessIdentities: []byte("invalid")}, digest, []definition.Signature{signature}))
+}
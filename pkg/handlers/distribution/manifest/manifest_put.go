@@ -23,6 +23,7 @@ import (
 	"path"
 	"strings"
 
+	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/distribution/distribution/v3"
 	"github.com/labstack/echo/v4"
 	"github.com/opencontainers/go-digest"
@@ -118,12 +119,14 @@ func (h *handler) PutManifest(c echo.Context) error {
 
 	refs := h.parseRef(ref)
 
-	repositoryService := h.repositoryServiceFactory.New()
 	repositoryObj := &models.Repository{Name: repository}
-	err = repositoryService.Create(ctx, repositoryObj, dao.AutoCreateNamespace{
-		AutoCreate: h.config.Namespace.AutoCreate,
-		Visibility: h.config.Namespace.Visibility,
-		UserID:     user.ID,
+	err = query.Q.Transaction(func(tx *query.Query) error { // the namespace count of the user is increased with the namespace auto created
+		repositoryService := h.repositoryServiceFactory.New(tx)
+		return repositoryService.Create(ctx, repositoryObj, dao.AutoCreateNamespace{
+			AutoCreate: h.config.Namespace.AutoCreate,
+			Visibility: h.config.Namespace.Visibility,
+			UserID:     user.ID,
+		})
 	})
 	if err != nil {
 		log.Error().Err(err).Str("repository", repository).Msg("Create repository failed")
@@ -180,6 +183,16 @@ func (h *handler) PutManifest(c echo.Context) error {
 		artifactObj.ID = tryFindArtifactObj.ID
 	}
 
+	err = h.checkSizeQuota(c, namespaceObj, repositoryObj, artifactObj, digests)
+	if err != nil {
+		log.Error().Err(err).Str("repository", repositoryObj.Name).Str("digest", refs.Digest.String()).Msg("Check size quota failed")
+		e, ok := err.(xerrors.ErrCode)
+		if ok {
+			return xerrors.NewDSError(c, e)
+		}
+		return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
+	}
+
 	if contentType == "application/vnd.docker.distribution.manifest.list.v2+json" ||
 		contentType == "application/vnd.oci.image.index.v1+json" {
 		artifactObj.Type = enums.ArtifactTypeImageIndex
@@ -192,6 +205,16 @@ func (h *handler) PutManifest(c echo.Context) error {
 			return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
 		}
 	} else {
+		// mark the referenced blobs as checked before reading them, so the gc will not delete them during the push
+		var referenceDigests = make([]string, 0, len(manifest.References()))
+		for _, reference := range manifest.References() {
+			referenceDigests = append(referenceDigests, reference.Digest.String())
+		}
+		err := h.blobServiceFactory.New().Touch(ctx, referenceDigests)
+		if err != nil {
+			log.Error().Err(err).Str("digest", refs.Digest.String()).Msg("Touch blobs failed")
+			return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
+		}
 		for _, reference := range manifest.References() {
 			if reference.MediaType == "application/vnd.oci.image.config.v1+json" || reference.MediaType == "application/vnd.docker.container.image.v1+json" || reference.MediaType == "application/vnd.cncf.helm.config.v1+json" || reference.MediaType == "application/vnd.sylabs.sif.config.v1+json" {
 				configRawReader, err := storage.Driver.Reader(ctx, path.Join(consts.Blobs, utils.GenPathByDigest(reference.Digest)))
@@ -210,8 +233,10 @@ func (h *handler) PutManifest(c echo.Context) error {
 			digests = append(digests, reference.Digest.String())
 		}
 
+		artifactObj.ArtifactType = h.getManifestArtifactType(manifest)
+
 		artifactObj.Type = h.getArtifactType(descriptor, manifest)
-		err := h.putManifestManifest(ctx, user, digests, repositoryObj, artifactObj, refs, manifest, descriptor)
+		err = h.putManifestManifest(ctx, user, digests, repositoryObj, artifactObj, refs, manifest, descriptor)
 		if err != nil {
 			e, ok := err.(xerrors.ErrCode)
 			if ok {
@@ -235,6 +260,19 @@ func (h *handler) putManifestManifest(ctx context.Context, user *models.User, di
 		log.Error().Err(err).Str("digest", refs.Digest.String()).Msg("Find blobs failed")
 		return xerrors.DSErrCodeUnknown
 	}
+	var foundDigests = mapset.NewSet[string]()
+	for _, blobObj := range blobObjs {
+		foundDigests.Add(blobObj.Digest)
+	}
+	for _, reference := range manifest.References() {
+		if len(reference.URLs) > 0 { // foreign layer is not stored in the registry
+			continue
+		}
+		if !foundDigests.Contains(reference.Digest.String()) {
+			log.Error().Str("digest", refs.Digest.String()).Str("blob", reference.Digest.String()).Msg("Manifest blob not found")
+			return xerrors.DSErrCodeManifestBlobUnknown
+		}
+	}
 
 	artifactObj.Blobs = blobObjs
 
@@ -278,6 +316,8 @@ func (h *handler) putManifestManifest(ctx context.Context, user *models.User, di
 		if workq.ProducerClient != nil {
 			err = workq.ProducerClient.Produce(ctx, enums.DaemonArtifactPushed, types.DaemonArtifactPushedPayload{
 				RepositoryID: repositoryObj.ID,
+				ArtifactID:   artifactObj.ID,
+				Tag:          refs.Tag,
 			}, definition.ProducerOption{Tx: tx})
 			if err != nil {
 				log.Error().Err(err).Str("tag", refs.Tag).Str("digest", refs.Digest.String()).Msg("Enqueue artifact pushed task failed")
@@ -410,6 +450,7 @@ func (h *handler) putManifestAsyncTaskVulnerability(ctx context.Context, artifac
 	err := artifactService.CreateVulnerability(ctx, &models.ArtifactVulnerability{
 		ArtifactID: artifactObj.ID,
 		Status:     enums.TaskCommonStatusPending,
+		ScanStatus: enums.TaskCommonStatusPending,
 	})
 	if err != nil {
 		log.Error().Err(err).Msg("Save vulnerability failed")
@@ -426,9 +467,49 @@ func (h *handler) putManifestAsyncTaskVulnerability(ctx context.Context, artifac
 	}
 }
 
+// putManifestAsyncTaskContentScan enqueues the secret and misconfiguration scan tasks if the namespace enabled them
+func (h *handler) putManifestAsyncTaskContentScan(ctx context.Context, artifactObj *models.Artifact) {
+	namespaceObj, err := h.namespaceServiceFactory.New().Get(ctx, artifactObj.NamespaceID)
+	if err != nil {
+		log.Error().Err(err).Int64("namespaceID", artifactObj.NamespaceID).Msg("Get namespace failed")
+		return
+	}
+	artifactService := h.artifactServiceFactory.New()
+	if namespaceObj.SecretScan {
+		err = artifactService.CreateSecret(ctx, &models.ArtifactSecret{
+			ArtifactID: artifactObj.ID,
+			Status:     enums.TaskCommonStatusPending,
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Save secret failed")
+		} else {
+			err = workq.ProducerClient.Produce(ctx, enums.DaemonSecret, types.TaskSecret{ArtifactID: artifactObj.ID}, definition.ProducerOption{})
+			if err != nil {
+				log.Error().Err(err).Interface("artifactObj", artifactObj).Msg("Enqueue task failed")
+			}
+		}
+	}
+	if namespaceObj.MisconfigurationScan {
+		err = artifactService.CreateMisconfiguration(ctx, &models.ArtifactMisconfiguration{
+			ArtifactID: artifactObj.ID,
+			Status:     enums.TaskCommonStatusPending,
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Save misconfiguration failed")
+			return
+		}
+		err = workq.ProducerClient.Produce(ctx, enums.DaemonMisconfiguration, types.TaskMisconfiguration{ArtifactID: artifactObj.ID}, definition.ProducerOption{})
+		if err != nil {
+			log.Error().Err(err).Interface("artifactObj", artifactObj).Msg("Enqueue task failed")
+			return
+		}
+	}
+}
+
 func (h *handler) putManifestAsyncTask(ctx context.Context, artifactObj *models.Artifact) {
 	h.putManifestAsyncTaskSbom(ctx, artifactObj)
 	h.putManifestAsyncTaskVulnerability(ctx, artifactObj)
+	h.putManifestAsyncTaskContentScan(ctx, artifactObj)
 }
 
 func (h *handler) getArtifactType(descriptor distribution.Descriptor, manifest distribution.Manifest) enums.ArtifactType {
@@ -464,6 +545,27 @@ func (h *handler) getArtifactType(descriptor distribution.Descriptor, manifest d
 	return enums.ArtifactTypeUnknown
 }
 
+// getManifestArtifactType returns the artifactType of the oci manifest, the config media type is used if the artifactType
+// is not set, see https://github.com/opencontainers/distribution-spec/blob/main/spec.md#listing-referrers
+func (h *handler) getManifestArtifactType(manifest distribution.Manifest) *string {
+	mediaType, data, err := manifest.Payload()
+	if err != nil || mediaType != imgspecv1.MediaTypeImageManifest {
+		return nil
+	}
+	var decoded imgspecv1.Manifest
+	err = json.Unmarshal(data, &decoded)
+	if err != nil {
+		return nil
+	}
+	if decoded.ArtifactType != "" {
+		return ptr.Of(decoded.ArtifactType)
+	}
+	if decoded.Config.MediaType != "" {
+		return ptr.Of(decoded.Config.MediaType)
+	}
+	return nil
+}
+
 // getArtifactReferrer ...
 func (h *handler) getArtifactReferrer(ctx context.Context, repository string, manifest distribution.Manifest) (*int64, error) {
 	mediaType, data, err := manifest.Payload()
@@ -23,7 +23,9 @@ import (
 	"testing"
 
 	"github.com/alicebob/miniredis/v2"
+	"github.com/distribution/distribution/v3"
 	"github.com/labstack/echo/v4"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -63,18 +65,32 @@ func TestPutManifestAsyncTask(t *testing.T) {
 	daoMockArtifactService.EXPECT().CreateVulnerability(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ *models.ArtifactVulnerability) error {
 		return fmt.Errorf("test")
 	}).Times(1)
+	daoMockArtifactService.EXPECT().CreateSecret(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ *models.ArtifactSecret) error {
+		return fmt.Errorf("test")
+	}).Times(1)
 
 	daoMockArtifactServiceFactory := daomock.NewMockArtifactServiceFactory(ctrl)
 	daoMockArtifactServiceFactory.EXPECT().New(gomock.Any()).DoAndReturn(func(txs ...*query.Query) dao.ArtifactService {
 		return daoMockArtifactService
-	}).Times(2)
+	}).Times(3)
+
+	daoMockNamespaceService := daomock.NewMockNamespaceService(ctrl)
+	daoMockNamespaceService.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, id int64) (*models.Namespace, error) {
+		return &models.Namespace{ID: id, SecretScan: true}, nil
+	}).Times(1)
+
+	daoMockNamespaceServiceFactory := daomock.NewMockNamespaceServiceFactory(ctrl)
+	daoMockNamespaceServiceFactory.EXPECT().New(gomock.Any()).DoAndReturn(func(txs ...*query.Query) dao.NamespaceService {
+		return daoMockNamespaceService
+	}).Times(1)
 
 	h := &handler{
-		artifactServiceFactory: daoMockArtifactServiceFactory,
+		artifactServiceFactory:  daoMockArtifactServiceFactory,
+		namespaceServiceFactory: daoMockNamespaceServiceFactory,
 	}
 
 	ctx := log.Logger.WithContext(context.Background())
-	h.putManifestAsyncTask(ctx, &models.Artifact{ID: 1})
+	h.putManifestAsyncTask(ctx, &models.Artifact{ID: 1, NamespaceID: 1})
 }
 
 func TestPutManifest(t *testing.T) {
@@ -137,3 +153,15 @@ func TestPutManifest(t *testing.T) {
 	assert.NoError(t, h.PutManifest(c))
 	assert.Equal(t, http.StatusCreated, rec.Code)
 }
+
+func TestGetManifestArtifactType(t *testing.T) {
+	h := &handler{}
+
+	manifest, _, err := distribution.UnmarshalManifest(imgspecv1.MediaTypeImageManifest, []byte(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","artifactType":"application/spdx+json","config":{"mediaType":"application/vnd.oci.empty.v1+json","digest":"sha256:44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a","size":2},"layers":[{"mediaType":"application/spdx+json","digest":"sha256:e45dd3e880e94bdb52cc88d6b4e0fbaec6876856f39a1a89f76e64d0739c2904","size":37869}]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "application/spdx+json", ptr.To(h.getManifestArtifactType(manifest)))
+
+	manifest, _, err = distribution.UnmarshalManifest(imgspecv1.MediaTypeImageManifest, []byte(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","config":{"mediaType":"application/vnd.oci.image.config.v1+json","digest":"sha256:44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a","size":2},"layers":[]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "application/vnd.oci.image.config.v1+json", ptr.To(h.getManifestArtifactType(manifest)))
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/handlers/distribution"
+)
+
+// checkSizeQuota checks the size quota with the manifest and the blobs that not referenced by the namespace or repository yet,
+// so the layers shared with the existing artifacts are not counted again. The soft quota warnings are set to the response header.
+func (h *handler) checkSizeQuota(c echo.Context, namespaceObj *models.Namespace, repositoryObj *models.Repository, artifactObj *models.Artifact, digests []string) error {
+	if namespaceObj.SizeLimit <= 0 && namespaceObj.SizeSoftLimit <= 0 && repositoryObj.SizeLimit <= 0 && repositoryObj.SizeSoftLimit <= 0 {
+		return nil
+	}
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	var manifestSize int64
+	if artifactObj.ID == 0 { // the manifest of the existing artifact is counted already
+		manifestSize = artifactObj.Size
+	}
+	blobObjs, err := h.blobServiceFactory.New().FindByDigests(ctx, digests)
+	if err != nil {
+		return err
+	}
+	var blobIDs = make([]int64, 0, len(blobObjs))
+	for _, blobObj := range blobObjs {
+		blobIDs = append(blobIDs, blobObj.ID)
+	}
+	artifactService := h.artifactServiceFactory.New()
+	namespaceSize, err := artifactService.GetNamespaceSize(ctx, namespaceObj.ID)
+	if err != nil {
+		return err
+	}
+	namespaceIncrease, err := artifactService.GetNamespaceSizeIncrease(ctx, namespaceObj.ID, blobIDs)
+	if err != nil {
+		return err
+	}
+	repositorySize, err := artifactService.GetRepositorySize(ctx, repositoryObj.ID)
+	if err != nil {
+		return err
+	}
+	repositoryIncrease, err := artifactService.GetRepositorySizeIncrease(ctx, repositoryObj.ID, blobIDs)
+	if err != nil {
+		return err
+	}
+	warnings, err := distribution.CheckSizeQuota(namespaceObj, namespaceSize, namespaceIncrease+manifestSize,
+		repositoryObj, repositorySize, repositoryIncrease+manifestSize)
+	if err != nil {
+		return err
+	}
+	if len(warnings) > 0 {
+		log.Warn().Strs("warnings", warnings).Str("repository", repositoryObj.Name).Msg("Size soft quota exceeded")
+		distribution.SetWarnings(c, warnings)
+	}
+	return nil
+}
@@ -56,7 +56,11 @@ func (h *handler) GetReferrer(c echo.Context) error {
 	}
 
 	artifactService := h.artifactServiceFactory.New()
-	artifactObjs, err := artifactService.GetReferrers(ctx, repositoryObj.ID, ref, strings.Split(artifactType, ","))
+	var artifactTypes []string
+	if artifactType != "" {
+		artifactTypes = strings.Split(artifactType, ",")
+	}
+	artifactObjs, err := artifactService.GetReferrers(ctx, repositoryObj.ID, ref, artifactTypes)
 	if err != nil {
 		log.Error().Err(err).Msg("Get referrers failed")
 		return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
@@ -72,11 +76,16 @@ func (h *handler) GetReferrer(c echo.Context) error {
 			log.Error().Err(err).Msg("Unmarshal artifact failed")
 			return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
 		}
+		// the artifactType of the manifest takes precedence over the config media type
+		artifactType := decoded.ArtifactType
+		if artifactType == "" {
+			artifactType = decoded.Config.MediaType
+		}
 		result.Manifests = append(result.Manifests, imgspecv1.Descriptor{
 			MediaType:    decoded.MediaType,
 			Size:         artifactObj.Size,
 			Digest:       digest.Digest(artifactObj.Digest),
-			ArtifactType: decoded.Config.MediaType,
+			ArtifactType: artifactType,
 			Annotations:  decoded.Annotations,
 		})
 	}
@@ -0,0 +1,57 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distribution
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/dustin/go-humanize"
+	"github.com/labstack/echo/v4"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// CheckSizeQuota checks the size quota of the namespace and repository with the size that will be increased,
+// exceeding the hard limit returns the quota exceed error, exceeding the soft limit only returns the warnings.
+// The repositoryObj is nil if the repository is not created yet.
+func CheckSizeQuota(namespaceObj *models.Namespace, namespaceSize, namespaceIncrease int64,
+	repositoryObj *models.Repository, repositorySize, repositoryIncrease int64) ([]string, error) {
+	if namespaceIncrease > 0 && namespaceObj.SizeLimit > 0 && namespaceSize+namespaceIncrease > namespaceObj.SizeLimit {
+		return nil, xerrors.GenDSErrCodeResourceSizeQuotaExceedNamespace(namespaceObj.Name, namespaceSize, namespaceObj.SizeLimit, namespaceIncrease)
+	}
+	if repositoryObj != nil && repositoryIncrease > 0 && repositoryObj.SizeLimit > 0 && repositorySize+repositoryIncrease > repositoryObj.SizeLimit {
+		return nil, xerrors.GenDSErrCodeResourceSizeQuotaExceedRepository(repositoryObj.Name, repositorySize, repositoryObj.SizeLimit, repositoryIncrease)
+	}
+	var warnings []string
+	if namespaceIncrease > 0 && namespaceObj.SizeSoftLimit > 0 && namespaceSize+namespaceIncrease > namespaceObj.SizeSoftLimit {
+		warnings = append(warnings, fmt.Sprintf("namespace(%s) size %s exceeds the soft quota %s",
+			namespaceObj.Name, humanize.BigIBytes(big.NewInt(namespaceSize+namespaceIncrease)), humanize.BigIBytes(big.NewInt(namespaceObj.SizeSoftLimit))))
+	}
+	if repositoryObj != nil && repositoryIncrease > 0 && repositoryObj.SizeSoftLimit > 0 && repositorySize+repositoryIncrease > repositoryObj.SizeSoftLimit {
+		warnings = append(warnings, fmt.Sprintf("repository(%s) size %s exceeds the soft quota %s",
+			repositoryObj.Name, humanize.BigIBytes(big.NewInt(repositorySize+repositoryIncrease)), humanize.BigIBytes(big.NewInt(repositoryObj.SizeSoftLimit))))
+	}
+	return warnings, nil
+}
+
+// SetWarnings sets the warnings to the response header with the format defined in the distribution spec
+func SetWarnings(c echo.Context, warnings []string) {
+	for _, warning := range warnings {
+		c.Response().Header().Add(consts.Warning, fmt.Sprintf("299 - %q", warning))
+	}
+}
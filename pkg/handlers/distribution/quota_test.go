@@ -0,0 +1,72 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distribution
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+func TestCheckSizeQuota(t *testing.T) {
+	namespaceObj := &models.Namespace{Name: "test", SizeLimit: 100, SizeSoftLimit: 80}
+	repositoryObj := &models.Repository{Name: "test/busybox", SizeLimit: 50, SizeSoftLimit: 40}
+
+	warnings, err := CheckSizeQuota(namespaceObj, 10, 10, repositoryObj, 10, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	// the increase is not counted if the blobs are referenced already
+	warnings, err = CheckSizeQuota(namespaceObj, 100, 0, repositoryObj, 50, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	warnings, err = CheckSizeQuota(namespaceObj, 75, 10, repositoryObj, 35, 10)
+	assert.NoError(t, err)
+	assert.Len(t, warnings, 2)
+
+	_, err = CheckSizeQuota(namespaceObj, 95, 10, repositoryObj, 10, 10)
+	assert.Error(t, err)
+	e, ok := err.(xerrors.ErrCode)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusForbidden, e.HTTPStatusCode)
+	assert.Contains(t, e.Title, "namespace(test)")
+
+	_, err = CheckSizeQuota(namespaceObj, 10, 10, repositoryObj, 45, 10)
+	assert.Error(t, err)
+	e, ok = err.(xerrors.ErrCode)
+	assert.True(t, ok)
+	assert.Contains(t, e.Title, "repository(test/busybox)")
+
+	// the repository is not created yet
+	warnings, err = CheckSizeQuota(&models.Namespace{Name: "test"}, 1000, 1000, nil, 0, 1000)
+	assert.NoError(t, err)
+	assert.Empty(t, warnings)
+}
+
+func TestSetWarnings(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPut, "/", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	SetWarnings(c, []string{"namespace(test) size 90 B exceeds the soft quota 80 B"})
+	assert.Equal(t, []string{`299 - "namespace(test) size 90 B exceeds the soft quota 80 B"`}, rec.Header().Values(consts.Warning))
+}
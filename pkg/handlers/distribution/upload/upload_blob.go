@@ -0,0 +1,71 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upload
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/modules/locker"
+)
+
+// withBlobLock calls fn with the blob lock held, the gc holds the same lock while it deletes the blob record and file.
+func withBlobLock(ctx context.Context, dgest string, fn func() error) error {
+	lockCtx, lockCancel := context.WithCancel(ctx)
+	defer lockCancel()
+	err := locker.Locker.AcquireWithRenew(lockCtx, consts.LockerBlobPrefix+dgest, time.Second*3, time.Second*5)
+	if err != nil {
+		return fmt.Errorf("acquire blob lock failed: %v", err)
+	}
+	return fn()
+}
+
+// touchBlob checks whether the blob exists, the existing blob is touched so the gc will skip it,
+// and the blob not exists will not be deleted by the gc until its record is created.
+func (h *handler) touchBlob(ctx context.Context, dgest string) (bool, error) {
+	var exist bool
+	err := withBlobLock(ctx, dgest, func() error {
+		blobService := h.blobServiceFactory.New()
+		err := blobService.Touch(ctx, []string{dgest})
+		if err != nil {
+			return fmt.Errorf("touch blob failed: %v", err)
+		}
+		exist, err = blobService.Exists(ctx, dgest)
+		if err != nil {
+			return fmt.Errorf("check blob exist failed: %v", err)
+		}
+		return nil
+	})
+	return exist, err
+}
+
+// createBlob creates the blob record after the blob file is written, the record created by the concurrent push
+// of the same blob is touched instead.
+func (h *handler) createBlob(ctx context.Context, blobObj *models.Blob) error {
+	return withBlobLock(ctx, blobObj.Digest, func() error {
+		blobService := h.blobServiceFactory.New()
+		exist, err := blobService.Exists(ctx, blobObj.Digest)
+		if err != nil {
+			return fmt.Errorf("check blob exist failed: %v", err)
+		}
+		if exist {
+			return blobService.Touch(ctx, []string{blobObj.Digest})
+		}
+		return blobService.Create(ctx, blobObj)
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upload
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/logger"
+	"github.com/go-sigma/sigma/pkg/modules/locker"
+	"github.com/go-sigma/sigma/pkg/tests"
+)
+
+func TestCreateBlob(t *testing.T) {
+	logger.SetLevel("debug")
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctx := log.Logger.WithContext(context.Background())
+
+	h := &handler{blobServiceFactory: dao.NewBlobServiceFactory()}
+	dgest := "sha256:2d4e459f4ecb2d4e459f4ecb2d4e459f4ecb2d4e459f4ecb2d4e459f4ecb2d4e"
+
+	exist, err := h.touchBlob(ctx, dgest)
+	assert.NoError(t, err)
+	assert.False(t, exist)
+
+	// the gc holds the blob lock for a while, the push waits for it
+	lockCtx, lockCancel := context.WithCancel(ctx)
+	assert.NoError(t, locker.Locker.AcquireWithRenew(lockCtx, consts.LockerBlobPrefix+dgest, time.Second*3, time.Second*5))
+	go func() {
+		<-time.After(time.Second)
+		lockCancel()
+	}()
+	assert.NoError(t, h.createBlob(ctx, &models.Blob{Digest: dgest, Size: 123, ContentType: "test"}))
+
+	// the blob record created by the concurrent push of the same blob
+	assert.NoError(t, h.createBlob(ctx, &models.Blob{Digest: dgest, Size: 123, ContentType: "test"}))
+
+	exist, err = h.touchBlob(ctx, dgest)
+	assert.NoError(t, err)
+	assert.True(t, exist)
+	blobObj, err := dao.NewBlobServiceFactory().New().FindByDigest(ctx, dgest)
+	assert.NoError(t, err)
+	assert.NotZero(t, blobObj.LastCheck)
+}
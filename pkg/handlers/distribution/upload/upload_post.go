@@ -96,39 +96,63 @@ func (h *handler) PostUpload(c echo.Context) error {
 		}
 		c.Response().Header().Set(consts.ContentDigest, dgest.String())
 
-		countReader := counter.NewCounter(c.Request().Body)
-
-		srcPath := fmt.Sprintf("%s/%s", consts.BlobUploads, fileID)
-		err = storage.Driver.Upload(ctx, srcPath, countReader)
+		length, err := utils.GetContentLength(c.Request())
 		if err != nil {
-			log.Error().Err(err).Msg("Upload blob failed")
-			return xerrors.NewDSError(c, xerrors.DSErrCodeBlobUploadInvalid)
+			log.Error().Err(err).Msg("Get content length failed")
+			return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
 		}
-		destPath := path.Join(consts.Blobs, utils.GenPathByDigest(dgest))
-		err = storage.Driver.Move(ctx, srcPath, destPath)
+		err = h.checkSizeQuota(c, namespaceObj, repository, length)
 		if err != nil {
-			log.Error().Err(err).Msg("Move blob failed")
+			log.Error().Err(err).Str("repository", repository).Str("digest", dgest.String()).Msg("Check size quota failed")
+			e, ok := err.(xerrors.ErrCode)
+			if ok {
+				return xerrors.NewDSError(c, e)
+			}
 			return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
 		}
 
-		err = storage.Driver.Delete(ctx, srcPath)
+		// the blob lock is only held while the blob record is checked or created, the blob not exists
+		// will not be deleted by the gc until its record is created
+		exist, err := h.touchBlob(ctx, dgest.String())
 		if err != nil {
-			log.Error().Err(err).Msg("Delete blob upload failed")
+			log.Error().Err(err).Str("digest", dgest.String()).Msg("Check blob exist failed")
 			return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
 		}
 
-		size := countReader.Count()
-
-		contentType := c.Request().Header.Get("Content-Type")
-		blobService := h.blobServiceFactory.New()
-		err = blobService.Create(ctx, &models.Blob{
-			Digest:      dgest.String(),
-			Size:        size,
-			ContentType: contentType,
-		})
-		if err != nil {
-			log.Error().Err(err).Msg("Save blob record failed")
-			return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
+		if !exist {
+			countReader := counter.NewCounter(c.Request().Body)
+
+			srcPath := fmt.Sprintf("%s/%s", consts.BlobUploads, fileID)
+			err = storage.Driver.Upload(ctx, srcPath, countReader)
+			if err != nil {
+				log.Error().Err(err).Msg("Upload blob failed")
+				return xerrors.NewDSError(c, xerrors.DSErrCodeBlobUploadInvalid)
+			}
+			destPath := path.Join(consts.Blobs, utils.GenPathByDigest(dgest))
+			err = storage.Driver.Move(ctx, srcPath, destPath)
+			if err != nil {
+				log.Error().Err(err).Msg("Move blob failed")
+				return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
+			}
+
+			err = storage.Driver.Delete(ctx, srcPath)
+			if err != nil {
+				log.Error().Err(err).Msg("Delete blob upload failed")
+				return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
+			}
+
+			size := countReader.Count()
+
+			contentType := c.Request().Header.Get("Content-Type")
+			err = h.createBlob(ctx, &models.Blob{
+				Digest:      dgest.String(),
+				Size:        size,
+				ContentType: contentType,
+			})
+			if err != nil {
+				log.Error().Err(err).Msg("Save blob record failed")
+				return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
+			}
 		}
 	}
 
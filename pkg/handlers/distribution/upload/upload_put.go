@@ -105,9 +105,10 @@ func (h *handler) PutUpload(c echo.Context) error {
 	}
 	srcPath := fmt.Sprintf("%s/%s", consts.BlobUploads, uploadObj.FileID)
 
-	blobService := h.blobServiceFactory.New()
-	exist, err := blobService.Exists(ctx, dgest.String())
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+	// the blob lock is only held while the blob record is checked or created, the blob not exists
+	// will not be deleted by the gc until its record is created
+	exist, err := h.touchBlob(ctx, dgest.String())
+	if err != nil {
 		log.Error().Err(err).Str("digest", dgest.String()).Msg("Check blob exist failed")
 		return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
 	}
@@ -142,6 +143,16 @@ func (h *handler) PutUpload(c echo.Context) error {
 		log.Error().Err(err).Msg("Get content length failed")
 		return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
 	}
+
+	err = h.checkSizeQuota(c, namespaceObj, repository, sizeBefore+length)
+	if err != nil {
+		log.Error().Err(err).Str("repository", repository).Str("digest", dgest.String()).Msg("Check size quota failed")
+		e, ok := err.(xerrors.ErrCode)
+		if ok {
+			return xerrors.NewDSError(c, e)
+		}
+		return xerrors.NewDSError(c, xerrors.DSErrCodeUnknown)
+	}
 	if length != 0 {
 		counterReader := counter.NewCounter(c.Request().Body)
 		etag, err := storage.Driver.UploadPart(ctx, srcPath, uploadObj.UploadID, uploadObj.PartNumber+1, counterReader)
@@ -207,7 +218,7 @@ func (h *handler) PutUpload(c echo.Context) error {
 	}
 
 	contentType := c.Request().Header.Get("Content-Type")
-	err = blobService.Create(ctx, &models.Blob{
+	err = h.createBlob(ctx, &models.Blob{
 		Digest:      dgest.String(),
 		Size:        sizeBefore + length,
 		ContentType: contentType,
@@ -0,0 +1,54 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upload
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/handlers/distribution"
+)
+
+// checkSizeQuota checks the size quota of the namespace and repository with the uploading blob size,
+// the blob is not referenced by any artifact until the manifest pushed, so the recorded size is used as the usage.
+// The soft quota warnings are set to the response header.
+func (h *handler) checkSizeQuota(c echo.Context, namespaceObj *models.Namespace, repository string, size int64) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	repositoryObj, err := h.repositoryServiceFactory.New().GetByName(ctx, repository)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		repositoryObj = nil // the repository will be created when the manifest pushed
+	}
+	var repositorySize int64
+	if repositoryObj != nil {
+		repositorySize = repositoryObj.Size
+	}
+	warnings, err := distribution.CheckSizeQuota(namespaceObj, namespaceObj.Size, size, repositoryObj, repositorySize, size)
+	if err != nil {
+		return err
+	}
+	if len(warnings) > 0 {
+		log.Warn().Strs("warnings", warnings).Str("repository", repository).Msg("Size soft quota exceeded")
+		distribution.SetWarnings(c, warnings)
+	}
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/go-sigma/sigma/pkg/auth"
+	"github.com/go-sigma/sigma/pkg/configs"
 	"github.com/go-sigma/sigma/pkg/consts"
 	"github.com/go-sigma/sigma/pkg/dal/dao"
 	"github.com/go-sigma/sigma/pkg/handlers"
@@ -55,11 +56,57 @@ type Handler interface {
 	ListNamespaceMembers(c echo.Context) error
 	// GetNamespaceMemberSelf handles the get self namespace member request
 	GetNamespaceMemberSelf(c echo.Context) error
+
+	// GetNamespaceVulnerabilityPolicy handles the get namespace vulnerability policy request
+	GetNamespaceVulnerabilityPolicy(c echo.Context) error
+	// PutNamespaceVulnerabilityPolicy handles the update namespace vulnerability policy request
+	PutNamespaceVulnerabilityPolicy(c echo.Context) error
+	// GetNamespaceLicensePolicy handles the get namespace license policy request
+	GetNamespaceLicensePolicy(c echo.Context) error
+	// PutNamespaceLicensePolicy handles the update namespace license policy request
+	PutNamespaceLicensePolicy(c echo.Context) error
+	// GetNamespaceSignaturePolicy handles the get namespace signature policy request
+	GetNamespaceSignaturePolicy(c echo.Context) error
+	// PutNamespaceSignaturePolicy handles the update namespace signature policy request
+	PutNamespaceSignaturePolicy(c echo.Context) error
+	// GetNamespaceVulnerabilityRescanPolicy handles the get namespace vulnerability rescan policy request
+	GetNamespaceVulnerabilityRescanPolicy(c echo.Context) error
+	// PutNamespaceVulnerabilityRescanPolicy handles the update namespace vulnerability rescan policy request
+	PutNamespaceVulnerabilityRescanPolicy(c echo.Context) error
+
+	// ListNamespaceSigningKeys handles the list namespace signing keys request
+	ListNamespaceSigningKeys(c echo.Context) error
+	// PostNamespaceSigningKey handles the generate or import namespace signing key request
+	PostNamespaceSigningKey(c echo.Context) error
+	// DeleteNamespaceSigningKey handles the delete namespace signing key request
+	DeleteNamespaceSigningKey(c echo.Context) error
+	// GetNamespaceSigningPolicy handles the get namespace sign-on-push policy request
+	GetNamespaceSigningPolicy(c echo.Context) error
+	// PutNamespaceSigningPolicy handles the update namespace sign-on-push policy request
+	PutNamespaceSigningPolicy(c echo.Context) error
+
+	// ListRecycleBinNamespaces handles the list deleted namespaces in the recycle bin request
+	ListRecycleBinNamespaces(c echo.Context) error
+	// RestoreRecycleBinNamespace handles the restore deleted namespace from the recycle bin request
+	RestoreRecycleBinNamespace(c echo.Context) error
+	// ListRecycleBinRepositories handles the list deleted repositories of the namespace in the recycle bin request
+	ListRecycleBinRepositories(c echo.Context) error
+	// RestoreRecycleBinRepository handles the restore deleted repository from the recycle bin request
+	RestoreRecycleBinRepository(c echo.Context) error
+	// ListRecycleBinTags handles the list deleted tags of the namespace in the recycle bin request
+	ListRecycleBinTags(c echo.Context) error
+	// RestoreRecycleBinTag handles the restore deleted tag from the recycle bin request
+	RestoreRecycleBinTag(c echo.Context) error
+	// ListRecycleBinArtifacts handles the list deleted artifacts of the namespace in the recycle bin request
+	ListRecycleBinArtifacts(c echo.Context) error
+	// RestoreRecycleBinArtifact handles the restore deleted artifact from the recycle bin request
+	RestoreRecycleBinArtifact(c echo.Context) error
 }
 
 var _ Handler = &handler{}
 
 type handler struct {
+	config                        *configs.Configuration
 	authServiceFactory            auth.AuthServiceFactory
 	auditServiceFactory           dao.AuditServiceFactory
 	namespaceServiceFactory       dao.NamespaceServiceFactory
@@ -67,11 +114,15 @@ type handler struct {
 	repositoryServiceFactory      dao.RepositoryServiceFactory
 	tagServiceFactory             dao.TagServiceFactory
 	artifactServiceFactory        dao.ArtifactServiceFactory
+	policyServiceFactory          dao.PolicyServiceFactory
+	signingKeyServiceFactory      dao.SigningKeyServiceFactory
+	userServiceFactory            dao.UserServiceFactory
 
 	producerClient definition.WorkQueueProducer
 }
 
 type inject struct {
+	config                        *configs.Configuration
 	authServiceFactory            auth.AuthServiceFactory
 	auditServiceFactory           dao.AuditServiceFactory
 	namespaceServiceFactory       dao.NamespaceServiceFactory
@@ -79,12 +130,16 @@ type inject struct {
 	repositoryServiceFactory      dao.RepositoryServiceFactory
 	tagServiceFactory             dao.TagServiceFactory
 	artifactServiceFactory        dao.ArtifactServiceFactory
+	policyServiceFactory          dao.PolicyServiceFactory
+	signingKeyServiceFactory      dao.SigningKeyServiceFactory
+	userServiceFactory            dao.UserServiceFactory
 
 	producerClient definition.WorkQueueProducer
 }
 
 // handlerNew creates a new instance of the distribution handlers
 func handlerNew(injects ...inject) Handler {
+	config := configs.GetConfiguration()
 	authServiceFactory := auth.NewAuthServiceFactory()
 	auditServiceFactory := dao.NewAuditServiceFactory()
 	namespaceServiceFactory := dao.NewNamespaceServiceFactory()
@@ -92,9 +147,15 @@ func handlerNew(injects ...inject) Handler {
 	repositoryServiceFactory := dao.NewRepositoryServiceFactory()
 	tagServiceFactory := dao.NewTagServiceFactory()
 	artifactServiceFactory := dao.NewArtifactServiceFactory()
+	policyServiceFactory := dao.NewPolicyServiceFactory()
+	signingKeyServiceFactory := dao.NewSigningKeyServiceFactory()
+	userServiceFactory := dao.NewUserServiceFactory()
 	producerClient := workq.ProducerClient
 	if len(injects) > 0 {
 		ij := injects[0]
+		if ij.config != nil {
+			config = ij.config
+		}
 		if ij.authServiceFactory != nil {
 			authServiceFactory = ij.authServiceFactory
 		}
@@ -116,11 +177,21 @@ func handlerNew(injects ...inject) Handler {
 		if ij.artifactServiceFactory != nil {
 			artifactServiceFactory = ij.artifactServiceFactory
 		}
+		if ij.policyServiceFactory != nil {
+			policyServiceFactory = ij.policyServiceFactory
+		}
+		if ij.signingKeyServiceFactory != nil {
+			signingKeyServiceFactory = ij.signingKeyServiceFactory
+		}
+		if ij.userServiceFactory != nil {
+			userServiceFactory = ij.userServiceFactory
+		}
 		if ij.producerClient != nil {
 			producerClient = ij.producerClient
 		}
 	}
 	return &handler{
+		config:                        config,
 		authServiceFactory:            authServiceFactory,
 		auditServiceFactory:           auditServiceFactory,
 		namespaceServiceFactory:       namespaceServiceFactory,
@@ -128,6 +199,9 @@ func handlerNew(injects ...inject) Handler {
 		repositoryServiceFactory:      repositoryServiceFactory,
 		tagServiceFactory:             tagServiceFactory,
 		artifactServiceFactory:        artifactServiceFactory,
+		policyServiceFactory:          policyServiceFactory,
+		signingKeyServiceFactory:      signingKeyServiceFactory,
+		userServiceFactory:            userServiceFactory,
 
 		producerClient: producerClient,
 	}
@@ -154,6 +228,30 @@ func (f factory) Initialize(e *echo.Echo) error {
 	namespaceGroup.PUT("/:namespace_id/members/:user_id", namespaceHandler.UpdateNamespaceMember)
 	namespaceGroup.DELETE("/:namespace_id/members/:user_id", namespaceHandler.DeleteNamespaceMember)
 
+	namespaceGroup.GET("/:namespace_id/vulnerability-policy", namespaceHandler.GetNamespaceVulnerabilityPolicy)
+	namespaceGroup.PUT("/:namespace_id/vulnerability-policy", namespaceHandler.PutNamespaceVulnerabilityPolicy)
+	namespaceGroup.GET("/:namespace_id/license-policy", namespaceHandler.GetNamespaceLicensePolicy)
+	namespaceGroup.PUT("/:namespace_id/license-policy", namespaceHandler.PutNamespaceLicensePolicy)
+	namespaceGroup.GET("/:namespace_id/signature-policy", namespaceHandler.GetNamespaceSignaturePolicy)
+	namespaceGroup.PUT("/:namespace_id/signature-policy", namespaceHandler.PutNamespaceSignaturePolicy)
+	namespaceGroup.GET("/:namespace_id/vulnerability-rescan-policy", namespaceHandler.GetNamespaceVulnerabilityRescanPolicy)
+	namespaceGroup.PUT("/:namespace_id/vulnerability-rescan-policy", namespaceHandler.PutNamespaceVulnerabilityRescanPolicy)
+
+	namespaceGroup.GET("/:namespace_id/signing-keys/", namespaceHandler.ListNamespaceSigningKeys)
+	namespaceGroup.POST("/:namespace_id/signing-keys/", namespaceHandler.PostNamespaceSigningKey)
+	namespaceGroup.DELETE("/:namespace_id/signing-keys/:id", namespaceHandler.DeleteNamespaceSigningKey)
+	namespaceGroup.GET("/:namespace_id/signing-policy", namespaceHandler.GetNamespaceSigningPolicy)
+	namespaceGroup.PUT("/:namespace_id/signing-policy", namespaceHandler.PutNamespaceSigningPolicy)
+
+	namespaceGroup.GET("/recycle-bin/", namespaceHandler.ListRecycleBinNamespaces)
+	namespaceGroup.POST("/recycle-bin/:id/restore", namespaceHandler.RestoreRecycleBinNamespace)
+	namespaceGroup.GET("/:namespace_id/recycle-bin/repositories/", namespaceHandler.ListRecycleBinRepositories)
+	namespaceGroup.POST("/:namespace_id/recycle-bin/repositories/:id/restore", namespaceHandler.RestoreRecycleBinRepository)
+	namespaceGroup.GET("/:namespace_id/recycle-bin/tags/", namespaceHandler.ListRecycleBinTags)
+	namespaceGroup.POST("/:namespace_id/recycle-bin/tags/:id/restore", namespaceHandler.RestoreRecycleBinTag)
+	namespaceGroup.GET("/:namespace_id/recycle-bin/artifacts/", namespaceHandler.ListRecycleBinArtifacts)
+	namespaceGroup.POST("/:namespace_id/recycle-bin/artifacts/:id/restore", namespaceHandler.RestoreRecycleBinArtifact)
+
 	return nil
 }
 
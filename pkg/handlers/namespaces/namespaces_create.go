@@ -31,6 +31,7 @@ import (
 	"github.com/go-sigma/sigma/pkg/types/enums"
 	"github.com/go-sigma/sigma/pkg/utils"
 	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/utils/quota"
 	"github.com/go-sigma/sigma/pkg/xerrors"
 )
 
@@ -67,6 +68,11 @@ func (h *handler) PostNamespace(c echo.Context) error {
 		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
 	}
 
+	if ptr.To(req.SizeLimit) > 0 && ptr.To(req.SizeSoftLimit) > ptr.To(req.SizeLimit) {
+		log.Error().Int64("sizeLimit", ptr.To(req.SizeLimit)).Int64("sizeSoftLimit", ptr.To(req.SizeSoftLimit)).Msg("Size soft limit is greater than the size limit")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, "Size soft limit should not be greater than the size limit")
+	}
+
 	namespaceService := h.namespaceServiceFactory.New()
 	_, err = namespaceService.GetByName(ctx, req.Name)
 	if err != nil {
@@ -86,16 +92,43 @@ func (h *handler) PostNamespace(c echo.Context) error {
 	if req.Visibility != nil {
 		namespaceObj.Visibility = ptr.To(req.Visibility)
 	}
+	if req.Scanner != nil {
+		namespaceObj.Scanner = req.Scanner
+	}
+	if req.SecretScan != nil {
+		namespaceObj.SecretScan = ptr.To(req.SecretScan)
+	}
+	if req.MisconfigurationScan != nil {
+		namespaceObj.MisconfigurationScan = ptr.To(req.MisconfigurationScan)
+	}
 	if ptr.To(req.SizeLimit) > 0 {
 		namespaceObj.SizeLimit = ptr.To(req.SizeLimit)
 	}
+	if ptr.To(req.SizeSoftLimit) > 0 {
+		namespaceObj.SizeSoftLimit = ptr.To(req.SizeSoftLimit)
+	}
 	if ptr.To(req.RepositoryLimit) > 0 {
 		namespaceObj.RepositoryLimit = ptr.To(req.RepositoryLimit)
 	}
 	if ptr.To(req.TagLimit) > 0 {
 		namespaceObj.TagLimit = ptr.To(req.TagLimit)
 	}
+	if req.QuotaThresholds != nil {
+		namespaceObj.QuotaThresholds = ptr.Of(quota.FormatThresholds(ptr.To(req.QuotaThresholds)))
+	}
 	err = query.Q.Transaction(func(tx *query.Query) error {
+		userService := h.userServiceFactory.New(tx)
+		err = userService.IncreaseNamespaceCount(ctx, user.ID)
+		if err != nil {
+			var e xerrors.ErrCode
+			if errors.As(err, &e) {
+				log.Error().Int64("UserID", user.ID).Msg("User namespace count quota exceed")
+				return e
+			}
+			log.Error().Err(err).Msg("Increase namespace count of user failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Increase namespace count of user failed: %v", err))
+		}
+		namespaceObj.CreatorID = ptr.Of(user.ID)
 		namespaceService := h.namespaceServiceFactory.New(tx)
 		err = namespaceService.Create(ctx, namespaceObj)
 		if err != nil {
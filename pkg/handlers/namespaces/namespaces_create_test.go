@@ -117,6 +117,24 @@ func TestPostNamespace(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusCreated, c.Response().Status)
 
+	// the namespaces created by the user are counted
+	userObj, err = userService.Get(ctx, userObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), userObj.NamespaceCount)
+
+	// create with user namespace count quota exceed
+	assert.NoError(t, userService.UpdateByID(ctx, userObj.ID, map[string]any{"namespace_limit": 3}))
+	req = httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"name":"test-user-quota"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.Set(consts.ContextUser, userObj)
+	err = namespaceHandler.PostNamespace(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, c.Response().Status)
+	assert.Contains(t, rec.Body.String(), "namespace count quota is 3")
+	assert.NoError(t, userService.UpdateByID(ctx, userObj.ID, map[string]any{"namespace_limit": 0}))
+
 	req = httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"name":"test"}`))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec = httptest.NewRecorder()
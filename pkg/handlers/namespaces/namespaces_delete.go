@@ -98,6 +98,13 @@ func (h *handler) DeleteNamespace(c echo.Context) error {
 			log.Error().Err(err).Msg("Delete namespace failed")
 			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Namespace(%d) find failed: %v", req.ID, err))
 		}
+		if namespaceObj.CreatorID != nil {
+			err = h.userServiceFactory.New(tx).DecreaseNamespaceCount(ctx, ptr.To(namespaceObj.CreatorID))
+			if err != nil {
+				log.Error().Err(err).Msg("Decrease namespace count of user failed")
+				return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Decrease namespace count of user failed: %v", err))
+			}
+		}
 		auditService := h.auditServiceFactory.New(tx)
 		err = auditService.Create(ctx, &models.Audit{
 			UserID:       user.ID,
@@ -108,6 +108,11 @@ func TestDeleteNamespace(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusNoContent, c.Response().Status)
 
+	// the deleted namespace is not counted in the namespace count of the creator
+	userObj, err = userService.Get(ctx, userObj.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), userObj.NamespaceCount)
+
 	req = httptest.NewRequest(http.MethodDelete, "/", nil)
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec = httptest.NewRecorder()
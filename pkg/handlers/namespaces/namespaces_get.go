@@ -30,6 +30,7 @@ import (
 	"github.com/go-sigma/sigma/pkg/types/enums"
 	"github.com/go-sigma/sigma/pkg/utils"
 	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/utils/quota"
 	"github.com/go-sigma/sigma/pkg/xerrors"
 )
 
@@ -112,19 +113,29 @@ func (h *handler) GetNamespace(c echo.Context) error {
 	}
 
 	return c.JSON(http.StatusOK, types.NamespaceItem{
-		ID:              namespaceObj.ID,
-		Name:            namespaceObj.Name,
-		Description:     namespaceObj.Description,
-		Overview:        ptr.Of(string(namespaceObj.Overview)),
-		Visibility:      namespaceObj.Visibility,
-		Role:            namespaceRole,
-		Size:            namespaceObj.Size,
-		SizeLimit:       namespaceObj.SizeLimit,
-		RepositoryCount: repositoryMapCount[namespaceObj.ID],
-		RepositoryLimit: namespaceObj.RepositoryLimit,
-		TagCount:        tagMapCount[namespaceObj.ID],
-		TagLimit:        namespaceObj.TagLimit,
-		CreatedAt:       time.Unix(0, int64(time.Millisecond)*namespaceObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
-		UpdatedAt:       time.Unix(0, int64(time.Millisecond)*namespaceObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		ID:                   namespaceObj.ID,
+		Name:                 namespaceObj.Name,
+		Description:          namespaceObj.Description,
+		Overview:             ptr.Of(string(namespaceObj.Overview)),
+		Visibility:           namespaceObj.Visibility,
+		Role:                 namespaceRole,
+		Size:                 namespaceObj.Size,
+		SizeLimit:            namespaceObj.SizeLimit,
+		SizeSoftLimit:        namespaceObj.SizeSoftLimit,
+		Scanner:              namespaceObj.Scanner,
+		SecretScan:           namespaceObj.SecretScan,
+		MisconfigurationScan: namespaceObj.MisconfigurationScan,
+		RepositoryCount:      repositoryMapCount[namespaceObj.ID],
+		RepositoryLimit:      namespaceObj.RepositoryLimit,
+		TagCount:             tagMapCount[namespaceObj.ID],
+		TagLimit:             namespaceObj.TagLimit,
+		QuotaThresholds:      quota.ParseThresholds(namespaceObj.QuotaThresholds, h.config.Namespace.QuotaThresholds),
+		QuotaState: types.NamespaceQuotaState{
+			Size:       namespaceObj.SizeQuotaThreshold,
+			Tag:        namespaceObj.TagQuotaThreshold,
+			Repository: namespaceObj.RepositoryQuotaThreshold,
+		},
+		CreatedAt: time.Unix(0, int64(time.Millisecond)*namespaceObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt: time.Unix(0, int64(time.Millisecond)*namespaceObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 	})
 }
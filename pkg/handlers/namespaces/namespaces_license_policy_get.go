@@ -0,0 +1,116 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespaces
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// GetNamespaceLicensePolicy handles the get namespace license policy request
+//
+//	@Summary	Get namespace license policy
+//	@security	BasicAuth
+//	@Tags		Namespace
+//	@Accept		json
+//	@Produce	json
+//	@Router		/namespaces/{namespace_id}/license-policy [get]
+//	@Param		namespace_id	path		number	true	"Namespace id"
+//	@Success	200				{object}	types.GetLicensePolicyResponse
+//	@Failure	401				{object}	xerrors.ErrCode
+//	@Failure	404				{object}	xerrors.ErrCode
+//	@Failure	500				{object}	xerrors.ErrCode
+func (h *handler) GetNamespaceLicensePolicy(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+
+	var req types.GetLicensePolicyRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+
+	authChecked, err := h.authServiceFactory.New().Namespace(ptr.To(user), req.NamespaceID, enums.AuthRead)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(errors.New(utils.UnwrapJoinedErrors(err))).Int64("NamespaceID", req.NamespaceID).Msg("Resource not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, utils.UnwrapJoinedErrors(err))
+		}
+		log.Error().Err(errors.New(utils.UnwrapJoinedErrors(err))).Int64("NamespaceID", req.NamespaceID).Msg("Get resource failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, utils.UnwrapJoinedErrors(err))
+	}
+	if !authChecked {
+		log.Error().Int64("UserID", user.ID).Int64("NamespaceID", req.NamespaceID).Msg("Auth check failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized, "No permission with this api")
+	}
+
+	policyObj, err := h.policyServiceFactory.New().GetLicensePolicy(ctx, req.NamespaceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) { // the namespace has no policy yet, return the default one
+			return c.JSON(http.StatusOK, types.GetLicensePolicyResponse{
+				DeniedLicenses:  []string{},
+				AllowedLicenses: []string{},
+				Exemptions:      []string{},
+			})
+		}
+		log.Error().Err(err).Msg("Get license policy failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get license policy failed: %v", err))
+	}
+
+	return c.JSON(http.StatusOK, types.GetLicensePolicyResponse{
+		Enabled:         policyObj.Enabled,
+		BlockPull:       policyObj.BlockPull,
+		WarnUnknown:     policyObj.WarnUnknown,
+		DeniedLicenses:  splitPolicyItems(policyObj.DeniedLicenses),
+		AllowedLicenses: splitPolicyItems(policyObj.AllowedLicenses),
+		Exemptions:      splitPolicyItems(policyObj.Exemptions),
+		CreatedAt:       time.Unix(0, int64(time.Millisecond)*policyObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt:       time.Unix(0, int64(time.Millisecond)*policyObj.UpdatedAt).UTC().Format(consts.DefaultTimePattern),
+	})
+}
+
+// splitPolicyItems splits the policy items joined by comma
+func splitPolicyItems(items string) []string {
+	if items == "" {
+		return []string{}
+	}
+	return strings.Split(items, ",")
+}
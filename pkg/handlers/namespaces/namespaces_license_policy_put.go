@@ -0,0 +1,163 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespaces
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// PutNamespaceLicensePolicy handles the update namespace license policy request
+//
+//	@Summary	Update namespace license policy
+//	@security	BasicAuth
+//	@Tags		Namespace
+//	@Accept		json
+//	@Produce	json
+//	@Router		/namespaces/{namespace_id}/license-policy [put]
+//	@Param		namespace_id	path	number								true	"Namespace id"
+//	@Param		message			body	types.UpdateLicensePolicyRequest	true	"License policy object"
+//	@Success	204
+//	@Failure	400	{object}	xerrors.ErrCode
+//	@Failure	401	{object}	xerrors.ErrCode
+//	@Failure	404	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) PutNamespaceLicensePolicy(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+
+	var req types.UpdateLicensePolicyRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+
+	authChecked, err := h.authServiceFactory.New().Namespace(ptr.To(user), req.NamespaceID, enums.AuthAdmin)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(errors.New(utils.UnwrapJoinedErrors(err))).Int64("NamespaceID", req.NamespaceID).Msg("Resource not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, utils.UnwrapJoinedErrors(err))
+		}
+		log.Error().Err(errors.New(utils.UnwrapJoinedErrors(err))).Int64("NamespaceID", req.NamespaceID).Msg("Get resource failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, utils.UnwrapJoinedErrors(err))
+	}
+	if !authChecked {
+		log.Error().Int64("UserID", user.ID).Int64("NamespaceID", req.NamespaceID).Msg("Auth check failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized, "No permission with this api")
+	}
+
+	namespaceObj, err := h.namespaceServiceFactory.New().Get(ctx, req.NamespaceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Msg("Namespace not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, err.Error())
+		}
+		log.Error().Err(err).Msg("Find namespace failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, err.Error())
+	}
+
+	err = query.Q.Transaction(func(tx *query.Query) error {
+		policyService := h.policyServiceFactory.New(tx)
+		policyObj, err := policyService.GetLicensePolicy(ctx, namespaceObj.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Msg("Get license policy failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Get license policy failed: %v", err))
+		}
+		if policyObj == nil {
+			err = policyService.CreateLicensePolicy(ctx, &models.LicensePolicy{
+				NamespaceID:     namespaceObj.ID,
+				Enabled:         req.Enabled,
+				BlockPull:       req.BlockPull,
+				WarnUnknown:     req.WarnUnknown,
+				DeniedLicenses:  strings.Join(req.DeniedLicenses, ","),
+				AllowedLicenses: strings.Join(req.AllowedLicenses, ","),
+				Exemptions:      strings.Join(req.Exemptions, ","),
+			})
+		} else {
+			err = policyService.UpdateLicensePolicy(ctx, policyObj.ID, map[string]any{
+				query.LicensePolicy.Enabled.ColumnName().String():         req.Enabled,
+				query.LicensePolicy.BlockPull.ColumnName().String():       req.BlockPull,
+				query.LicensePolicy.WarnUnknown.ColumnName().String():     req.WarnUnknown,
+				query.LicensePolicy.DeniedLicenses.ColumnName().String():  strings.Join(req.DeniedLicenses, ","),
+				query.LicensePolicy.AllowedLicenses.ColumnName().String(): strings.Join(req.AllowedLicenses, ","),
+				query.LicensePolicy.Exemptions.ColumnName().String():      strings.Join(req.Exemptions, ","),
+			})
+		}
+		if err != nil {
+			log.Error().Err(err).Msg("Save license policy failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Save license policy failed: %v", err))
+		}
+		auditService := h.auditServiceFactory.New(tx)
+		err = auditService.Create(ctx, &models.Audit{
+			UserID:       user.ID,
+			NamespaceID:  ptr.Of(namespaceObj.ID),
+			Action:       enums.AuditActionUpdate,
+			ResourceType: enums.AuditResourceTypeNamespace,
+			Resource:     namespaceObj.Name,
+			ReqRaw:       utils.MustMarshal(req),
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Create audit for update license policy failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Create audit for update license policy failed: %v", err))
+		}
+		err = h.producerClient.Produce(ctx, enums.DaemonWebhook, types.DaemonWebhookPayload{
+			NamespaceID:  ptr.Of(namespaceObj.ID),
+			Action:       enums.WebhookActionUpdate,
+			ResourceType: enums.WebhookResourceTypeNamespace,
+			Payload:      utils.MustMarshal(req),
+		}, definition.ProducerOption{Tx: tx})
+		if err != nil {
+			log.Error().Err(err).Msg("Webhook event produce failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Webhook event produce failed: %v", err))
+		}
+		return nil
+	})
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
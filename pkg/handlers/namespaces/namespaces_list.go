@@ -27,6 +27,7 @@ import (
 	"github.com/go-sigma/sigma/pkg/types"
 	"github.com/go-sigma/sigma/pkg/utils"
 	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/utils/quota"
 	"github.com/go-sigma/sigma/pkg/xerrors"
 )
 
@@ -88,19 +89,29 @@ func (h *handler) ListNamespaces(c echo.Context) error {
 	var resp = make([]any, 0, len(namespaceObjs))
 	for _, namespaceObj := range namespaceObjs {
 		resp = append(resp, types.NamespaceItem{
-			ID:              namespaceObj.ID,
-			Name:            namespaceObj.Name,
-			Description:     namespaceObj.Description,
-			Visibility:      namespaceObj.Visibility,
-			Role:            namespacesRole[namespaceObj.ID],
-			Size:            namespaceObj.Size,
-			SizeLimit:       namespaceObj.SizeLimit,
-			RepositoryLimit: namespaceObj.RepositoryLimit,
-			RepositoryCount: namespaceObj.RepositoryCount,
-			TagLimit:        namespaceObj.TagLimit,
-			TagCount:        namespaceObj.TagCount,
-			CreatedAt:       time.Unix(0, int64(time.Millisecond)*namespaceObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
-			UpdatedAt:       time.Unix(0, int64(time.Millisecond)*namespaceObj.UpdatedAt).UTC().Format(consts.DefaultTimePattern),
+			ID:                   namespaceObj.ID,
+			Name:                 namespaceObj.Name,
+			Description:          namespaceObj.Description,
+			Visibility:           namespaceObj.Visibility,
+			Role:                 namespacesRole[namespaceObj.ID],
+			Size:                 namespaceObj.Size,
+			SizeLimit:            namespaceObj.SizeLimit,
+			SizeSoftLimit:        namespaceObj.SizeSoftLimit,
+			Scanner:              namespaceObj.Scanner,
+			SecretScan:           namespaceObj.SecretScan,
+			MisconfigurationScan: namespaceObj.MisconfigurationScan,
+			RepositoryLimit:      namespaceObj.RepositoryLimit,
+			RepositoryCount:      namespaceObj.RepositoryCount,
+			TagLimit:             namespaceObj.TagLimit,
+			QuotaThresholds:      quota.ParseThresholds(namespaceObj.QuotaThresholds, h.config.Namespace.QuotaThresholds),
+			QuotaState: types.NamespaceQuotaState{
+				Size:       namespaceObj.SizeQuotaThreshold,
+				Tag:        namespaceObj.TagQuotaThreshold,
+				Repository: namespaceObj.RepositoryQuotaThreshold,
+			},
+			TagCount:  namespaceObj.TagCount,
+			CreatedAt: time.Unix(0, int64(time.Millisecond)*namespaceObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+			UpdatedAt: time.Unix(0, int64(time.Millisecond)*namespaceObj.UpdatedAt).UTC().Format(consts.DefaultTimePattern),
 		})
 	}
 
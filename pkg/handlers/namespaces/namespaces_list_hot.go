@@ -24,6 +24,7 @@ import (
 	"github.com/go-sigma/sigma/pkg/consts"
 	"github.com/go-sigma/sigma/pkg/dal/models"
 	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/utils/quota"
 	"github.com/go-sigma/sigma/pkg/xerrors"
 )
 
@@ -62,18 +63,28 @@ func (h *handler) HotNamespace(c echo.Context) error {
 	var resp = make([]any, 0, len(namespaceObjs))
 	for _, namespaceObj := range namespaceObjs {
 		resp = append(resp, types.NamespaceItem{
-			ID:              namespaceObj.ID,
-			Name:            namespaceObj.Name,
-			Description:     namespaceObj.Description,
-			Visibility:      namespaceObj.Visibility,
-			Size:            namespaceObj.Size,
-			SizeLimit:       namespaceObj.SizeLimit,
-			RepositoryLimit: namespaceObj.RepositoryLimit,
-			RepositoryCount: namespaceObj.RepositoryCount,
-			TagLimit:        namespaceObj.TagLimit,
-			TagCount:        namespaceObj.TagCount,
-			CreatedAt:       time.Unix(namespaceObj.CreatedAt, 0).UTC().Format(consts.DefaultTimePattern),
-			UpdatedAt:       time.Unix(namespaceObj.UpdatedAt, 0).UTC().Format(consts.DefaultTimePattern),
+			ID:                   namespaceObj.ID,
+			Name:                 namespaceObj.Name,
+			Description:          namespaceObj.Description,
+			Visibility:           namespaceObj.Visibility,
+			Size:                 namespaceObj.Size,
+			SizeLimit:            namespaceObj.SizeLimit,
+			SizeSoftLimit:        namespaceObj.SizeSoftLimit,
+			Scanner:              namespaceObj.Scanner,
+			SecretScan:           namespaceObj.SecretScan,
+			MisconfigurationScan: namespaceObj.MisconfigurationScan,
+			RepositoryLimit:      namespaceObj.RepositoryLimit,
+			RepositoryCount:      namespaceObj.RepositoryCount,
+			TagLimit:             namespaceObj.TagLimit,
+			QuotaThresholds:      quota.ParseThresholds(namespaceObj.QuotaThresholds, h.config.Namespace.QuotaThresholds),
+			QuotaState: types.NamespaceQuotaState{
+				Size:       namespaceObj.SizeQuotaThreshold,
+				Tag:        namespaceObj.TagQuotaThreshold,
+				Repository: namespaceObj.RepositoryQuotaThreshold,
+			},
+			TagCount:  namespaceObj.TagCount,
+			CreatedAt: time.Unix(namespaceObj.CreatedAt, 0).UTC().Format(consts.DefaultTimePattern),
+			UpdatedAt: time.Unix(namespaceObj.UpdatedAt, 0).UTC().Format(consts.DefaultTimePattern),
 		})
 	}
 
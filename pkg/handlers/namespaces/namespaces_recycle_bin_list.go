@@ -0,0 +1,304 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespaces
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/plugin/soft_delete"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// ListRecycleBinNamespaces handles the list deleted namespaces in the recycle bin request
+//
+//	@Summary	List the deleted namespaces in the recycle bin, only the admin can list them
+//	@security	BasicAuth
+//	@Tags		Namespace
+//	@Accept		json
+//	@Produce	json
+//	@Router		/namespaces/recycle-bin/ [get]
+//	@Param		limit	query		int64	false	"Limit size"	minimum(10)	maximum(100)	default(10)
+//	@Param		page	query		int64	false	"Page number"	minimum(1)	default(1)
+//	@Success	200		{object}	types.CommonList{items=[]types.RecycleBinNamespaceItem}
+//	@Failure	401		{object}	xerrors.ErrCode
+//	@Failure	500		{object}	xerrors.ErrCode
+func (h *handler) ListRecycleBinNamespaces(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	_, errCode := h.recycleBinAdminUser(c)
+	if errCode != nil {
+		return xerrors.NewHTTPError(c, ptr.To(errCode))
+	}
+
+	var req types.ListRecycleBinNamespaceRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+
+	namespaceObjs, total, err := h.namespaceServiceFactory.New().ListDeleted(ctx, h.recycleBinAfter(), req.Pagination)
+	if err != nil {
+		log.Error().Err(err).Msg("List deleted namespaces failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("List deleted namespaces failed: %v", err))
+	}
+
+	var resp = make([]any, 0, len(namespaceObjs))
+	for _, namespaceObj := range namespaceObjs {
+		deletedAt, expiredAt := h.recycleBinTime(namespaceObj.DeletedAt)
+		resp = append(resp, types.RecycleBinNamespaceItem{
+			ID:          namespaceObj.ID,
+			Name:        namespaceObj.Name,
+			Description: namespaceObj.Description,
+			DeletedAt:   deletedAt,
+			ExpiredAt:   expiredAt,
+		})
+	}
+
+	return c.JSON(http.StatusOK, types.CommonList{Total: total, Items: resp})
+}
+
+// ListRecycleBinRepositories handles the list deleted repositories of the namespace in the recycle bin request
+//
+//	@Summary	List the deleted repositories of the namespace in the recycle bin
+//	@security	BasicAuth
+//	@Tags		Namespace
+//	@Accept		json
+//	@Produce	json
+//	@Router		/namespaces/{namespace_id}/recycle-bin/repositories/ [get]
+//	@Param		namespace_id	path		number	true	"Namespace id"
+//	@Param		limit			query		int64	false	"Limit size"	minimum(10)	maximum(100)	default(10)
+//	@Param		page			query		int64	false	"Page number"	minimum(1)	default(1)
+//	@Success	200				{object}	types.CommonList{items=[]types.RecycleBinRepositoryItem}
+//	@Failure	401				{object}	xerrors.ErrCode
+//	@Failure	404				{object}	xerrors.ErrCode
+//	@Failure	500				{object}	xerrors.ErrCode
+func (h *handler) ListRecycleBinRepositories(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	var req types.ListRecycleBinRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+
+	_, errCode := h.recycleBinUser(c, req.NamespaceID, enums.AuthManage)
+	if errCode != nil {
+		return xerrors.NewHTTPError(c, ptr.To(errCode))
+	}
+
+	repositoryObjs, total, err := h.repositoryServiceFactory.New().ListDeleted(ctx, req.NamespaceID, h.recycleBinAfter(), req.Pagination)
+	if err != nil {
+		log.Error().Err(err).Msg("List deleted repositories failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("List deleted repositories failed: %v", err))
+	}
+
+	var resp = make([]any, 0, len(repositoryObjs))
+	for _, repositoryObj := range repositoryObjs {
+		deletedAt, expiredAt := h.recycleBinTime(repositoryObj.DeletedAt)
+		resp = append(resp, types.RecycleBinRepositoryItem{
+			ID:          repositoryObj.ID,
+			Name:        repositoryObj.Name,
+			Description: repositoryObj.Description,
+			DeletedAt:   deletedAt,
+			ExpiredAt:   expiredAt,
+		})
+	}
+
+	return c.JSON(http.StatusOK, types.CommonList{Total: total, Items: resp})
+}
+
+// ListRecycleBinTags handles the list deleted tags of the namespace in the recycle bin request
+//
+//	@Summary	List the deleted tags of the namespace in the recycle bin
+//	@security	BasicAuth
+//	@Tags		Namespace
+//	@Accept		json
+//	@Produce	json
+//	@Router		/namespaces/{namespace_id}/recycle-bin/tags/ [get]
+//	@Param		namespace_id	path		number	true	"Namespace id"
+//	@Param		limit			query		int64	false	"Limit size"	minimum(10)	maximum(100)	default(10)
+//	@Param		page			query		int64	false	"Page number"	minimum(1)	default(1)
+//	@Success	200				{object}	types.CommonList{items=[]types.RecycleBinTagItem}
+//	@Failure	401				{object}	xerrors.ErrCode
+//	@Failure	404				{object}	xerrors.ErrCode
+//	@Failure	500				{object}	xerrors.ErrCode
+func (h *handler) ListRecycleBinTags(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	var req types.ListRecycleBinRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+
+	_, errCode := h.recycleBinUser(c, req.NamespaceID, enums.AuthManage)
+	if errCode != nil {
+		return xerrors.NewHTTPError(c, ptr.To(errCode))
+	}
+
+	tagObjs, total, err := h.tagServiceFactory.New().ListDeleted(ctx, req.NamespaceID, h.recycleBinAfter(), req.Pagination)
+	if err != nil {
+		log.Error().Err(err).Msg("List deleted tags failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("List deleted tags failed: %v", err))
+	}
+
+	var resp = make([]any, 0, len(tagObjs))
+	for _, tagObj := range tagObjs {
+		deletedAt, expiredAt := h.recycleBinTime(tagObj.DeletedAt)
+		item := types.RecycleBinTagItem{
+			ID:           tagObj.ID,
+			Name:         tagObj.Name,
+			RepositoryID: tagObj.RepositoryID,
+			DeletedAt:    deletedAt,
+			ExpiredAt:    expiredAt,
+		}
+		if tagObj.Repository != nil {
+			item.Repository = tagObj.Repository.Name
+		}
+		if tagObj.Artifact != nil {
+			item.Digest = tagObj.Artifact.Digest
+		}
+		resp = append(resp, item)
+	}
+
+	return c.JSON(http.StatusOK, types.CommonList{Total: total, Items: resp})
+}
+
+// ListRecycleBinArtifacts handles the list deleted artifacts of the namespace in the recycle bin request
+//
+//	@Summary	List the deleted artifacts of the namespace in the recycle bin
+//	@security	BasicAuth
+//	@Tags		Namespace
+//	@Accept		json
+//	@Produce	json
+//	@Router		/namespaces/{namespace_id}/recycle-bin/artifacts/ [get]
+//	@Param		namespace_id	path		number	true	"Namespace id"
+//	@Param		limit			query		int64	false	"Limit size"	minimum(10)	maximum(100)	default(10)
+//	@Param		page			query		int64	false	"Page number"	minimum(1)	default(1)
+//	@Success	200				{object}	types.CommonList{items=[]types.RecycleBinArtifactItem}
+//	@Failure	401				{object}	xerrors.ErrCode
+//	@Failure	404				{object}	xerrors.ErrCode
+//	@Failure	500				{object}	xerrors.ErrCode
+func (h *handler) ListRecycleBinArtifacts(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	var req types.ListRecycleBinRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+
+	_, errCode := h.recycleBinUser(c, req.NamespaceID, enums.AuthManage)
+	if errCode != nil {
+		return xerrors.NewHTTPError(c, ptr.To(errCode))
+	}
+
+	artifactObjs, total, err := h.artifactServiceFactory.New().ListDeleted(ctx, req.NamespaceID, h.recycleBinAfter(), req.Pagination)
+	if err != nil {
+		log.Error().Err(err).Msg("List deleted artifacts failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("List deleted artifacts failed: %v", err))
+	}
+
+	var resp = make([]any, 0, len(artifactObjs))
+	for _, artifactObj := range artifactObjs {
+		deletedAt, expiredAt := h.recycleBinTime(artifactObj.DeletedAt)
+		resp = append(resp, types.RecycleBinArtifactItem{
+			ID:           artifactObj.ID,
+			RepositoryID: artifactObj.RepositoryID,
+			Repository:   artifactObj.Repository.Name,
+			Digest:       artifactObj.Digest,
+			Size:         artifactObj.Size,
+			DeletedAt:    deletedAt,
+			ExpiredAt:    expiredAt,
+		})
+	}
+
+	return c.JSON(http.StatusOK, types.CommonList{Total: total, Items: resp})
+}
+
+// recycleBinAfter returns the time in milliseconds, the items deleted after it are still in the recycle bin
+func (h *handler) recycleBinAfter() int64 {
+	return time.Now().Add(-h.config.RecycleBin.Retention).UnixMilli()
+}
+
+// recycleBinTime returns the deleted time and the time the item will be expired from the recycle bin
+func (h *handler) recycleBinTime(deletedAt soft_delete.DeletedAt) (string, string) {
+	deletedTime := time.UnixMilli(int64(deletedAt)).UTC()
+	return deletedTime.Format(consts.DefaultTimePattern), deletedTime.Add(h.config.RecycleBin.Retention).Format(consts.DefaultTimePattern)
+}
+
+// recycleBinUser gets the user from the context and checks the user has the specified permission of the namespace
+func (h *handler) recycleBinUser(c echo.Context, namespaceID int64, auth enums.Auth) (*models.User, *xerrors.ErrCode) {
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return nil, ptr.Of(xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return nil, ptr.Of(xerrors.HTTPErrCodeUnauthorized)
+	}
+	authChecked, err := h.authServiceFactory.New().Namespace(ptr.To(user), namespaceID, auth)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(errors.New(utils.UnwrapJoinedErrors(err))).Int64("NamespaceID", namespaceID).Msg("Resource not found")
+			return nil, ptr.Of(xerrors.HTTPErrCodeNotFound.Detail(utils.UnwrapJoinedErrors(err)))
+		}
+		log.Error().Err(errors.New(utils.UnwrapJoinedErrors(err))).Int64("NamespaceID", namespaceID).Msg("Get resource failed")
+		return nil, ptr.Of(xerrors.HTTPErrCodeInternalError.Detail(utils.UnwrapJoinedErrors(err)))
+	}
+	if !authChecked {
+		log.Error().Int64("UserID", user.ID).Int64("NamespaceID", namespaceID).Msg("Auth check failed")
+		return nil, ptr.Of(xerrors.HTTPErrCodeUnauthorized.Detail("No permission with this api"))
+	}
+	return user, nil
+}
+
+// recycleBinAdminUser gets the user from the context, the deleted namespaces can only be operated by the admin
+func (h *handler) recycleBinAdminUser(c echo.Context) (*models.User, *xerrors.ErrCode) {
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return nil, ptr.Of(xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return nil, ptr.Of(xerrors.HTTPErrCodeUnauthorized)
+	}
+	if !(user.Role == enums.UserRoleAdmin || user.Role == enums.UserRoleRoot) {
+		log.Error().Int64("UserID", user.ID).Msg("Auth check failed")
+		return nil, ptr.Of(xerrors.HTTPErrCodeUnauthorized.Detail("No permission with this api"))
+	}
+	return user, nil
+}
@@ -0,0 +1,442 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// RestoreRecycleBinNamespace handles the restore deleted namespace from the recycle bin request
+//
+//	@Summary	Restore the deleted namespace from the recycle bin, only the admin can restore it
+//	@security	BasicAuth
+//	@Tags		Namespace
+//	@Accept		json
+//	@Produce	json
+//	@Router		/namespaces/recycle-bin/{id}/restore [post]
+//	@Param		id	path	number	true	"Deleted namespace id"
+//	@Success	204
+//	@Failure	401	{object}	xerrors.ErrCode
+//	@Failure	404	{object}	xerrors.ErrCode
+//	@Failure	409	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) RestoreRecycleBinNamespace(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	user, errCode := h.recycleBinAdminUser(c)
+	if errCode != nil {
+		return xerrors.NewHTTPError(c, ptr.To(errCode))
+	}
+
+	var req types.RestoreRecycleBinNamespaceRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+
+	namespaceService := h.namespaceServiceFactory.New()
+	namespaceObj, err := namespaceService.GetDeleted(ctx, req.ID, h.recycleBinAfter())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Int64("NamespaceID", req.ID).Msg("Deleted namespace not found in the recycle bin")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Deleted namespace(%d) not found in the recycle bin", req.ID))
+		}
+		log.Error().Err(err).Int64("NamespaceID", req.ID).Msg("Get deleted namespace failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get deleted namespace failed: %v", err))
+	}
+	_, err = namespaceService.GetByName(ctx, namespaceObj.Name)
+	if err == nil {
+		log.Error().Str("Name", namespaceObj.Name).Msg("Namespace with the same name already exists")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeConflict, fmt.Sprintf("Namespace(%s) already exists", namespaceObj.Name))
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error().Err(err).Str("Name", namespaceObj.Name).Msg("Get namespace by name failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get namespace by name failed: %v", err))
+	}
+
+	err = query.Q.Transaction(func(tx *query.Query) error {
+		err = h.namespaceServiceFactory.New(tx).Restore(ctx, namespaceObj.ID)
+		if err != nil {
+			log.Error().Err(err).Int64("NamespaceID", namespaceObj.ID).Msg("Restore namespace failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Restore namespace failed: %v", err))
+		}
+		if namespaceObj.CreatorID != nil { // the restored namespace is counted in the namespace count of the creator again
+			err = h.userServiceFactory.New(tx).IncreaseNamespaceCount(ctx, ptr.To(namespaceObj.CreatorID))
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Error().Err(err).Int64("UserID", ptr.To(namespaceObj.CreatorID)).Msg("Increase namespace count of user failed")
+				var e xerrors.ErrCode
+				if errors.As(err, &e) {
+					return e
+				}
+				return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Increase namespace count of user failed: %v", err))
+			}
+		}
+		err = h.auditServiceFactory.New(tx).Create(ctx, &models.Audit{
+			UserID:       user.ID,
+			NamespaceID:  ptr.Of(namespaceObj.ID),
+			Action:       enums.AuditActionRestore,
+			ResourceType: enums.AuditResourceTypeNamespace,
+			Resource:     namespaceObj.Name,
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Create audit for restore namespace failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Create audit for restore namespace failed: %v", err))
+		}
+		return nil
+	})
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// RestoreRecycleBinRepository handles the restore deleted repository from the recycle bin request
+//
+//	@Summary	Restore the deleted repository of the namespace from the recycle bin
+//	@security	BasicAuth
+//	@Tags		Namespace
+//	@Accept		json
+//	@Produce	json
+//	@Router		/namespaces/{namespace_id}/recycle-bin/repositories/{id}/restore [post]
+//	@Param		namespace_id	path	number	true	"Namespace id"
+//	@Param		id				path	number	true	"Deleted repository id"
+//	@Success	204
+//	@Failure	401	{object}	xerrors.ErrCode
+//	@Failure	404	{object}	xerrors.ErrCode
+//	@Failure	409	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) RestoreRecycleBinRepository(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	var req types.RestoreRecycleBinRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+
+	user, errCode := h.recycleBinUser(c, req.NamespaceID, enums.AuthAdmin)
+	if errCode != nil {
+		return xerrors.NewHTTPError(c, ptr.To(errCode))
+	}
+
+	repositoryService := h.repositoryServiceFactory.New()
+	repositoryObj, err := repositoryService.GetDeleted(ctx, req.ID, h.recycleBinAfter())
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error().Err(err).Int64("RepositoryID", req.ID).Msg("Get deleted repository failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get deleted repository failed: %v", err))
+	}
+	if err != nil || repositoryObj.NamespaceID != req.NamespaceID {
+		log.Error().Int64("RepositoryID", req.ID).Msg("Deleted repository not found in the recycle bin")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Deleted repository(%d) not found in the recycle bin", req.ID))
+	}
+	_, err = repositoryService.GetByName(ctx, repositoryObj.Name)
+	if err == nil {
+		log.Error().Str("Name", repositoryObj.Name).Msg("Repository with the same name already exists")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeConflict, fmt.Sprintf("Repository(%s) already exists", repositoryObj.Name))
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error().Err(err).Str("Name", repositoryObj.Name).Msg("Get repository by name failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get repository by name failed: %v", err))
+	}
+
+	err = query.Q.Transaction(func(tx *query.Query) error {
+		err = h.repositoryServiceFactory.New(tx).Restore(ctx, repositoryObj.ID)
+		if err != nil {
+			log.Error().Err(err).Int64("RepositoryID", repositoryObj.ID).Msg("Restore repository failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Restore repository failed: %v", err))
+		}
+		err = h.auditServiceFactory.New(tx).Create(ctx, &models.Audit{
+			UserID:       user.ID,
+			NamespaceID:  ptr.Of(req.NamespaceID),
+			Action:       enums.AuditActionRestore,
+			ResourceType: enums.AuditResourceTypeRepository,
+			Resource:     repositoryObj.Name,
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Create audit for restore repository failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Create audit for restore repository failed: %v", err))
+		}
+		return nil
+	})
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// RestoreRecycleBinTag handles the restore deleted tag from the recycle bin request,
+// the artifact of the tag is restored too if it is deleted and no artifact with the same digest is pushed again.
+//
+//	@Summary	Restore the deleted tag of the namespace from the recycle bin
+//	@security	BasicAuth
+//	@Tags		Namespace
+//	@Accept		json
+//	@Produce	json
+//	@Router		/namespaces/{namespace_id}/recycle-bin/tags/{id}/restore [post]
+//	@Param		namespace_id	path	number	true	"Namespace id"
+//	@Param		id				path	number	true	"Deleted tag id"
+//	@Success	204
+//	@Failure	401	{object}	xerrors.ErrCode
+//	@Failure	404	{object}	xerrors.ErrCode
+//	@Failure	409	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) RestoreRecycleBinTag(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	var req types.RestoreRecycleBinRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+
+	user, errCode := h.recycleBinUser(c, req.NamespaceID, enums.AuthAdmin)
+	if errCode != nil {
+		return xerrors.NewHTTPError(c, ptr.To(errCode))
+	}
+
+	recycleBinAfter := h.recycleBinAfter()
+	tagService := h.tagServiceFactory.New()
+	tagObj, err := tagService.GetDeleted(ctx, req.ID, recycleBinAfter)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Int64("TagID", req.ID).Msg("Deleted tag not found in the recycle bin")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Deleted tag(%d) not found in the recycle bin", req.ID))
+		}
+		log.Error().Err(err).Int64("TagID", req.ID).Msg("Get deleted tag failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get deleted tag failed: %v", err))
+	}
+	repositoryObj, errCode := h.recycleBinRepository(ctx, req.NamespaceID, tagObj.RepositoryID)
+	if errCode != nil {
+		return xerrors.NewHTTPError(c, ptr.To(errCode))
+	}
+	_, err = tagService.GetByName(ctx, repositoryObj.ID, tagObj.Name)
+	if err == nil {
+		log.Error().Str("Repository", repositoryObj.Name).Str("Tag", tagObj.Name).Msg("Tag with the same name already exists")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeConflict, fmt.Sprintf("Tag(%s:%s) already exists", repositoryObj.Name, tagObj.Name))
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error().Err(err).Str("Tag", tagObj.Name).Msg("Get tag by name failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get tag by name failed: %v", err))
+	}
+
+	// the tag points to the live artifact, or the artifact pushed again with the same digest,
+	// otherwise the deleted artifact is restored together with the tag
+	artifactService := h.artifactServiceFactory.New()
+	artifactID := tagObj.ArtifactID
+	var restoreArtifact bool
+	_, err = artifactService.Get(ctx, tagObj.ArtifactID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Int64("ArtifactID", tagObj.ArtifactID).Msg("Get artifact failed")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get artifact failed: %v", err))
+		}
+		deletedArtifactObj, err := artifactService.GetDeleted(ctx, tagObj.ArtifactID, recycleBinAfter)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Error().Err(err).Int64("ArtifactID", tagObj.ArtifactID).Msg("Artifact of the tag is expired from the recycle bin")
+				return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeConflict, fmt.Sprintf("Artifact of the tag(%s:%s) is expired from the recycle bin", repositoryObj.Name, tagObj.Name))
+			}
+			log.Error().Err(err).Int64("ArtifactID", tagObj.ArtifactID).Msg("Get deleted artifact failed")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get deleted artifact failed: %v", err))
+		}
+		artifactObj, err := artifactService.GetByDigest(ctx, repositoryObj.ID, deletedArtifactObj.Digest)
+		if err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Error().Err(err).Str("Digest", deletedArtifactObj.Digest).Msg("Get artifact by digest failed")
+				return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get artifact by digest failed: %v", err))
+			}
+			restoreArtifact = true
+		} else {
+			artifactID = artifactObj.ID
+		}
+	}
+
+	err = query.Q.Transaction(func(tx *query.Query) error {
+		if restoreArtifact {
+			err = h.artifactServiceFactory.New(tx).Restore(ctx, artifactID)
+			if err != nil {
+				log.Error().Err(err).Int64("ArtifactID", artifactID).Msg("Restore artifact failed")
+				return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Restore artifact failed: %v", err))
+			}
+		}
+		err = h.tagServiceFactory.New(tx).Restore(ctx, tagObj.ID, artifactID)
+		if err != nil {
+			log.Error().Err(err).Int64("TagID", tagObj.ID).Msg("Restore tag failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Restore tag failed: %v", err))
+		}
+		err = h.auditServiceFactory.New(tx).Create(ctx, &models.Audit{
+			UserID:       user.ID,
+			NamespaceID:  ptr.Of(req.NamespaceID),
+			Action:       enums.AuditActionRestore,
+			ResourceType: enums.AuditResourceTypeTag,
+			Resource:     fmt.Sprintf("%s:%s", repositoryObj.Name, tagObj.Name),
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Create audit for restore tag failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Create audit for restore tag failed: %v", err))
+		}
+		return nil
+	})
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// RestoreRecycleBinArtifact handles the restore deleted artifact from the recycle bin request
+//
+//	@Summary	Restore the deleted artifact of the namespace from the recycle bin
+//	@security	BasicAuth
+//	@Tags		Namespace
+//	@Accept		json
+//	@Produce	json
+//	@Router		/namespaces/{namespace_id}/recycle-bin/artifacts/{id}/restore [post]
+//	@Param		namespace_id	path	number	true	"Namespace id"
+//	@Param		id				path	number	true	"Deleted artifact id"
+//	@Success	204
+//	@Failure	401	{object}	xerrors.ErrCode
+//	@Failure	404	{object}	xerrors.ErrCode
+//	@Failure	409	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) RestoreRecycleBinArtifact(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	var req types.RestoreRecycleBinRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+
+	user, errCode := h.recycleBinUser(c, req.NamespaceID, enums.AuthAdmin)
+	if errCode != nil {
+		return xerrors.NewHTTPError(c, ptr.To(errCode))
+	}
+
+	artifactService := h.artifactServiceFactory.New()
+	artifactObj, err := artifactService.GetDeleted(ctx, req.ID, h.recycleBinAfter())
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error().Err(err).Int64("ArtifactID", req.ID).Msg("Get deleted artifact failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get deleted artifact failed: %v", err))
+	}
+	if err != nil || artifactObj.NamespaceID != req.NamespaceID {
+		log.Error().Int64("ArtifactID", req.ID).Msg("Deleted artifact not found in the recycle bin")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("Deleted artifact(%d) not found in the recycle bin", req.ID))
+	}
+	repositoryObj, errCode := h.recycleBinRepository(ctx, req.NamespaceID, artifactObj.RepositoryID)
+	if errCode != nil {
+		return xerrors.NewHTTPError(c, ptr.To(errCode))
+	}
+	_, err = artifactService.GetByDigest(ctx, repositoryObj.ID, artifactObj.Digest)
+	if err == nil {
+		log.Error().Str("Repository", repositoryObj.Name).Str("Digest", artifactObj.Digest).Msg("Artifact with the same digest already exists")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeConflict, fmt.Sprintf("Artifact(%s@%s) already exists", repositoryObj.Name, artifactObj.Digest))
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error().Err(err).Str("Digest", artifactObj.Digest).Msg("Get artifact by digest failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get artifact by digest failed: %v", err))
+	}
+
+	err = query.Q.Transaction(func(tx *query.Query) error {
+		err = h.artifactServiceFactory.New(tx).Restore(ctx, artifactObj.ID)
+		if err != nil {
+			log.Error().Err(err).Int64("ArtifactID", artifactObj.ID).Msg("Restore artifact failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Restore artifact failed: %v", err))
+		}
+		err = h.auditServiceFactory.New(tx).Create(ctx, &models.Audit{
+			UserID:       user.ID,
+			NamespaceID:  ptr.Of(req.NamespaceID),
+			Action:       enums.AuditActionRestore,
+			ResourceType: enums.AuditResourceTypeArtifact,
+			Resource:     fmt.Sprintf("%s@%s", repositoryObj.Name, artifactObj.Digest),
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Create audit for restore artifact failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Create audit for restore artifact failed: %v", err))
+		}
+		return nil
+	})
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// recycleBinRepository gets the live repository of the tag or artifact to restore,
+// the deleted repository should be restored before the tags and artifacts of it.
+func (h *handler) recycleBinRepository(ctx context.Context, namespaceID, repositoryID int64) (*models.Repository, *xerrors.ErrCode) {
+	repositoryService := h.repositoryServiceFactory.New()
+	repositoryObj, err := repositoryService.Get(ctx, repositoryID)
+	if err == nil {
+		if repositoryObj.NamespaceID != namespaceID {
+			log.Error().Int64("RepositoryID", repositoryID).Int64("NamespaceID", namespaceID).Msg("Repository not found in the namespace")
+			return nil, ptr.Of(xerrors.HTTPErrCodeNotFound.Detail(fmt.Sprintf("Repository(%d) not found in the namespace", repositoryID)))
+		}
+		return repositoryObj, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error().Err(err).Int64("RepositoryID", repositoryID).Msg("Get repository failed")
+		return nil, ptr.Of(xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Get repository failed: %v", err)))
+	}
+	repositoryObj, err = repositoryService.GetDeleted(ctx, repositoryID, 0)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error().Err(err).Int64("RepositoryID", repositoryID).Msg("Get deleted repository failed")
+		return nil, ptr.Of(xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Get deleted repository failed: %v", err)))
+	}
+	if err != nil || repositoryObj.NamespaceID != namespaceID {
+		log.Error().Int64("RepositoryID", repositoryID).Int64("NamespaceID", namespaceID).Msg("Repository not found in the namespace")
+		return nil, ptr.Of(xerrors.HTTPErrCodeNotFound.Detail(fmt.Sprintf("Repository(%d) not found in the namespace", repositoryID)))
+	}
+	log.Error().Str("Repository", repositoryObj.Name).Msg("Repository is deleted, restore the repository first")
+	return nil, ptr.Of(xerrors.HTTPErrCodeConflict.Detail(fmt.Sprintf("Repository(%s) is deleted, restore the repository first", repositoryObj.Name)))
+}
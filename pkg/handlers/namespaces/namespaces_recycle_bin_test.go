@@ -0,0 +1,196 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespaces
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	"go.uber.org/mock/gomock"
+
+	"github.com/go-sigma/sigma/pkg/auth"
+	authmocks "github.com/go-sigma/sigma/pkg/auth/mocks"
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/logger"
+	"github.com/go-sigma/sigma/pkg/tests"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/validators"
+)
+
+func TestRecycleBin(t *testing.T) {
+	logger.SetLevel("debug")
+	e := echo.New()
+	validators.Initialize(e)
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authService := authmocks.NewMockAuthService(ctrl)
+	authService.EXPECT().Namespace(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(user models.User, namespaceID int64, auth enums.Auth) (bool, error) {
+		return true, nil
+	}).AnyTimes()
+	authServiceFactory := authmocks.NewMockAuthServiceFactory(ctrl)
+	authServiceFactory.EXPECT().New().DoAndReturn(func() auth.AuthService {
+		return authService
+	}).AnyTimes()
+
+	namespaceHandler := handlerNew(inject{
+		config:             &configs.Configuration{RecycleBin: configs.ConfigurationRecycleBin{Retention: time.Hour}},
+		authServiceFactory: authServiceFactory,
+	})
+
+	ctx := context.Background()
+	userObj := &models.User{Username: "recycle-bin", Password: ptr.Of("test"), Email: ptr.Of("test@gmail.com"), Role: enums.UserRoleUser}
+	assert.NoError(t, dao.NewUserServiceFactory().New().Create(ctx, userObj))
+	adminObj := &models.User{Username: "recycle-bin-admin", Password: ptr.Of("test"), Email: ptr.Of("admin@gmail.com"), Role: enums.UserRoleAdmin}
+	assert.NoError(t, dao.NewUserServiceFactory().New().Create(ctx, adminObj))
+
+	namespaceService := dao.NewNamespaceServiceFactory().New()
+	namespaceObj := &models.Namespace{Name: "test", Visibility: enums.VisibilityPrivate}
+	assert.NoError(t, namespaceService.Create(ctx, namespaceObj))
+	repositoryService := dao.NewRepositoryServiceFactory().New()
+	repositoryObj := &models.Repository{Name: "test/busybox", NamespaceID: namespaceObj.ID}
+	assert.NoError(t, repositoryService.Create(ctx, repositoryObj, dao.AutoCreateNamespace{UserID: userObj.ID}))
+	artifactService := dao.NewArtifactServiceFactory().New()
+	artifactObj := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:artifact",
+		Size: 123, ContentType: "test", Raw: []byte("test")}
+	assert.NoError(t, artifactService.Create(ctx, artifactObj))
+	tagService := dao.NewTagServiceFactory().New()
+	tagObj := &models.Tag{RepositoryID: repositoryObj.ID, ArtifactID: artifactObj.ID, Name: "latest"}
+	assert.NoError(t, tagService.Create(ctx, tagObj))
+
+	// the artifact and the tag of it are deleted together, then the repository is deleted
+	assert.NoError(t, artifactService.DeleteByDigest(ctx, repositoryObj.Name, artifactObj.Digest))
+	assert.NoError(t, repositoryService.DeleteByID(ctx, repositoryObj.ID))
+
+	call := func(user *models.User, method string, names, values []string, handle func(c echo.Context) error) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/", nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set(consts.ContextUser, user)
+		c.SetParamNames(names...)
+		c.SetParamValues(values...)
+		assert.NoError(t, handle(c))
+		return rec
+	}
+	namespaceID := strconv.FormatInt(namespaceObj.ID, 10)
+
+	rec := call(userObj, http.MethodGet, []string{"namespace_id"}, []string{namespaceID}, namespaceHandler.ListRecycleBinRepositories)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, int64(1), gjson.GetBytes(rec.Body.Bytes(), "total").Int())
+	assert.Equal(t, repositoryObj.Name, gjson.GetBytes(rec.Body.Bytes(), "items.0.name").String())
+
+	rec = call(userObj, http.MethodGet, []string{"namespace_id"}, []string{namespaceID}, namespaceHandler.ListRecycleBinTags)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, int64(1), gjson.GetBytes(rec.Body.Bytes(), "total").Int())
+	assert.Equal(t, repositoryObj.Name, gjson.GetBytes(rec.Body.Bytes(), "items.0.repository").String())
+	assert.Equal(t, artifactObj.Digest, gjson.GetBytes(rec.Body.Bytes(), "items.0.digest").String())
+
+	rec = call(userObj, http.MethodGet, []string{"namespace_id"}, []string{namespaceID}, namespaceHandler.ListRecycleBinArtifacts)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, int64(1), gjson.GetBytes(rec.Body.Bytes(), "total").Int())
+	assert.Equal(t, artifactObj.Digest, gjson.GetBytes(rec.Body.Bytes(), "items.0.digest").String())
+
+	// the tag can not be restored before the repository
+	rec = call(userObj, http.MethodPost, []string{"namespace_id", "id"}, []string{namespaceID, strconv.FormatInt(tagObj.ID, 10)}, namespaceHandler.RestoreRecycleBinTag)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	// the repository in another namespace is not found
+	rec = call(userObj, http.MethodPost, []string{"namespace_id", "id"}, []string{strconv.FormatInt(namespaceObj.ID+1, 10), strconv.FormatInt(repositoryObj.ID, 10)}, namespaceHandler.RestoreRecycleBinRepository)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = call(userObj, http.MethodPost, []string{"namespace_id", "id"}, []string{namespaceID, strconv.FormatInt(repositoryObj.ID, 10)}, namespaceHandler.RestoreRecycleBinRepository)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	_, err := repositoryService.GetByName(ctx, repositoryObj.Name)
+	assert.NoError(t, err)
+
+	rec = call(userObj, http.MethodPost, []string{"namespace_id", "id"}, []string{namespaceID, strconv.FormatInt(repositoryObj.ID, 10)}, namespaceHandler.RestoreRecycleBinRepository)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	// the deleted artifact of the tag is restored together with the tag
+	rec = call(userObj, http.MethodPost, []string{"namespace_id", "id"}, []string{namespaceID, strconv.FormatInt(tagObj.ID, 10)}, namespaceHandler.RestoreRecycleBinTag)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	restoredTagObj, err := tagService.GetByName(ctx, repositoryObj.ID, tagObj.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, artifactObj.ID, restoredTagObj.ArtifactID)
+	_, err = artifactService.GetByDigest(ctx, repositoryObj.ID, artifactObj.Digest)
+	assert.NoError(t, err)
+
+	// the artifact with the same digest already exists
+	artifactObj2 := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:artifact2",
+		Size: 123, ContentType: "test", Raw: []byte("test")}
+	assert.NoError(t, artifactService.Create(ctx, artifactObj2))
+	assert.NoError(t, artifactService.DeleteByID(ctx, artifactObj2.ID))
+	deletedArtifactObj2, err := artifactService.GetDeleted(ctx, artifactObj2.ID, 0)
+	assert.NoError(t, err)
+	assert.NoError(t, artifactService.Create(ctx, &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: deletedArtifactObj2.Digest,
+		Size: 123, ContentType: "test", Raw: []byte("test")}))
+	rec = call(userObj, http.MethodPost, []string{"namespace_id", "id"}, []string{namespaceID, strconv.FormatInt(artifactObj2.ID, 10)}, namespaceHandler.RestoreRecycleBinArtifact)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	artifactObj3 := &models.Artifact{NamespaceID: namespaceObj.ID, RepositoryID: repositoryObj.ID, Digest: "sha256:artifact3",
+		Size: 123, ContentType: "test", Raw: []byte("test")}
+	assert.NoError(t, artifactService.Create(ctx, artifactObj3))
+	assert.NoError(t, artifactService.DeleteByID(ctx, artifactObj3.ID))
+	rec = call(userObj, http.MethodPost, []string{"namespace_id", "id"}, []string{namespaceID, strconv.FormatInt(artifactObj3.ID, 10)}, namespaceHandler.RestoreRecycleBinArtifact)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	_, err = artifactService.GetByDigest(ctx, repositoryObj.ID, artifactObj3.Digest)
+	assert.NoError(t, err)
+
+	// the deleted namespaces can only be operated by the admin
+	assert.NoError(t, namespaceService.DeleteByID(ctx, namespaceObj.ID))
+
+	rec = call(userObj, http.MethodGet, nil, nil, namespaceHandler.ListRecycleBinNamespaces)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = call(adminObj, http.MethodGet, nil, nil, namespaceHandler.ListRecycleBinNamespaces)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, int64(1), gjson.GetBytes(rec.Body.Bytes(), "total").Int())
+	assert.Equal(t, namespaceObj.Name, gjson.GetBytes(rec.Body.Bytes(), "items.0.name").String())
+
+	rec = call(userObj, http.MethodPost, []string{"id"}, []string{namespaceID}, namespaceHandler.RestoreRecycleBinNamespace)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = call(adminObj, http.MethodPost, []string{"id"}, []string{namespaceID}, namespaceHandler.RestoreRecycleBinNamespace)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	_, err = namespaceService.Get(ctx, namespaceObj.ID)
+	assert.NoError(t, err)
+
+	count, err := query.Q.Audit.WithContext(ctx).Where(query.Q.Audit.Action.Eq(enums.AuditActionRestore)).Count()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+}
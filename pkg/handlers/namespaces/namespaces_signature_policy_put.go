@@ -0,0 +1,185 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespaces
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
+	"github.com/go-sigma/sigma/pkg/signing"
+	cosignverify "github.com/go-sigma/sigma/pkg/signing/cosign/verify"
+	notationverify "github.com/go-sigma/sigma/pkg/signing/notation/verify"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// PutNamespaceSignaturePolicy handles the update namespace signature policy request
+//
+//	@Summary	Update namespace signature policy
+//	@security	BasicAuth
+//	@Tags		Namespace
+//	@Accept		json
+//	@Produce	json
+//	@Router		/namespaces/{namespace_id}/signature-policy [put]
+//	@Param		namespace_id	path	number								true	"Namespace id"
+//	@Param		message			body	types.UpdateSignaturePolicyRequest	true	"Signature policy object"
+//	@Success	204
+//	@Failure	400	{object}	xerrors.ErrCode
+//	@Failure	401	{object}	xerrors.ErrCode
+//	@Failure	404	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) PutNamespaceSignaturePolicy(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+
+	var req types.UpdateSignaturePolicyRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+
+	authChecked, err := h.authServiceFactory.New().Namespace(ptr.To(user), req.NamespaceID, enums.AuthAdmin)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(errors.New(utils.UnwrapJoinedErrors(err))).Int64("NamespaceID", req.NamespaceID).Msg("Resource not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, utils.UnwrapJoinedErrors(err))
+		}
+		log.Error().Err(errors.New(utils.UnwrapJoinedErrors(err))).Int64("NamespaceID", req.NamespaceID).Msg("Get resource failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, utils.UnwrapJoinedErrors(err))
+	}
+	if !authChecked {
+		log.Error().Int64("UserID", user.ID).Int64("NamespaceID", req.NamespaceID).Msg("Auth check failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized, "No permission with this api")
+	}
+
+	namespaceObj, err := h.namespaceServiceFactory.New().Get(ctx, req.NamespaceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Msg("Namespace not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, err.Error())
+		}
+		log.Error().Err(err).Msg("Find namespace failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, err.Error())
+	}
+
+	_, err = signing.NewVerifying(signing.VerifyingOptions{
+		Cosign:   cosignverify.Options{PublicKeys: req.PublicKeys, Identities: req.KeylessIdentities, Roots: req.KeylessRoots},
+		Notation: notationverify.Options{Roots: req.NotationRoots, Identities: req.NotationIdentities},
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Signature policy is invalid")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Signature policy is invalid: %v", err))
+	}
+	var identities []byte
+	if len(req.KeylessIdentities) > 0 {
+		identities = utils.MustMarshal(req.KeylessIdentities)
+	}
+	var notationIdentities []byte
+	if len(req.NotationIdentities) > 0 {
+		notationIdentities = utils.MustMarshal(req.NotationIdentities)
+	}
+
+	err = query.Q.Transaction(func(tx *query.Query) error {
+		policyService := h.policyServiceFactory.New(tx)
+		policyObj, err := policyService.GetSignaturePolicy(ctx, namespaceObj.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Msg("Get signature policy failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Get signature policy failed: %v", err))
+		}
+		if policyObj == nil {
+			err = policyService.CreateSignaturePolicy(ctx, &models.SignaturePolicy{
+				NamespaceID:        namespaceObj.ID,
+				Enabled:            req.Enabled,
+				PublicKeys:         req.PublicKeys,
+				KeylessIdentities:  identities,
+				KeylessRoots:       req.KeylessRoots,
+				NotationRoots:      req.NotationRoots,
+				NotationIdentities: notationIdentities,
+				Exemptions:         strings.Join(req.Exemptions, ","),
+			})
+		} else {
+			err = policyService.UpdateSignaturePolicy(ctx, policyObj.ID, map[string]any{
+				query.SignaturePolicy.Enabled.ColumnName().String():            req.Enabled,
+				query.SignaturePolicy.PublicKeys.ColumnName().String():         req.PublicKeys,
+				query.SignaturePolicy.KeylessIdentities.ColumnName().String():  identities,
+				query.SignaturePolicy.KeylessRoots.ColumnName().String():       req.KeylessRoots,
+				query.SignaturePolicy.NotationRoots.ColumnName().String():      req.NotationRoots,
+				query.SignaturePolicy.NotationIdentities.ColumnName().String(): notationIdentities,
+				query.SignaturePolicy.Exemptions.ColumnName().String():         strings.Join(req.Exemptions, ","),
+			})
+		}
+		if err != nil {
+			log.Error().Err(err).Msg("Save signature policy failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Save signature policy failed: %v", err))
+		}
+		auditService := h.auditServiceFactory.New(tx)
+		err = auditService.Create(ctx, &models.Audit{
+			UserID:       user.ID,
+			NamespaceID:  ptr.Of(namespaceObj.ID),
+			Action:       enums.AuditActionUpdate,
+			ResourceType: enums.AuditResourceTypeNamespace,
+			Resource:     namespaceObj.Name,
+			ReqRaw:       utils.MustMarshal(req),
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Create audit for update signature policy failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Create audit for update signature policy failed: %v", err))
+		}
+		err = h.producerClient.Produce(ctx, enums.DaemonWebhook, types.DaemonWebhookPayload{
+			NamespaceID:  ptr.Of(namespaceObj.ID),
+			Action:       enums.WebhookActionUpdate,
+			ResourceType: enums.WebhookResourceTypeNamespace,
+			Payload:      utils.MustMarshal(req),
+		}, definition.ProducerOption{Tx: tx})
+		if err != nil {
+			log.Error().Err(err).Msg("Webhook event produce failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Webhook event produce failed: %v", err))
+		}
+		return nil
+	})
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,125 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespaces
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// DeleteNamespaceSigningKey handles the delete namespace signing key request,
+// the artifacts signed by the deleted key are not trusted any more, e.g. the key is compromised.
+//
+//	@Summary	Delete the namespace signing key
+//	@security	BasicAuth
+//	@Tags		Namespace
+//	@Accept		json
+//	@Produce	json
+//	@Router		/namespaces/{namespace_id}/signing-keys/{id} [delete]
+//	@Param		namespace_id	path	number	true	"Namespace id"
+//	@Param		id				path	number	true	"Signing key id"
+//	@Success	204
+//	@Failure	401	{object}	xerrors.ErrCode
+//	@Failure	404	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) DeleteNamespaceSigningKey(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+
+	var req types.DeleteNamespaceSigningKeyRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+
+	authChecked, err := h.authServiceFactory.New().Namespace(ptr.To(user), req.NamespaceID, enums.AuthAdmin)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(errors.New(utils.UnwrapJoinedErrors(err))).Int64("NamespaceID", req.NamespaceID).Msg("Resource not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, utils.UnwrapJoinedErrors(err))
+		}
+		log.Error().Err(errors.New(utils.UnwrapJoinedErrors(err))).Int64("NamespaceID", req.NamespaceID).Msg("Get resource failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, utils.UnwrapJoinedErrors(err))
+	}
+	if !authChecked {
+		log.Error().Int64("UserID", user.ID).Int64("NamespaceID", req.NamespaceID).Msg("Auth check failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized, "No permission with this api")
+	}
+
+	err = query.Q.Transaction(func(tx *query.Query) error {
+		signingKeyService := h.signingKeyServiceFactory.New(tx)
+		signingKeyObj, err := signingKeyService.Get(ctx, req.NamespaceID, req.ID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Error().Err(err).Int64("ID", req.ID).Msg("Signing key not found")
+				return xerrors.HTTPErrCodeNotFound.Detail(fmt.Sprintf("Signing key(%d) not found", req.ID))
+			}
+			log.Error().Err(err).Msg("Get signing key failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Get signing key failed: %v", err))
+		}
+		err = signingKeyService.Delete(ctx, req.NamespaceID, req.ID)
+		if err != nil {
+			log.Error().Err(err).Msg("Delete signing key failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Delete signing key failed: %v", err))
+		}
+		err = h.auditServiceFactory.New(tx).Create(ctx, &models.Audit{
+			UserID:       user.ID,
+			NamespaceID:  ptr.Of(req.NamespaceID),
+			Action:       enums.AuditActionDelete,
+			ResourceType: enums.AuditResourceTypeNamespace,
+			Resource:     signingKeyObj.Fingerprint,
+			ReqRaw:       utils.MustMarshal(req),
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Create audit for delete signing key failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Create audit for delete signing key failed: %v", err))
+		}
+		return nil
+	})
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,108 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespaces
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// ListNamespaceSigningKeys handles the list namespace signing keys request
+//
+//	@Summary	List the public keys of the namespace signing keys, including the retired keys
+//	@security	BasicAuth
+//	@Tags		Namespace
+//	@Accept		json
+//	@Produce	json
+//	@Router		/namespaces/{namespace_id}/signing-keys/ [get]
+//	@Param		namespace_id	path		number	true	"Namespace id"
+//	@Success	200				{object}	types.CommonList{items=[]types.NamespaceSigningKeyItem}
+//	@Failure	401				{object}	xerrors.ErrCode
+//	@Failure	404				{object}	xerrors.ErrCode
+//	@Failure	500				{object}	xerrors.ErrCode
+func (h *handler) ListNamespaceSigningKeys(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+
+	var req types.ListNamespaceSigningKeysRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+
+	authChecked, err := h.authServiceFactory.New().Namespace(ptr.To(user), req.NamespaceID, enums.AuthRead)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(errors.New(utils.UnwrapJoinedErrors(err))).Int64("NamespaceID", req.NamespaceID).Msg("Resource not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, utils.UnwrapJoinedErrors(err))
+		}
+		log.Error().Err(errors.New(utils.UnwrapJoinedErrors(err))).Int64("NamespaceID", req.NamespaceID).Msg("Get resource failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, utils.UnwrapJoinedErrors(err))
+	}
+	if !authChecked {
+		log.Error().Int64("UserID", user.ID).Int64("NamespaceID", req.NamespaceID).Msg("Auth check failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized, "No permission with this api")
+	}
+
+	signingKeyObjs, err := h.signingKeyServiceFactory.New().ListByNamespace(ctx, req.NamespaceID)
+	if err != nil {
+		log.Error().Err(err).Msg("List namespace signing keys failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("List namespace signing keys failed: %v", err))
+	}
+
+	var resp = make([]any, 0, len(signingKeyObjs))
+	for _, signingKeyObj := range signingKeyObjs {
+		var retiredAt *string
+		if signingKeyObj.RetiredAt != nil {
+			retiredAt = ptr.Of(time.UnixMilli(ptr.To(signingKeyObj.RetiredAt)).UTC().Format(consts.DefaultTimePattern))
+		}
+		resp = append(resp, types.NamespaceSigningKeyItem{
+			ID:          signingKeyObj.ID,
+			Fingerprint: signingKeyObj.Fingerprint,
+			PublicKey:   signingKeyObj.PublicKey,
+			Imported:    signingKeyObj.Imported,
+			Active:      signingKeyObj.Active,
+			RetiredAt:   retiredAt,
+			CreatedAt:   time.UnixMilli(signingKeyObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		})
+	}
+
+	return c.JSON(http.StatusOK, types.CommonList{Total: int64(len(resp)), Items: resp})
+}
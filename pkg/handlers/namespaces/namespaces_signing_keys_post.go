@@ -0,0 +1,176 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespaces
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/modules/workq/definition"
+	"github.com/go-sigma/sigma/pkg/signing"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// PostNamespaceSigningKey handles the generate or import namespace signing key request
+//
+//	@Summary	Generate or import the namespace signing key, the previous active key is retired
+//	@security	BasicAuth
+//	@Tags		Namespace
+//	@Accept		json
+//	@Produce	json
+//	@Router		/namespaces/{namespace_id}/signing-keys/ [post]
+//	@Param		namespace_id	path		number									true	"Namespace id"
+//	@Param		message			body		types.PostNamespaceSigningKeyRequest	true	"Signing key object"
+//	@Success	201				{object}	types.PostNamespaceSigningKeyResponse
+//	@Failure	400				{object}	xerrors.ErrCode
+//	@Failure	401				{object}	xerrors.ErrCode
+//	@Failure	404				{object}	xerrors.ErrCode
+//	@Failure	500				{object}	xerrors.ErrCode
+func (h *handler) PostNamespaceSigningKey(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+
+	var req types.PostNamespaceSigningKeyRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+
+	authChecked, err := h.authServiceFactory.New().Namespace(ptr.To(user), req.NamespaceID, enums.AuthAdmin)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(errors.New(utils.UnwrapJoinedErrors(err))).Int64("NamespaceID", req.NamespaceID).Msg("Resource not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, utils.UnwrapJoinedErrors(err))
+		}
+		log.Error().Err(errors.New(utils.UnwrapJoinedErrors(err))).Int64("NamespaceID", req.NamespaceID).Msg("Get resource failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, utils.UnwrapJoinedErrors(err))
+	}
+	if !authChecked {
+		log.Error().Int64("UserID", user.ID).Int64("NamespaceID", req.NamespaceID).Msg("Auth check failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized, "No permission with this api")
+	}
+
+	namespaceObj, err := h.namespaceServiceFactory.New().Get(ctx, req.NamespaceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Msg("Namespace not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, err.Error())
+		}
+		log.Error().Err(err).Msg("Find namespace failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, err.Error())
+	}
+
+	var keyPair *signing.KeyPair
+	if req.PrivateKey != nil {
+		keyPair, err = signing.ImportKeyPair([]byte(ptr.To(req.PrivateKey)), []byte(ptr.To(req.Password)))
+		if err != nil {
+			log.Error().Err(err).Msg("Import signing key failed")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Import signing key failed: %v", err))
+		}
+	} else {
+		keyPair, err = signing.GenerateKeyPair()
+		if err != nil {
+			log.Error().Err(err).Msg("Generate signing key failed")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Generate signing key failed: %v", err))
+		}
+	}
+	privateKey, err := signing.EncryptPrivateKey(namespaceObj.ID, keyPair.PrivateKey)
+	if err != nil {
+		log.Error().Err(err).Msg("Encrypt signing key failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Encrypt signing key failed: %v", err))
+	}
+
+	signingKeyObj := &models.SigningKey{
+		NamespaceID: namespaceObj.ID,
+		Fingerprint: keyPair.Fingerprint,
+		PublicKey:   string(keyPair.PublicKey),
+		PrivateKey:  privateKey,
+		Imported:    req.PrivateKey != nil,
+		Active:      true,
+	}
+	err = query.Q.Transaction(func(tx *query.Query) error {
+		signingKeyService := h.signingKeyServiceFactory.New(tx)
+		err := signingKeyService.Retire(ctx, namespaceObj.ID)
+		if err != nil {
+			log.Error().Err(err).Msg("Retire signing key failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Retire signing key failed: %v", err))
+		}
+		err = signingKeyService.Create(ctx, signingKeyObj)
+		if err != nil {
+			log.Error().Err(err).Msg("Create signing key failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Create signing key failed: %v", err))
+		}
+		payload := utils.MustMarshal(types.PostNamespaceSigningKeyResponse{ID: signingKeyObj.ID, Fingerprint: signingKeyObj.Fingerprint, PublicKey: signingKeyObj.PublicKey})
+		err = h.auditServiceFactory.New(tx).Create(ctx, &models.Audit{
+			UserID:       user.ID,
+			NamespaceID:  ptr.Of(namespaceObj.ID),
+			Action:       enums.AuditActionCreate,
+			ResourceType: enums.AuditResourceTypeNamespace,
+			Resource:     namespaceObj.Name,
+			ReqRaw:       payload,
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Create audit for create signing key failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Create audit for create signing key failed: %v", err))
+		}
+		err = h.producerClient.Produce(ctx, enums.DaemonWebhook, types.DaemonWebhookPayload{
+			NamespaceID:  ptr.Of(namespaceObj.ID),
+			Action:       enums.WebhookActionUpdate,
+			ResourceType: enums.WebhookResourceTypeNamespace,
+			Payload:      payload,
+		}, definition.ProducerOption{Tx: tx})
+		if err != nil {
+			log.Error().Err(err).Msg("Webhook event produce failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Webhook event produce failed: %v", err))
+		}
+		return nil
+	})
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+
+	return c.JSON(http.StatusCreated, types.PostNamespaceSigningKeyResponse{
+		ID:          signingKeyObj.ID,
+		Fingerprint: signingKeyObj.Fingerprint,
+		PublicKey:   signingKeyObj.PublicKey,
+	})
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/go-sigma/sigma/pkg/types/enums"
 	"github.com/go-sigma/sigma/pkg/utils"
 	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/utils/quota"
 	"github.com/go-sigma/sigma/pkg/xerrors"
 )
 
@@ -96,16 +97,34 @@ func (h *handler) PutNamespace(c echo.Context) error {
 		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, "Namespace quota is less than the before limit")
 	}
 
+	sizeLimit, sizeSoftLimit := namespaceObj.SizeLimit, namespaceObj.SizeSoftLimit
+	if req.SizeLimit != nil {
+		sizeLimit = ptr.To(req.SizeLimit)
+	}
+	if req.SizeSoftLimit != nil {
+		sizeSoftLimit = ptr.To(req.SizeSoftLimit)
+	}
+	if sizeLimit > 0 && sizeSoftLimit > sizeLimit {
+		log.Error().Int64("sizeLimit", sizeLimit).Int64("sizeSoftLimit", sizeSoftLimit).Msg("Size soft limit is greater than the size limit")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, "Size soft limit should not be greater than the size limit")
+	}
+
 	updates := make(map[string]any, 5)
 	if req.SizeLimit != nil {
 		updates[query.Namespace.SizeLimit.ColumnName().String()] = ptr.To(req.SizeLimit)
 	}
+	if req.SizeSoftLimit != nil {
+		updates[query.Namespace.SizeSoftLimit.ColumnName().String()] = ptr.To(req.SizeSoftLimit)
+	}
 	if req.RepositoryLimit != nil {
 		updates[query.Namespace.RepositoryLimit.ColumnName().String()] = ptr.To(req.RepositoryLimit)
 	}
 	if req.TagLimit != nil {
 		updates[query.Namespace.TagLimit.ColumnName().String()] = ptr.To(req.TagLimit)
 	}
+	if req.QuotaThresholds != nil {
+		updates[query.Namespace.QuotaThresholds.ColumnName().String()] = quota.FormatThresholds(ptr.To(req.QuotaThresholds))
+	}
 	if req.Description != nil {
 		updates[query.Namespace.Description.ColumnName().String()] = ptr.To(req.Description)
 	}
@@ -115,6 +134,15 @@ func (h *handler) PutNamespace(c echo.Context) error {
 	if req.Overview != nil {
 		updates[query.Repository.Overview.ColumnName().String()] = []byte(ptr.To(req.Overview))
 	}
+	if req.Scanner != nil {
+		updates[query.Namespace.Scanner.ColumnName().String()] = ptr.To(req.Scanner)
+	}
+	if req.SecretScan != nil {
+		updates[query.Namespace.SecretScan.ColumnName().String()] = ptr.To(req.SecretScan)
+	}
+	if req.MisconfigurationScan != nil {
+		updates[query.Namespace.MisconfigurationScan.ColumnName().String()] = ptr.To(req.MisconfigurationScan)
+	}
 
 	if len(updates) > 0 {
 		err = query.Q.Transaction(func(tx *query.Query) error {
@@ -147,6 +175,15 @@ func (h *handler) PutNamespace(c echo.Context) error {
 				log.Error().Err(err).Msg("Webhook event produce failed")
 				return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Webhook event produce failed: %v", err))
 			}
+			if req.SizeLimit != nil || req.RepositoryLimit != nil || req.TagLimit != nil || req.QuotaThresholds != nil {
+				err = h.producerClient.Produce(ctx, enums.DaemonQuota, types.DaemonQuotaPayload{
+					NamespaceID: namespaceObj.ID,
+				}, definition.ProducerOption{Tx: tx})
+				if err != nil {
+					log.Error().Err(err).Msg("Quota event produce failed")
+					return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Quota event produce failed: %v", err))
+				}
+			}
 			return nil
 		})
 		if err != nil {
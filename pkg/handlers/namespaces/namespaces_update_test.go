@@ -64,7 +64,7 @@ func TestPutNamespace(t *testing.T) {
 	workQueueProducer := workqmocks.NewMockWorkQueueProducer(ctrl)
 	workQueueProducer.EXPECT().Produce(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, topic enums.Daemon, payload any, option definition.ProducerOption) error {
 		return nil
-	}).Times(3)
+	}).Times(7)
 
 	namespaceHandler := handlerNew(inject{producerClient: workQueueProducer})
 
@@ -108,6 +108,50 @@ func TestPutNamespace(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusNoContent, c.Response().Status)
 
+	req = httptest.NewRequest(http.MethodPut, "/", bytes.NewBufferString(`{"size_soft_limit":80}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.Set(consts.ContextUser, userObj)
+	c.SetParamNames("id")
+	c.SetParamValues(strconv.FormatInt(resultID, 10))
+	err = namespaceHandler.PutNamespace(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, c.Response().Status)
+
+	req = httptest.NewRequest(http.MethodPut, "/", bytes.NewBufferString(`{"quota_thresholds":[50,90]}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.Set(consts.ContextUser, userObj)
+	c.SetParamNames("id")
+	c.SetParamValues(strconv.FormatInt(resultID, 10))
+	err = namespaceHandler.PutNamespace(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, c.Response().Status)
+
+	req = httptest.NewRequest(http.MethodPut, "/", bytes.NewBufferString(`{"quota_thresholds":[101]}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.Set(consts.ContextUser, userObj)
+	c.SetParamNames("id")
+	c.SetParamValues(strconv.FormatInt(resultID, 10))
+	err = namespaceHandler.PutNamespace(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, c.Response().Status)
+
+	req = httptest.NewRequest(http.MethodPut, "/", bytes.NewBufferString(`{"size_soft_limit":102}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.Set(consts.ContextUser, userObj)
+	c.SetParamNames("id")
+	c.SetParamValues(strconv.FormatInt(resultID, 10))
+	err = namespaceHandler.PutNamespace(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, c.Response().Status)
+
 	req = httptest.NewRequest(http.MethodPut, "/", bytes.NewBufferString(`{"visibility":"test"}`))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec = httptest.NewRecorder()
@@ -207,8 +207,9 @@ func (h *handler) Callback(c echo.Context) error {
 			err = query.Q.Transaction(func(tx *query.Query) error {
 				userService := dao.NewUserServiceFactory().New(tx)
 				userSignedObj = &models.User{
-					Username: userInfo.Username,
-					Email:    ptr.Of(userInfo.Email),
+					Username:       userInfo.Username,
+					Email:          ptr.Of(userInfo.Email),
+					NamespaceLimit: h.config.Namespace.UserLimit.Get(enums.UserRoleUser),
 				}
 				err = userService.Create(ctx, userSignedObj)
 				if err != nil {
@@ -82,6 +82,11 @@ func (h *handler) CreateRepository(c echo.Context) error {
 		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized, "No permission with this api or resource")
 	}
 
+	if ptr.To(req.SizeLimit) > 0 && ptr.To(req.SizeSoftLimit) > ptr.To(req.SizeLimit) {
+		log.Error().Int64("sizeLimit", ptr.To(req.SizeLimit)).Int64("sizeSoftLimit", ptr.To(req.SizeSoftLimit)).Msg("Size soft limit is greater than the size limit")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, "Size soft limit should not be greater than the size limit")
+	}
+
 	namespaceService := h.namespaceServiceFactory.New()
 	namespaceObj, err := namespaceService.Get(ctx, req.NamespaceID)
 	if err != nil {
@@ -94,12 +99,13 @@ func (h *handler) CreateRepository(c echo.Context) error {
 	}
 
 	repositoryObj := &models.Repository{
-		NamespaceID: namespaceObj.ID,
-		Name:        req.Name,
-		Description: req.Description,
-		Overview:    []byte(ptr.To(req.Overview)),
-		TagLimit:    ptr.To(req.TagLimit),
-		SizeLimit:   ptr.To(req.SizeLimit),
+		NamespaceID:   namespaceObj.ID,
+		Name:          req.Name,
+		Description:   req.Description,
+		Overview:      []byte(ptr.To(req.Overview)),
+		TagLimit:      ptr.To(req.TagLimit),
+		SizeLimit:     ptr.To(req.SizeLimit),
+		SizeSoftLimit: ptr.To(req.SizeSoftLimit),
 	}
 	repositoryService := h.repositoryServiceFactory.New()
 	err = repositoryService.Create(ctx, repositoryObj, dao.AutoCreateNamespace{
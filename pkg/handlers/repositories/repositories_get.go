@@ -144,20 +144,23 @@ func (h *handler) GetRepository(c echo.Context) error {
 			BuildkitDockerfile:         repositoryObj.Builder.BuildkitDockerfile,
 			BuildkitPlatforms:          platforms,
 			BuildkitBuildArgs:          repositoryObj.Builder.BuildkitBuildArgs,
+
+			SigningType: repositoryObj.Builder.SigningType,
 		}
 	}
 
 	return c.JSON(http.StatusOK, types.RepositoryItem{
-		ID:          repositoryObj.ID,
-		NamespaceID: repositoryObj.NamespaceID,
-		Name:        repositoryObj.Name,
-		Description: repositoryObj.Description,
-		Overview:    ptr.Of(string(repositoryObj.Overview)),
-		SizeLimit:   ptr.Of(repositoryObj.SizeLimit),
-		Size:        ptr.Of(repositoryObj.Size),
-		TagCount:    repositoryObj.TagCount,
-		Builder:     builderItemObj,
-		CreatedAt:   time.Unix(0, int64(time.Millisecond)*repositoryObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
-		UpdatedAt:   time.Unix(0, int64(time.Millisecond)*repositoryObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		ID:            repositoryObj.ID,
+		NamespaceID:   repositoryObj.NamespaceID,
+		Name:          repositoryObj.Name,
+		Description:   repositoryObj.Description,
+		Overview:      ptr.Of(string(repositoryObj.Overview)),
+		SizeLimit:     ptr.Of(repositoryObj.SizeLimit),
+		SizeSoftLimit: ptr.Of(repositoryObj.SizeSoftLimit),
+		Size:          ptr.Of(repositoryObj.Size),
+		TagCount:      repositoryObj.TagCount,
+		Builder:       builderItemObj,
+		CreatedAt:     time.Unix(0, int64(time.Millisecond)*repositoryObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt:     time.Unix(0, int64(time.Millisecond)*repositoryObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 	})
 }
@@ -107,17 +107,18 @@ func (h *handler) ListRepositories(c echo.Context) error {
 	var resp = make([]any, 0, len(repositoryObjs))
 	for _, repository := range repositoryObjs {
 		repositoryObj := types.RepositoryItem{
-			ID:          repository.ID,
-			NamespaceID: repository.NamespaceID,
-			Name:        repository.Name,
-			Description: repository.Description,
-			Overview:    ptr.Of(string(repository.Overview)),
-			SizeLimit:   ptr.Of(repository.SizeLimit),
-			Size:        ptr.Of(repository.Size),
-			TagCount:    repository.TagCount,
-			TagLimit:    ptr.Of(repository.TagLimit),
-			CreatedAt:   time.Unix(0, int64(time.Millisecond)*repository.CreatedAt).UTC().Format(consts.DefaultTimePattern),
-			UpdatedAt:   time.Unix(0, int64(time.Millisecond)*repository.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+			ID:            repository.ID,
+			NamespaceID:   repository.NamespaceID,
+			Name:          repository.Name,
+			Description:   repository.Description,
+			Overview:      ptr.Of(string(repository.Overview)),
+			SizeLimit:     ptr.Of(repository.SizeLimit),
+			SizeSoftLimit: ptr.Of(repository.SizeSoftLimit),
+			Size:          ptr.Of(repository.Size),
+			TagCount:      repository.TagCount,
+			TagLimit:      ptr.Of(repository.TagLimit),
+			CreatedAt:     time.Unix(0, int64(time.Millisecond)*repository.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+			UpdatedAt:     time.Unix(0, int64(time.Millisecond)*repository.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 		}
 		if builderMap != nil && builderMap[repository.ID] != nil {
 			builderObj := builderMap[repository.ID]
@@ -164,6 +165,8 @@ func (h *handler) ListRepositories(c echo.Context) error {
 				BuildkitContext:            builderObj.BuildkitContext,
 				BuildkitDockerfile:         builderObj.BuildkitDockerfile,
 				BuildkitPlatforms:          platforms,
+
+				SigningType: builderObj.SigningType,
 			}
 		}
 		resp = append(resp, repositoryObj)
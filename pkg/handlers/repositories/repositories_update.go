@@ -108,10 +108,25 @@ func (h *handler) UpdateRepository(c echo.Context) error {
 		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound)
 	}
 
+	sizeLimit, sizeSoftLimit := repositoryObj.SizeLimit, repositoryObj.SizeSoftLimit
+	if req.SizeLimit != nil {
+		sizeLimit = ptr.To(req.SizeLimit)
+	}
+	if req.SizeSoftLimit != nil {
+		sizeSoftLimit = ptr.To(req.SizeSoftLimit)
+	}
+	if sizeLimit > 0 && sizeSoftLimit > sizeLimit {
+		log.Error().Int64("sizeLimit", sizeLimit).Int64("sizeSoftLimit", sizeSoftLimit).Msg("Size soft limit is greater than the size limit")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, "Size soft limit should not be greater than the size limit")
+	}
+
 	updates := make(map[string]interface{}, 5)
 	if req.SizeLimit != nil {
 		updates[query.Namespace.SizeLimit.ColumnName().String()] = ptr.To(req.SizeLimit)
 	}
+	if req.SizeSoftLimit != nil {
+		updates[query.Repository.SizeSoftLimit.ColumnName().String()] = ptr.To(req.SizeSoftLimit)
+	}
 	if req.TagLimit != nil {
 		updates[query.Namespace.TagLimit.ColumnName().String()] = ptr.To(req.TagLimit)
 	}
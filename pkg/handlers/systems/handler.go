@@ -22,7 +22,9 @@ import (
 
 	"github.com/go-sigma/sigma/pkg/configs"
 	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
 	"github.com/go-sigma/sigma/pkg/handlers"
+	"github.com/go-sigma/sigma/pkg/middlewares"
 	"github.com/go-sigma/sigma/pkg/utils"
 )
 
@@ -34,29 +36,40 @@ type Handler interface {
 	GetVersion(c echo.Context) error
 	// GetConfig handles the get config request
 	GetConfig(c echo.Context) error
+	// GetVulnerabilityRescanPolicy handles the get system vulnerability rescan policy request
+	GetVulnerabilityRescanPolicy(c echo.Context) error
+	// PutVulnerabilityRescanPolicy handles the update system vulnerability rescan policy request
+	PutVulnerabilityRescanPolicy(c echo.Context) error
 }
 
 var _ Handler = &handler{}
 
 type handler struct {
-	config *configs.Configuration
+	config               *configs.Configuration
+	policyServiceFactory dao.PolicyServiceFactory
 }
 
 type inject struct {
-	config *configs.Configuration
+	config               *configs.Configuration
+	policyServiceFactory dao.PolicyServiceFactory
 }
 
 // handlerNew creates a new instance of the distribution handlers
 func handlerNew(injects ...inject) Handler {
 	config := configs.GetConfiguration()
+	policyServiceFactory := dao.NewPolicyServiceFactory()
 	if len(injects) > 0 {
 		ij := injects[0]
 		if ij.config != nil {
 			config = ij.config
 		}
+		if ij.policyServiceFactory != nil {
+			policyServiceFactory = ij.policyServiceFactory
+		}
 	}
 	return &handler{
-		config: config,
+		config:               config,
+		policyServiceFactory: policyServiceFactory,
 	}
 }
 
@@ -70,6 +83,8 @@ func (f factory) Initialize(e *echo.Echo) error {
 	systemGroup.GET("/endpoint", repositoryHandler.GetEndpoint)
 	systemGroup.GET("/version", repositoryHandler.GetVersion)
 	systemGroup.GET("/config", repositoryHandler.GetConfig)
+	systemGroup.GET("/vulnerability-rescan-policy", repositoryHandler.GetVulnerabilityRescanPolicy, middlewares.AuthWithConfig(middlewares.AuthConfig{}))
+	systemGroup.PUT("/vulnerability-rescan-policy", repositoryHandler.PutVulnerabilityRescanPolicy, middlewares.AuthWithConfig(middlewares.AuthConfig{}))
 	return nil
 }
 
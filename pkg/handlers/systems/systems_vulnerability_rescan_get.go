@@ -0,0 +1,87 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package systems
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// GetVulnerabilityRescanPolicy handles the get system vulnerability rescan policy request
+//
+//	@Summary	Get system vulnerability rescan policy
+//	@security	BasicAuth
+//	@Tags		System
+//	@Accept		json
+//	@Produce	json
+//	@Router		/systems/vulnerability-rescan-policy [get]
+//	@Success	200	{object}	types.GetVulnerabilityRescanPolicyResponse
+//	@Failure	401	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) GetVulnerabilityRescanPolicy(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	if !(user.Role == enums.UserRoleAdmin || user.Role == enums.UserRoleRoot) {
+		log.Error().Int64("UserID", user.ID).Msg("Auth check failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized, "No permission with this api")
+	}
+
+	policyObj, err := h.policyServiceFactory.New().GetVulnerabilityRescanPolicy(ctx, nil)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) { // the system-wide rescan policy is not set yet, return the default one
+			return c.JSON(http.StatusOK, types.GetVulnerabilityRescanPolicyResponse{
+				PulledWithin: consts.DefaultVulnerabilityRescanPulledWithin,
+			})
+		}
+		log.Error().Err(err).Msg("Get vulnerability rescan policy failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get vulnerability rescan policy failed: %v", err))
+	}
+
+	resp := types.GetVulnerabilityRescanPolicyResponse{
+		Enabled:      policyObj.Enabled,
+		CronRule:     policyObj.CronRule,
+		PulledWithin: policyObj.PulledWithin,
+		CreatedAt:    time.Unix(0, int64(time.Millisecond)*policyObj.CreatedAt).UTC().Format(consts.DefaultTimePattern),
+		UpdatedAt:    time.Unix(0, int64(time.Millisecond)*policyObj.UpdatedAt).UTC().Format(consts.DefaultTimePattern),
+	}
+	if policyObj.CronNextTrigger != nil {
+		resp.CronNextTrigger = ptr.Of(time.Unix(0, int64(time.Millisecond)*ptr.To(policyObj.CronNextTrigger)).UTC().Format(consts.DefaultTimePattern))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
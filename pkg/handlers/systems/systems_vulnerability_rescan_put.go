@@ -0,0 +1,119 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package systems
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/robfig/cron/v3"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// PutVulnerabilityRescanPolicy handles the update system vulnerability rescan policy request
+//
+//	@Summary	Update system vulnerability rescan policy
+//	@security	BasicAuth
+//	@Tags		System
+//	@Accept		json
+//	@Produce	json
+//	@Router		/systems/vulnerability-rescan-policy [put]
+//	@Param		message	body	types.UpdateVulnerabilityRescanPolicyRequest	true	"Vulnerability rescan policy object"
+//	@Success	204
+//	@Failure	400	{object}	xerrors.ErrCode
+//	@Failure	401	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) PutVulnerabilityRescanPolicy(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	if !(user.Role == enums.UserRoleAdmin || user.Role == enums.UserRoleRoot) {
+		log.Error().Int64("UserID", user.ID).Msg("Auth check failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized, "No permission with this api")
+	}
+
+	var req types.UpdateVulnerabilityRescanPolicyRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+	schedule, err := cron.ParseStandard(req.CronRule)
+	if err != nil {
+		log.Error().Err(err).Str("CronRule", req.CronRule).Msg("Parse cron rule failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Parse cron rule failed: %v", err))
+	}
+
+	nextTrigger := schedule.Next(time.Now()).UnixMilli()
+	err = query.Q.Transaction(func(tx *query.Query) error {
+		policyService := h.policyServiceFactory.New(tx)
+		policyObj, err := policyService.GetVulnerabilityRescanPolicy(ctx, nil)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Msg("Get vulnerability rescan policy failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Get vulnerability rescan policy failed: %v", err))
+		}
+		if policyObj == nil {
+			err = policyService.CreateVulnerabilityRescanPolicy(ctx, &models.VulnerabilityRescanPolicy{
+				Enabled:         req.Enabled,
+				CronRule:        req.CronRule,
+				PulledWithin:    req.PulledWithin,
+				CronNextTrigger: ptr.Of(nextTrigger),
+			})
+		} else {
+			err = policyService.UpdateVulnerabilityRescanPolicy(ctx, policyObj.ID, map[string]any{
+				query.VulnerabilityRescanPolicy.Enabled.ColumnName().String():         req.Enabled,
+				query.VulnerabilityRescanPolicy.CronRule.ColumnName().String():        req.CronRule,
+				query.VulnerabilityRescanPolicy.PulledWithin.ColumnName().String():    req.PulledWithin,
+				query.VulnerabilityRescanPolicy.CronNextTrigger.ColumnName().String(): nextTrigger,
+			})
+		}
+		if err != nil {
+			log.Error().Err(err).Msg("Save vulnerability rescan policy failed")
+			return xerrors.HTTPErrCodeInternalError.Detail(fmt.Sprintf("Save vulnerability rescan policy failed: %v", err))
+		}
+		return nil
+	})
+	if err != nil {
+		var e xerrors.ErrCode
+		if errors.As(err, &e) {
+			return xerrors.NewHTTPError(c, e)
+		}
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
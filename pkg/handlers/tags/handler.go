@@ -46,6 +46,7 @@ type handler struct {
 	repositoryServiceFactory dao.RepositoryServiceFactory
 	tagServiceFactory        dao.TagServiceFactory
 	artifactServiceFactory   dao.ArtifactServiceFactory
+	policyServiceFactory     dao.PolicyServiceFactory
 }
 
 type inject struct {
@@ -54,6 +55,7 @@ type inject struct {
 	repositoryServiceFactory dao.RepositoryServiceFactory
 	tagServiceFactory        dao.TagServiceFactory
 	artifactServiceFactory   dao.ArtifactServiceFactory
+	policyServiceFactory     dao.PolicyServiceFactory
 }
 
 // handlerNew creates a new instance of the distribution handlers
@@ -63,6 +65,7 @@ func handlerNew(injects ...inject) Handler {
 	tagServiceFactory := dao.NewTagServiceFactory()
 	artifactServiceFactory := dao.NewArtifactServiceFactory()
 	authServiceFactory := auth.NewAuthServiceFactory()
+	policyServiceFactory := dao.NewPolicyServiceFactory()
 	if len(injects) > 0 {
 		ij := injects[0]
 		if ij.repositoryServiceFactory != nil {
@@ -80,6 +83,9 @@ func handlerNew(injects ...inject) Handler {
 		if ij.authServiceFactory != nil {
 			authServiceFactory = ij.authServiceFactory
 		}
+		if ij.policyServiceFactory != nil {
+			policyServiceFactory = ij.policyServiceFactory
+		}
 	}
 	return &handler{
 		authServiceFactory:       authServiceFactory,
@@ -87,6 +93,7 @@ func handlerNew(injects ...inject) Handler {
 		repositoryServiceFactory: repositoryServiceFactory,
 		tagServiceFactory:        tagServiceFactory,
 		artifactServiceFactory:   artifactServiceFactory,
+		policyServiceFactory:     policyServiceFactory,
 	}
 }
 
@@ -0,0 +1,40 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tag
+
+import (
+	"encoding/json"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/scanner"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/utils"
+)
+
+// vulnerabilityResult renders the artifact vulnerability result with the vulnerability allowlist,
+// the findings are omitted to keep the response small.
+func vulnerabilityResult(result []byte, allowlist []*models.VulnerabilityAllowlist) string {
+	if len(result) == 0 {
+		return ""
+	}
+	var summary types.VulnerabilitySummary
+	err := json.Unmarshal(result, &summary)
+	if err != nil {
+		return string(result)
+	}
+	summary = scanner.SuppressVulnerabilities(summary, allowlist)
+	summary.Findings = nil
+	return string(utils.MustMarshal(summary))
+}
@@ -92,6 +92,12 @@ func (h *handler) GetTag(c echo.Context) error {
 		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, err.Error())
 	}
 
+	allowlist, err := h.policyServiceFactory.New().ListActiveVulnerabilityAllowlist(ctx, tag.Artifact.NamespaceID, tag.RepositoryID)
+	if err != nil {
+		log.Error().Err(err).Msg("List vulnerability allowlist failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, err.Error())
+	}
+
 	var artifacts = make([]types.TagItemArtifact, 0, len(tag.Artifact.ArtifactSubs))
 	for _, item := range tag.Artifact.ArtifactSubs {
 		artifacts = append(artifacts, types.TagItemArtifact{
@@ -103,7 +109,7 @@ func (h *handler) GetTag(c echo.Context) error {
 			BlobSize:      item.BlobsSize,
 			LastPull:      time.Unix(0, int64(time.Millisecond)*item.LastPull).UTC().Format(consts.DefaultTimePattern),
 			PushedAt:      time.Unix(0, int64(time.Millisecond)*item.PushedAt).UTC().Format(consts.DefaultTimePattern),
-			Vulnerability: string(item.Vulnerability.Result),
+			Vulnerability: vulnerabilityResult(item.Vulnerability.Result, allowlist),
 			Sbom:          string(item.Sbom.Result),
 			CreatedAt:     time.Unix(0, int64(time.Millisecond)*item.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 			UpdatedAt:     time.Unix(0, int64(time.Millisecond)*item.CreatedAt).UTC().Format(consts.DefaultTimePattern),
@@ -122,7 +128,7 @@ func (h *handler) GetTag(c echo.Context) error {
 			BlobSize:      tag.Artifact.BlobsSize,
 			LastPull:      time.Unix(0, int64(time.Millisecond)*tag.Artifact.LastPull).UTC().Format(consts.DefaultTimePattern),
 			PushedAt:      time.Unix(0, int64(time.Millisecond)*tag.Artifact.PushedAt).UTC().Format(consts.DefaultTimePattern),
-			Vulnerability: string(tag.Artifact.Vulnerability.Result),
+			Vulnerability: vulnerabilityResult(tag.Artifact.Vulnerability.Result, allowlist),
 			Sbom:          string(tag.Artifact.Sbom.Result),
 			CreatedAt:     time.Unix(0, int64(time.Millisecond)*tag.Artifact.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 			UpdatedAt:     time.Unix(0, int64(time.Millisecond)*tag.Artifact.CreatedAt).UTC().Format(consts.DefaultTimePattern),
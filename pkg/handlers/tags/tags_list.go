@@ -120,6 +120,12 @@ func (h *handler) ListTag(c echo.Context) error {
 		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, err.Error())
 	}
 
+	allowlist, err := h.policyServiceFactory.New().ListActiveVulnerabilityAllowlist(ctx, namespaceObj.ID, repositoryObj.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("List vulnerability allowlist failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, err.Error())
+	}
+
 	var resp = make([]any, 0, len(tags))
 	for _, tag := range tags {
 		if tag.Artifact == nil {
@@ -140,7 +146,7 @@ func (h *handler) ListTag(c echo.Context) error {
 				BlobSize:        item.BlobsSize,
 				LastPull:        time.Unix(0, int64(time.Millisecond)*item.LastPull).UTC().Format(consts.DefaultTimePattern),
 				PushedAt:        time.Unix(0, int64(time.Millisecond)*item.PushedAt).UTC().Format(consts.DefaultTimePattern),
-				Vulnerability:   string(item.Vulnerability.Result),
+				Vulnerability:   vulnerabilityResult(item.Vulnerability.Result, allowlist),
 				Sbom:            string(item.Sbom.Result),
 				CreatedAt:       time.Unix(0, int64(time.Millisecond)*item.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 				UpdatedAt:       time.Unix(0, int64(time.Millisecond)*item.CreatedAt).UTC().Format(consts.DefaultTimePattern),
@@ -161,7 +167,7 @@ func (h *handler) ListTag(c echo.Context) error {
 				BlobSize:        tag.Artifact.BlobsSize,
 				LastPull:        time.Unix(0, int64(time.Millisecond)*tag.Artifact.LastPull).UTC().Format(consts.DefaultTimePattern),
 				PushedAt:        time.Unix(0, int64(time.Millisecond)*tag.Artifact.PushedAt).UTC().Format(consts.DefaultTimePattern),
-				Vulnerability:   string(tag.Artifact.Vulnerability.Result),
+				Vulnerability:   vulnerabilityResult(tag.Artifact.Vulnerability.Result, allowlist),
 				Sbom:            string(tag.Artifact.Sbom.Result),
 				CreatedAt:       time.Unix(0, int64(time.Millisecond)*tag.Artifact.CreatedAt).UTC().Format(consts.DefaultTimePattern),
 				UpdatedAt:       time.Unix(0, int64(time.Millisecond)*tag.Artifact.CreatedAt).UTC().Format(consts.DefaultTimePattern),
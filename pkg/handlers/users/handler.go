@@ -61,6 +61,13 @@ type Handler interface {
 	SelfPut(c echo.Context) error
 	// SelfResetPassword handles the self reset request
 	SelfResetPassword(c echo.Context) error
+	// SelfGetNamespaceQuota handles the self get namespace quota request
+	SelfGetNamespaceQuota(c echo.Context) error
+
+	// GetNamespaceQuota handles the get user namespace quota request
+	GetNamespaceQuota(c echo.Context) error
+	// PutNamespaceQuota handles the put user namespace quota request
+	PutNamespaceQuota(c echo.Context) error
 }
 
 type handler struct {
@@ -142,11 +149,14 @@ func (f factory) Initialize(e *echo.Echo) error {
 	userGroup.GET("/self", userHandler.SelfGet)
 	userGroup.PUT("/self", userHandler.SelfPut)
 	userGroup.PUT("/self/reset-password", userHandler.SelfResetPassword)
+	userGroup.GET("/self/namespace-quota", userHandler.SelfGetNamespaceQuota)
 
 	userGroup.GET("/recover-password", userHandler.RecoverPassword)
 	userGroup.PUT("/recover-password-reset/:code", userHandler.RecoverPasswordReset)
 
 	userGroup.PUT("/:id/reset-password", userHandler.ResetPassword)
+	userGroup.GET("/:id/namespace-quota", userHandler.GetNamespaceQuota)
+	userGroup.PUT("/:id/namespace-quota", userHandler.PutNamespaceQuota)
 
 	return nil
 }
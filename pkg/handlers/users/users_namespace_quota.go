@@ -0,0 +1,179 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package users
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/dal/query"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/xerrors"
+)
+
+// SelfGetNamespaceQuota handles the self get namespace quota request
+//
+//	@Summary	Get the namespace quota of current user
+//	@security	BasicAuth
+//	@Tags		User
+//	@Accept		json
+//	@Produce	json
+//	@Router		/users/self/namespace-quota [get]
+//	@Success	200	{object}	types.UserNamespaceQuotaResponse
+//	@Failure	401	{object}	xerrors.ErrCode
+func (h *handler) SelfGetNamespaceQuota(c echo.Context) error {
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+
+	return c.JSON(http.StatusOK, types.UserNamespaceQuotaResponse{
+		NamespaceLimit: user.NamespaceLimit,
+		NamespaceCount: user.NamespaceCount,
+	})
+}
+
+// GetNamespaceQuota handles the get user namespace quota request
+//
+//	@Summary	Get the namespace quota of user
+//	@security	BasicAuth
+//	@Tags		User
+//	@Accept		json
+//	@Produce	json
+//	@Router		/users/{id}/namespace-quota [get]
+//	@Param		id	path		string	true	"User id"
+//	@Success	200	{object}	types.UserNamespaceQuotaResponse
+//	@Failure	401	{object}	xerrors.ErrCode
+//	@Failure	404	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) GetNamespaceQuota(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	if !(user.Role == enums.UserRoleAdmin || user.Role == enums.UserRoleRoot) {
+		log.Error().Int64("UserID", user.ID).Msg("Auth check failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized, "No permission with this api")
+	}
+
+	var req types.GetUserNamespaceQuotaRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+
+	userObj, err := h.userServiceFactory.New().Get(ctx, req.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Int64("UserID", req.UserID).Msg("User not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("User not found: %v", err))
+		}
+		log.Error().Err(err).Int64("UserID", req.UserID).Msg("Get user failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get user failed: %v", err))
+	}
+
+	return c.JSON(http.StatusOK, types.UserNamespaceQuotaResponse{
+		NamespaceLimit: userObj.NamespaceLimit,
+		NamespaceCount: userObj.NamespaceCount,
+	})
+}
+
+// PutNamespaceQuota handles the put user namespace quota request
+//
+//	@Summary	Update the namespace quota of user
+//	@security	BasicAuth
+//	@Tags		User
+//	@Accept		json
+//	@Produce	json
+//	@Router		/users/{id}/namespace-quota [put]
+//	@Param		id		path	string								true	"User id"
+//	@Param		message	body	types.PutUserNamespaceQuotaRequest	true	"Namespace quota object"
+//	@Success	204
+//	@Failure	400	{object}	xerrors.ErrCode
+//	@Failure	401	{object}	xerrors.ErrCode
+//	@Failure	404	{object}	xerrors.ErrCode
+//	@Failure	500	{object}	xerrors.ErrCode
+func (h *handler) PutNamespaceQuota(c echo.Context) error {
+	ctx := log.Logger.WithContext(c.Request().Context())
+
+	iuser := c.Get(consts.ContextUser)
+	if iuser == nil {
+		log.Error().Msg("Get user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	user, ok := iuser.(*models.User)
+	if !ok {
+		log.Error().Msg("Convert user from header failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized)
+	}
+	if !(user.Role == enums.UserRoleAdmin || user.Role == enums.UserRoleRoot) {
+		log.Error().Int64("UserID", user.ID).Msg("Auth check failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeUnauthorized, "No permission with this api")
+	}
+
+	var req types.PutUserNamespaceQuotaRequest
+	err := utils.BindValidate(c, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Bind and validate request body failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeBadRequest, fmt.Sprintf("Bind and validate request body failed: %v", err))
+	}
+
+	userService := h.userServiceFactory.New()
+	userObj, err := userService.Get(ctx, req.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Int64("UserID", req.UserID).Msg("User not found")
+			return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeNotFound, fmt.Sprintf("User not found: %v", err))
+		}
+		log.Error().Err(err).Int64("UserID", req.UserID).Msg("Get user failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Get user failed: %v", err))
+	}
+
+	// the limit lower than the current count is allowed, the user just can not create namespace any more
+	err = userService.UpdateByID(ctx, userObj.ID, map[string]any{
+		query.User.NamespaceLimit.ColumnName().String(): ptr.To(req.NamespaceLimit),
+	})
+	if err != nil {
+		log.Error().Err(err).Int64("UserID", userObj.ID).Msg("Update user namespace limit failed")
+		return xerrors.NewHTTPError(c, xerrors.HTTPErrCodeInternalError, fmt.Sprintf("Update user namespace limit failed: %v", err))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,175 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package users
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/logger"
+	"github.com/go-sigma/sigma/pkg/tests"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+	"github.com/go-sigma/sigma/pkg/validators"
+)
+
+func TestNamespaceQuota(t *testing.T) {
+	logger.SetLevel("debug")
+
+	e := echo.New()
+	validators.Initialize(e)
+	assert.NoError(t, tests.Initialize(t))
+	assert.NoError(t, tests.DB.Init())
+	defer func() {
+		conn, err := dal.DB.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+		assert.NoError(t, tests.DB.DeInit())
+	}()
+
+	config := &configs.Configuration{
+		Namespace: configs.ConfigurationNamespace{
+			UserLimit: configs.ConfigurationNamespaceUserLimit{User: 2},
+		},
+		Auth: configs.ConfigurationAuth{
+			Jwt: configs.ConfigurationAuthJwt{
+				PrivateKey: privateKeyString,
+			},
+		},
+	}
+	configs.SetConfiguration(config)
+
+	userHandler, err := handlerNew()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	// the signup user got the default namespace limit of the user role
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"username":"quota","password":"123498712311Aa!","email":"quota@xx.com"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	err = userHandler.Signup(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, c.Response().Status)
+
+	userService := dao.NewUserServiceFactory().New()
+	userObj, err := userService.GetByUsername(ctx, "quota")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), userObj.NamespaceLimit)
+
+	// the namespace auto created by the user is counted
+	repositoryService := dao.NewRepositoryServiceFactory().New()
+	assert.NoError(t, repositoryService.Create(ctx, &models.Repository{Name: "quota/busybox"}, dao.AutoCreateNamespace{AutoCreate: true, UserID: userObj.ID}))
+
+	// the namespace that the user is granted the admin of is not counted
+	namespaceObj := &models.Namespace{Name: "quota-granted"}
+	assert.NoError(t, dao.NewNamespaceServiceFactory().New().Create(ctx, namespaceObj))
+	_, err = dao.NewNamespaceMemberServiceFactory().New().AddNamespaceMember(ctx, userObj.ID, ptr.To(namespaceObj), enums.NamespaceRoleAdmin)
+	assert.NoError(t, err)
+
+	userObj, err = userService.Get(ctx, userObj.ID)
+	assert.NoError(t, err)
+
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.Set(consts.ContextUser, userObj)
+	err = userHandler.SelfGetNamespaceQuota(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, c.Response().Status)
+	assert.Equal(t, int64(2), gjson.GetBytes(rec.Body.Bytes(), "namespace_limit").Int())
+	assert.Equal(t, int64(1), gjson.GetBytes(rec.Body.Bytes(), "namespace_count").Int())
+
+	// the normal user can not adjust the limit
+	req = httptest.NewRequest(http.MethodPut, "/", bytes.NewBufferString(`{"namespace_limit":10}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.Set(consts.ContextUser, userObj)
+	c.SetParamNames("id")
+	c.SetParamValues(strconv.FormatInt(userObj.ID, 10))
+	err = userHandler.PutNamespaceQuota(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, c.Response().Status)
+
+	adminObj := &models.User{Username: "quota-admin", Password: ptr.Of("test"), Email: ptr.Of("quota-admin@xx.com"), Role: enums.UserRoleAdmin}
+	assert.NoError(t, userService.Create(ctx, adminObj))
+
+	req = httptest.NewRequest(http.MethodPut, "/", bytes.NewBufferString(`{"namespace_limit":10}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.Set(consts.ContextUser, adminObj)
+	c.SetParamNames("id")
+	c.SetParamValues(strconv.FormatInt(userObj.ID, 10))
+	err = userHandler.PutNamespaceQuota(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, c.Response().Status)
+
+	req = httptest.NewRequest(http.MethodPut, "/", bytes.NewBufferString(`{}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.Set(consts.ContextUser, adminObj)
+	c.SetParamNames("id")
+	c.SetParamValues(strconv.FormatInt(userObj.ID, 10))
+	err = userHandler.PutNamespaceQuota(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, c.Response().Status)
+
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.Set(consts.ContextUser, adminObj)
+	c.SetParamNames("id")
+	c.SetParamValues(strconv.FormatInt(userObj.ID, 10))
+	err = userHandler.GetNamespaceQuota(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, c.Response().Status)
+	assert.Equal(t, int64(10), gjson.GetBytes(rec.Body.Bytes(), "namespace_limit").Int())
+	assert.Equal(t, int64(1), gjson.GetBytes(rec.Body.Bytes(), "namespace_count").Int())
+
+	// the namespace can not be auto created over the limit
+	assert.NoError(t, userService.UpdateByID(ctx, userObj.ID, map[string]any{"namespace_limit": 1}))
+	err = repositoryService.Create(ctx, &models.Repository{Name: "quota-exceed/busybox"}, dao.AutoCreateNamespace{AutoCreate: true, UserID: userObj.ID})
+	assert.ErrorContains(t, err, "namespace count quota is 1")
+	_, err = dao.NewNamespaceServiceFactory().New().GetByName(ctx, "quota-exceed")
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.Set(consts.ContextUser, adminObj)
+	c.SetParamNames("id")
+	c.SetParamValues("10000")
+	err = userHandler.GetNamespaceQuota(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, c.Response().Status)
+}
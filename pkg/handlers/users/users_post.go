@@ -62,14 +62,14 @@ func (h *handler) Post(c echo.Context) error {
 		Password:       ptr.Of(pwdHash),
 		Email:          ptr.Of(req.Email),
 		Role:           req.Role,
-		NamespaceLimit: ptr.To(req.NamespaceLimit),
+		NamespaceLimit: h.config.Namespace.UserLimit.Get(req.Role),
+	}
+	if req.NamespaceLimit != nil {
+		userObj.NamespaceLimit = ptr.To(req.NamespaceLimit)
 	}
 
 	err = query.Q.Transaction(func(tx *query.Query) error {
 		userService := h.userServiceFactory.New(tx)
-		if userObj.Role == enums.UserRoleAdmin {
-			userObj.NamespaceLimit = 0
-		}
 		err = userService.Create(ctx, &userObj)
 		if err != nil {
 			log.Error().Err(err).Msg("Create user failed")
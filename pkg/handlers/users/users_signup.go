@@ -25,6 +25,7 @@ import (
 	"github.com/go-sigma/sigma/pkg/consts"
 	"github.com/go-sigma/sigma/pkg/dal/models"
 	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
 	"github.com/go-sigma/sigma/pkg/utils"
 	"github.com/go-sigma/sigma/pkg/utils/ptr"
 	"github.com/go-sigma/sigma/pkg/xerrors"
@@ -60,9 +61,10 @@ func (h *handler) Signup(c echo.Context) error {
 	}
 
 	user := &models.User{
-		Username: req.Username,
-		Password: ptr.Of(pwdHash),
-		Email:    ptr.Of(req.Email),
+		Username:       req.Username,
+		Password:       ptr.Of(pwdHash),
+		Email:          ptr.Of(req.Email),
+		NamespaceLimit: h.config.Namespace.UserLimit.Get(enums.UserRoleUser),
 	}
 	err = userService.Create(ctx, user)
 	if err != nil {
@@ -16,8 +16,16 @@ package inits
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"math/big"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/sigstore/cosign/v2/pkg/cosign"
@@ -66,8 +74,69 @@ func signing(_ configs.Configuration) error {
 				log.Error().Err(err).Msg("Create signing key failed")
 				return err
 			}
-			return nil
 		}
 	}
+	return notationSigning(ctx)
+}
+
+// notationSigning generates the notation signing key and the self-signed certificate if not exist
+func notationSigning(ctx context.Context) error {
+	settingServiceFactory := dao.NewSettingServiceFactory()
+	_, err := settingServiceFactory.New().Get(ctx, consts.SettingNotationPrivateKey)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error().Err(err).Msg("Get notation signing key failed")
+		return err
+	}
+	privateKey, certificate, err := generateNotationCertificate()
+	if err != nil {
+		log.Error().Err(err).Msg("Generate notation signing key failed")
+		return fmt.Errorf("generate notation signing key failed: %v", err)
+	}
+	err = query.Q.Transaction(func(tx *query.Query) error {
+		settingService := settingServiceFactory.New(tx)
+		err := settingService.Create(ctx, consts.SettingNotationPrivateKey, privateKey)
+		if err != nil {
+			return err
+		}
+		return settingService.Create(ctx, consts.SettingNotationCertificate, certificate)
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Create notation signing key failed")
+		return err
+	}
 	return nil
 }
+
+// generateNotationCertificate generates the ecdsa key and the self-signed code signing certificate in pem format
+func generateNotationCertificate() ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: consts.AppName, Organization: []string{consts.AppName}},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().AddDate(10, 0, 0),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		BasicConstraintsValid: true,
+	}
+	certDer, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer}),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDer}), nil
+}
@@ -15,6 +15,9 @@
 package inits
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -35,4 +38,19 @@ func TestSigning(t *testing.T) {
 	}()
 
 	assert.NoError(t, signing(configs.Configuration{}))
+	assert.NoError(t, signing(configs.Configuration{}))
+}
+
+func TestGenerateNotationCertificate(t *testing.T) {
+	privateKey, certificate, err := generateNotationCertificate()
+	assert.NoError(t, err)
+
+	_, err = tls.X509KeyPair(certificate, privateKey)
+	assert.NoError(t, err)
+
+	block, _ := pem.Decode(certificate)
+	assert.NotNil(t, block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	assert.NoError(t, err)
+	assert.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning}, cert.ExtKeyUsage)
 }
@@ -0,0 +1,210 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grype
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/scanner"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+func init() {
+	utils.PanicIf(scanner.RegisterScannerFactory(enums.ScannerTypeGrype, &factory{}))
+}
+
+type factory struct{}
+
+var _ scanner.Factory = factory{}
+
+// New ...
+func (f factory) New(_ configs.Configuration) (scanner.Scanner, error) {
+	return &grype{}, nil
+}
+
+type grype struct{}
+
+// severities the grype severities, the negligible and unknown severity is treated as none
+var severities = map[string]enums.VulnerabilitySeverity{
+	"Critical": enums.VulnerabilitySeverityCritical,
+	"High":     enums.VulnerabilitySeverityHigh,
+	"Medium":   enums.VulnerabilitySeverityMedium,
+	"Low":      enums.VulnerabilitySeverityLow,
+}
+
+type report struct {
+	Matches    []match    `json:"matches"`
+	Descriptor descriptor `json:"descriptor"`
+}
+
+type descriptor struct {
+	Name    string          `json:"name"`
+	Version string          `json:"version"`
+	DB      json.RawMessage `json:"db,omitempty"`
+}
+
+type match struct {
+	Vulnerability          vulnerability   `json:"vulnerability"`
+	RelatedVulnerabilities []vulnerability `json:"relatedVulnerabilities"`
+	Artifact               artifact        `json:"artifact"`
+}
+
+type vulnerability struct {
+	ID          string   `json:"id"`
+	DataSource  string   `json:"dataSource"`
+	Severity    string   `json:"severity"`
+	Description string   `json:"description"`
+	Cvss        []cvss   `json:"cvss"`
+	Fix         fix      `json:"fix"`
+	URLs        []string `json:"urls"`
+}
+
+type cvss struct {
+	Version string `json:"version"`
+	Metrics struct {
+		BaseScore float64 `json:"baseScore"`
+	} `json:"metrics"`
+}
+
+type fix struct {
+	Versions []string `json:"versions"`
+	State    string   `json:"state"`
+}
+
+type artifact struct {
+	Name      string     `json:"name"`
+	Version   string     `json:"version"`
+	Type      string     `json:"type"`
+	Locations []location `json:"locations"`
+}
+
+type location struct {
+	Path    string `json:"path"`
+	LayerID string `json:"layerID"`
+}
+
+// Scan scans the artifact with grype
+func (g *grype) Scan(ctx context.Context, option scanner.Option) (*scanner.Report, error) {
+	filename := fmt.Sprintf("%s.grype.json", uuid.New().String())
+	cmd := exec.CommandContext(ctx, "grype", fmt.Sprintf("registry:%s", option.Image()), "-q", "-o", "json", "--file", filename)
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("GRYPE_REGISTRY_AUTH_TOKEN=%s", option.Token),
+		"GRYPE_DB_AUTO_UPDATE=false",
+		"GRYPE_CHECK_FOR_APP_UPDATE=false",
+	)
+	if strings.HasPrefix(option.Endpoint, "https://") {
+		cmd.Env = append(cmd.Env, "GRYPE_REGISTRY_INSECURE_SKIP_TLS_VERIFY=true")
+	} else {
+		cmd.Env = append(cmd.Env, "GRYPE_REGISTRY_INSECURE_USE_HTTP=true")
+	}
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	log.Info().Str("artifactDigest", option.Digest).Str("cmd", cmd.String()).Msg("Start scan artifact with grype")
+
+	defer func() {
+		if utils.IsFile(filename) {
+			err := os.Remove(filename)
+			if err != nil {
+				log.Error().Err(err).Msg("Remove file failed")
+			}
+		}
+	}()
+
+	err := cmd.Run()
+	if err != nil {
+		log.Error().Err(err).Str("stdout", stdout.String()).Str("stderr", stderr.String()).Str("cmd", cmd.String()).Msg("Run grype failed")
+		return nil, &scanner.ExecError{Err: fmt.Errorf("run grype failed: %w", err), Stdout: stdout.Bytes(), Stderr: stderr.Bytes()}
+	}
+
+	raw, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("read grype file(%s) failed: %w", filename, err)
+	}
+	var reportObj report
+	err = json.Unmarshal(raw, &reportObj)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal grype report failed: %w", err)
+	}
+	return &scanner.Report{Raw: raw, Metadata: reportObj.Descriptor.DB}, nil
+}
+
+// Parse parses the grype json report to the normalized findings
+func (g *grype) Parse(raw []byte) ([]types.VulnerabilityReportItem, error) {
+	var reportObj report
+	err := json.Unmarshal(raw, &reportObj)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal grype report failed: %w", err)
+	}
+	var items = make([]types.VulnerabilityReportItem, 0, len(reportObj.Matches))
+	for _, m := range reportObj.Matches {
+		item := types.VulnerabilityReportItem{
+			ID:               m.Vulnerability.ID,
+			Package:          m.Artifact.Name,
+			InstalledVersion: m.Artifact.Version,
+			FixedVersion:     strings.Join(m.Vulnerability.Fix.Versions, ", "),
+			Severity:         enums.VulnerabilitySeverityNone,
+			Target:           m.Artifact.Type,
+			Title:            m.Vulnerability.Description,
+			PrimaryURL:       m.Vulnerability.DataSource,
+		}
+		if severity, ok := severities[m.Vulnerability.Severity]; ok {
+			item.Severity = severity
+		}
+		if len(m.Artifact.Locations) > 0 {
+			item.LayerDigest = m.Artifact.Locations[0].LayerID
+		}
+		item.CVSS = cvssScore(append([]vulnerability{m.Vulnerability}, m.RelatedVulnerabilities...))
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// cvssScore returns the max cvss v3 score of the vulnerabilities, falls back to the max cvss v2 score,
+// the cvss is usually provided by the related nvd vulnerability.
+func cvssScore(vulnerabilities []vulnerability) *float64 {
+	var v2, v3 float64
+	for _, v := range vulnerabilities {
+		for _, c := range v.Cvss {
+			if strings.HasPrefix(c.Version, "2") {
+				v2 = max(v2, c.Metrics.BaseScore)
+			} else {
+				v3 = max(v3, c.Metrics.BaseScore)
+			}
+		}
+	}
+	if v3 > 0 {
+		return ptr.Of(v3)
+	}
+	if v2 > 0 {
+		return ptr.Of(v2)
+	}
+	return nil
+}
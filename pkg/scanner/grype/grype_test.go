@@ -0,0 +1,85 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grype
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+const grypeReport = `{
+  "matches": [
+    {
+      "vulnerability": {
+        "id": "CVE-2023-5678",
+        "dataSource": "https://security.alpinelinux.org/vuln/CVE-2023-5678",
+        "severity": "Medium",
+        "description": "Generating excessively long X9.42 DH keys",
+        "cvss": [],
+        "fix": {"versions": ["3.1.4-r1"], "state": "fixed"}
+      },
+      "relatedVulnerabilities": [
+        {
+          "id": "CVE-2023-5678",
+          "cvss": [
+            {"version": "2.0", "metrics": {"baseScore": 6.1}},
+            {"version": "3.1", "metrics": {"baseScore": 5.3}}
+          ]
+        }
+      ],
+      "artifact": {
+        "name": "libcrypto3",
+        "version": "3.1.3-r0",
+        "type": "apk",
+        "locations": [{"path": "/lib/apk/db/installed", "layerID": "sha256:96526aa774ef0126ad0fe9e9a95764c5fc37f409ab9e97021e7b4775d82bf6fa"}]
+      }
+    },
+    {
+      "vulnerability": {
+        "id": "CVE-2024-0002",
+        "severity": "Negligible",
+        "cvss": [{"version": "2.0", "metrics": {"baseScore": 2.1}}],
+        "fix": {"versions": [], "state": "not-fixed"}
+      },
+      "artifact": {"name": "musl", "version": "1.2.4-r1", "type": "apk"}
+    }
+  ],
+  "descriptor": {"name": "grype", "version": "0.74.0", "db": {"built": "2024-01-01T00:00:00Z", "schemaVersion": 5}}
+}`
+
+func TestParse(t *testing.T) {
+	scannerObj, err := factory{}.New(configs.Configuration{})
+	assert.NoError(t, err)
+
+	items, err := scannerObj.Parse([]byte(grypeReport))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(items))
+	assert.Equal(t, "libcrypto3", items[0].Package)
+	assert.Equal(t, "3.1.4-r1", items[0].FixedVersion)
+	assert.Equal(t, enums.VulnerabilitySeverityMedium, items[0].Severity)
+	assert.Equal(t, 5.3, ptr.To(items[0].CVSS))
+	assert.Equal(t, "sha256:96526aa774ef0126ad0fe9e9a95764c5fc37f409ab9e97021e7b4775d82bf6fa", items[0].LayerDigest)
+	assert.Equal(t, enums.VulnerabilitySeverityNone, items[1].Severity)
+	assert.Equal(t, "", items[1].FixedVersion)
+	assert.Equal(t, 2.1, ptr.To(items[1].CVSS))
+
+	_, err = scannerObj.Parse([]byte("invalid"))
+	assert.Error(t, err)
+}
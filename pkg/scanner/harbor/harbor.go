@@ -0,0 +1,244 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package harbor
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/scanner"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+const (
+	// mimeScanRequest the mime type of the scan request
+	mimeScanRequest = "application/vnd.scanner.adapter.scan.request+json; version=1.0"
+	// mimeScanResponse the mime type of the scan response
+	mimeScanResponse = "application/vnd.scanner.adapter.scan.response+json; version=1.0"
+	// mimeVulnerabilityReport the mime type of the vulnerability report
+	mimeVulnerabilityReport = "application/vnd.security.vulnerability.report; version=1.1"
+	// defaultRefreshAfter the duration to wait for the report if the adapter not specified the Refresh-After header
+	defaultRefreshAfter = time.Second * 5
+)
+
+func init() {
+	utils.PanicIf(scanner.RegisterScannerFactory(enums.ScannerTypeHarbor, &factory{}))
+}
+
+type factory struct{}
+
+var _ scanner.Factory = factory{}
+
+// New ...
+func (f factory) New(config configs.Configuration) (scanner.Scanner, error) {
+	return &harbor{config: config.Daemon.Scanner.Harbor}, nil
+}
+
+// harbor is the scanner that calls the scanner adapter which implements the harbor pluggable scanner api spec,
+// see https://github.com/goharbor/pluggable-scanner-spec
+type harbor struct {
+	config configs.ConfigurationDaemonScannerHarbor
+}
+
+// severities the harbor severities, the negligible and unknown severity is treated as none
+var severities = map[string]enums.VulnerabilitySeverity{
+	"Critical": enums.VulnerabilitySeverityCritical,
+	"High":     enums.VulnerabilitySeverityHigh,
+	"Medium":   enums.VulnerabilitySeverityMedium,
+	"Low":      enums.VulnerabilitySeverityLow,
+}
+
+type scanRequest struct {
+	Registry scanRequestRegistry `json:"registry"`
+	Artifact scanRequestArtifact `json:"artifact"`
+}
+
+type scanRequestRegistry struct {
+	URL           string `json:"url"`
+	Authorization string `json:"authorization"`
+}
+
+type scanRequestArtifact struct {
+	Repository string `json:"repository"`
+	Digest     string `json:"digest"`
+	MimeType   string `json:"mime_type,omitempty"`
+}
+
+type scanResponse struct {
+	ID string `json:"id"`
+}
+
+type report struct {
+	GeneratedAt     string          `json:"generated_at"`
+	Scanner         json.RawMessage `json:"scanner,omitempty"`
+	Vulnerabilities []vulnerability `json:"vulnerabilities"`
+}
+
+type vulnerability struct {
+	ID            string         `json:"id"`
+	Package       string         `json:"package"`
+	Version       string         `json:"version"`
+	FixVersion    string         `json:"fix_version"`
+	Severity      string         `json:"severity"`
+	Description   string         `json:"description"`
+	Links         []string       `json:"links"`
+	Layer         *layer         `json:"layer,omitempty"`
+	PreferredCVSS *preferredCVSS `json:"preferred_cvss,omitempty"`
+}
+
+type layer struct {
+	Digest string `json:"digest"`
+}
+
+type preferredCVSS struct {
+	ScoreV3 *float64 `json:"score_v3,omitempty"`
+	ScoreV2 *float64 `json:"score_v2,omitempty"`
+}
+
+func (h *harbor) client() *resty.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if h.config.SkipTlsVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} // nolint: gosec
+	}
+	client := resty.NewWithClient(&http.Client{
+		Transport: transport,
+		// the adapter responses 302 if the report is not ready
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	})
+	client.SetBaseURL(strings.TrimSuffix(h.config.Endpoint, "/"))
+	client.SetHeader("User-Agent", consts.UserAgent)
+	if h.config.Authorization != "" {
+		client.SetHeader(echo.HeaderAuthorization, h.config.Authorization)
+	}
+	return client
+}
+
+// Scan submits the artifact to the scanner adapter and waits for the vulnerability report
+func (h *harbor) Scan(ctx context.Context, option scanner.Option) (*scanner.Report, error) {
+	if h.config.Endpoint == "" {
+		return nil, fmt.Errorf("harbor scanner adapter endpoint not configured")
+	}
+	ctx, cancel := context.WithTimeout(ctx, h.config.Timeout)
+	defer cancel()
+
+	client := h.client()
+	resp, err := client.R().SetContext(ctx).
+		SetHeader(echo.HeaderContentType, mimeScanRequest).
+		SetHeader(echo.HeaderAccept, mimeScanResponse).
+		SetBody(scanRequest{
+			Registry: scanRequestRegistry{URL: option.Endpoint, Authorization: fmt.Sprintf("Bearer %s", option.Token)},
+			Artifact: scanRequestArtifact{Repository: option.Repository, Digest: option.Digest, MimeType: option.MediaType},
+		}).
+		Post("/api/v1/scan")
+	if err != nil {
+		return nil, fmt.Errorf("request harbor scanner adapter failed: %w", err)
+	}
+	if resp.StatusCode() != http.StatusAccepted {
+		return nil, fmt.Errorf("request harbor scanner adapter failed, status code: %d, body: %s", resp.StatusCode(), resp.String())
+	}
+	var scanResp scanResponse
+	err = json.Unmarshal(resp.Body(), &scanResp)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal harbor scan response failed: %w", err)
+	}
+
+	log.Info().Str("artifactDigest", option.Digest).Str("scanID", scanResp.ID).Msg("Start scan artifact with harbor scanner adapter")
+
+	for {
+		resp, err = client.R().SetContext(ctx).
+			SetHeader(echo.HeaderAccept, mimeVulnerabilityReport).
+			Get(fmt.Sprintf("/api/v1/scan/%s/report", scanResp.ID))
+		if err != nil {
+			return nil, fmt.Errorf("get harbor scan report failed: %w", err)
+		}
+		switch resp.StatusCode() {
+		case http.StatusOK:
+			var reportObj report
+			err = json.Unmarshal(resp.Body(), &reportObj)
+			if err != nil {
+				return nil, fmt.Errorf("unmarshal harbor scan report failed: %w", err)
+			}
+			return &scanner.Report{Raw: resp.Body(), Metadata: reportObj.Scanner}, nil
+		case http.StatusFound:
+			refreshAfter := defaultRefreshAfter
+			seconds, err := strconv.Atoi(resp.Header().Get("Refresh-After"))
+			if err == nil && seconds > 0 {
+				refreshAfter = time.Second * time.Duration(seconds)
+			}
+			select {
+			case <-ctx.Done():
+				return nil, fmt.Errorf("wait harbor scan report failed: %w", ctx.Err())
+			case <-time.After(refreshAfter):
+			}
+		default:
+			return nil, fmt.Errorf("get harbor scan report failed, status code: %d, body: %s", resp.StatusCode(), resp.String())
+		}
+	}
+}
+
+// Parse parses the harbor vulnerability report to the normalized findings
+func (h *harbor) Parse(raw []byte) ([]types.VulnerabilityReportItem, error) {
+	var reportObj report
+	err := json.Unmarshal(raw, &reportObj)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal harbor report failed: %w", err)
+	}
+	var items = make([]types.VulnerabilityReportItem, 0, len(reportObj.Vulnerabilities))
+	for _, v := range reportObj.Vulnerabilities {
+		item := types.VulnerabilityReportItem{
+			ID:               v.ID,
+			Package:          v.Package,
+			InstalledVersion: v.Version,
+			FixedVersion:     v.FixVersion,
+			Severity:         enums.VulnerabilitySeverityNone,
+			Title:            v.Description,
+		}
+		if severity, ok := severities[v.Severity]; ok {
+			item.Severity = severity
+		}
+		if v.Layer != nil {
+			item.LayerDigest = v.Layer.Digest
+		}
+		if len(v.Links) > 0 {
+			item.PrimaryURL = v.Links[0]
+		}
+		if v.PreferredCVSS != nil {
+			if ptr.To(v.PreferredCVSS.ScoreV3) > 0 {
+				item.CVSS = v.PreferredCVSS.ScoreV3
+			} else if ptr.To(v.PreferredCVSS.ScoreV2) > 0 {
+				item.CVSS = v.PreferredCVSS.ScoreV2
+			}
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
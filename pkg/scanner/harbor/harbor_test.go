@@ -0,0 +1,126 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package harbor
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/scanner"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+const harborReport = `{
+  "generated_at": "2024-01-01T00:00:00Z",
+  "scanner": {"name": "Commercial", "vendor": "Vendor", "version": "1.0"},
+  "severity": "High",
+  "vulnerabilities": [
+    {
+      "id": "CVE-2023-5678",
+      "package": "libcrypto3",
+      "version": "3.1.3-r0",
+      "fix_version": "3.1.4-r1",
+      "severity": "High",
+      "description": "Generating excessively long X9.42 DH keys",
+      "links": ["https://nvd.nist.gov/vuln/detail/CVE-2023-5678"],
+      "layer": {"digest": "sha256:96526aa774ef0126ad0fe9e9a95764c5fc37f409ab9e97021e7b4775d82bf6fa"},
+      "preferred_cvss": {"score_v3": 7.5, "score_v2": 5.0}
+    },
+    {
+      "id": "CVE-2024-0002",
+      "package": "musl",
+      "version": "1.2.4-r1",
+      "severity": "Unknown"
+    }
+  ]
+}`
+
+func TestParse(t *testing.T) {
+	scannerObj, err := factory{}.New(configs.Configuration{})
+	assert.NoError(t, err)
+
+	items, err := scannerObj.Parse([]byte(harborReport))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(items))
+	assert.Equal(t, enums.VulnerabilitySeverityHigh, items[0].Severity)
+	assert.Equal(t, 7.5, ptr.To(items[0].CVSS))
+	assert.Equal(t, "https://nvd.nist.gov/vuln/detail/CVE-2023-5678", items[0].PrimaryURL)
+	assert.Equal(t, "sha256:96526aa774ef0126ad0fe9e9a95764c5fc37f409ab9e97021e7b4775d82bf6fa", items[0].LayerDigest)
+	assert.Equal(t, enums.VulnerabilitySeverityNone, items[1].Severity)
+	assert.Nil(t, items[1].CVSS)
+
+	_, err = scannerObj.Parse([]byte("invalid"))
+	assert.Error(t, err)
+}
+
+func TestScan(t *testing.T) {
+	var polled int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer adapter", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/api/v1/scan":
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, mimeScanRequest, r.Header.Get("Content-Type"))
+			var req scanRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "library/busybox", req.Artifact.Repository)
+			assert.Equal(t, "Bearer token", req.Registry.Authorization)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"id":"scan-id"}`))
+		case "/api/v1/scan/scan-id/report":
+			assert.Equal(t, mimeVulnerabilityReport, r.Header.Get("Accept"))
+			polled++
+			if polled == 1 {
+				w.Header().Set("Refresh-After", "1")
+				w.Header().Set("Location", r.URL.String())
+				w.WriteHeader(http.StatusFound)
+				return
+			}
+			_, _ = w.Write([]byte(harborReport))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	scannerObj, err := factory{}.New(configs.Configuration{Daemon: configs.ConfigurationDaemon{Scanner: configs.ConfigurationDaemonScanner{
+		Harbor: configs.ConfigurationDaemonScannerHarbor{Endpoint: server.URL, Authorization: "Bearer adapter", Timeout: time.Minute},
+	}}})
+	assert.NoError(t, err)
+
+	reportObj, err := scannerObj.Scan(context.Background(), scanner.Option{
+		Endpoint:   "http://127.0.0.1:3000",
+		Repository: "library/busybox",
+		Digest:     "sha256:xxx",
+		Token:      "token",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, polled)
+	assert.JSONEq(t, harborReport, string(reportObj.Raw))
+	assert.JSONEq(t, `{"name": "Commercial", "vendor": "Vendor", "version": "1.0"}`, string(reportObj.Metadata))
+
+	scannerObj, err = factory{}.New(configs.Configuration{})
+	assert.NoError(t, err)
+	_, err = scannerObj.Scan(context.Background(), scanner.Option{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+//go:generate mockgen -destination=mocks/scanner.go -package=mocks github.com/go-sigma/sigma/pkg/scanner Scanner
+
+// Scanner is the interface for the vulnerability scanner
+type Scanner interface {
+	// Scan scans the artifact and returns the raw report of the scanner.
+	Scan(ctx context.Context, option Option) (*Report, error)
+	// Parse parses the raw report that returned by Scan to the normalized findings.
+	Parse(raw []byte) ([]types.VulnerabilityReportItem, error)
+}
+
+// Option is the option of the artifact to be scanned
+type Option struct {
+	// Endpoint is the registry endpoint with the scheme, e.g. http://127.0.0.1:3000
+	Endpoint   string
+	Repository string
+	Digest     string
+	MediaType  string
+	// Token is the bearer token to pull the artifact from the registry
+	Token string
+}
+
+// Image returns the image reference of the artifact, e.g. 127.0.0.1:3000/library/busybox@sha256:xxx
+func (o Option) Image() string {
+	return fmt.Sprintf("%s/%s@%s", utils.TrimHTTP(o.Endpoint), o.Repository, o.Digest)
+}
+
+// Report is the report returned by the scanner
+type Report struct {
+	// Raw is the raw report of the scanner, it can be parsed by the scanner's Parse
+	Raw []byte
+	// Metadata is the metadata of the vulnerability database that the scanner used
+	Metadata []byte
+}
+
+// ExecError is the error returned by the scanner that runs a command
+type ExecError struct {
+	Err    error
+	Stdout []byte
+	Stderr []byte
+}
+
+// Error ...
+func (e *ExecError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap ...
+func (e *ExecError) Unwrap() error {
+	return e.Err
+}
+
+// Factory is the interface for the scanner factory
+type Factory interface {
+	New(config configs.Configuration) (Scanner, error)
+}
+
+var scannerFactories = make(map[enums.ScannerType]Factory)
+
+// RegisterScannerFactory registers a scanner factory by name.
+// If RegisterScannerFactory is called twice with the same name, it returns error.
+func RegisterScannerFactory(name enums.ScannerType, factory Factory) error {
+	if _, ok := scannerFactories[name]; ok {
+		return fmt.Errorf("scanner %q already registered", name)
+	}
+	scannerFactories[name] = factory
+	return nil
+}
+
+// New creates the scanner by name, the default scanner in config is used if name is nil
+func New(config configs.Configuration, name *enums.ScannerType) (Scanner, error) {
+	scannerType := Type(config, name)
+	factory, ok := scannerFactories[scannerType]
+	if !ok {
+		return nil, fmt.Errorf("scanner %q not registered", scannerType)
+	}
+	return factory.New(config)
+}
+
+// Type returns the scanner type that will be used, the default scanner in config is used if name is nil
+func Type(config configs.Configuration, name *enums.ScannerType) enums.ScannerType {
+	if name != nil {
+		return *name
+	}
+	return config.Daemon.Scanner.Default
+}
+
+// SuppressVulnerabilities recalculates the severity counts of the vulnerability summary,
+// the findings matched the allowlist are moved to the suppressed list and are not counted.
+// The summary scanned without findings is returned as it is.
+func SuppressVulnerabilities(summary types.VulnerabilitySummary, allowlist []*models.VulnerabilityAllowlist) types.VulnerabilitySummary {
+	if len(summary.Findings) == 0 {
+		return summary
+	}
+	result := types.VulnerabilitySummary{Findings: summary.Findings}
+	for _, finding := range summary.Findings {
+		if VulnerabilityAllowed(finding, allowlist) {
+			result.Suppressed = append(result.Suppressed, finding)
+			continue
+		}
+		switch finding.Severity {
+		case enums.VulnerabilitySeverityCritical:
+			result.Critical++
+		case enums.VulnerabilitySeverityHigh:
+			result.High++
+		case enums.VulnerabilitySeverityMedium:
+			result.Medium++
+		case enums.VulnerabilitySeverityLow:
+			result.Low++
+		}
+	}
+	return result
+}
+
+// VulnerabilityAllowed checks the finding matched the allowlist or not,
+// the allowlist entry without package matches the vulnerability in any package.
+func VulnerabilityAllowed(finding types.VulnerabilityFinding, allowlist []*models.VulnerabilityAllowlist) bool {
+	for _, item := range allowlist {
+		if !strings.EqualFold(item.VulnerabilityID, finding.ID) {
+			continue
+		}
+		if ptr.To(item.Package) == "" || ptr.To(item.Package) == finding.Package {
+			return true
+		}
+	}
+	return false
+}
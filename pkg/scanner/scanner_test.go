@@ -0,0 +1,119 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+type dummyScanner struct{}
+
+func (dummyScanner) Scan(_ context.Context, _ Option) (*Report, error) {
+	return &Report{}, nil
+}
+
+func (dummyScanner) Parse(_ []byte) ([]types.VulnerabilityReportItem, error) {
+	return nil, nil
+}
+
+type dummyFactory struct{}
+
+func (dummyFactory) New(_ configs.Configuration) (Scanner, error) {
+	return &dummyScanner{}, nil
+}
+
+type dummyFactoryError struct{}
+
+func (dummyFactoryError) New(_ configs.Configuration) (Scanner, error) {
+	return nil, fmt.Errorf("dummy error")
+}
+
+func TestRegisterScannerFactory(t *testing.T) {
+	scannerFactories = make(map[enums.ScannerType]Factory)
+
+	err := RegisterScannerFactory(enums.ScannerTypeTrivy, &dummyFactory{})
+	assert.NoError(t, err)
+
+	err = RegisterScannerFactory(enums.ScannerTypeTrivy, &dummyFactory{})
+	assert.Error(t, err)
+}
+
+func TestNew(t *testing.T) {
+	scannerFactories = make(map[enums.ScannerType]Factory)
+
+	err := RegisterScannerFactory(enums.ScannerTypeTrivy, &dummyFactory{})
+	assert.NoError(t, err)
+	err = RegisterScannerFactory(enums.ScannerTypeHarbor, &dummyFactoryError{})
+	assert.NoError(t, err)
+
+	config := configs.Configuration{Daemon: configs.ConfigurationDaemon{Scanner: configs.ConfigurationDaemonScanner{Default: enums.ScannerTypeTrivy}}}
+
+	scannerObj, err := New(config, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, scannerObj)
+
+	_, err = New(config, ptr.Of(enums.ScannerTypeGrype))
+	assert.Error(t, err)
+
+	_, err = New(config, ptr.Of(enums.ScannerTypeHarbor))
+	assert.Error(t, err)
+
+	assert.Equal(t, enums.ScannerTypeTrivy, Type(config, nil))
+	assert.Equal(t, enums.ScannerTypeGrype, Type(config, ptr.Of(enums.ScannerTypeGrype)))
+}
+
+func TestOptionImage(t *testing.T) {
+	option := Option{Endpoint: "https://127.0.0.1:3000", Repository: "library/busybox", Digest: "sha256:xxx"}
+	assert.Equal(t, "127.0.0.1:3000/library/busybox@sha256:xxx", option.Image())
+}
+
+func TestSuppressVulnerabilities(t *testing.T) {
+	summary := types.VulnerabilitySummary{Critical: 1, High: 1}
+	assert.Equal(t, summary, SuppressVulnerabilities(summary, nil))
+
+	summary = types.VulnerabilitySummary{
+		Findings: []types.VulnerabilityFinding{
+			{ID: "CVE-2023-0001", Package: "openssl", Severity: enums.VulnerabilitySeverityCritical},
+			{ID: "CVE-2023-0002", Package: "busybox", Severity: enums.VulnerabilitySeverityHigh},
+			{ID: "CVE-2023-0002", Package: "ssl_client", Severity: enums.VulnerabilitySeverityHigh},
+			{ID: "CVE-2023-0003", Package: "zlib", Severity: enums.VulnerabilitySeverityLow},
+		},
+	}
+	result := SuppressVulnerabilities(summary, nil)
+	assert.Equal(t, int64(1), result.Critical)
+	assert.Equal(t, int64(2), result.High)
+	assert.Equal(t, int64(1), result.Low)
+	assert.Len(t, result.Suppressed, 0)
+
+	result = SuppressVulnerabilities(summary, []*models.VulnerabilityAllowlist{
+		{VulnerabilityID: "cve-2023-0001"},
+		{VulnerabilityID: "CVE-2023-0002", Package: ptr.Of("busybox")},
+	})
+	assert.Equal(t, int64(0), result.Critical)
+	assert.Equal(t, int64(1), result.High)
+	assert.Equal(t, int64(1), result.Low)
+	assert.Len(t, result.Suppressed, 2)
+	assert.Len(t, result.Findings, 4)
+}
@@ -0,0 +1,259 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trivy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+
+	trivyTypes "github.com/aquasecurity/trivy/pkg/types"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/scanner"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+func init() {
+	utils.PanicIf(scanner.RegisterScannerFactory(enums.ScannerTypeTrivy, &factory{}))
+}
+
+type factory struct{}
+
+var _ scanner.Factory = factory{}
+
+// New ...
+func (f factory) New(_ configs.Configuration) (scanner.Scanner, error) {
+	return &trivy{}, nil
+}
+
+type trivy struct{}
+
+// severities the trivy severities, the unknown severity is treated as none
+var severities = map[string]enums.VulnerabilitySeverity{
+	"CRITICAL": enums.VulnerabilitySeverityCritical,
+	"HIGH":     enums.VulnerabilitySeverityHigh,
+	"MEDIUM":   enums.VulnerabilitySeverityMedium,
+	"LOW":      enums.VulnerabilitySeverityLow,
+}
+
+// cacheDir returns the cache dir of trivy, it is the same as the dir that trivy used when scanning
+func cacheDir() (string, error) {
+	if utils.IsDir("/opt/trivy") {
+		return "/opt/trivy", nil
+	}
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return path.Join(cacheDir, "trivy"), nil
+}
+
+// DBMetadata reads the metadata of the installed trivy vulnerability database
+func DBMetadata() ([]byte, *types.TrivyDBMetadata, error) {
+	cacheDir, err := cacheDir()
+	if err != nil {
+		return nil, nil, err
+	}
+	content, err := os.ReadFile(path.Join(cacheDir, "db", "metadata.json"))
+	if err != nil {
+		return nil, nil, err
+	}
+	var metadata types.TrivyDBMetadata
+	err = json.Unmarshal(content, &metadata)
+	if err != nil {
+		return nil, nil, err
+	}
+	return content, &metadata, nil
+}
+
+// Scan scans the artifact with trivy
+func (t *trivy) Scan(ctx context.Context, option scanner.Option) (*scanner.Report, error) {
+	metadata, _, err := DBMetadata()
+	if err != nil {
+		log.Warn().Err(err).Msg("Read trivy db metadata failed")
+	}
+	raw, err := run(ctx, option, "vuln")
+	if err != nil {
+		return nil, err
+	}
+	return &scanner.Report{Raw: raw, Metadata: metadata}, nil
+}
+
+// ScanSecret scans the image contents for the leaked secrets (e.g. private keys, tokens) with trivy
+func ScanSecret(ctx context.Context, option scanner.Option) ([]byte, error) {
+	return run(ctx, option, "secret")
+}
+
+// ScanMisconfiguration scans the config files (e.g. Dockerfile, kubernetes manifests) inside the image with trivy
+func ScanMisconfiguration(ctx context.Context, option scanner.Option) ([]byte, error) {
+	return run(ctx, option, "misconfig")
+}
+
+// run runs trivy with the specified scanners, returns the raw json report
+func run(ctx context.Context, option scanner.Option, scanners string) ([]byte, error) {
+	filename := fmt.Sprintf("%s.trivy.json", uuid.New().String())
+	cmd := exec.CommandContext(ctx, "trivy", "image")
+	if strings.HasPrefix(option.Endpoint, "https://") {
+		cmd.Args = append(cmd.Args, "--insecure")
+	}
+	cmd.Args = append(cmd.Args, "-q", "--format", "json", "--parallel", "2", "--scanners", scanners, "--output", filename,
+		"--skip-db-update", "--skip-java-db-update")
+	if utils.IsDir("/opt/trivy") {
+		cmd.Args = append(cmd.Args, "--offline-scan", "--cache-dir", "/opt/trivy")
+	}
+	cmd.Args = append(cmd.Args, option.Image())
+	cmd.Env = append(cmd.Env, fmt.Sprintf("TRIVY_REGISTRY_TOKEN=%s", option.Token))
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	log.Info().Str("artifactDigest", option.Digest).Str("scanners", scanners).Str("cmd", cmd.String()).Msg("Start scan artifact with trivy")
+
+	defer func() {
+		if utils.IsFile(filename) {
+			err := os.Remove(filename)
+			if err != nil {
+				log.Error().Err(err).Msg("Remove file failed")
+			}
+		}
+	}()
+
+	err := cmd.Run()
+	if err != nil {
+		log.Error().Err(err).Str("stdout", stdout.String()).Str("stderr", stderr.String()).Str("cmd", cmd.String()).Msg("Run trivy failed")
+		return nil, &scanner.ExecError{Err: fmt.Errorf("run trivy failed: %w", err), Stdout: stdout.Bytes(), Stderr: stderr.Bytes()}
+	}
+
+	raw, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("read trivy file(%s) failed: %w", filename, err)
+	}
+	return raw, nil
+}
+
+// Parse parses the trivy json report to the normalized findings
+func (t *trivy) Parse(raw []byte) ([]types.VulnerabilityReportItem, error) {
+	var trivyObj trivyTypes.Report
+	err := json.Unmarshal(raw, &trivyObj)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal trivy report failed: %w", err)
+	}
+	var items = make([]types.VulnerabilityReportItem, 0)
+	for _, result := range trivyObj.Results {
+		for _, v := range result.Vulnerabilities {
+			item := types.VulnerabilityReportItem{
+				ID:               v.VulnerabilityID,
+				Package:          v.PkgName,
+				InstalledVersion: v.InstalledVersion,
+				FixedVersion:     v.FixedVersion,
+				Severity:         enums.VulnerabilitySeverityNone,
+				LayerDigest:      v.Layer.Digest,
+				Target:           result.Target,
+				Title:            v.Title,
+				PrimaryURL:       v.PrimaryURL,
+			}
+			if severity, ok := severities[v.Severity]; ok {
+				item.Severity = severity
+			}
+			for _, cvss := range v.CVSS {
+				score := cvss.V3Score
+				if score == 0 {
+					score = cvss.V2Score
+				}
+				if score > 0 && (item.CVSS == nil || score > ptr.To(item.CVSS)) {
+					item.CVSS = ptr.Of(score)
+				}
+			}
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// ParseSecrets parses the trivy json report to the normalized secret findings
+func ParseSecrets(raw []byte) ([]types.SecretReportItem, error) {
+	var trivyObj trivyTypes.Report
+	err := json.Unmarshal(raw, &trivyObj)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal trivy report failed: %w", err)
+	}
+	var items = make([]types.SecretReportItem, 0)
+	for _, result := range trivyObj.Results {
+		for _, secret := range result.Secrets {
+			item := types.SecretReportItem{
+				RuleID:      secret.RuleID,
+				Category:    string(secret.Category),
+				Title:       secret.Title,
+				Severity:    enums.VulnerabilitySeverityNone,
+				Target:      result.Target,
+				StartLine:   secret.StartLine,
+				EndLine:     secret.EndLine,
+				Match:       secret.Match,
+				LayerDigest: secret.Layer.Digest,
+			}
+			if severity, ok := severities[secret.Severity]; ok {
+				item.Severity = severity
+			}
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// ParseMisconfigurations parses the trivy json report to the normalized misconfiguration findings,
+// only the failed checks are returned.
+func ParseMisconfigurations(raw []byte) ([]types.MisconfigurationReportItem, error) {
+	var trivyObj trivyTypes.Report
+	err := json.Unmarshal(raw, &trivyObj)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal trivy report failed: %w", err)
+	}
+	var items = make([]types.MisconfigurationReportItem, 0)
+	for _, result := range trivyObj.Results {
+		for _, misconfiguration := range result.Misconfigurations {
+			if misconfiguration.Status != "" && misconfiguration.Status != trivyTypes.MisconfStatusFailure {
+				continue
+			}
+			item := types.MisconfigurationReportItem{
+				ID:          misconfiguration.ID,
+				Type:        misconfiguration.Type,
+				Title:       misconfiguration.Title,
+				Message:     misconfiguration.Message,
+				Resolution:  misconfiguration.Resolution,
+				Severity:    enums.VulnerabilitySeverityNone,
+				Target:      result.Target,
+				PrimaryURL:  misconfiguration.PrimaryURL,
+				LayerDigest: misconfiguration.Layer.Digest,
+			}
+			if severity, ok := severities[misconfiguration.Severity]; ok {
+				item.Severity = severity
+			}
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trivy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-sigma/sigma/pkg/types/enums"
+)
+
+const trivySecretReport = `{
+  "SchemaVersion": 2,
+  "ArtifactName": "127.0.0.1:3000/library/app@sha256:2776ee23722eaabcffed77dafd22b7a1da734971bf268a323b6819926dfe1ebd",
+  "Results": [
+    {
+      "Target": "/app/.env",
+      "Class": "secret",
+      "Secrets": [
+        {
+          "RuleID": "aws-access-key-id",
+          "Category": "AWS",
+          "Severity": "CRITICAL",
+          "Title": "AWS Access Key ID",
+          "StartLine": 1,
+          "EndLine": 1,
+          "Match": "AWS_ACCESS_KEY_ID=********************",
+          "Layer": {"Digest": "sha256:96526aa774ef0126ad0fe9e9a95764c5fc37f409ab9e97021e7b4775d82bf6fa"}
+        }
+      ]
+    }
+  ]
+}`
+
+const trivyMisconfigurationReport = `{
+  "SchemaVersion": 2,
+  "Results": [
+    {
+      "Target": "Dockerfile",
+      "Class": "config",
+      "Type": "dockerfile",
+      "Misconfigurations": [
+        {
+          "Type": "Dockerfile Security Check",
+          "ID": "DS002",
+          "Title": "Image user should not be 'root'",
+          "Message": "Specify at least 1 USER command in Dockerfile with non-root user as argument",
+          "Resolution": "Add 'USER <non root user name>' line to the Dockerfile",
+          "Severity": "HIGH",
+          "PrimaryURL": "https://avd.aquasec.com/misconfig/ds002",
+          "Status": "FAIL"
+        },
+        {
+          "Type": "Dockerfile Security Check",
+          "ID": "DS001",
+          "Title": "':latest' tag used",
+          "Severity": "MEDIUM",
+          "Status": "PASS"
+        }
+      ]
+    }
+  ]
+}`
+
+func TestParseSecrets(t *testing.T) {
+	items, err := ParseSecrets([]byte(trivySecretReport))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(items))
+	assert.Equal(t, "aws-access-key-id", items[0].RuleID)
+	assert.Equal(t, "AWS", items[0].Category)
+	assert.Equal(t, "/app/.env", items[0].Target)
+	assert.Equal(t, enums.VulnerabilitySeverityCritical, items[0].Severity)
+	assert.Equal(t, "sha256:96526aa774ef0126ad0fe9e9a95764c5fc37f409ab9e97021e7b4775d82bf6fa", items[0].LayerDigest)
+
+	_, err = ParseSecrets([]byte("invalid"))
+	assert.Error(t, err)
+}
+
+func TestParseMisconfigurations(t *testing.T) {
+	items, err := ParseMisconfigurations([]byte(trivyMisconfigurationReport))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(items))
+	assert.Equal(t, "DS002", items[0].ID)
+	assert.Equal(t, "Dockerfile", items[0].Target)
+	assert.Equal(t, enums.VulnerabilitySeverityHigh, items[0].Severity)
+	assert.Equal(t, "https://avd.aquasec.com/misconfig/ds002", items[0].PrimaryURL)
+
+	_, err = ParseMisconfigurations([]byte("invalid"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,272 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/signing/definition"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+const (
+	// MediaTypeInToto the media type of the unsigned in-toto statement layer, e.g. the buildkit attestations
+	MediaTypeInToto = "application/vnd.in-toto+json"
+	// MediaTypeDSSE the media type of the dsse envelope layer, e.g. the cosign attestations
+	MediaTypeDSSE = "application/vnd.dsse.envelope.v1+json"
+	// annotationReferenceType the buildkit annotation of the attestation manifest in the image index
+	annotationReferenceType = "vnd.docker.reference.type"
+	// annotationReferenceDigest the buildkit annotation of the attested image manifest digest
+	annotationReferenceDigest = "vnd.docker.reference.digest"
+	// maxAttestationLayerSize the max size of the attestation layer, the provenance may be large
+	maxAttestationLayerSize = 4 << 20
+)
+
+// Attestation is the in-toto attestation attached to the artifact
+type Attestation struct {
+	// Digest the digest of the attestation manifest
+	Digest string
+	// MediaType the media type of the attestation layer
+	MediaType string
+	// SubjectDigest the digest of the attested manifest
+	SubjectDigest string
+	// Statement the in-toto statement
+	Statement []byte
+	// Envelope the dsse envelope of the statement, nil if the statement is not signed
+	Envelope *definition.Envelope
+}
+
+// ListAttestations lists the in-toto attestations of the artifact, the attestation referrers, the cosign
+// attestation tag (e.g. sha256-<hex>.att) and the buildkit attestation manifests in the image index are supported.
+func ListAttestations(ctx context.Context, artifactService dao.ArtifactService, tagService dao.TagService, artifactObj *models.Artifact) ([]Attestation, error) {
+	type attestationObj struct {
+		artifact      *models.Artifact
+		subjectDigest string
+	}
+	var attestationObjs []attestationObj
+
+	referrers, err := artifactService.GetReferrers(ctx, artifactObj.RepositoryID, artifactObj.Digest, nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	for _, referrer := range referrers {
+		attestationObjs = append(attestationObjs, attestationObj{artifact: referrer, subjectDigest: artifactObj.Digest})
+	}
+
+	dgest, err := digest.Parse(artifactObj.Digest)
+	if err != nil {
+		return nil, err
+	}
+	tagObj, err := tagService.GetByName(ctx, artifactObj.RepositoryID, fmt.Sprintf("%s-%s.att", dgest.Algorithm(), dgest.Hex()))
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if tagObj != nil && tagObj.Artifact != nil {
+		attestationObjs = append(attestationObjs, attestationObj{artifact: tagObj.Artifact, subjectDigest: artifactObj.Digest})
+	}
+
+	if artifactObj.ContentType == imgspecv1.MediaTypeImageIndex {
+		var index imgspecv1.Index
+		err = json.Unmarshal(artifactObj.Raw, &index)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal image index failed: %w", err)
+		}
+		for _, manifest := range index.Manifests {
+			if manifest.Annotations[annotationReferenceType] != "attestation-manifest" {
+				continue
+			}
+			manifestObj, err := artifactService.GetByDigest(ctx, artifactObj.RepositoryID, manifest.Digest.String())
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					continue
+				}
+				return nil, err
+			}
+			attestationObjs = append(attestationObjs, attestationObj{artifact: manifestObj, subjectDigest: manifest.Annotations[annotationReferenceDigest]})
+		}
+	}
+
+	var attestations []Attestation
+	for _, obj := range attestationObjs {
+		var manifest imgspecv1.Manifest
+		err = json.Unmarshal(obj.artifact.Raw, &manifest)
+		if err != nil {
+			log.Warn().Err(err).Str("digest", obj.artifact.Digest).Msg("Unmarshal attestation manifest failed")
+			continue
+		}
+		for _, layer := range manifest.Layers {
+			if layer.MediaType != MediaTypeInToto && layer.MediaType != MediaTypeDSSE {
+				continue
+			}
+			if layer.Size > maxAttestationLayerSize {
+				log.Warn().Str("digest", obj.artifact.Digest).Int64("size", layer.Size).Msg("Attestation layer is too large")
+				continue
+			}
+			content, err := readLayer(ctx, layer.Digest, maxAttestationLayerSize)
+			if err != nil {
+				return nil, err
+			}
+			var attestation = Attestation{Digest: obj.artifact.Digest, MediaType: layer.MediaType, SubjectDigest: obj.subjectDigest, Statement: content}
+			if layer.MediaType == MediaTypeDSSE {
+				envelope, err := parseEnvelope(content)
+				if err != nil {
+					log.Warn().Err(err).Str("digest", obj.artifact.Digest).Msg("Parse attestation envelope failed")
+					continue
+				}
+				envelope.Certificate = []byte(layer.Annotations["dev.sigstore.cosign/certificate"])
+				envelope.Chain = []byte(layer.Annotations["dev.sigstore.cosign/chain"])
+				attestation.Statement = envelope.Payload
+				attestation.Envelope = envelope
+			}
+			attestations = append(attestations, attestation)
+		}
+	}
+	return attestations, nil
+}
+
+// parseEnvelope parses the dsse envelope, the payload is decoded
+func parseEnvelope(content []byte) (*definition.Envelope, error) {
+	var envelope struct {
+		PayloadType string `json:"payloadType"`
+		Payload     string `json:"payload"`
+		Signatures  []struct {
+			KeyID string `json:"keyid"`
+			Sig   string `json:"sig"`
+		} `json:"signatures"`
+	}
+	err := json.Unmarshal(content, &envelope)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal envelope failed: %w", err)
+	}
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("decode envelope payload failed: %w", err)
+	}
+	var result = &definition.Envelope{PayloadType: envelope.PayloadType, Payload: payload}
+	for _, signature := range envelope.Signatures {
+		result.Signatures = append(result.Signatures, signature.Sig)
+	}
+	return result, nil
+}
+
+// statement the in-toto statement, the predicate of slsa provenance v0.2 and v1 are recognized
+type statement struct {
+	PredicateType string `json:"predicateType"`
+	Subject       []struct {
+		Name   string            `json:"name"`
+		Digest map[string]string `json:"digest"`
+	} `json:"subject"`
+	Predicate json.RawMessage `json:"predicate"`
+}
+
+// provenance the fields of slsa provenance v0.2 and v1 predicate
+type provenance struct {
+	// v0.2
+	Builder struct {
+		ID string `json:"id"`
+	} `json:"builder"`
+	BuildType string                              `json:"buildType"`
+	Materials []types.ArtifactAttestationMaterial `json:"materials"`
+	// v1
+	BuildDefinition struct {
+		BuildType            string                              `json:"buildType"`
+		ResolvedDependencies []types.ArtifactAttestationMaterial `json:"resolvedDependencies"`
+	} `json:"buildDefinition"`
+	RunDetails struct {
+		Builder struct {
+			ID string `json:"id"`
+		} `json:"builder"`
+	} `json:"runDetails"`
+}
+
+// InspectAttestation decodes the in-toto statement of the attestation, the signed attestation is verified with the verifier,
+// the attestation is verified only if any of the statement subjects is the attested manifest.
+func InspectAttestation(attestation Attestation, verifier definition.EnvelopeVerifying) (types.ArtifactAttestationItem, error) {
+	var item = types.ArtifactAttestationItem{
+		Digest:        attestation.Digest,
+		MediaType:     attestation.MediaType,
+		SubjectDigest: attestation.SubjectDigest,
+		Subjects:      []types.ArtifactAttestationSubject{},
+		Materials:     []types.ArtifactAttestationMaterial{},
+		Signed:        attestation.Envelope != nil,
+	}
+	var stmt statement
+	err := json.Unmarshal(attestation.Statement, &stmt)
+	if err != nil {
+		return item, fmt.Errorf("unmarshal in-toto statement failed: %w", err)
+	}
+	item.PredicateType = stmt.PredicateType
+	var subjected bool
+	for _, subject := range stmt.Subject {
+		item.Subjects = append(item.Subjects, types.ArtifactAttestationSubject{Name: subject.Name, Digest: subject.Digest})
+		dgest, err := digest.Parse(attestation.SubjectDigest)
+		if err == nil && subject.Digest[dgest.Algorithm().String()] == dgest.Hex() {
+			subjected = true
+		}
+	}
+	if len(stmt.Predicate) > 0 {
+		err = json.Unmarshal(stmt.Predicate, &item.Predicate)
+		if err != nil {
+			return item, fmt.Errorf("unmarshal in-toto predicate failed: %w", err)
+		}
+	}
+	if strings.HasPrefix(stmt.PredicateType, "https://slsa.dev/provenance/") && len(stmt.Predicate) > 0 {
+		var predicate provenance
+		err = json.Unmarshal(stmt.Predicate, &predicate)
+		if err != nil {
+			return item, fmt.Errorf("unmarshal slsa provenance failed: %w", err)
+		}
+		if predicate.RunDetails.Builder.ID != "" {
+			item.BuilderID = ptr.Of(predicate.RunDetails.Builder.ID)
+		} else if predicate.Builder.ID != "" {
+			item.BuilderID = ptr.Of(predicate.Builder.ID)
+		}
+		if predicate.BuildDefinition.BuildType != "" {
+			item.BuildType = ptr.Of(predicate.BuildDefinition.BuildType)
+		} else if predicate.BuildType != "" {
+			item.BuildType = ptr.Of(predicate.BuildType)
+		}
+		item.Materials = append(item.Materials, predicate.Materials...)
+		item.Materials = append(item.Materials, predicate.BuildDefinition.ResolvedDependencies...)
+	}
+
+	if attestation.Envelope == nil {
+		return item, nil
+	}
+	if !subjected {
+		item.VerifyMessage = ptr.Of(fmt.Sprintf("attestation is not attested for %s", attestation.SubjectDigest))
+		return item, nil
+	}
+	err = verifier.VerifyEnvelope(ptr.To(attestation.Envelope))
+	if err != nil {
+		item.VerifyMessage = ptr.Of(err.Error())
+		return item, nil
+	}
+	item.Verified = true
+	return item, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	cosignverify "github.com/go-sigma/sigma/pkg/signing/cosign/verify"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+const attestedDigest = "sha256:2776ee23722eaabcffed77dafd22b7a1da734971bf268a323b6819926dfe1ebd"
+
+func provenanceStatement(hex string) []byte {
+	return []byte(fmt.Sprintf(`{"_type":"https://in-toto.io/Statement/v0.1","predicateType":"https://slsa.dev/provenance/v0.2",`+
+		`"subject":[{"name":"127.0.0.1:3000/library/busybox","digest":{"sha256":"%s"}}],`+
+		`"predicate":{"builder":{"id":"https://github.com/actions/runner"},"buildType":"https://mobyproject.org/buildkit@v1",`+
+		`"materials":[{"uri":"pkg:docker/alpine@3.19","digest":{"sha256":"4bcff63911fcb4448bd4fdacec207030997caf25e9bea4045fa6c8c44de311d1"}}]}}`, hex))
+}
+
+func signEnvelope(t *testing.T, key *ecdsa.PrivateKey, payload []byte) []byte {
+	const payloadType = "application/vnd.in-toto+json"
+	hashed := sha256.Sum256([]byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload)))
+	sig, err := ecdsa.SignASN1(rand.Reader, key, hashed[:])
+	assert.NoError(t, err)
+	envelope, err := json.Marshal(map[string]any{
+		"payloadType": payloadType,
+		"payload":     base64.StdEncoding.EncodeToString(payload),
+		"signatures":  []map[string]string{{"keyid": "", "sig": base64.StdEncoding.EncodeToString(sig)}},
+	})
+	assert.NoError(t, err)
+	return envelope
+}
+
+func TestInspectAttestation(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	assert.NoError(t, err)
+	verifier, err := cosignverify.NewEnvelope(cosignverify.Options{PublicKeys: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))})
+	assert.NoError(t, err)
+
+	const hex = "2776ee23722eaabcffed77dafd22b7a1da734971bf268a323b6819926dfe1ebd"
+	envelope, err := parseEnvelope(signEnvelope(t, key, provenanceStatement(hex)))
+	assert.NoError(t, err)
+
+	item, err := InspectAttestation(Attestation{MediaType: MediaTypeDSSE, SubjectDigest: attestedDigest, Statement: envelope.Payload, Envelope: envelope}, verifier)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://slsa.dev/provenance/v0.2", item.PredicateType)
+	assert.Equal(t, ptr.Of("https://github.com/actions/runner"), item.BuilderID)
+	assert.Equal(t, ptr.Of("https://mobyproject.org/buildkit@v1"), item.BuildType)
+	assert.Len(t, item.Materials, 1)
+	assert.Equal(t, "pkg:docker/alpine@3.19", item.Materials[0].URI)
+	assert.True(t, item.Signed)
+	assert.True(t, item.Verified)
+
+	// the attestation of other image is not verified for the attested digest
+	envelope, err = parseEnvelope(signEnvelope(t, key, provenanceStatement("4bcff63911fcb4448bd4fdacec207030997caf25e9bea4045fa6c8c44de311d1")))
+	assert.NoError(t, err)
+	item, err = InspectAttestation(Attestation{MediaType: MediaTypeDSSE, SubjectDigest: attestedDigest, Statement: envelope.Payload, Envelope: envelope}, verifier)
+	assert.NoError(t, err)
+	assert.True(t, item.Signed)
+	assert.False(t, item.Verified)
+	assert.NotNil(t, item.VerifyMessage)
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	envelope, err = parseEnvelope(signEnvelope(t, otherKey, provenanceStatement(hex)))
+	assert.NoError(t, err)
+	item, err = InspectAttestation(Attestation{MediaType: MediaTypeDSSE, SubjectDigest: attestedDigest, Statement: envelope.Payload, Envelope: envelope}, verifier)
+	assert.NoError(t, err)
+	assert.False(t, item.Verified)
+
+	// the buildkit attestation is not signed
+	v1 := []byte(fmt.Sprintf(`{"_type":"https://in-toto.io/Statement/v1","predicateType":"https://slsa.dev/provenance/v1",`+
+		`"subject":[{"name":"busybox","digest":{"sha256":"%s"}}],"predicate":{"buildDefinition":{"buildType":"https://mobyproject.org/buildkit@v1",`+
+		`"resolvedDependencies":[{"uri":"pkg:docker/alpine@3.19"}]},"runDetails":{"builder":{"id":"https://github.com/go-sigma/sigma"}}}}`, hex))
+	item, err = InspectAttestation(Attestation{MediaType: MediaTypeInToto, SubjectDigest: attestedDigest, Statement: v1}, verifier)
+	assert.NoError(t, err)
+	assert.Equal(t, ptr.Of("https://github.com/go-sigma/sigma"), item.BuilderID)
+	assert.Len(t, item.Materials, 1)
+	assert.False(t, item.Signed)
+	assert.False(t, item.Verified)
+	assert.Nil(t, item.VerifyMessage)
+
+	_, err = InspectAttestation(Attestation{MediaType: MediaTypeInToto, Statement: []byte("invalid")}, verifier)
+	assert.Error(t, err)
+	_, err = parseEnvelope([]byte(`{"payload":"invalid base64"}`))
+	assert.Error(t, err)
+}
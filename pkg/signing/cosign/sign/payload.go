@@ -0,0 +1,93 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sign
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+)
+
+const (
+	// MediaTypeSimpleSigning the media type of the cosign signature layer
+	MediaTypeSimpleSigning = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// ArtifactTypeSignature the artifact type of the cosign signature referrer
+	ArtifactTypeSignature = "application/vnd.dev.cosign.artifact.sig.v1+json"
+	// AnnotationSignature the annotation of the signature in the cosign signature layer
+	AnnotationSignature = "dev.cosignproject.cosign/signature"
+)
+
+// simpleSigning the cosign simple signing payload
+type simpleSigning struct {
+	Critical struct {
+		Identity struct {
+			DockerReference string `json:"docker-reference"`
+		} `json:"identity"`
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+	Optional map[string]any `json:"optional"`
+}
+
+// Payload returns the cosign simple signing payload of the image
+func Payload(dockerReference, digest string) ([]byte, error) {
+	var payload simpleSigning
+	payload.Critical.Identity.DockerReference = dockerReference
+	payload.Critical.Image.DockerManifestDigest = digest
+	payload.Critical.Type = "cosign container image signature"
+	return json.Marshal(payload)
+}
+
+// SignPayload signs the payload with the cosign private key which is encrypted with the empty password,
+// returns the signature in base64 encoding.
+func SignPayload(privateKey, payload []byte) (string, error) {
+	signer, err := cosign.LoadPrivateKey(privateKey, []byte{})
+	if err != nil {
+		return "", err
+	}
+	signature, err := signer.SignMessage(bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
+
+// Manifest returns the cosign signature referrer manifest of the subject, the payload is the only layer
+func Manifest(subject imgspecv1.Descriptor, payload []byte, signature string) imgspecv1.Manifest {
+	return imgspecv1.Manifest{
+		Versioned:    specs.Versioned{SchemaVersion: 2},
+		MediaType:    imgspecv1.MediaTypeImageManifest,
+		ArtifactType: ArtifactTypeSignature,
+		Config:       imgspecv1.DescriptorEmptyJSON,
+		Layers: []imgspecv1.Descriptor{
+			{
+				MediaType:   MediaTypeSimpleSigning,
+				Digest:      digest.FromBytes(payload),
+				Size:        int64(len(payload)),
+				Annotations: map[string]string{AnnotationSignature: signature},
+			},
+		},
+		Subject:     &subject,
+		Annotations: map[string]string{imgspecv1.AnnotationCreated: time.Now().UTC().Format(time.RFC3339)},
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sign
+
+import (
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-sigma/sigma/pkg/signing/cosign/verify"
+	"github.com/go-sigma/sigma/pkg/signing/definition"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+)
+
+func TestSignPayload(t *testing.T) {
+	const dgest = "sha256:2776ee23722eaabcffed77dafd22b7a1da734971bf268a323b6819926dfe1ebd"
+
+	keys, err := cosign.GenerateKeyPair(nil)
+	assert.NoError(t, err)
+
+	payload, err := Payload("127.0.0.1:3000/library/busybox", dgest)
+	assert.NoError(t, err)
+	signature, err := SignPayload(keys.PrivateBytes, payload)
+	assert.NoError(t, err)
+
+	verifier, err := verify.New(verify.Options{PublicKeys: string(keys.PublicBytes)})
+	assert.NoError(t, err)
+	assert.NoError(t, verifier.Verify(dgest, []definition.Signature{{Type: enums.SigningTypeCosign, Payload: payload, Signature: signature}}))
+
+	manifest := Manifest(imgspecv1.Descriptor{MediaType: imgspecv1.MediaTypeImageManifest, Digest: digest.Digest(dgest), Size: 1024}, payload, signature)
+	assert.Equal(t, ArtifactTypeSignature, manifest.ArtifactType)
+	assert.Equal(t, digest.FromBytes(payload), manifest.Layers[0].Digest)
+	assert.Equal(t, signature, manifest.Layers[0].Annotations[AnnotationSignature])
+	assert.Equal(t, dgest, manifest.Subject.Digest.String())
+
+	_, err = SignPayload([]byte("invalid"), payload)
+	assert.Error(t, err)
+}
@@ -16,20 +16,14 @@ package sign
 
 import (
 	"context"
-	"fmt"
 	"os"
 	"os/exec"
 
-	"github.com/aquasecurity/trivy/pkg/digest"
 	"github.com/rs/zerolog/log"
 
-	"github.com/go-sigma/sigma/pkg/configs"
 	"github.com/go-sigma/sigma/pkg/consts"
-	"github.com/go-sigma/sigma/pkg/handlers/distribution/clients"
 	"github.com/go-sigma/sigma/pkg/signing/definition"
-	"github.com/go-sigma/sigma/pkg/utils"
-	"github.com/go-sigma/sigma/pkg/utils/hash"
-	"github.com/go-sigma/sigma/pkg/utils/imagerefs"
+	"github.com/go-sigma/sigma/pkg/signing/reference"
 )
 
 type signing struct {
@@ -47,7 +41,7 @@ func New(http, multiArch bool) definition.Signing {
 
 // Sign ...
 func (s *signing) Sign(ctx context.Context, token, priKey, ref string) error {
-	imageRef, err := s.GetImageRef(ctx, token, ref)
+	imageRef, err := reference.Resolve(ctx, s.Http, token, ref)
 	if err != nil {
 		return err
 	}
@@ -89,37 +83,3 @@ func (s *signing) Sign(ctx context.Context, token, priKey, ref string) error {
 
 	return cmd.Run()
 }
-
-// GetDigest ...
-func (s *signing) GetImageRef(ctx context.Context, token, ref string) (string, error) {
-	domain, _, repo, tag, err := imagerefs.Parse(ref)
-	if err != nil {
-		return "", err
-	}
-	if s.Http {
-		domain = fmt.Sprintf("http://%s", domain)
-	} else {
-		domain = fmt.Sprintf("https://%s", domain)
-	}
-	clientsFactory := clients.NewClientsFactory()
-	client, err := clientsFactory.New(configs.Configuration{
-		Proxy: configs.ConfigurationProxy{
-			Endpoint:  domain,
-			TlsVerify: !s.Http,
-			Token:     token,
-		},
-	})
-	if err != nil {
-		return "", err
-	}
-	manifest, _, err := client.GetManifest(ctx, repo, tag)
-	if err != nil {
-		return "", err
-	}
-	_, manifestBytes, err := manifest.Payload()
-	if err != nil {
-		return "", err
-	}
-	d := digest.NewDigestFromString(digest.SHA256, hash.MustString(string(manifestBytes)))
-	return fmt.Sprintf("%s/%s@%s", utils.TrimHTTP(domain), repo, d.String()), nil
-}
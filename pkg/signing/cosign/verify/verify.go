@@ -13,3 +13,297 @@
 // limitations under the License.
 
 package verify
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/go-sigma/sigma/pkg/signing/definition"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+)
+
+var (
+	// oidIssuer the deprecated fulcio oidc issuer extension, the value is the raw string
+	oidIssuer = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	// oidIssuerV2 the fulcio oidc issuer extension, the value is the der encoded utf8 string
+	oidIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+// Options the trusted materials of the verifier
+type Options struct {
+	// PublicKeys the trusted public keys in pem format
+	PublicKeys string
+	// Identities the trusted keyless identities
+	Identities []types.SignatureIdentity
+	// Roots the trusted root certificates in pem format, it's required if the identities is not empty
+	Roots string
+}
+
+type identity struct {
+	issuer  *regexp.Regexp
+	subject *regexp.Regexp
+}
+
+type verifying struct {
+	publicKeys []crypto.PublicKey
+	identities []identity
+	roots      *x509.CertPool
+}
+
+// New creates a cosign signature verifier, returns error if the trusted materials are invalid
+func New(opt Options) (definition.Verifying, error) {
+	v, err := newVerifying(opt)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// NewEnvelope creates a cosign attestation envelope verifier, returns error if the trusted materials are invalid
+func NewEnvelope(opt Options) (definition.EnvelopeVerifying, error) {
+	v, err := newVerifying(opt)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// newVerifying parses the trusted materials of the verifier
+func newVerifying(opt Options) (*verifying, error) {
+	v := &verifying{}
+	rest := []byte(opt.PublicKeys)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		var publicKey crypto.PublicKey
+		var err error
+		switch block.Type {
+		case "PUBLIC KEY":
+			publicKey, err = x509.ParsePKIXPublicKey(block.Bytes)
+		case "RSA PUBLIC KEY":
+			publicKey, err = x509.ParsePKCS1PublicKey(block.Bytes)
+		default:
+			err = fmt.Errorf("unsupported pem block type: %s", block.Type)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse public key failed: %w", err)
+		}
+		v.publicKeys = append(v.publicKeys, publicKey)
+	}
+	if len(v.publicKeys) == 0 && len(opt.PublicKeys) > 0 {
+		return nil, errors.New("no public key found in pem")
+	}
+	if len(opt.Roots) > 0 {
+		v.roots = x509.NewCertPool()
+		if !v.roots.AppendCertsFromPEM([]byte(opt.Roots)) {
+			return nil, errors.New("no root certificate found in pem")
+		}
+	}
+	if len(opt.Identities) > 0 && v.roots == nil {
+		return nil, errors.New("root certificates are required to verify the keyless identities")
+	}
+	for _, item := range opt.Identities {
+		issuer, err := regexp.Compile("^(?:" + item.Issuer + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("compile issuer(%s) failed: %w", item.Issuer, err)
+		}
+		subject, err := regexp.Compile("^(?:" + item.Subject + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("compile subject(%s) failed: %w", item.Subject, err)
+		}
+		v.identities = append(v.identities, identity{issuer: issuer, subject: subject})
+	}
+	return v, nil
+}
+
+// Verify verifies the signatures of the artifact with digest, returns nil if any of the signatures is trusted
+func (v *verifying) Verify(digest string, signatures []definition.Signature) error {
+	var errs []error
+	for _, signature := range signatures {
+		if signature.Type == enums.SigningTypeNotation {
+			continue
+		}
+		err := v.verify(digest, signature)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return errors.New("no cosign signature found")
+	}
+	return fmt.Errorf("no trusted cosign signature found: %w", errors.Join(errs...))
+}
+
+// verify verifies a single signature
+func (v *verifying) verify(digest string, signature definition.Signature) error {
+	var payload struct {
+		Critical struct {
+			Image struct {
+				DockerManifestDigest string `json:"docker-manifest-digest"`
+			} `json:"image"`
+		} `json:"critical"`
+	}
+	err := json.Unmarshal(signature.Payload, &payload)
+	if err != nil {
+		return fmt.Errorf("unmarshal signature payload failed: %w", err)
+	}
+	if payload.Critical.Image.DockerManifestDigest != digest {
+		return fmt.Errorf("signature is signed for %s", payload.Critical.Image.DockerManifestDigest)
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature.Signature)
+	if err != nil {
+		return fmt.Errorf("decode signature failed: %w", err)
+	}
+
+	if len(signature.Certificate) > 0 {
+		return v.verifyKeyless(signature, sig)
+	}
+	return v.verifyPublicKeys(signature.Payload, sig)
+}
+
+// VerifyEnvelope verifies the signatures of the dsse envelope, returns nil if any of the signatures is trusted
+func (v *verifying) VerifyEnvelope(envelope definition.Envelope) error {
+	if len(envelope.Signatures) == 0 {
+		return errors.New("no signature found in envelope")
+	}
+	message := pae(envelope.PayloadType, envelope.Payload)
+	var errs []error
+	for _, signature := range envelope.Signatures {
+		sig, err := base64.StdEncoding.DecodeString(signature)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("decode signature failed: %w", err))
+			continue
+		}
+		if len(envelope.Certificate) > 0 {
+			err = v.verifyKeyless(definition.Signature{Payload: message, Certificate: envelope.Certificate, Chain: envelope.Chain}, sig)
+		} else {
+			err = v.verifyPublicKeys(message, sig)
+		}
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+	return fmt.Errorf("no trusted envelope signature found: %w", errors.Join(errs...))
+}
+
+// verifyPublicKeys verifies the signature of the payload with the trusted public keys
+func (v *verifying) verifyPublicKeys(payload, sig []byte) error {
+	for _, publicKey := range v.publicKeys {
+		if verifySignature(publicKey, payload, sig) == nil {
+			return nil
+		}
+	}
+	return errors.New("signature is not signed by the trusted public keys")
+}
+
+// pae returns the dsse pre-authentication encoding of the payload, which is the signed message of the envelope
+func pae(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload))
+}
+
+// verifyKeyless verifies the keyless signature with the signing certificate
+func (v *verifying) verifyKeyless(signature definition.Signature, sig []byte) error {
+	if len(v.identities) == 0 {
+		return errors.New("keyless signature is not trusted")
+	}
+	block, _ := pem.Decode(signature.Certificate)
+	if block == nil {
+		return errors.New("decode signing certificate failed")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("parse signing certificate failed: %w", err)
+	}
+	intermediates := x509.NewCertPool()
+	if len(signature.Chain) > 0 {
+		intermediates.AppendCertsFromPEM(signature.Chain)
+	}
+	// the keyless signing certificate is short-lived, so it's verified at the time it's issued
+	_, err = cert.Verify(x509.VerifyOptions{
+		Roots:         v.roots,
+		Intermediates: intermediates,
+		CurrentTime:   cert.NotBefore,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	})
+	if err != nil {
+		return fmt.Errorf("verify signing certificate failed: %w", err)
+	}
+	err = verifySignature(cert.PublicKey, signature.Payload, sig)
+	if err != nil {
+		return err
+	}
+	issuer := CertificateIssuer(cert)
+	var subjects []string
+	subjects = append(subjects, cert.EmailAddresses...)
+	for _, uri := range cert.URIs {
+		subjects = append(subjects, uri.String())
+	}
+	for _, item := range v.identities {
+		if !item.issuer.MatchString(issuer) {
+			continue
+		}
+		for _, subject := range subjects {
+			if item.subject.MatchString(subject) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("keyless identity(%s, %v) is not trusted", issuer, subjects)
+}
+
+// CertificateIssuer returns the oidc issuer recorded in the fulcio certificate extensions
+func CertificateIssuer(cert *x509.Certificate) string {
+	var issuer string
+	for _, ext := range cert.Extensions {
+		switch {
+		case ext.Id.Equal(oidIssuerV2):
+			var value string
+			_, err := asn1.UnmarshalWithParams(ext.Value, &value, "utf8")
+			if err == nil {
+				return value
+			}
+		case ext.Id.Equal(oidIssuer):
+			issuer = string(ext.Value)
+		}
+	}
+	return issuer
+}
+
+// verifySignature verifies the signature of the payload with the public key
+func verifySignature(publicKey crypto.PublicKey, payload, sig []byte) error {
+	hashed := sha256.Sum256(payload)
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		if ecdsa.VerifyASN1(key, hashed[:], sig) {
+			return nil
+		}
+	case *rsa.PublicKey:
+		if rsa.VerifyPKCS1v15(key, crypto.SHA256, hashed[:], sig) == nil {
+			return nil
+		}
+	case ed25519.PublicKey:
+		if ed25519.Verify(key, payload, sig) {
+			return nil
+		}
+	default:
+		return fmt.Errorf("unsupported public key type: %T", publicKey)
+	}
+	return errors.New("invalid signature")
+}
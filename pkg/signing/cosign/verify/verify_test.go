@@ -0,0 +1,171 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-sigma/sigma/pkg/signing/definition"
+	"github.com/go-sigma/sigma/pkg/types"
+)
+
+const digest = "sha256:2776ee23722eaabcffed77dafd22b7a1da734971bf268a323b6819926dfe1ebd"
+
+func payload(digest string) []byte {
+	return []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"127.0.0.1:3000/library/busybox"},"image":{"docker-manifest-digest":"%s"},"type":"cosign container image signature"},"optional":null}`, digest))
+}
+
+func sign(t *testing.T, key *ecdsa.PrivateKey, payload []byte) string {
+	hashed := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, hashed[:])
+	assert.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(sig)
+}
+
+func publicKeyPem(t *testing.T, key *ecdsa.PrivateKey) string {
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	assert.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func TestVerifyPublicKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	verifier, err := New(Options{PublicKeys: publicKeyPem(t, otherKey) + publicKeyPem(t, key)})
+	assert.NoError(t, err)
+
+	assert.NoError(t, verifier.Verify(digest, []definition.Signature{{Payload: payload(digest), Signature: sign(t, key, payload(digest))}}))
+	assert.Error(t, verifier.Verify(digest, nil))
+
+	untrustedKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	assert.Error(t, verifier.Verify(digest, []definition.Signature{{Payload: payload(digest), Signature: sign(t, untrustedKey, payload(digest))}}))
+
+	otherDigest := "sha256:96526aa774ef0126ad0fe9e9a95764c5fc37f409ab9e97021e7b4775d82bf6fa"
+	assert.Error(t, verifier.Verify(digest, []definition.Signature{{Payload: payload(otherDigest), Signature: sign(t, key, payload(otherDigest))}}))
+
+	_, err = New(Options{PublicKeys: "invalid"})
+	assert.Error(t, err)
+	_, err = New(Options{Identities: []types.SignatureIdentity{{Issuer: ".*", Subject: ".*"}}})
+	assert.Error(t, err)
+}
+
+func TestVerifyKeyless(t *testing.T) {
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	rootTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "sigstore"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour * 24),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	rootDer, err := x509.CreateCertificate(rand.Reader, rootTemplate, rootTemplate, &rootKey.PublicKey, rootKey)
+	assert.NoError(t, err)
+	rootCert, err := x509.ParseCertificate(rootDer)
+	assert.NoError(t, err)
+
+	issuer, err := asn1.MarshalWithParams("https://token.actions.githubusercontent.com", "utf8")
+	assert.NoError(t, err)
+	subject, err := url.Parse("https://github.com/go-sigma/sigma/.github/workflows/release.yml@refs/heads/main")
+	assert.NoError(t, err)
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	leafTemplate := &x509.Certificate{
+		SerialNumber:    big.NewInt(2),
+		NotBefore:       time.Now().Add(-time.Minute * 30),
+		NotAfter:        time.Now().Add(-time.Minute * 20),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		URIs:            []*url.URL{subject},
+		ExtraExtensions: []pkix.Extension{{Id: oidIssuerV2, Value: issuer}},
+	}
+	leafDer, err := x509.CreateCertificate(rand.Reader, leafTemplate, rootCert, &leafKey.PublicKey, rootKey)
+	assert.NoError(t, err)
+
+	roots := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rootDer}))
+	signature := definition.Signature{
+		Payload:     payload(digest),
+		Signature:   sign(t, leafKey, payload(digest)),
+		Certificate: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDer}),
+	}
+
+	verifier, err := New(Options{Roots: roots, Identities: []types.SignatureIdentity{
+		{Issuer: "https://token.actions.githubusercontent.com", Subject: "https://github.com/go-sigma/.*"},
+	}})
+	assert.NoError(t, err)
+	assert.NoError(t, verifier.Verify(digest, []definition.Signature{signature}))
+
+	verifier, err = New(Options{Roots: roots, Identities: []types.SignatureIdentity{
+		{Issuer: "https://token.actions.githubusercontent.com", Subject: "https://github.com/other/.*"},
+	}})
+	assert.NoError(t, err)
+	assert.Error(t, verifier.Verify(digest, []definition.Signature{signature}))
+
+	verifier, err = New(Options{PublicKeys: publicKeyPem(t, leafKey)})
+	assert.NoError(t, err)
+	assert.Error(t, verifier.Verify(digest, []definition.Signature{signature}))
+}
+
+func TestVerifyEnvelope(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	untrustedKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	const payloadType = "application/vnd.in-toto+json"
+	statement := []byte(`{"_type":"https://in-toto.io/Statement/v0.1","predicateType":"https://slsa.dev/provenance/v0.2","subject":[],"predicate":{}}`)
+
+	verifier, err := NewEnvelope(Options{PublicKeys: publicKeyPem(t, key)})
+	assert.NoError(t, err)
+
+	envelope := definition.Envelope{PayloadType: payloadType, Payload: statement, Signatures: []string{sign(t, key, pae(payloadType, statement))}}
+	assert.NoError(t, verifier.VerifyEnvelope(envelope))
+
+	envelope.Signatures = []string{sign(t, untrustedKey, pae(payloadType, statement)), sign(t, key, pae(payloadType, statement))}
+	assert.NoError(t, verifier.VerifyEnvelope(envelope))
+
+	envelope.Signatures = []string{sign(t, key, statement)} // the payload is not the pre-authentication encoding
+	assert.Error(t, verifier.VerifyEnvelope(envelope))
+
+	envelope.Signatures = []string{sign(t, untrustedKey, pae(payloadType, statement))}
+	assert.Error(t, verifier.VerifyEnvelope(envelope))
+
+	envelope.Signatures = nil
+	assert.Error(t, verifier.VerifyEnvelope(envelope))
+
+	envelope.Signatures = []string{"invalid base64"}
+	assert.Error(t, verifier.VerifyEnvelope(envelope))
+}
@@ -14,17 +14,61 @@
 
 package definition
 
-import "context"
+import (
+	"context"
+
+	"github.com/go-sigma/sigma/pkg/types/enums"
+)
 
 //go:generate mockgen -destination=mocks/signing.go -package=mocks github.com/go-sigma/sigma/pkg/signing/definition Signing
 //go:generate mockgen -destination=mocks/verifying.go -package=mocks github.com/go-sigma/sigma/pkg/signing/definition Verifying
+//go:generate mockgen -destination=mocks/envelope_verifying.go -package=mocks github.com/go-sigma/sigma/pkg/signing/definition EnvelopeVerifying
 
 // Signing ...
 type Signing interface {
 	Sign(ctx context.Context, token, priKey, ref string) error
 }
 
+// Signature is the signature attached to the artifact
+type Signature struct {
+	// Type the signing type of the signature, e.g. cosign, notation
+	Type enums.SigningType
+	// Digest the digest of the signature manifest
+	Digest string
+	// MediaType the media type of the signature layer, e.g. application/jose+json
+	MediaType string
+	// Payload the signed payload of cosign signature, or the signature envelope of notation signature
+	Payload []byte
+	// Signature the base64 encoded signature of the payload
+	Signature string
+	// Certificate the pem encoded signing certificate, only the keyless signature contains it
+	Certificate []byte
+	// Chain the pem encoded intermediate certificates of the signing certificate
+	Chain []byte
+}
+
 // Verifying ...
 type Verifying interface {
-	Verify(ref, token string) error
+	// Verify verifies the signatures of the artifact with digest, returns nil if any of the signatures is trusted
+	Verify(digest string, signatures []Signature) error
+}
+
+// Envelope is the dsse envelope of the signed in-toto attestation
+type Envelope struct {
+	// PayloadType the type of the payload, e.g. application/vnd.in-toto+json
+	PayloadType string
+	// Payload the decoded payload of the envelope
+	Payload []byte
+	// Signatures the base64 encoded signatures of the pre-authentication encoding of the payload
+	Signatures []string
+	// Certificate the pem encoded signing certificate, only the keyless attestation contains it
+	Certificate []byte
+	// Chain the pem encoded intermediate certificates of the signing certificate
+	Chain []byte
+}
+
+// EnvelopeVerifying ...
+type EnvelopeVerifying interface {
+	// VerifyEnvelope verifies the signatures of the dsse envelope, returns nil if any of the signatures is trusted
+	VerifyEnvelope(envelope Envelope) error
 }
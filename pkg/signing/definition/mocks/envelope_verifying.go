@@ -0,0 +1,54 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/go-sigma/sigma/pkg/signing/definition (interfaces: EnvelopeVerifying)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/envelope_verifying.go -package=mocks github.com/go-sigma/sigma/pkg/signing/definition EnvelopeVerifying
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	definition "github.com/go-sigma/sigma/pkg/signing/definition"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEnvelopeVerifying is a mock of EnvelopeVerifying interface.
+type MockEnvelopeVerifying struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnvelopeVerifyingMockRecorder
+}
+
+// MockEnvelopeVerifyingMockRecorder is the mock recorder for MockEnvelopeVerifying.
+type MockEnvelopeVerifyingMockRecorder struct {
+	mock *MockEnvelopeVerifying
+}
+
+// NewMockEnvelopeVerifying creates a new mock instance.
+func NewMockEnvelopeVerifying(ctrl *gomock.Controller) *MockEnvelopeVerifying {
+	mock := &MockEnvelopeVerifying{ctrl: ctrl}
+	mock.recorder = &MockEnvelopeVerifyingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnvelopeVerifying) EXPECT() *MockEnvelopeVerifyingMockRecorder {
+	return m.recorder
+}
+
+// VerifyEnvelope mocks base method.
+func (m *MockEnvelopeVerifying) VerifyEnvelope(arg0 definition.Envelope) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEnvelope", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEnvelope indicates an expected call of VerifyEnvelope.
+func (mr *MockEnvelopeVerifyingMockRecorder) VerifyEnvelope(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEnvelope", reflect.TypeOf((*MockEnvelopeVerifying)(nil).VerifyEnvelope), arg0)
+}
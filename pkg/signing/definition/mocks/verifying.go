@@ -12,6 +12,7 @@ package mocks
 import (
 	reflect "reflect"
 
+	definition "github.com/go-sigma/sigma/pkg/signing/definition"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -39,7 +40,7 @@ func (m *MockVerifying) EXPECT() *MockVerifyingMockRecorder {
 }
 
 // Verify mocks base method.
-func (m *MockVerifying) Verify(arg0, arg1 string) error {
+func (m *MockVerifying) Verify(arg0 string, arg1 []definition.Signature) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Verify", arg0, arg1)
 	ret0, _ := ret[0].(error)
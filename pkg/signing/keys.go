@@ -0,0 +1,138 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/secure-systems-lab/go-securesystemslib/encrypted"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/utils/crypt"
+)
+
+// KeyPair the cosign key pair, the private key is encrypted by cosign with the empty password
+type KeyPair struct {
+	PrivateKey  []byte
+	PublicKey   []byte
+	Fingerprint string
+}
+
+// GenerateKeyPair generates a new cosign key pair
+func GenerateKeyPair() (*KeyPair, error) {
+	keys, err := cosign.GenerateKeyPair(nil)
+	if err != nil {
+		return nil, err
+	}
+	fingerprint, err := Fingerprint(keys.PublicBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyPair{PrivateKey: keys.PrivateBytes, PublicKey: keys.PublicBytes, Fingerprint: fingerprint}, nil
+}
+
+// ImportKeyPair imports the private key in pem format, the cosign encrypted private key is decrypted with the password,
+// the pkcs1, pkcs8 and ec private keys are supported too.
+func ImportKeyPair(privateKey, password []byte) (*KeyPair, error) {
+	block, _ := pem.Decode(privateKey)
+	if block == nil {
+		return nil, errors.New("invalid pem block")
+	}
+	var key any
+	var err error
+	switch block.Type {
+	case cosign.CosignPrivateKeyPemType, cosign.SigstorePrivateKeyPemType:
+		var der []byte
+		der, err = encrypted.Decrypt(block.Bytes, password)
+		if err != nil {
+			return nil, fmt.Errorf("decrypt private key failed: %w", err)
+		}
+		key, err = x509.ParsePKCS8PrivateKey(der)
+	case cosign.RSAPrivateKeyPemType:
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case cosign.ECPrivateKeyPemType:
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case cosign.PrivateKeyPemType:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported private key type: %s", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse private key failed: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("unsupported private key")
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(signer)
+	if err != nil {
+		return nil, fmt.Errorf("marshal private key failed: %w", err)
+	}
+	encryptedDer, err := encrypted.Encrypt(der, []byte{})
+	if err != nil {
+		return nil, fmt.Errorf("encrypt private key failed: %w", err)
+	}
+	publicDer, err := x509.MarshalPKIXPublicKey(signer.Public())
+	if err != nil {
+		return nil, fmt.Errorf("marshal public key failed: %w", err)
+	}
+	publicKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDer})
+	fingerprint, err := Fingerprint(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyPair{
+		PrivateKey:  pem.EncodeToMemory(&pem.Block{Type: cosign.SigstorePrivateKeyPemType, Bytes: encryptedDer}),
+		PublicKey:   publicKey,
+		Fingerprint: fingerprint,
+	}, nil
+}
+
+// Fingerprint returns the sha256 fingerprint of the public key in pem format
+func Fingerprint(publicKey []byte) (string, error) {
+	block, _ := pem.Decode(publicKey)
+	if block == nil {
+		return "", errors.New("invalid pem block")
+	}
+	sum := sha256.Sum256(block.Bytes)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// EncryptPrivateKey encrypts the private key of the namespace before it is saved in the database
+func EncryptPrivateKey(namespaceID int64, privateKey []byte) (string, error) {
+	return crypt.Encrypt(privateKeySecret(namespaceID), string(privateKey))
+}
+
+// DecryptPrivateKey decrypts the private key of the namespace saved in the database
+func DecryptPrivateKey(namespaceID int64, ciphertext string) (string, error) {
+	return crypt.Decrypt(privateKeySecret(namespaceID), ciphertext)
+}
+
+// privateKeySecret returns the secret to encrypt the private key of the namespace,
+// the private key must be re-imported if the jwt private key of the server is changed.
+func privateKeySecret(namespaceID int64) string {
+	var secret string
+	if config := configs.GetConfiguration(); config != nil {
+		secret = config.Auth.Jwt.PrivateKey
+	}
+	return fmt.Sprintf("%s-%d", secret, namespaceID)
+}
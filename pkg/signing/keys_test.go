@@ -0,0 +1,74 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateKeyPair(t *testing.T) {
+	keyPair, err := GenerateKeyPair()
+	assert.NoError(t, err)
+	_, err = cosign.LoadPrivateKey(keyPair.PrivateKey, []byte{})
+	assert.NoError(t, err)
+	assert.Len(t, keyPair.Fingerprint, 64)
+
+	imported, err := ImportKeyPair(keyPair.PrivateKey, []byte{})
+	assert.NoError(t, err)
+	assert.Equal(t, keyPair.Fingerprint, imported.Fingerprint)
+}
+
+func TestImportKeyPair(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	keyPair, err := ImportKeyPair(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil)
+	assert.NoError(t, err)
+	_, err = cosign.LoadPrivateKey(keyPair.PrivateKey, []byte{})
+	assert.NoError(t, err)
+
+	passwordKeys, err := cosign.GenerateKeyPair(func(bool) ([]byte, error) { return []byte("password"), nil })
+	assert.NoError(t, err)
+	_, err = ImportKeyPair(passwordKeys.PrivateBytes, []byte("password"))
+	assert.NoError(t, err)
+	_, err = ImportKeyPair(passwordKeys.PrivateBytes, []byte("wrong"))
+	assert.Error(t, err)
+
+	_, err = ImportKeyPair([]byte("invalid"), nil)
+	assert.Error(t, err)
+	_, err = ImportKeyPair(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil)
+	assert.Error(t, err)
+}
+
+func TestEncryptPrivateKey(t *testing.T) {
+	ciphertext, err := EncryptPrivateKey(1, []byte("private-key"))
+	assert.NoError(t, err)
+	plaintext, err := DecryptPrivateKey(1, ciphertext)
+	assert.NoError(t, err)
+	assert.Equal(t, "private-key", plaintext)
+	plaintext, err = DecryptPrivateKey(2, ciphertext)
+	assert.NoError(t, err)
+	assert.NotEqual(t, "private-key", plaintext)
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sign
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/signing/definition"
+	"github.com/go-sigma/sigma/pkg/signing/reference"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/imagerefs"
+)
+
+type signing struct {
+	Http        bool
+	Certificate string
+}
+
+// New ...
+func New(http bool, certificate string) definition.Signing {
+	return &signing{
+		Http:        http,
+		Certificate: certificate,
+	}
+}
+
+// Sign signs the image with notation, the signature is pushed as the referrer of the image.
+// The private key and the certificate should be in pem format.
+func (s *signing) Sign(ctx context.Context, token, priKey, ref string) error {
+	imageRef, err := reference.Resolve(ctx, s.Http, token, ref)
+	if err != nil {
+		return err
+	}
+	domain, _, _, _, err := imagerefs.Parse(imageRef)
+	if err != nil {
+		return err
+	}
+	dir, err := os.MkdirTemp("", consts.AppName)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := os.RemoveAll(dir)
+		if err != nil {
+			log.Error().Err(err).Msg("Remove temp dir failed")
+		}
+	}()
+
+	keyPath := path.Join(dir, "key.pem")
+	certPath := path.Join(dir, "cert.pem")
+	err = os.WriteFile(keyPath, []byte(priKey), 0600)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(certPath, []byte(s.Certificate), 0600)
+	if err != nil {
+		return err
+	}
+	// notation reads the signing keys from $XDG_CONFIG_HOME/notation/signingkeys.json
+	err = os.MkdirAll(path.Join(dir, "notation"), 0700)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(path.Join(dir, "notation", "signingkeys.json"), utils.MustMarshal(map[string]any{
+		"default": consts.AppName,
+		"keys":    []map[string]string{{"name": consts.AppName, "keyPath": keyPath, "certPath": certPath}},
+	}), 0600)
+	if err != nil {
+		return err
+	}
+	// notation reads the registry credentials from the docker config, the token is used as the bearer token directly
+	err = os.MkdirAll(path.Join(dir, "docker"), 0700)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(path.Join(dir, "docker", "config.json"), utils.MustMarshal(map[string]any{
+		"auths": map[string]any{domain: map[string]string{"registrytoken": token}},
+	}), 0600)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, "notation", "sign")
+	cmd.Args = append(cmd.Args, "--key", consts.AppName)
+	cmd.Args = append(cmd.Args, "--signature-format", "jws")
+	if s.Http {
+		cmd.Args = append(cmd.Args, "--insecure-registry")
+	}
+	cmd.Args = append(cmd.Args, imageRef)
+	cmd.Env = append(os.Environ(), "XDG_CONFIG_HOME="+dir, "DOCKER_CONFIG="+path.Join(dir, "docker"))
+	cmd.Stdout = os.Stdout
+	cmd.Stdin = os.Stdin
+	cmd.Stderr = os.Stderr
+	log.Info().Str("command", cmd.String()).Msg("Signing image with notation")
+
+	return cmd.Run()
+}
@@ -0,0 +1,313 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+const (
+	// MediaTypeJWS the media type of the notation jws signature envelope
+	MediaTypeJWS = "application/jose+json"
+	// MediaTypeCOSE the media type of the notation cose signature envelope
+	MediaTypeCOSE = "application/cose"
+	// ArtifactType the artifact type of the notation signature manifest
+	ArtifactType = "application/vnd.cncf.notary.signature"
+
+	headerSigningTime  = "io.cncf.notary.signingTime"
+	headerSigningAgent = "io.cncf.notary.signingAgent"
+
+	// coseTagSign1 the cbor tag of the COSE_Sign1 structure
+	coseTagSign1 = 18
+	// coseLabelAlg the label of the algorithm in the cose header
+	coseLabelAlg = 1
+	// coseLabelX5Chain the label of the certificate chain in the cose header
+	coseLabelX5Chain = 33
+)
+
+// coseAlgorithms the cose algorithm identifiers to the jws algorithm names
+var coseAlgorithms = map[int64]string{
+	-7:  "ES256",
+	-35: "ES384",
+	-36: "ES512",
+	-37: "PS256",
+	-38: "PS384",
+	-39: "PS512",
+}
+
+// Envelope the parsed notation signature envelope
+type Envelope struct {
+	// TargetDigest the digest of the signed artifact
+	TargetDigest string
+	// Algorithm the signature algorithm, e.g. ES256, PS256
+	Algorithm string
+	// SigningTime the signing time claimed by the signer
+	SigningTime time.Time
+	// SigningAgent the signing agent, e.g. notation-go/1.0.0
+	SigningAgent string
+	// Certificates the signing certificate chain, the first one is the signing certificate
+	Certificates []*x509.Certificate
+
+	signed    []byte
+	signature []byte
+}
+
+// payload the notation signature payload
+type payload struct {
+	TargetArtifact struct {
+		MediaType string `json:"mediaType"`
+		Digest    string `json:"digest"`
+		Size      int64  `json:"size"`
+	} `json:"targetArtifact"`
+}
+
+// Parse parses the notation signature envelope
+func Parse(mediaType string, raw []byte) (*Envelope, error) {
+	var envelope *Envelope
+	var content []byte
+	var err error
+	switch mediaType {
+	case MediaTypeJWS:
+		envelope, content, err = parseJWS(raw)
+	case MediaTypeCOSE:
+		envelope, content, err = parseCOSE(raw)
+	default:
+		return nil, fmt.Errorf("unsupported signature envelope: %s", mediaType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(envelope.Certificates) == 0 {
+		return nil, errors.New("signing certificate not found in signature envelope")
+	}
+	var p payload
+	err = json.Unmarshal(content, &p)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal signature payload failed: %w", err)
+	}
+	envelope.TargetDigest = p.TargetArtifact.Digest
+	return envelope, nil
+}
+
+// VerifySignature verifies the signature of the envelope with the signing certificate
+func (e *Envelope) VerifySignature() error {
+	publicKey := e.Certificates[0].PublicKey
+	var hash crypto.Hash
+	switch e.Algorithm {
+	case "ES256", "PS256":
+		hash = crypto.SHA256
+	case "ES384", "PS384":
+		hash = crypto.SHA384
+	case "ES512", "PS512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported signature algorithm: %s", e.Algorithm)
+	}
+	hasher := hash.New()
+	hasher.Write(e.signed) // nolint: errcheck
+	hashed := hasher.Sum(nil)
+	switch e.Algorithm[0] {
+	case 'E':
+		key, ok := publicKey.(*ecdsa.PublicKey)
+		if !ok || len(e.signature)%2 != 0 {
+			return errors.New("invalid ecdsa signature")
+		}
+		size := len(e.signature) / 2
+		r := new(big.Int).SetBytes(e.signature[:size])
+		s := new(big.Int).SetBytes(e.signature[size:])
+		if !ecdsa.Verify(key, hashed, r, s) {
+			return errors.New("invalid signature")
+		}
+	case 'P':
+		key, ok := publicKey.(*rsa.PublicKey)
+		if !ok {
+			return errors.New("invalid rsa signature")
+		}
+		err := rsa.VerifyPSS(key, hash, hashed, e.signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		if err != nil {
+			return errors.New("invalid signature")
+		}
+	}
+	return nil
+}
+
+// parseJWS parses the jws envelope in json serialization
+func parseJWS(raw []byte) (*Envelope, []byte, error) {
+	var jws struct {
+		Payload   string `json:"payload"`
+		Protected string `json:"protected"`
+		Header    struct {
+			X5c          []string `json:"x5c"`
+			SigningAgent string   `json:"io.cncf.notary.signingAgent"`
+		} `json:"header"`
+		Signature string `json:"signature"`
+	}
+	err := json.Unmarshal(raw, &jws)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unmarshal jws envelope failed: %w", err)
+	}
+	protectedBytes, err := base64.RawURLEncoding.DecodeString(jws.Protected)
+	if err != nil {
+		return nil, nil, fmt.Errorf("decode jws protected header failed: %w", err)
+	}
+	var protected struct {
+		Alg         string `json:"alg"`
+		SigningTime string `json:"io.cncf.notary.signingTime"`
+	}
+	err = json.Unmarshal(protectedBytes, &protected)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unmarshal jws protected header failed: %w", err)
+	}
+	content, err := base64.RawURLEncoding.DecodeString(jws.Payload)
+	if err != nil {
+		return nil, nil, fmt.Errorf("decode jws payload failed: %w", err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(jws.Signature)
+	if err != nil {
+		return nil, nil, fmt.Errorf("decode jws signature failed: %w", err)
+	}
+	envelope := &Envelope{
+		Algorithm:    protected.Alg,
+		SigningAgent: jws.Header.SigningAgent,
+		signed:       []byte(jws.Protected + "." + jws.Payload),
+		signature:    signature,
+	}
+	if protected.SigningTime != "" {
+		envelope.SigningTime, err = time.Parse(time.RFC3339, protected.SigningTime)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parse signing time failed: %w", err)
+		}
+	}
+	for _, item := range jws.Header.X5c {
+		der, err := base64.StdEncoding.DecodeString(item)
+		if err != nil {
+			return nil, nil, fmt.Errorf("decode certificate failed: %w", err)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parse certificate failed: %w", err)
+		}
+		envelope.Certificates = append(envelope.Certificates, cert)
+	}
+	return envelope, content, nil
+}
+
+// coseSign1 the COSE_Sign1 structure
+type coseSign1 struct {
+	_           struct{} `cbor:",toarray"`
+	Protected   []byte
+	Unprotected map[any]any
+	Payload     []byte
+	Signature   []byte
+}
+
+// parseCOSE parses the COSE_Sign1 envelope
+func parseCOSE(raw []byte) (*Envelope, []byte, error) {
+	var tag cbor.RawTag
+	err := cbor.Unmarshal(raw, &tag)
+	if err == nil {
+		if tag.Number != coseTagSign1 {
+			return nil, nil, fmt.Errorf("unsupported cose tag: %d", tag.Number)
+		}
+		raw = tag.Content
+	}
+	var message coseSign1
+	err = cbor.Unmarshal(raw, &message)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unmarshal cose envelope failed: %w", err)
+	}
+	var protected map[any]any
+	err = cbor.Unmarshal(message.Protected, &protected)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unmarshal cose protected header failed: %w", err)
+	}
+	signed, err := cbor.Marshal([]any{"Signature1", message.Protected, []byte{}, message.Payload})
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal cose signature structure failed: %w", err)
+	}
+	envelope := &Envelope{signed: signed, signature: message.Signature}
+	if alg, ok := coseInt(lookupLabel(protected, coseLabelAlg)); ok {
+		envelope.Algorithm = coseAlgorithms[alg]
+	}
+	envelope.SigningTime = coseTime(protected[headerSigningTime])
+	if agent, ok := message.Unprotected[headerSigningAgent].(string); ok {
+		envelope.SigningAgent = agent
+	}
+	var chain [][]byte
+	switch value := lookupLabel(message.Unprotected, coseLabelX5Chain).(type) {
+	case []byte:
+		chain = append(chain, value)
+	case []any:
+		for _, item := range value {
+			if der, ok := item.([]byte); ok {
+				chain = append(chain, der)
+			}
+		}
+	}
+	for _, der := range chain {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parse certificate failed: %w", err)
+		}
+		envelope.Certificates = append(envelope.Certificates, cert)
+	}
+	return envelope, message.Payload, nil
+}
+
+// lookupLabel looks up the integer label in the cose header, the positive integer is decoded as uint64
+func lookupLabel(header map[any]any, label int64) any {
+	if value, ok := header[label]; ok {
+		return value
+	}
+	if label >= 0 {
+		return header[uint64(label)]
+	}
+	return nil
+}
+
+// coseInt converts the cbor integer to int64
+func coseInt(value any) (int64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return v, true
+	case uint64:
+		return int64(v), true
+	}
+	return 0, false
+}
+
+// coseTime converts the cbor time to time.Time
+func coseTime(value any) time.Time {
+	switch v := value.(type) {
+	case time.Time:
+		return v
+	case cbor.Tag:
+		if seconds, ok := coseInt(v.Content); ok {
+			return time.Unix(seconds, 0)
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,190 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-sigma/sigma/pkg/signing/definition"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+)
+
+// identityPrefix the prefix of the x509 subject trusted identity
+const identityPrefix = "x509.subject:"
+
+// Options the trust store and trusted identities of the verifier, same as the notation trust policy
+type Options struct {
+	// Roots the trusted root certificates in pem format
+	Roots string
+	// Identities the trusted identities, e.g. "x509.subject: C=US, O=sigma", or "*" to trust any identity issued by the roots
+	Identities []string
+}
+
+type verifying struct {
+	roots      *x509.CertPool
+	anyone     bool
+	identities []map[string]string
+}
+
+// New creates a notation signature verifier, returns error if the trust store or trusted identities are invalid
+func New(opt Options) (definition.Verifying, error) {
+	v := &verifying{}
+	if len(opt.Roots) > 0 {
+		v.roots = x509.NewCertPool()
+		if !v.roots.AppendCertsFromPEM([]byte(opt.Roots)) {
+			return nil, errors.New("no root certificate found in pem")
+		}
+	}
+	if len(opt.Identities) > 0 && v.roots == nil {
+		return nil, errors.New("root certificates are required to verify the notation identities")
+	}
+	for _, item := range opt.Identities {
+		item = strings.TrimSpace(item)
+		if item == "*" {
+			v.anyone = true
+			continue
+		}
+		if !strings.HasPrefix(item, identityPrefix) {
+			return nil, fmt.Errorf("identity(%s) must be '*' or start with '%s'", item, identityPrefix)
+		}
+		dn, err := parseDN(strings.TrimPrefix(item, identityPrefix))
+		if err != nil {
+			return nil, fmt.Errorf("parse identity(%s) failed: %w", item, err)
+		}
+		v.identities = append(v.identities, dn)
+	}
+	return v, nil
+}
+
+// Verify verifies the notation signatures of the artifact with digest, returns nil if any of the signatures is trusted
+func (v *verifying) Verify(digest string, signatures []definition.Signature) error {
+	var errs []error
+	for _, signature := range signatures {
+		if signature.Type != enums.SigningTypeNotation {
+			continue
+		}
+		err := v.verify(digest, signature)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return errors.New("no notation signature found")
+	}
+	return fmt.Errorf("no trusted notation signature found: %w", errors.Join(errs...))
+}
+
+// verify verifies a single notation signature
+func (v *verifying) verify(digest string, signature definition.Signature) error {
+	if v.roots == nil || (!v.anyone && len(v.identities) == 0) {
+		return errors.New("notation signature is not trusted")
+	}
+	envelope, err := Parse(signature.MediaType, signature.Payload)
+	if err != nil {
+		return err
+	}
+	if envelope.TargetDigest != digest {
+		return fmt.Errorf("signature is signed for %s", envelope.TargetDigest)
+	}
+	err = envelope.VerifySignature()
+	if err != nil {
+		return err
+	}
+	leaf := envelope.Certificates[0]
+	intermediates := x509.NewCertPool()
+	for _, cert := range envelope.Certificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	opts := x509.VerifyOptions{
+		Roots:         v.roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}
+	if !envelope.SigningTime.IsZero() {
+		opts.CurrentTime = envelope.SigningTime
+	}
+	_, err = leaf.Verify(opts)
+	if err != nil {
+		return fmt.Errorf("verify signing certificate failed: %w", err)
+	}
+	if v.anyone {
+		return nil
+	}
+	subject := subjectAttributes(leaf.Subject)
+	for _, identity := range v.identities {
+		if isSubset(identity, subject) {
+			return nil
+		}
+	}
+	return fmt.Errorf("notation identity(%s) is not trusted", leaf.Subject.String())
+}
+
+// attributeTypes the short names of the distinguished name attribute types
+var attributeTypes = map[string]string{
+	"2.5.4.3":              "CN",
+	"2.5.4.6":              "C",
+	"2.5.4.7":              "L",
+	"2.5.4.8":              "ST",
+	"2.5.4.10":             "O",
+	"2.5.4.11":             "OU",
+	"1.2.840.113549.1.9.1": "E",
+}
+
+// subjectAttributes converts the certificate subject to the attribute map
+func subjectAttributes(name pkix.Name) map[string]string {
+	var attributes = make(map[string]string)
+	for _, item := range name.Names {
+		key, ok := attributeTypes[item.Type.String()]
+		if !ok {
+			continue
+		}
+		if value, ok := item.Value.(string); ok {
+			attributes[key] = value
+		}
+	}
+	return attributes
+}
+
+// parseDN parses the distinguished name, e.g. "C=US, ST=WA, O=sigma"
+func parseDN(dn string) (map[string]string, error) {
+	var attributes = make(map[string]string)
+	for _, item := range strings.Split(dn, ",") {
+		kv := strings.SplitN(strings.TrimSpace(item), "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" || strings.TrimSpace(kv[1]) == "" {
+			return nil, fmt.Errorf("invalid attribute: %s", item)
+		}
+		key := strings.ToUpper(strings.TrimSpace(kv[0]))
+		if _, ok := attributes[key]; ok {
+			return nil, fmt.Errorf("duplicate attribute: %s", key)
+		}
+		attributes[key] = strings.TrimSpace(kv[1])
+	}
+	return attributes, nil
+}
+
+// isSubset checks all of the attributes of the identity are matched with the subject
+func isSubset(identity, subject map[string]string) bool {
+	for key, value := range identity {
+		if subject[key] != value {
+			return false
+		}
+	}
+	return true
+}
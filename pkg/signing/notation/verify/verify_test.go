@@ -0,0 +1,180 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-sigma/sigma/pkg/signing/definition"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+)
+
+const digest = "sha256:2776ee23722eaabcffed77dafd22b7a1da734971bf268a323b6819926dfe1ebd"
+
+type chain struct {
+	rootPem string
+	leaf    *x509.Certificate
+	key     *ecdsa.PrivateKey
+}
+
+func newChain(t *testing.T) chain {
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	rootTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "sigma root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	rootDer, err := x509.CreateCertificate(rand.Reader, rootTemplate, rootTemplate, &rootKey.PublicKey, rootKey)
+	assert.NoError(t, err)
+	root, err := x509.ParseCertificate(rootDer)
+	assert.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	leafTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "signer", Organization: []string{"sigma"}, Country: []string{"US"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}
+	leafDer, err := x509.CreateCertificate(rand.Reader, leafTemplate, root, &key.PublicKey, rootKey)
+	assert.NoError(t, err)
+	leaf, err := x509.ParseCertificate(leafDer)
+	assert.NoError(t, err)
+
+	return chain{rootPem: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rootDer})), leaf: leaf, key: key}
+}
+
+func signRaw(t *testing.T, key *ecdsa.PrivateKey, signed []byte) []byte {
+	hashed := sha256.Sum256(signed)
+	r, s, err := ecdsa.Sign(rand.Reader, key, hashed[:])
+	assert.NoError(t, err)
+	var sig = make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	return sig
+}
+
+func targetPayload(digest string) []byte {
+	return []byte(fmt.Sprintf(`{"targetArtifact":{"mediaType":"application/vnd.oci.image.manifest.v1+json","digest":"%s","size":1024}}`, digest))
+}
+
+func jwsEnvelope(t *testing.T, c chain, digest string) []byte {
+	protected := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"alg":"ES256","cty":"application/vnd.cncf.notary.payload.v1+json","io.cncf.notary.signingTime":"%s"}`, time.Now().Format(time.RFC3339))))
+	content := base64.RawURLEncoding.EncodeToString(targetPayload(digest))
+	raw, err := json.Marshal(map[string]any{
+		"payload":   content,
+		"protected": protected,
+		"header": map[string]any{
+			"x5c":                         []string{base64.StdEncoding.EncodeToString(c.leaf.Raw)},
+			"io.cncf.notary.signingAgent": "notation-go/1.2.0",
+		},
+		"signature": base64.RawURLEncoding.EncodeToString(signRaw(t, c.key, []byte(protected+"."+content))),
+	})
+	assert.NoError(t, err)
+	return raw
+}
+
+func coseEnvelope(t *testing.T, c chain, digest string) []byte {
+	protected, err := cbor.Marshal(map[any]any{int64(coseLabelAlg): int64(-7)})
+	assert.NoError(t, err)
+	content := targetPayload(digest)
+	signed, err := cbor.Marshal([]any{"Signature1", protected, []byte{}, content})
+	assert.NoError(t, err)
+	raw, err := cbor.Marshal(cbor.Tag{Number: coseTagSign1, Content: []any{
+		protected,
+		map[any]any{int64(coseLabelX5Chain): []any{c.leaf.Raw}, headerSigningAgent: "notation-go/1.2.0"},
+		content,
+		signRaw(t, c.key, signed),
+	}})
+	assert.NoError(t, err)
+	return raw
+}
+
+func TestParse(t *testing.T) {
+	c := newChain(t)
+	for mediaType, raw := range map[string][]byte{MediaTypeJWS: jwsEnvelope(t, c, digest), MediaTypeCOSE: coseEnvelope(t, c, digest)} {
+		envelope, err := Parse(mediaType, raw)
+		assert.NoError(t, err)
+		assert.Equal(t, digest, envelope.TargetDigest)
+		assert.Equal(t, "ES256", envelope.Algorithm)
+		assert.Equal(t, "notation-go/1.2.0", envelope.SigningAgent)
+		assert.Equal(t, "signer", envelope.Certificates[0].Subject.CommonName)
+		assert.NoError(t, envelope.VerifySignature())
+	}
+
+	_, err := Parse("application/json", nil)
+	assert.Error(t, err)
+	_, err = Parse(MediaTypeJWS, []byte("invalid"))
+	assert.Error(t, err)
+}
+
+func TestVerify(t *testing.T) {
+	c := newChain(t)
+	signatures := []definition.Signature{
+		{Type: enums.SigningTypeNotation, MediaType: MediaTypeJWS, Payload: jwsEnvelope(t, c, digest)},
+		{Type: enums.SigningTypeNotation, MediaType: MediaTypeCOSE, Payload: coseEnvelope(t, c, digest)},
+	}
+
+	verifier, err := New(Options{Roots: c.rootPem, Identities: []string{"x509.subject: CN=signer, O=sigma"}})
+	assert.NoError(t, err)
+	for _, signature := range signatures {
+		assert.NoError(t, verifier.Verify(digest, []definition.Signature{signature}))
+	}
+	assert.Error(t, verifier.Verify("sha256:96526aa774ef0126ad0fe9e9a95764c5fc37f409ab9e97021e7b4775d82bf6fa", signatures))
+	assert.Error(t, verifier.Verify(digest, []definition.Signature{{Type: enums.SigningTypeCosign}}))
+
+	verifier, err = New(Options{Roots: c.rootPem, Identities: []string{"*"}})
+	assert.NoError(t, err)
+	assert.NoError(t, verifier.Verify(digest, signatures))
+
+	verifier, err = New(Options{Roots: c.rootPem, Identities: []string{"x509.subject: CN=signer, O=other"}})
+	assert.NoError(t, err)
+	assert.Error(t, verifier.Verify(digest, signatures))
+
+	other := newChain(t)
+	verifier, err = New(Options{Roots: other.rootPem, Identities: []string{"*"}})
+	assert.NoError(t, err)
+	assert.Error(t, verifier.Verify(digest, signatures))
+
+	_, err = New(Options{Identities: []string{"*"}})
+	assert.Error(t, err)
+	_, err = New(Options{Roots: c.rootPem, Identities: []string{"CN=signer"}})
+	assert.Error(t, err)
+	_, err = New(Options{Roots: "invalid"})
+	assert.Error(t, err)
+}
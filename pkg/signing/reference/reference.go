@@ -0,0 +1,62 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reference
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aquasecurity/trivy/pkg/digest"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/handlers/distribution/clients"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/hash"
+	"github.com/go-sigma/sigma/pkg/utils/imagerefs"
+)
+
+// Resolve resolves the image reference with tag to the reference with digest, the signature is always attached to the digest
+func Resolve(ctx context.Context, http bool, token, ref string) (string, error) {
+	domain, _, repo, tag, err := imagerefs.Parse(ref)
+	if err != nil {
+		return "", err
+	}
+	if http {
+		domain = fmt.Sprintf("http://%s", domain)
+	} else {
+		domain = fmt.Sprintf("https://%s", domain)
+	}
+	clientsFactory := clients.NewClientsFactory()
+	client, err := clientsFactory.New(configs.Configuration{
+		Proxy: configs.ConfigurationProxy{
+			Endpoint:  domain,
+			TlsVerify: !http,
+			Token:     token,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	manifest, _, err := client.GetManifest(ctx, repo, tag)
+	if err != nil {
+		return "", err
+	}
+	_, manifestBytes, err := manifest.Payload()
+	if err != nil {
+		return "", err
+	}
+	d := digest.NewDigestFromString(digest.SHA256, hash.MustString(string(manifestBytes)))
+	return fmt.Sprintf("%s/%s@%s", utils.TrimHTTP(domain), repo, d.String()), nil
+}
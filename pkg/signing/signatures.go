@@ -0,0 +1,169 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+	"github.com/go-sigma/sigma/pkg/dal/dao"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	cosignverify "github.com/go-sigma/sigma/pkg/signing/cosign/verify"
+	"github.com/go-sigma/sigma/pkg/signing/definition"
+	notationverify "github.com/go-sigma/sigma/pkg/signing/notation/verify"
+	"github.com/go-sigma/sigma/pkg/storage"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+const (
+	// mediaTypeCosignSimpleSigning the media type of the cosign signature layer
+	mediaTypeCosignSimpleSigning = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// maxSignatureLayerSize the max size of the signature layer
+	maxSignatureLayerSize = 1 << 20
+)
+
+// ListSignatures lists the cosign and notation signatures of the artifact, the cosign signature referrers,
+// the cosign signature tag (e.g. sha256-<hex>.sig) and the notation signature referrers are supported.
+func ListSignatures(ctx context.Context, artifactService dao.ArtifactService, tagService dao.TagService, repositoryID int64, artifactDigest string) ([]definition.Signature, error) {
+	referrers, err := artifactService.GetReferrers(ctx, repositoryID, artifactDigest, nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	var signatureObjs []*models.Artifact
+	for _, referrer := range referrers {
+		if referrer.Type == enums.ArtifactTypeCosign || ptr.To(referrer.ArtifactType) == notationverify.ArtifactType {
+			signatureObjs = append(signatureObjs, referrer)
+		}
+	}
+	dgest, err := digest.Parse(artifactDigest)
+	if err != nil {
+		return nil, err
+	}
+	tagObj, err := tagService.GetByName(ctx, repositoryID, fmt.Sprintf("%s-%s.sig", dgest.Algorithm(), dgest.Hex()))
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if tagObj != nil && tagObj.Artifact != nil {
+		signatureObjs = append(signatureObjs, tagObj.Artifact)
+	}
+
+	var signatures []definition.Signature
+	for _, signatureObj := range signatureObjs {
+		var manifest imgspecv1.Manifest
+		err = json.Unmarshal(signatureObj.Raw, &manifest)
+		if err != nil {
+			log.Warn().Err(err).Str("digest", signatureObj.Digest).Msg("Unmarshal signature manifest failed")
+			continue
+		}
+		for _, layer := range manifest.Layers {
+			if layer.Size > maxSignatureLayerSize {
+				continue
+			}
+			var signature = definition.Signature{Digest: signatureObj.Digest, MediaType: layer.MediaType}
+			switch layer.MediaType {
+			case mediaTypeCosignSimpleSigning:
+				signature.Type = enums.SigningTypeCosign
+				signature.Signature = layer.Annotations["dev.cosignproject.cosign/signature"]
+				signature.Certificate = []byte(layer.Annotations["dev.sigstore.cosign/certificate"])
+				signature.Chain = []byte(layer.Annotations["dev.sigstore.cosign/chain"])
+			case notationverify.MediaTypeJWS, notationverify.MediaTypeCOSE:
+				signature.Type = enums.SigningTypeNotation
+			default:
+				continue
+			}
+			signature.Payload, err = readLayer(ctx, layer.Digest, maxSignatureLayerSize)
+			if err != nil {
+				return nil, err
+			}
+			signatures = append(signatures, signature)
+		}
+	}
+	return signatures, nil
+}
+
+// readLayer reads the signature or attestation layer from the storage, at most limit bytes are read
+func readLayer(ctx context.Context, dgest digest.Digest, limit int64) ([]byte, error) {
+	reader, err := storage.Driver.Reader(ctx, path.Join(consts.Blobs, utils.GenPathByDigest(dgest)))
+	if err != nil {
+		return nil, fmt.Errorf("read layer(%s) failed: %w", dgest, err)
+	}
+	defer reader.Close() // nolint: errcheck
+	return io.ReadAll(io.LimitReader(reader, limit))
+}
+
+// Inspect inspects the signer details of the signature, the signer is nil if the cosign signature is signed by a key pair
+func Inspect(signature definition.Signature) (types.ArtifactSignatureItem, error) {
+	var item = types.ArtifactSignatureItem{Type: signature.Type, Digest: signature.Digest, MediaType: signature.MediaType}
+	switch signature.Type {
+	case enums.SigningTypeNotation:
+		envelope, err := notationverify.Parse(signature.MediaType, signature.Payload)
+		if err != nil {
+			return item, err
+		}
+		item.Algorithm = envelope.Algorithm
+		item.SigningAgent = envelope.SigningAgent
+		if !envelope.SigningTime.IsZero() {
+			item.SigningTime = ptr.Of(envelope.SigningTime.UTC().Format(consts.DefaultTimePattern))
+		}
+		item.Signer = inspectCertificate(envelope.Certificates[0])
+	default:
+		if len(signature.Certificate) == 0 {
+			return item, nil
+		}
+		block, _ := pem.Decode(signature.Certificate)
+		if block == nil {
+			return item, errors.New("decode signing certificate failed")
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return item, fmt.Errorf("parse signing certificate failed: %w", err)
+		}
+		item.Signer = inspectCertificate(cert)
+		item.Signer.OidcIssuer = cosignverify.CertificateIssuer(cert)
+	}
+	return item, nil
+}
+
+// inspectCertificate returns the signer details of the signing certificate
+func inspectCertificate(cert *x509.Certificate) *types.ArtifactSignatureSigner {
+	signer := &types.ArtifactSignatureSigner{
+		Subject:      cert.Subject.String(),
+		Issuer:       cert.Issuer.String(),
+		SerialNumber: cert.SerialNumber.String(),
+		NotBefore:    cert.NotBefore.UTC().Format(time.RFC3339),
+		NotAfter:     cert.NotAfter.UTC().Format(time.RFC3339),
+		Identities:   append([]string{}, cert.EmailAddresses...),
+	}
+	for _, uri := range cert.URIs {
+		signer.Identities = append(signer.Identities, uri.String())
+	}
+	return signer
+}
@@ -15,8 +15,14 @@
 package signing
 
 import (
-	"github.com/go-sigma/sigma/pkg/signing/cosign/sign"
+	"errors"
+	"fmt"
+
+	cosignsign "github.com/go-sigma/sigma/pkg/signing/cosign/sign"
+	cosignverify "github.com/go-sigma/sigma/pkg/signing/cosign/verify"
 	"github.com/go-sigma/sigma/pkg/signing/definition"
+	notationsign "github.com/go-sigma/sigma/pkg/signing/notation/sign"
+	notationverify "github.com/go-sigma/sigma/pkg/signing/notation/verify"
 	"github.com/go-sigma/sigma/pkg/types/enums"
 )
 
@@ -26,14 +32,56 @@ type Options struct {
 
 	Http      bool
 	MultiArch bool
+
+	// Certificate the signing certificate in pem format, required by notation
+	Certificate string
 }
 
 // NewSigning ...
 func NewSigning(opt Options) definition.Signing {
 	switch opt.Type {
 	case enums.SigningTypeCosign:
-		return sign.New(opt.Http, opt.MultiArch)
+		return cosignsign.New(opt.Http, opt.MultiArch)
+	case enums.SigningTypeNotation:
+		return notationsign.New(opt.Http, opt.Certificate)
 	default:
-		return sign.New(opt.Http, opt.MultiArch)
+		return cosignsign.New(opt.Http, opt.MultiArch)
+	}
+}
+
+// VerifyingOptions the trusted materials of the cosign and notation verifiers
+type VerifyingOptions struct {
+	Cosign   cosignverify.Options
+	Notation notationverify.Options
+}
+
+type verifyings []definition.Verifying
+
+// NewVerifying creates a verifier which trusts the artifact if any of the cosign or notation signatures is trusted
+func NewVerifying(opt VerifyingOptions) (definition.Verifying, error) {
+	cosignVerifying, err := cosignverify.New(opt.Cosign)
+	if err != nil {
+		return nil, err
+	}
+	notationVerifying, err := notationverify.New(opt.Notation)
+	if err != nil {
+		return nil, err
+	}
+	return verifyings{cosignVerifying, notationVerifying}, nil
+}
+
+// Verify verifies the signatures of the artifact with digest, returns nil if any of the signatures is trusted
+func (v verifyings) Verify(digest string, signatures []definition.Signature) error {
+	if len(signatures) == 0 {
+		return errors.New("no signature found")
+	}
+	var errs []error
+	for _, verifying := range v {
+		err := verifying.Verify(digest, signatures)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
 	}
+	return fmt.Errorf("no trusted signature found: %w", errors.Join(errs...))
 }
@@ -14,6 +14,12 @@
 
 package types
 
+import (
+	"time"
+
+	"github.com/go-sigma/sigma/pkg/types/enums"
+)
+
 // ArtifactItem represents an artifact.
 type ArtifactItem struct {
 	ID        int64  `json:"id"`
@@ -28,6 +34,24 @@ type ArtifactItem struct {
 	UpdatedAt string `json:"updated_at"`
 }
 
+// VulnerabilitySummary represents the severity counts of the artifact vulnerability scan result,
+// the findings suppressed by the vulnerability allowlist are not counted.
+type VulnerabilitySummary struct {
+	Critical   int64                  `json:"critical"`
+	High       int64                  `json:"high"`
+	Medium     int64                  `json:"medium"`
+	Low        int64                  `json:"low"`
+	Suppressed []VulnerabilityFinding `json:"suppressed,omitempty"`
+	Findings   []VulnerabilityFinding `json:"findings,omitempty"`
+}
+
+// VulnerabilityFinding represents a vulnerability found in the artifact.
+type VulnerabilityFinding struct {
+	ID       string                      `json:"id" example:"CVE-2023-5678"`
+	Package  string                      `json:"package" example:"libcrypto3"`
+	Severity enums.VulnerabilitySeverity `json:"severity" example:"Medium"`
+}
+
 // ListArtifactRequest represents the request to list artifacts.
 type ListArtifactRequest struct {
 	Pagination
@@ -49,3 +73,224 @@ type DeleteArtifactRequest struct {
 	Repository string `json:"repository" query:"repository" validate:"required,is_valid_repository"`
 	Digest     string `json:"digest" param:"digest" validate:"required,is_valid_digest"`
 }
+
+// TrivyDBMetadata is the metadata of the trivy vulnerability database.
+type TrivyDBMetadata struct {
+	Version      int       `json:"Version"`
+	NextUpdate   time.Time `json:"NextUpdate"`
+	UpdatedAt    time.Time `json:"UpdatedAt"`
+	DownloadedAt time.Time `json:"DownloadedAt"`
+}
+
+// VulnerabilityReportItem represents a normalized vulnerability finding in the trivy report.
+type VulnerabilityReportItem struct {
+	ID               string                      `json:"id" example:"CVE-2023-5678"`
+	Package          string                      `json:"package" example:"libcrypto3"`
+	InstalledVersion string                      `json:"installed_version" example:"3.1.3-r0"`
+	FixedVersion     string                      `json:"fixed_version" example:"3.1.4-r1"`
+	Severity         enums.VulnerabilitySeverity `json:"severity" example:"Medium"`
+	CVSS             *float64                    `json:"cvss,omitempty" example:"5.3"`
+	LayerDigest      string                      `json:"layer_digest,omitempty" example:"sha256:2c03dbb20264f09924f9eab176da44e5421e74a78b09531d3c63448a7baa7c59"`
+	Target           string                      `json:"target" example:"library/alpine (alpine 3.18.4)"`
+	Title            string                      `json:"title,omitempty" example:"openssl: Excessive time spent checking DH q parameter value"`
+	PrimaryURL       string                      `json:"primary_url,omitempty" example:"https://avd.aquasec.com/nvd/cve-2023-5678"`
+	Suppressed       bool                        `json:"suppressed" example:"false"`
+}
+
+// VulnerabilityReportFilter the filter of the vulnerability report.
+type VulnerabilityReportFilter struct {
+	Severity *enums.VulnerabilitySeverity `json:"severity,omitempty" query:"severity" validate:"omitempty,is_valid_severity" example:"High"`
+	Package  *string                      `json:"package,omitempty" query:"package" example:"openssl"`
+	Keyword  *string                      `json:"keyword,omitempty" query:"keyword" example:"CVE-2023"`
+	Fixable  *bool                        `json:"fixable,omitempty" query:"fixable" example:"true"`
+}
+
+// ListArtifactVulnerabilityRequest represents the request to list the vulnerabilities of the artifact.
+type ListArtifactVulnerabilityRequest struct {
+	Pagination
+	Sortable
+	VulnerabilityReportFilter
+
+	ID int64 `json:"id" param:"id" validate:"required,number" swaggerignore:"true"`
+}
+
+// ExportArtifactVulnerabilityRequest represents the request to export the vulnerabilities of the artifact.
+type ExportArtifactVulnerabilityRequest struct {
+	Sortable
+	VulnerabilityReportFilter
+
+	ID     int64                           `json:"id" param:"id" validate:"required,number" swaggerignore:"true"`
+	Format enums.VulnerabilityReportFormat `json:"format" query:"format" validate:"is_valid_report_format" example:"sarif"`
+}
+
+// GetArtifactSbomRequest represents the request to get the sbom of the artifact.
+type GetArtifactSbomRequest struct {
+	ID     int64             `json:"id" param:"id" validate:"required,number" swaggerignore:"true"`
+	Format *enums.SbomFormat `json:"format,omitempty" query:"format" validate:"omitempty,is_valid_sbom_format" example:"spdx-json"`
+}
+
+// SearchArtifactPackageRequest represents the request to search the package in all of the artifact sboms.
+type SearchArtifactPackageRequest struct {
+	Pagination
+
+	Name        string  `json:"name" query:"name" validate:"required" example:"openssl"`
+	Version     *string `json:"version,omitempty" query:"version" example:">=3.0.0, <3.0.8"`
+	Type        *string `json:"type,omitempty" query:"type" example:"apk"`
+	NamespaceID *int64  `json:"namespace_id,omitempty" query:"namespace_id" example:"1"`
+}
+
+// ArtifactPackageItem represents an artifact contains the searched package.
+type ArtifactPackageItem struct {
+	Name         string   `json:"name" example:"openssl"`
+	Version      string   `json:"version" example:"3.0.7-r0"`
+	Type         string   `json:"type" example:"apk"`
+	Purl         string   `json:"purl" example:"pkg:apk/alpine/openssl@3.0.7-r0?arch=x86_64"`
+	NamespaceID  int64    `json:"namespace_id" example:"1"`
+	Namespace    string   `json:"namespace" example:"library"`
+	RepositoryID int64    `json:"repository_id" example:"1"`
+	Repository   string   `json:"repository" example:"library/alpine"`
+	ArtifactID   int64    `json:"artifact_id" example:"1"`
+	Digest       string   `json:"digest" example:"sha256:87508bf3e050b975770b142e62db72eeb345a67d82d36ca166300d8b27e45744"`
+	Tags         []string `json:"tags" example:"latest"`
+}
+
+// LicenseCheckItem represents a package found by the license policy check.
+type LicenseCheckItem struct {
+	Name     string   `json:"name" example:"busybox"`
+	Version  string   `json:"version" example:"1.36.1-r2"`
+	Type     string   `json:"type" example:"apk"`
+	Licenses []string `json:"licenses" example:"GPL-2.0-only"`
+	Reason   string   `json:"reason" example:"license GPL-2.0-only is denied"`
+}
+
+// LicenseCheckResult represents the result of the license policy check of the artifact.
+type LicenseCheckResult struct {
+	Status     enums.LicenseCheckStatus `json:"status" example:"Violated"`
+	Violations []LicenseCheckItem       `json:"violations"`
+	Warnings   []LicenseCheckItem       `json:"warnings"`
+}
+
+// GetArtifactLicenseRequest represents the request to get the license check result of the artifact.
+type GetArtifactLicenseRequest struct {
+	ID int64 `json:"id" param:"id" validate:"required,number" swaggerignore:"true"`
+}
+
+// SeveritySummary represents the severity counts of the secret or misconfiguration scan result.
+type SeveritySummary struct {
+	Critical int64 `json:"critical" example:"0"`
+	High     int64 `json:"high" example:"1"`
+	Medium   int64 `json:"medium" example:"0"`
+	Low      int64 `json:"low" example:"0"`
+}
+
+// SecretReportItem represents a normalized secret finding in the trivy report.
+type SecretReportItem struct {
+	RuleID      string                      `json:"rule_id" example:"aws-access-key-id"`
+	Category    string                      `json:"category" example:"AWS"`
+	Title       string                      `json:"title" example:"AWS Access Key ID"`
+	Severity    enums.VulnerabilitySeverity `json:"severity" example:"Critical"`
+	Target      string                      `json:"target" example:"/app/.env"`
+	StartLine   int                         `json:"start_line" example:"1"`
+	EndLine     int                         `json:"end_line" example:"1"`
+	Match       string                      `json:"match" example:"AWS_ACCESS_KEY_ID=********************"`
+	LayerDigest string                      `json:"layer_digest,omitempty" example:"sha256:2c03dbb20264f09924f9eab176da44e5421e74a78b09531d3c63448a7baa7c59"`
+}
+
+// MisconfigurationReportItem represents a normalized misconfiguration finding in the trivy report.
+type MisconfigurationReportItem struct {
+	ID          string                      `json:"id" example:"DS002"`
+	Type        string                      `json:"type" example:"Dockerfile Security Check"`
+	Title       string                      `json:"title" example:"Image user should not be 'root'"`
+	Message     string                      `json:"message" example:"Specify at least 1 USER command in Dockerfile with non-root user as argument"`
+	Resolution  string                      `json:"resolution" example:"Add 'USER <non root user name>' line to the Dockerfile"`
+	Severity    enums.VulnerabilitySeverity `json:"severity" example:"High"`
+	Target      string                      `json:"target" example:"Dockerfile"`
+	PrimaryURL  string                      `json:"primary_url,omitempty" example:"https://avd.aquasec.com/misconfig/ds002"`
+	LayerDigest string                      `json:"layer_digest,omitempty" example:"sha256:2c03dbb20264f09924f9eab176da44e5421e74a78b09531d3c63448a7baa7c59"`
+}
+
+// ListArtifactContentScanRequest represents the request to list the secrets or misconfigurations of the artifact.
+type ListArtifactContentScanRequest struct {
+	Pagination
+
+	ID       int64                        `json:"id" param:"id" validate:"required,number" swaggerignore:"true"`
+	Severity *enums.VulnerabilitySeverity `json:"severity,omitempty" query:"severity" validate:"omitempty,is_valid_severity" example:"High"`
+}
+
+// ListArtifactSecretResponse represents the secret scan result of the artifact.
+type ListArtifactSecretResponse struct {
+	Status  enums.TaskCommonStatus `json:"status" example:"Success"`
+	Summary SeveritySummary        `json:"summary"`
+	Total   int64                  `json:"total" example:"1"`
+	Items   []SecretReportItem     `json:"items"`
+}
+
+// ListArtifactMisconfigurationResponse represents the misconfiguration scan result of the artifact.
+type ListArtifactMisconfigurationResponse struct {
+	Status  enums.TaskCommonStatus       `json:"status" example:"Success"`
+	Summary SeveritySummary              `json:"summary"`
+	Total   int64                        `json:"total" example:"1"`
+	Items   []MisconfigurationReportItem `json:"items"`
+}
+
+// ArtifactSignatureSigner represents the signer details of the signing certificate.
+type ArtifactSignatureSigner struct {
+	Subject      string   `json:"subject" example:"CN=sigma,O=sigma"`
+	Issuer       string   `json:"issuer" example:"CN=sigma,O=sigma"`
+	SerialNumber string   `json:"serial_number" example:"1"`
+	NotBefore    string   `json:"not_before" example:"2006-01-02T15:04:05Z"`
+	NotAfter     string   `json:"not_after" example:"2006-01-02T15:04:05Z"`
+	Identities   []string `json:"identities,omitempty" example:"https://github.com/go-sigma/sigma/.github/workflows/release.yml@refs/heads/main"`
+	OidcIssuer   string   `json:"oidc_issuer,omitempty" example:"https://token.actions.githubusercontent.com"`
+}
+
+// ArtifactSignatureItem represents the signature of the artifact.
+type ArtifactSignatureItem struct {
+	Type         enums.SigningType        `json:"type" example:"notation"`
+	Digest       string                   `json:"digest" example:"sha256:2c03dbb20264f09924f9eab176da44e5421e74a78b09531d3c63448a7baa7c59"`
+	MediaType    string                   `json:"media_type" example:"application/jose+json"`
+	Algorithm    string                   `json:"algorithm,omitempty" example:"ES256"`
+	SigningAgent string                   `json:"signing_agent,omitempty" example:"notation-go/1.1.0"`
+	SigningTime  *string                  `json:"signing_time,omitempty" example:"2006-01-02 15:04:05"`
+	Signer       *ArtifactSignatureSigner `json:"signer,omitempty"`
+}
+
+// ListArtifactSignatureRequest represents the request to list the signatures of the artifact.
+type ListArtifactSignatureRequest struct {
+	ID int64 `json:"id" param:"id" validate:"required,number" swaggerignore:"true"`
+}
+
+// ListArtifactAttestationRequest ...
+type ListArtifactAttestationRequest struct {
+	ID int64 `json:"id" param:"id" validate:"required,number" swaggerignore:"true"`
+
+	PredicateType *string `json:"predicate_type,omitempty" query:"predicate_type" validate:"omitempty,max=256" example:"https://slsa.dev/provenance/v0.2"`
+}
+
+// ArtifactAttestationSubject ...
+type ArtifactAttestationSubject struct {
+	Name   string            `json:"name" example:"127.0.0.1:3000/library/busybox"`
+	Digest map[string]string `json:"digest"`
+}
+
+// ArtifactAttestationMaterial ...
+type ArtifactAttestationMaterial struct {
+	URI    string            `json:"uri" example:"pkg:docker/alpine@3.19"`
+	Digest map[string]string `json:"digest,omitempty"`
+}
+
+// ArtifactAttestationItem ...
+type ArtifactAttestationItem struct {
+	Digest        string                        `json:"digest" example:"sha256:2c03dbb20264f09924f9eab176da44e5421e74a78b09531d3c63448a7baa7c59"`
+	MediaType     string                        `json:"media_type" example:"application/vnd.dsse.envelope.v1+json"`
+	SubjectDigest string                        `json:"subject_digest" example:"sha256:2c03dbb20264f09924f9eab176da44e5421e74a78b09531d3c63448a7baa7c59"`
+	PredicateType string                        `json:"predicate_type" example:"https://slsa.dev/provenance/v0.2"`
+	Subjects      []ArtifactAttestationSubject  `json:"subjects"`
+	BuilderID     *string                       `json:"builder_id,omitempty" example:"https://github.com/actions/runner"`
+	BuildType     *string                       `json:"build_type,omitempty" example:"https://mobyproject.org/buildkit@v1"`
+	Materials     []ArtifactAttestationMaterial `json:"materials"`
+	Predicate     any                           `json:"predicate" swaggertype:"object"`
+	Signed        bool                          `json:"signed" example:"true"`
+	Verified      bool                          `json:"verified" example:"true"`
+	VerifyMessage *string                       `json:"verify_message,omitempty" example:"no trusted envelope signature found"`
+}
@@ -54,7 +54,9 @@ type Builder struct {
 	BuildkitPlatforms          []enums.OciPlatform `env:"BUILDKIT_PLATFORMS" envSeparator:","`
 	BuildkitBuildArgs          []string            `env:"BUILDKIT_BUILD_ARGS" envSeparator:","`
 
-	SigningPrivateKey string `env:"SIGNING_PRIVATE_KEY,notEmpty"`
+	SigningType        enums.SigningType `env:"SIGNING_TYPE" envDefault:"cosign"`
+	SigningPrivateKey  string            `env:"SIGNING_PRIVATE_KEY,notEmpty"`
+	SigningCertificate string            `env:"SIGNING_CERTIFICATE"`
 }
 
 // GetBuilderRequest represents the request to get a builder.
@@ -102,6 +104,8 @@ type BuilderItem struct {
 	BuildkitDockerfile         *string             `json:"buildkit_dockerfile"`
 	BuildkitPlatforms          []enums.OciPlatform `json:"buildkit_platforms" example:"linux/amd64"`
 	BuildkitBuildArgs          *string             `json:"buildkit_build_args" example:"a=b,c=d"`
+
+	SigningType enums.SigningType `json:"signing_type" example:"cosign"`
 }
 
 // PostOrPutBuilderRequest ...
@@ -139,6 +143,8 @@ type PostOrPutBuilderRequest struct {
 	BuildkitDockerfile         *string             `json:"buildkit_dockerfile,omitempty" validate:"omitempty,min=1,max=255"`
 	BuildkitPlatforms          []enums.OciPlatform `json:"buildkit_platforms" validate:"required,min=1,is_valid_oci_platforms" example:"linux/amd64"`
 	BuildkitBuildArgs          *string             `json:"buildkit_build_args" example:"a=b,c=d"` // TODO: validate
+
+	SigningType *enums.SigningType `json:"signing_type,omitempty" validate:"omitempty,is_valid_signing_type" example:"cosign"`
 }
 
 // CreateBuilderRequest ...
@@ -28,6 +28,16 @@ type TaskVulnerability struct {
 	ArtifactID int64 `json:"artifact_id"`
 }
 
+// TaskSecret is the task secret scan struct
+type TaskSecret struct {
+	ArtifactID int64 `json:"artifact_id"`
+}
+
+// TaskMisconfiguration is the task misconfiguration scan struct
+type TaskMisconfiguration struct {
+	ArtifactID int64 `json:"artifact_id"`
+}
+
 // TaskProxyArtifact is the task proxy artifact
 type TaskProxyArtifact struct {
 	BlobDigest string `json:"blob_digest"`
@@ -64,7 +74,14 @@ type DaemonBuilderPayload struct {
 
 // DaemonArtifactPushedPayload ...
 type DaemonArtifactPushedPayload struct {
-	RepositoryID int64 `json:"repository_id"`
+	RepositoryID int64  `json:"repository_id"`
+	ArtifactID   int64  `json:"artifact_id,omitempty"`
+	Tag          string `json:"tag,omitempty"`
+}
+
+// DaemonQuotaPayload ...
+type DaemonQuotaPayload struct {
+	NamespaceID int64 `json:"namespace_id"`
 }
 
 // DaemonTagPushedPayload ...
@@ -87,9 +104,10 @@ type RetentionPatternPayload struct {
 type UpdateGcArtifactRuleRequest struct {
 	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"number" example:"10"`
 
-	RetentionDay int     `json:"retention_day" validate:"gte=0,lte=180" example:"10" minimum:"0" maximum:"180"`
-	CronEnabled  bool    `json:"cron_enabled" example:"true"`
-	CronRule     *string `json:"cron_rule,omitempty" validate:"omitempty,is_valid_cron_rule" example:"0 0 * * 6"`
+	RetentionDay         int     `json:"retention_day" validate:"gte=0,lte=180" example:"10" minimum:"0" maximum:"180"`
+	ReferrerRetentionDay int     `json:"referrer_retention_day" validate:"gte=0,lte=180" example:"7" minimum:"0" maximum:"180"`
+	CronEnabled          bool    `json:"cron_enabled" example:"true"`
+	CronRule             *string `json:"cron_rule,omitempty" validate:"omitempty,is_valid_cron_rule" example:"0 0 * * 6"`
 }
 
 // GetGcArtifactRuleRequest ...
@@ -99,13 +117,14 @@ type GetGcArtifactRuleRequest struct {
 
 // GetGcArtifactRuleResponse ...
 type GetGcArtifactRuleResponse struct {
-	IsRunning       bool    `json:"is_running" example:"true"`
-	RetentionDay    int     `json:"retention_day" example:"10"`
-	CronEnabled     bool    `json:"cron_enabled" example:"true"`
-	CronRule        *string `json:"cron_rule,omitempty" example:"0 0 * * 6"`
-	CronNextTrigger *string `json:"cron_next_trigger,omitempty" example:"2021-01-01 00:00:00"`
-	CreatedAt       string  `json:"created_at" example:"2006-01-02 15:04:05"`
-	UpdatedAt       string  `json:"updated_at" example:"2006-01-02 15:04:05"`
+	IsRunning            bool    `json:"is_running" example:"true"`
+	RetentionDay         int     `json:"retention_day" example:"10"`
+	ReferrerRetentionDay int     `json:"referrer_retention_day" example:"7"`
+	CronEnabled          bool    `json:"cron_enabled" example:"true"`
+	CronRule             *string `json:"cron_rule,omitempty" example:"0 0 * * 6"`
+	CronNextTrigger      *string `json:"cron_next_trigger,omitempty" example:"2021-01-01 00:00:00"`
+	CreatedAt            string  `json:"created_at" example:"2006-01-02 15:04:05"`
+	UpdatedAt            string  `json:"updated_at" example:"2006-01-02 15:04:05"`
 }
 
 // GetGcArtifactLatestRunnerRequest ...
@@ -115,22 +134,26 @@ type GetGcArtifactLatestRunnerRequest struct {
 
 // GcArtifactRunnerItem ...
 type GcArtifactRunnerItem struct {
-	ID           int64                  `json:"id" example:"1"`
-	Status       enums.TaskCommonStatus `json:"status" example:"Pending"`
-	Message      string                 `json:"message" example:"log"`
-	SuccessCount *int64                 `json:"success_count" example:"1"`
-	FailedCount  *int64                 `json:"failed_count" example:"1"`
-	StartedAt    *string                `json:"started_at" example:"2006-01-02 15:04:05"`
-	EndedAt      *string                `json:"ended_at" example:"2006-01-02 15:04:05"`
-	RawDuration  *int64                 `json:"raw_duration" example:"10"`
-	Duration     *string                `json:"duration" example:"1h"`
-	CreatedAt    string                 `json:"created_at" example:"2006-01-02 15:04:05"`
-	UpdatedAt    string                 `json:"updated_at" example:"2006-01-02 15:04:05"`
+	ID              int64                  `json:"id" example:"1"`
+	Status          enums.TaskCommonStatus `json:"status" example:"Pending"`
+	Message         string                 `json:"message" example:"log"`
+	SuccessCount    *int64                 `json:"success_count" example:"1"`
+	FailedCount     *int64                 `json:"failed_count" example:"1"`
+	DryRun          bool                   `json:"dry_run" example:"false"`
+	ReclaimableSize *int64                 `json:"reclaimable_size" example:"1024"`
+	StartedAt       *string                `json:"started_at" example:"2006-01-02 15:04:05"`
+	EndedAt         *string                `json:"ended_at" example:"2006-01-02 15:04:05"`
+	RawDuration     *int64                 `json:"raw_duration" example:"10"`
+	Duration        *string                `json:"duration" example:"1h"`
+	CreatedAt       string                 `json:"created_at" example:"2006-01-02 15:04:05"`
+	UpdatedAt       string                 `json:"updated_at" example:"2006-01-02 15:04:05"`
 }
 
 // CreateGcArtifactRunnerRequest ...
 type CreateGcArtifactRunnerRequest struct {
 	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"number"`
+
+	DryRun bool `json:"dry_run" example:"false"`
 }
 
 // ListGcArtifactRunnersRequest ...
@@ -161,6 +184,7 @@ type GcArtifactRecordItem struct {
 	ID        int64                `json:"id" example:"1"`
 	Digest    string               `json:"digest" example:"sha256:87508bf3e050b975770b142e62db72eeb345a67d82d36ca166300d8b27e45744"`
 	Status    enums.GcRecordStatus `json:"status" example:"Success"`
+	Size      int64                `json:"size" example:"1024"`
 	Message   string               `json:"message" example:"log"`
 	CreatedAt string               `json:"created_at" example:"2006-01-02 15:04:05"`
 	UpdatedAt string               `json:"updated_at" example:"2006-01-02 15:04:05"`
@@ -204,22 +228,26 @@ type GetGcBlobLatestRunnerRequest struct {
 
 // GcBlobRunnerItem ...
 type GcBlobRunnerItem struct {
-	ID           int64                  `json:"id" example:"1"`
-	Status       enums.TaskCommonStatus `json:"status" example:"Pending"`
-	Message      string                 `json:"message" example:"log"`
-	SuccessCount *int64                 `json:"success_count" example:"1"`
-	FailedCount  *int64                 `json:"failed_count" example:"1"`
-	StartedAt    *string                `json:"started_at" example:"2006-01-02 15:04:05"`
-	EndedAt      *string                `json:"ended_at" example:"2006-01-02 15:04:05"`
-	RawDuration  *int64                 `json:"raw_duration" example:"10"`
-	Duration     *string                `json:"duration" example:"1h"`
-	CreatedAt    string                 `json:"created_at" example:"2006-01-02 15:04:05"`
-	UpdatedAt    string                 `json:"updated_at" example:"2006-01-02 15:04:05"`
+	ID              int64                  `json:"id" example:"1"`
+	Status          enums.TaskCommonStatus `json:"status" example:"Pending"`
+	Message         string                 `json:"message" example:"log"`
+	SuccessCount    *int64                 `json:"success_count" example:"1"`
+	FailedCount     *int64                 `json:"failed_count" example:"1"`
+	DryRun          bool                   `json:"dry_run" example:"false"`
+	ReclaimableSize *int64                 `json:"reclaimable_size" example:"1024"`
+	StartedAt       *string                `json:"started_at" example:"2006-01-02 15:04:05"`
+	EndedAt         *string                `json:"ended_at" example:"2006-01-02 15:04:05"`
+	RawDuration     *int64                 `json:"raw_duration" example:"10"`
+	Duration        *string                `json:"duration" example:"1h"`
+	CreatedAt       string                 `json:"created_at" example:"2006-01-02 15:04:05"`
+	UpdatedAt       string                 `json:"updated_at" example:"2006-01-02 15:04:05"`
 }
 
 // CreateGcBlobRunnerRequest ...
 type CreateGcBlobRunnerRequest struct {
 	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"number"`
+
+	DryRun bool `json:"dry_run" example:"false"`
 }
 
 // ListGcBlobRunnersRequest ...
@@ -250,6 +278,7 @@ type GcBlobRecordItem struct {
 	ID        int64                `json:"id" example:"1"`
 	Digest    string               `json:"digest" example:"sha256:87508bf3e050b975770b142e62db72eeb345a67d82d36ca166300d8b27e45744"`
 	Status    enums.GcRecordStatus `json:"status" example:"Success"`
+	Size      int64                `json:"size" example:"1024"`
 	Message   string               `json:"message" example:"log"`
 	CreatedAt string               `json:"created_at" example:"2006-01-02 15:04:05"`
 	UpdatedAt string               `json:"updated_at" example:"2006-01-02 15:04:05"`
@@ -262,6 +291,101 @@ type GetGcBlobRecordRequest struct {
 	RecordID    int64 `json:"record_id" param:"record_id" validate:"required,number"`
 }
 
+// UpdateGcBlobUploadRuleRequest ...
+type UpdateGcBlobUploadRuleRequest struct {
+	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"number" example:"10"`
+
+	RetentionHour int     `json:"retention_hour" validate:"gte=1,lte=720" example:"24" minimum:"1" maximum:"720"`
+	CronEnabled   bool    `json:"cron_enabled" example:"true"`
+	CronRule      *string `json:"cron_rule,omitempty" validate:"omitempty,is_valid_cron_rule" example:"0 * * * *"`
+}
+
+// GetGcBlobUploadRuleRequest ...
+type GetGcBlobUploadRuleRequest struct {
+	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"number"`
+}
+
+// GetGcBlobUploadRuleResponse ...
+type GetGcBlobUploadRuleResponse struct {
+	RetentionHour   int     `json:"retention_hour" example:"24"`
+	CronEnabled     bool    `json:"cron_enabled" example:"true"`
+	CronRule        *string `json:"cron_rule,omitempty" example:"0 0 * * 6"`
+	CronNextTrigger *string `json:"cron_next_trigger,omitempty" example:"2021-01-01 00:00:00"`
+	CreatedAt       string  `json:"created_at" example:"2006-01-02 15:04:05"`
+	UpdatedAt       string  `json:"updated_at" example:"2006-01-02 15:04:05"`
+}
+
+// GetGcBlobUploadLatestRunnerRequest ...
+type GetGcBlobUploadLatestRunnerRequest struct {
+	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"number"`
+}
+
+// GcBlobUploadRunnerItem ...
+type GcBlobUploadRunnerItem struct {
+	ID              int64                  `json:"id" example:"1"`
+	Status          enums.TaskCommonStatus `json:"status" example:"Pending"`
+	Message         string                 `json:"message" example:"log"`
+	SuccessCount    *int64                 `json:"success_count" example:"1"`
+	FailedCount     *int64                 `json:"failed_count" example:"1"`
+	DryRun          bool                   `json:"dry_run" example:"false"`
+	ReclaimableSize *int64                 `json:"reclaimable_size" example:"1024"`
+	StartedAt       *string                `json:"started_at" example:"2006-01-02 15:04:05"`
+	EndedAt         *string                `json:"ended_at" example:"2006-01-02 15:04:05"`
+	RawDuration     *int64                 `json:"raw_duration" example:"10"`
+	Duration        *string                `json:"duration" example:"1h"`
+	CreatedAt       string                 `json:"created_at" example:"2006-01-02 15:04:05"`
+	UpdatedAt       string                 `json:"updated_at" example:"2006-01-02 15:04:05"`
+}
+
+// CreateGcBlobUploadRunnerRequest ...
+type CreateGcBlobUploadRunnerRequest struct {
+	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"number"`
+
+	DryRun bool `json:"dry_run" example:"false"`
+}
+
+// ListGcBlobUploadRunnersRequest ...
+type ListGcBlobUploadRunnersRequest struct {
+	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"number"`
+
+	Pagination
+	Sortable
+}
+
+// GetGcBlobUploadRunnerRequest ...
+type GetGcBlobUploadRunnerRequest struct {
+	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"number"`
+	RunnerID    int64 `json:"runner_id" param:"runner_id" validate:"required,number"`
+}
+
+// ListGcBlobUploadRecordsRequest ...
+type ListGcBlobUploadRecordsRequest struct {
+	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"number"`
+	RunnerID    int64 `json:"runner_id" param:"runner_id" validate:"required,number"`
+
+	Pagination
+	Sortable
+}
+
+// GcBlobUploadRecordItem ...
+type GcBlobUploadRecordItem struct {
+	ID         int64                `json:"id" example:"1"`
+	UploadID   string               `json:"upload_id" example:"2c2d5e3a-1b1c-4d6e-8f9a-0b1c2d3e4f5a"`
+	Repository string               `json:"repository" example:"library/busybox"`
+	Status     enums.GcRecordStatus `json:"status" example:"Success"`
+	Size       int64                `json:"size" example:"1024"`
+	Message    string               `json:"message" example:"log"`
+	CreatedAt  string               `json:"created_at" example:"2006-01-02 15:04:05"`
+	UpdatedAt  string               `json:"updated_at" example:"2006-01-02 15:04:05"`
+}
+
+// GetGcBlobUploadRecordRequest ...
+type GetGcBlobUploadRecordRequest struct {
+	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"number"`
+	RunnerID    int64 `json:"runner_id" param:"runner_id" validate:"required,number"`
+	RecordID    int64 `json:"record_id" param:"record_id" validate:"required,number"`
+}
+
 // UpdateGcRepositoryRuleRequest ...
 type UpdateGcRepositoryRuleRequest struct {
 	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"number" example:"10"`
@@ -293,22 +417,26 @@ type GetGcRepositoryLatestRunnerRequest struct {
 
 // GcRepositoryRunnerItem ...
 type GcRepositoryRunnerItem struct {
-	ID           int64                  `json:"id" example:"1"`
-	Status       enums.TaskCommonStatus `json:"status" example:"Pending"`
-	Message      string                 `json:"message" example:"log"`
-	SuccessCount *int64                 `json:"success_count" example:"1"`
-	FailedCount  *int64                 `json:"failed_count" example:"1"`
-	StartedAt    *string                `json:"started_at" example:"2006-01-02 15:04:05"`
-	EndedAt      *string                `json:"ended_at" example:"2006-01-02 15:04:05"`
-	RawDuration  *int64                 `json:"raw_duration" example:"10"`
-	Duration     *string                `json:"duration" example:"1h"`
-	CreatedAt    string                 `json:"created_at" example:"2006-01-02 15:04:05"`
-	UpdatedAt    string                 `json:"updated_at" example:"2006-01-02 15:04:05"`
+	ID              int64                  `json:"id" example:"1"`
+	Status          enums.TaskCommonStatus `json:"status" example:"Pending"`
+	Message         string                 `json:"message" example:"log"`
+	SuccessCount    *int64                 `json:"success_count" example:"1"`
+	FailedCount     *int64                 `json:"failed_count" example:"1"`
+	DryRun          bool                   `json:"dry_run" example:"false"`
+	ReclaimableSize *int64                 `json:"reclaimable_size" example:"1024"`
+	StartedAt       *string                `json:"started_at" example:"2006-01-02 15:04:05"`
+	EndedAt         *string                `json:"ended_at" example:"2006-01-02 15:04:05"`
+	RawDuration     *int64                 `json:"raw_duration" example:"10"`
+	Duration        *string                `json:"duration" example:"1h"`
+	CreatedAt       string                 `json:"created_at" example:"2006-01-02 15:04:05"`
+	UpdatedAt       string                 `json:"updated_at" example:"2006-01-02 15:04:05"`
 }
 
 // CreateGcRepositoryRunnerRequest ...
 type CreateGcRepositoryRunnerRequest struct {
 	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"number"`
+
+	DryRun bool `json:"dry_run" example:"false"`
 }
 
 // ListGcRepositoryRunnersRequest ...
@@ -339,6 +467,7 @@ type GcRepositoryRecordItem struct {
 	ID         int64                `json:"id" example:"1"`
 	Repository string               `json:"repository" example:"library/busybox"`
 	Status     enums.GcRecordStatus `json:"status" example:"Success"`
+	Size       int64                `json:"size" example:"1024"`
 	Message    string               `json:"message" example:"log"`
 	CreatedAt  string               `json:"created_at" example:"2006-01-02 15:04:05"`
 	UpdatedAt  string               `json:"updated_at" example:"2006-01-02 15:04:05"`
@@ -360,6 +489,20 @@ type UpdateGcTagRuleRequest struct {
 	RetentionRuleType   enums.RetentionRuleType `json:"retention_rule_type" validate:"is_valid_retention_rule_type" example:"Day"`
 	RetentionRuleAmount int64                   `json:"retention_rule_amount" validate:"number,gte=1,lte=180" example:"1"  minimum:"1" maximum:"180"`
 	RetentionPattern    *string                 `json:"retention_pattern,omitempty" validate:"omitempty,is_valid_retention_pattern" example:"v*,1.*"`
+	RetentionRules      []TagRetentionRule      `json:"retention_rules,omitempty" validate:"omitempty,max=32,dive"`
+}
+
+// TagRetentionRule the retention rule of the gc tag rule, the tag retained by any of the rules is kept.
+// The rules only take effect on the repositories matched by the repository pattern,
+// the tags and the untagged artifacts which are not retained by any of the rules will be deleted.
+// The patterns are the regular expressions separated by comma, the whole name should be matched.
+type TagRetentionRule struct {
+	RepositoryPattern *string                `json:"repository_pattern,omitempty" validate:"omitempty,is_valid_retention_pattern" example:"library/.*"`
+	TagInclude        *string                `json:"tag_include,omitempty" validate:"omitempty,is_valid_retention_pattern" example:"main-.*"`
+	TagExclude        *string                `json:"tag_exclude,omitempty" validate:"omitempty,is_valid_retention_pattern" example:"main-tmp-.*"`
+	Untagged          bool                   `json:"untagged" example:"false"`
+	Keep              enums.TagRetentionKeep `json:"keep" validate:"is_valid_tag_retention_keep" example:"LastPushed"`
+	Amount            int64                  `json:"amount,omitempty" validate:"required_unless=Keep All,gte=0,lte=10000" example:"10" minimum:"0" maximum:"10000"`
 }
 
 // GetGcTagRuleRequest ...
@@ -375,6 +518,7 @@ type GetGcTagRuleResponse struct {
 	RetentionRuleType   enums.RetentionRuleType `json:"retention_rule_type,omitempty" example:"Day"`
 	RetentionRuleAmount int64                   `json:"retention_rule_amount,omitempty"  example:"1"`
 	RetentionPattern    *string                 `json:"retention_pattern,omitempty" example:"v*,1.*"`
+	RetentionRules      []TagRetentionRule      `json:"retention_rules"`
 	CreatedAt           string                  `json:"created_at" example:"2006-01-02 15:04:05"`
 	UpdatedAt           string                  `json:"updated_at" example:"2006-01-02 15:04:05"`
 }
@@ -386,22 +530,26 @@ type GetGcTagLatestRunnerRequest struct {
 
 // GcTagRunnerItem ...
 type GcTagRunnerItem struct {
-	ID           int64                  `json:"id" example:"1"`
-	Status       enums.TaskCommonStatus `json:"status" example:"Pending"`
-	Message      string                 `json:"message" example:"log"`
-	SuccessCount *int64                 `json:"success_count" example:"1"`
-	FailedCount  *int64                 `json:"failed_count" example:"1"`
-	StartedAt    *string                `json:"started_at" example:"2006-01-02 15:04:05"`
-	EndedAt      *string                `json:"ended_at" example:"2006-01-02 15:04:05"`
-	RawDuration  *int64                 `json:"raw_duration" example:"10"`
-	Duration     *string                `json:"duration" example:"1h"`
-	CreatedAt    string                 `json:"created_at" example:"2006-01-02 15:04:05"`
-	UpdatedAt    string                 `json:"updated_at" example:"2006-01-02 15:04:05"`
+	ID              int64                  `json:"id" example:"1"`
+	Status          enums.TaskCommonStatus `json:"status" example:"Pending"`
+	Message         string                 `json:"message" example:"log"`
+	SuccessCount    *int64                 `json:"success_count" example:"1"`
+	FailedCount     *int64                 `json:"failed_count" example:"1"`
+	DryRun          bool                   `json:"dry_run" example:"false"`
+	ReclaimableSize *int64                 `json:"reclaimable_size" example:"1024"`
+	StartedAt       *string                `json:"started_at" example:"2006-01-02 15:04:05"`
+	EndedAt         *string                `json:"ended_at" example:"2006-01-02 15:04:05"`
+	RawDuration     *int64                 `json:"raw_duration" example:"10"`
+	Duration        *string                `json:"duration" example:"1h"`
+	CreatedAt       string                 `json:"created_at" example:"2006-01-02 15:04:05"`
+	UpdatedAt       string                 `json:"updated_at" example:"2006-01-02 15:04:05"`
 }
 
 // CreateGcTagRunnerRequest ...
 type CreateGcTagRunnerRequest struct {
 	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"number"`
+
+	DryRun bool `json:"dry_run" example:"false"`
 }
 
 // ListGcTagRunnersRequest ...
@@ -432,6 +580,7 @@ type GcTagRecordItem struct {
 	ID        int64                `json:"id" example:"1"`
 	Tag       string               `json:"digest" example:"sha256:87508bf3e050b975770b142e62db72eeb345a67d82d36ca166300d8b27e45744"`
 	Status    enums.GcRecordStatus `json:"status" example:"Success"`
+	Size      int64                `json:"size" example:"1024"`
 	Message   string               `json:"message" example:"log"`
 	CreatedAt string               `json:"created_at" example:"2006-01-02 15:04:05"`
 	UpdatedAt string               `json:"updated_at" example:"2006-01-02 15:04:05"`
@@ -443,3 +592,80 @@ type GetGcTagRecordRequest struct {
 	RunnerID    int64 `json:"runner_id" param:"runner_id" validate:"required,number"`
 	RecordID    int64 `json:"record_id" param:"record_id" validate:"required,number"`
 }
+
+// UpdateGcPipelineRuleRequest ...
+type UpdateGcPipelineRuleRequest struct {
+	CronEnabled bool    `json:"cron_enabled" example:"true"`
+	CronRule    *string `json:"cron_rule,omitempty" validate:"omitempty,is_valid_cron_rule" example:"0 0 * * 6"`
+}
+
+// GetGcPipelineRuleResponse ...
+type GetGcPipelineRuleResponse struct {
+	CronEnabled     bool    `json:"cron_enabled" example:"true"`
+	CronRule        *string `json:"cron_rule,omitempty" example:"0 0 * * 6"`
+	CronNextTrigger *string `json:"cron_next_trigger,omitempty" example:"2021-01-01 00:00:00"`
+	CreatedAt       string  `json:"created_at" example:"2006-01-02 15:04:05"`
+	UpdatedAt       string  `json:"updated_at" example:"2006-01-02 15:04:05"`
+}
+
+// GcPipelineReportStage is the reclaimed count and size of one gc stage
+type GcPipelineReportStage struct {
+	Count int64 `json:"count" example:"1"`
+	Size  int64 `json:"size" example:"1024"`
+}
+
+// GcPipelineReportNamespaceStage is the deleted count of one gc stage in the namespace,
+// the reclaimable size is the size of the blobs left unreferenced, they are freed by the blob stage
+type GcPipelineReportNamespaceStage struct {
+	Count           int64 `json:"count" example:"1"`
+	ReclaimableSize int64 `json:"reclaimable_size" example:"1024"`
+}
+
+// GcPipelineReportNamespace is the deleted count and reclaimable size of the namespace in the gc pipeline
+type GcPipelineReportNamespace struct {
+	NamespaceID int64                          `json:"namespace_id" example:"1"`
+	Namespace   string                         `json:"namespace" example:"library"`
+	Repository  GcPipelineReportNamespaceStage `json:"repository"`
+	Tag         GcPipelineReportNamespaceStage `json:"tag"`
+	Artifact    GcPipelineReportNamespaceStage `json:"artifact"`
+}
+
+// GcPipelineReport is the report of the gc pipeline runner, blobs are shared between namespaces so it is not split by namespace,
+// the size of the blob stage is the size actually freed in the storage
+type GcPipelineReport struct {
+	Namespaces []GcPipelineReportNamespace `json:"namespaces"`
+	BlobUpload GcPipelineReportStage       `json:"blob_upload"`
+	Blob       GcPipelineReportStage       `json:"blob"`
+}
+
+// GcPipelineRunnerItem ...
+type GcPipelineRunnerItem struct {
+	ID                 int64                  `json:"id" example:"1"`
+	Status             enums.TaskCommonStatus `json:"status" example:"Pending"`
+	Stage              *enums.Daemon          `json:"stage,omitempty" example:"GcTag"`
+	Message            string                 `json:"message" example:"log"`
+	OperateType        enums.OperateType      `json:"operate_type" example:"Manual"`
+	RepositoryRunnerID *int64                 `json:"repository_runner_id,omitempty" example:"1"`
+	TagRunnerID        *int64                 `json:"tag_runner_id,omitempty" example:"1"`
+	ArtifactRunnerID   *int64                 `json:"artifact_runner_id,omitempty" example:"1"`
+	BlobUploadRunnerID *int64                 `json:"blob_upload_runner_id,omitempty" example:"1"`
+	BlobRunnerID       *int64                 `json:"blob_runner_id,omitempty" example:"1"`
+	Report             *GcPipelineReport      `json:"report,omitempty"`
+	StartedAt          *string                `json:"started_at" example:"2006-01-02 15:04:05"`
+	EndedAt            *string                `json:"ended_at" example:"2006-01-02 15:04:05"`
+	RawDuration        *int64                 `json:"raw_duration" example:"10"`
+	Duration           *string                `json:"duration" example:"1h"`
+	CreatedAt          string                 `json:"created_at" example:"2006-01-02 15:04:05"`
+	UpdatedAt          string                 `json:"updated_at" example:"2006-01-02 15:04:05"`
+}
+
+// ListGcPipelineRunnersRequest ...
+type ListGcPipelineRunnersRequest struct {
+	Pagination
+	Sortable
+}
+
+// GetGcPipelineRunnerRequest ...
+type GetGcPipelineRunnerRequest struct {
+	RunnerID int64 `json:"runner_id" param:"runner_id" validate:"required,number"`
+}
@@ -44,6 +44,7 @@ type TaskCommonStatus string
 // GcRecordStatus x ENUM(
 // Success,
 // Failed,
+// Planned,
 // )
 type GcRecordStatus string
 
@@ -92,19 +93,30 @@ type WorkQueueType string
 // )
 type LockerType string
 
+// QuotaKind x ENUM(
+// Size,
+// Tag,
+// Repository,
+// )
+type QuotaKind string
+
 // Daemon x ENUM(
 // Vulnerability,
 // Sbom,
+// Secret,
+// Misconfiguration,
 // Gc,
 // GcRepository,
 // GcArtifact,
 // GcBlob,
 // GcTag,
+// GcBlobUpload,
 // Webhook,
 // Builder,
 // CodeRepository,
 // TagPushed,
 // ArtifactPushed,
+// Quota,
 // )
 type Daemon string
 
@@ -153,6 +165,7 @@ type ArtifactType string
 // Delete,
 // Pull,
 // Push,
+// Restore,
 // )
 type AuditAction string
 
@@ -163,6 +176,8 @@ type AuditAction string
 // Tag,
 // Webhook,
 // Builder,
+// Artifact,
+// Quota,
 // )
 type AuditResourceType string
 
@@ -181,6 +196,11 @@ type AuditResourceType string
 // DaemonTaskGcTagRunner,
 // DaemonTaskGcArtifactRunner,
 // DaemonTaskGcBlobRunner,
+// VulnerabilityAllowlist,
+// DaemonTaskGcPipelineRunner,
+// DaemonTaskGcBlobUploadRule,
+// DaemonTaskGcBlobUploadRunner,
+// Quota,
 // )
 type WebhookResourceType string
 
@@ -194,6 +214,9 @@ type WebhookResourceType string
 // Started,
 // Doing,
 // Finished,
+// Expired,
+// Violated,
+// Reached,
 // )
 type WebhookAction string
 
@@ -262,11 +285,13 @@ type StorageType string
 // BuilderType x ENUM(
 // docker,
 // kubernetes,
+// buildkit,
 // )
 type BuilderType string
 
 // SigningType x ENUM(
 // cosign,
+// notation,
 // )
 type SigningType string
 
@@ -292,6 +317,14 @@ type UserRole string
 // )
 type RetentionRuleType string
 
+// TagRetentionKeep x ENUM(
+// All,
+// LastPushed,
+// PulledWithin,
+// NewestSemver,
+// )
+type TagRetentionKeep string
+
 // NamespaceRole x ENUM(
 // Admin="NamespaceAdmin",
 // Manager="NamespaceManager",
@@ -311,3 +344,46 @@ type Auth string
 // Automatic,
 // )
 type OperateType string
+
+// VulnerabilitySeverity x ENUM(
+// None,
+// Low,
+// Medium,
+// High,
+// Critical,
+// )
+type VulnerabilitySeverity string
+
+// VulnerabilityAllowlistScope x ENUM(
+// System,
+// Namespace,
+// Repository,
+// )
+type VulnerabilityAllowlistScope string
+
+// VulnerabilityReportFormat x ENUM(
+// sarif,
+// csv,
+// cyclonedx-vex,
+// )
+type VulnerabilityReportFormat string
+
+// SbomFormat x ENUM(
+// spdx-json,
+// cyclonedx-json,
+// )
+type SbomFormat string
+
+// LicenseCheckStatus x ENUM(
+// Passed,
+// Warning,
+// Violated,
+// )
+type LicenseCheckStatus string
+
+// ScannerType x ENUM(
+// trivy,
+// grype,
+// harbor,
+// )
+type ScannerType string
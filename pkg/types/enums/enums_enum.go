@@ -127,6 +127,8 @@ const (
 	AuditActionPull AuditAction = "Pull"
 	// AuditActionPush is a AuditAction of type Push.
 	AuditActionPush AuditAction = "Push"
+	// AuditActionRestore is a AuditAction of type Restore.
+	AuditActionRestore AuditAction = "Restore"
 )
 
 var ErrInvalidAuditAction = errors.New("not a valid AuditAction")
@@ -144,11 +146,12 @@ func (x AuditAction) IsValid() bool {
 }
 
 var _AuditActionValue = map[string]AuditAction{
-	"Create": AuditActionCreate,
-	"Update": AuditActionUpdate,
-	"Delete": AuditActionDelete,
-	"Pull":   AuditActionPull,
-	"Push":   AuditActionPush,
+	"Create":  AuditActionCreate,
+	"Update":  AuditActionUpdate,
+	"Delete":  AuditActionDelete,
+	"Pull":    AuditActionPull,
+	"Push":    AuditActionPush,
+	"Restore": AuditActionRestore,
 }
 
 // ParseAuditAction attempts to convert a string to a AuditAction.
@@ -221,6 +224,10 @@ const (
 	AuditResourceTypeWebhook AuditResourceType = "Webhook"
 	// AuditResourceTypeBuilder is a AuditResourceType of type Builder.
 	AuditResourceTypeBuilder AuditResourceType = "Builder"
+	// AuditResourceTypeArtifact is a AuditResourceType of type Artifact.
+	AuditResourceTypeArtifact AuditResourceType = "Artifact"
+	// AuditResourceTypeQuota is a AuditResourceType of type Quota.
+	AuditResourceTypeQuota AuditResourceType = "Quota"
 )
 
 var ErrInvalidAuditResourceType = errors.New("not a valid AuditResourceType")
@@ -244,6 +251,8 @@ var _AuditResourceTypeValue = map[string]AuditResourceType{
 	"Tag":             AuditResourceTypeTag,
 	"Webhook":         AuditResourceTypeWebhook,
 	"Builder":         AuditResourceTypeBuilder,
+	"Artifact":        AuditResourceTypeArtifact,
+	"Quota":           AuditResourceTypeQuota,
 }
 
 // ParseAuditResourceType attempts to convert a string to a AuditResourceType.
@@ -578,6 +587,8 @@ const (
 	BuilderTypeDocker BuilderType = "docker"
 	// BuilderTypeKubernetes is a BuilderType of type kubernetes.
 	BuilderTypeKubernetes BuilderType = "kubernetes"
+	// BuilderTypeBuildkit is a BuilderType of type buildkit.
+	BuilderTypeBuildkit BuilderType = "buildkit"
 )
 
 var ErrInvalidBuilderType = errors.New("not a valid BuilderType")
@@ -597,6 +608,7 @@ func (x BuilderType) IsValid() bool {
 var _BuilderTypeValue = map[string]BuilderType{
 	"docker":     BuilderTypeDocker,
 	"kubernetes": BuilderTypeKubernetes,
+	"buildkit":   BuilderTypeBuildkit,
 }
 
 // ParseBuilderType attempts to convert a string to a BuilderType.
@@ -747,6 +759,10 @@ const (
 	DaemonVulnerability Daemon = "Vulnerability"
 	// DaemonSbom is a Daemon of type Sbom.
 	DaemonSbom Daemon = "Sbom"
+	// DaemonSecret is a Daemon of type Secret.
+	DaemonSecret Daemon = "Secret"
+	// DaemonMisconfiguration is a Daemon of type Misconfiguration.
+	DaemonMisconfiguration Daemon = "Misconfiguration"
 	// DaemonGc is a Daemon of type Gc.
 	DaemonGc Daemon = "Gc"
 	// DaemonGcRepository is a Daemon of type GcRepository.
@@ -757,6 +773,8 @@ const (
 	DaemonGcBlob Daemon = "GcBlob"
 	// DaemonGcTag is a Daemon of type GcTag.
 	DaemonGcTag Daemon = "GcTag"
+	// DaemonGcBlobUpload is a Daemon of type GcBlobUpload.
+	DaemonGcBlobUpload Daemon = "GcBlobUpload"
 	// DaemonWebhook is a Daemon of type Webhook.
 	DaemonWebhook Daemon = "Webhook"
 	// DaemonBuilder is a Daemon of type Builder.
@@ -767,6 +785,8 @@ const (
 	DaemonTagPushed Daemon = "TagPushed"
 	// DaemonArtifactPushed is a Daemon of type ArtifactPushed.
 	DaemonArtifactPushed Daemon = "ArtifactPushed"
+	// DaemonQuota is a Daemon of type Quota.
+	DaemonQuota Daemon = "Quota"
 )
 
 var ErrInvalidDaemon = errors.New("not a valid Daemon")
@@ -784,18 +804,22 @@ func (x Daemon) IsValid() bool {
 }
 
 var _DaemonValue = map[string]Daemon{
-	"Vulnerability":  DaemonVulnerability,
-	"Sbom":           DaemonSbom,
-	"Gc":             DaemonGc,
-	"GcRepository":   DaemonGcRepository,
-	"GcArtifact":     DaemonGcArtifact,
-	"GcBlob":         DaemonGcBlob,
-	"GcTag":          DaemonGcTag,
-	"Webhook":        DaemonWebhook,
-	"Builder":        DaemonBuilder,
-	"CodeRepository": DaemonCodeRepository,
-	"TagPushed":      DaemonTagPushed,
-	"ArtifactPushed": DaemonArtifactPushed,
+	"Vulnerability":    DaemonVulnerability,
+	"Sbom":             DaemonSbom,
+	"Secret":           DaemonSecret,
+	"Misconfiguration": DaemonMisconfiguration,
+	"Gc":               DaemonGc,
+	"GcRepository":     DaemonGcRepository,
+	"GcArtifact":       DaemonGcArtifact,
+	"GcBlob":           DaemonGcBlob,
+	"GcTag":            DaemonGcTag,
+	"GcBlobUpload":     DaemonGcBlobUpload,
+	"Webhook":          DaemonWebhook,
+	"Builder":          DaemonBuilder,
+	"CodeRepository":   DaemonCodeRepository,
+	"TagPushed":        DaemonTagPushed,
+	"ArtifactPushed":   DaemonArtifactPushed,
+	"Quota":            DaemonQuota,
 }
 
 // ParseDaemon attempts to convert a string to a Daemon.
@@ -1115,6 +1139,8 @@ const (
 	GcRecordStatusSuccess GcRecordStatus = "Success"
 	// GcRecordStatusFailed is a GcRecordStatus of type Failed.
 	GcRecordStatusFailed GcRecordStatus = "Failed"
+	// GcRecordStatusPlanned is a GcRecordStatus of type Planned.
+	GcRecordStatusPlanned GcRecordStatus = "Planned"
 )
 
 var ErrInvalidGcRecordStatus = errors.New("not a valid GcRecordStatus")
@@ -1134,6 +1160,7 @@ func (x GcRecordStatus) IsValid() bool {
 var _GcRecordStatusValue = map[string]GcRecordStatus{
 	"Success": GcRecordStatusSuccess,
 	"Failed":  GcRecordStatusFailed,
+	"Planned": GcRecordStatusPlanned,
 }
 
 // ParseGcRecordStatus attempts to convert a string to a GcRecordStatus.
@@ -1276,6 +1303,92 @@ func (x GcTarget) Value() (driver.Value, error) {
 	return x.String(), nil
 }
 
+const (
+	// LicenseCheckStatusPassed is a LicenseCheckStatus of type Passed.
+	LicenseCheckStatusPassed LicenseCheckStatus = "Passed"
+	// LicenseCheckStatusWarning is a LicenseCheckStatus of type Warning.
+	LicenseCheckStatusWarning LicenseCheckStatus = "Warning"
+	// LicenseCheckStatusViolated is a LicenseCheckStatus of type Violated.
+	LicenseCheckStatusViolated LicenseCheckStatus = "Violated"
+)
+
+var ErrInvalidLicenseCheckStatus = errors.New("not a valid LicenseCheckStatus")
+
+// String implements the Stringer interface.
+func (x LicenseCheckStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x LicenseCheckStatus) IsValid() bool {
+	_, err := ParseLicenseCheckStatus(string(x))
+	return err == nil
+}
+
+var _LicenseCheckStatusValue = map[string]LicenseCheckStatus{
+	"Passed":   LicenseCheckStatusPassed,
+	"Warning":  LicenseCheckStatusWarning,
+	"Violated": LicenseCheckStatusViolated,
+}
+
+// ParseLicenseCheckStatus attempts to convert a string to a LicenseCheckStatus.
+func ParseLicenseCheckStatus(name string) (LicenseCheckStatus, error) {
+	if x, ok := _LicenseCheckStatusValue[name]; ok {
+		return x, nil
+	}
+	return LicenseCheckStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidLicenseCheckStatus)
+}
+
+// MustParseLicenseCheckStatus converts a string to a LicenseCheckStatus, and panics if is not valid.
+func MustParseLicenseCheckStatus(name string) LicenseCheckStatus {
+	val, err := ParseLicenseCheckStatus(name)
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+var errLicenseCheckStatusNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *LicenseCheckStatus) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = LicenseCheckStatus("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseLicenseCheckStatus(v)
+	case []byte:
+		*x, err = ParseLicenseCheckStatus(string(v))
+	case LicenseCheckStatus:
+		*x = v
+	case *LicenseCheckStatus:
+		if v == nil {
+			return errLicenseCheckStatusNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errLicenseCheckStatusNilPtr
+		}
+		*x, err = ParseLicenseCheckStatus(*v)
+	default:
+		return errors.New("invalid type for LicenseCheckStatus")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x LicenseCheckStatus) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
 const (
 	// LockerTypeRedis is a LockerType of type redis.
 	LockerTypeRedis LockerType = "redis"
@@ -1828,6 +1941,92 @@ func (x Provider) Value() (driver.Value, error) {
 	return x.String(), nil
 }
 
+const (
+	// QuotaKindSize is a QuotaKind of type Size.
+	QuotaKindSize QuotaKind = "Size"
+	// QuotaKindTag is a QuotaKind of type Tag.
+	QuotaKindTag QuotaKind = "Tag"
+	// QuotaKindRepository is a QuotaKind of type Repository.
+	QuotaKindRepository QuotaKind = "Repository"
+)
+
+var ErrInvalidQuotaKind = errors.New("not a valid QuotaKind")
+
+// String implements the Stringer interface.
+func (x QuotaKind) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x QuotaKind) IsValid() bool {
+	_, err := ParseQuotaKind(string(x))
+	return err == nil
+}
+
+var _QuotaKindValue = map[string]QuotaKind{
+	"Size":       QuotaKindSize,
+	"Tag":        QuotaKindTag,
+	"Repository": QuotaKindRepository,
+}
+
+// ParseQuotaKind attempts to convert a string to a QuotaKind.
+func ParseQuotaKind(name string) (QuotaKind, error) {
+	if x, ok := _QuotaKindValue[name]; ok {
+		return x, nil
+	}
+	return QuotaKind(""), fmt.Errorf("%s is %w", name, ErrInvalidQuotaKind)
+}
+
+// MustParseQuotaKind converts a string to a QuotaKind, and panics if is not valid.
+func MustParseQuotaKind(name string) QuotaKind {
+	val, err := ParseQuotaKind(name)
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+var errQuotaKindNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *QuotaKind) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = QuotaKind("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseQuotaKind(v)
+	case []byte:
+		*x, err = ParseQuotaKind(string(v))
+	case QuotaKind:
+		*x = v
+	case *QuotaKind:
+		if v == nil {
+			return errQuotaKindNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errQuotaKindNilPtr
+		}
+		*x, err = ParseQuotaKind(*v)
+	default:
+		return errors.New("invalid type for QuotaKind")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x QuotaKind) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
 const (
 	// RedisTypeNone is a RedisType of type none.
 	RedisTypeNone RedisType = "none"
@@ -1994,6 +2193,175 @@ func (x RetentionRuleType) Value() (driver.Value, error) {
 	return x.String(), nil
 }
 
+const (
+	// SbomFormatSpdxJson is a SbomFormat of type spdx-json.
+	SbomFormatSpdxJson SbomFormat = "spdx-json"
+	// SbomFormatCyclonedxJson is a SbomFormat of type cyclonedx-json.
+	SbomFormatCyclonedxJson SbomFormat = "cyclonedx-json"
+)
+
+var ErrInvalidSbomFormat = errors.New("not a valid SbomFormat")
+
+// String implements the Stringer interface.
+func (x SbomFormat) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x SbomFormat) IsValid() bool {
+	_, err := ParseSbomFormat(string(x))
+	return err == nil
+}
+
+var _SbomFormatValue = map[string]SbomFormat{
+	"spdx-json":      SbomFormatSpdxJson,
+	"cyclonedx-json": SbomFormatCyclonedxJson,
+}
+
+// ParseSbomFormat attempts to convert a string to a SbomFormat.
+func ParseSbomFormat(name string) (SbomFormat, error) {
+	if x, ok := _SbomFormatValue[name]; ok {
+		return x, nil
+	}
+	return SbomFormat(""), fmt.Errorf("%s is %w", name, ErrInvalidSbomFormat)
+}
+
+// MustParseSbomFormat converts a string to a SbomFormat, and panics if is not valid.
+func MustParseSbomFormat(name string) SbomFormat {
+	val, err := ParseSbomFormat(name)
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+var errSbomFormatNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *SbomFormat) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = SbomFormat("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseSbomFormat(v)
+	case []byte:
+		*x, err = ParseSbomFormat(string(v))
+	case SbomFormat:
+		*x = v
+	case *SbomFormat:
+		if v == nil {
+			return errSbomFormatNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errSbomFormatNilPtr
+		}
+		*x, err = ParseSbomFormat(*v)
+	default:
+		return errors.New("invalid type for SbomFormat")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x SbomFormat) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+const (
+	// ScannerTypeTrivy is a ScannerType of type trivy.
+	ScannerTypeTrivy ScannerType = "trivy"
+	// ScannerTypeGrype is a ScannerType of type grype.
+	ScannerTypeGrype ScannerType = "grype"
+	// ScannerTypeHarbor is a ScannerType of type harbor.
+	ScannerTypeHarbor ScannerType = "harbor"
+)
+
+var ErrInvalidScannerType = errors.New("not a valid ScannerType")
+
+// String implements the Stringer interface.
+func (x ScannerType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ScannerType) IsValid() bool {
+	_, err := ParseScannerType(string(x))
+	return err == nil
+}
+
+var _ScannerTypeValue = map[string]ScannerType{
+	"trivy":  ScannerTypeTrivy,
+	"grype":  ScannerTypeGrype,
+	"harbor": ScannerTypeHarbor,
+}
+
+// ParseScannerType attempts to convert a string to a ScannerType.
+func ParseScannerType(name string) (ScannerType, error) {
+	if x, ok := _ScannerTypeValue[name]; ok {
+		return x, nil
+	}
+	return ScannerType(""), fmt.Errorf("%s is %w", name, ErrInvalidScannerType)
+}
+
+// MustParseScannerType converts a string to a ScannerType, and panics if is not valid.
+func MustParseScannerType(name string) ScannerType {
+	val, err := ParseScannerType(name)
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+var errScannerTypeNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *ScannerType) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = ScannerType("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseScannerType(v)
+	case []byte:
+		*x, err = ParseScannerType(string(v))
+	case ScannerType:
+		*x = v
+	case *ScannerType:
+		if v == nil {
+			return errScannerTypeNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errScannerTypeNilPtr
+		}
+		*x, err = ParseScannerType(*v)
+	default:
+		return errors.New("invalid type for ScannerType")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x ScannerType) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
 const (
 	// ScmCredentialTypeSsh is a ScmCredentialType of type ssh.
 	ScmCredentialTypeSsh ScmCredentialType = "ssh"
@@ -2175,6 +2543,8 @@ func (x ScmProvider) Value() (driver.Value, error) {
 const (
 	// SigningTypeCosign is a SigningType of type cosign.
 	SigningTypeCosign SigningType = "cosign"
+	// SigningTypeNotation is a SigningType of type notation.
+	SigningTypeNotation SigningType = "notation"
 )
 
 var ErrInvalidSigningType = errors.New("not a valid SigningType")
@@ -2192,7 +2562,8 @@ func (x SigningType) IsValid() bool {
 }
 
 var _SigningTypeValue = map[string]SigningType{
-	"cosign": SigningTypeCosign,
+	"cosign":   SigningTypeCosign,
+	"notation": SigningTypeNotation,
 }
 
 // ParseSigningType attempts to convert a string to a SigningType.
@@ -2427,6 +2798,95 @@ func (x StorageType) Value() (driver.Value, error) {
 	return x.String(), nil
 }
 
+const (
+	// TagRetentionKeepAll is a TagRetentionKeep of type All.
+	TagRetentionKeepAll TagRetentionKeep = "All"
+	// TagRetentionKeepLastPushed is a TagRetentionKeep of type LastPushed.
+	TagRetentionKeepLastPushed TagRetentionKeep = "LastPushed"
+	// TagRetentionKeepPulledWithin is a TagRetentionKeep of type PulledWithin.
+	TagRetentionKeepPulledWithin TagRetentionKeep = "PulledWithin"
+	// TagRetentionKeepNewestSemver is a TagRetentionKeep of type NewestSemver.
+	TagRetentionKeepNewestSemver TagRetentionKeep = "NewestSemver"
+)
+
+var ErrInvalidTagRetentionKeep = errors.New("not a valid TagRetentionKeep")
+
+// String implements the Stringer interface.
+func (x TagRetentionKeep) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x TagRetentionKeep) IsValid() bool {
+	_, err := ParseTagRetentionKeep(string(x))
+	return err == nil
+}
+
+var _TagRetentionKeepValue = map[string]TagRetentionKeep{
+	"All":          TagRetentionKeepAll,
+	"LastPushed":   TagRetentionKeepLastPushed,
+	"PulledWithin": TagRetentionKeepPulledWithin,
+	"NewestSemver": TagRetentionKeepNewestSemver,
+}
+
+// ParseTagRetentionKeep attempts to convert a string to a TagRetentionKeep.
+func ParseTagRetentionKeep(name string) (TagRetentionKeep, error) {
+	if x, ok := _TagRetentionKeepValue[name]; ok {
+		return x, nil
+	}
+	return TagRetentionKeep(""), fmt.Errorf("%s is %w", name, ErrInvalidTagRetentionKeep)
+}
+
+// MustParseTagRetentionKeep converts a string to a TagRetentionKeep, and panics if is not valid.
+func MustParseTagRetentionKeep(name string) TagRetentionKeep {
+	val, err := ParseTagRetentionKeep(name)
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+var errTagRetentionKeepNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *TagRetentionKeep) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = TagRetentionKeep("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseTagRetentionKeep(v)
+	case []byte:
+		*x, err = ParseTagRetentionKeep(string(v))
+	case TagRetentionKeep:
+		*x = v
+	case *TagRetentionKeep:
+		if v == nil {
+			return errTagRetentionKeepNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errTagRetentionKeepNilPtr
+		}
+		*x, err = ParseTagRetentionKeep(*v)
+	default:
+		return errors.New("invalid type for TagRetentionKeep")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x TagRetentionKeep) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
 const (
 	// TaskCommonStatusPending is a TaskCommonStatus of type Pending.
 	TaskCommonStatusPending TaskCommonStatus = "Pending"
@@ -2771,6 +3231,270 @@ func (x Visibility) Value() (driver.Value, error) {
 	return x.String(), nil
 }
 
+const (
+	// VulnerabilityAllowlistScopeSystem is a VulnerabilityAllowlistScope of type System.
+	VulnerabilityAllowlistScopeSystem VulnerabilityAllowlistScope = "System"
+	// VulnerabilityAllowlistScopeNamespace is a VulnerabilityAllowlistScope of type Namespace.
+	VulnerabilityAllowlistScopeNamespace VulnerabilityAllowlistScope = "Namespace"
+	// VulnerabilityAllowlistScopeRepository is a VulnerabilityAllowlistScope of type Repository.
+	VulnerabilityAllowlistScopeRepository VulnerabilityAllowlistScope = "Repository"
+)
+
+var ErrInvalidVulnerabilityAllowlistScope = errors.New("not a valid VulnerabilityAllowlistScope")
+
+// String implements the Stringer interface.
+func (x VulnerabilityAllowlistScope) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x VulnerabilityAllowlistScope) IsValid() bool {
+	_, err := ParseVulnerabilityAllowlistScope(string(x))
+	return err == nil
+}
+
+var _VulnerabilityAllowlistScopeValue = map[string]VulnerabilityAllowlistScope{
+	"System":     VulnerabilityAllowlistScopeSystem,
+	"Namespace":  VulnerabilityAllowlistScopeNamespace,
+	"Repository": VulnerabilityAllowlistScopeRepository,
+}
+
+// ParseVulnerabilityAllowlistScope attempts to convert a string to a VulnerabilityAllowlistScope.
+func ParseVulnerabilityAllowlistScope(name string) (VulnerabilityAllowlistScope, error) {
+	if x, ok := _VulnerabilityAllowlistScopeValue[name]; ok {
+		return x, nil
+	}
+	return VulnerabilityAllowlistScope(""), fmt.Errorf("%s is %w", name, ErrInvalidVulnerabilityAllowlistScope)
+}
+
+// MustParseVulnerabilityAllowlistScope converts a string to a VulnerabilityAllowlistScope, and panics if is not valid.
+func MustParseVulnerabilityAllowlistScope(name string) VulnerabilityAllowlistScope {
+	val, err := ParseVulnerabilityAllowlistScope(name)
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+var errVulnerabilityAllowlistScopeNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *VulnerabilityAllowlistScope) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = VulnerabilityAllowlistScope("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseVulnerabilityAllowlistScope(v)
+	case []byte:
+		*x, err = ParseVulnerabilityAllowlistScope(string(v))
+	case VulnerabilityAllowlistScope:
+		*x = v
+	case *VulnerabilityAllowlistScope:
+		if v == nil {
+			return errVulnerabilityAllowlistScopeNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errVulnerabilityAllowlistScopeNilPtr
+		}
+		*x, err = ParseVulnerabilityAllowlistScope(*v)
+	default:
+		return errors.New("invalid type for VulnerabilityAllowlistScope")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x VulnerabilityAllowlistScope) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+const (
+	// VulnerabilityReportFormatSarif is a VulnerabilityReportFormat of type sarif.
+	VulnerabilityReportFormatSarif VulnerabilityReportFormat = "sarif"
+	// VulnerabilityReportFormatCsv is a VulnerabilityReportFormat of type csv.
+	VulnerabilityReportFormatCsv VulnerabilityReportFormat = "csv"
+	// VulnerabilityReportFormatCyclonedxVex is a VulnerabilityReportFormat of type cyclonedx-vex.
+	VulnerabilityReportFormatCyclonedxVex VulnerabilityReportFormat = "cyclonedx-vex"
+)
+
+var ErrInvalidVulnerabilityReportFormat = errors.New("not a valid VulnerabilityReportFormat")
+
+// String implements the Stringer interface.
+func (x VulnerabilityReportFormat) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x VulnerabilityReportFormat) IsValid() bool {
+	_, err := ParseVulnerabilityReportFormat(string(x))
+	return err == nil
+}
+
+var _VulnerabilityReportFormatValue = map[string]VulnerabilityReportFormat{
+	"sarif":         VulnerabilityReportFormatSarif,
+	"csv":           VulnerabilityReportFormatCsv,
+	"cyclonedx-vex": VulnerabilityReportFormatCyclonedxVex,
+}
+
+// ParseVulnerabilityReportFormat attempts to convert a string to a VulnerabilityReportFormat.
+func ParseVulnerabilityReportFormat(name string) (VulnerabilityReportFormat, error) {
+	if x, ok := _VulnerabilityReportFormatValue[name]; ok {
+		return x, nil
+	}
+	return VulnerabilityReportFormat(""), fmt.Errorf("%s is %w", name, ErrInvalidVulnerabilityReportFormat)
+}
+
+// MustParseVulnerabilityReportFormat converts a string to a VulnerabilityReportFormat, and panics if is not valid.
+func MustParseVulnerabilityReportFormat(name string) VulnerabilityReportFormat {
+	val, err := ParseVulnerabilityReportFormat(name)
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+var errVulnerabilityReportFormatNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *VulnerabilityReportFormat) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = VulnerabilityReportFormat("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseVulnerabilityReportFormat(v)
+	case []byte:
+		*x, err = ParseVulnerabilityReportFormat(string(v))
+	case VulnerabilityReportFormat:
+		*x = v
+	case *VulnerabilityReportFormat:
+		if v == nil {
+			return errVulnerabilityReportFormatNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errVulnerabilityReportFormatNilPtr
+		}
+		*x, err = ParseVulnerabilityReportFormat(*v)
+	default:
+		return errors.New("invalid type for VulnerabilityReportFormat")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x VulnerabilityReportFormat) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+const (
+	// VulnerabilitySeverityNone is a VulnerabilitySeverity of type None.
+	VulnerabilitySeverityNone VulnerabilitySeverity = "None"
+	// VulnerabilitySeverityLow is a VulnerabilitySeverity of type Low.
+	VulnerabilitySeverityLow VulnerabilitySeverity = "Low"
+	// VulnerabilitySeverityMedium is a VulnerabilitySeverity of type Medium.
+	VulnerabilitySeverityMedium VulnerabilitySeverity = "Medium"
+	// VulnerabilitySeverityHigh is a VulnerabilitySeverity of type High.
+	VulnerabilitySeverityHigh VulnerabilitySeverity = "High"
+	// VulnerabilitySeverityCritical is a VulnerabilitySeverity of type Critical.
+	VulnerabilitySeverityCritical VulnerabilitySeverity = "Critical"
+)
+
+var ErrInvalidVulnerabilitySeverity = errors.New("not a valid VulnerabilitySeverity")
+
+// String implements the Stringer interface.
+func (x VulnerabilitySeverity) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x VulnerabilitySeverity) IsValid() bool {
+	_, err := ParseVulnerabilitySeverity(string(x))
+	return err == nil
+}
+
+var _VulnerabilitySeverityValue = map[string]VulnerabilitySeverity{
+	"None":     VulnerabilitySeverityNone,
+	"Low":      VulnerabilitySeverityLow,
+	"Medium":   VulnerabilitySeverityMedium,
+	"High":     VulnerabilitySeverityHigh,
+	"Critical": VulnerabilitySeverityCritical,
+}
+
+// ParseVulnerabilitySeverity attempts to convert a string to a VulnerabilitySeverity.
+func ParseVulnerabilitySeverity(name string) (VulnerabilitySeverity, error) {
+	if x, ok := _VulnerabilitySeverityValue[name]; ok {
+		return x, nil
+	}
+	return VulnerabilitySeverity(""), fmt.Errorf("%s is %w", name, ErrInvalidVulnerabilitySeverity)
+}
+
+// MustParseVulnerabilitySeverity converts a string to a VulnerabilitySeverity, and panics if is not valid.
+func MustParseVulnerabilitySeverity(name string) VulnerabilitySeverity {
+	val, err := ParseVulnerabilitySeverity(name)
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+var errVulnerabilitySeverityNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *VulnerabilitySeverity) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = VulnerabilitySeverity("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseVulnerabilitySeverity(v)
+	case []byte:
+		*x, err = ParseVulnerabilitySeverity(string(v))
+	case VulnerabilitySeverity:
+		*x = v
+	case *VulnerabilitySeverity:
+		if v == nil {
+			return errVulnerabilitySeverityNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errVulnerabilitySeverityNilPtr
+		}
+		*x, err = ParseVulnerabilitySeverity(*v)
+	default:
+		return errors.New("invalid type for VulnerabilitySeverity")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x VulnerabilitySeverity) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
 const (
 	// WebhookActionCreate is a WebhookAction of type Create.
 	WebhookActionCreate WebhookAction = "Create"
@@ -2790,6 +3514,12 @@ const (
 	WebhookActionDoing WebhookAction = "Doing"
 	// WebhookActionFinished is a WebhookAction of type Finished.
 	WebhookActionFinished WebhookAction = "Finished"
+	// WebhookActionExpired is a WebhookAction of type Expired.
+	WebhookActionExpired WebhookAction = "Expired"
+	// WebhookActionViolated is a WebhookAction of type Violated.
+	WebhookActionViolated WebhookAction = "Violated"
+	// WebhookActionReached is a WebhookAction of type Reached.
+	WebhookActionReached WebhookAction = "Reached"
 )
 
 var ErrInvalidWebhookAction = errors.New("not a valid WebhookAction")
@@ -2816,6 +3546,9 @@ var _WebhookActionValue = map[string]WebhookAction{
 	"Started":  WebhookActionStarted,
 	"Doing":    WebhookActionDoing,
 	"Finished": WebhookActionFinished,
+	"Expired":  WebhookActionExpired,
+	"Violated": WebhookActionViolated,
+	"Reached":  WebhookActionReached,
 }
 
 // ParseWebhookAction attempts to convert a string to a WebhookAction.
@@ -2904,6 +3637,16 @@ const (
 	WebhookResourceTypeDaemonTaskGcArtifactRunner WebhookResourceType = "DaemonTaskGcArtifactRunner"
 	// WebhookResourceTypeDaemonTaskGcBlobRunner is a WebhookResourceType of type DaemonTaskGcBlobRunner.
 	WebhookResourceTypeDaemonTaskGcBlobRunner WebhookResourceType = "DaemonTaskGcBlobRunner"
+	// WebhookResourceTypeVulnerabilityAllowlist is a WebhookResourceType of type VulnerabilityAllowlist.
+	WebhookResourceTypeVulnerabilityAllowlist WebhookResourceType = "VulnerabilityAllowlist"
+	// WebhookResourceTypeDaemonTaskGcPipelineRunner is a WebhookResourceType of type DaemonTaskGcPipelineRunner.
+	WebhookResourceTypeDaemonTaskGcPipelineRunner WebhookResourceType = "DaemonTaskGcPipelineRunner"
+	// WebhookResourceTypeDaemonTaskGcBlobUploadRule is a WebhookResourceType of type DaemonTaskGcBlobUploadRule.
+	WebhookResourceTypeDaemonTaskGcBlobUploadRule WebhookResourceType = "DaemonTaskGcBlobUploadRule"
+	// WebhookResourceTypeDaemonTaskGcBlobUploadRunner is a WebhookResourceType of type DaemonTaskGcBlobUploadRunner.
+	WebhookResourceTypeDaemonTaskGcBlobUploadRunner WebhookResourceType = "DaemonTaskGcBlobUploadRunner"
+	// WebhookResourceTypeQuota is a WebhookResourceType of type Quota.
+	WebhookResourceTypeQuota WebhookResourceType = "Quota"
 )
 
 var ErrInvalidWebhookResourceType = errors.New("not a valid WebhookResourceType")
@@ -2935,6 +3678,11 @@ var _WebhookResourceTypeValue = map[string]WebhookResourceType{
 	"DaemonTaskGcTagRunner":        WebhookResourceTypeDaemonTaskGcTagRunner,
 	"DaemonTaskGcArtifactRunner":   WebhookResourceTypeDaemonTaskGcArtifactRunner,
 	"DaemonTaskGcBlobRunner":       WebhookResourceTypeDaemonTaskGcBlobRunner,
+	"VulnerabilityAllowlist":       WebhookResourceTypeVulnerabilityAllowlist,
+	"DaemonTaskGcPipelineRunner":   WebhookResourceTypeDaemonTaskGcPipelineRunner,
+	"DaemonTaskGcBlobUploadRule":   WebhookResourceTypeDaemonTaskGcBlobUploadRule,
+	"DaemonTaskGcBlobUploadRunner": WebhookResourceTypeDaemonTaskGcBlobUploadRunner,
+	"Quota":                        WebhookResourceTypeQuota,
 }
 
 // ParseWebhookResourceType attempts to convert a string to a WebhookResourceType.
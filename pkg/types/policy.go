@@ -0,0 +1,202 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "github.com/go-sigma/sigma/pkg/types/enums"
+
+// GetVulnerabilityPolicyRequest ...
+type GetVulnerabilityPolicyRequest struct {
+	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"required,number" swaggerignore:"true"`
+}
+
+// GetVulnerabilityPolicyResponse ...
+type GetVulnerabilityPolicyResponse struct {
+	Enabled        bool                        `json:"enabled" example:"true"`
+	MaxSeverity    enums.VulnerabilitySeverity `json:"max_severity" example:"High"`
+	BlockUnscanned bool                        `json:"block_unscanned" example:"true"`
+	Exemptions     []string                    `json:"exemptions" example:"library/busybox"`
+	CreatedAt      string                      `json:"created_at" example:"2006-01-02 15:04:05"`
+	UpdatedAt      string                      `json:"updated_at" example:"2006-01-02 15:04:05"`
+}
+
+// UpdateVulnerabilityPolicyRequest ...
+type UpdateVulnerabilityPolicyRequest struct {
+	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"required,number" swaggerignore:"true"`
+
+	Enabled        bool                        `json:"enabled" example:"true"`
+	MaxSeverity    enums.VulnerabilitySeverity `json:"max_severity" validate:"is_valid_severity" example:"High"`
+	BlockUnscanned bool                        `json:"block_unscanned" example:"true"`
+	Exemptions     []string                    `json:"exemptions,omitempty" validate:"omitempty,dive,is_valid_repository" example:"library/busybox"`
+}
+
+// GetLicensePolicyRequest ...
+type GetLicensePolicyRequest struct {
+	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"required,number" swaggerignore:"true"`
+}
+
+// GetLicensePolicyResponse ...
+type GetLicensePolicyResponse struct {
+	Enabled         bool     `json:"enabled" example:"true"`
+	BlockPull       bool     `json:"block_pull" example:"true"`
+	WarnUnknown     bool     `json:"warn_unknown" example:"true"`
+	DeniedLicenses  []string `json:"denied_licenses" example:"AGPL-3.0-only"`
+	AllowedLicenses []string `json:"allowed_licenses" example:"MIT"`
+	Exemptions      []string `json:"exemptions" example:"library/busybox"`
+	CreatedAt       string   `json:"created_at" example:"2006-01-02 15:04:05"`
+	UpdatedAt       string   `json:"updated_at" example:"2006-01-02 15:04:05"`
+}
+
+// UpdateLicensePolicyRequest ...
+type UpdateLicensePolicyRequest struct {
+	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"required,number" swaggerignore:"true"`
+
+	Enabled         bool     `json:"enabled" example:"true"`
+	BlockPull       bool     `json:"block_pull" example:"true"`
+	WarnUnknown     bool     `json:"warn_unknown" example:"true"`
+	DeniedLicenses  []string `json:"denied_licenses,omitempty" validate:"omitempty,dive,required,max=64" example:"AGPL-3.0-only"`
+	AllowedLicenses []string `json:"allowed_licenses,omitempty" validate:"omitempty,dive,required,max=64" example:"MIT"`
+	Exemptions      []string `json:"exemptions,omitempty" validate:"omitempty,dive,is_valid_repository" example:"library/busybox"`
+}
+
+// SignatureIdentity represents the trusted keyless signing identity, both of the issuer and subject are regular expressions.
+type SignatureIdentity struct {
+	Issuer  string `json:"issuer" validate:"required,max=256" example:"https://token.actions.githubusercontent.com"`
+	Subject string `json:"subject" validate:"required,max=256" example:"https://github.com/go-sigma/.*"`
+}
+
+// GetSignaturePolicyRequest ...
+type GetSignaturePolicyRequest struct {
+	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"required,number" swaggerignore:"true"`
+}
+
+// GetSignaturePolicyResponse ...
+type GetSignaturePolicyResponse struct {
+	Enabled            bool                `json:"enabled" example:"true"`
+	PublicKeys         string              `json:"public_keys" example:"-----BEGIN PUBLIC KEY-----"`
+	KeylessIdentities  []SignatureIdentity `json:"keyless_identities"`
+	KeylessRoots       string              `json:"keyless_roots" example:"-----BEGIN CERTIFICATE-----"`
+	NotationRoots      string              `json:"notation_roots" example:"-----BEGIN CERTIFICATE-----"`
+	NotationIdentities []string            `json:"notation_identities" example:"x509.subject: CN=sigma,O=sigma"`
+	Exemptions         []string            `json:"exemptions" example:"library/busybox"`
+	CreatedAt          string              `json:"created_at" example:"2006-01-02 15:04:05"`
+	UpdatedAt          string              `json:"updated_at" example:"2006-01-02 15:04:05"`
+}
+
+// UpdateSignaturePolicyRequest ...
+type UpdateSignaturePolicyRequest struct {
+	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"required,number" swaggerignore:"true"`
+
+	Enabled            bool                `json:"enabled" example:"true"`
+	PublicKeys         string              `json:"public_keys,omitempty" validate:"omitempty,max=65536" example:"-----BEGIN PUBLIC KEY-----"`
+	KeylessIdentities  []SignatureIdentity `json:"keyless_identities,omitempty" validate:"omitempty,dive"`
+	KeylessRoots       string              `json:"keyless_roots,omitempty" validate:"omitempty,max=65536" example:"-----BEGIN CERTIFICATE-----"`
+	NotationRoots      string              `json:"notation_roots,omitempty" validate:"omitempty,max=65536" example:"-----BEGIN CERTIFICATE-----"`
+	NotationIdentities []string            `json:"notation_identities,omitempty" validate:"omitempty,dive,max=256" example:"x509.subject: CN=sigma,O=sigma"`
+	Exemptions         []string            `json:"exemptions,omitempty" validate:"omitempty,dive,is_valid_repository" example:"library/busybox"`
+}
+
+// VulnerabilityAllowlistItem ...
+type VulnerabilityAllowlistItem struct {
+	ID              int64                             `json:"id" example:"1"`
+	Scope           enums.VulnerabilityAllowlistScope `json:"scope" example:"Namespace"`
+	NamespaceID     *int64                            `json:"namespace_id,omitempty" example:"1"`
+	RepositoryID    *int64                            `json:"repository_id,omitempty" example:"1"`
+	VulnerabilityID string                            `json:"vulnerability_id" example:"CVE-2023-5678"`
+	Package         *string                           `json:"package,omitempty" example:"libcrypto3"`
+	Justification   string                            `json:"justification" example:"No fix available"`
+	Creator         string                            `json:"creator" example:"sigma"`
+	ExpiresAt       *string                           `json:"expires_at,omitempty" example:"2006-01-02 15:04:05"`
+	CreatedAt       string                            `json:"created_at" example:"2006-01-02 15:04:05"`
+	UpdatedAt       string                            `json:"updated_at" example:"2006-01-02 15:04:05"`
+}
+
+// ListVulnerabilityAllowlistRequest ...
+type ListVulnerabilityAllowlistRequest struct {
+	Pagination
+	Sortable
+
+	NamespaceID  *int64 `json:"namespace_id,omitempty" query:"namespace_id" validate:"omitempty,numeric" example:"1"`
+	RepositoryID *int64 `json:"repository_id,omitempty" query:"repository_id" validate:"omitempty,numeric" example:"1"`
+}
+
+// PostVulnerabilityAllowlistRequest ...
+type PostVulnerabilityAllowlistRequest struct {
+	NamespaceID     *int64  `json:"namespace_id,omitempty" validate:"omitempty,numeric" example:"1"`
+	RepositoryID    *int64  `json:"repository_id,omitempty" validate:"omitempty,numeric" example:"1"`
+	VulnerabilityID string  `json:"vulnerability_id" validate:"required,max=64" example:"CVE-2023-5678"`
+	Package         *string `json:"package,omitempty" validate:"omitempty,max=256" example:"libcrypto3"`
+	Justification   string  `json:"justification" validate:"required,max=512" example:"No fix available"`
+	ExpiresAt       *int64  `json:"expires_at,omitempty" validate:"omitempty,gt=0" example:"1704038400000"`
+}
+
+// PostVulnerabilityAllowlistResponse ...
+type PostVulnerabilityAllowlistResponse struct {
+	ID int64 `json:"id" example:"1"`
+}
+
+// DeleteVulnerabilityAllowlistRequest ...
+type DeleteVulnerabilityAllowlistRequest struct {
+	ID int64 `json:"id" param:"id" validate:"required,number" example:"1"`
+}
+
+// GetNamespaceVulnerabilityRescanPolicyRequest ...
+type GetNamespaceVulnerabilityRescanPolicyRequest struct {
+	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"required,number" swaggerignore:"true"`
+}
+
+// GetVulnerabilityRescanPolicyResponse ...
+type GetVulnerabilityRescanPolicyResponse struct {
+	Enabled         bool    `json:"enabled" example:"true"`
+	CronRule        string  `json:"cron_rule" example:"0 2 * * *"`
+	PulledWithin    int64   `json:"pulled_within" example:"30"`
+	CronNextTrigger *string `json:"cron_next_trigger,omitempty" example:"2006-01-02 15:04:05"`
+	CreatedAt       string  `json:"created_at" example:"2006-01-02 15:04:05"`
+	UpdatedAt       string  `json:"updated_at" example:"2006-01-02 15:04:05"`
+}
+
+// UpdateVulnerabilityRescanPolicyRequest ...
+type UpdateVulnerabilityRescanPolicyRequest struct {
+	Enabled      bool   `json:"enabled" example:"true"`
+	CronRule     string `json:"cron_rule" validate:"required,max=30" example:"0 2 * * *"`
+	PulledWithin int64  `json:"pulled_within" validate:"gte=1,lte=365" example:"30"`
+}
+
+// UpdateNamespaceVulnerabilityRescanPolicyRequest ...
+type UpdateNamespaceVulnerabilityRescanPolicyRequest struct {
+	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"required,number" swaggerignore:"true"`
+
+	UpdateVulnerabilityRescanPolicyRequest
+}
+
+// GetSigningPolicyRequest ...
+type GetSigningPolicyRequest struct {
+	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"required,number" swaggerignore:"true"`
+}
+
+// GetSigningPolicyResponse ...
+type GetSigningPolicyResponse struct {
+	Enabled    bool   `json:"enabled" example:"true"`
+	TagPattern string `json:"tag_pattern" example:"^v[0-9]+\\.[0-9]+\\.[0-9]+$"`
+	CreatedAt  string `json:"created_at" example:"2006-01-02 15:04:05"`
+	UpdatedAt  string `json:"updated_at" example:"2006-01-02 15:04:05"`
+}
+
+// UpdateSigningPolicyRequest ...
+type UpdateSigningPolicyRequest struct {
+	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"required,number" swaggerignore:"true"`
+
+	Enabled    bool   `json:"enabled" example:"true"`
+	TagPattern string `json:"tag_pattern" validate:"max=256" example:"^v[0-9]+\\.[0-9]+\\.[0-9]+$"`
+}
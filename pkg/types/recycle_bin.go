@@ -0,0 +1,82 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// ListRecycleBinNamespaceRequest represents the request to list the deleted namespaces in the recycle bin.
+type ListRecycleBinNamespaceRequest struct {
+	Pagination
+}
+
+// RestoreRecycleBinNamespaceRequest represents the request to restore the deleted namespace from the recycle bin.
+type RestoreRecycleBinNamespaceRequest struct {
+	ID int64 `json:"id" param:"id" validate:"required,number" swaggerignore:"true"`
+}
+
+// RecycleBinNamespaceItem represents a deleted namespace in the recycle bin.
+type RecycleBinNamespaceItem struct {
+	ID          int64   `json:"id" example:"1"`
+	Name        string  `json:"name" example:"test"`
+	Description *string `json:"description,omitempty" example:"i am just description"`
+
+	DeletedAt string `json:"deleted_at" example:"2006-01-02 15:04:05"`
+	ExpiredAt string `json:"expired_at" example:"2006-01-02 15:04:05"`
+}
+
+// ListRecycleBinRequest represents the request to list the deleted repositories, tags or artifacts of the namespace in the recycle bin.
+type ListRecycleBinRequest struct {
+	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"required,number" swaggerignore:"true"`
+
+	Pagination
+}
+
+// RestoreRecycleBinRequest represents the request to restore the deleted repository, tag or artifact of the namespace from the recycle bin.
+type RestoreRecycleBinRequest struct {
+	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"required,number" swaggerignore:"true"`
+	ID          int64 `json:"id" param:"id" validate:"required,number" swaggerignore:"true"`
+}
+
+// RecycleBinRepositoryItem represents a deleted repository in the recycle bin.
+type RecycleBinRepositoryItem struct {
+	ID          int64   `json:"id" example:"1"`
+	Name        string  `json:"name" example:"library/busybox"`
+	Description *string `json:"description,omitempty" example:"i am just description"`
+
+	DeletedAt string `json:"deleted_at" example:"2006-01-02 15:04:05"`
+	ExpiredAt string `json:"expired_at" example:"2006-01-02 15:04:05"`
+}
+
+// RecycleBinTagItem represents a deleted tag in the recycle bin.
+type RecycleBinTagItem struct {
+	ID           int64  `json:"id" example:"1"`
+	Name         string `json:"name" example:"latest"`
+	RepositoryID int64  `json:"repository_id" example:"1"`
+	Repository   string `json:"repository" example:"library/busybox"`
+	Digest       string `json:"digest" example:"sha256:87508bf3e050b975770b142e62db72eeb345a67d82d36ca166300d8b27e45744"`
+
+	DeletedAt string `json:"deleted_at" example:"2006-01-02 15:04:05"`
+	ExpiredAt string `json:"expired_at" example:"2006-01-02 15:04:05"`
+}
+
+// RecycleBinArtifactItem represents a deleted artifact in the recycle bin.
+type RecycleBinArtifactItem struct {
+	ID           int64  `json:"id" example:"1"`
+	RepositoryID int64  `json:"repository_id" example:"1"`
+	Repository   string `json:"repository" example:"library/busybox"`
+	Digest       string `json:"digest" example:"sha256:87508bf3e050b975770b142e62db72eeb345a67d82d36ca166300d8b27e45744"`
+	Size         int64  `json:"size" example:"1234"`
+
+	DeletedAt string `json:"deleted_at" example:"2006-01-02 15:04:05"`
+	ExpiredAt string `json:"expired_at" example:"2006-01-02 15:04:05"`
+}
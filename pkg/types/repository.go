@@ -18,16 +18,17 @@ import "github.com/go-sigma/sigma/pkg/types/enums"
 
 // RepositoryItem represents a repository.
 type RepositoryItem struct {
-	ID          int64            `json:"id" example:"1"`
-	NamespaceID int64            `json:"namespace_id" example:"1"`
-	Name        string           `json:"name" example:"busybox"`
-	Description *string          `json:"description,omitempty" example:"i am just description"`
-	Overview    *string          `json:"overview,omitempty" example:"i am just overview"`
-	Visibility  enums.Visibility `json:"visibility" example:"private"`
-	TagCount    int64            `json:"tag_count" example:"100"`
-	TagLimit    *int64           `json:"tag_limit" example:"1000"`
-	SizeLimit   *int64           `json:"size_limit" example:"10000"`
-	Size        *int64           `json:"size" example:"10000"`
+	ID            int64            `json:"id" example:"1"`
+	NamespaceID   int64            `json:"namespace_id" example:"1"`
+	Name          string           `json:"name" example:"busybox"`
+	Description   *string          `json:"description,omitempty" example:"i am just description"`
+	Overview      *string          `json:"overview,omitempty" example:"i am just overview"`
+	Visibility    enums.Visibility `json:"visibility" example:"private"`
+	TagCount      int64            `json:"tag_count" example:"100"`
+	TagLimit      *int64           `json:"tag_limit" example:"1000"`
+	SizeLimit     *int64           `json:"size_limit" example:"10000"`
+	SizeSoftLimit *int64           `json:"size_soft_limit" example:"8000"`
+	Size          *int64           `json:"size" example:"10000"`
 
 	Builder *BuilderItem `json:"builder"`
 
@@ -61,12 +62,13 @@ type DeleteRepositoryRequest struct {
 type CreateRepositoryRequest struct {
 	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"required" example:"10" swaggerignore:"true"`
 
-	Name        string            `json:"name" validate:"required,is_valid_repository" example:"test"`
-	Description *string           `json:"description,omitempty" validate:"omitempty,max=30" example:"i am just description"`
-	Overview    *string           `json:"overview,omitempty" validate:"omitempty,max=3000" example:"i am just overview"`
-	SizeLimit   *int64            `json:"size_limit,omitempty" validate:"omitempty,numeric" example:"10000"`
-	TagLimit    *int64            `json:"tag_limit,omitempty" validate:"omitempty,numeric" example:"10000"`
-	Visibility  *enums.Visibility `json:"visibility,omitempty" validate:"omitempty,is_valid_visibility" example:"public"`
+	Name          string            `json:"name" validate:"required,is_valid_repository" example:"test"`
+	Description   *string           `json:"description,omitempty" validate:"omitempty,max=30" example:"i am just description"`
+	Overview      *string           `json:"overview,omitempty" validate:"omitempty,max=3000" example:"i am just overview"`
+	SizeLimit     *int64            `json:"size_limit,omitempty" validate:"omitempty,numeric" example:"10000"`
+	SizeSoftLimit *int64            `json:"size_soft_limit,omitempty" validate:"omitempty,numeric" example:"8000"`
+	TagLimit      *int64            `json:"tag_limit,omitempty" validate:"omitempty,numeric" example:"10000"`
+	Visibility    *enums.Visibility `json:"visibility,omitempty" validate:"omitempty,is_valid_visibility" example:"public"`
 }
 
 // CreateRepositoryResponse represents the response to create a repository.
@@ -78,9 +80,10 @@ type CreateRepositoryResponse struct {
 type UpdateRepositoryRequest struct {
 	NamespaceID int64 `json:"namespace_id" param:"namespace_id" validate:"required" example:"10" swaggerignore:"true"`
 
-	ID          int64   `json:"id" param:"id" validate:"required,number" example:"1" swaggerignore:"true"`
-	Description *string `json:"description,omitempty" validate:"omitempty,max=300" example:"i am just description"`
-	Overview    *string `json:"overview,omitempty" validate:"omitempty,max=100000" example:"i am just overview"`
-	SizeLimit   *int64  `json:"size_limit,omitempty" validate:"omitempty,numeric" example:"10000"`
-	TagLimit    *int64  `json:"tag_limit,omitempty" validate:"omitempty,numeric" example:"10000"`
+	ID            int64   `json:"id" param:"id" validate:"required,number" example:"1" swaggerignore:"true"`
+	Description   *string `json:"description,omitempty" validate:"omitempty,max=300" example:"i am just description"`
+	Overview      *string `json:"overview,omitempty" validate:"omitempty,max=100000" example:"i am just overview"`
+	SizeLimit     *int64  `json:"size_limit,omitempty" validate:"omitempty,numeric" example:"10000"`
+	SizeSoftLimit *int64  `json:"size_soft_limit,omitempty" validate:"omitempty,numeric" example:"8000"`
+	TagLimit      *int64  `json:"tag_limit,omitempty" validate:"omitempty,numeric" example:"10000"`
 }
@@ -64,6 +64,25 @@ type UserItem struct {
 	UpdatedAt string `json:"updated_at" example:"2006-01-02 15:04:05"`
 }
 
+// GetUserNamespaceQuotaRequest ...
+type GetUserNamespaceQuotaRequest struct {
+	UserID int64 `param:"id" validate:"required,number" example:"10" swaggerignore:"true"`
+}
+
+// PutUserNamespaceQuotaRequest ...
+type PutUserNamespaceQuotaRequest struct {
+	UserID int64 `param:"id" validate:"required,number" example:"10" swaggerignore:"true"`
+
+	NamespaceLimit *int64 `json:"namespace_limit" validate:"required,min=0" example:"10"`
+}
+
+// UserNamespaceQuotaResponse the namespace count limit of the user, 0 means unlimited,
+// the count is the number of namespaces that the user is the namespace admin of
+type UserNamespaceQuotaResponse struct {
+	NamespaceLimit int64 `json:"namespace_limit" example:"10"`
+	NamespaceCount int64 `json:"namespace_count" example:"2"`
+}
+
 // PostUserLoginRequest ...
 type PostUserLoginRequest struct {
 	Username string `json:"username" validate:"required,is_valid_username,min=2,max=20" example:"sigma"`
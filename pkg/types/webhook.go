@@ -248,6 +248,15 @@ type WebhookPayloadGcBlob struct {
 	FailedCount  int64               `json:"failed_count"`
 }
 
+// WebhookPayloadGcBlobUpload ...
+type WebhookPayloadGcBlobUpload struct {
+	WebhookPayload
+	OperateType  enums.OperateType   `json:"operate_type" example:"Automatic"`
+	OperateUser  *WebhookPayloadUser `json:"operate_user"`
+	SuccessCount int64               `json:"success_count"`
+	FailedCount  int64               `json:"failed_count"`
+}
+
 // WebhookPayloadGcArtifact ...
 type WebhookPayloadGcArtifact struct {
 	WebhookPayload
@@ -277,3 +286,39 @@ type WebhookPayloadGcTag struct {
 	SuccessCount int64               `json:"success_count"`
 	FailedCount  int64               `json:"failed_count"`
 }
+
+// WebhookPayloadGcPipeline ...
+type WebhookPayloadGcPipeline struct {
+	WebhookPayload
+	OperateType enums.OperateType      `json:"operate_type" example:"Automatic"`
+	OperateUser *WebhookPayloadUser    `json:"operate_user"`
+	Status      enums.TaskCommonStatus `json:"status" example:"Success"`
+	Message     string                 `json:"message"`
+	Report      *GcPipelineReport      `json:"report,omitempty"`
+}
+
+// WebhookPayloadVulnerabilityAllowlist ...
+type WebhookPayloadVulnerabilityAllowlist struct {
+	WebhookPayload
+	Allowlist VulnerabilityAllowlistItem `json:"allowlist"`
+}
+
+// WebhookPayloadQuota ...
+type WebhookPayloadQuota struct {
+	WebhookPayload
+	NamespaceID int64           `json:"namespace_id" example:"1"`
+	Namespace   string          `json:"namespace" example:"library"`
+	Kind        enums.QuotaKind `json:"kind" example:"Size"`
+	Threshold   int             `json:"threshold" example:"80"`
+	Usage       int64           `json:"usage" example:"8000"`
+	Limit       int64           `json:"limit" example:"10000"`
+}
+
+// WebhookPayloadLicenseViolation ...
+type WebhookPayloadLicenseViolation struct {
+	WebhookPayload
+	Namespace  string             `json:"namespace"`
+	Repository string             `json:"repository"`
+	Digest     string             `json:"digest"`
+	Violations []LicenseCheckItem `json:"violations"`
+}
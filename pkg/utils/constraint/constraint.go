@@ -0,0 +1,226 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package constraint
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Compare compares two package versions, it returns -1, 0 or 1 if a is less than, equal to or greater than b.
+// The versions are compared segment by segment, numeric segments are compared numerically and alphabetic
+// segments lexically, an optional epoch (e.g. 1:2.0) is compared first. When one version is the prefix of
+// the other, a trailing pre-release segment (e.g. 1.0.0-rc1) or tilde makes the version less, any other
+// trailing segment (e.g. the alpine revision 1.0.0-r1) makes it greater.
+func Compare(a, b string) int {
+	epochA, restA := splitEpoch(a)
+	epochB, restB := splitEpoch(b)
+	if epochA != epochB {
+		if epochA < epochB {
+			return -1
+		}
+		return 1
+	}
+	tokensA, tokensB := tokenize(restA), tokenize(restB)
+	for i := 0; i < len(tokensA) || i < len(tokensB); i++ {
+		if i >= len(tokensA) {
+			return -trailing(tokensB[i])
+		}
+		if i >= len(tokensB) {
+			return trailing(tokensA[i])
+		}
+		if r := compareToken(tokensA[i], tokensB[i]); r != 0 {
+			return r
+		}
+	}
+	return 0
+}
+
+// Constraint is a parsed version constraint
+type Constraint struct {
+	groups [][]condition
+}
+
+type condition struct {
+	op      string
+	version string
+}
+
+var operators = []string{"==", "!=", "<=", ">=", "=", "<", ">"}
+
+// Parse parses the version constraint, the conditions separated by comma must all match,
+// the groups separated by '||' are alternatives, e.g. ">=1.0, <2.0 || >=3.0".
+func Parse(constraint string) (Constraint, error) {
+	var c Constraint
+	for _, group := range strings.Split(constraint, "||") {
+		var conditions []condition
+		for _, item := range strings.Split(group, ",") {
+			item = strings.TrimSpace(item)
+			if item == "" {
+				return Constraint{}, fmt.Errorf("invalid constraint: %q", constraint)
+			}
+			cond := condition{op: "="}
+			for _, op := range operators {
+				if strings.HasPrefix(item, op) {
+					cond.op = op
+					item = strings.TrimSpace(strings.TrimPrefix(item, op))
+					break
+				}
+			}
+			if item == "" {
+				return Constraint{}, fmt.Errorf("invalid constraint: %q", constraint)
+			}
+			cond.version = item
+			conditions = append(conditions, cond)
+		}
+		c.groups = append(c.groups, conditions)
+	}
+	return c, nil
+}
+
+// Check checks the version whether satisfy the constraint
+func (c Constraint) Check(version string) bool {
+	for _, group := range c.groups {
+		matched := true
+		for _, cond := range group {
+			if !cond.check(version) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (c condition) check(version string) bool {
+	r := Compare(version, c.version)
+	switch c.op {
+	case "=", "==":
+		return r == 0
+	case "!=":
+		return r != 0
+	case "<":
+		return r < 0
+	case "<=":
+		return r <= 0
+	case ">":
+		return r > 0
+	case ">=":
+		return r >= 0
+	}
+	return false
+}
+
+func splitEpoch(version string) (int64, string) {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	index := strings.Index(version, ":")
+	if index <= 0 {
+		return 0, version
+	}
+	epoch, err := strconv.ParseInt(version[:index], 10, 64)
+	if err != nil {
+		return 0, version
+	}
+	return epoch, version[index+1:]
+}
+
+func tokenize(version string) []string {
+	var tokens []string
+	var current strings.Builder
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+	for _, r := range version {
+		switch {
+		case r == '~':
+			flush()
+			tokens = append(tokens, "~")
+		case unicode.IsDigit(r):
+			if current.Len() > 0 && !isNumeric(current.String()) {
+				flush()
+			}
+			current.WriteRune(r)
+		case unicode.IsLetter(r):
+			if current.Len() > 0 && isNumeric(current.String()) {
+				flush()
+			}
+			current.WriteRune(r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+var preReleases = map[string]bool{
+	"~":        true,
+	"alpha":    true,
+	"beta":     true,
+	"dev":      true,
+	"pre":      true,
+	"preview":  true,
+	"rc":       true,
+	"snapshot": true,
+}
+
+// trailing returns the result of comparing a version with a trailing token to its prefix
+func trailing(token string) int {
+	if preReleases[strings.ToLower(token)] {
+		return -1
+	}
+	return 1
+}
+
+func compareToken(a, b string) int {
+	if a == b {
+		return 0
+	}
+	if a == "~" {
+		return -1
+	}
+	if b == "~" {
+		return 1
+	}
+	numericA, numericB := isNumeric(a), isNumeric(b)
+	switch {
+	case numericA && numericB:
+		a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+		if len(a) != len(b) {
+			if len(a) < len(b) {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	case numericA:
+		return 1
+	case numericB:
+		return -1
+	}
+	return strings.Compare(a, b)
+}
+
+func isNumeric(s string) bool {
+	return s != "" && unicode.IsDigit(rune(s[0]))
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"v1.0.0", "1.0.0", 0},
+		{"1.0.0", "1.0.1", -1},
+		{"1.10.0", "1.9.0", 1},
+		{"1.0", "1.0.0", -1},
+		{"1.0.0-rc1", "1.0.0", -1},
+		{"1.0.0-rc1", "1.0.0-rc2", -1},
+		{"1.0.0~beta", "1.0.0", -1},
+		{"1.36.1-r2", "1.36.1", 1},
+		{"1.36.1-r2", "1.36.1-r10", -1},
+		{"3.0.2-0ubuntu1.10", "3.0.2-0ubuntu1.9", 1},
+		{"1:1.0", "2.0", 1},
+		{"1.1.1k", "1.1.1j", 1},
+		{"1.01", "1.1", 0},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.want, Compare(c.a, c.b), "%s <=> %s", c.a, c.b)
+		assert.Equal(t, -c.want, Compare(c.b, c.a), "%s <=> %s", c.b, c.a)
+	}
+}
+
+func TestConstraint(t *testing.T) {
+	c, err := Parse(">=1.0, <2.0 || >=3.0")
+	assert.NoError(t, err)
+	assert.True(t, c.Check("1.0.0"))
+	assert.True(t, c.Check("1.9.9"))
+	assert.False(t, c.Check("2.0.0"))
+	assert.True(t, c.Check("3.1"))
+	assert.False(t, c.Check("0.9"))
+
+	c, err = Parse("1.2.3")
+	assert.NoError(t, err)
+	assert.True(t, c.Check("1.2.3"))
+	assert.False(t, c.Check("1.2.4"))
+
+	c, err = Parse("!= 1.2.3")
+	assert.NoError(t, err)
+	assert.False(t, c.Check("1.2.3"))
+	assert.True(t, c.Check("1.2.4"))
+
+	c, err = Parse("> 1.0, <= 1.1")
+	assert.NoError(t, err)
+	assert.True(t, c.Check("1.1"))
+	assert.False(t, c.Check("1.0"))
+
+	_, err = Parse(">=1.0,")
+	assert.Error(t, err)
+	_, err = Parse(">=")
+	assert.Error(t, err)
+}
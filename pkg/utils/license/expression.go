@@ -0,0 +1,182 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license
+
+import (
+	"fmt"
+	"strings"
+)
+
+// expression is a parsed spdx license expression
+type expression interface {
+	// check returns the reason if the expression is not satisfied, permitted returns the reason if the license id is not permitted
+	check(permitted func(id string) string) string
+	String() string
+}
+
+// licenseExpr is a license id, the exception after WITH is ignored
+type licenseExpr struct {
+	id string
+}
+
+func (e licenseExpr) check(permitted func(id string) string) string {
+	if unknownLicenses[strings.ToLower(e.id)] {
+		return ""
+	}
+	return permitted(e.id)
+}
+
+func (e licenseExpr) String() string {
+	return e.id
+}
+
+// andExpr is satisfied if all of the operands are satisfied
+type andExpr []expression
+
+func (e andExpr) check(permitted func(id string) string) string {
+	for _, operand := range e {
+		reason := operand.check(permitted)
+		if reason != "" {
+			return reason
+		}
+	}
+	return ""
+}
+
+func (e andExpr) String() string {
+	return join(e, " AND ")
+}
+
+// orExpr is satisfied if any of the operands is satisfied
+type orExpr []expression
+
+func (e orExpr) check(permitted func(id string) string) string {
+	for _, operand := range e {
+		if operand.check(permitted) == "" {
+			return ""
+		}
+	}
+	return fmt.Sprintf("none of the licenses in %s is allowed", e.String())
+}
+
+func (e orExpr) String() string {
+	return join(e, " OR ")
+}
+
+func join(operands []expression, sep string) string {
+	items := make([]string, 0, len(operands))
+	for _, operand := range operands {
+		switch operand.(type) {
+		case andExpr, orExpr:
+			items = append(items, "("+operand.String()+")")
+		default:
+			items = append(items, operand.String())
+		}
+	}
+	return strings.Join(items, sep)
+}
+
+// parse parses the spdx license expression, the operators are case insensitive and the exceptions are ignored,
+// e.g. "MIT OR (Apache-2.0 WITH LLVM-exception)" is parsed as MIT OR Apache-2.0.
+// The invalid expression is treated as all of the license ids in it are required.
+func parse(raw string) expression {
+	p := &parser{tokens: strings.Fields(strings.NewReplacer("(", " ( ", ")", " ) ").Replace(raw))}
+	expr, err := p.parseOr()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected token %q", p.tokens[p.pos])
+	}
+	if err != nil {
+		var fallback andExpr
+		for _, id := range IDs([]string{raw}) {
+			fallback = append(fallback, licenseExpr{id: id})
+		}
+		return fallback
+	}
+	return expr
+}
+
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *parser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+// parseOr parses: and-expression { OR and-expression }
+func (p *parser) parseOr() (expression, error) {
+	return p.parseBinary("OR", p.parseAnd, func(operands []expression) expression { return orExpr(operands) })
+}
+
+// parseAnd parses: primary { AND primary }
+func (p *parser) parseAnd() (expression, error) {
+	return p.parseBinary("AND", p.parsePrimary, func(operands []expression) expression { return andExpr(operands) })
+}
+
+func (p *parser) parseBinary(operator string, operand func() (expression, error), build func([]expression) expression) (expression, error) {
+	expr, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	operands := []expression{expr}
+	for strings.EqualFold(p.peek(), operator) {
+		p.pos++
+		expr, err = operand()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, expr)
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return build(operands), nil
+}
+
+// parsePrimary parses: "(" or-expression ")" | license-id [ WITH exception-id ]
+func (p *parser) parsePrimary() (expression, error) {
+	token := p.peek()
+	switch strings.ToUpper(token) {
+	case "":
+		return nil, fmt.Errorf("unexpected end of expression")
+	case "(":
+		p.pos++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return expr, nil
+	case ")", "AND", "OR", "WITH":
+		return nil, fmt.Errorf("unexpected token %q", token)
+	}
+	p.pos++
+	if strings.EqualFold(p.peek(), "WITH") {
+		p.pos++
+		exception := strings.ToUpper(p.peek())
+		if exception == "" || exception == "(" || exception == ")" || exception == "AND" || exception == "OR" || exception == "WITH" {
+			return nil, fmt.Errorf("missing exception after WITH")
+		}
+		p.pos++
+	}
+	return licenseExpr{id: token}, nil
+}
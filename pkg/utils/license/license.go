@@ -0,0 +1,122 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+)
+
+// unknownLicenses the license ids that mean the license of the package is unknown
+var unknownLicenses = map[string]bool{
+	"":            true,
+	"noassertion": true,
+	"none":        true,
+	"unknown":     true,
+}
+
+// IDs returns the license ids in the license expressions, the operators and the exceptions are ignored,
+// e.g. "MIT OR (Apache-2.0 WITH LLVM-exception)" returns [MIT Apache-2.0].
+func IDs(expressions []string) []string {
+	var ids []string
+	for _, expression := range expressions {
+		fields := strings.Fields(strings.NewReplacer("(", " ", ")", " ").Replace(expression))
+		for i := 0; i < len(fields); i++ {
+			switch strings.ToUpper(fields[i]) {
+			case "AND", "OR":
+				continue
+			case "WITH":
+				i++ // skip the exception
+				continue
+			}
+			if !unknownLicenses[strings.ToLower(fields[i])] {
+				ids = append(ids, fields[i])
+			}
+		}
+	}
+	return ids
+}
+
+// Split splits the licenses joined by comma
+func Split(licenses string) []string {
+	var result []string
+	for _, l := range strings.Split(licenses, ",") {
+		l = strings.TrimSpace(l)
+		if l != "" {
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
+// Check checks the licenses of the packages with the license policy,
+// every license expression of the package must be satisfied by the policy.
+func Check(policyObj *models.LicensePolicy, packages []*models.ArtifactPackage) types.LicenseCheckResult {
+	denied := toSet(Split(policyObj.DeniedLicenses))
+	allowed := toSet(Split(policyObj.AllowedLicenses))
+	permitted := func(id string) string {
+		if denied[strings.ToLower(id)] {
+			return fmt.Sprintf("license %s is denied", id)
+		}
+		if len(allowed) > 0 && !allowed[strings.ToLower(id)] {
+			return fmt.Sprintf("license %s is not allowed", id)
+		}
+		return ""
+	}
+
+	result := types.LicenseCheckResult{
+		Status:     enums.LicenseCheckStatusPassed,
+		Violations: make([]types.LicenseCheckItem, 0),
+		Warnings:   make([]types.LicenseCheckItem, 0),
+	}
+	for _, p := range packages {
+		licenses := Split(p.Licenses)
+		item := types.LicenseCheckItem{Name: p.Name, Version: p.Version, Type: p.Type, Licenses: licenses}
+		if len(IDs(licenses)) == 0 {
+			if policyObj.WarnUnknown {
+				item.Reason = "license is unknown"
+				result.Warnings = append(result.Warnings, item)
+			}
+			continue
+		}
+		for _, l := range licenses {
+			item.Reason = parse(l).check(permitted)
+			if item.Reason != "" {
+				break
+			}
+		}
+		if item.Reason != "" {
+			result.Violations = append(result.Violations, item)
+		}
+	}
+	if len(result.Violations) > 0 {
+		result.Status = enums.LicenseCheckStatusViolated
+	} else if len(result.Warnings) > 0 {
+		result.Status = enums.LicenseCheckStatusWarning
+	}
+	return result
+}
+
+func toSet(items []string) map[string]bool {
+	set := make(map[string]bool, len(items))
+	for _, item := range items {
+		set[strings.ToLower(item)] = true
+	}
+	return set
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+)
+
+func TestIDs(t *testing.T) {
+	assert.Equal(t, []string{"MIT", "Apache-2.0"}, IDs([]string{"MIT OR (Apache-2.0 WITH LLVM-exception)"}))
+	assert.Equal(t, []string{"GPL-2.0-only", "BSD-3-Clause"}, IDs([]string{"GPL-2.0-only", "BSD-3-Clause"}))
+	assert.Len(t, IDs([]string{"NOASSERTION"}), 0)
+	assert.Len(t, IDs(nil), 0)
+}
+
+func TestSplit(t *testing.T) {
+	assert.Equal(t, []string{"MIT", "Apache-2.0"}, Split("MIT, Apache-2.0,"))
+	assert.Len(t, Split(""), 0)
+}
+
+func TestCheck(t *testing.T) {
+	packages := []*models.ArtifactPackage{
+		{Name: "busybox", Version: "1.36.1-r2", Type: "apk", Licenses: "GPL-2.0-only"},
+		{Name: "musl", Version: "1.2.4-r2", Type: "apk", Licenses: "MIT"},
+		{Name: "mongo", Version: "7.0.0", Type: "go-module", Licenses: "AGPL-3.0-only"},
+		{Name: "unknown", Version: "1.0.0", Type: "go-module"},
+	}
+
+	result := Check(&models.LicensePolicy{DeniedLicenses: "agpl-3.0-only"}, packages)
+	assert.Equal(t, enums.LicenseCheckStatusViolated, result.Status)
+	assert.Len(t, result.Violations, 1)
+	assert.Equal(t, "mongo", result.Violations[0].Name)
+	assert.Len(t, result.Warnings, 0)
+
+	result = Check(&models.LicensePolicy{AllowedLicenses: "MIT,GPL-2.0-only", WarnUnknown: true}, packages)
+	assert.Equal(t, enums.LicenseCheckStatusViolated, result.Status)
+	assert.Len(t, result.Violations, 1)
+	assert.Equal(t, "license AGPL-3.0-only is not allowed", result.Violations[0].Reason)
+	assert.Len(t, result.Warnings, 1)
+	assert.Equal(t, "unknown", result.Warnings[0].Name)
+
+	result = Check(&models.LicensePolicy{WarnUnknown: true}, packages)
+	assert.Equal(t, enums.LicenseCheckStatusWarning, result.Status)
+
+	result = Check(&models.LicensePolicy{}, packages)
+	assert.Equal(t, enums.LicenseCheckStatusPassed, result.Status)
+}
+
+func TestParse(t *testing.T) {
+	assert.Equal(t, "MIT OR Apache-2.0", parse("MIT OR (Apache-2.0 WITH LLVM-exception)").String())
+	assert.Equal(t, "(MIT AND BSD-3-Clause) OR GPL-2.0-only", parse("MIT and BSD-3-Clause or GPL-2.0-only").String())
+	assert.Equal(t, "MIT AND (BSD-3-Clause OR GPL-2.0-only)", parse("MIT AND (BSD-3-Clause OR GPL-2.0-only)").String())
+	assert.Equal(t, "GPL-2.0-only", parse("((GPL-2.0-only))").String())
+	// the invalid expression requires all of the license ids
+	assert.Equal(t, "MIT AND GPL-2.0-only", parse("MIT OR (GPL-2.0-only").String())
+	assert.Equal(t, "MIT AND GPL-2.0-only", parse("MIT GPL-2.0-only").String())
+}
+
+func TestCheckExpression(t *testing.T) {
+	packages := []*models.ArtifactPackage{
+		{Name: "dual", Version: "1.0.0", Type: "go-module", Licenses: "MIT OR AGPL-3.0-only"},
+		{Name: "both", Version: "1.0.0", Type: "go-module", Licenses: "MIT AND AGPL-3.0-only"},
+		{Name: "nested", Version: "1.0.0", Type: "go-module", Licenses: "(MIT OR AGPL-3.0-only) AND (GPL-3.0-only OR Apache-2.0)"},
+	}
+
+	result := Check(&models.LicensePolicy{DeniedLicenses: "AGPL-3.0-only"}, packages)
+	assert.Equal(t, enums.LicenseCheckStatusViolated, result.Status)
+	assert.Len(t, result.Violations, 1)
+	assert.Equal(t, "both", result.Violations[0].Name)
+	assert.Equal(t, "license AGPL-3.0-only is denied", result.Violations[0].Reason)
+
+	result = Check(&models.LicensePolicy{AllowedLicenses: "MIT,GPL-3.0-only"}, packages)
+	assert.Len(t, result.Violations, 1)
+	assert.Equal(t, "both", result.Violations[0].Name)
+
+	result = Check(&models.LicensePolicy{DeniedLicenses: "MIT,AGPL-3.0-only"}, packages)
+	assert.Len(t, result.Violations, 3)
+	assert.Equal(t, "none of the licenses in MIT OR AGPL-3.0-only is allowed", result.Violations[0].Reason)
+}
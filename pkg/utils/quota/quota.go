@@ -0,0 +1,77 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ParseThresholds parses the comma separated quota warning threshold percentages,
+// the defaults is returned if the thresholds is not set, the invalid percentage is ignored.
+func ParseThresholds(thresholds *string, defaults []int) []int {
+	if thresholds == nil {
+		return normalize(defaults)
+	}
+	var result []int
+	for _, item := range strings.Split(*thresholds, ",") {
+		threshold, err := strconv.Atoi(strings.TrimSpace(item))
+		if err != nil {
+			continue
+		}
+		result = append(result, threshold)
+	}
+	return normalize(result)
+}
+
+// FormatThresholds formats the quota warning threshold percentages to the comma separated string
+func FormatThresholds(thresholds []int) string {
+	var items = make([]string, 0, len(thresholds))
+	for _, threshold := range normalize(thresholds) {
+		items = append(items, strconv.Itoa(threshold))
+	}
+	return strings.Join(items, ",")
+}
+
+// Crossed returns the highest threshold percentage that the usage has reached,
+// 0 is returned if no threshold reached or the limit is not set.
+func Crossed(thresholds []int, usage, limit int64) int {
+	if limit <= 0 {
+		return 0
+	}
+	var crossed int
+	for _, threshold := range thresholds {
+		if usage*100 >= int64(threshold)*limit && threshold > crossed {
+			crossed = threshold
+		}
+	}
+	return crossed
+}
+
+// normalize removes the duplicate and out of range percentages, and sorts the rest in ascending order
+func normalize(thresholds []int) []int {
+	var result = make([]int, 0, len(thresholds))
+	var seen = make(map[int]bool, len(thresholds))
+	for _, threshold := range thresholds {
+		if threshold < 1 || threshold > 100 || seen[threshold] {
+			continue
+		}
+		seen[threshold] = true
+		result = append(result, threshold)
+	}
+	sort.Ints(result)
+	return result
+}
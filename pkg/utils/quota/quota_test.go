@@ -0,0 +1,44 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+func TestParseThresholds(t *testing.T) {
+	assert.Equal(t, []int{80, 95}, ParseThresholds(nil, []int{95, 80}))
+	assert.Equal(t, []int{50, 90}, ParseThresholds(ptr.Of("90, 50,abc,0,101,50"), []int{80, 95}))
+	assert.Equal(t, []int{}, ParseThresholds(ptr.Of(""), []int{80, 95}))
+}
+
+func TestFormatThresholds(t *testing.T) {
+	assert.Equal(t, "80,95", FormatThresholds([]int{95, 80, 80}))
+	assert.Equal(t, "", FormatThresholds(nil))
+}
+
+func TestCrossed(t *testing.T) {
+	thresholds := []int{80, 95}
+	assert.Equal(t, 0, Crossed(thresholds, 79, 100))
+	assert.Equal(t, 80, Crossed(thresholds, 80, 100))
+	assert.Equal(t, 80, Crossed(thresholds, 94, 100))
+	assert.Equal(t, 95, Crossed(thresholds, 120, 100))
+	assert.Equal(t, 0, Crossed(thresholds, 120, 0))
+	assert.Equal(t, 0, Crossed(nil, 120, 100))
+}
@@ -0,0 +1,77 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package referrer
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/opencontainers/go-digest"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/go-sigma/sigma/pkg/consts"
+)
+
+// Push pushes the blobs and the manifest to the repository by the endpoint of the registry,
+// the blobs are uploaded in monolithic mode and the manifest is put by digest, returns the digest of the manifest.
+func Push(ctx context.Context, endpoint, authorization, repository string, manifest imgspecv1.Manifest, blobs ...[]byte) (digest.Digest, error) {
+	manifestBytes, err := json.Marshal(manifest)
+	if err != nil {
+		return "", err
+	}
+
+	client := resty.New()
+	if strings.HasPrefix(endpoint, "https://") {
+		client = resty.NewWithClient(&http.Client{
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}, // nolint: gosec
+		})
+	}
+	client.SetBaseURL(strings.TrimSuffix(endpoint, "/"))
+	client.SetHeader("User-Agent", consts.UserAgent)
+	client.SetAuthToken(authorization)
+
+	for _, blob := range blobs {
+		resp, err := client.R().SetContext(ctx).
+			SetHeader(echo.HeaderContentType, echo.MIMEOctetStream).
+			SetQueryParam("digest", digest.FromBytes(blob).String()).
+			SetBody(blob).
+			Post(fmt.Sprintf("/v2/%s/blobs/uploads/", repository))
+		if err != nil {
+			return "", fmt.Errorf("push blob failed: %w", err)
+		}
+		if resp.StatusCode() != http.StatusCreated {
+			return "", fmt.Errorf("push blob failed, status code: %d, body: %s", resp.StatusCode(), resp.String())
+		}
+	}
+
+	manifestDigest := digest.FromBytes(manifestBytes)
+	resp, err := client.R().SetContext(ctx).
+		SetHeader(echo.HeaderContentType, manifest.MediaType).
+		SetBody(manifestBytes).
+		Put(fmt.Sprintf("/v2/%s/manifests/%s", repository, manifestDigest))
+	if err != nil {
+		return "", fmt.Errorf("push manifest failed: %w", err)
+	}
+	if resp.StatusCode() != http.StatusCreated {
+		return "", fmt.Errorf("push manifest failed, status code: %d, body: %s", resp.StatusCode(), resp.String())
+	}
+	return manifestDigest, nil
+}
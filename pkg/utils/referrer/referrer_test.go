@@ -0,0 +1,68 @@
+// Copyright 2023 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package referrer
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPush(t *testing.T) {
+	content := []byte("content")
+	var blobs []string
+	var manifestPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		switch r.Method {
+		case http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			assert.Equal(t, r.URL.Query().Get("digest"), digest.FromBytes(body).String())
+			blobs = append(blobs, r.URL.Query().Get("digest"))
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodPut:
+			manifestPath = r.URL.Path
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer server.Close()
+
+	manifest := imgspecv1.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: imgspecv1.MediaTypeImageManifest,
+		Config:    imgspecv1.DescriptorEmptyJSON,
+		Layers:    []imgspecv1.Descriptor{{MediaType: "text/plain", Digest: digest.FromBytes(content), Size: int64(len(content))}},
+	}
+	dgest, err := Push(context.Background(), server.URL, "token", "library/busybox", manifest, imgspecv1.DescriptorEmptyJSON.Data, content)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{imgspecv1.DescriptorEmptyJSON.Digest.String(), digest.FromBytes(content).String()}, blobs)
+	assert.Equal(t, "/v2/library/busybox/manifests/"+dgest.String(), manifestPath)
+
+	failed := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer failed.Close()
+	_, err = Push(context.Background(), failed.URL, "token", "library/busybox", manifest, content)
+	assert.Error(t, err)
+}
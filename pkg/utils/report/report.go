@@ -0,0 +1,389 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/go-sigma/sigma/pkg/configs"
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	"github.com/go-sigma/sigma/pkg/scanner"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/compress"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+// severityRanks the rank of the vulnerability severity, higher is more serious
+var severityRanks = map[enums.VulnerabilitySeverity]int{
+	enums.VulnerabilitySeverityNone:     0,
+	enums.VulnerabilitySeverityLow:      1,
+	enums.VulnerabilitySeverityMedium:   2,
+	enums.VulnerabilitySeverityHigh:     3,
+	enums.VulnerabilitySeverityCritical: 4,
+}
+
+// Parse parses the gzip compressed raw report of the scanner to the normalized findings,
+// the findings matched the allowlist are marked as suppressed.
+func Parse(raw []byte, scannerType enums.ScannerType, allowlist []*models.VulnerabilityAllowlist) ([]types.VulnerabilityReportItem, error) {
+	content, err := compress.Decompress(raw)
+	if err != nil {
+		return nil, fmt.Errorf("decompress %s report failed: %w", scannerType, err)
+	}
+	scannerObj, err := scanner.New(ptr.To(configs.GetConfiguration()), ptr.Of(scannerType))
+	if err != nil {
+		return nil, err
+	}
+	items, err := scannerObj.Parse([]byte(content))
+	if err != nil {
+		return nil, err
+	}
+	for index := range items {
+		items[index].Suppressed = scanner.VulnerabilityAllowed(types.VulnerabilityFinding{ID: items[index].ID, Package: items[index].Package}, allowlist)
+	}
+	return items, nil
+}
+
+// Filter filters the findings with the filter
+func Filter(items []types.VulnerabilityReportItem, filter types.VulnerabilityReportFilter) []types.VulnerabilityReportItem {
+	var result = make([]types.VulnerabilityReportItem, 0, len(items))
+	for _, item := range items {
+		if filter.Severity != nil && item.Severity != ptr.To(filter.Severity) {
+			continue
+		}
+		if filter.Package != nil && !strings.Contains(item.Package, ptr.To(filter.Package)) {
+			continue
+		}
+		if filter.Keyword != nil && !strings.Contains(strings.ToLower(item.ID+" "+item.Title), strings.ToLower(ptr.To(filter.Keyword))) {
+			continue
+		}
+		if filter.Fixable != nil && (item.FixedVersion != "") != ptr.To(filter.Fixable) {
+			continue
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+// Sort sorts the findings with the sort field, supports id, package, severity and cvss,
+// the findings are sorted by the severity desc if the sort field is not specified.
+func Sort(items []types.VulnerabilityReportItem, sortable types.Sortable) {
+	field := ptr.To(sortable.Sort)
+	desc := ptr.To(sortable.Method) == enums.SortMethodDesc
+	if field == "" {
+		field = "severity"
+		desc = true
+	}
+	compare := func(i, j int) int {
+		switch field {
+		case "id":
+			return strings.Compare(items[i].ID, items[j].ID)
+		case "package":
+			return strings.Compare(items[i].Package, items[j].Package)
+		case "cvss":
+			return compareFloat(ptr.To(items[i].CVSS), ptr.To(items[j].CVSS))
+		default:
+			return severityRanks[items[i].Severity] - severityRanks[items[j].Severity]
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		c := compare(i, j)
+		if c == 0 {
+			return items[i].ID < items[j].ID
+		}
+		if desc {
+			return c > 0
+		}
+		return c < 0
+	})
+}
+
+func compareFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// csvHeader the header of the csv report
+var csvHeader = []string{"id", "package", "installed_version", "fixed_version", "severity", "cvss",
+	"layer_digest", "target", "title", "primary_url", "suppressed"}
+
+// Csv exports the findings as csv
+func Csv(items []types.VulnerabilityReportItem) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	err := writer.Write(csvHeader)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		var cvss string
+		if item.CVSS != nil {
+			cvss = strconv.FormatFloat(ptr.To(item.CVSS), 'f', 1, 64)
+		}
+		err = writer.Write([]string{item.ID, item.Package, item.InstalledVersion, item.FixedVersion, item.Severity.String(),
+			cvss, item.LayerDigest, item.Target, item.Title, item.PrimaryURL, strconv.FormatBool(item.Suppressed)})
+		if err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	err = writer.Error()
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+type sarifReport struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+// sarifTools the sarif tool driver of the scanners
+var sarifTools = map[enums.ScannerType]sarifDriver{
+	enums.ScannerTypeTrivy:  {Name: "Trivy", InformationURI: "https://github.com/aquasecurity/trivy"},
+	enums.ScannerTypeGrype:  {Name: "Grype", InformationURI: "https://github.com/anchore/grype"},
+	enums.ScannerTypeHarbor: {Name: "Harbor Scanner Adapter", InformationURI: "https://github.com/goharbor/pluggable-scanner-spec"},
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string              `json:"id"`
+	Name                 string              `json:"name"`
+	ShortDescription     sarifMessage        `json:"shortDescription"`
+	HelpURI              string              `json:"helpUri,omitempty"`
+	DefaultConfiguration sarifConfiguration  `json:"defaultConfiguration"`
+	Properties           sarifRuleProperties `json:"properties"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifRuleProperties struct {
+	SecuritySeverity string   `json:"security-severity,omitempty"`
+	Tags             []string `json:"tags"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID       string             `json:"ruleId"`
+	RuleIndex    int                `json:"ruleIndex"`
+	Level        string             `json:"level"`
+	Message      sarifMessage       `json:"message"`
+	Locations    []sarifLocation    `json:"locations"`
+	Suppressions []sarifSuppression `json:"suppressions,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifSuppression struct {
+	Kind          string `json:"kind"`
+	Justification string `json:"justification,omitempty"`
+}
+
+// sarifLevel converts the severity to the sarif level
+func sarifLevel(severity enums.VulnerabilitySeverity) string {
+	switch severity {
+	case enums.VulnerabilitySeverityCritical, enums.VulnerabilitySeverityHigh:
+		return "error"
+	case enums.VulnerabilitySeverityMedium:
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// Sarif exports the findings as sarif 2.1.0, name is the reference of the scanned artifact
+// and scannerType is the scanner that generated the findings
+func Sarif(name string, scannerType enums.ScannerType, items []types.VulnerabilityReportItem) ([]byte, error) {
+	var rules = make([]sarifRule, 0)
+	var results = make([]sarifResult, 0, len(items))
+	var ruleIndexes = make(map[string]int)
+	for _, item := range items {
+		index, ok := ruleIndexes[item.ID]
+		if !ok {
+			rule := sarifRule{
+				ID:                   item.ID,
+				Name:                 "Vulnerability",
+				ShortDescription:     sarifMessage{Text: item.ID},
+				HelpURI:              item.PrimaryURL,
+				DefaultConfiguration: sarifConfiguration{Level: sarifLevel(item.Severity)},
+				Properties:           sarifRuleProperties{Tags: []string{"vulnerability", "security", strings.ToUpper(item.Severity.String())}},
+			}
+			if item.Title != "" {
+				rule.ShortDescription.Text = item.Title
+			}
+			if item.CVSS != nil {
+				rule.Properties.SecuritySeverity = strconv.FormatFloat(ptr.To(item.CVSS), 'f', 1, 64)
+			}
+			index = len(rules)
+			ruleIndexes[item.ID] = index
+			rules = append(rules, rule)
+		}
+		result := sarifResult{
+			RuleID:    item.ID,
+			RuleIndex: index,
+			Level:     sarifLevel(item.Severity),
+			Message: sarifMessage{Text: fmt.Sprintf("Package: %s\nInstalled Version: %s\nVulnerability %s\nSeverity: %s\nFixed Version: %s\nLink: %s",
+				item.Package, item.InstalledVersion, item.ID, item.Severity, item.FixedVersion, item.PrimaryURL)},
+			Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: name}}}},
+		}
+		if item.Suppressed {
+			result.Suppressions = []sarifSuppression{{Kind: "external", Justification: "Accepted by the vulnerability allowlist"}}
+		}
+		results = append(results, result)
+	}
+	return json.Marshal(sarifReport{
+		Version: "2.1.0",
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           sarifTools[scannerType].Name,
+				InformationURI: sarifTools[scannerType].InformationURI,
+				Rules:          rules,
+			}},
+			Results: results,
+		}},
+	})
+}
+
+type vexReport struct {
+	BomFormat       string             `json:"bomFormat"`
+	SpecVersion     string             `json:"specVersion"`
+	SerialNumber    string             `json:"serialNumber"`
+	Version         int                `json:"version"`
+	Metadata        vexMetadata        `json:"metadata"`
+	Vulnerabilities []vexVulnerability `json:"vulnerabilities"`
+}
+
+type vexMetadata struct {
+	Timestamp string       `json:"timestamp"`
+	Component vexComponent `json:"component"`
+}
+
+type vexComponent struct {
+	Type   string `json:"type"`
+	BomRef string `json:"bom-ref"`
+	Name   string `json:"name"`
+}
+
+type vexVulnerability struct {
+	ID       string      `json:"id"`
+	Source   *vexSource  `json:"source,omitempty"`
+	Ratings  []vexRating `json:"ratings,omitempty"`
+	Affects  []vexAffect `json:"affects"`
+	Analysis vexAnalysis `json:"analysis"`
+}
+
+type vexSource struct {
+	URL string `json:"url"`
+}
+
+type vexRating struct {
+	Score    *float64 `json:"score,omitempty"`
+	Severity string   `json:"severity"`
+}
+
+type vexAffect struct {
+	Ref string `json:"ref"`
+}
+
+type vexAnalysis struct {
+	State    string   `json:"state"`
+	Detail   string   `json:"detail,omitempty"`
+	Response []string `json:"response,omitempty"`
+}
+
+// CycloneDXVex exports the findings as cyclonedx 1.5 vex, name is the reference of the scanned artifact
+func CycloneDXVex(name string, items []types.VulnerabilityReportItem) ([]byte, error) {
+	var vulnerabilities = make([]vexVulnerability, 0, len(items))
+	for _, item := range items {
+		vulnerability := vexVulnerability{
+			ID:      item.ID,
+			Ratings: []vexRating{{Score: item.CVSS, Severity: strings.ToLower(item.Severity.String())}},
+			Affects: []vexAffect{{Ref: name}},
+			Analysis: vexAnalysis{
+				State:  "in_triage",
+				Detail: fmt.Sprintf("%s %s in %s", item.Package, item.InstalledVersion, item.Target),
+			},
+		}
+		if item.PrimaryURL != "" {
+			vulnerability.Source = &vexSource{URL: item.PrimaryURL}
+		}
+		if item.Suppressed { // the allowlist accepts the risk, it never claims the vulnerability is not exploitable
+			vulnerability.Analysis.State = "exploitable"
+			vulnerability.Analysis.Detail = fmt.Sprintf("%s, risk accepted by the vulnerability allowlist", vulnerability.Analysis.Detail)
+			vulnerability.Analysis.Response = []string{"will_not_fix"}
+		} else if item.FixedVersion != "" {
+			vulnerability.Analysis.Response = []string{"update"}
+		}
+		vulnerabilities = append(vulnerabilities, vulnerability)
+	}
+	return json.Marshal(vexReport{
+		BomFormat:    "CycloneDX",
+		SpecVersion:  "1.5",
+		SerialNumber: fmt.Sprintf("urn:uuid:%s", uuid.New().String()),
+		Version:      1,
+		Metadata: vexMetadata{
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+			Component: vexComponent{Type: "container", BomRef: name, Name: name},
+		},
+		Vulnerabilities: vulnerabilities,
+	})
+}
@@ -0,0 +1,147 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-sigma/sigma/pkg/dal/models"
+	_ "github.com/go-sigma/sigma/pkg/scanner/trivy"
+	"github.com/go-sigma/sigma/pkg/types"
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/compress"
+	"github.com/go-sigma/sigma/pkg/utils/ptr"
+)
+
+const trivyReport = `{
+  "ArtifactName": "library/alpine:3.18",
+  "Results": [
+    {
+      "Target": "library/alpine:3.18 (alpine 3.18.4)",
+      "Vulnerabilities": [
+        {
+          "VulnerabilityID": "CVE-2023-5678",
+          "PkgName": "libcrypto3",
+          "InstalledVersion": "3.1.3-r0",
+          "FixedVersion": "3.1.4-r1",
+          "Layer": {"Digest": "sha256:96526aa774ef0126ad0fe9e9a95764c5fc37f409ab9e97021e7b4775d82bf6fa"},
+          "PrimaryURL": "https://avd.aquasec.com/nvd/cve-2023-5678",
+          "Title": "openssl: Generating excessively long X9.42 DH keys",
+          "Severity": "MEDIUM",
+          "CVSS": {"nvd": {"V3Score": 5.3}, "redhat": {"V3Score": 5.5}}
+        },
+        {
+          "VulnerabilityID": "CVE-2024-0001",
+          "PkgName": "busybox",
+          "InstalledVersion": "1.36.1-r2",
+          "Severity": "CRITICAL",
+          "CVSS": {"nvd": {"V2Score": 9.8}}
+        },
+        {
+          "VulnerabilityID": "CVE-2024-0002",
+          "PkgName": "musl",
+          "InstalledVersion": "1.2.4-r1",
+          "Severity": "UNKNOWN"
+        }
+      ]
+    }
+  ]
+}`
+
+func TestParse(t *testing.T) {
+	raw, err := compress.CompressBytes([]byte(trivyReport))
+	assert.NoError(t, err)
+
+	items, err := Parse(raw, enums.ScannerTypeTrivy, []*models.VulnerabilityAllowlist{{VulnerabilityID: "cve-2024-0001"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(items))
+	assert.Equal(t, "libcrypto3", items[0].Package)
+	assert.Equal(t, enums.VulnerabilitySeverityMedium, items[0].Severity)
+	assert.Equal(t, 5.5, ptr.To(items[0].CVSS))
+	assert.Equal(t, "sha256:96526aa774ef0126ad0fe9e9a95764c5fc37f409ab9e97021e7b4775d82bf6fa", items[0].LayerDigest)
+	assert.False(t, items[0].Suppressed)
+	assert.Equal(t, 9.8, ptr.To(items[1].CVSS))
+	assert.True(t, items[1].Suppressed)
+	assert.Equal(t, enums.VulnerabilitySeverityNone, items[2].Severity)
+	assert.Nil(t, items[2].CVSS)
+
+	_, err = Parse([]byte("invalid"), enums.ScannerTypeTrivy, nil)
+	assert.Error(t, err)
+}
+
+func TestFilterAndSort(t *testing.T) {
+	raw, err := compress.CompressBytes([]byte(trivyReport))
+	assert.NoError(t, err)
+	items, err := Parse(raw, enums.ScannerTypeTrivy, nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(Filter(items, types.VulnerabilityReportFilter{Severity: ptr.Of(enums.VulnerabilitySeverityCritical)})))
+	assert.Equal(t, 1, len(Filter(items, types.VulnerabilityReportFilter{Fixable: ptr.Of(true)})))
+	assert.Equal(t, 2, len(Filter(items, types.VulnerabilityReportFilter{Fixable: ptr.Of(false)})))
+	assert.Equal(t, 1, len(Filter(items, types.VulnerabilityReportFilter{Package: ptr.Of("crypto")})))
+	assert.Equal(t, 1, len(Filter(items, types.VulnerabilityReportFilter{Keyword: ptr.Of("x9.42")})))
+	assert.Equal(t, 3, len(Filter(items, types.VulnerabilityReportFilter{})))
+
+	Sort(items, types.Sortable{})
+	assert.Equal(t, "CVE-2024-0001", items[0].ID)
+	assert.Equal(t, "CVE-2024-0002", items[2].ID)
+
+	Sort(items, types.Sortable{Sort: ptr.Of("package"), Method: ptr.Of(enums.SortMethodAsc)})
+	assert.Equal(t, "busybox", items[0].Package)
+
+	Sort(items, types.Sortable{Sort: ptr.Of("cvss"), Method: ptr.Of(enums.SortMethodDesc)})
+	assert.Equal(t, "CVE-2024-0001", items[0].ID)
+	assert.Equal(t, "CVE-2024-0002", items[2].ID)
+}
+
+func TestExport(t *testing.T) {
+	raw, err := compress.CompressBytes([]byte(trivyReport))
+	assert.NoError(t, err)
+	items, err := Parse(raw, enums.ScannerTypeTrivy, []*models.VulnerabilityAllowlist{{VulnerabilityID: "CVE-2024-0002", Package: ptr.Of("musl")}})
+	assert.NoError(t, err)
+
+	content, err := Csv(items)
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	assert.Equal(t, 4, len(lines))
+	assert.Equal(t, strings.Join(csvHeader, ","), lines[0])
+	assert.True(t, strings.HasPrefix(lines[1], "CVE-2023-5678,libcrypto3,3.1.3-r0,3.1.4-r1,Medium,5.5,"))
+
+	content, err = Sarif("library/alpine:3.18", enums.ScannerTypeTrivy, items)
+	assert.NoError(t, err)
+	var sarifObj sarifReport
+	assert.NoError(t, json.Unmarshal(content, &sarifObj))
+	assert.Equal(t, "2.1.0", sarifObj.Version)
+	assert.Equal(t, 3, len(sarifObj.Runs[0].Tool.Driver.Rules))
+	assert.Equal(t, "warning", sarifObj.Runs[0].Results[0].Level)
+	assert.Equal(t, "error", sarifObj.Runs[0].Results[1].Level)
+	assert.Equal(t, 1, len(sarifObj.Runs[0].Results[2].Suppressions))
+
+	content, err = CycloneDXVex("library/alpine:3.18", items)
+	assert.NoError(t, err)
+	var vexObj vexReport
+	assert.NoError(t, json.Unmarshal(content, &vexObj))
+	assert.Equal(t, "CycloneDX", vexObj.BomFormat)
+	assert.Equal(t, "1.5", vexObj.SpecVersion)
+	assert.Equal(t, 3, len(vexObj.Vulnerabilities))
+	assert.Equal(t, []string{"update"}, vexObj.Vulnerabilities[0].Analysis.Response)
+	assert.Equal(t, "in_triage", vexObj.Vulnerabilities[1].Analysis.State)
+	assert.Equal(t, "exploitable", vexObj.Vulnerabilities[2].Analysis.State)
+	assert.Equal(t, []string{"will_not_fix"}, vexObj.Vulnerabilities[2].Analysis.Response)
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/syft/format/cyclonedxjson"
+	"github.com/anchore/syft/syft/format/spdxjson"
+	"github.com/anchore/syft/syft/format/syftjson"
+	"github.com/anchore/syft/syft/sbom"
+
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/compress"
+)
+
+const (
+	// SpdxVersion the spdx version of the exported sbom
+	SpdxVersion = "2.3"
+	// CycloneDXVersion the cyclonedx version of the exported sbom
+	CycloneDXVersion = "1.5"
+)
+
+// MediaType returns the media type of the sbom format
+func MediaType(format enums.SbomFormat) string {
+	switch format {
+	case enums.SbomFormatCyclonedxJson:
+		return "application/vnd.cyclonedx+json"
+	default:
+		return "application/spdx+json"
+	}
+}
+
+// Extension returns the file extension of the sbom format
+func Extension(format enums.SbomFormat) string {
+	switch format {
+	case enums.SbomFormatCyclonedxJson:
+		return "cdx.json"
+	default:
+		return "spdx.json"
+	}
+}
+
+// Convert converts the gzip compressed syft json sbom to the specified format
+func Convert(raw []byte, format enums.SbomFormat) ([]byte, error) {
+	content, err := compress.Decompress(raw)
+	if err != nil {
+		return nil, fmt.Errorf("decompress syft sbom failed: %w", err)
+	}
+	document, _, _, err := syftjson.NewFormatDecoder().Decode(strings.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("decode syft sbom failed: %w", err)
+	}
+	var encoder sbom.FormatEncoder
+	switch format {
+	case enums.SbomFormatSpdxJson:
+		encoder, err = spdxjson.NewFormatEncoderWithConfig(spdxjson.EncoderConfig{Version: SpdxVersion})
+	case enums.SbomFormatCyclonedxJson:
+		encoder, err = cyclonedxjson.NewFormatEncoderWithConfig(cyclonedxjson.EncoderConfig{Version: CycloneDXVersion})
+	default:
+		return nil, fmt.Errorf("sbom format %q not supported", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var buffer bytes.Buffer
+	err = encoder.Encode(&buffer, *document)
+	if err != nil {
+		return nil, fmt.Errorf("encode %s sbom failed: %w", format, err)
+	}
+	return buffer.Bytes(), nil
+}
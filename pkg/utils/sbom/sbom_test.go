@@ -0,0 +1,98 @@
+// Copyright 2024 sigma
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-sigma/sigma/pkg/types/enums"
+	"github.com/go-sigma/sigma/pkg/utils/compress"
+)
+
+const syftSbom = `{
+  "artifacts": [
+    {
+      "id": "4b756c6f6fb0ce6a",
+      "name": "busybox",
+      "version": "1.36.1-r2",
+      "type": "apk",
+      "foundBy": "apk-db-cataloger",
+      "locations": [{"path": "/lib/apk/db/installed"}],
+      "licenses": [{"value": "GPL-2.0-only", "spdxExpression": "GPL-2.0-only", "type": "declared"}],
+      "language": "",
+      "cpes": [],
+      "purl": "pkg:apk/alpine/busybox@1.36.1-r2?arch=x86_64&distro=alpine-3.18.4"
+    }
+  ],
+  "artifactRelationships": [],
+  "source": {
+    "id": "sha256:2a1d9b4e1e4c0a8c",
+    "name": "library/alpine",
+    "version": "sha256:2a1d9b4e1e4c0a8c",
+    "type": "image",
+    "metadata": {"userInput": "library/alpine", "imageID": "sha256:2a1d9b4e1e4c0a8c", "manifestDigest": "sha256:2a1d9b4e1e4c0a8c", "mediaType": "application/vnd.oci.image.manifest.v1+json", "tags": [], "imageSize": 1, "layers": [], "manifest": null, "config": null, "repoDigests": [], "architecture": "amd64", "os": "linux"}
+  },
+  "distro": {"prettyName": "Alpine Linux v3.18", "name": "Alpine Linux", "id": "alpine", "versionID": "3.18.4"},
+  "descriptor": {"name": "syft", "version": "1.12.2"},
+  "schema": {"version": "16.0.16", "url": "https://raw.githubusercontent.com/anchore/syft/main/schema/json/schema-16.0.16.json"}
+}`
+
+func TestConvert(t *testing.T) {
+	raw, err := compress.CompressBytes([]byte(syftSbom))
+	assert.NoError(t, err)
+
+	content, err := Convert(raw, enums.SbomFormatSpdxJson)
+	assert.NoError(t, err)
+	var spdxObj struct {
+		SpdxVersion string `json:"spdxVersion"`
+		Packages    []struct {
+			Name string `json:"name"`
+		} `json:"packages"`
+	}
+	assert.NoError(t, json.Unmarshal(content, &spdxObj))
+	assert.Equal(t, "SPDX-"+SpdxVersion, spdxObj.SpdxVersion)
+	assert.Contains(t, string(content), "busybox")
+
+	content, err = Convert(raw, enums.SbomFormatCyclonedxJson)
+	assert.NoError(t, err)
+	var cdxObj struct {
+		BomFormat   string `json:"bomFormat"`
+		SpecVersion string `json:"specVersion"`
+	}
+	assert.NoError(t, json.Unmarshal(content, &cdxObj))
+	assert.Equal(t, "CycloneDX", cdxObj.BomFormat)
+	assert.Equal(t, CycloneDXVersion, cdxObj.SpecVersion)
+
+	_, err = Convert(raw, "fake")
+	assert.Error(t, err)
+
+	_, err = Convert([]byte("invalid"), enums.SbomFormatSpdxJson)
+	assert.Error(t, err)
+
+	raw, err = compress.CompressBytes([]byte(`{"invalid": true}`))
+	assert.NoError(t, err)
+	_, err = Convert(raw, enums.SbomFormatSpdxJson)
+	assert.Error(t, err)
+}
+
+func TestMediaType(t *testing.T) {
+	assert.Equal(t, "application/spdx+json", MediaType(enums.SbomFormatSpdxJson))
+	assert.Equal(t, "application/vnd.cyclonedx+json", MediaType(enums.SbomFormatCyclonedxJson))
+	assert.Equal(t, "spdx.json", Extension(enums.SbomFormatSpdxJson))
+	assert.Equal(t, "cdx.json", Extension(enums.SbomFormatCyclonedxJson))
+}
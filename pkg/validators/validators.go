@@ -78,6 +78,12 @@ func register(v *validator.Validate) {
 	v.RegisterValidation("is_valid_provider", ValidateProvider)                     // nolint:errcheck
 	v.RegisterValidation("is_valid_scm_credential_type", ValidateScmCredentialType) // nolint:errcheck
 	v.RegisterValidation("is_valid_oci_platforms", ValidateOciPlatforms)            // nolint:errcheck
+	v.RegisterValidation("is_valid_severity", ValidateVulnerabilitySeverity)        // nolint:errcheck
+	v.RegisterValidation("is_valid_report_format", ValidateReportFormat)            // nolint:errcheck
+	v.RegisterValidation("is_valid_scanner", ValidateScannerType)                   // nolint:errcheck
+	v.RegisterValidation("is_valid_sbom_format", ValidateSbomFormat)                // nolint:errcheck
+	v.RegisterValidation("is_valid_signing_type", ValidateSigningType)              // nolint:errcheck
+	v.RegisterValidation("is_valid_tag_retention_keep", ValidateTagRetentionKeep)   // nolint:errcheck
 }
 
 // ValidateNamespaceRole ...
@@ -223,3 +229,39 @@ func ValidateOciPlatforms(field validator.FieldLevel) bool {
 	}
 	return true
 }
+
+// ValidateVulnerabilitySeverity validates vulnerability severity
+func ValidateVulnerabilitySeverity(field validator.FieldLevel) bool {
+	_, err := enums.ParseVulnerabilitySeverity(field.Field().String())
+	return err == nil
+}
+
+// ValidateReportFormat validates the vulnerability report format
+func ValidateReportFormat(field validator.FieldLevel) bool {
+	_, err := enums.ParseVulnerabilityReportFormat(field.Field().String())
+	return err == nil
+}
+
+// ValidateScannerType validates the vulnerability scanner type
+func ValidateScannerType(field validator.FieldLevel) bool {
+	_, err := enums.ParseScannerType(field.Field().String())
+	return err == nil
+}
+
+// ValidateSbomFormat validates the sbom format
+func ValidateSbomFormat(field validator.FieldLevel) bool {
+	_, err := enums.ParseSbomFormat(field.Field().String())
+	return err == nil
+}
+
+// ValidateSigningType validates the signing type
+func ValidateSigningType(field validator.FieldLevel) bool {
+	_, err := enums.ParseSigningType(field.Field().String())
+	return err == nil
+}
+
+// ValidateTagRetentionKeep ...
+func ValidateTagRetentionKeep(field validator.FieldLevel) bool {
+	_, err := enums.ParseTagRetentionKeep(field.Field().String())
+	return err == nil
+}
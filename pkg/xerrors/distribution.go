@@ -320,6 +320,50 @@ func GenDSErrCodeResourceCountQuotaExceedNamespaceTag(name string, limit int64)
 	return c
 }
 
+// GenDSErrCodeResourceCountQuotaExceedUserNamespace ...
+func GenDSErrCodeResourceCountQuotaExceedUserNamespace(name string, limit int64) ErrCode {
+	c := ErrCode{
+		Code:           "DENIED",
+		Title:          fmt.Sprintf("requested access to the resource count quota is exceed, user(%s) namespace count quota is %d", name, limit),
+		Description:    `The access controller denied access for the operation on a resource.`,
+		HTTPStatusCode: http.StatusForbidden,
+	}
+	return c
+}
+
+// GenDSErrCodeVulnerabilityPolicyDenied ...
+func GenDSErrCodeVulnerabilityPolicyDenied(name, reason string) ErrCode {
+	c := ErrCode{
+		Code:           "DENIED",
+		Title:          fmt.Sprintf("requested access to the artifact is denied by the vulnerability policy of namespace(%s): %s", name, reason),
+		Description:    `The artifact violates the vulnerability policy of the namespace.`,
+		HTTPStatusCode: http.StatusForbidden,
+	}
+	return c
+}
+
+// GenDSErrCodeLicensePolicyDenied ...
+func GenDSErrCodeLicensePolicyDenied(name, reason string) ErrCode {
+	c := ErrCode{
+		Code:           "DENIED",
+		Title:          fmt.Sprintf("requested access to the artifact is denied by the license policy of namespace(%s): %s", name, reason),
+		Description:    `The artifact violates the license policy of the namespace.`,
+		HTTPStatusCode: http.StatusForbidden,
+	}
+	return c
+}
+
+// GenDSErrCodeSignaturePolicyDenied ...
+func GenDSErrCodeSignaturePolicyDenied(name, reason string) ErrCode {
+	c := ErrCode{
+		Code:           "DENIED",
+		Title:          fmt.Sprintf("requested access to the artifact is denied by the signature policy of namespace(%s): %s", name, reason),
+		Description:    `The artifact has no trusted signature required by the signature policy of the namespace.`,
+		HTTPStatusCode: http.StatusForbidden,
+	}
+	return c
+}
+
 // GenDSErrCodeResourceNotFound ...
 func GenDSErrCodeResourceNotFound(err error) ErrCode {
 	c := ErrCode{
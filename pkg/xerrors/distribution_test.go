@@ -61,6 +61,22 @@ func TestGenDSErrCodeResourceCountQuotaExceedNamespaceTag(t *testing.T) {
 	assert.Equal(t, "requested access to the resource count quota is exceed, namespace(library) tag count quota is 10", GenDSErrCodeResourceCountQuotaExceedNamespaceTag("library", 10).Title)
 }
 
+func TestGenDSErrCodeResourceCountQuotaExceedUserNamespace(t *testing.T) {
+	assert.Equal(t, "requested access to the resource count quota is exceed, user(sigma) namespace count quota is 10", GenDSErrCodeResourceCountQuotaExceedUserNamespace("sigma", 10).Title)
+}
+
+func TestGenDSErrCodeVulnerabilityPolicyDenied(t *testing.T) {
+	assert.Equal(t, "requested access to the artifact is denied by the vulnerability policy of namespace(library): artifact has not been scanned", GenDSErrCodeVulnerabilityPolicyDenied("library", "artifact has not been scanned").Title)
+}
+
+func TestGenDSErrCodeLicensePolicyDenied(t *testing.T) {
+	assert.Equal(t, "requested access to the artifact is denied by the license policy of namespace(library): artifact violates the license policy", GenDSErrCodeLicensePolicyDenied("library", "artifact violates the license policy").Title)
+}
+
+func TestGenDSErrCodeSignaturePolicyDenied(t *testing.T) {
+	assert.Equal(t, "requested access to the artifact is denied by the signature policy of namespace(library): artifact is not signed", GenDSErrCodeSignaturePolicyDenied("library", "artifact is not signed").Title)
+}
+
 func TestGenDSErrCodeResourceNotFound(t *testing.T) {
 	assert.Equal(t, "Not found", GenDSErrCodeResourceNotFound(errors.New("Not found")).Title)
 }